@@ -22,12 +22,14 @@ package app
 
 import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kubernetes/pkg/client/external_metrics"
 	"k8s.io/kubernetes/pkg/controller/podautoscaler"
 	"k8s.io/kubernetes/pkg/controller/podautoscaler/metrics"
 	resourceclient "k8s.io/metrics/pkg/client/clientset_generated/clientset/typed/metrics/v1alpha1"
 	"k8s.io/metrics/pkg/client/custom_metrics"
 
 	// install the APIs so that they're registered with the scheme for the clients
+	_ "k8s.io/kubernetes/pkg/apis/external_metrics/install"
 	_ "k8s.io/metrics/pkg/apis/custom_metrics/install"
 	_ "k8s.io/metrics/pkg/apis/metrics/install"
 )
@@ -50,6 +52,7 @@ func startHPAControllerWithRESTClient(ctx ControllerContext) (bool, error) {
 	metricsClient := metrics.NewRESTMetricsClient(
 		resourceclient.NewForConfigOrDie(clientConfig),
 		custom_metrics.NewForConfigOrDie(clientConfig),
+		external_metrics.NewForConfigOrDie(clientConfig),
 	)
 	return startHPAControllerWithMetricsClient(ctx, metricsClient)
 }
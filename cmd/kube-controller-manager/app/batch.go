@@ -48,6 +48,8 @@ func startCronJobController(ctx ControllerContext) (bool, error) {
 	cronjobConfig := ctx.ClientBuilder.ConfigOrDie("cronjob-controller")
 	cronjobConfig.ContentConfig.GroupVersion = &schema.GroupVersion{Group: batch.GroupName, Version: "v2alpha1"}
 	go cronjob.NewCronJobController(
+		ctx.InformerFactory.Batch().V2alpha1().CronJobs(),
+		ctx.InformerFactory.Batch().V2alpha1().Jobs(),
 		clientset.NewForConfigOrDie(cronjobConfig),
 	).Run(ctx.Stop)
 	return true, nil
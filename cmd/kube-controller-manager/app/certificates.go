@@ -24,7 +24,9 @@ import (
 	"github.com/golang/glog"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	certcontroller "k8s.io/kubernetes/pkg/controller/certificates"
+	"k8s.io/kubernetes/pkg/features"
 )
 
 func startCSRController(ctx ControllerContext) (bool, error) {
@@ -39,11 +41,20 @@ func startCSRController(ctx ControllerContext) (bool, error) {
 		return false, nil
 	}
 
+	approver := certcontroller.NewGroupApprover(ctx.Options.ApproveAllKubeletCSRsForGroup)
+	if utilfeature.DefaultFeatureGate.Enabled(features.RotateKubeletServerCertificate) ||
+		utilfeature.DefaultFeatureGate.Enabled(features.RotateKubeletClientCertificate) {
+		approver = certcontroller.NewChainedApprover(
+			approver,
+			certcontroller.NewNodeApprover(ctx.InformerFactory.Core().V1().Nodes()),
+		)
+	}
+
 	certController, err := certcontroller.NewCertificateController(
 		c,
 		ctx.InformerFactory.Certificates().V1beta1().CertificateSigningRequests(),
 		signer,
-		certcontroller.NewGroupApprover(ctx.Options.ApproveAllKubeletCSRsForGroup),
+		approver,
 	)
 	if err != nil {
 		// TODO this is failing consistently in test-cmd and local-up-cluster.sh.  Fix them and make it consistent with all others which
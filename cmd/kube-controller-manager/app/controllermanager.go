@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/server/healthz"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/discovery"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 	clientv1 "k8s.io/client-go/pkg/api/v1"
@@ -56,7 +57,10 @@ import (
 	servicecontroller "k8s.io/kubernetes/pkg/controller/service"
 	serviceaccountcontroller "k8s.io/kubernetes/pkg/controller/serviceaccount"
 	"k8s.io/kubernetes/pkg/controller/volume/attachdetach"
+	"k8s.io/kubernetes/pkg/controller/volume/expand"
 	persistentvolumecontroller "k8s.io/kubernetes/pkg/controller/volume/persistentvolume"
+	"k8s.io/kubernetes/pkg/controller/volume/snapshot"
+	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/serviceaccount"
 	"k8s.io/kubernetes/pkg/util/configz"
 
@@ -128,14 +132,18 @@ func Run(s *options.CMServer) error {
 	}
 	leaderElectionClient := clientset.NewForConfigOrDie(restclient.AddUserAgent(kubeconfig, "leader-election"))
 
+	mux := http.NewServeMux()
+	var debugMux *http.ServeMux
+	if s.EnableProfiling {
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		// controllers install their debugging handlers under /debug/controllers/
+		debugMux = mux
+	}
+
 	go func() {
-		mux := http.NewServeMux()
 		healthz.InstallHandler(mux)
-		if s.EnableProfiling {
-			mux.HandleFunc("/debug/pprof/", pprof.Index)
-			mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
-			mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
-		}
 		configz.InstallHandler(mux)
 		mux.Handle("/metrics", prometheus.Handler())
 
@@ -167,7 +175,7 @@ func Run(s *options.CMServer) error {
 			clientBuilder = rootClientBuilder
 		}
 
-		err := StartControllers(newControllerInitializers(), s, rootClientBuilder, clientBuilder, stop)
+		err := StartControllers(newControllerInitializers(), s, rootClientBuilder, clientBuilder, debugMux, stop)
 		glog.Fatalf("error running controllers: %v", err)
 		panic("unreachable")
 	}
@@ -223,6 +231,10 @@ type ControllerContext struct {
 	// AvailableResources is a map listing currently available resources
 	AvailableResources map[schema.GroupVersionResource]bool
 
+	// DebugMux is used by the controllers to install their debugging
+	// handlers. It is nil if profiling is disabled.
+	DebugMux *http.ServeMux
+
 	// Stop is the stop channel
 	Stop <-chan struct{}
 }
@@ -335,7 +347,7 @@ func getAvailableResources(clientBuilder controller.ControllerClientBuilder) (ma
 	return allResources, nil
 }
 
-func StartControllers(controllers map[string]InitFunc, s *options.CMServer, rootClientBuilder, clientBuilder controller.ControllerClientBuilder, stop <-chan struct{}) error {
+func StartControllers(controllers map[string]InitFunc, s *options.CMServer, rootClientBuilder, clientBuilder controller.ControllerClientBuilder, debugMux *http.ServeMux, stop <-chan struct{}) error {
 	versionedClient := rootClientBuilder.ClientOrDie("shared-informers")
 	sharedInformers := informers.NewSharedInformerFactory(versionedClient, ResyncPeriod(s)())
 
@@ -379,6 +391,7 @@ func StartControllers(controllers map[string]InitFunc, s *options.CMServer, root
 		InformerFactory:    sharedInformers,
 		Options:            *s,
 		AvailableResources: availableResources,
+		DebugMux:           debugMux,
 		Stop:               stop,
 	}
 
@@ -503,7 +516,8 @@ func StartControllers(controllers map[string]InitFunc, s *options.CMServer, root
 			sharedInformers.Core().V1().PersistentVolumeClaims(),
 			sharedInformers.Core().V1().PersistentVolumes(),
 			cloud,
-			ProbeAttachableVolumePlugins(s.VolumeConfiguration),
+			ProbeAttachableVolumePlugins(),
+			GetDynamicPluginProber(s.VolumeConfiguration),
 			s.DisableAttachDetachReconcilerSync,
 			s.ReconcilerSyncLoopPeriod.Duration,
 		)
@@ -513,6 +527,36 @@ func StartControllers(controllers map[string]InitFunc, s *options.CMServer, root
 	go attachDetachController.Run(stop)
 	time.Sleep(wait.Jitter(s.ControllerStartInterval.Duration, ControllerStartJitter))
 
+	if utilfeature.DefaultFeatureGate.Enabled(features.ExpandPersistentVolumes) {
+		expandController, expandControllerErr := expand.NewExpandController(
+			clientBuilder.ClientOrDie("expand-controller"),
+			sharedInformers.Core().V1().PersistentVolumeClaims(),
+			sharedInformers.Core().V1().PersistentVolumes(),
+			cloud,
+			ProbeControllerVolumePlugins(cloud, s.VolumeConfiguration))
+		if expandControllerErr != nil {
+			return fmt.Errorf("failed to start volume expand controller: %v", expandControllerErr)
+		}
+		go expandController.Run(1, stop)
+		time.Sleep(wait.Jitter(s.ControllerStartInterval.Duration, ControllerStartJitter))
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(features.VolumeSnapshotDataSource) {
+		snapshotController, snapshotControllerErr := snapshot.NewSnapshotController(
+			clientBuilder.ClientOrDie("snapshot-controller"),
+			sharedInformers.Storage().V1beta1().VolumeSnapshots(),
+			sharedInformers.Storage().V1beta1().VolumeSnapshotContents(),
+			sharedInformers.Core().V1().PersistentVolumeClaims(),
+			sharedInformers.Core().V1().PersistentVolumes(),
+			cloud,
+			ProbeControllerVolumePlugins(cloud, s.VolumeConfiguration))
+		if snapshotControllerErr != nil {
+			return fmt.Errorf("failed to start volume snapshot controller: %v", snapshotControllerErr)
+		}
+		go snapshotController.Run(1, stop)
+		time.Sleep(wait.Jitter(s.ControllerStartInterval.Duration, ControllerStartJitter))
+	}
+
 	sharedInformers.Start(stop)
 
 	select {}
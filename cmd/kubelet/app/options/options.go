@@ -35,10 +35,11 @@ import (
 )
 
 const (
-	DefaultKubeletPodsDirName       = "pods"
-	DefaultKubeletVolumesDirName    = "volumes"
-	DefaultKubeletPluginsDirName    = "plugins"
-	DefaultKubeletContainersDirName = "containers"
+	DefaultKubeletPodsDirName          = "pods"
+	DefaultKubeletVolumesDirName       = "volumes"
+	DefaultKubeletVolumeDevicesDirName = "volumeDevices"
+	DefaultKubeletPluginsDirName       = "plugins"
+	DefaultKubeletContainersDirName    = "containers"
 )
 
 // KubeletServer encapsulates all of the parameters necessary for starting up
@@ -64,6 +65,11 @@ type KubeletServer struct {
 	// If runOnce is true, the Kubelet will check the API server once for pods,
 	// run those in addition to the pods specified by the local manifest, and exit.
 	RunOnce bool
+
+	// DynamicConfigDir is the directory where the Kubelet checkpoints the configuration
+	// assigned to its Node. Dynamic Kubelet configuration is only used when it is set and
+	// the DynamicKubeletConfig feature gate is enabled.
+	DynamicConfigDir string
 }
 
 // NewKubeletServer will create a new KubeletServer with default values.
@@ -172,6 +178,9 @@ func (s *KubeletServer) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&s.ImageMinimumGCAge.Duration, "minimum-image-ttl-duration", s.ImageMinimumGCAge.Duration, "Minimum age for an unused image before it is garbage collected.  Examples: '300ms', '10s' or '2h45m'. Default: '2m'")
 	fs.Int32Var(&s.ImageGCHighThresholdPercent, "image-gc-high-threshold", s.ImageGCHighThresholdPercent, "The percent of disk usage after which image garbage collection is always run. Default: 90%")
 	fs.Int32Var(&s.ImageGCLowThresholdPercent, "image-gc-low-threshold", s.ImageGCLowThresholdPercent, "The percent of disk usage before which image garbage collection is never run. Lowest disk usage to garbage collect to. Default: 80%")
+	fs.StringSliceVar(&s.ImageGCPinnedImages, "image-gc-pinned-images", s.ImageGCPinnedImages, "Comma-separated list of image IDs, repo tags or repo digests that are never garbage collected. The pod infra container image is always pinned.")
+	fs.StringVar(&s.ImageGCPinnedImageSelector, "image-gc-pinned-image-selector", s.ImageGCPinnedImageSelector, "Label selector for images that are never garbage collected, e.g. 'k8s.io/pinned=true'.")
+	fs.Int32Var(&s.ImageGCMaxUnusedImages, "image-gc-max-unused-images", s.ImageGCMaxUnusedImages, "Maximum number of unused images to keep, regardless of disk usage. Pinned images are not counted. 0 means no limit. Default: 0")
 	fs.Int32Var(&s.LowDiskSpaceThresholdMB, "low-diskspace-threshold-mb", s.LowDiskSpaceThresholdMB, "The absolute free disk space, in MB, to maintain. When disk space falls below this threshold, new pods would be rejected. Default: 256")
 	fs.MarkDeprecated("low-diskspace-threshold-mb", "Use --eviction-hard instead. Will be removed in a future version.")
 	fs.DurationVar(&s.VolumeStatsAggPeriod.Duration, "volume-stats-agg-period", s.VolumeStatsAggPeriod.Duration, "Specifies interval for kubelet to calculate and cache the volume disk usage for all pods and volumes.  To disable volume calculations, set to 0.  Default: '1m'")
@@ -269,4 +278,9 @@ func (s *KubeletServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.SystemReservedCgroup, "system-reserved-cgroup", s.SystemReservedCgroup, "Absolute name of the top level cgroup that is used to manage non-kubernetes components for which compute resources were reserved via '--system-reserved' flag. Ex. '/system-reserved'. [default='']")
 	fs.StringVar(&s.KubeReservedCgroup, "kube-reserved-cgroup", s.KubeReservedCgroup, "Absolute name of the top level cgroup that is used to manage kubernetes components for which compute resources were reserved via '--kube-reserved' flag. Ex. '/kube-reserved'. [default='']")
 	fs.BoolVar(&s.ExperimentalNodeAllocatableIgnoreEvictionThreshold, "experimental-allocatable-ignore-eviction", s.ExperimentalNodeAllocatableIgnoreEvictionThreshold, "When set to 'true', Hard Eviction Thresholds will be ignored while calculating Node Allocatable. See https://github.com/kubernetes/community/blob/master/contributors/design-proposals/node-allocatable.md for more details. [default=false]")
+
+	// Dynamic Kubelet configuration flags
+	fs.StringVar(&s.DynamicConfigDir, "dynamic-config-dir", s.DynamicConfigDir, "<Warning: Alpha feature> The Kubelet checkpoints the configuration assigned to its Node in this directory and restarts to use it. Requires the DynamicKubeletConfig feature gate. Empty string disables dynamic Kubelet configuration.")
+	fs.DurationVar(&s.ConfigTrialDuration.Duration, "config-trial-duration", s.ConfigTrialDuration.Duration, "<Warning: Alpha feature> Length of the trial period of a new dynamic configuration. If the Kubelet restarts more than --crash-loop-threshold times during the trial period, it rolls back to its last-known-good configuration. Default: '10m'")
+	fs.Int32Var(&s.CrashLoopThreshold, "crash-loop-threshold", s.CrashLoopThreshold, "<Warning: Alpha feature> Number of Kubelet restarts during the trial period of a dynamic configuration after which the configuration is considered bad. Default: 10")
 }
@@ -0,0 +1,45 @@
+#!/bin/bash
+
+# Copyright 2017 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+KUBE_ROOT=$(dirname "${BASH_SOURCE}")/..
+KUBE_CSI_DRIVER_ROOT="${KUBE_ROOT}/pkg/volume/csi/api/v1alpha1/driver"
+source "${KUBE_ROOT}/hack/lib/init.sh"
+
+kube::golang::setup_env
+
+function cleanup {
+	rm -rf ${KUBE_CSI_DRIVER_ROOT}/_tmp/
+}
+
+trap cleanup EXIT
+
+mkdir -p ${KUBE_CSI_DRIVER_ROOT}/_tmp
+cp ${KUBE_CSI_DRIVER_ROOT}/api.pb.go ${KUBE_CSI_DRIVER_ROOT}/_tmp/
+
+ret=0
+KUBE_VERBOSE=3 "${KUBE_ROOT}/hack/update-generated-csi.sh"
+diff -I "gzipped FileDescriptorProto" -I "0x" -Naupr ${KUBE_CSI_DRIVER_ROOT}/_tmp/api.pb.go ${KUBE_CSI_DRIVER_ROOT}/api.pb.go || ret=$?
+if [[ $ret -eq 0 ]]; then
+    echo "Generated CSI driver api is up to date."
+    cp ${KUBE_CSI_DRIVER_ROOT}/_tmp/api.pb.go ${KUBE_CSI_DRIVER_ROOT}/
+else
+    echo "Generated CSI driver api is out of date. Please run hack/update-generated-csi.sh"
+    exit 1
+fi
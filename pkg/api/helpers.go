@@ -132,7 +132,31 @@ var standardContainerResources = sets.NewString(
 // IsStandardContainerResourceName returns true if the container can make a resource request
 // for the specified resource
 func IsStandardContainerResourceName(str string) bool {
-	return standardContainerResources.Has(str)
+	return standardContainerResources.Has(str) || IsHugePageResourceName(ResourceName(str))
+}
+
+// IsHugePageResourceName returns true if the resource name has the huge page
+// resource prefix.
+func IsHugePageResourceName(name ResourceName) bool {
+	return strings.HasPrefix(string(name), ResourceHugePagesPrefix)
+}
+
+// HugePageResourceName returns a ResourceName with the canonical huge page
+// prefix prepended for the specified page size. The page size is converted
+// to its canonical representation.
+func HugePageResourceName(pageSize resource.Quantity) ResourceName {
+	return ResourceName(fmt.Sprintf("%s%s", ResourceHugePagesPrefix, pageSize.String()))
+}
+
+// HugePageSizeFromResourceName returns the page size for the specified huge page
+// resource name. If the specified input is not a valid huge page resource name
+// an error is returned.
+func HugePageSizeFromResourceName(name ResourceName) (resource.Quantity, error) {
+	if !IsHugePageResourceName(name) {
+		return resource.Quantity{}, fmt.Errorf("resource name: %s is not valid hugepage name", name)
+	}
+	pageSize := strings.TrimPrefix(string(name), ResourceHugePagesPrefix)
+	return resource.ParseQuantity(pageSize)
 }
 
 // IsOpaqueIntResourceName returns true if the resource name has the opaque
@@ -207,7 +231,7 @@ var standardResources = sets.NewString(
 
 // IsStandardResourceName returns true if the resource is known to the system
 func IsStandardResourceName(str string) bool {
-	return standardResources.Has(str)
+	return standardResources.Has(str) || IsHugePageResourceName(ResourceName(str))
 }
 
 var integerResources = sets.NewString(
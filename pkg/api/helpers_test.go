@@ -78,6 +78,7 @@ func TestIsStandardResource(t *testing.T) {
 		{"disk", false},
 		{"blah", false},
 		{"x.y.z", false},
+		{"hugepages-2Mi", true},
 	}
 	for i, tc := range testCases {
 		if IsStandardResourceName(tc.input) != tc.output {
@@ -86,6 +87,53 @@ func TestIsStandardResource(t *testing.T) {
 	}
 }
 
+func TestHugePageSizeFromResourceName(t *testing.T) {
+	testCases := []struct {
+		resourceName ResourceName
+		expectVal    resource.Quantity
+		expectErr    bool
+	}{
+		{
+			resourceName: ResourceName("hugepages-"),
+			expectErr:    true,
+		},
+		{
+			resourceName: ResourceName("hugepages-100m"),
+			expectVal:    resource.MustParse("100m"),
+		},
+		{
+			resourceName: ResourceName("hugepages-2Mi"),
+			expectVal:    resource.MustParse("2Mi"),
+		},
+		{
+			resourceName: ResourceName("memory"),
+			expectErr:    true,
+		},
+	}
+	for i, tc := range testCases {
+		v, err := HugePageSizeFromResourceName(tc.resourceName)
+		if err == nil && tc.expectErr {
+			t.Errorf("case[%d], expected error but got none", i)
+		}
+		if err != nil && !tc.expectErr {
+			t.Errorf("case[%d], unexpected error: %v", i, err)
+		}
+		if err == nil && v.Cmp(tc.expectVal) != 0 {
+			t.Errorf("case[%d], expected: %v, got: %v", i, tc.expectVal, v)
+		}
+	}
+}
+
+func TestHugePageResourceName(t *testing.T) {
+	pageSize := resource.NewQuantity(2*1024*1024, resource.BinarySI)
+	if name := HugePageResourceName(*pageSize); name != ResourceName("hugepages-2Mi") {
+		t.Errorf("expected hugepages-2Mi, got %v", name)
+	}
+	if !IsHugePageResourceName(HugePageResourceName(*pageSize)) {
+		t.Errorf("expected %v to be a huge page resource name", HugePageResourceName(*pageSize))
+	}
+}
+
 func TestAddToNodeAddresses(t *testing.T) {
 	testCases := []struct {
 		existing []NodeAddress
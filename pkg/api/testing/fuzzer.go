@@ -36,6 +36,7 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/testapi"
 	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/apis/apps"
 	"k8s.io/kubernetes/pkg/apis/autoscaling"
 	"k8s.io/kubernetes/pkg/apis/batch"
 	"k8s.io/kubernetes/pkg/apis/certificates"
@@ -85,6 +86,11 @@ func overrideGenericFuncs(t apitesting.TestingCommon, codecs runtimeserializer.C
 				return
 			}
 
+			// strip trailing newlines which do not survive roundtrips
+			for len(bytes) >= 1 && bytes[len(bytes)-1] == 10 {
+				bytes = bytes[:len(bytes)-1]
+			}
+
 			// Set the bytes field on the RawExtension
 			r.Raw = bytes
 		},
@@ -525,6 +531,11 @@ func extensionFuncs(t apitesting.TestingCommon) []interface{} {
 				}
 			}
 		},
+		func(j *extensions.DaemonSetSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			rhl := int32(c.Rand.Int31())
+			j.RevisionHistoryLimit = &rhl
+		},
 		func(j *extensions.DaemonSetUpdateStrategy, c fuzz.Continue) {
 			c.FuzzNoCustom(j) // fuzz self without calling this function again
 			// Ensure that strategyType is one of valid values.
@@ -547,14 +558,42 @@ func extensionFuncs(t apitesting.TestingCommon) []interface{} {
 	}
 }
 
+func appsFuncs(t apitesting.TestingCommon) []interface{} {
+	return []interface{}{
+		func(s *apps.StatefulSet, c fuzz.Continue) {
+			c.FuzzNoCustom(s) // fuzz self without calling this function again
+
+			// match defaulter
+			if len(s.Spec.PodManagementPolicy) == 0 {
+				s.Spec.PodManagementPolicy = apps.OrderedReadyPodManagement
+			}
+			if len(s.Spec.UpdateStrategy.Type) == 0 {
+				s.Spec.UpdateStrategy.Type = apps.OnDeleteStatefulSetStrategyType
+			}
+			if s.Spec.UpdateStrategy.Type == apps.RollingUpdateStatefulSetStrategyType && s.Spec.UpdateStrategy.RollingUpdate == nil {
+				s.Spec.UpdateStrategy.RollingUpdate = &apps.RollingUpdateStatefulSetStrategy{}
+			}
+			if s.Spec.RevisionHistoryLimit == nil {
+				s.Spec.RevisionHistoryLimit = new(int32)
+				*s.Spec.RevisionHistoryLimit = 10
+			}
+		},
+	}
+}
+
 func batchFuncs(t apitesting.TestingCommon) []interface{} {
 	return []interface{}{
 		func(j *batch.JobSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(j) // fuzz self without calling this function again
 			completions := int32(c.Rand.Int31())
 			parallelism := int32(c.Rand.Int31())
+			backoffLimit := int32(c.Rand.Int31())
 			j.Completions = &completions
 			j.Parallelism = &parallelism
+			j.BackoffLimit = &backoffLimit
+			modes := []batch.CompletionMode{batch.NonIndexedCompletion, batch.IndexedCompletion}
+			mode := modes[c.Rand.Intn(len(modes))]
+			j.CompletionMode = &mode
 			if c.Rand.Int31()%2 == 0 {
 				j.ManualSelector = newBool(true)
 			} else {
@@ -581,6 +620,10 @@ func batchFuncs(t apitesting.TestingCommon) []interface{} {
 			policies := []batch.ConcurrencyPolicy{batch.AllowConcurrent, batch.ForbidConcurrent, batch.ReplaceConcurrent}
 			*cp = policies[c.Rand.Intn(len(policies))]
 		},
+		func(cp *batch.CatchUpPolicy, c fuzz.Continue) {
+			policies := []batch.CatchUpPolicy{batch.RunLatestCatchUp, batch.RunAllCatchUp, batch.SkipCatchUp}
+			*cp = policies[c.Rand.Intn(len(policies))]
+		},
 	}
 }
 
@@ -600,6 +643,7 @@ func autoscalingFuncs(t apitesting.TestingCommon) []interface{} {
 			}
 
 			targetUtilization := int32(c.RandUint64())
+			targetValue := randomQuantity()
 			s.Metrics = []autoscaling.MetricSpec{
 				{
 					Type: autoscaling.PodsMetricSourceType,
@@ -608,6 +652,13 @@ func autoscalingFuncs(t apitesting.TestingCommon) []interface{} {
 						TargetAverageValue: randomQuantity(),
 					},
 				},
+				{
+					Type: autoscaling.ExternalMetricSourceType,
+					External: &autoscaling.ExternalMetricSource{
+						MetricName:  c.RandString(),
+						TargetValue: &targetValue,
+					},
+				},
 				{
 					Type: autoscaling.ResourceMetricSourceType,
 					Resource: &autoscaling.ResourceMetricSource{
@@ -616,6 +667,37 @@ func autoscalingFuncs(t apitesting.TestingCommon) []interface{} {
 					},
 				},
 			}
+
+			// match defaulter: every field of the scaling rules is populated
+			randomScalingRules := func() *autoscaling.HPAScalingRules {
+				stabilizationWindowSeconds := c.Rand.Int31()
+				selectPolicy := autoscaling.MaxPolicySelect
+				if c.RandBool() {
+					selectPolicy = autoscaling.MinPolicySelect
+				}
+				return &autoscaling.HPAScalingRules{
+					StabilizationWindowSeconds: &stabilizationWindowSeconds,
+					SelectPolicy:               &selectPolicy,
+					Policies: []autoscaling.HPAScalingPolicy{
+						{
+							Type:          autoscaling.PodsScalingPolicy,
+							Value:         c.Rand.Int31(),
+							PeriodSeconds: c.Rand.Int31(),
+						},
+						{
+							Type:          autoscaling.PercentScalingPolicy,
+							Value:         c.Rand.Int31(),
+							PeriodSeconds: c.Rand.Int31(),
+						},
+					},
+				}
+			}
+			if s.Behavior != nil {
+				s.Behavior = &autoscaling.HorizontalPodAutoscalerBehavior{
+					ScaleUp:   randomScalingRules(),
+					ScaleDown: randomScalingRules(),
+				}
+			}
 		},
 		func(s *autoscaling.HorizontalPodAutoscalerStatus, c fuzz.Continue) {
 			c.FuzzNoCustom(s) // fuzz self without calling this function again
@@ -635,6 +717,13 @@ func autoscalingFuncs(t apitesting.TestingCommon) []interface{} {
 						CurrentAverageValue: randomQuantity(),
 					},
 				},
+				{
+					Type: autoscaling.ExternalMetricSourceType,
+					External: &autoscaling.ExternalMetricStatus{
+						MetricName:   c.RandString(),
+						CurrentValue: randomQuantity(),
+					},
+				},
 				{
 					Type: autoscaling.ResourceMetricSourceType,
 					Resource: &autoscaling.ResourceMetricStatus{
@@ -701,6 +790,7 @@ func FuzzerFuncs(t apitesting.TestingCommon, codecs runtimeserializer.CodecFacto
 		overrideGenericFuncs(t, codecs),
 		coreFuncs(t),
 		extensionFuncs(t),
+		appsFuncs(t),
 		batchFuncs(t),
 		autoscalingFuncs(t),
 		rbacFuncs(t),
@@ -364,6 +364,13 @@ type PersistentVolumeSource struct {
 	// PortworxVolume represents a portworx volume attached and mounted on kubelets host machine
 	// +optional
 	PortworxVolume *PortworxVolumeSource
+	// CSI represents storage that is handled by an external storage driver
+	// talking to the kubelet and the controllers over gRPC (Alpha feature).
+	// +optional
+	CSI *CSIPersistentVolumeSource
+	// Local represents directly-attached storage with node affinity (Alpha feature).
+	// +optional
+	Local *LocalVolumeSource
 }
 
 type PersistentVolumeClaimVolumeSource struct {
@@ -420,6 +427,23 @@ type PersistentVolumeSpec struct {
 	// means that this volume does not belong to any StorageClass.
 	// +optional
 	StorageClassName string
+	// NodeAffinity defines constraints that limit what nodes this volume
+	// can be accessed from. This field influences the scheduling of pods
+	// that use this volume (Alpha feature).
+	// +optional
+	NodeAffinity *VolumeNodeAffinity
+	// VolumeMode defines if a volume is intended to be used with a formatted filesystem
+	// or to remain in raw block state. Value of Filesystem is implied when not included in spec.
+	// This is an alpha feature and may change in the future.
+	// +optional
+	VolumeMode *PersistentVolumeMode
+}
+
+// VolumeNodeAffinity defines constraints that limit what nodes this volume
+// can be accessed from.
+type VolumeNodeAffinity struct {
+	// Required specifies hard node constraints that must be met.
+	Required *NodeSelector
 }
 
 // PersistentVolumeReclaimPolicy describes a policy for end-of-life maintenance of persistent volumes
@@ -437,6 +461,16 @@ const (
 	PersistentVolumeReclaimRetain PersistentVolumeReclaimPolicy = "Retain"
 )
 
+// PersistentVolumeMode describes how a volume is intended to be consumed, either Block or Filesystem.
+type PersistentVolumeMode string
+
+const (
+	// PersistentVolumeBlock means the volume will not be formatted with a filesystem and will remain a raw block device.
+	PersistentVolumeBlock PersistentVolumeMode = "Block"
+	// PersistentVolumeFilesystem means the volume will be or is formatted with a filesystem.
+	PersistentVolumeFilesystem PersistentVolumeMode = "Filesystem"
+)
+
 type PersistentVolumeStatus struct {
 	// Phase indicates if a volume is available, bound to a claim, or released by a claim
 	// +optional
@@ -501,6 +535,18 @@ type PersistentVolumeClaimSpec struct {
 	// More info: http://kubernetes.io/docs/user-guide/persistent-volumes#class-1
 	// +optional
 	StorageClassName *string
+	// DataSource is the object to populate the new volume with when it is
+	// dynamically provisioned.  Only VolumeSnapshot objects of the
+	// storage.k8s.io API group are supported; the provisioner restores the
+	// snapshot into the new volume.  This field is alpha-level and is only
+	// honored by servers that enable the VolumeSnapshotDataSource feature.
+	// +optional
+	DataSource *TypedLocalObjectReference
+	// VolumeMode defines what type of volume is required by the claim.
+	// Value of Filesystem is implied when not included in claim spec.
+	// This is an alpha feature and may change in the future.
+	// +optional
+	VolumeMode *PersistentVolumeMode
 }
 
 type PersistentVolumeClaimStatus struct {
@@ -513,6 +559,33 @@ type PersistentVolumeClaimStatus struct {
 	// Represents the actual resources of the underlying volume
 	// +optional
 	Capacity ResourceList
+	// Current Condition of persistent volume claim. If underlying persistent volume is being
+	// resized then the Condition will be set to 'Resizing'.
+	// +optional
+	Conditions []PersistentVolumeClaimCondition
+}
+
+type PersistentVolumeClaimConditionType string
+
+// These are valid conditions of Pvc
+const (
+	// A user triggered resize of the pvc has been started
+	PersistentVolumeClaimResizing PersistentVolumeClaimConditionType = "Resizing"
+	// PersistentVolumeClaimFileSystemResizePending - controller resize is finished and a file system resize is pending on node
+	PersistentVolumeClaimFileSystemResizePending PersistentVolumeClaimConditionType = "FileSystemResizePending"
+)
+
+type PersistentVolumeClaimCondition struct {
+	Type   PersistentVolumeClaimConditionType
+	Status ConditionStatus
+	// +optional
+	LastProbeTime metav1.Time
+	// +optional
+	LastTransitionTime metav1.Time
+	// +optional
+	Reason string
+	// +optional
+	Message string
 }
 
 type PersistentVolumeAccessMode string
@@ -580,8 +653,9 @@ type EmptyDirVolumeSource struct {
 type StorageMedium string
 
 const (
-	StorageMediumDefault StorageMedium = ""       // use whatever the default is for the node
-	StorageMediumMemory  StorageMedium = "Memory" // use memory (tmpfs)
+	StorageMediumDefault   StorageMedium = ""          // use whatever the default is for the node
+	StorageMediumMemory    StorageMedium = "Memory"    // use memory (tmpfs)
+	StorageMediumHugePages StorageMedium = "HugePages" // use hugepages (hugetlbfs)
 )
 
 // Protocol defines network protocols supported for things like container ports.
@@ -1028,6 +1102,38 @@ type PortworxVolumeSource struct {
 	ReadOnly bool
 }
 
+// CSIPersistentVolumeSource represents storage that is managed by an external
+// storage driver.
+type CSIPersistentVolumeSource struct {
+	// Driver is the name of the driver to use for this volume.
+	// Required.
+	Driver string
+	// VolumeHandle is the unique volume name returned by the driver's
+	// CreateVolume call, used to refer to the volume in all the other calls.
+	// Required.
+	VolumeHandle string
+	// Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	// +optional
+	ReadOnly bool
+	// FSType to mount. Must be a filesystem type supported by the host
+	// operating system. Ex. "ext4", "xfs".
+	// +optional
+	FSType string
+	// VolumeAttributes are the attributes of the volume returned by the
+	// driver's CreateVolume call, passed back to the driver in the attach
+	// and mount calls.
+	// +optional
+	VolumeAttributes map[string]string
+}
+
+// LocalVolumeSource represents directly-attached storage with node affinity.
+type LocalVolumeSource struct {
+	// The full path to the volume on the node, for example a directory or a
+	// mount point of a disk partition.
+	Path string
+}
+
 type AzureDataDiskCachingMode string
 
 const (
@@ -1184,6 +1290,45 @@ type VolumeMount struct {
 	// Defaults to "" (volume's root).
 	// +optional
 	SubPath string
+	// mountPropagation determines how mounts are propagated from the host
+	// to container and the other way around.
+	// When not set, MountPropagationNone is used.
+	// This is an alpha feature and may change in the future.
+	// +optional
+	MountPropagation *MountPropagationMode
+}
+
+// MountPropagationMode describes mount propagation.
+type MountPropagationMode string
+
+const (
+	// MountPropagationNone means that the volume in a container will
+	// not receive new mounts from the host or other containers, and filesystems
+	// mounted inside the container won't be propagated to the host or other
+	// containers.
+	// Note that this mode corresponds to "private" in Linux terminology.
+	MountPropagationNone MountPropagationMode = "None"
+	// MountPropagationHostToContainer means that the volume in a container will
+	// receive new mounts from the host or other containers, but filesystems
+	// mounted inside the container won't be propagated to the host or other
+	// containers.
+	// Note that this mode is recursively applied to all mounts in the volume
+	// ("rslave" in Linux terminology).
+	MountPropagationHostToContainer MountPropagationMode = "HostToContainer"
+	// MountPropagationBidirectional means that the volume in a container will
+	// receive new mounts from the host or other containers, and its own mounts
+	// will be propagated from the container to the host or other containers.
+	// Note that this mode is recursively applied to all mounts in the volume
+	// ("rshared" in Linux terminology).
+	MountPropagationBidirectional MountPropagationMode = "Bidirectional"
+)
+
+// VolumeDevice describes a mapping of a raw block device within a container.
+type VolumeDevice struct {
+	// Name must match the name of a persistentVolumeClaim in the pod.
+	Name string
+	// DevicePath is the path inside of the container that the device will be mapped to.
+	DevicePath string
 }
 
 // EnvVar represents an environment variable present in a Container.
@@ -1352,6 +1497,16 @@ type TCPSocketAction struct {
 	Port intstr.IntOrString
 }
 
+// GRPCAction describes an action based on the standard gRPC health checking protocol.
+type GRPCAction struct {
+	// Required: Port number of the gRPC service.
+	Port int32
+	// Service is the name of the service to place in the gRPC HealthCheckRequest.
+	// If this is not specified, the default behavior is defined by gRPC.
+	// +optional
+	Service *string
+}
+
 // ExecAction describes a "run in container" action.
 type ExecAction struct {
 	// Command is the command line to execute inside the container, the working directory for the
@@ -1476,10 +1631,18 @@ type Container struct {
 	Resources ResourceRequirements
 	// +optional
 	VolumeMounts []VolumeMount
+	// VolumeDevices is the list of block devices to be used by the container.
+	// This is an alpha feature and may change in the future.
+	// +optional
+	VolumeDevices []VolumeDevice
 	// +optional
 	LivenessProbe *Probe
 	// +optional
 	ReadinessProbe *Probe
+	// StartupProbe indicates that the container has successfully initialized.
+	// Liveness and readiness probes are not run until it succeeds.
+	// +optional
+	StartupProbe *Probe
 	// +optional
 	Lifecycle *Lifecycle
 	// Required.
@@ -1518,6 +1681,10 @@ type Handler struct {
 	// TODO: implement a realistic TCP lifecycle hook
 	// +optional
 	TCPSocket *TCPSocketAction
+	// GRPC specifies an action involving a gRPC health check.
+	// Only supported by probes.
+	// +optional
+	GRPC *GRPCAction
 }
 
 // Lifecycle describes actions that the management system should take in response to container lifecycle
@@ -1599,6 +1766,10 @@ type ContainerStatus struct {
 	LastTerminationState ContainerState
 	// Ready specifies whether the container has passed its readiness check.
 	Ready bool
+	// Started specifies whether the container has passed its startup probe.
+	// Nil if the container is not running.
+	// +optional
+	Started *bool
 	// Note that this is calculated from dead containers.  But those containers are subject to
 	// garbage collection.  This value will get capped at 5 by GC.
 	RestartCount int32
@@ -2670,6 +2841,16 @@ type NodeSpec struct {
 	// If specified, the node's taints.
 	// +optional
 	Taints []Taint
+
+	// If specified, the source to get node configuration from
+	// The DynamicKubeletConfig feature gate must be enabled for the Kubelet to use this field
+	// +optional
+	ConfigSource *NodeConfigSource
+}
+
+// NodeConfigSource specifies a source of node configuration. Exactly one subfield must be non-nil.
+type NodeConfigSource struct {
+	ConfigMapRef *ObjectReference
 }
 
 // DaemonEndpoint contains information about a single Daemon endpoint.
@@ -2900,6 +3081,9 @@ const (
 const (
 	// Namespace prefix for opaque counted resources (alpha).
 	ResourceOpaqueIntPrefix = "pod.alpha.kubernetes.io/opaque-int-resource-"
+	// Name prefix for huge page resources (alpha). The page size follows the
+	// prefix, e.g. "hugepages-2Mi". Quantities are expressed in bytes.
+	ResourceHugePagesPrefix = "hugepages-"
 )
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -3229,6 +3413,19 @@ type LocalObjectReference struct {
 	Name string
 }
 
+// TypedLocalObjectReference contains enough information to let you locate the
+// typed referenced object inside the same namespace.
+type TypedLocalObjectReference struct {
+	// APIGroup is the group for the resource being referenced.
+	// If APIGroup is not specified, the specified Kind must be in the core API group.
+	// +optional
+	APIGroup *string
+	// Kind is the type of resource being referenced
+	Kind string
+	// Name is the name of resource being referenced
+	Name string
+}
+
 type SerializedReference struct {
 	metav1.TypeMeta
 	// +optional
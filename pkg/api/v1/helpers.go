@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -46,6 +47,30 @@ func OpaqueIntResourceName(name string) ResourceName {
 	return ResourceName(fmt.Sprintf("%s%s", api.ResourceOpaqueIntPrefix, name))
 }
 
+// IsHugePageResourceName returns true if the resource name has the huge page
+// resource prefix.
+func IsHugePageResourceName(name ResourceName) bool {
+	return strings.HasPrefix(string(name), ResourceHugePagesPrefix)
+}
+
+// HugePageResourceName returns a ResourceName with the canonical huge page
+// prefix prepended for the specified page size. The page size is converted
+// to its canonical representation.
+func HugePageResourceName(pageSize resource.Quantity) ResourceName {
+	return ResourceName(fmt.Sprintf("%s%s", ResourceHugePagesPrefix, pageSize.String()))
+}
+
+// HugePageSizeFromResourceName returns the page size for the specified huge page
+// resource name. If the specified input is not a valid huge page resource name
+// an error is returned.
+func HugePageSizeFromResourceName(name ResourceName) (resource.Quantity, error) {
+	if !IsHugePageResourceName(name) {
+		return resource.Quantity{}, fmt.Errorf("resource name: %s is not valid hugepage name", name)
+	}
+	pageSize := strings.TrimPrefix(string(name), ResourceHugePagesPrefix)
+	return resource.ParseQuantity(pageSize)
+}
+
 // NewDeleteOptions returns a DeleteOptions indicating the resource should
 // be deleted within the specified grace period. Use zero to indicate
 // immediate deletion. If you would prefer to use the default grace period,
@@ -354,7 +354,9 @@ func validateVolumeSource(source *api.VolumeSource, fldPath *field.Path) field.E
 	allErrs := field.ErrorList{}
 	if source.EmptyDir != nil {
 		numVolumes++
-		// EmptyDirs have nothing to validate
+		if source.EmptyDir.Medium == api.StorageMediumHugePages && !utilfeature.DefaultFeatureGate.Enabled(features.HugePages) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("emptyDir").Child("medium"), "HugePages medium is disabled by feature-gate"))
+		}
 	}
 	if source.HostPath != nil {
 		if numVolumes > 0 {
@@ -1004,6 +1006,62 @@ func validatePortworxVolumeSource(pwx *api.PortworxVolumeSource, fldPath *field.
 	return allErrs
 }
 
+func validateCSIPersistentVolumeSource(csi *api.CSIPersistentVolumeSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(csi.Driver) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("driver"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(csi.Driver) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("driver"), csi.Driver, msg))
+		}
+	}
+	if len(csi.VolumeHandle) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("volumeHandle"), ""))
+	}
+	return allErrs
+}
+
+func validateLocalVolumeSource(ls *api.LocalVolumeSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if ls.Path == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("path"), ""))
+		return allErrs
+	}
+
+	if !path.IsAbs(ls.Path) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), ls.Path, "must be an absolute path"))
+	}
+	// TODO: this assumes the OS of apiserver & nodes are the same
+	for _, item := range strings.Split(ls.Path, string(os.PathSeparator)) {
+		if item == ".." {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), ls.Path, "must not contain '..'"))
+			break
+		}
+	}
+	return allErrs
+}
+
+// validateVolumeNodeAffinity tests that the PersistentVolume.NodeAffinity has
+// valid data and returns whether it was specified.
+func validateVolumeNodeAffinity(nodeAffinity *api.VolumeNodeAffinity, fldPath *field.Path) (bool, field.ErrorList) {
+	allErrs := field.ErrorList{}
+
+	if nodeAffinity == nil {
+		return false, allErrs
+	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(features.PersistentLocalVolumes) && !utilfeature.DefaultFeatureGate.Enabled(features.VolumeScheduling) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "Volume node affinity is disabled by feature-gate"))
+	}
+
+	if nodeAffinity.Required == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("required"), "must specify required node constraints"))
+	} else {
+		allErrs = append(allErrs, ValidateNodeSelector(nodeAffinity.Required, fldPath.Child("required"))...)
+	}
+	return true, allErrs
+}
+
 // ValidatePersistentVolumeName checks that a name is appropriate for a
 // PersistentVolumeName object.
 var ValidatePersistentVolumeName = NameIsDNSSubdomain
@@ -1012,6 +1070,23 @@ var supportedAccessModes = sets.NewString(string(api.ReadWriteOnce), string(api.
 
 var supportedReclaimPolicy = sets.NewString(string(api.PersistentVolumeReclaimDelete), string(api.PersistentVolumeReclaimRecycle), string(api.PersistentVolumeReclaimRetain))
 
+var supportedVolumeModes = sets.NewString(string(api.PersistentVolumeBlock), string(api.PersistentVolumeFilesystem))
+
+// validateVolumeMode tests that the volume mode of a volume or claim is
+// supported and allowed by the BlockVolume feature gate.
+func validateVolumeMode(volumeMode *api.PersistentVolumeMode, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if volumeMode == nil {
+		return allErrs
+	}
+	if !utilfeature.DefaultFeatureGate.Enabled(features.BlockVolume) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "field is disabled by feature-gate BlockVolume"))
+	} else if !supportedVolumeModes.Has(string(*volumeMode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *volumeMode, supportedVolumeModes.List()))
+	}
+	return allErrs
+}
+
 func ValidatePersistentVolume(pv *api.PersistentVolume) field.ErrorList {
 	allErrs := ValidateObjectMeta(&pv.ObjectMeta, false, ValidatePersistentVolumeName, field.NewPath("metadata"))
 
@@ -1041,6 +1116,7 @@ func ValidatePersistentVolume(pv *api.PersistentVolume) field.ErrorList {
 			allErrs = append(allErrs, field.NotSupported(specPath.Child("persistentVolumeReclaimPolicy"), pv.Spec.PersistentVolumeReclaimPolicy, supportedReclaimPolicy.List()))
 		}
 	}
+	allErrs = append(allErrs, validateVolumeMode(pv.Spec.VolumeMode, specPath.Child("volumeMode"))...)
 
 	volumePlugin := findPluginBySpec(volumePlugins, pv)
 	mountOptions := volume.MountOptionFromApiPV(pv)
@@ -1189,6 +1265,26 @@ func ValidatePersistentVolume(pv *api.PersistentVolume) field.ErrorList {
 		numVolumes++
 		allErrs = append(allErrs, validateAzureDisk(pv.Spec.AzureDisk, specPath.Child("azureDisk"))...)
 	}
+	if pv.Spec.CSI != nil {
+		if !utilfeature.DefaultFeatureGate.Enabled(features.CSIPersistentVolume) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("csi"), "CSI persistent volumes are disabled by feature-gate"))
+		} else if numVolumes > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("csi"), "may not specify more than 1 volume type"))
+		} else {
+			numVolumes++
+			allErrs = append(allErrs, validateCSIPersistentVolumeSource(pv.Spec.CSI, specPath.Child("csi"))...)
+		}
+	}
+	if pv.Spec.Local != nil {
+		if !utilfeature.DefaultFeatureGate.Enabled(features.PersistentLocalVolumes) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("local"), "Local volumes are disabled by feature-gate"))
+		} else if numVolumes > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("local"), "may not specify more than 1 volume type"))
+		} else {
+			numVolumes++
+			allErrs = append(allErrs, validateLocalVolumeSource(pv.Spec.Local, specPath.Child("local"))...)
+		}
+	}
 
 	if numVolumes == 0 {
 		allErrs = append(allErrs, field.Required(specPath, "must specify a volume type"))
@@ -1205,6 +1301,13 @@ func ValidatePersistentVolume(pv *api.PersistentVolume) field.ErrorList {
 		}
 	}
 
+	nodeAffinitySpecified, errs := validateVolumeNodeAffinity(pv.Spec.NodeAffinity, specPath.Child("nodeAffinity"))
+	allErrs = append(allErrs, errs...)
+	// Local volumes are only usable on the nodes they are attached to.
+	if pv.Spec.Local != nil && !nodeAffinitySpecified {
+		allErrs = append(allErrs, field.Required(specPath.Child("nodeAffinity"), "Local volume requires node affinity"))
+	}
+
 	return allErrs
 }
 
@@ -1213,6 +1316,10 @@ func ValidatePersistentVolume(pv *api.PersistentVolume) field.ErrorList {
 func ValidatePersistentVolumeUpdate(newPv, oldPv *api.PersistentVolume) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = ValidatePersistentVolume(newPv)
+	// volumeMode should not be mutable after creation
+	if !apiequality.Semantic.DeepEqual(newPv.Spec.VolumeMode, oldPv.Spec.VolumeMode) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "volumeMode"), "field is immutable after creation"))
+	}
 	newPv.Status = oldPv.Status
 	return allErrs
 }
@@ -1261,6 +1368,40 @@ func ValidatePersistentVolumeClaimSpec(spec *api.PersistentVolumeClaimSpec, fldP
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("storageClassName"), *spec.StorageClassName, msg))
 		}
 	}
+	if spec.DataSource != nil {
+		if !utilfeature.DefaultFeatureGate.Enabled(features.VolumeSnapshotDataSource) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataSource"), "field is disabled by feature-gate VolumeSnapshotDataSource"))
+		} else {
+			allErrs = append(allErrs, validateDataSource(spec.DataSource, fldPath.Child("dataSource"))...)
+		}
+	}
+	allErrs = append(allErrs, validateVolumeMode(spec.VolumeMode, fldPath.Child("volumeMode"))...)
+	return allErrs
+}
+
+// The only data source claims can be provisioned from.
+const (
+	volumeSnapshotAPIGroup = "storage.k8s.io"
+	volumeSnapshotKind     = "VolumeSnapshot"
+)
+
+// validateDataSource tests that the data source of a claim refers to a
+// VolumeSnapshot.
+func validateDataSource(dataSource *api.TypedLocalObjectReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(dataSource.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	apiGroup := ""
+	if dataSource.APIGroup != nil {
+		apiGroup = *dataSource.APIGroup
+	}
+	if apiGroup != volumeSnapshotAPIGroup {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("apiGroup"), apiGroup, []string{volumeSnapshotAPIGroup}))
+	}
+	if dataSource.Kind != volumeSnapshotKind {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kind"), dataSource.Kind, []string{volumeSnapshotKind}))
+	}
 	return allErrs
 }
 
@@ -1268,6 +1409,7 @@ func ValidatePersistentVolumeClaimSpec(spec *api.PersistentVolumeClaimSpec, fldP
 func ValidatePersistentVolumeClaimUpdate(newPvc, oldPvc *api.PersistentVolumeClaim) field.ErrorList {
 	allErrs := ValidateObjectMetaUpdate(&newPvc.ObjectMeta, &oldPvc.ObjectMeta, field.NewPath("metadata"))
 	allErrs = append(allErrs, ValidatePersistentVolumeClaim(newPvc)...)
+
 	// PVController needs to update PVC.Spec w/ VolumeName.
 	// Claims are immutable in order to enforce quota, range limits, etc. without gaming the system.
 	if len(oldPvc.Spec.VolumeName) == 0 {
@@ -1276,10 +1418,37 @@ func ValidatePersistentVolumeClaimUpdate(newPvc, oldPvc *api.PersistentVolumeCla
 		oldPvc.Spec.VolumeName = newPvc.Spec.VolumeName
 		defer func() { oldPvc.Spec.VolumeName = "" }()
 	}
-	// changes to Spec are not allowed, but updates to label/and some annotations are OK.
-	// no-op updates pass validation.
-	if !apiequality.Semantic.DeepEqual(newPvc.Spec, oldPvc.Spec) {
-		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable after creation"))
+	if utilfeature.DefaultFeatureGate.Enabled(features.ExpandPersistentVolumes) {
+		// Bound claims may change their storage request, so compare the
+		// remaining spec with the request reset to the old value.
+		newSpec := newPvc.Spec
+		if oldPvc.Status.Phase == api.ClaimBound && newPvc.Spec.Resources.Requests != nil {
+			newSpec.Resources.Requests = api.ResourceList{}
+			for name, quantity := range newPvc.Spec.Resources.Requests {
+				newSpec.Resources.Requests[name] = quantity
+			}
+			if oldSize, ok := oldPvc.Spec.Resources.Requests[api.ResourceStorage]; ok {
+				newSpec.Resources.Requests[api.ResourceStorage] = oldSize
+			} else {
+				delete(newSpec.Resources.Requests, api.ResourceStorage)
+			}
+		}
+
+		oldSize := oldPvc.Spec.Resources.Requests[api.ResourceStorage]
+		newSize := newPvc.Spec.Resources.Requests[api.ResourceStorage]
+
+		if !apiequality.Semantic.DeepEqual(newSpec, oldPvc.Spec) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "is immutable after creation except resources.requests for bound claims"))
+		}
+		if newSize.Cmp(oldSize) < 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "resources", "requests", "storage"), "field can not be less than previous value"))
+		}
+	} else {
+		// changes to Spec are not allowed, but updates to label/and some annotations are OK.
+		// no-op updates pass validation.
+		if !apiequality.Semantic.DeepEqual(newPvc.Spec, oldPvc.Spec) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "field is immutable after creation"))
+		}
 	}
 
 	// storageclass annotation should be immutable after creation
@@ -1303,10 +1472,29 @@ func ValidatePersistentVolumeClaimStatusUpdate(newPvc, oldPvc *api.PersistentVol
 	for r, qty := range newPvc.Status.Capacity {
 		allErrs = append(allErrs, validateBasicResource(qty, capPath.Key(string(r)))...)
 	}
+	allErrs = append(allErrs, validatePersistentVolumeClaimConditions(newPvc.Status.Conditions, field.NewPath("status", "conditions"))...)
 	newPvc.Spec = oldPvc.Spec
 	return allErrs
 }
 
+var supportedPersistentVolumeClaimConditions = sets.NewString(string(api.PersistentVolumeClaimResizing), string(api.PersistentVolumeClaimFileSystemResizePending))
+
+func validatePersistentVolumeClaimConditions(conditions []api.PersistentVolumeClaimCondition, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(conditions) == 0 {
+		return allErrs
+	}
+	if !utilfeature.DefaultFeatureGate.Enabled(features.ExpandPersistentVolumes) {
+		return append(allErrs, field.Forbidden(fldPath, "field is disabled by feature-gate ExpandPersistentVolumes"))
+	}
+	for i, condition := range conditions {
+		if !supportedPersistentVolumeClaimConditions.Has(string(condition.Type)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i).Child("type"), condition.Type, supportedPersistentVolumeClaimConditions.List()))
+		}
+	}
+	return allErrs
+}
+
 var supportedPortProtocols = sets.NewString(string(api.ProtocolTCP), string(api.ProtocolUDP))
 
 func validateContainerPorts(ports []api.ContainerPort, fldPath *field.Path) field.ErrorList {
@@ -1540,7 +1728,7 @@ func validateSecretKeySelector(s *api.SecretKeySelector, fldPath *field.Path) fi
 	return allErrs
 }
 
-func ValidateVolumeMounts(mounts []api.VolumeMount, volumes sets.String, fldPath *field.Path) field.ErrorList {
+func ValidateVolumeMounts(mounts []api.VolumeMount, volumes sets.String, container *api.Container, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	mountpoints := sets.NewString()
 
@@ -1561,6 +1749,89 @@ func ValidateVolumeMounts(mounts []api.VolumeMount, volumes sets.String, fldPath
 		if len(mnt.SubPath) > 0 {
 			allErrs = append(allErrs, validateLocalDescendingPath(mnt.SubPath, fldPath.Child("subPath"))...)
 		}
+		if mnt.MountPropagation != nil {
+			allErrs = append(allErrs, validateMountPropagation(mnt.MountPropagation, container, idxPath.Child("mountPropagation"))...)
+		}
+	}
+	return allErrs
+}
+
+var supportedMountPropagations = sets.NewString(string(api.MountPropagationBidirectional), string(api.MountPropagationHostToContainer), string(api.MountPropagationNone))
+
+// validateMountPropagation verifies that the mount propagation of a volume
+// mount is enabled, supported and, when Bidirectional, requested by a
+// privileged container.
+func validateMountPropagation(mountPropagation *api.MountPropagationMode, container *api.Container, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if mountPropagation == nil {
+		return allErrs
+	}
+	if !utilfeature.DefaultFeatureGate.Enabled(features.MountPropagation) {
+		return append(allErrs, field.Forbidden(fldPath, "field is disabled by feature-gate MountPropagation"))
+	}
+
+	if !supportedMountPropagations.Has(string(*mountPropagation)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *mountPropagation, supportedMountPropagations.List()))
+	}
+
+	if container == nil {
+		// The container is not available yet, e.g. during validation of
+		// PodPreset. Pod validation refuses Bidirectional propagation in
+		// non-privileged containers later.
+		return allErrs
+	}
+
+	privileged := container.SecurityContext != nil && container.SecurityContext.Privileged != nil && *container.SecurityContext.Privileged
+	if *mountPropagation == api.MountPropagationBidirectional && !privileged {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "Bidirectional mount propagation is available only to privileged containers"))
+	}
+	return allErrs
+}
+
+// validateVolumeDevices tests that the raw block devices of a container refer
+// to claims of the pod and do not share a volume or a path with the volume
+// mounts of the container.
+func validateVolumeDevices(devices []api.VolumeDevice, mounts []api.VolumeMount, claimVolumes sets.String, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(devices) == 0 {
+		return allErrs
+	}
+	if !utilfeature.DefaultFeatureGate.Enabled(features.BlockVolume) {
+		return append(allErrs, field.Forbidden(fldPath, "field is disabled by feature-gate BlockVolume"))
+	}
+
+	mountNames := sets.NewString()
+	mountPaths := sets.NewString()
+	for _, mnt := range mounts {
+		mountNames.Insert(mnt.Name)
+		mountPaths.Insert(mnt.MountPath)
+	}
+	devicePaths := sets.NewString()
+	deviceNames := sets.NewString()
+	for i, dev := range devices {
+		idxPath := fldPath.Index(i)
+		if len(dev.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else if !claimVolumes.Has(dev.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), dev.Name, "must match the name of a persistentVolumeClaim volume"))
+		} else if mountNames.Has(dev.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), dev.Name, "can not be used in both volumeMounts and volumeDevices"))
+		} else if deviceNames.Has(dev.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), dev.Name))
+		}
+		deviceNames.Insert(dev.Name)
+
+		if len(dev.DevicePath) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("devicePath"), ""))
+		} else if !path.IsAbs(dev.DevicePath) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("devicePath"), dev.DevicePath, "must be an absolute path"))
+		} else if devicePaths.Has(dev.DevicePath) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("devicePath"), dev.DevicePath, "must be unique"))
+		} else if mountPaths.Has(dev.DevicePath) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("devicePath"), dev.DevicePath, "can not be used in both volumeMounts and volumeDevices"))
+		}
+		devicePaths.Insert(dev.DevicePath)
 	}
 	return allErrs
 }
@@ -1660,6 +1931,14 @@ func validateTCPSocketAction(tcp *api.TCPSocketAction, fldPath *field.Path) fiel
 	return ValidatePortNumOrName(tcp.Port, fldPath.Child("port"))
 }
 
+func validateGRPCAction(grpc *api.GRPCAction, fldPath *field.Path) field.ErrorList {
+	allErrors := field.ErrorList{}
+	for _, msg := range validation.IsValidPortNum(int(grpc.Port)) {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("port"), grpc.Port, msg))
+	}
+	return allErrors
+}
+
 func validateHandler(handler *api.Handler, fldPath *field.Path) field.ErrorList {
 	numHandlers := 0
 	allErrors := field.ErrorList{}
@@ -1687,19 +1966,35 @@ func validateHandler(handler *api.Handler, fldPath *field.Path) field.ErrorList
 			allErrors = append(allErrors, validateTCPSocketAction(handler.TCPSocket, fldPath.Child("tcpSocket"))...)
 		}
 	}
+	if handler.GRPC != nil {
+		if numHandlers > 0 {
+			allErrors = append(allErrors, field.Forbidden(fldPath.Child("grpc"), "may not specify more than 1 handler type"))
+		} else {
+			numHandlers++
+			allErrors = append(allErrors, validateGRPCAction(handler.GRPC, fldPath.Child("grpc"))...)
+		}
+	}
 	if numHandlers == 0 {
 		allErrors = append(allErrors, field.Required(fldPath, "must specify a handler type"))
 	}
 	return allErrors
 }
 
+func validateLifecycleHandler(handler *api.Handler, fldPath *field.Path) field.ErrorList {
+	allErrs := validateHandler(handler, fldPath)
+	if handler.GRPC != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("grpc"), "only supported by probes"))
+	}
+	return allErrs
+}
+
 func validateLifecycle(lifecycle *api.Lifecycle, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if lifecycle.PostStart != nil {
-		allErrs = append(allErrs, validateHandler(lifecycle.PostStart, fldPath.Child("postStart"))...)
+		allErrs = append(allErrs, validateLifecycleHandler(lifecycle.PostStart, fldPath.Child("postStart"))...)
 	}
 	if lifecycle.PreStop != nil {
-		allErrs = append(allErrs, validateHandler(lifecycle.PreStop, fldPath.Child("preStop"))...)
+		allErrs = append(allErrs, validateLifecycleHandler(lifecycle.PreStop, fldPath.Child("preStop"))...)
 	}
 	return allErrs
 }
@@ -1748,6 +2043,9 @@ func validateInitContainers(containers, otherContainers []api.Container, volumes
 		if ctr.ReadinessProbe != nil {
 			allErrs = append(allErrs, field.Invalid(idxPath.Child("readinessProbe"), ctr.ReadinessProbe, "must not be set for init containers"))
 		}
+		if ctr.StartupProbe != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("startupProbe"), ctr.StartupProbe, "must not be set for init containers"))
+		}
 	}
 	return allErrs
 }
@@ -1794,9 +2092,14 @@ func validateContainers(containers []api.Container, volumes sets.String, fldPath
 		}
 
 		allErrs = append(allErrs, validateProbe(ctr.ReadinessProbe, idxPath.Child("readinessProbe"))...)
+		allErrs = append(allErrs, validateProbe(ctr.StartupProbe, idxPath.Child("startupProbe"))...)
+		// Startup-specific validation
+		if ctr.StartupProbe != nil && ctr.StartupProbe.SuccessThreshold != 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("startupProbe", "successThreshold"), ctr.StartupProbe.SuccessThreshold, "must be 1"))
+		}
 		allErrs = append(allErrs, validateContainerPorts(ctr.Ports, idxPath.Child("ports"))...)
 		allErrs = append(allErrs, ValidateEnv(ctr.Env, idxPath.Child("env"))...)
-		allErrs = append(allErrs, ValidateVolumeMounts(ctr.VolumeMounts, volumes, idxPath.Child("volumeMounts"))...)
+		allErrs = append(allErrs, ValidateVolumeMounts(ctr.VolumeMounts, volumes, &ctr, idxPath.Child("volumeMounts"))...)
 		allErrs = append(allErrs, validatePullPolicy(ctr.ImagePullPolicy, idxPath.Child("imagePullPolicy"))...)
 		allErrs = append(allErrs, ValidateResourceRequirements(&ctr.Resources, idxPath.Child("resources"))...)
 		allErrs = append(allErrs, ValidateSecurityContext(ctr.SecurityContext, idxPath.Child("securityContext"))...)
@@ -2009,6 +2312,18 @@ func ValidatePodSpec(spec *api.PodSpec, fldPath *field.Path) field.ErrorList {
 	allErrs = append(allErrs, vErrs...)
 	allErrs = append(allErrs, validateContainers(spec.Containers, allVolumes, fldPath.Child("containers"))...)
 	allErrs = append(allErrs, validateInitContainers(spec.InitContainers, spec.Containers, allVolumes, fldPath.Child("initContainers"))...)
+	claimVolumes := sets.NewString()
+	for _, vol := range spec.Volumes {
+		if vol.PersistentVolumeClaim != nil && allVolumes.Has(vol.Name) {
+			claimVolumes.Insert(vol.Name)
+		}
+	}
+	for i, ctr := range spec.Containers {
+		allErrs = append(allErrs, validateVolumeDevices(ctr.VolumeDevices, ctr.VolumeMounts, claimVolumes, fldPath.Child("containers").Index(i).Child("volumeDevices"))...)
+	}
+	for i, ctr := range spec.InitContainers {
+		allErrs = append(allErrs, validateVolumeDevices(ctr.VolumeDevices, ctr.VolumeMounts, claimVolumes, fldPath.Child("initContainers").Index(i).Child("volumeDevices"))...)
+	}
 	allErrs = append(allErrs, validateRestartPolicy(&spec.RestartPolicy, fldPath.Child("restartPolicy"))...)
 	allErrs = append(allErrs, validateDNSPolicy(&spec.DNSPolicy, fldPath.Child("dnsPolicy"))...)
 	allErrs = append(allErrs, unversionedvalidation.ValidateLabels(spec.NodeSelector, fldPath.Child("nodeSelector"))...)
@@ -2955,10 +3270,50 @@ func ValidateNode(node *api.Node) field.ErrorList {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "externalID"), ""))
 	}
 
+	allErrs = append(allErrs, validateNodeConfigSource(node.Spec.ConfigSource, field.NewPath("spec", "configSource"))...)
+
 	// TODO(rjnagal): Ignore PodCIDR till its completely implemented.
 	return allErrs
 }
 
+// validateNodeConfigSource tests that the config source of a node references exactly one source
+// and that the reference carries enough information to find that source.
+func validateNodeConfigSource(source *api.NodeConfigSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if source == nil {
+		return allErrs
+	}
+	if !utilfeature.DefaultFeatureGate.Enabled(features.DynamicKubeletConfig) {
+		return append(allErrs, field.Forbidden(fldPath, "configSource may only be set when the DynamicKubeletConfig feature gate is enabled"))
+	}
+	ref := source.ConfigMapRef
+	if ref == nil {
+		return append(allErrs, field.Required(fldPath.Child("configMapRef"), "exactly one config source must be specified"))
+	}
+	refPath := fldPath.Child("configMapRef")
+	if len(ref.Kind) > 0 && ref.Kind != "ConfigMap" {
+		allErrs = append(allErrs, field.NotSupported(refPath.Child("kind"), ref.Kind, []string{"ConfigMap"}))
+	}
+	if len(ref.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(refPath.Child("namespace"), ""))
+	} else {
+		for _, msg := range ValidateNamespaceName(ref.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("namespace"), ref.Namespace, msg))
+		}
+	}
+	if len(ref.Name) == 0 {
+		allErrs = append(allErrs, field.Required(refPath.Child("name"), ""))
+	} else {
+		for _, msg := range ValidateConfigMapName(ref.Name, false) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("name"), ref.Name, msg))
+		}
+	}
+	if len(ref.UID) == 0 {
+		allErrs = append(allErrs, field.Required(refPath.Child("uid"), ""))
+	}
+	return allErrs
+}
+
 // ValidateNodeUpdate tests to make sure a node update can be applied.  Modifies oldNode.
 func ValidateNodeUpdate(node, oldNode *api.Node) field.ErrorList {
 	fldPath := field.NewPath("metadata")
@@ -3015,10 +3370,14 @@ func ValidateNodeUpdate(node, oldNode *api.Node) field.ErrorList {
 	}
 	oldNode.Spec.Taints = node.Spec.Taints
 
+	// update the config source
+	allErrs = append(allErrs, validateNodeConfigSource(node.Spec.ConfigSource, field.NewPath("spec", "configSource"))...)
+	oldNode.Spec.ConfigSource = node.Spec.ConfigSource
+
 	// TODO: Add a 'real' error type for this error and provide print actual diffs.
 	if !apiequality.Semantic.DeepEqual(oldNode, node) {
 		glog.V(4).Infof("Update failed validation %#v vs %#v", oldNode, node)
-		allErrs = append(allErrs, field.Forbidden(field.NewPath(""), "node updates may only change labels, taints or capacity"))
+		allErrs = append(allErrs, field.Forbidden(field.NewPath(""), "node updates may only change labels, taints, configSource or capacity"))
 	}
 
 	return allErrs
@@ -3380,11 +3739,17 @@ func ValidateResourceRequirements(requirements *api.ResourceRequirements, fldPat
 		// Validate resource quantity.
 		allErrs = append(allErrs, ValidateResourceQuantityValue(string(resourceName), quantity, fldPath)...)
 
+		if api.IsHugePageResourceName(resourceName) {
+			allErrs = append(allErrs, validateHugePageResource(resourceName, quantity, fldPath)...)
+		}
+
 		// Check that request <= limit.
 		requestQuantity, exists := requirements.Requests[resourceName]
 		if exists {
-			// For GPUs, not only requests can't exceed limits, they also can't be lower, i.e. must be equal.
-			if resourceName == api.ResourceNvidiaGPU && quantity.Cmp(requestQuantity) != 0 {
+			// For GPUs and huge pages, not only requests can't exceed limits, they also can't be lower, i.e. must be equal.
+			if api.IsHugePageResourceName(resourceName) && quantity.Cmp(requestQuantity) != 0 {
+				allErrs = append(allErrs, field.Invalid(reqPath, requestQuantity.String(), fmt.Sprintf("must be equal to %s limit", resourceName)))
+			} else if resourceName == api.ResourceNvidiaGPU && quantity.Cmp(requestQuantity) != 0 {
 				allErrs = append(allErrs, field.Invalid(reqPath, requestQuantity.String(), fmt.Sprintf("must be equal to %s limit", api.ResourceNvidiaGPU)))
 			} else if quantity.Cmp(requestQuantity) < 0 {
 				allErrs = append(allErrs, field.Invalid(limPath, quantity.String(), fmt.Sprintf("must be greater than or equal to %s request", resourceName)))
@@ -3397,11 +3762,34 @@ func ValidateResourceRequirements(requirements *api.ResourceRequirements, fldPat
 		allErrs = append(allErrs, validateContainerResourceName(string(resourceName), fldPath)...)
 		// Validate resource quantity.
 		allErrs = append(allErrs, ValidateResourceQuantityValue(string(resourceName), quantity, fldPath)...)
+		// Huge pages can not be overcommitted, so a request must come with a matching limit.
+		if api.IsHugePageResourceName(resourceName) {
+			if _, exists := requirements.Limits[resourceName]; !exists {
+				allErrs = append(allErrs, field.Required(limPath.Key(string(resourceName)), "must be specified when requesting huge pages"))
+			}
+		}
 	}
 
 	return allErrs
 }
 
+// validateHugePageResource checks that huge pages are enabled and that the
+// quantity is a multiple of the page size encoded in the resource name.
+func validateHugePageResource(resourceName api.ResourceName, quantity resource.Quantity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !utilfeature.DefaultFeatureGate.Enabled(features.HugePages) {
+		return append(allErrs, field.Forbidden(fldPath, "HugePages are disabled by feature-gate"))
+	}
+	pageSize, err := api.HugePageSizeFromResourceName(resourceName)
+	if err != nil || pageSize.Value() <= 0 {
+		return append(allErrs, field.Invalid(fldPath, string(resourceName), "must specify a valid huge page size"))
+	}
+	if quantity.Value()%pageSize.Value() != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, quantity.String(), fmt.Sprintf("must be a multiple of the page size %s", pageSize.String())))
+	}
+	return allErrs
+}
+
 // validateResourceQuotaScopes ensures that each enumerated hard resource constraint is valid for set of scopes
 func validateResourceQuotaScopes(resourceQuotaSpec *api.ResourceQuotaSpec, fld *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
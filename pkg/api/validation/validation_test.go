@@ -17,6 +17,7 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -26,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/service"
 	"k8s.io/kubernetes/pkg/api/v1"
@@ -258,6 +260,184 @@ func TestValidatePersistentVolumes(t *testing.T) {
 
 }
 
+func TestValidateCSIPersistentVolumeSource(t *testing.T) {
+	csiVolume := func(csi *api.CSIPersistentVolumeSource) *api.PersistentVolume {
+		return testVolume("csi-volume", "", api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceName(api.ResourceStorage): resource.MustParse("10G"),
+			},
+			AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				CSI: csi,
+			},
+		})
+	}
+	successCases := map[string]*api.PersistentVolume{
+		"valid": csiVolume(&api.CSIPersistentVolumeSource{Driver: "com.example.csi", VolumeHandle: "vol-1"}),
+		"with attributes": csiVolume(&api.CSIPersistentVolumeSource{
+			Driver:           "com.example.csi",
+			VolumeHandle:     "vol-1",
+			FSType:           "ext4",
+			VolumeAttributes: map[string]string{"zone": "a"},
+		}),
+	}
+	errorCases := map[string]*api.PersistentVolume{
+		"missing driver":        csiVolume(&api.CSIPersistentVolumeSource{VolumeHandle: "vol-1"}),
+		"invalid driver":        csiVolume(&api.CSIPersistentVolumeSource{Driver: "Example_Driver", VolumeHandle: "vol-1"}),
+		"missing volume handle": csiVolume(&api.CSIPersistentVolumeSource{Driver: "com.example.csi"}),
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("CSIPersistentVolume=true"); err != nil {
+		t.Fatalf("failed to enable feature gate for CSIPersistentVolume: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set("CSIPersistentVolume=false")
+	for k, v := range successCases {
+		if errs := ValidatePersistentVolume(v); len(errs) != 0 {
+			t.Errorf("case[%s] expected success, got %v", k, errs)
+		}
+	}
+	for k, v := range errorCases {
+		if errs := ValidatePersistentVolume(v); len(errs) == 0 {
+			t.Errorf("case[%s] expected failure", k)
+		}
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("CSIPersistentVolume=false"); err != nil {
+		t.Fatalf("failed to disable feature gate for CSIPersistentVolume: %v", err)
+	}
+	for k, v := range successCases {
+		if errs := ValidatePersistentVolume(v); len(errs) == 0 {
+			t.Errorf("case[%s] expected failure with the feature gate disabled", k)
+		}
+	}
+}
+
+func TestValidateLocalVolumes(t *testing.T) {
+	nodeAffinity := &api.VolumeNodeAffinity{
+		Required: &api.NodeSelector{
+			NodeSelectorTerms: []api.NodeSelectorTerm{
+				{
+					MatchExpressions: []api.NodeSelectorRequirement{
+						{
+							Key:      "kubernetes.io/hostname",
+							Operator: api.NodeSelectorOpIn,
+							Values:   []string{"node-1"},
+						},
+					},
+				},
+			},
+		},
+	}
+	localVolume := func(path string, affinity *api.VolumeNodeAffinity) *api.PersistentVolume {
+		return testVolume("local-volume", "", api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceName(api.ResourceStorage): resource.MustParse("10G"),
+			},
+			AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				Local: &api.LocalVolumeSource{Path: path},
+			},
+			NodeAffinity: affinity,
+		})
+	}
+	successCases := map[string]*api.PersistentVolume{
+		"valid": localVolume("/mnt/disks/ssd1", nodeAffinity),
+	}
+	errorCases := map[string]*api.PersistentVolume{
+		"missing path":                      localVolume("", nodeAffinity),
+		"relative path":                     localVolume("mnt/disks/ssd1", nodeAffinity),
+		"path with backsteps":               localVolume("/mnt/disks/../ssd1", nodeAffinity),
+		"missing node affinity":             localVolume("/mnt/disks/ssd1", nil),
+		"missing required node constraints": localVolume("/mnt/disks/ssd1", &api.VolumeNodeAffinity{}),
+		"empty node selector terms": localVolume("/mnt/disks/ssd1", &api.VolumeNodeAffinity{
+			Required: &api.NodeSelector{},
+		}),
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("PersistentLocalVolumes=true"); err != nil {
+		t.Fatalf("failed to enable feature gate for PersistentLocalVolumes: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set("PersistentLocalVolumes=false")
+	for k, v := range successCases {
+		if errs := ValidatePersistentVolume(v); len(errs) != 0 {
+			t.Errorf("case[%s] expected success, got %v", k, errs)
+		}
+	}
+	for k, v := range errorCases {
+		if errs := ValidatePersistentVolume(v); len(errs) == 0 {
+			t.Errorf("case[%s] expected failure", k)
+		}
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("PersistentLocalVolumes=false"); err != nil {
+		t.Fatalf("failed to disable feature gate for PersistentLocalVolumes: %v", err)
+	}
+	for k, v := range successCases {
+		if errs := ValidatePersistentVolume(v); len(errs) == 0 {
+			t.Errorf("case[%s] expected failure with the feature gate disabled", k)
+		}
+	}
+}
+
+func TestValidateVolumeNodeAffinity(t *testing.T) {
+	hostPathVolume := func(affinity *api.VolumeNodeAffinity) *api.PersistentVolume {
+		return testVolume("host-path-volume", "", api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceName(api.ResourceStorage): resource.MustParse("10G"),
+			},
+			AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/foo"},
+			},
+			NodeAffinity: affinity,
+		})
+	}
+	volume := hostPathVolume(&api.VolumeNodeAffinity{
+		Required: &api.NodeSelector{
+			NodeSelectorTerms: []api.NodeSelectorTerm{
+				{
+					MatchExpressions: []api.NodeSelectorRequirement{
+						{
+							Key:      "failure-domain.beta.kubernetes.io/zone",
+							Operator: api.NodeSelectorOpIn,
+							Values:   []string{"us-central1-a"},
+						},
+					},
+				},
+			},
+		},
+	})
+	invalidVolume := hostPathVolume(&api.VolumeNodeAffinity{
+		Required: &api.NodeSelector{
+			NodeSelectorTerms: []api.NodeSelectorTerm{
+				{
+					MatchExpressions: []api.NodeSelectorRequirement{
+						{
+							Key:      "failure-domain.beta.kubernetes.io/zone",
+							Operator: api.NodeSelectorOpIn,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	if errs := ValidatePersistentVolume(volume); len(errs) == 0 {
+		t.Errorf("expected failure with the feature gates disabled")
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("VolumeScheduling=true"); err != nil {
+		t.Fatalf("failed to enable feature gate for VolumeScheduling: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set("VolumeScheduling=false")
+	if errs := ValidatePersistentVolume(volume); len(errs) != 0 {
+		t.Errorf("expected success, got %v", errs)
+	}
+	if errs := ValidatePersistentVolume(invalidVolume); len(errs) == 0 {
+		t.Errorf("expected failure for a requirement without values")
+	}
+}
+
 func testVolumeClaim(name string, namespace string, spec api.PersistentVolumeClaimSpec) *api.PersistentVolumeClaim {
 	return &api.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
@@ -638,6 +818,317 @@ func TestValidatePersistentVolumeClaimUpdate(t *testing.T) {
 	}
 }
 
+func TestValidatePersistentVolumeClaimResize(t *testing.T) {
+	newClaim := func(size string, phase api.PersistentVolumeClaimPhase) *api.PersistentVolumeClaim {
+		claim := testVolumeClaim("foo", "ns", api.PersistentVolumeClaimSpec{
+			AccessModes: []api.PersistentVolumeAccessMode{
+				api.ReadWriteOnce,
+			},
+			Resources: api.ResourceRequirements{
+				Requests: api.ResourceList{
+					api.ResourceName(api.ResourceStorage): resource.MustParse(size),
+				},
+			},
+			VolumeName: "volume",
+		})
+		claim.ResourceVersion = "1"
+		claim.Status.Phase = phase
+		return claim
+	}
+
+	scenarios := map[string]struct {
+		isExpectedFailure bool
+		enableResize      bool
+		oldClaim          *api.PersistentVolumeClaim
+		newClaim          *api.PersistentVolumeClaim
+	}{
+		"valid-expand-bound-claim": {
+			isExpectedFailure: false,
+			enableResize:      true,
+			oldClaim:          newClaim("10G", api.ClaimBound),
+			newClaim:          newClaim("20G", api.ClaimBound),
+		},
+		"invalid-expand-feature-disabled": {
+			isExpectedFailure: true,
+			enableResize:      false,
+			oldClaim:          newClaim("10G", api.ClaimBound),
+			newClaim:          newClaim("20G", api.ClaimBound),
+		},
+		"invalid-shrink-bound-claim": {
+			isExpectedFailure: true,
+			enableResize:      true,
+			oldClaim:          newClaim("20G", api.ClaimBound),
+			newClaim:          newClaim("10G", api.ClaimBound),
+		},
+		"invalid-expand-pending-claim": {
+			isExpectedFailure: true,
+			enableResize:      true,
+			oldClaim:          newClaim("10G", api.ClaimPending),
+			newClaim:          newClaim("20G", api.ClaimPending),
+		},
+	}
+
+	defer utilfeature.DefaultFeatureGate.Set("ExpandPersistentVolumes=false")
+	for name, scenario := range scenarios {
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("ExpandPersistentVolumes=%t", scenario.enableResize)); err != nil {
+			t.Fatalf("Failed to set feature gate: %v", err)
+		}
+		errs := ValidatePersistentVolumeClaimUpdate(scenario.newClaim, scenario.oldClaim)
+		if len(errs) == 0 && scenario.isExpectedFailure {
+			t.Errorf("Unexpected success for scenario: %s", name)
+		}
+		if len(errs) > 0 && !scenario.isExpectedFailure {
+			t.Errorf("Unexpected failure for scenario: %s - %+v", name, errs)
+		}
+	}
+}
+
+func TestValidatePersistentVolumeClaimDataSource(t *testing.T) {
+	storageGroup := "storage.k8s.io"
+	otherGroup := "apps"
+	newClaim := func(dataSource *api.TypedLocalObjectReference) *api.PersistentVolumeClaim {
+		return testVolumeClaim("foo", "ns", api.PersistentVolumeClaimSpec{
+			AccessModes: []api.PersistentVolumeAccessMode{
+				api.ReadWriteOnce,
+			},
+			Resources: api.ResourceRequirements{
+				Requests: api.ResourceList{
+					api.ResourceName(api.ResourceStorage): resource.MustParse("10G"),
+				},
+			},
+			DataSource: dataSource,
+		})
+	}
+
+	scenarios := map[string]struct {
+		isExpectedFailure bool
+		enableSnapshot    bool
+		claim             *api.PersistentVolumeClaim
+	}{
+		"valid-snapshot-data-source": {
+			isExpectedFailure: false,
+			enableSnapshot:    true,
+			claim:             newClaim(&api.TypedLocalObjectReference{APIGroup: &storageGroup, Kind: "VolumeSnapshot", Name: "snapshot"}),
+		},
+		"valid-no-data-source-feature-disabled": {
+			isExpectedFailure: false,
+			enableSnapshot:    false,
+			claim:             newClaim(nil),
+		},
+		"invalid-data-source-feature-disabled": {
+			isExpectedFailure: true,
+			enableSnapshot:    false,
+			claim:             newClaim(&api.TypedLocalObjectReference{APIGroup: &storageGroup, Kind: "VolumeSnapshot", Name: "snapshot"}),
+		},
+		"invalid-missing-name": {
+			isExpectedFailure: true,
+			enableSnapshot:    true,
+			claim:             newClaim(&api.TypedLocalObjectReference{APIGroup: &storageGroup, Kind: "VolumeSnapshot"}),
+		},
+		"invalid-missing-api-group": {
+			isExpectedFailure: true,
+			enableSnapshot:    true,
+			claim:             newClaim(&api.TypedLocalObjectReference{Kind: "VolumeSnapshot", Name: "snapshot"}),
+		},
+		"invalid-api-group": {
+			isExpectedFailure: true,
+			enableSnapshot:    true,
+			claim:             newClaim(&api.TypedLocalObjectReference{APIGroup: &otherGroup, Kind: "VolumeSnapshot", Name: "snapshot"}),
+		},
+		"invalid-kind": {
+			isExpectedFailure: true,
+			enableSnapshot:    true,
+			claim:             newClaim(&api.TypedLocalObjectReference{APIGroup: &storageGroup, Kind: "PersistentVolumeClaim", Name: "snapshot"}),
+		},
+	}
+
+	defer utilfeature.DefaultFeatureGate.Set("VolumeSnapshotDataSource=false")
+	for name, scenario := range scenarios {
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("VolumeSnapshotDataSource=%t", scenario.enableSnapshot)); err != nil {
+			t.Fatalf("Failed to set feature gate: %v", err)
+		}
+		errs := ValidatePersistentVolumeClaim(scenario.claim)
+		if len(errs) == 0 && scenario.isExpectedFailure {
+			t.Errorf("Unexpected success for scenario: %s", name)
+		}
+		if len(errs) > 0 && !scenario.isExpectedFailure {
+			t.Errorf("Unexpected failure for scenario: %s - %+v", name, errs)
+		}
+	}
+}
+
+func TestValidatePersistentVolumeClaimVolumeMode(t *testing.T) {
+	block := api.PersistentVolumeBlock
+	filesystem := api.PersistentVolumeFilesystem
+	invalidMode := api.PersistentVolumeMode("fakeVolumeMode")
+	newClaim := func(volumeMode *api.PersistentVolumeMode) *api.PersistentVolumeClaim {
+		return testVolumeClaim("foo", "ns", api.PersistentVolumeClaimSpec{
+			AccessModes: []api.PersistentVolumeAccessMode{
+				api.ReadWriteOnce,
+			},
+			Resources: api.ResourceRequirements{
+				Requests: api.ResourceList{
+					api.ResourceName(api.ResourceStorage): resource.MustParse("10G"),
+				},
+			},
+			VolumeMode: volumeMode,
+		})
+	}
+
+	scenarios := map[string]struct {
+		isExpectedFailure bool
+		enableBlock       bool
+		claim             *api.PersistentVolumeClaim
+	}{
+		"valid-block-mode": {
+			isExpectedFailure: false,
+			enableBlock:       true,
+			claim:             newClaim(&block),
+		},
+		"valid-filesystem-mode": {
+			isExpectedFailure: false,
+			enableBlock:       true,
+			claim:             newClaim(&filesystem),
+		},
+		"valid-no-mode-feature-disabled": {
+			isExpectedFailure: false,
+			enableBlock:       false,
+			claim:             newClaim(nil),
+		},
+		"invalid-block-mode-feature-disabled": {
+			isExpectedFailure: true,
+			enableBlock:       false,
+			claim:             newClaim(&block),
+		},
+		"invalid-mode": {
+			isExpectedFailure: true,
+			enableBlock:       true,
+			claim:             newClaim(&invalidMode),
+		},
+	}
+
+	defer utilfeature.DefaultFeatureGate.Set("BlockVolume=false")
+	for name, scenario := range scenarios {
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("BlockVolume=%t", scenario.enableBlock)); err != nil {
+			t.Fatalf("Failed to set feature gate: %v", err)
+		}
+		errs := ValidatePersistentVolumeClaim(scenario.claim)
+		if len(errs) == 0 && scenario.isExpectedFailure {
+			t.Errorf("Unexpected success for scenario: %s", name)
+		}
+		if len(errs) > 0 && !scenario.isExpectedFailure {
+			t.Errorf("Unexpected failure for scenario: %s - %+v", name, errs)
+		}
+	}
+}
+
+func TestValidatePersistentVolumeVolumeMode(t *testing.T) {
+	block := api.PersistentVolumeBlock
+	filesystem := api.PersistentVolumeFilesystem
+	invalidMode := api.PersistentVolumeMode("fakeVolumeMode")
+	newVolume := func(volumeMode *api.PersistentVolumeMode) *api.PersistentVolume {
+		return testVolume("foo", "", api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceName(api.ResourceStorage): resource.MustParse("10G"),
+			},
+			AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/foo"},
+			},
+			VolumeMode: volumeMode,
+		})
+	}
+
+	defer utilfeature.DefaultFeatureGate.Set("BlockVolume=false")
+	utilfeature.DefaultFeatureGate.Set("BlockVolume=false")
+	if errs := ValidatePersistentVolume(newVolume(&block)); len(errs) == 0 {
+		t.Errorf("expected failure for block volume mode with feature disabled")
+	}
+
+	utilfeature.DefaultFeatureGate.Set("BlockVolume=true")
+	for _, mode := range []*api.PersistentVolumeMode{nil, &block, &filesystem} {
+		if errs := ValidatePersistentVolume(newVolume(mode)); len(errs) != 0 {
+			t.Errorf("expected success for volume mode %v: %v", mode, errs)
+		}
+	}
+	if errs := ValidatePersistentVolume(newVolume(&invalidMode)); len(errs) == 0 {
+		t.Errorf("expected failure for invalid volume mode")
+	}
+
+	oldVolume := newVolume(&filesystem)
+	oldVolume.ResourceVersion = "1"
+	updatedVolume := newVolume(&block)
+	updatedVolume.ResourceVersion = "1"
+	if errs := ValidatePersistentVolumeUpdate(updatedVolume, oldVolume); len(errs) == 0 {
+		t.Errorf("expected failure when changing the volume mode of a volume")
+	}
+}
+
+func TestValidatePersistentVolumeClaimStatusUpdateConditions(t *testing.T) {
+	newClaim := func(conditionType api.PersistentVolumeClaimConditionType) *api.PersistentVolumeClaim {
+		claim := testVolumeClaim("foo", "ns", api.PersistentVolumeClaimSpec{
+			AccessModes: []api.PersistentVolumeAccessMode{
+				api.ReadWriteOnce,
+			},
+			Resources: api.ResourceRequirements{
+				Requests: api.ResourceList{
+					api.ResourceName(api.ResourceStorage): resource.MustParse("10G"),
+				},
+			},
+			VolumeName: "volume",
+		})
+		claim.ResourceVersion = "1"
+		claim.Status.Phase = api.ClaimBound
+		if conditionType != "" {
+			claim.Status.Conditions = []api.PersistentVolumeClaimCondition{
+				{Type: conditionType, Status: api.ConditionTrue},
+			}
+		}
+		return claim
+	}
+
+	scenarios := map[string]struct {
+		isExpectedFailure bool
+		enableResize      bool
+		newClaim          *api.PersistentVolumeClaim
+	}{
+		"valid-resizing-condition": {
+			isExpectedFailure: false,
+			enableResize:      true,
+			newClaim:          newClaim(api.PersistentVolumeClaimResizing),
+		},
+		"valid-no-conditions-feature-disabled": {
+			isExpectedFailure: false,
+			enableResize:      false,
+			newClaim:          newClaim(""),
+		},
+		"invalid-condition-feature-disabled": {
+			isExpectedFailure: true,
+			enableResize:      false,
+			newClaim:          newClaim(api.PersistentVolumeClaimFileSystemResizePending),
+		},
+		"invalid-unknown-condition": {
+			isExpectedFailure: true,
+			enableResize:      true,
+			newClaim:          newClaim("Unknown"),
+		},
+	}
+
+	defer utilfeature.DefaultFeatureGate.Set("ExpandPersistentVolumes=false")
+	for name, scenario := range scenarios {
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("ExpandPersistentVolumes=%t", scenario.enableResize)); err != nil {
+			t.Fatalf("Failed to set feature gate: %v", err)
+		}
+		errs := ValidatePersistentVolumeClaimStatusUpdate(scenario.newClaim, newClaim(""))
+		if len(errs) == 0 && scenario.isExpectedFailure {
+			t.Errorf("Unexpected success for scenario: %s", name)
+		}
+		if len(errs) > 0 && !scenario.isExpectedFailure {
+			t.Errorf("Unexpected failure for scenario: %s - %+v", name, errs)
+		}
+	}
+}
+
 func TestValidateKeyToPath(t *testing.T) {
 	testCases := []struct {
 		kp      api.KeyToPath
@@ -2479,7 +2970,7 @@ func TestValidateVolumeMounts(t *testing.T) {
 		{Name: "abc-123", MountPath: "/bad", SubPath: "..baz"},
 		{Name: "abc", MountPath: "c:/foo/bar"},
 	}
-	if errs := ValidateVolumeMounts(successCase, volumes, field.NewPath("field")); len(errs) != 0 {
+	if errs := ValidateVolumeMounts(successCase, volumes, &api.Container{}, field.NewPath("field")); len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
 	}
 
@@ -2494,7 +2985,166 @@ func TestValidateVolumeMounts(t *testing.T) {
 		"subpath ends in ..":  {{Name: "abc", MountPath: "/bar", SubPath: "./.."}},
 	}
 	for k, v := range errorCases {
-		if errs := ValidateVolumeMounts(v, volumes, field.NewPath("field")); len(errs) == 0 {
+		if errs := ValidateVolumeMounts(v, volumes, &api.Container{}, field.NewPath("field")); len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+		}
+	}
+}
+
+func TestValidateMountPropagation(t *testing.T) {
+	bTrue := true
+	bFalse := false
+	privilegedContainer := &api.Container{
+		SecurityContext: &api.SecurityContext{
+			Privileged: &bTrue,
+		},
+	}
+	nonPrivilegedContainer := &api.Container{
+		SecurityContext: &api.SecurityContext{
+			Privileged: &bFalse,
+		},
+	}
+	defaultContainer := &api.Container{}
+
+	propagationBidirectional := api.MountPropagationBidirectional
+	propagationHostToContainer := api.MountPropagationHostToContainer
+	propagationNone := api.MountPropagationNone
+	propagationInvalid := api.MountPropagationMode("invalid")
+
+	tests := []struct {
+		mount       api.VolumeMount
+		container   *api.Container
+		expectError bool
+	}{
+		{
+			// implicitly non-privileged container + no propagation
+			api.VolumeMount{Name: "foo", MountPath: "/foo"},
+			defaultContainer,
+			false,
+		},
+		{
+			// implicitly non-privileged container + HostToContainer
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationHostToContainer},
+			defaultContainer,
+			false,
+		},
+		{
+			// non-privileged container + None
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationNone},
+			nonPrivilegedContainer,
+			false,
+		},
+		{
+			// error: implicitly non-privileged container + Bidirectional
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationBidirectional},
+			defaultContainer,
+			true,
+		},
+		{
+			// explicitly non-privileged container + no propagation
+			api.VolumeMount{Name: "foo", MountPath: "/foo"},
+			nonPrivilegedContainer,
+			false,
+		},
+		{
+			// explicitly non-privileged container + HostToContainer
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationHostToContainer},
+			nonPrivilegedContainer,
+			false,
+		},
+		{
+			// error: explicitly non-privileged container + Bidirectional
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationBidirectional},
+			nonPrivilegedContainer,
+			true,
+		},
+		{
+			// privileged container + no propagation
+			api.VolumeMount{Name: "foo", MountPath: "/foo"},
+			privilegedContainer,
+			false,
+		},
+		{
+			// privileged container + HostToContainer
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationHostToContainer},
+			privilegedContainer,
+			false,
+		},
+		{
+			// privileged container + Bidirectional
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationBidirectional},
+			privilegedContainer,
+			false,
+		},
+		{
+			// error: privileged container + invalid mount propagation
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationInvalid},
+			privilegedContainer,
+			true,
+		},
+		{
+			// no container + Bidirectional
+			api.VolumeMount{Name: "foo", MountPath: "/foo", MountPropagation: &propagationBidirectional},
+			nil,
+			false,
+		},
+	}
+
+	volumes := sets.NewString("foo")
+
+	defer utilfeature.DefaultFeatureGate.Set("MountPropagation=false")
+	utilfeature.DefaultFeatureGate.Set("MountPropagation=false")
+	mounts := []api.VolumeMount{{Name: "foo", MountPath: "/foo", MountPropagation: &propagationHostToContainer}}
+	if errs := ValidateVolumeMounts(mounts, volumes, privilegedContainer, field.NewPath("field")); len(errs) == 0 {
+		t.Errorf("expected failure with feature disabled")
+	}
+
+	utilfeature.DefaultFeatureGate.Set("MountPropagation=true")
+	for i, test := range tests {
+		errs := ValidateVolumeMounts([]api.VolumeMount{test.mount}, volumes, test.container, field.NewPath("field"))
+		if test.expectError && len(errs) == 0 {
+			t.Errorf("test %d expected error, got none", i)
+		}
+		if !test.expectError && len(errs) != 0 {
+			t.Errorf("test %d expected success, got error: %v", i, errs)
+		}
+	}
+}
+
+func TestValidateVolumeDevices(t *testing.T) {
+	claimVolumes := sets.NewString("abc", "123", "abc-123")
+
+	successCase := []api.VolumeDevice{
+		{Name: "abc", DevicePath: "/foo"},
+		{Name: "abc-123", DevicePath: "/usr/share/test"},
+	}
+	mounts := []api.VolumeMount{
+		{Name: "123", MountPath: "/bar"},
+	}
+
+	defer utilfeature.DefaultFeatureGate.Set("BlockVolume=false")
+	utilfeature.DefaultFeatureGate.Set("BlockVolume=false")
+	if errs := validateVolumeDevices(successCase, mounts, claimVolumes, field.NewPath("field")); len(errs) == 0 {
+		t.Errorf("expected failure with feature disabled")
+	}
+
+	utilfeature.DefaultFeatureGate.Set("BlockVolume=true")
+	if errs := validateVolumeDevices(successCase, mounts, claimVolumes, field.NewPath("field")); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string][]api.VolumeDevice{
+		"empty name":               {{Name: "", DevicePath: "/foo"}},
+		"name not a claim":         {{Name: "other", DevicePath: "/foo"}},
+		"name used as mount":       {{Name: "123", DevicePath: "/foo"}},
+		"duplicate name":           {{Name: "abc", DevicePath: "/foo"}, {Name: "abc", DevicePath: "/baz"}},
+		"empty devicepath":         {{Name: "abc", DevicePath: ""}},
+		"relative devicepath":      {{Name: "abc", DevicePath: "foo"}},
+		"devicepath collision":     {{Name: "abc", DevicePath: "/foo"}, {Name: "abc-123", DevicePath: "/foo"}},
+		"devicepath used as mount": {{Name: "abc", DevicePath: "/bar"}},
+	}
+	for k, v := range errorCases {
+		if errs := validateVolumeDevices(v, mounts, claimVolumes, field.NewPath("field")); len(errs) == 0 {
 			t.Errorf("expected failure for %s", k)
 		}
 	}
@@ -2538,6 +3188,8 @@ func TestValidateHandler(t *testing.T) {
 		{HTTPGet: &api.HTTPGetAction{Path: "/", Port: intstr.FromString("port"), Host: "", Scheme: "HTTP"}},
 		{HTTPGet: &api.HTTPGetAction{Path: "/", Port: intstr.FromString("port"), Host: "", Scheme: "HTTP", HTTPHeaders: []api.HTTPHeader{{Name: "Host", Value: "foo.example.com"}}}},
 		{HTTPGet: &api.HTTPGetAction{Path: "/", Port: intstr.FromString("port"), Host: "", Scheme: "HTTP", HTTPHeaders: []api.HTTPHeader{{Name: "X-Forwarded-For", Value: "1.2.3.4"}, {Name: "X-Forwarded-For", Value: "5.6.7.8"}}}},
+		{GRPC: &api.GRPCAction{Port: 1}},
+		{GRPC: &api.GRPCAction{Port: 65535, Service: &[]string{"health"}[0]}},
 	}
 	for _, h := range successCases {
 		if errs := validateHandler(&h, field.NewPath("field")); len(errs) != 0 {
@@ -2553,6 +3205,9 @@ func TestValidateHandler(t *testing.T) {
 		{HTTPGet: &api.HTTPGetAction{Path: "", Port: intstr.FromString(""), Host: ""}},
 		{HTTPGet: &api.HTTPGetAction{Path: "/", Port: intstr.FromString("port"), Host: "", Scheme: "HTTP", HTTPHeaders: []api.HTTPHeader{{Name: "Host:", Value: "foo.example.com"}}}},
 		{HTTPGet: &api.HTTPGetAction{Path: "/", Port: intstr.FromString("port"), Host: "", Scheme: "HTTP", HTTPHeaders: []api.HTTPHeader{{Name: "X_Forwarded_For", Value: "foo.example.com"}}}},
+		{GRPC: &api.GRPCAction{}},
+		{GRPC: &api.GRPCAction{Port: 65536}},
+		{Exec: &api.ExecAction{Command: []string{"echo"}}, GRPC: &api.GRPCAction{Port: 1}},
 	}
 	for _, h := range errorCases {
 		if errs := validateHandler(&h, field.NewPath("field")); len(errs) == 0 {
@@ -2604,6 +3259,74 @@ func TestValidatePullPolicy(t *testing.T) {
 	}
 }
 
+func TestValidateResourceRequirementsHugePages(t *testing.T) {
+	successCases := map[string]api.ResourceRequirements{
+		"limit only": {
+			Limits: api.ResourceList{
+				api.ResourceName(api.ResourceMemory): resource.MustParse("10G"),
+				api.ResourceName("hugepages-2Mi"):    resource.MustParse("4Mi"),
+			},
+		},
+		"request equals limit": {
+			Requests: api.ResourceList{
+				api.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi"),
+			},
+			Limits: api.ResourceList{
+				api.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi"),
+			},
+		},
+	}
+	errorCases := map[string]api.ResourceRequirements{
+		"request without limit": {
+			Requests: api.ResourceList{
+				api.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi"),
+			},
+		},
+		"request less than limit": {
+			Requests: api.ResourceList{
+				api.ResourceName("hugepages-2Mi"): resource.MustParse("2Mi"),
+			},
+			Limits: api.ResourceList{
+				api.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi"),
+			},
+		},
+		"not a multiple of the page size": {
+			Limits: api.ResourceList{
+				api.ResourceName("hugepages-2Mi"): resource.MustParse("3Mi"),
+			},
+		},
+		"invalid page size": {
+			Limits: api.ResourceList{
+				api.ResourceName("hugepages-foo"): resource.MustParse("4Mi"),
+			},
+		},
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("HugePages=true"); err != nil {
+		t.Fatalf("failed to enable feature gate for HugePages: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set("HugePages=false")
+	for k, v := range successCases {
+		if errs := ValidateResourceRequirements(&v, field.NewPath("resources")); len(errs) != 0 {
+			t.Errorf("case[%s] expected success, got %v", k, errs)
+		}
+	}
+	for k, v := range errorCases {
+		if errs := ValidateResourceRequirements(&v, field.NewPath("resources")); len(errs) == 0 {
+			t.Errorf("case[%s] expected failure", k)
+		}
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("HugePages=false"); err != nil {
+		t.Fatalf("failed to disable feature gate for HugePages: %v", err)
+	}
+	for k, v := range successCases {
+		if errs := ValidateResourceRequirements(&v, field.NewPath("resources")); len(errs) == 0 {
+			t.Errorf("case[%s] expected failure with the feature gate disabled", k)
+		}
+	}
+}
+
 func getResourceLimits(cpu, memory string) api.ResourceList {
 	res := api.ResourceList{}
 	res[api.ResourceCPU] = resource.MustParse(cpu)
@@ -2763,6 +3486,18 @@ func TestValidateContainers(t *testing.T) {
 			TerminationMessagePolicy: "File",
 		},
 		{Name: "abc-1234", Image: "image", ImagePullPolicy: "IfNotPresent", TerminationMessagePolicy: "File", SecurityContext: fakeValidSecurityContext(true)},
+		{
+			Name:  "startup-probe",
+			Image: "image",
+			StartupProbe: &api.Probe{
+				Handler: api.Handler{
+					GRPC: &api.GRPCAction{Port: 8080},
+				},
+				SuccessThreshold: 1,
+			},
+			ImagePullPolicy:          "IfNotPresent",
+			TerminationMessagePolicy: "File",
+		},
 	}
 	if errs := validateContainers(successCase, volumes, field.NewPath("field")); len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
@@ -2847,6 +3582,19 @@ func TestValidateContainers(t *testing.T) {
 				TerminationMessagePolicy: "File",
 			},
 		},
+		"invalid lifecycle, grpc handler.": {
+			{
+				Name:  "life-123",
+				Image: "image",
+				Lifecycle: &api.Lifecycle{
+					PostStart: &api.Handler{
+						GRPC: &api.GRPCAction{Port: 8080},
+					},
+				},
+				ImagePullPolicy:          "IfNotPresent",
+				TerminationMessagePolicy: "File",
+			},
+		},
 		"invalid lifecycle, no action.": {
 			{
 				Name:  "life-123",
@@ -2882,6 +3630,32 @@ func TestValidateContainers(t *testing.T) {
 				TerminationMessagePolicy: "File",
 			},
 		},
+		"invalid startup probe, success threshold is not 1.": {
+			{
+				Name:  "life-123",
+				Image: "image",
+				StartupProbe: &api.Probe{
+					Handler: api.Handler{
+						TCPSocket: &api.TCPSocketAction{Port: intstr.FromInt(80)},
+					},
+					SuccessThreshold: 2,
+				},
+				ImagePullPolicy:          "IfNotPresent",
+				TerminationMessagePolicy: "File",
+			},
+		},
+		"invalid startup probe, no action.": {
+			{
+				Name:  "life-123",
+				Image: "image",
+				StartupProbe: &api.Probe{
+					Handler:          api.Handler{},
+					SuccessThreshold: 1,
+				},
+				ImagePullPolicy:          "IfNotPresent",
+				TerminationMessagePolicy: "File",
+			},
+		},
 		"invalid message termination policy": {
 			{
 				Name:                     "life-123",
@@ -6707,6 +7481,81 @@ func TestValidateNodeUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateNodeConfigSource(t *testing.T) {
+	newNode := func(source *api.NodeConfigSource) api.Node {
+		return api.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", ResourceVersion: "1"},
+			Spec: api.NodeSpec{
+				ExternalID:   "external",
+				ConfigSource: source,
+			},
+		}
+	}
+	validRef := func() *api.ObjectReference {
+		return &api.ObjectReference{Kind: "ConfigMap", Namespace: "kube-system", Name: "kubelet-config", UID: "uid"}
+	}
+	successCases := map[string]*api.NodeConfigSource{
+		"no config source": nil,
+		"config map":       {ConfigMapRef: validRef()},
+	}
+	errorCases := map[string]*api.NodeConfigSource{
+		"empty config source": {},
+		"wrong kind": {ConfigMapRef: func() *api.ObjectReference {
+			ref := validRef()
+			ref.Kind = "Secret"
+			return ref
+		}()},
+		"missing namespace": {ConfigMapRef: func() *api.ObjectReference {
+			ref := validRef()
+			ref.Namespace = ""
+			return ref
+		}()},
+		"invalid name": {ConfigMapRef: func() *api.ObjectReference {
+			ref := validRef()
+			ref.Name = "Invalid_Name"
+			return ref
+		}()},
+		"missing uid": {ConfigMapRef: func() *api.ObjectReference {
+			ref := validRef()
+			ref.UID = ""
+			return ref
+		}()},
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("DynamicKubeletConfig=true"); err != nil {
+		t.Fatalf("failed to enable feature gate for DynamicKubeletConfig: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set("DynamicKubeletConfig=false")
+	for k, v := range successCases {
+		node := newNode(v)
+		if errs := ValidateNode(&node); len(errs) != 0 {
+			t.Errorf("case[%s] expected success, got %v", k, errs)
+		}
+		oldNode := newNode(nil)
+		if errs := ValidateNodeUpdate(&node, &oldNode); len(errs) != 0 {
+			t.Errorf("case[%s] expected update to succeed, got %v", k, errs)
+		}
+	}
+	for k, v := range errorCases {
+		node := newNode(v)
+		if errs := ValidateNode(&node); len(errs) == 0 {
+			t.Errorf("case[%s] expected failure", k)
+		}
+		oldNode := newNode(nil)
+		if errs := ValidateNodeUpdate(&node, &oldNode); len(errs) == 0 {
+			t.Errorf("case[%s] expected update to fail", k)
+		}
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("DynamicKubeletConfig=false"); err != nil {
+		t.Fatalf("failed to disable feature gate for DynamicKubeletConfig: %v", err)
+	}
+	node := newNode(&api.NodeConfigSource{ConfigMapRef: validRef()})
+	if errs := ValidateNode(&node); len(errs) == 0 {
+		t.Errorf("expected failure with the feature gate disabled")
+	}
+}
+
 func TestValidateServiceUpdate(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -53,6 +53,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&extensions.Scale{},
 		&StatefulSet{},
 		&StatefulSetList{},
+		&ControllerRevision{},
+		&ControllerRevisionList{},
 	)
 	return nil
 }
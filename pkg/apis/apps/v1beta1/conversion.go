@@ -78,6 +78,18 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 	if err != nil {
 		return err
 	}
+	err = scheme.AddFieldLabelConversionFunc("apps/v1beta1", "ControllerRevision",
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "metadata.name", "metadata.namespace":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label %q not supported for ControllerRevision", label)
+			}
+		})
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -110,6 +122,16 @@ func Convert_v1beta1_StatefulSetSpec_To_apps_StatefulSetSpec(in *StatefulSetSpec
 		out.VolumeClaimTemplates = nil
 	}
 	out.ServiceName = in.ServiceName
+	out.PodManagementPolicy = apps.PodManagementPolicyType(in.PodManagementPolicy)
+	if err := s.Convert(&in.UpdateStrategy, &out.UpdateStrategy, 0); err != nil {
+		return err
+	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int32)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	return nil
 }
 
@@ -140,6 +162,16 @@ func Convert_apps_StatefulSetSpec_To_v1beta1_StatefulSetSpec(in *apps.StatefulSe
 		out.VolumeClaimTemplates = nil
 	}
 	out.ServiceName = in.ServiceName
+	out.PodManagementPolicy = PodManagementPolicyType(in.PodManagementPolicy)
+	if err := s.Convert(&in.UpdateStrategy, &out.UpdateStrategy, 0); err != nil {
+		return err
+	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int32)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	return nil
 }
 
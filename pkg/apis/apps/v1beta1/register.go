@@ -47,6 +47,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Scale{},
 		&StatefulSet{},
 		&StatefulSetList{},
+		&ControllerRevision{},
+		&ControllerRevisionList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
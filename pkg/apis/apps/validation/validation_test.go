@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/apis/apps"
 )
@@ -55,15 +56,51 @@ func TestValidateStatefulSet(t *testing.T) {
 		{
 			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
-				Template: validPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
 			},
 		},
 		{
 			ObjectMeta: metav1.ObjectMeta{Name: "abc-123", Namespace: metav1.NamespaceDefault},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
-				Template: validPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "abc-123", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				Replicas:            3,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy: apps.StatefulSetUpdateStrategy{
+					Type:          apps.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &apps.RollingUpdateStatefulSetStrategy{Partition: 2},
+				},
+				RevisionHistoryLimit: newInt32(5),
+				Selector:             &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:             validPodTemplate.Template,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "abc-123", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "abc-123", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				PodManagementPolicy: apps.ParallelPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
 			},
 		},
 	}
@@ -77,41 +114,53 @@ func TestValidateStatefulSet(t *testing.T) {
 		"zero-length ID": {
 			ObjectMeta: metav1.ObjectMeta{Name: "", Namespace: metav1.NamespaceDefault},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
-				Template: validPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
 			},
 		},
 		"missing-namespace": {
 			ObjectMeta: metav1.ObjectMeta{Name: "abc-123"},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
-				Template: validPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
 			},
 		},
 		"empty selector": {
 			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
 			Spec: apps.StatefulSetSpec{
-				Template: validPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Template:            validPodTemplate.Template,
 			},
 		},
 		"selector_doesnt_match": {
 			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
-				Template: validPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				Template:            validPodTemplate.Template,
 			},
 		},
 		"invalid manifest": {
 			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
 			},
 		},
 		"negative_replicas": {
 			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
 			Spec: apps.StatefulSetSpec{
-				Replicas: -1,
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Replicas:            -1,
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
 			},
 		},
 		"invalid_label": {
@@ -123,8 +172,10 @@ func TestValidateStatefulSet(t *testing.T) {
 				},
 			},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
-				Template: validPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
 			},
 		},
 		"invalid_label 2": {
@@ -136,7 +187,9 @@ func TestValidateStatefulSet(t *testing.T) {
 				},
 			},
 			Spec: apps.StatefulSetSpec{
-				Template: invalidPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Template:            invalidPodTemplate.Template,
 			},
 		},
 		"invalid_annotation": {
@@ -148,8 +201,10 @@ func TestValidateStatefulSet(t *testing.T) {
 				},
 			},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
-				Template: validPodTemplate.Template,
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
 			},
 		},
 		"invalid restart policy 1": {
@@ -158,7 +213,9 @@ func TestValidateStatefulSet(t *testing.T) {
 				Namespace: metav1.NamespaceDefault,
 			},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
 				Template: api.PodTemplateSpec{
 					Spec: api.PodSpec{
 						RestartPolicy: api.RestartPolicyOnFailure,
@@ -177,7 +234,9 @@ func TestValidateStatefulSet(t *testing.T) {
 				Namespace: metav1.NamespaceDefault,
 			},
 			Spec: apps.StatefulSetSpec{
-				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
 				Template: api.PodTemplateSpec{
 					Spec: api.PodSpec{
 						RestartPolicy: api.RestartPolicyNever,
@@ -190,6 +249,73 @@ func TestValidateStatefulSet(t *testing.T) {
 				},
 			},
 		},
+		"missing update strategy": {
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
+				Template: validPodTemplate.Template,
+			},
+		},
+		"invalid update strategy": {
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: "foo"},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
+			},
+		},
+		"negative partition": {
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy: apps.StatefulSetUpdateStrategy{
+					Type:          apps.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &apps.RollingUpdateStatefulSetStrategy{Partition: -1},
+				},
+				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
+				Template: validPodTemplate.Template,
+			},
+		},
+		"rolling update with OnDelete": {
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy: apps.StatefulSetUpdateStrategy{
+					Type:          apps.OnDeleteStatefulSetStrategyType,
+					RollingUpdate: &apps.RollingUpdateStatefulSetStrategy{Partition: 1},
+				},
+				Selector: &metav1.LabelSelector{MatchLabels: validLabels},
+				Template: validPodTemplate.Template,
+			},
+		},
+		"missing pod management policy": {
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				UpdateStrategy: apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:       &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:       validPodTemplate.Template,
+			},
+		},
+		"invalid pod management policy": {
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				PodManagementPolicy: "Burst",
+				UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:            validPodTemplate.Template,
+			},
+		},
+		"negative revision history limit": {
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+			Spec: apps.StatefulSetSpec{
+				PodManagementPolicy:  apps.OrderedReadyPodManagement,
+				UpdateStrategy:       apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+				RevisionHistoryLimit: newInt32(-1),
+				Selector:             &metav1.LabelSelector{MatchLabels: validLabels},
+				Template:             validPodTemplate.Template,
+			},
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateStatefulSet(&v)
@@ -208,7 +334,12 @@ func TestValidateStatefulSet(t *testing.T) {
 				field != "spec.template.labels" &&
 				field != "metadata.annotations" &&
 				field != "metadata.labels" &&
-				field != "status.replicas" {
+				field != "status.replicas" &&
+				field != "spec.podManagementPolicy" &&
+				field != "spec.updateStrategy.type" &&
+				field != "spec.updateStrategy.rollingUpdate" &&
+				field != "spec.updateStrategy.rollingUpdate.partition" &&
+				field != "spec.revisionHistoryLimit" {
 				t.Errorf("%s: missing prefix for: %v", k, errs[i])
 			}
 		}
@@ -276,6 +407,30 @@ func TestValidateStatefulSetUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			old: apps.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+				Spec: apps.StatefulSetSpec{
+					PodManagementPolicy: apps.OrderedReadyPodManagement,
+					UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType},
+					Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+					Template:            validPodTemplate.Template,
+				},
+			},
+			update: apps.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+				Spec: apps.StatefulSetSpec{
+					PodManagementPolicy: apps.OrderedReadyPodManagement,
+					UpdateStrategy: apps.StatefulSetUpdateStrategy{
+						Type:          apps.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &apps.RollingUpdateStatefulSetStrategy{Partition: 1},
+					},
+					RevisionHistoryLimit: newInt32(2),
+					Selector:             &metav1.LabelSelector{MatchLabels: validLabels},
+					Template:             readWriteVolumePodTemplate.Template,
+				},
+			},
+		},
 	}
 	for _, successCase := range successCases {
 		successCase.old.ObjectMeta.ResourceVersion = "1"
@@ -302,7 +457,7 @@ func TestValidateStatefulSetUpdate(t *testing.T) {
 				},
 			},
 		},
-		"updates to a field other than spec.Replicas": {
+		"updates to a field other than spec.Replicas, spec.Template and spec.UpdateStrategy": {
 			old: apps.StatefulSet{
 				ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
 				Spec: apps.StatefulSetSpec{
@@ -313,9 +468,30 @@ func TestValidateStatefulSetUpdate(t *testing.T) {
 			update: apps.StatefulSet{
 				ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
 				Spec: apps.StatefulSetSpec{
-					Replicas: 1,
-					Selector: &metav1.LabelSelector{MatchLabels: validLabels},
-					Template: readWriteVolumePodTemplate.Template,
+					Replicas:    1,
+					ServiceName: "foo",
+					Selector:    &metav1.LabelSelector{MatchLabels: validLabels},
+					Template:    validPodTemplate.Template,
+				},
+			},
+		},
+		"updates to spec.PodManagementPolicy": {
+			old: apps.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+				Spec: apps.StatefulSetSpec{
+					PodManagementPolicy: apps.OrderedReadyPodManagement,
+					UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType},
+					Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+					Template:            validPodTemplate.Template,
+				},
+			},
+			update: apps.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+				Spec: apps.StatefulSetSpec{
+					PodManagementPolicy: apps.ParallelPodManagement,
+					UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType},
+					Selector:            &metav1.LabelSelector{MatchLabels: validLabels},
+					Template:            validPodTemplate.Template,
 				},
 			},
 		},
@@ -377,3 +553,138 @@ func TestValidateStatefulSetUpdate(t *testing.T) {
 		}
 	}
 }
+
+func newInt32(val int32) *int32 {
+	p := new(int32)
+	*p = val
+	return p
+}
+
+func TestValidateControllerRevision(t *testing.T) {
+	newControllerRevision := func(name, namespace string, data runtime.Object, revision int64) apps.ControllerRevision {
+		return apps.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Data:     runtime.RawExtension{Object: data},
+			Revision: revision,
+		}
+	}
+
+	ss := apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+		Spec: apps.StatefulSetSpec{
+			Template: api.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a": "b"}},
+			},
+		},
+	}
+
+	var (
+		valid       = newControllerRevision("validname", "validns", &ss, 0)
+		badRevision = newControllerRevision("validname", "validns", &ss, -1)
+		emptyName   = newControllerRevision("", "validns", &ss, 0)
+		invalidName = newControllerRevision("NoUppercaseOrSpecialCharsLike=Equals", "validns", &ss, 0)
+		emptyNs     = newControllerRevision("validname", "", &ss, 100)
+		invalidNs   = newControllerRevision("validname", "NoUppercaseOrSpecialCharsLike=Equals", &ss, 100)
+		nilData     = newControllerRevision("validname", "validns", nil, 0)
+	)
+
+	tests := map[string]struct {
+		history apps.ControllerRevision
+		isValid bool
+	}{
+		"valid":             {valid, true},
+		"negative revision": {badRevision, false},
+		"empty name":        {emptyName, false},
+		"invalid name":      {invalidName, false},
+		"empty namespace":   {emptyNs, false},
+		"invalid namespace": {invalidNs, false},
+		"nil data":          {nilData, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateControllerRevision(&tc.history)
+		if tc.isValid && len(errs) > 0 {
+			t.Errorf("%v: unexpected error: %v", name, errs)
+		}
+		if !tc.isValid && len(errs) == 0 {
+			t.Errorf("%v: unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateControllerRevisionUpdate(t *testing.T) {
+	newControllerRevision := func(version, name, namespace string, data runtime.Object, revision int64) apps.ControllerRevision {
+		return apps.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				ResourceVersion: version,
+			},
+			Data:     runtime.RawExtension{Object: data},
+			Revision: revision,
+		}
+	}
+
+	ss := apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
+		Spec: apps.StatefulSetSpec{
+			Template: api.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a": "b"}},
+			},
+		},
+	}
+	modifiedss := ss
+	modifiedss.Spec.Replicas = 3
+
+	var (
+		valid           = newControllerRevision("1", "validname", "validns", &ss, 0)
+		noVersion       = newControllerRevision("", "validname", "validns", &ss, 0)
+		changedData     = newControllerRevision("1", "validname", "validns", &modifiedss, 0)
+		changedRevision = newControllerRevision("1", "validname", "validns", &ss, 1)
+	)
+
+	cases := []struct {
+		name       string
+		newHistory apps.ControllerRevision
+		oldHistory apps.ControllerRevision
+		isValid    bool
+	}{
+		{
+			name:       "valid",
+			newHistory: valid,
+			oldHistory: valid,
+			isValid:    true,
+		},
+		{
+			name:       "invalid",
+			newHistory: noVersion,
+			oldHistory: valid,
+			isValid:    false,
+		},
+		{
+			name:       "changed data",
+			newHistory: changedData,
+			oldHistory: valid,
+			isValid:    false,
+		},
+		{
+			name:       "changed revision",
+			newHistory: changedRevision,
+			oldHistory: valid,
+			isValid:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		errs := ValidateControllerRevisionUpdate(&tc.newHistory, &tc.oldHistory)
+		if tc.isValid && len(errs) > 0 {
+			t.Errorf("%v: unexpected error: %v", tc.name, errs)
+		}
+		if !tc.isValid && len(errs) == 0 {
+			t.Errorf("%v: unexpected non-error", tc.name)
+		}
+	}
+}
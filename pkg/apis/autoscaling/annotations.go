@@ -28,3 +28,11 @@ const MetricStatusesAnnotation = "autoscaling.alpha.kubernetes.io/current-metric
 // metrics are present.  This is here because it's used by both the v2alpha1 defaulting
 // logic, and the pseudo-defaulting done in v1 conversion.
 const DefaultCPUUtilization = 80
+
+// BehaviorSpecsAnnotation is the annotation which holds the HPA scaling behavior
+// when converting the `Behavior` field from autoscaling/v2alpha1
+const BehaviorSpecsAnnotation = "autoscaling.alpha.kubernetes.io/behavior"
+
+// HorizontalPodAutoscalerConditionsAnnotation is the annotation which holds the conditions
+// of an HPA when converting the `Conditions` field from autoscaling/v2alpha1
+const HorizontalPodAutoscalerConditionsAnnotation = "autoscaling.alpha.kubernetes.io/conditions"
@@ -68,9 +68,9 @@ func Convert_autoscaling_HorizontalPodAutoscaler_To_v1_HorizontalPodAutoscaler(i
 		}
 	}
 
-	if len(otherMetrics) > 0 || len(in.Status.CurrentMetrics) > 0 {
+	if len(otherMetrics) > 0 || len(in.Status.CurrentMetrics) > 0 || in.Spec.Behavior != nil || len(in.Status.Conditions) > 0 {
 		old := out.Annotations
-		out.Annotations = make(map[string]string, len(old)+2)
+		out.Annotations = make(map[string]string, len(old)+4)
 		if old != nil {
 			for k, v := range old {
 				out.Annotations[k] = v
@@ -94,6 +94,32 @@ func Convert_autoscaling_HorizontalPodAutoscaler_To_v1_HorizontalPodAutoscaler(i
 		out.Annotations[autoscaling.MetricStatusesAnnotation] = string(currentMetricsEnc)
 	}
 
+	if in.Spec.Behavior != nil {
+		behavior := HorizontalPodAutoscalerBehavior{}
+		if err := Convert_autoscaling_HorizontalPodAutoscalerBehavior_To_v1_HorizontalPodAutoscalerBehavior(in.Spec.Behavior, &behavior, s); err != nil {
+			return err
+		}
+		behaviorEnc, err := json.Marshal(behavior)
+		if err != nil {
+			return err
+		}
+		out.Annotations[autoscaling.BehaviorSpecsAnnotation] = string(behaviorEnc)
+	}
+
+	if len(in.Status.Conditions) > 0 {
+		conditions := make([]HorizontalPodAutoscalerCondition, len(in.Status.Conditions))
+		for i, condition := range in.Status.Conditions {
+			if err := Convert_autoscaling_HorizontalPodAutoscalerCondition_To_v1_HorizontalPodAutoscalerCondition(&condition, &conditions[i], s); err != nil {
+				return err
+			}
+		}
+		conditionsEnc, err := json.Marshal(conditions)
+		if err != nil {
+			return err
+		}
+		out.Annotations[autoscaling.HorizontalPodAutoscalerConditionsAnnotation] = string(conditionsEnc)
+	}
+
 	return nil
 }
 
@@ -138,6 +164,34 @@ func Convert_v1_HorizontalPodAutoscaler_To_autoscaling_HorizontalPodAutoscaler(i
 		delete(out.Annotations, autoscaling.MetricStatusesAnnotation)
 	}
 
+	if behaviorEnc, hasBehavior := out.Annotations[autoscaling.BehaviorSpecsAnnotation]; hasBehavior {
+		var behavior HorizontalPodAutoscalerBehavior
+		if err := json.Unmarshal([]byte(behaviorEnc), &behavior); err != nil {
+			return err
+		}
+
+		out.Spec.Behavior = &autoscaling.HorizontalPodAutoscalerBehavior{}
+		if err := Convert_v1_HorizontalPodAutoscalerBehavior_To_autoscaling_HorizontalPodAutoscalerBehavior(&behavior, out.Spec.Behavior, s); err != nil {
+			return err
+		}
+		delete(out.Annotations, autoscaling.BehaviorSpecsAnnotation)
+	}
+
+	if conditionsEnc, hasConditions := out.Annotations[autoscaling.HorizontalPodAutoscalerConditionsAnnotation]; hasConditions {
+		var conditions []HorizontalPodAutoscalerCondition
+		if err := json.Unmarshal([]byte(conditionsEnc), &conditions); err != nil {
+			return err
+		}
+
+		out.Status.Conditions = make([]autoscaling.HorizontalPodAutoscalerCondition, len(conditions))
+		for i, condition := range conditions {
+			if err := Convert_v1_HorizontalPodAutoscalerCondition_To_autoscaling_HorizontalPodAutoscalerCondition(&condition, &out.Status.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+		delete(out.Annotations, autoscaling.HorizontalPodAutoscalerConditionsAnnotation)
+	}
+
 	// autoscaling/v1 formerly had an implicit default applied in the controller.  In v2alpha1, we apply it explicitly.
 	// We apply it here, explicitly, since we have access to the full set of metrics from the annotation.
 	if len(out.Spec.Metrics) == 0 {
@@ -46,4 +46,91 @@ func SetDefaults_HorizontalPodAutoscaler(obj *HorizontalPodAutoscaler) {
 			},
 		}
 	}
+
+	if obj.Spec.Behavior != nil {
+		obj.Spec.Behavior.ScaleUp = GenerateHPAScaleUpRules(obj.Spec.Behavior.ScaleUp)
+		obj.Spec.Behavior.ScaleDown = GenerateHPAScaleDownRules(obj.Spec.Behavior.ScaleDown)
+	}
+}
+
+var (
+	scaleUpLimitPercent         int32 = 100
+	scaleUpLimitMinimumPods     int32 = 4
+	scaleUpPeriod               int32 = 15
+	scaleUpStabilizationSeconds int32
+	maxPolicy                   = MaxPolicySelect
+	defaultHPAScaleUpRules      = HPAScalingRules{
+		StabilizationWindowSeconds: &scaleUpStabilizationSeconds,
+		SelectPolicy:               &maxPolicy,
+		Policies: []HPAScalingPolicy{
+			{
+				Type:          PodsScalingPolicy,
+				Value:         scaleUpLimitMinimumPods,
+				PeriodSeconds: scaleUpPeriod,
+			},
+			{
+				Type:          PercentScalingPolicy,
+				Value:         scaleUpLimitPercent,
+				PeriodSeconds: scaleUpPeriod,
+			},
+		},
+	}
+
+	scaleDownLimitPercent         int32 = 100
+	scaleDownPeriod               int32 = 15
+	scaleDownStabilizationSeconds int32 = 300
+	defaultHPAScaleDownRules            = HPAScalingRules{
+		StabilizationWindowSeconds: &scaleDownStabilizationSeconds,
+		SelectPolicy:               &maxPolicy,
+		Policies: []HPAScalingPolicy{
+			{
+				Type:          PercentScalingPolicy,
+				Value:         scaleDownLimitPercent,
+				PeriodSeconds: scaleDownPeriod,
+			},
+		},
+	}
+)
+
+// GenerateHPAScaleUpRules returns a fully-initialized HPAScalingRules value
+// for scaling up, filling the fields missing from scalingRules with defaults.
+// It never modifies scalingRules.
+func GenerateHPAScaleUpRules(scalingRules *HPAScalingRules) *HPAScalingRules {
+	return copyHPAScalingRules(scalingRules, &defaultHPAScaleUpRules)
+}
+
+// GenerateHPAScaleDownRules returns a fully-initialized HPAScalingRules value
+// for scaling down, filling the fields missing from scalingRules with defaults.
+// It never modifies scalingRules.
+func GenerateHPAScaleDownRules(scalingRules *HPAScalingRules) *HPAScalingRules {
+	return copyHPAScalingRules(scalingRules, &defaultHPAScaleDownRules)
+}
+
+// copyHPAScalingRules copies the fields set in from into a new value,
+// taking every unset field from defaults.
+func copyHPAScalingRules(from, defaults *HPAScalingRules) *HPAScalingRules {
+	if from == nil {
+		from = &HPAScalingRules{}
+	}
+	to := HPAScalingRules{}
+	if from.SelectPolicy != nil {
+		selectPolicy := *from.SelectPolicy
+		to.SelectPolicy = &selectPolicy
+	} else {
+		selectPolicy := *defaults.SelectPolicy
+		to.SelectPolicy = &selectPolicy
+	}
+	if from.StabilizationWindowSeconds != nil {
+		window := *from.StabilizationWindowSeconds
+		to.StabilizationWindowSeconds = &window
+	} else {
+		window := *defaults.StabilizationWindowSeconds
+		to.StabilizationWindowSeconds = &window
+	}
+	if len(from.Policies) > 0 {
+		to.Policies = append([]HPAScalingPolicy{}, from.Policies...)
+	} else if *to.SelectPolicy != DisabledPolicySelect {
+		to.Policies = append([]HPAScalingPolicy{}, defaults.Policies...)
+	}
+	return &to
 }
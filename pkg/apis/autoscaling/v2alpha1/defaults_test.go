@@ -0,0 +1,167 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1_test
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/api"
+	_ "k8s.io/kubernetes/pkg/api/install"
+	_ "k8s.io/kubernetes/pkg/apis/autoscaling/install"
+	. "k8s.io/kubernetes/pkg/apis/autoscaling/v2alpha1"
+)
+
+func TestSetDefaultHPABehavior(t *testing.T) {
+	maxPolicy := MaxPolicySelect
+	minPolicy := MinPolicySelect
+	disabledPolicy := DisabledPolicySelect
+
+	defaultScaleUpPolicies := []HPAScalingPolicy{
+		{Type: PodsScalingPolicy, Value: 4, PeriodSeconds: 15},
+		{Type: PercentScalingPolicy, Value: 100, PeriodSeconds: 15},
+	}
+	defaultScaleDownPolicies := []HPAScalingPolicy{
+		{Type: PercentScalingPolicy, Value: 100, PeriodSeconds: 15},
+	}
+	customPolicies := []HPAScalingPolicy{
+		{Type: PodsScalingPolicy, Value: 1, PeriodSeconds: 60},
+	}
+
+	tests := []struct {
+		test              string
+		behavior          *HorizontalPodAutoscalerBehavior
+		expectedScaleUp   *HPAScalingRules
+		expectedScaleDown *HPAScalingRules
+	}{
+		{
+			test:     "unset rules use the defaults of each direction",
+			behavior: &HorizontalPodAutoscalerBehavior{},
+			expectedScaleUp: &HPAScalingRules{
+				StabilizationWindowSeconds: newInt32(0),
+				SelectPolicy:               &maxPolicy,
+				Policies:                   defaultScaleUpPolicies,
+			},
+			expectedScaleDown: &HPAScalingRules{
+				StabilizationWindowSeconds: newInt32(300),
+				SelectPolicy:               &maxPolicy,
+				Policies:                   defaultScaleDownPolicies,
+			},
+		},
+		{
+			test: "set fields are kept",
+			behavior: &HorizontalPodAutoscalerBehavior{
+				ScaleUp: &HPAScalingRules{
+					StabilizationWindowSeconds: newInt32(60),
+					SelectPolicy:               &minPolicy,
+					Policies:                   customPolicies,
+				},
+				ScaleDown: &HPAScalingRules{
+					StabilizationWindowSeconds: newInt32(600),
+				},
+			},
+			expectedScaleUp: &HPAScalingRules{
+				StabilizationWindowSeconds: newInt32(60),
+				SelectPolicy:               &minPolicy,
+				Policies:                   customPolicies,
+			},
+			expectedScaleDown: &HPAScalingRules{
+				StabilizationWindowSeconds: newInt32(600),
+				SelectPolicy:               &maxPolicy,
+				Policies:                   defaultScaleDownPolicies,
+			},
+		},
+		{
+			test: "disabled rules get no default policies",
+			behavior: &HorizontalPodAutoscalerBehavior{
+				ScaleDown: &HPAScalingRules{
+					SelectPolicy: &disabledPolicy,
+				},
+			},
+			expectedScaleUp: &HPAScalingRules{
+				StabilizationWindowSeconds: newInt32(0),
+				SelectPolicy:               &maxPolicy,
+				Policies:                   defaultScaleUpPolicies,
+			},
+			expectedScaleDown: &HPAScalingRules{
+				StabilizationWindowSeconds: newInt32(300),
+				SelectPolicy:               &disabledPolicy,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		hpa := &HorizontalPodAutoscaler{
+			Spec: HorizontalPodAutoscalerSpec{
+				Behavior: test.behavior,
+			},
+		}
+		obj2 := roundTrip(t, runtime.Object(hpa))
+		hpa2, ok := obj2.(*HorizontalPodAutoscaler)
+		if !ok {
+			t.Fatalf("%s: unexpected object: %v", test.test, obj2)
+		}
+		if hpa2.Spec.Behavior == nil {
+			t.Errorf("%s: unexpected nil Behavior", test.test)
+			continue
+		}
+		if !reflect.DeepEqual(test.expectedScaleUp, hpa2.Spec.Behavior.ScaleUp) {
+			t.Errorf("%s: expected scale up rules %#v, got %#v", test.test, test.expectedScaleUp, hpa2.Spec.Behavior.ScaleUp)
+		}
+		if !reflect.DeepEqual(test.expectedScaleDown, hpa2.Spec.Behavior.ScaleDown) {
+			t.Errorf("%s: expected scale down rules %#v, got %#v", test.test, test.expectedScaleDown, hpa2.Spec.Behavior.ScaleDown)
+		}
+	}
+}
+
+func TestSetDefaultHPANoBehavior(t *testing.T) {
+	obj2 := roundTrip(t, runtime.Object(&HorizontalPodAutoscaler{}))
+	hpa2, ok := obj2.(*HorizontalPodAutoscaler)
+	if !ok {
+		t.Fatalf("unexpected object: %v", obj2)
+	}
+	if hpa2.Spec.Behavior != nil {
+		t.Errorf("expected no Behavior to be defaulted, got %#v", hpa2.Spec.Behavior)
+	}
+}
+
+func roundTrip(t *testing.T, obj runtime.Object) runtime.Object {
+	data, err := runtime.Encode(api.Codecs.LegacyCodec(SchemeGroupVersion), obj)
+	if err != nil {
+		t.Errorf("%v\n %#v", err, obj)
+		return nil
+	}
+	obj2, err := runtime.Decode(api.Codecs.UniversalDecoder(), data)
+	if err != nil {
+		t.Errorf("%v\nData: %s\nSource: %#v", err, string(data), obj)
+		return nil
+	}
+	obj3 := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+	err = api.Scheme.Convert(obj2, obj3, nil)
+	if err != nil {
+		t.Errorf("%v\nSource: %#v", err, obj2)
+		return nil
+	}
+	return obj3
+}
+
+func newInt32(val int32) *int32 {
+	p := new(int32)
+	*p = val
+	return p
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
 	"strings"
 
 	pathvalidation "k8s.io/apimachinery/pkg/api/validation/path"
+	unversionedvalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	apivalidation "k8s.io/kubernetes/pkg/api/validation"
@@ -58,6 +60,9 @@ func validateHorizontalPodAutoscalerSpec(autoscaler autoscaling.HorizontalPodAut
 	if refErrs := validateMetrics(autoscaler.Metrics, fldPath.Child("metrics")); len(refErrs) > 0 {
 		allErrs = append(allErrs, refErrs...)
 	}
+	if refErrs := validateBehavior(autoscaler.Behavior, fldPath.Child("behavior")); len(refErrs) > 0 {
+		allErrs = append(allErrs, refErrs...)
+	}
 	return allErrs
 }
 
@@ -115,7 +120,7 @@ func validateMetrics(metrics []autoscaling.MetricSpec, fldPath *field.Path) fiel
 	return allErrs
 }
 
-var validMetricSourceTypes = sets.NewString(string(autoscaling.ObjectMetricSourceType), string(autoscaling.PodsMetricSourceType), string(autoscaling.ResourceMetricSourceType))
+var validMetricSourceTypes = sets.NewString(string(autoscaling.ObjectMetricSourceType), string(autoscaling.PodsMetricSourceType), string(autoscaling.ResourceMetricSourceType), string(autoscaling.ExternalMetricSourceType))
 var validMetricSourceTypesList = validMetricSourceTypes.List()
 
 func validateMetricSpec(spec autoscaling.MetricSpec, fldPath *field.Path) field.ErrorList {
@@ -151,6 +156,13 @@ func validateMetricSpec(spec autoscaling.MetricSpec, fldPath *field.Path) field.
 		}
 	}
 
+	if spec.External != nil {
+		typesPresent.Insert("external")
+		if typesPresent.Len() == 1 {
+			allErrs = append(allErrs, validateExternalSource(spec.External, fldPath.Child("external"))...)
+		}
+	}
+
 	expectedField := strings.ToLower(string(spec.Type))
 
 	if !typesPresent.Has(expectedField) {
@@ -197,6 +209,40 @@ func validatePodsSource(src *autoscaling.PodsMetricSource, fldPath *field.Path)
 	return allErrs
 }
 
+func validateExternalSource(src *autoscaling.ExternalMetricSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(src.MetricName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("metricName"), "must specify a metric name"))
+	} else {
+		for _, msg := range pathvalidation.IsValidPathSegmentName(src.MetricName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("metricName"), src.MetricName, msg))
+		}
+	}
+
+	if src.MetricSelector != nil {
+		allErrs = append(allErrs, unversionedvalidation.ValidateLabelSelector(src.MetricSelector, fldPath.Child("metricSelector"))...)
+	}
+
+	if src.TargetValue == nil && src.TargetAverageValue == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("targetValue"), "must set either a target value or a target average value"))
+	}
+
+	if src.TargetValue != nil && src.TargetAverageValue != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("targetAverageValue"), "may not set both a target value and a target average value"))
+	}
+
+	if src.TargetValue != nil && src.TargetValue.Sign() != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetValue"), src.TargetValue, "must be positive"))
+	}
+
+	if src.TargetAverageValue != nil && src.TargetAverageValue.Sign() != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetAverageValue"), src.TargetAverageValue, "must be positive"))
+	}
+
+	return allErrs
+}
+
 func validateResourceSource(src *autoscaling.ResourceMetricSource, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -222,3 +268,73 @@ func validateResourceSource(src *autoscaling.ResourceMetricSource, fldPath *fiel
 
 	return allErrs
 }
+
+const (
+	// MaxStabilizationWindowSeconds is the largest stabilization window accepted for scaling rules.
+	MaxStabilizationWindowSeconds int32 = 3600
+	// MaxPeriodSeconds is the largest period accepted for a scaling policy.
+	MaxPeriodSeconds int32 = 1800
+)
+
+func validateBehavior(behavior *autoscaling.HorizontalPodAutoscalerBehavior, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if behavior == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateScalingRules(behavior.ScaleUp, fldPath.Child("scaleUp"))...)
+	allErrs = append(allErrs, validateScalingRules(behavior.ScaleDown, fldPath.Child("scaleDown"))...)
+
+	return allErrs
+}
+
+var validPolicySelects = sets.NewString(string(autoscaling.MaxPolicySelect), string(autoscaling.MinPolicySelect), string(autoscaling.DisabledPolicySelect))
+var validPolicySelectsList = validPolicySelects.List()
+
+func validateScalingRules(rules *autoscaling.HPAScalingRules, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if rules == nil {
+		return allErrs
+	}
+
+	if rules.StabilizationWindowSeconds != nil {
+		window := *rules.StabilizationWindowSeconds
+		if window < 0 || window > MaxStabilizationWindowSeconds {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("stabilizationWindowSeconds"), window, fmt.Sprintf("must be between 0 and %d", MaxStabilizationWindowSeconds)))
+		}
+	}
+
+	if rules.SelectPolicy != nil && !validPolicySelects.Has(string(*rules.SelectPolicy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("selectPolicy"), *rules.SelectPolicy, validPolicySelectsList))
+	}
+
+	policiesPath := fldPath.Child("policies")
+	for i, policy := range rules.Policies {
+		allErrs = append(allErrs, validateScalingPolicy(policy, policiesPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+var validScalingPolicyTypes = sets.NewString(string(autoscaling.PodsScalingPolicy), string(autoscaling.PercentScalingPolicy))
+var validScalingPolicyTypesList = validScalingPolicyTypes.List()
+
+func validateScalingPolicy(policy autoscaling.HPAScalingPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(policy.Type) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must specify a policy type"))
+	} else if !validScalingPolicyTypes.Has(string(policy.Type)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), policy.Type, validScalingPolicyTypesList))
+	}
+
+	if policy.Value <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), policy.Value, "must be greater than 0"))
+	}
+
+	if policy.PeriodSeconds <= 0 || policy.PeriodSeconds > MaxPeriodSeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("periodSeconds"), policy.PeriodSeconds, fmt.Sprintf("must be between 1 and %d", MaxPeriodSeconds)))
+	}
+
+	return allErrs
+}
@@ -92,6 +92,39 @@ func TestValidateScale(t *testing.T) {
 
 func TestValidateHorizontalPodAutoscaler(t *testing.T) {
 	successCases := []autoscaling.HorizontalPodAutoscaler{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myautoscaler",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: autoscaling.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscaling.CrossVersionObjectReference{
+					Kind: "ReplicationController",
+					Name: "myrc",
+				},
+				MinReplicas: newInt32(1),
+				MaxReplicas: 5,
+				Metrics: []autoscaling.MetricSpec{
+					{
+						Type: autoscaling.ExternalMetricSourceType,
+						External: &autoscaling.ExternalMetricSource{
+							MetricName: "queue_length",
+							MetricSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"queue": "worker_tasks"},
+							},
+							TargetValue: resource.NewMilliQuantity(300, resource.DecimalSI),
+						},
+					},
+					{
+						Type: autoscaling.ExternalMetricSourceType,
+						External: &autoscaling.ExternalMetricSource{
+							MetricName:         "queue_length",
+							TargetAverageValue: resource.NewMilliQuantity(300, resource.DecimalSI),
+						},
+					},
+				},
+			},
+		},
 		{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "myautoscaler",
@@ -567,6 +600,108 @@ func TestValidateHorizontalPodAutoscaler(t *testing.T) {
 			},
 			msg: "must populate the given metric source only",
 		},
+		{
+			horizontalPodAutoscaler: autoscaling.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "myautoscaler", Namespace: metav1.NamespaceDefault},
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{Name: "myrc", Kind: "ReplicationController"},
+					MinReplicas:    newInt32(1),
+					MaxReplicas:    5,
+					Metrics: []autoscaling.MetricSpec{
+						{
+							Type: autoscaling.ExternalMetricSourceType,
+							External: &autoscaling.ExternalMetricSource{
+								TargetValue: resource.NewMilliQuantity(300, resource.DecimalSI),
+							},
+						},
+					},
+				},
+			},
+			msg: "external.metricName: Required",
+		},
+		{
+			horizontalPodAutoscaler: autoscaling.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "myautoscaler", Namespace: metav1.NamespaceDefault},
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{Name: "myrc", Kind: "ReplicationController"},
+					MinReplicas:    newInt32(1),
+					MaxReplicas:    5,
+					Metrics: []autoscaling.MetricSpec{
+						{
+							Type: autoscaling.ExternalMetricSourceType,
+							External: &autoscaling.ExternalMetricSource{
+								MetricName: "queue_length",
+							},
+						},
+					},
+				},
+			},
+			msg: "must set either a target value or a target average value",
+		},
+		{
+			horizontalPodAutoscaler: autoscaling.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "myautoscaler", Namespace: metav1.NamespaceDefault},
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{Name: "myrc", Kind: "ReplicationController"},
+					MinReplicas:    newInt32(1),
+					MaxReplicas:    5,
+					Metrics: []autoscaling.MetricSpec{
+						{
+							Type: autoscaling.ExternalMetricSourceType,
+							External: &autoscaling.ExternalMetricSource{
+								MetricName:         "queue_length",
+								TargetValue:        resource.NewMilliQuantity(300, resource.DecimalSI),
+								TargetAverageValue: resource.NewMilliQuantity(300, resource.DecimalSI),
+							},
+						},
+					},
+				},
+			},
+			msg: "may not set both a target value and a target average value",
+		},
+		{
+			horizontalPodAutoscaler: autoscaling.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "myautoscaler", Namespace: metav1.NamespaceDefault},
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{Name: "myrc", Kind: "ReplicationController"},
+					MinReplicas:    newInt32(1),
+					MaxReplicas:    5,
+					Metrics: []autoscaling.MetricSpec{
+						{
+							Type: autoscaling.ExternalMetricSourceType,
+							External: &autoscaling.ExternalMetricSource{
+								MetricName:  "queue_length",
+								TargetValue: resource.NewMilliQuantity(-300, resource.DecimalSI),
+							},
+						},
+					},
+				},
+			},
+			msg: "external.targetValue: Invalid",
+		},
+		{
+			horizontalPodAutoscaler: autoscaling.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "myautoscaler", Namespace: metav1.NamespaceDefault},
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{Name: "myrc", Kind: "ReplicationController"},
+					MinReplicas:    newInt32(1),
+					MaxReplicas:    5,
+					Metrics: []autoscaling.MetricSpec{
+						{
+							Type: autoscaling.ExternalMetricSourceType,
+							External: &autoscaling.ExternalMetricSource{
+								MetricName: "queue_length",
+								MetricSelector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "queue", Operator: "Bogus"}},
+								},
+								TargetValue: resource.NewMilliQuantity(300, resource.DecimalSI),
+							},
+						},
+					},
+				},
+			},
+			msg: "external.metricSelector.matchExpressions[0].operator: Invalid",
+		},
 	}
 
 	for _, c := range errorCases {
@@ -601,6 +736,12 @@ func TestValidateHorizontalPodAutoscaler(t *testing.T) {
 				TargetValue: *resource.NewMilliQuantity(100, resource.DecimalSI),
 			},
 		},
+		autoscaling.ExternalMetricSourceType: {
+			External: &autoscaling.ExternalMetricSource{
+				MetricName:  "some_metric",
+				TargetValue: resource.NewMilliQuantity(100, resource.DecimalSI),
+			},
+		},
 	}
 
 	for correctType, spec := range sourceTypes {
@@ -631,6 +772,112 @@ func TestValidateHorizontalPodAutoscaler(t *testing.T) {
 	}
 }
 
+func TestValidateHorizontalPodAutoscalerBehavior(t *testing.T) {
+	maxPolicy := autoscaling.MaxPolicySelect
+	disabledPolicy := autoscaling.DisabledPolicySelect
+	invalidPolicy := autoscaling.ScalingPolicySelect("Average")
+
+	newHPA := func(behavior *autoscaling.HorizontalPodAutoscalerBehavior) *autoscaling.HorizontalPodAutoscaler {
+		return &autoscaling.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "myautoscaler", Namespace: metav1.NamespaceDefault},
+			Spec: autoscaling.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscaling.CrossVersionObjectReference{Name: "myrc", Kind: "ReplicationController"},
+				MinReplicas:    newInt32(1),
+				MaxReplicas:    5,
+				Behavior:       behavior,
+			},
+		}
+	}
+
+	successCases := []*autoscaling.HorizontalPodAutoscalerBehavior{
+		nil,
+		{},
+		{
+			ScaleUp: &autoscaling.HPAScalingRules{
+				StabilizationWindowSeconds: newInt32(0),
+				SelectPolicy:               &maxPolicy,
+				Policies: []autoscaling.HPAScalingPolicy{
+					{Type: autoscaling.PodsScalingPolicy, Value: 4, PeriodSeconds: 15},
+					{Type: autoscaling.PercentScalingPolicy, Value: 100, PeriodSeconds: 15},
+				},
+			},
+			ScaleDown: &autoscaling.HPAScalingRules{
+				StabilizationWindowSeconds: newInt32(3600),
+				SelectPolicy:               &disabledPolicy,
+			},
+		},
+	}
+	for i, behavior := range successCases {
+		if errs := ValidateHorizontalPodAutoscaler(newHPA(behavior)); len(errs) != 0 {
+			t.Errorf("case %d: expected success: %v", i, errs)
+		}
+	}
+
+	errorCases := []struct {
+		behavior *autoscaling.HorizontalPodAutoscalerBehavior
+		msg      string
+	}{
+		{
+			behavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+				ScaleUp: &autoscaling.HPAScalingRules{StabilizationWindowSeconds: newInt32(-1)},
+			},
+			msg: "behavior.scaleUp.stabilizationWindowSeconds: Invalid",
+		},
+		{
+			behavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+				ScaleDown: &autoscaling.HPAScalingRules{StabilizationWindowSeconds: newInt32(3601)},
+			},
+			msg: "behavior.scaleDown.stabilizationWindowSeconds: Invalid",
+		},
+		{
+			behavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+				ScaleDown: &autoscaling.HPAScalingRules{SelectPolicy: &invalidPolicy},
+			},
+			msg: "behavior.scaleDown.selectPolicy: Unsupported value",
+		},
+		{
+			behavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+				ScaleUp: &autoscaling.HPAScalingRules{
+					Policies: []autoscaling.HPAScalingPolicy{{Value: 1, PeriodSeconds: 15}},
+				},
+			},
+			msg: "behavior.scaleUp.policies[0].type: Required",
+		},
+		{
+			behavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+				ScaleUp: &autoscaling.HPAScalingRules{
+					Policies: []autoscaling.HPAScalingPolicy{{Type: "Replicas", Value: 1, PeriodSeconds: 15}},
+				},
+			},
+			msg: "behavior.scaleUp.policies[0].type: Unsupported value",
+		},
+		{
+			behavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+				ScaleUp: &autoscaling.HPAScalingRules{
+					Policies: []autoscaling.HPAScalingPolicy{{Type: autoscaling.PodsScalingPolicy, Value: 0, PeriodSeconds: 15}},
+				},
+			},
+			msg: "behavior.scaleUp.policies[0].value: Invalid",
+		},
+		{
+			behavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+				ScaleDown: &autoscaling.HPAScalingRules{
+					Policies: []autoscaling.HPAScalingPolicy{{Type: autoscaling.PercentScalingPolicy, Value: 10, PeriodSeconds: 1801}},
+				},
+			},
+			msg: "behavior.scaleDown.policies[0].periodSeconds: Invalid",
+		},
+	}
+	for _, c := range errorCases {
+		errs := ValidateHorizontalPodAutoscaler(newHPA(c.behavior))
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %q", c.msg)
+		} else if !strings.Contains(errs[0].Error(), c.msg) {
+			t.Errorf("unexpected error: %q, expected: %q", errs[0], c.msg)
+		}
+	}
+}
+
 func newInt32(val int32) *int32 {
 	p := new(int32)
 	*p = val
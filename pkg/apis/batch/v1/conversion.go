@@ -51,6 +51,21 @@ func Convert_batch_JobSpec_To_v1_JobSpec(in *batch.JobSpec, out *JobSpec, s conv
 	out.Parallelism = in.Parallelism
 	out.Completions = in.Completions
 	out.ActiveDeadlineSeconds = in.ActiveDeadlineSeconds
+	out.BackoffLimit = in.BackoffLimit
+	if in.PodFailurePolicy != nil {
+		out.PodFailurePolicy = new(PodFailurePolicy)
+		if err := Convert_batch_PodFailurePolicy_To_v1_PodFailurePolicy(in.PodFailurePolicy, out.PodFailurePolicy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodFailurePolicy = nil
+	}
+	if in.CompletionMode != nil {
+		mode := CompletionMode(*in.CompletionMode)
+		out.CompletionMode = &mode
+	} else {
+		out.CompletionMode = nil
+	}
 	out.Selector = in.Selector
 	if in.ManualSelector != nil {
 		out.ManualSelector = new(bool)
@@ -69,6 +84,21 @@ func Convert_v1_JobSpec_To_batch_JobSpec(in *JobSpec, out *batch.JobSpec, s conv
 	out.Parallelism = in.Parallelism
 	out.Completions = in.Completions
 	out.ActiveDeadlineSeconds = in.ActiveDeadlineSeconds
+	out.BackoffLimit = in.BackoffLimit
+	if in.PodFailurePolicy != nil {
+		out.PodFailurePolicy = new(batch.PodFailurePolicy)
+		if err := Convert_v1_PodFailurePolicy_To_batch_PodFailurePolicy(in.PodFailurePolicy, out.PodFailurePolicy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodFailurePolicy = nil
+	}
+	if in.CompletionMode != nil {
+		mode := batch.CompletionMode(*in.CompletionMode)
+		out.CompletionMode = &mode
+	} else {
+		out.CompletionMode = nil
+	}
 	out.Selector = in.Selector
 	if in.ManualSelector != nil {
 		out.ManualSelector = new(bool)
@@ -40,6 +40,14 @@ func SetDefaults_Job(obj *Job) {
 		obj.Spec.Parallelism = new(int32)
 		*obj.Spec.Parallelism = 1
 	}
+	if obj.Spec.BackoffLimit == nil {
+		obj.Spec.BackoffLimit = new(int32)
+		*obj.Spec.BackoffLimit = 6
+	}
+	if obj.Spec.CompletionMode == nil {
+		mode := NonIndexedCompletion
+		obj.Spec.CompletionMode = &mode
+	}
 	labels := obj.Spec.Template.Labels
 	if labels != nil && len(obj.Labels) == 0 {
 		obj.Labels = labels
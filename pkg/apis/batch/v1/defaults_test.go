@@ -46,8 +46,48 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(1),
-					Parallelism: newInt32(1),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(1),
+					Parallelism:    newInt32(1),
+					BackoffLimit:   newInt32(6),
+				},
+			},
+			expectLabels: true,
+		},
+		"CompletionMode explicitly Indexed -> no change": {
+			original: &Job{
+				Spec: JobSpec{
+					CompletionMode: newCompletionMode(IndexedCompletion),
+					Completions:    newInt32(3),
+					Template: v1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: defaultLabels},
+					},
+				},
+			},
+			expected: &Job{
+				Spec: JobSpec{
+					CompletionMode: newCompletionMode(IndexedCompletion),
+					Completions:    newInt32(3),
+					Parallelism:    newInt32(1),
+				},
+			},
+			expectLabels: true,
+		},
+		"BackoffLimit explicitly 0 -> no change": {
+			original: &Job{
+				Spec: JobSpec{
+					BackoffLimit: newInt32(0),
+					Template: v1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: defaultLabels},
+					},
+				},
+			},
+			expected: &Job{
+				Spec: JobSpec{
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(1),
+					Parallelism:    newInt32(1),
+					BackoffLimit:   newInt32(0),
 				},
 			},
 			expectLabels: true,
@@ -65,8 +105,9 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(1),
-					Parallelism: newInt32(1),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(1),
+					Parallelism:    newInt32(1),
 				},
 			},
 		},
@@ -81,7 +122,8 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Parallelism: newInt32(0),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Parallelism:    newInt32(0),
 				},
 			},
 			expectLabels: true,
@@ -97,7 +139,8 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Parallelism: newInt32(2),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Parallelism:    newInt32(2),
 				},
 			},
 			expectLabels: true,
@@ -113,8 +156,9 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(2),
-					Parallelism: newInt32(1),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(2),
+					Parallelism:    newInt32(1),
 				},
 			},
 			expectLabels: true,
@@ -131,8 +175,9 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(10),
-					Parallelism: newInt32(11),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(10),
+					Parallelism:    newInt32(11),
 					Template: v1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{Labels: defaultLabels},
 					},
@@ -152,8 +197,9 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(11),
-					Parallelism: newInt32(10),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(11),
+					Parallelism:    newInt32(10),
 				},
 			},
 			expectLabels: true,
@@ -185,6 +231,14 @@ func TestSetDefaultJob(t *testing.T) {
 				t.Errorf("%s: got different parallelism than expected: %d %d", name, *actual.Spec.Parallelism, *expected.Spec.Parallelism)
 			}
 		}
+		if expected.Spec.BackoffLimit != nil {
+			if actual.Spec.BackoffLimit == nil || *actual.Spec.BackoffLimit != *expected.Spec.BackoffLimit {
+				t.Errorf("%s: got different backoffLimit than expected: %v %v", name, actual.Spec.BackoffLimit, *expected.Spec.BackoffLimit)
+			}
+		}
+		if actual.Spec.CompletionMode == nil || *actual.Spec.CompletionMode != *expected.Spec.CompletionMode {
+			t.Errorf("%s: got different completionMode than expected: %v %v", name, actual.Spec.CompletionMode, *expected.Spec.CompletionMode)
+		}
 		if test.expectLabels != reflect.DeepEqual(actual.Labels, actual.Spec.Template.Labels) {
 			if test.expectLabels {
 				t.Errorf("%s: expected: %v, got: %v", name, actual.Spec.Template.Labels, actual.Labels)
@@ -216,6 +270,10 @@ func roundTrip(t *testing.T, obj runtime.Object) runtime.Object {
 	return obj3
 }
 
+func newCompletionMode(mode CompletionMode) *CompletionMode {
+	return &mode
+}
+
 func newInt32(val int32) *int32 {
 	p := new(int32)
 	*p = val
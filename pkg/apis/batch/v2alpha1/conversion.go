@@ -58,6 +58,21 @@ func Convert_batch_JobSpec_To_v2alpha1_JobSpec(in *batch.JobSpec, out *JobSpec,
 	out.Parallelism = in.Parallelism
 	out.Completions = in.Completions
 	out.ActiveDeadlineSeconds = in.ActiveDeadlineSeconds
+	out.BackoffLimit = in.BackoffLimit
+	if in.PodFailurePolicy != nil {
+		out.PodFailurePolicy = new(PodFailurePolicy)
+		if err := Convert_batch_PodFailurePolicy_To_v2alpha1_PodFailurePolicy(in.PodFailurePolicy, out.PodFailurePolicy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodFailurePolicy = nil
+	}
+	if in.CompletionMode != nil {
+		mode := CompletionMode(*in.CompletionMode)
+		out.CompletionMode = &mode
+	} else {
+		out.CompletionMode = nil
+	}
 	out.Selector = in.Selector
 	if in.ManualSelector != nil {
 		out.ManualSelector = new(bool)
@@ -76,6 +91,21 @@ func Convert_v2alpha1_JobSpec_To_batch_JobSpec(in *JobSpec, out *batch.JobSpec,
 	out.Parallelism = in.Parallelism
 	out.Completions = in.Completions
 	out.ActiveDeadlineSeconds = in.ActiveDeadlineSeconds
+	out.BackoffLimit = in.BackoffLimit
+	if in.PodFailurePolicy != nil {
+		out.PodFailurePolicy = new(batch.PodFailurePolicy)
+		if err := Convert_v2alpha1_PodFailurePolicy_To_batch_PodFailurePolicy(in.PodFailurePolicy, out.PodFailurePolicy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodFailurePolicy = nil
+	}
+	if in.CompletionMode != nil {
+		mode := batch.CompletionMode(*in.CompletionMode)
+		out.CompletionMode = &mode
+	} else {
+		out.CompletionMode = nil
+	}
 	out.Selector = in.Selector
 	if in.ManualSelector != nil {
 		out.ManualSelector = new(bool)
@@ -46,8 +46,48 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(1),
-					Parallelism: newInt32(1),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(1),
+					Parallelism:    newInt32(1),
+					BackoffLimit:   newInt32(6),
+				},
+			},
+			expectLabels: true,
+		},
+		"CompletionMode explicitly Indexed -> no change": {
+			original: &Job{
+				Spec: JobSpec{
+					CompletionMode: newCompletionMode(IndexedCompletion),
+					Completions:    newInt32(3),
+					Template: v1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: defaultLabels},
+					},
+				},
+			},
+			expected: &Job{
+				Spec: JobSpec{
+					CompletionMode: newCompletionMode(IndexedCompletion),
+					Completions:    newInt32(3),
+					Parallelism:    newInt32(1),
+				},
+			},
+			expectLabels: true,
+		},
+		"BackoffLimit explicitly 0 -> no change": {
+			original: &Job{
+				Spec: JobSpec{
+					BackoffLimit: newInt32(0),
+					Template: v1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: defaultLabels},
+					},
+				},
+			},
+			expected: &Job{
+				Spec: JobSpec{
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(1),
+					Parallelism:    newInt32(1),
+					BackoffLimit:   newInt32(0),
 				},
 			},
 			expectLabels: true,
@@ -65,8 +105,9 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(1),
-					Parallelism: newInt32(1),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(1),
+					Parallelism:    newInt32(1),
 				},
 			},
 		},
@@ -81,7 +122,8 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Parallelism: newInt32(0),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Parallelism:    newInt32(0),
 				},
 			},
 			expectLabels: true,
@@ -97,7 +139,8 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Parallelism: newInt32(2),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Parallelism:    newInt32(2),
 				},
 			},
 			expectLabels: true,
@@ -113,8 +156,9 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(2),
-					Parallelism: newInt32(1),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(2),
+					Parallelism:    newInt32(1),
 				},
 			},
 			expectLabels: true,
@@ -131,8 +175,9 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(10),
-					Parallelism: newInt32(11),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(10),
+					Parallelism:    newInt32(11),
 					Template: v1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{Labels: defaultLabels},
 					},
@@ -152,8 +197,9 @@ func TestSetDefaultJob(t *testing.T) {
 			},
 			expected: &Job{
 				Spec: JobSpec{
-					Completions: newInt32(11),
-					Parallelism: newInt32(10),
+					CompletionMode: newCompletionMode(NonIndexedCompletion),
+					Completions:    newInt32(11),
+					Parallelism:    newInt32(10),
 				},
 			},
 			expectLabels: true,
@@ -185,6 +231,14 @@ func TestSetDefaultJob(t *testing.T) {
 				t.Errorf("%s: got different parallelism than expected: %d %d", name, *actual.Spec.Parallelism, *expected.Spec.Parallelism)
 			}
 		}
+		if expected.Spec.BackoffLimit != nil {
+			if actual.Spec.BackoffLimit == nil || *actual.Spec.BackoffLimit != *expected.Spec.BackoffLimit {
+				t.Errorf("%s: got different backoffLimit than expected: %v %v", name, actual.Spec.BackoffLimit, *expected.Spec.BackoffLimit)
+			}
+		}
+		if actual.Spec.CompletionMode == nil || *actual.Spec.CompletionMode != *expected.Spec.CompletionMode {
+			t.Errorf("%s: got different completionMode than expected: %v %v", name, actual.Spec.CompletionMode, *expected.Spec.CompletionMode)
+		}
 		if test.expectLabels != reflect.DeepEqual(actual.Labels, actual.Spec.Template.Labels) {
 			if test.expectLabels {
 				t.Errorf("%s: expected: %v, got: %v", name, actual.Spec.Template.Labels, actual.Labels)
@@ -196,6 +250,63 @@ func TestSetDefaultJob(t *testing.T) {
 	}
 }
 
+func TestSetDefaultCronJob(t *testing.T) {
+	tests := map[string]struct {
+		original *CronJob
+		expected *CronJob
+	}{
+		"empty CronJob should default ConcurrencyPolicy, CatchUpPolicy and Suspend": {
+			original: &CronJob{},
+			expected: &CronJob{
+				Spec: CronJobSpec{
+					ConcurrencyPolicy: AllowConcurrent,
+					CatchUpPolicy:     RunLatestCatchUp,
+					Suspend:           newBool(false),
+				},
+			},
+		},
+		"set fields should not be defaulted": {
+			original: &CronJob{
+				Spec: CronJobSpec{
+					ConcurrencyPolicy: ForbidConcurrent,
+					CatchUpPolicy:     RunAllCatchUp,
+					CatchUpLimit:      newInt32(5),
+					Suspend:           newBool(true),
+				},
+			},
+			expected: &CronJob{
+				Spec: CronJobSpec{
+					ConcurrencyPolicy: ForbidConcurrent,
+					CatchUpPolicy:     RunAllCatchUp,
+					CatchUpLimit:      newInt32(5),
+					Suspend:           newBool(true),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		obj2 := roundTrip(t, runtime.Object(test.original))
+		actual, ok := obj2.(*CronJob)
+		if !ok {
+			t.Errorf("%s: unexpected object: %v", name, actual)
+			t.FailNow()
+		}
+		if actual.Spec.ConcurrencyPolicy != test.expected.Spec.ConcurrencyPolicy {
+			t.Errorf("%s: got different concurrencyPolicy than expected: %v %v", name, actual.Spec.ConcurrencyPolicy, test.expected.Spec.ConcurrencyPolicy)
+		}
+		if actual.Spec.CatchUpPolicy != test.expected.Spec.CatchUpPolicy {
+			t.Errorf("%s: got different catchUpPolicy than expected: %v %v", name, actual.Spec.CatchUpPolicy, test.expected.Spec.CatchUpPolicy)
+		}
+		if !reflect.DeepEqual(actual.Spec.CatchUpLimit, test.expected.Spec.CatchUpLimit) {
+			t.Errorf("%s: got different catchUpLimit than expected: %v %v", name, actual.Spec.CatchUpLimit, test.expected.Spec.CatchUpLimit)
+		}
+		if !reflect.DeepEqual(actual.Spec.Suspend, test.expected.Spec.Suspend) {
+			t.Errorf("%s: got different suspend than expected: %v %v", name, actual.Spec.Suspend, test.expected.Spec.Suspend)
+		}
+	}
+}
+
 func roundTrip(t *testing.T, obj runtime.Object) runtime.Object {
 	data, err := runtime.Encode(api.Codecs.LegacyCodec(SchemeGroupVersion), obj)
 	if err != nil {
@@ -216,8 +327,18 @@ func roundTrip(t *testing.T, obj runtime.Object) runtime.Object {
 	return obj3
 }
 
+func newCompletionMode(mode CompletionMode) *CompletionMode {
+	return &mode
+}
+
 func newInt32(val int32) *int32 {
 	p := new(int32)
 	*p = val
 	return p
 }
+
+func newBool(val bool) *bool {
+	p := new(bool)
+	*p = val
+	return p
+}
@@ -106,6 +106,34 @@ type JobSpec struct {
 	// +optional
 	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty" protobuf:"varint,3,opt,name=activeDeadlineSeconds"`
 
+	// Specifies the number of retries before marking this job failed.
+	// Defaults to 6
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty" protobuf:"varint,7,opt,name=backoffLimit"`
+
+	// Specifies the policy of handling failed pods. Rules are evaluated in order
+	// against each failed pod, and the first matching rule decides whether the
+	// failure is counted towards the backoffLimit, ignored, or fails the whole
+	// job immediately. Failures not matched by any rule are counted.
+	// +optional
+	PodFailurePolicy *PodFailurePolicy `json:"podFailurePolicy,omitempty" protobuf:"bytes,8,opt,name=podFailurePolicy"`
+
+	// CompletionMode specifies how pod completions are tracked. It can be
+	// `NonIndexed` (default) or `Indexed`.
+	//
+	// `NonIndexed` means that the job is considered complete when there have
+	// been .spec.completions successfully completed pods. Each pod completion is
+	// homologous to each other.
+	//
+	// `Indexed` means that the pods of a job get an associated completion index
+	// from 0 to (.spec.completions - 1), available in the annotation
+	// batch.kubernetes.io/job-completion-index, the JOB_COMPLETION_INDEX
+	// environment variable and the pod hostname. The job is considered complete
+	// when there is one successfully completed pod for each index. When value is
+	// `Indexed`, .spec.completions must be specified.
+	// +optional
+	CompletionMode *CompletionMode `json:"completionMode,omitempty" protobuf:"bytes,9,opt,name=completionMode,casttype=CompletionMode"`
+
 	// Selector is a label query over pods that should match the pod count.
 	// Normally, the system sets this field for you.
 	// More info: http://kubernetes.io/docs/user-guide/labels#label-selectors
@@ -131,6 +159,83 @@ type JobSpec struct {
 	Template v1.PodTemplateSpec `json:"template" protobuf:"bytes,6,opt,name=template"`
 }
 
+// CompletionMode specifies how pod completions of a Job are tracked.
+type CompletionMode string
+
+const (
+	// NonIndexedCompletion is a Job completion mode. In this mode, the Job is
+	// considered complete when there have been .spec.completions
+	// successfully completed pods. Pod completions are homologous to each other.
+	NonIndexedCompletion CompletionMode = "NonIndexed"
+
+	// IndexedCompletion is a Job completion mode. In this mode, the pods of a
+	// Job get an associated completion index from 0 to (.spec.completions - 1).
+	// The Job is considered complete when a pod completes for each completion
+	// index.
+	IndexedCompletion CompletionMode = "Indexed"
+)
+
+// PodFailurePolicyAction specifies how a pod failure matched by a rule is handled.
+type PodFailurePolicyAction string
+
+const (
+	// PodFailurePolicyActionFailJob marks the job as failed and terminates
+	// its remaining active pods; the failure is considered non-retryable.
+	PodFailurePolicyActionFailJob PodFailurePolicyAction = "FailJob"
+	// PodFailurePolicyActionIgnore does not count the failure towards the
+	// backoffLimit, and a replacement pod is created.
+	PodFailurePolicyActionIgnore PodFailurePolicyAction = "Ignore"
+	// PodFailurePolicyActionCount counts the failure towards the backoffLimit,
+	// which is also what happens to failures not matched by any rule.
+	PodFailurePolicyActionCount PodFailurePolicyAction = "Count"
+)
+
+// PodFailurePolicyOnExitCodesOperator is the relationship between a container
+// exit code and the listed values.
+type PodFailurePolicyOnExitCodesOperator string
+
+const (
+	PodFailurePolicyOnExitCodesOpIn    PodFailurePolicyOnExitCodesOperator = "In"
+	PodFailurePolicyOnExitCodesOpNotIn PodFailurePolicyOnExitCodesOperator = "NotIn"
+)
+
+// PodFailurePolicy describes how failed pods influence the backoffLimit.
+type PodFailurePolicy struct {
+	// A list of pod failure policy rules. The rules are evaluated in order.
+	// Once a rule matches a pod failure, the remaining rules are ignored.
+	Rules []PodFailurePolicyRule `json:"rules" protobuf:"bytes,1,rep,name=rules"`
+}
+
+// PodFailurePolicyRule describes how a pod failure is handled when its
+// requirement is met.
+type PodFailurePolicyRule struct {
+	// Specifies the action taken on a pod failure when the requirement is satisfied.
+	Action PodFailurePolicyAction `json:"action" protobuf:"bytes,1,opt,name=action,casttype=PodFailurePolicyAction"`
+
+	// Represents the requirement on the container exit codes.
+	OnExitCodes PodFailurePolicyOnExitCodesRequirement `json:"onExitCodes" protobuf:"bytes,2,opt,name=onExitCodes"`
+}
+
+// PodFailurePolicyOnExitCodesRequirement describes the requirement for handling
+// a failed pod based on the exit codes of its terminated containers.
+type PodFailurePolicyOnExitCodesRequirement struct {
+	// Restricts the check for exit codes to the container with the specified
+	// name. When unset, the rule applies to all containers in the pod template.
+	// +optional
+	ContainerName *string `json:"containerName,omitempty" protobuf:"bytes,1,opt,name=containerName"`
+
+	// Represents the relationship between the container exit code(s) and the
+	// specified values. Containers which completed with success (exit code 0)
+	// are excluded from the requirement check. Possible values are In and NotIn.
+	Operator PodFailurePolicyOnExitCodesOperator `json:"operator" protobuf:"bytes,2,opt,name=operator,casttype=PodFailurePolicyOnExitCodesOperator"`
+
+	// Specifies the set of values. Each returned container exit code (might be
+	// multiple in case of multiple containers) is checked against this set of
+	// values with respect to the operator. The value 0 cannot be used for the
+	// In operator.
+	Values []int32 `json:"values" protobuf:"varint,3,rep,name=values"`
+}
+
 // JobStatus represents the current state of a Job.
 type JobStatus struct {
 
@@ -162,6 +267,16 @@ type JobStatus struct {
 	// Failed is the number of pods which reached Phase Failed.
 	// +optional
 	Failed int32 `json:"failed,omitempty" protobuf:"varint,6,opt,name=failed"`
+
+	// CompletedIndexes holds the completed indexes when .spec.completionMode =
+	// "Indexed" in a text format. The indexes are represented as decimal integers
+	// separated by commas. The numbers are listed in increasing order. Three or
+	// more consecutive numbers are compressed and represented by the first and
+	// last element of the series, separated by a hyphen.
+	// For example, if the completed indexes are 1, 3, 4, 5 and 7, they are
+	// represented as "1,3-5,7".
+	// +optional
+	CompletedIndexes string `json:"completedIndexes,omitempty" protobuf:"bytes,7,opt,name=completedIndexes"`
 }
 
 type JobConditionType string
@@ -260,6 +375,24 @@ type CronJobSpec struct {
 	// This is a pointer to distinguish between explicit zero and not specified.
 	// +optional
 	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty" protobuf:"varint,7,opt,name=failedJobsHistoryLimit"`
+
+	// The time zone name for the given schedule, see
+	// https://en.wikipedia.org/wiki/List_of_tz_database_time_zones.
+	// If not specified, the schedule is evaluated in the local time zone of
+	// the controller manager.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty" protobuf:"bytes,8,opt,name=timeZone"`
+
+	// CatchUpPolicy specifies how schedule times that were missed, for example
+	// while the controller was down, are handled.  Defaults to RunLatest.
+	// +optional
+	CatchUpPolicy CatchUpPolicy `json:"catchUpPolicy,omitempty" protobuf:"bytes,9,opt,name=catchUpPolicy,casttype=CatchUpPolicy"`
+
+	// The maximum number of missed schedule times started when catchUpPolicy
+	// is RunAll. Only the most recent ones are started, older missed times are
+	// skipped.  Defaults to 100.
+	// +optional
+	CatchUpLimit *int32 `json:"catchUpLimit,omitempty" protobuf:"varint,10,opt,name=catchUpLimit"`
 }
 
 // ConcurrencyPolicy describes how the job will be handled.
@@ -280,6 +413,26 @@ const (
 	ReplaceConcurrent ConcurrencyPolicy = "Replace"
 )
 
+// CatchUpPolicy describes how missed schedule times of a CronJob are handled.
+// Only one of the following catch up policies may be specified.
+// If none of the following policies is specified, the default one
+// is RunLatest.
+type CatchUpPolicy string
+
+const (
+	// RunLatestCatchUp starts a job only for the most recent missed schedule time.
+	RunLatestCatchUp CatchUpPolicy = "RunLatest"
+
+	// RunAllCatchUp starts a job for each missed schedule time, oldest first,
+	// up to catchUpLimit of the most recent ones.
+	RunAllCatchUp CatchUpPolicy = "RunAll"
+
+	// SkipCatchUp starts no job for missed schedule times; a job is only
+	// started when the most recent schedule time is the only one not yet
+	// handled.
+	SkipCatchUp CatchUpPolicy = "Skip"
+)
+
 // CronJobStatus represents the current state of a cron job.
 type CronJobStatus struct {
 	// Active holds pointers to currently running jobs.
@@ -289,4 +442,10 @@ type CronJobStatus struct {
 	// LastScheduleTime keeps information of when was the last time the job was successfully scheduled.
 	// +optional
 	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty" protobuf:"bytes,4,opt,name=lastScheduleTime"`
+
+	// LastMissedTime keeps information of when was the last schedule time that
+	// was skipped without starting a job, because of the catch up policy or
+	// the starting deadline.
+	// +optional
+	LastMissedTime *metav1.Time `json:"lastMissedTime,omitempty" protobuf:"bytes,5,opt,name=lastMissedTime"`
 }
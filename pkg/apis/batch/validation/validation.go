@@ -17,11 +17,19 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
+	"time"
+
+	// Embed the IANA time zone database so that CronJob time zones can be
+	// validated regardless of the time zone data installed on the host.
+	_ "time/tzdata"
+
 	"github.com/robfig/cron"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unversionedvalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kubernetes/pkg/api"
 	apivalidation "k8s.io/kubernetes/pkg/api/validation"
@@ -114,12 +122,93 @@ func validateJobSpec(spec *batch.JobSpec, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.ActiveDeadlineSeconds), fldPath.Child("activeDeadlineSeconds"))...)
 	}
 
+	if spec.BackoffLimit != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.BackoffLimit), fldPath.Child("backoffLimit"))...)
+	}
+	if spec.CompletionMode != nil {
+		switch *spec.CompletionMode {
+		case batch.NonIndexedCompletion:
+		case batch.IndexedCompletion:
+			if spec.Completions == nil {
+				allErrs = append(allErrs, field.Required(fldPath.Child("completions"), fmt.Sprintf("when completion mode is %s", batch.IndexedCompletion)))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("completionMode"), *spec.CompletionMode,
+				[]string{string(batch.NonIndexedCompletion), string(batch.IndexedCompletion)}))
+		}
+	}
+
 	allErrs = append(allErrs, apivalidation.ValidatePodTemplateSpec(&spec.Template, fldPath.Child("template"))...)
 	if spec.Template.Spec.RestartPolicy != api.RestartPolicyOnFailure &&
 		spec.Template.Spec.RestartPolicy != api.RestartPolicyNever {
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("template", "spec", "restartPolicy"),
 			spec.Template.Spec.RestartPolicy, []string{string(api.RestartPolicyOnFailure), string(api.RestartPolicyNever)}))
 	}
+	if spec.PodFailurePolicy != nil {
+		allErrs = append(allErrs, validatePodFailurePolicy(spec, fldPath)...)
+	}
+	return allErrs
+}
+
+var supportedPodFailurePolicyActions = sets.NewString(
+	string(batch.PodFailurePolicyActionFailJob),
+	string(batch.PodFailurePolicyActionIgnore),
+	string(batch.PodFailurePolicyActionCount),
+)
+
+var supportedPodFailurePolicyOnExitCodesOperators = sets.NewString(
+	string(batch.PodFailurePolicyOnExitCodesOpIn),
+	string(batch.PodFailurePolicyOnExitCodesOpNotIn),
+)
+
+func validatePodFailurePolicy(spec *batch.JobSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	// Container exit codes are only observed on failed pods when the kubelet
+	// does not restart the containers in place.
+	if spec.Template.Spec.RestartPolicy != api.RestartPolicyNever {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("template", "spec", "restartPolicy"),
+			spec.Template.Spec.RestartPolicy, []string{string(api.RestartPolicyNever)}))
+	}
+	containerNames := sets.NewString()
+	for _, c := range spec.Template.Spec.InitContainers {
+		containerNames.Insert(c.Name)
+	}
+	for _, c := range spec.Template.Spec.Containers {
+		containerNames.Insert(c.Name)
+	}
+	rulesPath := fldPath.Child("podFailurePolicy", "rules")
+	for i, rule := range spec.PodFailurePolicy.Rules {
+		rulePath := rulesPath.Index(i)
+		if !supportedPodFailurePolicyActions.Has(string(rule.Action)) {
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("action"), rule.Action, supportedPodFailurePolicyActions.List()))
+		}
+		allErrs = append(allErrs, validatePodFailurePolicyOnExitCodes(&rule.OnExitCodes, containerNames, rulePath.Child("onExitCodes"))...)
+	}
+	return allErrs
+}
+
+func validatePodFailurePolicyOnExitCodes(requirement *batch.PodFailurePolicyOnExitCodesRequirement, containerNames sets.String, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if requirement.ContainerName != nil && !containerNames.Has(*requirement.ContainerName) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("containerName"), *requirement.ContainerName, "must be one of the container names in the pod template"))
+	}
+	if !supportedPodFailurePolicyOnExitCodesOperators.Has(string(requirement.Operator)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operator"), requirement.Operator, supportedPodFailurePolicyOnExitCodesOperators.List()))
+	}
+	valuesPath := fldPath.Child("values")
+	if len(requirement.Values) == 0 {
+		allErrs = append(allErrs, field.Required(valuesPath, "at least one value is required"))
+	}
+	seen := sets.NewInt()
+	for i, value := range requirement.Values {
+		if seen.Has(int(value)) {
+			allErrs = append(allErrs, field.Duplicate(valuesPath.Index(i), value))
+		}
+		seen.Insert(int(value))
+		if value == 0 && requirement.Operator == batch.PodFailurePolicyOnExitCodesOpIn {
+			allErrs = append(allErrs, field.Invalid(valuesPath.Index(i), value, "must not be 0 for the In operator"))
+		}
+	}
 	return allErrs
 }
 
@@ -147,6 +236,7 @@ func ValidateJobSpecUpdate(spec, oldSpec batch.JobSpec, fldPath *field.Path) fie
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ValidateJobSpec(&spec, fldPath)...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(spec.Completions, oldSpec.Completions, fldPath.Child("completions"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(spec.CompletionMode, oldSpec.CompletionMode, fldPath.Child("completionMode"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(spec.Selector, oldSpec.Selector, fldPath.Child("selector"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(spec.Template, oldSpec.Template, fldPath.Child("template"))...)
 	return allErrs
@@ -177,6 +267,17 @@ func ValidateCronJobSpec(spec *batch.CronJobSpec, fldPath *field.Path) field.Err
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.StartingDeadlineSeconds), fldPath.Child("startingDeadlineSeconds"))...)
 	}
 	allErrs = append(allErrs, validateConcurrencyPolicy(&spec.ConcurrencyPolicy, fldPath.Child("concurrencyPolicy"))...)
+	allErrs = append(allErrs, validateCatchUpPolicy(&spec.CatchUpPolicy, fldPath.Child("catchUpPolicy"))...)
+	if spec.CatchUpLimit != nil {
+		if spec.CatchUpPolicy != batch.RunAllCatchUp {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("catchUpLimit"), fmt.Sprintf("may only be set when catchUpPolicy is %s", batch.RunAllCatchUp)))
+		} else if *spec.CatchUpLimit < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("catchUpLimit"), *spec.CatchUpLimit, "must be greater than 0"))
+		}
+	}
+	if spec.TimeZone != nil {
+		allErrs = append(allErrs, validateTimeZone(*spec.TimeZone, fldPath.Child("timeZone"))...)
+	}
 	allErrs = append(allErrs, ValidateJobTemplateSpec(&spec.JobTemplate, fldPath.Child("jobTemplate"))...)
 
 	if spec.SuccessfulJobsHistoryLimit != nil {
@@ -206,6 +307,34 @@ func validateConcurrencyPolicy(concurrencyPolicy *batch.ConcurrencyPolicy, fldPa
 	return allErrs
 }
 
+func validateCatchUpPolicy(catchUpPolicy *batch.CatchUpPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch *catchUpPolicy {
+	case batch.RunLatestCatchUp, batch.RunAllCatchUp, batch.SkipCatchUp:
+		break
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath, ""))
+	default:
+		validValues := []string{string(batch.RunLatestCatchUp), string(batch.RunAllCatchUp), string(batch.SkipCatchUp)}
+		allErrs = append(allErrs, field.NotSupported(fldPath, *catchUpPolicy, validValues))
+	}
+
+	return allErrs
+}
+
+func validateTimeZone(timeZone string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	// "" and "Local" are accepted by time.LoadLocation, but would make the
+	// schedule depend on the time zone of the controller manager.
+	if len(timeZone) == 0 || timeZone == "Local" {
+		allErrs = append(allErrs, field.Invalid(fldPath, timeZone, "must be a time zone name of the IANA time zone database"))
+	} else if _, err := time.LoadLocation(timeZone); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, timeZone, err.Error()))
+	}
+
+	return allErrs
+}
+
 func validateScheduleFormat(schedule string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if _, err := cron.ParseStandard(schedule); err != nil {
@@ -69,6 +69,13 @@ func TestValidateJob(t *testing.T) {
 	validPodTemplateSpecForManual := getValidPodTemplateSpecForManual(validManualSelector)
 	validGeneratedSelector := getValidGeneratedSelector()
 	validPodTemplateSpecForGenerated := getValidPodTemplateSpecForGenerated(validGeneratedSelector)
+	validPodTemplateSpecNeverRestart := getValidPodTemplateSpecForGenerated(validGeneratedSelector)
+	validPodTemplateSpecNeverRestart.Spec.RestartPolicy = api.RestartPolicyNever
+	containerName := "abc"
+	unknownContainerName := "xyz"
+	indexedCompletion := batch.IndexedCompletion
+	unknownCompletion := batch.CompletionMode("Ordered")
+	completions := int32(3)
 
 	successCases := map[string]batch.Job{
 		"manual selector": {
@@ -94,6 +101,49 @@ func TestValidateJob(t *testing.T) {
 				Template: validPodTemplateSpecForGenerated,
 			},
 		},
+		"indexed completion mode": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				CompletionMode: &indexedCompletion,
+				Completions:    &completions,
+				Selector:       validGeneratedSelector,
+				Template:       validPodTemplateSpecForGenerated,
+			},
+		},
+		"pod failure policy": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				Selector: validGeneratedSelector,
+				Template: validPodTemplateSpecNeverRestart,
+				PodFailurePolicy: &batch.PodFailurePolicy{
+					Rules: []batch.PodFailurePolicyRule{
+						{
+							Action: batch.PodFailurePolicyActionFailJob,
+							OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+								ContainerName: &containerName,
+								Operator:      batch.PodFailurePolicyOnExitCodesOpIn,
+								Values:        []int32{1, 42},
+							},
+						},
+						{
+							Action: batch.PodFailurePolicyActionIgnore,
+							OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+								Operator: batch.PodFailurePolicyOnExitCodesOpNotIn,
+								Values:   []int32{0, 1, 42},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for k, v := range successCases {
 		if errs := ValidateJob(&v); len(errs) != 0 {
@@ -149,6 +199,182 @@ func TestValidateJob(t *testing.T) {
 				Template: validPodTemplateSpecForGenerated,
 			},
 		},
+		"spec.completions:Required value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				CompletionMode: &indexedCompletion,
+				Selector:       validGeneratedSelector,
+				Template:       validPodTemplateSpecForGenerated,
+			},
+		},
+		"spec.completionMode: Unsupported value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				CompletionMode: &unknownCompletion,
+				Selector:       validGeneratedSelector,
+				Template:       validPodTemplateSpecForGenerated,
+			},
+		},
+		"spec.backoffLimit:must be greater than or equal to 0": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				BackoffLimit: &negative,
+				Selector:     validGeneratedSelector,
+				Template:     validPodTemplateSpecForGenerated,
+			},
+		},
+		"spec.template.spec.restartPolicy: Unsupported value: \"OnFailure\"": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				Selector: validGeneratedSelector,
+				Template: validPodTemplateSpecForGenerated,
+				PodFailurePolicy: &batch.PodFailurePolicy{
+					Rules: []batch.PodFailurePolicyRule{{
+						Action: batch.PodFailurePolicyActionFailJob,
+						OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+							Operator: batch.PodFailurePolicyOnExitCodesOpIn,
+							Values:   []int32{1},
+						},
+					}},
+				},
+			},
+		},
+		"spec.podFailurePolicy.rules[0].action: Unsupported value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				Selector: validGeneratedSelector,
+				Template: validPodTemplateSpecNeverRestart,
+				PodFailurePolicy: &batch.PodFailurePolicy{
+					Rules: []batch.PodFailurePolicyRule{{
+						Action: "Restart",
+						OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+							Operator: batch.PodFailurePolicyOnExitCodesOpIn,
+							Values:   []int32{1},
+						},
+					}},
+				},
+			},
+		},
+		"spec.podFailurePolicy.rules[0].onExitCodes.containerName: Invalid value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				Selector: validGeneratedSelector,
+				Template: validPodTemplateSpecNeverRestart,
+				PodFailurePolicy: &batch.PodFailurePolicy{
+					Rules: []batch.PodFailurePolicyRule{{
+						Action: batch.PodFailurePolicyActionFailJob,
+						OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+							ContainerName: &unknownContainerName,
+							Operator:      batch.PodFailurePolicyOnExitCodesOpIn,
+							Values:        []int32{1},
+						},
+					}},
+				},
+			},
+		},
+		"spec.podFailurePolicy.rules[0].onExitCodes.operator: Unsupported value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				Selector: validGeneratedSelector,
+				Template: validPodTemplateSpecNeverRestart,
+				PodFailurePolicy: &batch.PodFailurePolicy{
+					Rules: []batch.PodFailurePolicyRule{{
+						Action: batch.PodFailurePolicyActionFailJob,
+						OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+							Operator: "Exists",
+							Values:   []int32{1},
+						},
+					}},
+				},
+			},
+		},
+		"spec.podFailurePolicy.rules[0].onExitCodes.values: Required value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				Selector: validGeneratedSelector,
+				Template: validPodTemplateSpecNeverRestart,
+				PodFailurePolicy: &batch.PodFailurePolicy{
+					Rules: []batch.PodFailurePolicyRule{{
+						Action: batch.PodFailurePolicyActionFailJob,
+						OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+							Operator: batch.PodFailurePolicyOnExitCodesOpIn,
+						},
+					}},
+				},
+			},
+		},
+		"spec.podFailurePolicy.rules[0].onExitCodes.values[1]: Duplicate value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				Selector: validGeneratedSelector,
+				Template: validPodTemplateSpecNeverRestart,
+				PodFailurePolicy: &batch.PodFailurePolicy{
+					Rules: []batch.PodFailurePolicyRule{{
+						Action: batch.PodFailurePolicyActionFailJob,
+						OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+							Operator: batch.PodFailurePolicyOnExitCodesOpIn,
+							Values:   []int32{1, 1},
+						},
+					}},
+				},
+			},
+		},
+		"spec.podFailurePolicy.rules[0].onExitCodes.values[0]: Invalid value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.JobSpec{
+				Selector: validGeneratedSelector,
+				Template: validPodTemplateSpecNeverRestart,
+				PodFailurePolicy: &batch.PodFailurePolicy{
+					Rules: []batch.PodFailurePolicyRule{{
+						Action: batch.PodFailurePolicyActionFailJob,
+						OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+							Operator: batch.PodFailurePolicyOnExitCodesOpIn,
+							Values:   []int32{0},
+						},
+					}},
+				},
+			},
+		},
 		"spec.template.metadata.labels: Invalid value: {\"y\":\"z\"}: `selector` does not match template `labels`": {
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "myjob",
@@ -309,6 +535,9 @@ func TestValidateCronJob(t *testing.T) {
 	validPodTemplateSpec := getValidPodTemplateSpecForGenerated(getValidGeneratedSelector())
 	validPodTemplateSpec.Labels = map[string]string{}
 
+	validTimeZone := "America/New_York"
+	positive := int32(5)
+
 	successCases := map[string]batch.CronJob{
 		"basic scheduled job": {
 			ObjectMeta: metav1.ObjectMeta{
@@ -319,6 +548,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "* * * * ?",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						Template: validPodTemplateSpec,
@@ -335,6 +565,60 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "@hourly",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
+		"time zone": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
+				TimeZone:          &validTimeZone,
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
+		"run all catch up": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunAllCatchUp,
+				CatchUpLimit:      &positive,
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
+		"skip catch up": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.SkipCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						Template: validPodTemplateSpec,
@@ -351,6 +635,9 @@ func TestValidateCronJob(t *testing.T) {
 
 	negative := int32(-1)
 	negative64 := int64(-1)
+	zero := int32(0)
+	localTimeZone := "Local"
+	invalidTimeZone := "Mars/Olympus_Mons"
 
 	errorCases := map[string]batch.CronJob{
 		"spec.schedule: Invalid value": {
@@ -362,6 +649,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "error",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						Template: validPodTemplateSpec,
@@ -378,6 +666,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						Template: validPodTemplateSpec,
@@ -394,6 +683,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:                "* * * * ?",
 				ConcurrencyPolicy:       batch.AllowConcurrent,
+				CatchUpPolicy:           batch.RunLatestCatchUp,
 				StartingDeadlineSeconds: &negative64,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
@@ -411,6 +701,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:                   "* * * * ?",
 				ConcurrencyPolicy:          batch.AllowConcurrent,
+				CatchUpPolicy:              batch.RunLatestCatchUp,
 				SuccessfulJobsHistoryLimit: &negative,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
@@ -428,6 +719,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:               "* * * * ?",
 				ConcurrencyPolicy:      batch.AllowConcurrent,
+				CatchUpPolicy:          batch.RunLatestCatchUp,
 				FailedJobsHistoryLimit: &negative,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
@@ -443,7 +735,8 @@ func TestValidateCronJob(t *testing.T) {
 				UID:       types.UID("1a2b3c"),
 			},
 			Spec: batch.CronJobSpec{
-				Schedule: "* * * * ?",
+				Schedule:      "* * * * ?",
+				CatchUpPolicy: batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						Template: validPodTemplateSpec,
@@ -460,6 +753,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "* * * * ?",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						Parallelism: &negative,
@@ -477,6 +771,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "* * * * ?",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 
 					Spec: batch.JobSpec{
@@ -495,6 +790,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "* * * * ?",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						ActiveDeadlineSeconds: &negative64,
@@ -512,6 +808,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "* * * * ?",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						Selector: validManualSelector,
@@ -529,6 +826,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "* * * * ?",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						ManualSelector: newBool(true),
@@ -546,6 +844,7 @@ func TestValidateCronJob(t *testing.T) {
 			Spec: batch.CronJobSpec{
 				Schedule:          "* * * * ?",
 				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
 				JobTemplate: batch.JobTemplateSpec{
 					Spec: batch.JobSpec{
 						Template: api.PodTemplateSpec{
@@ -559,6 +858,111 @@ func TestValidateCronJob(t *testing.T) {
 				},
 			},
 		},
+		"spec.catchUpPolicy: Required value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
+		"spec.catchUpPolicy: Unsupported value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     "RunSome",
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
+		"spec.catchUpLimit: Forbidden": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
+				CatchUpLimit:      &positive,
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
+		"spec.catchUpLimit: must be greater than 0": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunAllCatchUp,
+				CatchUpLimit:      &zero,
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
+		"spec.timeZone: must be a time zone name": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
+				TimeZone:          &localTimeZone,
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
+		"spec.timeZone: Invalid value": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mycronjob",
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("1a2b3c"),
+			},
+			Spec: batch.CronJobSpec{
+				Schedule:          "* * * * ?",
+				ConcurrencyPolicy: batch.AllowConcurrent,
+				CatchUpPolicy:     batch.RunLatestCatchUp,
+				TimeZone:          &invalidTimeZone,
+				JobTemplate: batch.JobTemplateSpec{
+					Spec: batch.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+			},
+		},
 	}
 
 	for k, v := range errorCases {
@@ -259,6 +259,16 @@ type KubeletConfiguration struct {
 	// image garbage collection is never run. Lowest disk usage to garbage
 	// collect to.
 	ImageGCLowThresholdPercent int32
+	// imageGCPinnedImages is a list of image IDs, repo tags or repo digests
+	// that are never garbage collected.
+	ImageGCPinnedImages []string
+	// imageGCPinnedImageSelector is a label selector. Images whose labels
+	// match it are never garbage collected.
+	ImageGCPinnedImageSelector string
+	// imageGCMaxUnusedImages is the maximum number of unused images to keep,
+	// regardless of disk usage. Pinned images are not counted. 0 means no
+	// limit.
+	ImageGCMaxUnusedImages int32
 	// lowDiskSpaceThresholdMB is the absolute free disk space, in MB, to
 	// maintain. When disk space falls below this threshold, new pods would
 	// be rejected.
@@ -499,6 +509,18 @@ type KubeletConfiguration struct {
 	// This flag, if set, will avoid including `EvictionHard` limits while computing Node Allocatable.
 	// Refer to [Node Allocatable](https://github.com/kubernetes/community/blob/master/contributors/design-proposals/node-allocatable.md) doc for more information.
 	ExperimentalNodeAllocatableIgnoreEvictionThreshold bool
+
+	/* following flags are meant for dynamic Kubelet configuration */
+
+	// configTrialDuration is the length of the trial period for a dynamic
+	// configuration. If the Kubelet crash-loops during the trial period, the
+	// configuration is marked bad and the Kubelet rolls back to its
+	// last-known-good configuration.
+	ConfigTrialDuration metav1.Duration
+	// crashLoopThreshold is the number of Kubelet restarts during the trial
+	// period of a dynamic configuration after which the configuration is
+	// considered bad.
+	CrashLoopThreshold int32
 }
 
 type KubeletAuthorizationMode string
@@ -835,6 +857,11 @@ type VolumeConfiguration struct {
 	// volumePluginDir is the full path of the directory in which the flex
 	// volume plugin should search for additional third party volume plugins
 	FlexVolumePluginDir string
+	// csiControllerSocketTemplate is the path of the socket of the controller
+	// side of a CSI driver, with %v standing for the driver name. CSI volumes
+	// are not provisioned nor deleted by the controller manager when it is
+	// empty.
+	CSIControllerSocketTemplate string
 }
 
 type PersistentVolumeRecyclerConfiguration struct {
@@ -46,6 +46,8 @@ const (
 
 	defaultIPTablesMasqueradeBit = 14
 	defaultIPTablesDropBit       = 15
+
+	defaultCrashLoopThreshold = 10
 )
 
 var (
@@ -275,6 +277,9 @@ func SetDefaults_KubeletConfiguration(obj *KubeletConfiguration) {
 		temp := int32(80)
 		obj.ImageGCLowThresholdPercent = &temp
 	}
+	if obj.ImageGCMaxUnusedImages == nil {
+		obj.ImageGCMaxUnusedImages = new(int32)
+	}
 	if obj.LowDiskSpaceThresholdMB == 0 {
 		obj.LowDiskSpaceThresholdMB = 256
 	}
@@ -412,6 +417,13 @@ func SetDefaults_KubeletConfiguration(obj *KubeletConfiguration) {
 	if obj.EnableCRI == nil {
 		obj.EnableCRI = boolVar(true)
 	}
+	if obj.ConfigTrialDuration == zeroDuration {
+		obj.ConfigTrialDuration = metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.CrashLoopThreshold == nil {
+		temp := int32(defaultCrashLoopThreshold)
+		obj.CrashLoopThreshold = &temp
+	}
 }
 
 func boolVar(b bool) *bool {
@@ -314,6 +314,16 @@ type KubeletConfiguration struct {
 	// image garbage collection is never run. Lowest disk usage to garbage
 	// collect to. The percent is calculated as this field value out of 100.
 	ImageGCLowThresholdPercent *int32 `json:"imageGCLowThresholdPercent"`
+	// imageGCPinnedImages is a list of image IDs, repo tags or repo digests
+	// that are never garbage collected.
+	ImageGCPinnedImages []string `json:"imageGCPinnedImages,omitempty"`
+	// imageGCPinnedImageSelector is a label selector. Images whose labels
+	// match it are never garbage collected.
+	ImageGCPinnedImageSelector string `json:"imageGCPinnedImageSelector"`
+	// imageGCMaxUnusedImages is the maximum number of unused images to keep,
+	// regardless of disk usage. Pinned images are not counted. 0 means no
+	// limit.
+	ImageGCMaxUnusedImages *int32 `json:"imageGCMaxUnusedImages"`
 	// lowDiskSpaceThresholdMB is the absolute free disk space, in MB, to
 	// maintain. When disk space falls below this threshold, new pods would
 	// be rejected.
@@ -537,6 +547,18 @@ type KubeletConfiguration struct {
 	// This flag, if set, will avoid including `EvictionHard` limits while computing Node Allocatable.
 	// Refer to [Node Allocatable](https://github.com/kubernetes/community/blob/master/contributors/design-proposals/node-allocatable.md) doc for more information.
 	ExperimentalNodeAllocatableIgnoreEvictionThreshold bool `json:"experimentalNodeAllocatableIgnoreEvictionThreshold,omitempty"`
+
+	/* following flags are meant for dynamic Kubelet configuration */
+
+	// configTrialDuration is the length of the trial period for a dynamic
+	// configuration. If the Kubelet crash-loops during the trial period, the
+	// configuration is marked bad and the Kubelet rolls back to its
+	// last-known-good configuration.
+	ConfigTrialDuration metav1.Duration `json:"configTrialDuration"`
+	// crashLoopThreshold is the number of Kubelet restarts during the trial
+	// period of a dynamic configuration after which the configuration is
+	// considered bad.
+	CrashLoopThreshold *int32 `json:"crashLoopThreshold"`
 }
 
 type KubeletAuthorizationMode string
@@ -446,6 +446,12 @@ type DaemonSetSpec struct {
 	// Populated by the system. It can be set only during the creation.
 	// +optional
 	TemplateGeneration int64
+
+	// The number of old history to retain to allow rollback.
+	// This is a pointer to distinguish between explicit zero and not specified.
+	// Defaults to 10.
+	// +optional
+	RevisionHistoryLimit *int32
 }
 
 // DaemonSetStatus represents the current status of a daemon set.
@@ -517,6 +523,11 @@ const (
 	// to daemon set pods to distinguish between old and new pod templates
 	// during DaemonSet template update.
 	DaemonSetTemplateGenerationKey string = "pod-template-generation"
+
+	// DefaultDaemonSetUniqueLabelKey is the default label key that is added
+	// to daemon set pods to identify the ControllerRevision of the daemon set
+	// they were created from.
+	DefaultDaemonSetUniqueLabelKey string = "daemonset-controller-hash"
 )
 
 // DaemonSetList is a collection of daemon sets.
@@ -77,6 +77,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 					UpdateStrategy: DaemonSetUpdateStrategy{
 						Type: OnDeleteDaemonSetStrategyType,
 					},
+					RevisionHistoryLimit: newInt32(10),
 				},
 			},
 		},
@@ -105,6 +106,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 					UpdateStrategy: DaemonSetUpdateStrategy{
 						Type: OnDeleteDaemonSetStrategyType,
 					},
+					RevisionHistoryLimit: newInt32(10),
 				},
 			},
 		},
@@ -116,6 +118,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 					UpdateStrategy: DaemonSetUpdateStrategy{
 						Type: OnDeleteDaemonSetStrategyType,
 					},
+					RevisionHistoryLimit: newInt32(10),
 				},
 			},
 		},
@@ -129,6 +132,7 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 					UpdateStrategy: DaemonSetUpdateStrategy{
 						Type: OnDeleteDaemonSetStrategyType,
 					},
+					RevisionHistoryLimit: newInt32(10),
 				},
 			},
 		},
@@ -142,6 +146,23 @@ func TestSetDefaultDaemonSet(t *testing.T) {
 					UpdateStrategy: DaemonSetUpdateStrategy{
 						Type: OnDeleteDaemonSetStrategyType,
 					},
+					RevisionHistoryLimit: newInt32(10),
+				},
+			},
+		},
+		{ // Revision history limit.
+			original: &DaemonSet{
+				Spec: DaemonSetSpec{
+					RevisionHistoryLimit: newInt32(1),
+				},
+			},
+			expected: &DaemonSet{
+				Spec: DaemonSetSpec{
+					Template: templateNoLabel,
+					UpdateStrategy: DaemonSetUpdateStrategy{
+						Type: OnDeleteDaemonSetStrategyType,
+					},
+					RevisionHistoryLimit: newInt32(1),
 				},
 			},
 		},
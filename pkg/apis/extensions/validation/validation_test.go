@@ -726,6 +726,7 @@ func TestValidateDaemonSet(t *testing.T) {
 		}
 	}
 
+	negativeRevisionHistoryLimit := int32(-1)
 	errorCases := map[string]extensions.DaemonSet{
 		"zero-length ID": {
 			ObjectMeta: metav1.ObjectMeta{Name: "", Namespace: metav1.NamespaceDefault},
@@ -843,6 +844,17 @@ func TestValidateDaemonSet(t *testing.T) {
 				},
 			},
 		},
+		"negative revisionHistoryLimit": {
+			ObjectMeta: metav1.ObjectMeta{Name: "abc-123", Namespace: metav1.NamespaceDefault},
+			Spec: extensions.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: validSelector},
+				Template: validPodTemplate.Template,
+				UpdateStrategy: extensions.DaemonSetUpdateStrategy{
+					Type: extensions.OnDeleteDaemonSetStrategyType,
+				},
+				RevisionHistoryLimit: &negativeRevisionHistoryLimit,
+			},
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateDaemonSet(&v)
@@ -857,6 +869,7 @@ func TestValidateDaemonSet(t *testing.T) {
 				field != "metadata.namespace" &&
 				field != "spec.selector" &&
 				field != "spec.template" &&
+				field != "spec.revisionHistoryLimit" &&
 				field != "GCEPersistentDisk.ReadOnly" &&
 				field != "spec.template.labels" &&
 				field != "metadata.annotations" &&
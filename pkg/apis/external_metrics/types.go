@@ -0,0 +1,56 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external_metrics
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// a list of values for a given metric for some set labels
+type ExternalMetricValueList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// value of the metric matching a given set of labels
+	Items []ExternalMetricValue
+}
+
+// a metric value for external metric
+// A single metric value is identified by metric name and a set of string labels.
+// For one metric there can be multiple values with different sets of labels.
+type ExternalMetricValue struct {
+	metav1.TypeMeta
+
+	// the name of the metric
+	MetricName string
+
+	// a set of labels that identify a single time series for the metric
+	MetricLabels map[string]string
+
+	// indicates the time at which the metrics were produced
+	Timestamp metav1.Time
+
+	// indicates the window ([Timestamp-Window, Timestamp]) from
+	// which these metrics were calculated, when returning rate
+	// metrics calculated from cumulative metrics (or zero for
+	// non-calculated instantaneous metrics).
+	WindowSeconds *int64
+
+	// the value of the metric
+	Value resource.Quantity
+}
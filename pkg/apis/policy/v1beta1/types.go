@@ -32,8 +32,34 @@ type PodDisruptionBudgetSpec struct {
 	// Label query over pods whose evictions are managed by the disruption
 	// budget.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
+
+	// UnhealthyPodEvictionPolicy defines the criteria for when unhealthy pods
+	// should be considered for eviction. Unhealthy pods are running pods which
+	// are not ready.
+	// Valid policies are IfHealthyBudget and AlwaysAllow.
+	// If no policy is specified, the IfHealthyBudget policy is used.
+	// +optional
+	UnhealthyPodEvictionPolicy *UnhealthyPodEvictionPolicyType `json:"unhealthyPodEvictionPolicy,omitempty" protobuf:"bytes,3,opt,name=unhealthyPodEvictionPolicy"`
 }
 
+// UnhealthyPodEvictionPolicyType defines the criteria for when unhealthy pods
+// should be considered for eviction.
+type UnhealthyPodEvictionPolicyType string
+
+const (
+	// IfHealthyBudget policy means that unhealthy pods can be evicted only if
+	// the guarded application is not disrupted, i.e. status.currentHealthy is
+	// at least equal to status.desiredHealthy. Evicting an unhealthy pod does
+	// not consume a disruption. Healthy pods are subject to the budget.
+	IfHealthyBudget UnhealthyPodEvictionPolicyType = "IfHealthyBudget"
+
+	// AlwaysAllow policy means that unhealthy pods can always be evicted,
+	// regardless of whether the budget is met. This prevents drains from
+	// being blocked by misbehaving applications. Healthy pods are subject to
+	// the budget.
+	AlwaysAllow UnhealthyPodEvictionPolicyType = "AlwaysAllow"
+)
+
 // PodDisruptionBudgetStatus represents information about the status of a
 // PodDisruptionBudget. Status may trail the actual state of a system.
 type PodDisruptionBudgetStatus struct {
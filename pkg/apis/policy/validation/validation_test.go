@@ -61,6 +61,30 @@ func TestValidatePodDisruptionBudgetSpec(t *testing.T) {
 	}
 }
 
+func TestValidateUnhealthyPodEvictionPolicy(t *testing.T) {
+	ifHealthyBudget := policy.IfHealthyBudget
+	alwaysAllow := policy.AlwaysAllow
+	invalid := policy.UnhealthyPodEvictionPolicyType("Never")
+	for _, c := range []*policy.UnhealthyPodEvictionPolicyType{nil, &ifHealthyBudget, &alwaysAllow} {
+		spec := policy.PodDisruptionBudgetSpec{
+			MinAvailable:               intstr.FromInt(1),
+			UnhealthyPodEvictionPolicy: c,
+		}
+		if errs := ValidatePodDisruptionBudgetSpec(spec, field.NewPath("foo")); len(errs) != 0 {
+			t.Errorf("unexpected failure %v for %v", errs, spec)
+		}
+	}
+
+	spec := policy.PodDisruptionBudgetSpec{
+		MinAvailable:               intstr.FromInt(1),
+		UnhealthyPodEvictionPolicy: &invalid,
+	}
+	errs := ValidatePodDisruptionBudgetSpec(spec, field.NewPath("foo"))
+	if len(errs) != 1 || errs[0].Type != field.ErrorTypeNotSupported || errs[0].Field != "foo.unhealthyPodEvictionPolicy" {
+		t.Errorf("expected a single not supported error for foo.unhealthyPodEvictionPolicy, got %v", errs)
+	}
+}
+
 func TestValidatePodDisruptionBudgetStatus(t *testing.T) {
 	successCases := []policy.PodDisruptionBudgetStatus{
 		{PodDisruptionsAllowed: 10},
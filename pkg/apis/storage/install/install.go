@@ -41,7 +41,7 @@ func Install(groupFactoryRegistry announced.APIGroupFactoryRegistry, registry *r
 			// TODO:  change the order when GKE supports v1
 			VersionPreferenceOrder:     []string{v1beta1.SchemeGroupVersion.Version, v1.SchemeGroupVersion.Version},
 			ImportPrefix:               "k8s.io/kubernetes/pkg/apis/storage",
-			RootScopedKinds:            sets.NewString("StorageClass"),
+			RootScopedKinds:            sets.NewString("StorageClass", "VolumeAttachment", "VolumeSnapshotContent"),
 			AddInternalObjectsToScheme: storage.AddToScheme,
 		},
 		announced.VersionToSchemeFunc{
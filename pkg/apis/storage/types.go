@@ -16,7 +16,10 @@ limitations under the License.
 
 package storage
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api"
+)
 
 // +genclient=true
 // +nonNamespaced=true
@@ -45,6 +48,20 @@ type StorageClass struct {
 	// 512, with a cumulative max size of 256K
 	// +optional
 	Parameters map[string]string
+
+	// VolumeBindingMode indicates how PersistentVolumeClaims should be
+	// provisioned and bound.  When unset, VolumeBindingImmediate is used.
+	// This field is alpha-level and is only honored by servers that enable
+	// the VolumeScheduling feature.
+	// +optional
+	VolumeBindingMode *VolumeBindingMode
+
+	// AllowVolumeExpansion indicates whether claims of this class may request
+	// a larger size after they are bound.  When unset, expansion is not allowed.
+	// This field is alpha-level and is only honored by servers that enable
+	// the ExpandPersistentVolumes feature.
+	// +optional
+	AllowVolumeExpansion *bool
 }
 
 // StorageClassList is a collection of storage classes.
@@ -58,3 +75,229 @@ type StorageClassList struct {
 	// Items is the list of StorageClasses
 	Items []StorageClass
 }
+
+// VolumeBindingMode indicates how PersistentVolumeClaims should be bound.
+type VolumeBindingMode string
+
+const (
+	// VolumeBindingImmediate indicates that PersistentVolumeClaims should be
+	// immediately provisioned and bound.  This is the default mode.
+	VolumeBindingImmediate VolumeBindingMode = "Immediate"
+
+	// VolumeBindingWaitForFirstConsumer indicates that PersistentVolumeClaims
+	// should not be provisioned and bound until the first Pod is created that
+	// references the PersistentVolumeClaim.  The volume provisioning and
+	// binding will occur during Pod scheduling.
+	VolumeBindingWaitForFirstConsumer VolumeBindingMode = "WaitForFirstConsumer"
+)
+
+// +genclient=true
+// +nonNamespaced=true
+
+// VolumeAttachment captures the intent to attach or detach the specified volume
+// to/from the specified node.
+//
+// VolumeAttachment objects are non-namespaced. They are created by the
+// attach/detach controller and acted on by the external attacher of the volume
+// driver named in the spec.
+type VolumeAttachment struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Specification of the desired attach/detach volume behavior.
+	// Populated by the Kubernetes system.
+	Spec VolumeAttachmentSpec
+
+	// Status of the VolumeAttachment request.
+	// Populated by the entity completing the attach or detach
+	// operation, i.e. the external-attacher.
+	// +optional
+	Status VolumeAttachmentStatus
+}
+
+// VolumeAttachmentList is a collection of VolumeAttachment objects.
+type VolumeAttachmentList struct {
+	metav1.TypeMeta
+	// Standard list metadata
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta
+
+	// Items is the list of VolumeAttachments
+	Items []VolumeAttachment
+}
+
+// VolumeAttachmentSpec is the specification of a VolumeAttachment request.
+type VolumeAttachmentSpec struct {
+	// Attacher indicates the name of the volume driver that MUST handle this
+	// request. This is the name returned by the driver's GetPluginInfo call.
+	Attacher string
+
+	// Source represents the volume that should be attached.
+	Source VolumeAttachmentSource
+
+	// The node that the volume should be attached to.
+	NodeName string
+}
+
+// VolumeAttachmentSource represents a volume that should be attached.
+// Right now only PersistentVolumes can be attached via an external attacher,
+// in future we may allow also inline volumes in pods.
+// Exactly one member can be set.
+type VolumeAttachmentSource struct {
+	// Name of the persistent volume to attach.
+	// +optional
+	PersistentVolumeName *string
+}
+
+// VolumeAttachmentStatus is the status of a VolumeAttachment request.
+type VolumeAttachmentStatus struct {
+	// Indicates the volume is successfully attached.
+	// This field must only be set by the entity completing the attach
+	// operation, i.e. the external-attacher.
+	Attached bool
+
+	// Upon successful attach, this field is populated with any
+	// information returned by the attach operation that must be passed
+	// into subsequent WaitForAttach or Mount calls.
+	// This field must only be set by the entity completing the attach
+	// operation, i.e. the external-attacher.
+	// +optional
+	AttachmentMetadata map[string]string
+
+	// The last error encountered during attach operation, if any.
+	// This field must only be set by the entity completing the attach
+	// operation, i.e. the external-attacher.
+	// +optional
+	AttachError *VolumeError
+
+	// The last error encountered during detach operation, if any.
+	// This field must only be set by the entity completing the detach
+	// operation, i.e. the external-attacher.
+	// +optional
+	DetachError *VolumeError
+}
+
+// VolumeError captures an error encountered during a volume operation.
+type VolumeError struct {
+	// Time the error was encountered.
+	// +optional
+	Time metav1.Time
+
+	// String detailing the error encountered during Attach or Detach operation.
+	// This string may be logged, so it should not contain sensitive
+	// information.
+	// +optional
+	Message string
+}
+
+// +genclient=true
+
+// VolumeSnapshot is a user's request for taking a point-in-time snapshot of
+// the volume bound to a PersistentVolumeClaim.  The snapshot controller
+// takes the snapshot through the volume plugin of the claim's volume and
+// records it in a VolumeSnapshotContent object.
+type VolumeSnapshot struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the desired characteristics of the snapshot.
+	Spec VolumeSnapshotSpec
+
+	// Status represents the current information about the snapshot.
+	// Populated by the snapshot controller.
+	// +optional
+	Status VolumeSnapshotStatus
+}
+
+// VolumeSnapshotList is a collection of VolumeSnapshot objects.
+type VolumeSnapshotList struct {
+	metav1.TypeMeta
+	// Standard list metadata
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta
+
+	// Items is the list of VolumeSnapshots
+	Items []VolumeSnapshot
+}
+
+// VolumeSnapshotSpec is the specification of a VolumeSnapshot request.
+type VolumeSnapshotSpec struct {
+	// PersistentVolumeClaimName is the name of the claim, in the same
+	// namespace as the snapshot, whose volume should be snapshotted.
+	PersistentVolumeClaimName string
+
+	// SnapshotContentName is the name of the VolumeSnapshotContent object
+	// that holds the taken snapshot.  It is set by the snapshot controller
+	// once the snapshot is taken and can not be changed afterwards.
+	// +optional
+	SnapshotContentName string
+}
+
+// VolumeSnapshotStatus is the status of a VolumeSnapshot request.
+type VolumeSnapshotStatus struct {
+	// CreationTime is the time the snapshot was taken.
+	// +optional
+	CreationTime *metav1.Time
+
+	// Ready indicates that the snapshot was taken and can be used to
+	// restore a new volume.
+	Ready bool
+
+	// The last error encountered while taking the snapshot, if any.
+	// +optional
+	Error *VolumeError
+}
+
+// +genclient=true
+// +nonNamespaced=true
+
+// VolumeSnapshotContent represents a snapshot taken by a volume plugin.
+//
+// VolumeSnapshotContent objects are non-namespaced. They are created by the
+// snapshot controller for the VolumeSnapshot they are bound to and deleted,
+// together with the snapshot in the storage backend, once that VolumeSnapshot
+// is deleted.
+type VolumeSnapshotContent struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec describes the snapshot held by this object.
+	Spec VolumeSnapshotContentSpec
+}
+
+// VolumeSnapshotContentList is a collection of VolumeSnapshotContent objects.
+type VolumeSnapshotContentList struct {
+	metav1.TypeMeta
+	// Standard list metadata
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta
+
+	// Items is the list of VolumeSnapshotContents
+	Items []VolumeSnapshotContent
+}
+
+// VolumeSnapshotContentSpec is the specification of a VolumeSnapshotContent.
+type VolumeSnapshotContentSpec struct {
+	// Snapshotter is the name of the volume plugin that took the snapshot,
+	// e.g. "kubernetes.io/gce-pd".  Only this plugin can restore or delete it.
+	Snapshotter string
+
+	// SnapshotHandle is the identifier of the snapshot returned by the
+	// volume plugin, opaque to the rest of the system.
+	SnapshotHandle string
+
+	// PersistentVolumeName is the name of the volume the snapshot was
+	// taken from.
+	// +optional
+	PersistentVolumeName string
+
+	// VolumeSnapshotRef is the VolumeSnapshot this content is bound to.
+	// +optional
+	VolumeSnapshotRef *api.ObjectReference
+}
@@ -20,10 +20,13 @@ import (
 	"reflect"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	apivalidation "k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/apis/storage"
+	"k8s.io/kubernetes/pkg/features"
 )
 
 // ValidateStorageClass validates a StorageClass.
@@ -31,6 +34,8 @@ func ValidateStorageClass(storageClass *storage.StorageClass) field.ErrorList {
 	allErrs := apivalidation.ValidateObjectMeta(&storageClass.ObjectMeta, false, apivalidation.ValidateClassName, field.NewPath("metadata"))
 	allErrs = append(allErrs, validateProvisioner(storageClass.Provisioner, field.NewPath("provisioner"))...)
 	allErrs = append(allErrs, validateParameters(storageClass.Parameters, field.NewPath("parameters"))...)
+	allErrs = append(allErrs, validateVolumeBindingMode(storageClass.VolumeBindingMode, field.NewPath("volumeBindingMode"))...)
+	allErrs = append(allErrs, validateAllowVolumeExpansion(storageClass.AllowVolumeExpansion, field.NewPath("allowVolumeExpansion"))...)
 
 	return allErrs
 }
@@ -45,6 +50,10 @@ func ValidateStorageClassUpdate(storageClass, oldStorageClass *storage.StorageCl
 	if strings.Compare(storageClass.Provisioner, oldStorageClass.Provisioner) != 0 {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("provisioner"), "updates to provisioner are forbidden."))
 	}
+
+	if !reflect.DeepEqual(storageClass.VolumeBindingMode, oldStorageClass.VolumeBindingMode) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("volumeBindingMode"), "updates to volumeBindingMode are forbidden."))
+	}
 	return allErrs
 }
 
@@ -87,3 +96,207 @@ func validateParameters(params map[string]string, fldPath *field.Path) field.Err
 	}
 	return allErrs
 }
+
+// ValidateVolumeAttachment validates a VolumeAttachment.
+func ValidateVolumeAttachment(volumeAttachment *storage.VolumeAttachment) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMeta(&volumeAttachment.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateVolumeAttachmentSpec(&volumeAttachment.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateVolumeAttachmentStatus(&volumeAttachment.Status, field.NewPath("status"))...)
+	return allErrs
+}
+
+// ValidateVolumeAttachmentUpdate tests if an update to VolumeAttachment is valid.
+func ValidateVolumeAttachmentUpdate(newVolumeAttachment, oldVolumeAttachment *storage.VolumeAttachment) field.ErrorList {
+	allErrs := ValidateVolumeAttachment(newVolumeAttachment)
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newVolumeAttachment.ObjectMeta, &oldVolumeAttachment.ObjectMeta, field.NewPath("metadata"))...)
+	// The spec of a VolumeAttachment is immutable.
+	if !reflect.DeepEqual(newVolumeAttachment.Spec, oldVolumeAttachment.Spec) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), newVolumeAttachment.Spec, "field is immutable"))
+	}
+	return allErrs
+}
+
+// validateVolumeAttachmentSpec tests that the specified VolumeAttachmentSpec
+// has valid data.
+func validateVolumeAttachmentSpec(spec *storage.VolumeAttachmentSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := validateAttacher(spec.Attacher, fldPath.Child("attacher"))
+	allErrs = append(allErrs, validateVolumeAttachmentSource(&spec.Source, fldPath.Child("source"))...)
+	allErrs = append(allErrs, validateNodeName(spec.NodeName, fldPath.Child("nodeName"))...)
+	return allErrs
+}
+
+// validateAttacher tests if attacher is a valid driver name.
+func validateAttacher(attacher string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(attacher) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, attacher))
+	}
+	for _, msg := range validation.IsDNS1123Subdomain(attacher) {
+		allErrs = append(allErrs, field.Invalid(fldPath, attacher, msg))
+	}
+	return allErrs
+}
+
+// validateVolumeAttachmentSource tests if the source is valid for VolumeAttachment.
+func validateVolumeAttachmentSource(source *storage.VolumeAttachmentSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if source.PersistentVolumeName == nil || len(*source.PersistentVolumeName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("persistentVolumeName"), ""))
+	}
+	return allErrs
+}
+
+// validateNodeName tests if the nodeName is valid for VolumeAttachment.
+func validateNodeName(nodeName string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, msg := range apivalidation.ValidateNodeName(nodeName, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath, nodeName, msg))
+	}
+	return allErrs
+}
+
+// validateVolumeAttachmentStatus tests if volumeAttachmentStatus is valid.
+func validateVolumeAttachmentStatus(status *storage.VolumeAttachmentStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateAttachmentMetadata(status.AttachmentMetadata, fldPath.Child("attachmentMetadata"))...)
+	allErrs = append(allErrs, validateVolumeError(status.AttachError, fldPath.Child("attachError"))...)
+	allErrs = append(allErrs, validateVolumeError(status.DetachError, fldPath.Child("detachError"))...)
+	return allErrs
+}
+
+const maxAttachedVolumeMetadataSize = 256 * (1 << 10) // 256 kB
+const maxVolumeErrorMessageSize = 1024
+
+func validateAttachmentMetadata(metadata map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var size int64
+	for k, v := range metadata {
+		size += (int64)(len(k)) + (int64)(len(v))
+	}
+	if size > maxAttachedVolumeMetadataSize {
+		allErrs = append(allErrs, field.TooLong(fldPath, metadata, maxAttachedVolumeMetadataSize))
+	}
+	return allErrs
+}
+
+func validateVolumeError(e *storage.VolumeError, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if e == nil {
+		return allErrs
+	}
+	if len(e.Message) > maxVolumeErrorMessageSize {
+		allErrs = append(allErrs, field.TooLong(fldPath.Child("message"), e.Message, maxVolumeErrorMessageSize))
+	}
+	return allErrs
+}
+
+var supportedVolumeBindingModes = sets.NewString(string(storage.VolumeBindingImmediate), string(storage.VolumeBindingWaitForFirstConsumer))
+
+// validateVolumeBindingMode tests that VolumeBindingMode specifies valid values.
+func validateVolumeBindingMode(mode *storage.VolumeBindingMode, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if utilfeature.DefaultFeatureGate.Enabled(features.VolumeScheduling) {
+		if mode == nil {
+			allErrs = append(allErrs, field.Required(fldPath, ""))
+		} else if !supportedVolumeBindingModes.Has(string(*mode)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath, mode, supportedVolumeBindingModes.List()))
+		}
+	} else if mode != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "field is disabled by feature-gate VolumeScheduling"))
+	}
+
+	return allErrs
+}
+
+// validateAllowVolumeExpansion tests that AllowVolumeExpansion is only set
+// when the ExpandPersistentVolumes feature is enabled.
+func validateAllowVolumeExpansion(allowExpand *bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if allowExpand != nil && !utilfeature.DefaultFeatureGate.Enabled(features.ExpandPersistentVolumes) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "field is disabled by feature-gate ExpandPersistentVolumes"))
+	}
+	return allErrs
+}
+
+// ValidateVolumeSnapshot validates a VolumeSnapshot.
+func ValidateVolumeSnapshot(snapshot *storage.VolumeSnapshot) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMeta(&snapshot.ObjectMeta, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateVolumeSnapshotSpec(&snapshot.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateVolumeError(snapshot.Status.Error, field.NewPath("status", "error"))...)
+	return allErrs
+}
+
+// ValidateVolumeSnapshotUpdate tests if an update to VolumeSnapshot is valid.
+func ValidateVolumeSnapshotUpdate(newSnapshot, oldSnapshot *storage.VolumeSnapshot) field.ErrorList {
+	allErrs := ValidateVolumeSnapshot(newSnapshot)
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newSnapshot.ObjectMeta, &oldSnapshot.ObjectMeta, field.NewPath("metadata"))...)
+	specPath := field.NewPath("spec")
+	if newSnapshot.Spec.PersistentVolumeClaimName != oldSnapshot.Spec.PersistentVolumeClaimName {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("persistentVolumeClaimName"), newSnapshot.Spec.PersistentVolumeClaimName, "field is immutable"))
+	}
+	// The snapshot controller binds the snapshot to its content only once.
+	if len(oldSnapshot.Spec.SnapshotContentName) > 0 && newSnapshot.Spec.SnapshotContentName != oldSnapshot.Spec.SnapshotContentName {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("snapshotContentName"), newSnapshot.Spec.SnapshotContentName, "field is immutable once set"))
+	}
+	return allErrs
+}
+
+// validateVolumeSnapshotSpec tests that the specified VolumeSnapshotSpec
+// has valid data.
+func validateVolumeSnapshotSpec(spec *storage.VolumeSnapshotSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(spec.PersistentVolumeClaimName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("persistentVolumeClaimName"), ""))
+	} else {
+		for _, msg := range apivalidation.ValidatePersistentVolumeName(spec.PersistentVolumeClaimName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("persistentVolumeClaimName"), spec.PersistentVolumeClaimName, msg))
+		}
+	}
+	if len(spec.SnapshotContentName) > 0 {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(spec.SnapshotContentName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("snapshotContentName"), spec.SnapshotContentName, msg))
+		}
+	}
+	return allErrs
+}
+
+// ValidateVolumeSnapshotContent validates a VolumeSnapshotContent.
+func ValidateVolumeSnapshotContent(content *storage.VolumeSnapshotContent) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMeta(&content.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateVolumeSnapshotContentSpec(&content.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateVolumeSnapshotContentUpdate tests if an update to VolumeSnapshotContent is valid.
+func ValidateVolumeSnapshotContentUpdate(newContent, oldContent *storage.VolumeSnapshotContent) field.ErrorList {
+	allErrs := ValidateVolumeSnapshotContent(newContent)
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newContent.ObjectMeta, &oldContent.ObjectMeta, field.NewPath("metadata"))...)
+	// The spec of a VolumeSnapshotContent is immutable.
+	if !reflect.DeepEqual(newContent.Spec, oldContent.Spec) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), newContent.Spec, "field is immutable"))
+	}
+	return allErrs
+}
+
+// validateVolumeSnapshotContentSpec tests that the specified
+// VolumeSnapshotContentSpec has valid data.
+func validateVolumeSnapshotContentSpec(spec *storage.VolumeSnapshotContentSpec, fldPath *field.Path) field.ErrorList {
+	// Snapshotters are volume plugin names, which follow the same rules as
+	// provisioner names.
+	allErrs := validateProvisioner(spec.Snapshotter, fldPath.Child("snapshotter"))
+	if len(spec.SnapshotHandle) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("snapshotHandle"), ""))
+	}
+	if ref := spec.VolumeSnapshotRef; ref != nil {
+		refPath := fldPath.Child("volumeSnapshotRef")
+		if len(ref.Name) == 0 {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), ""))
+		}
+		if len(ref.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(refPath.Child("namespace"), ""))
+		}
+	}
+	return allErrs
+}
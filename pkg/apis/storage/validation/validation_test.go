@@ -18,9 +18,12 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/apis/storage"
 )
 
@@ -100,3 +103,437 @@ func TestValidateStorageClass(t *testing.T) {
 		}
 	}
 }
+
+func makeClassWithBinding(mode *storage.VolumeBindingMode) *storage.StorageClass {
+	return &storage.StorageClass{
+		ObjectMeta:        metav1.ObjectMeta{Name: "foo", ResourceVersion: "foo"},
+		Provisioner:       "kubernetes.io/foo-provisioner",
+		VolumeBindingMode: mode,
+	}
+}
+
+func TestValidateVolumeBindingMode(t *testing.T) {
+	immediateMode := storage.VolumeBindingImmediate
+	waitMode := storage.VolumeBindingWaitForFirstConsumer
+	invalidMode := storage.VolumeBindingMode("foo")
+
+	cases := map[string]struct {
+		class             *storage.StorageClass
+		shouldSucceed     bool
+		shouldSucceedGate bool
+	}{
+		"no mode": {
+			class:             makeClassWithBinding(nil),
+			shouldSucceed:     true,
+			shouldSucceedGate: false,
+		},
+		"immediate mode": {
+			class:             makeClassWithBinding(&immediateMode),
+			shouldSucceed:     false,
+			shouldSucceedGate: true,
+		},
+		"wait for first consumer mode": {
+			class:             makeClassWithBinding(&waitMode),
+			shouldSucceed:     false,
+			shouldSucceedGate: true,
+		},
+		"invalid mode": {
+			class:             makeClassWithBinding(&invalidMode),
+			shouldSucceed:     false,
+			shouldSucceedGate: false,
+		},
+	}
+
+	for testName, testCase := range cases {
+		errs := ValidateStorageClass(testCase.class)
+		if testCase.shouldSucceed && len(errs) != 0 {
+			t.Errorf("Expected success for test %q, got %v", testName, errs)
+		}
+		if !testCase.shouldSucceed && len(errs) == 0 {
+			t.Errorf("Expected failure for test %q, got success", testName)
+		}
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("VolumeScheduling=true"); err != nil {
+		t.Fatalf("Failed to enable feature gate for VolumeScheduling: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set("VolumeScheduling=false")
+	for testName, testCase := range cases {
+		errs := ValidateStorageClass(testCase.class)
+		if testCase.shouldSucceedGate && len(errs) != 0 {
+			t.Errorf("Expected success for test %q with the feature gate enabled, got %v", testName, errs)
+		}
+		if !testCase.shouldSucceedGate && len(errs) == 0 {
+			t.Errorf("Expected failure for test %q with the feature gate enabled, got success", testName)
+		}
+	}
+
+	// The binding mode of a class may not be changed.
+	if errs := ValidateStorageClassUpdate(makeClassWithBinding(&waitMode), makeClassWithBinding(&immediateMode)); len(errs) == 0 {
+		t.Errorf("Expected failure when updating the volume binding mode")
+	}
+}
+
+func TestValidateAllowVolumeExpansion(t *testing.T) {
+	allowExpand := true
+	class := &storage.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: "foo"},
+		Provisioner:          "kubernetes.io/foo-provisioner",
+		AllowVolumeExpansion: &allowExpand,
+	}
+
+	if errs := ValidateStorageClass(class); len(errs) == 0 {
+		t.Errorf("Expected failure when allowVolumeExpansion is set with the feature gate disabled")
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("ExpandPersistentVolumes=true"); err != nil {
+		t.Fatalf("Failed to enable feature gate for ExpandPersistentVolumes: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set("ExpandPersistentVolumes=false")
+	if errs := ValidateStorageClass(class); len(errs) != 0 {
+		t.Errorf("Expected success when allowVolumeExpansion is set with the feature gate enabled, got %v", errs)
+	}
+}
+
+func TestVolumeAttachmentValidation(t *testing.T) {
+	volumeName := "pv-name"
+	empty := ""
+	successCases := []storage.VolumeAttachment{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "myattacher",
+				Source: storage.VolumeAttachmentSource{
+					PersistentVolumeName: &volumeName,
+				},
+				NodeName: "mynode",
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "com.example.csi",
+				Source: storage.VolumeAttachmentSource{
+					PersistentVolumeName: &volumeName,
+				},
+				NodeName: "mynode",
+			},
+			Status: storage.VolumeAttachmentStatus{
+				Attached: true,
+				AttachmentMetadata: map[string]string{
+					"foo": "bar",
+				},
+				AttachError: &storage.VolumeError{
+					Time:    metav1.Time{},
+					Message: "hello world",
+				},
+			},
+		},
+	}
+
+	for _, volumeAttachment := range successCases {
+		if errs := ValidateVolumeAttachment(&volumeAttachment); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]storage.VolumeAttachment{
+		"namespace is present": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "myattacher",
+				Source: storage.VolumeAttachmentSource{
+					PersistentVolumeName: &volumeName,
+				},
+				NodeName: "mynode",
+			},
+		},
+		"missing attacher": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeAttachmentSpec{
+				Source: storage.VolumeAttachmentSource{
+					PersistentVolumeName: &volumeName,
+				},
+				NodeName: "mynode",
+			},
+		},
+		"invalid attacher": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "Invalid_Attacher",
+				Source: storage.VolumeAttachmentSource{
+					PersistentVolumeName: &volumeName,
+				},
+				NodeName: "mynode",
+			},
+		},
+		"missing volume": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "myattacher",
+				NodeName: "mynode",
+			},
+		},
+		"empty volume name": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "myattacher",
+				Source: storage.VolumeAttachmentSource{
+					PersistentVolumeName: &empty,
+				},
+				NodeName: "mynode",
+			},
+		},
+		"invalid node name": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "myattacher",
+				Source: storage.VolumeAttachmentSource{
+					PersistentVolumeName: &volumeName,
+				},
+				NodeName: "Invalid_Node",
+			},
+		},
+		"too long error message": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeAttachmentSpec{
+				Attacher: "myattacher",
+				Source: storage.VolumeAttachmentSource{
+					PersistentVolumeName: &volumeName,
+				},
+				NodeName: "mynode",
+			},
+			Status: storage.VolumeAttachmentStatus{
+				DetachError: &storage.VolumeError{
+					Message: strings.Repeat("a", maxVolumeErrorMessageSize+1),
+				},
+			},
+		},
+	}
+
+	for k, volumeAttachment := range errorCases {
+		if errs := ValidateVolumeAttachment(&volumeAttachment); len(errs) == 0 {
+			t.Errorf("expected failure for test: %s", k)
+		}
+	}
+}
+
+func TestVolumeAttachmentUpdateValidation(t *testing.T) {
+	volumeName := "foo"
+	newVolumeName := "bar"
+	old := storage.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", ResourceVersion: "1"},
+		Spec: storage.VolumeAttachmentSpec{
+			Attacher: "myattacher",
+			Source: storage.VolumeAttachmentSource{
+				PersistentVolumeName: &volumeName,
+			},
+			NodeName: "mynode",
+		},
+	}
+
+	// Status may change.
+	statusChanged := old
+	statusChanged.Status = storage.VolumeAttachmentStatus{
+		Attached:           true,
+		AttachmentMetadata: map[string]string{"foo": "bar"},
+	}
+	if errs := ValidateVolumeAttachmentUpdate(&statusChanged, &old); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string]storage.VolumeAttachment{}
+	attacherChanged := old
+	attacherChanged.Spec.Attacher = "otherattacher"
+	errorCases["changed attacher"] = attacherChanged
+	volumeChanged := old
+	volumeChanged.Spec.Source = storage.VolumeAttachmentSource{PersistentVolumeName: &newVolumeName}
+	errorCases["changed volume"] = volumeChanged
+	nodeChanged := old
+	nodeChanged.Spec.NodeName = "othernode"
+	errorCases["changed node"] = nodeChanged
+
+	for k, volumeAttachment := range errorCases {
+		if errs := ValidateVolumeAttachmentUpdate(&volumeAttachment, &old); len(errs) == 0 {
+			t.Errorf("expected failure for test: %s", k)
+		}
+	}
+}
+
+func TestVolumeSnapshotValidation(t *testing.T) {
+	successCases := []storage.VolumeSnapshot{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec: storage.VolumeSnapshotSpec{
+				PersistentVolumeClaimName: "myclaim",
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec: storage.VolumeSnapshotSpec{
+				PersistentVolumeClaimName: "myclaim",
+				SnapshotContentName:       "snapcontent-1234",
+			},
+			Status: storage.VolumeSnapshotStatus{
+				CreationTime: &metav1.Time{},
+				Ready:        true,
+			},
+		},
+	}
+
+	for _, snapshot := range successCases {
+		if errs := ValidateVolumeSnapshot(&snapshot); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]storage.VolumeSnapshot{
+		"namespace is missing": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeSnapshotSpec{
+				PersistentVolumeClaimName: "myclaim",
+			},
+		},
+		"missing claim name": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		},
+		"invalid claim name": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec: storage.VolumeSnapshotSpec{
+				PersistentVolumeClaimName: "Invalid_Claim",
+			},
+		},
+		"invalid content name": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec: storage.VolumeSnapshotSpec{
+				PersistentVolumeClaimName: "myclaim",
+				SnapshotContentName:       "Invalid_Content",
+			},
+		},
+		"too long error message": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec: storage.VolumeSnapshotSpec{
+				PersistentVolumeClaimName: "myclaim",
+			},
+			Status: storage.VolumeSnapshotStatus{
+				Error: &storage.VolumeError{
+					Message: strings.Repeat("a", maxVolumeErrorMessageSize+1),
+				},
+			},
+		},
+	}
+
+	for k, snapshot := range errorCases {
+		if errs := ValidateVolumeSnapshot(&snapshot); len(errs) == 0 {
+			t.Errorf("expected failure for test: %s", k)
+		}
+	}
+}
+
+func TestVolumeSnapshotUpdateValidation(t *testing.T) {
+	old := storage.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", ResourceVersion: "1"},
+		Spec: storage.VolumeSnapshotSpec{
+			PersistentVolumeClaimName: "myclaim",
+		},
+	}
+
+	// The content may be bound once.
+	bound := old
+	bound.Spec.SnapshotContentName = "snapcontent-1234"
+	if errs := ValidateVolumeSnapshotUpdate(&bound, &old); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string]storage.VolumeSnapshot{}
+	claimChanged := old
+	claimChanged.Spec.PersistentVolumeClaimName = "otherclaim"
+	errorCases["changed claim"] = claimChanged
+	contentChanged := bound
+	contentChanged.Spec.SnapshotContentName = "snapcontent-5678"
+	errorCases["changed content"] = contentChanged
+
+	for k, snapshot := range errorCases {
+		if errs := ValidateVolumeSnapshotUpdate(&snapshot, &bound); len(errs) == 0 {
+			t.Errorf("expected failure for test: %s", k)
+		}
+	}
+}
+
+func TestVolumeSnapshotContentValidation(t *testing.T) {
+	successCases := []storage.VolumeSnapshotContent{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeSnapshotContentSpec{
+				Snapshotter:    "kubernetes.io/host-path",
+				SnapshotHandle: "/tmp/snapshot",
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeSnapshotContentSpec{
+				Snapshotter:          "kubernetes.io/gce-pd",
+				SnapshotHandle:       "snapshot-1234",
+				PersistentVolumeName: "pv-name",
+				VolumeSnapshotRef:    &api.ObjectReference{Namespace: "default", Name: "foo"},
+			},
+		},
+	}
+
+	for _, content := range successCases {
+		if errs := ValidateVolumeSnapshotContent(&content); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]storage.VolumeSnapshotContent{
+		"namespace is present": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+			Spec: storage.VolumeSnapshotContentSpec{
+				Snapshotter:    "kubernetes.io/host-path",
+				SnapshotHandle: "/tmp/snapshot",
+			},
+		},
+		"missing snapshotter": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeSnapshotContentSpec{
+				SnapshotHandle: "/tmp/snapshot",
+			},
+		},
+		"invalid snapshotter": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeSnapshotContentSpec{
+				Snapshotter:    "kubernetes.io/invalid/snapshotter",
+				SnapshotHandle: "/tmp/snapshot",
+			},
+		},
+		"missing handle": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeSnapshotContentSpec{
+				Snapshotter: "kubernetes.io/host-path",
+			},
+		},
+		"incomplete snapshot reference": {
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: storage.VolumeSnapshotContentSpec{
+				Snapshotter:       "kubernetes.io/host-path",
+				SnapshotHandle:    "/tmp/snapshot",
+				VolumeSnapshotRef: &api.ObjectReference{Name: "foo"},
+			},
+		},
+	}
+
+	for k, content := range errorCases {
+		if errs := ValidateVolumeSnapshotContent(&content); len(errs) == 0 {
+			t.Errorf("expected failure for test: %s", k)
+		}
+	}
+
+	old := successCases[0]
+	old.ResourceVersion = "1"
+	handleChanged := old
+	handleChanged.Spec.SnapshotHandle = "/tmp/other"
+	if errs := ValidateVolumeSnapshotContentUpdate(&handleChanged, &old); len(errs) == 0 {
+		t.Errorf("expected failure for changed handle")
+	}
+}
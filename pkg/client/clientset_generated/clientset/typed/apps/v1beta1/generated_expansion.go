@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1beta1
 
+type ControllerRevisionExpansion interface{}
+
 type DeploymentExpansion interface{}
 
 type ScaleExpansion interface{}
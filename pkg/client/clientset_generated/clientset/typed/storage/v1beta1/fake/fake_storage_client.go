@@ -30,6 +30,18 @@ func (c *FakeStorageV1beta1) StorageClasses() v1beta1.StorageClassInterface {
 	return &FakeStorageClasses{c}
 }
 
+func (c *FakeStorageV1beta1) VolumeAttachments() v1beta1.VolumeAttachmentInterface {
+	return &FakeVolumeAttachments{c}
+}
+
+func (c *FakeStorageV1beta1) VolumeSnapshots(namespace string) v1beta1.VolumeSnapshotInterface {
+	return &FakeVolumeSnapshots{c, namespace}
+}
+
+func (c *FakeStorageV1beta1) VolumeSnapshotContents() v1beta1.VolumeSnapshotContentInterface {
+	return &FakeVolumeSnapshotContents{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeStorageV1beta1) RESTClient() rest.Interface {
@@ -26,6 +26,9 @@ import (
 type StorageV1beta1Interface interface {
 	RESTClient() rest.Interface
 	StorageClassesGetter
+	VolumeAttachmentsGetter
+	VolumeSnapshotsGetter
+	VolumeSnapshotContentsGetter
 }
 
 // StorageV1beta1Client is used to interact with features provided by the storage.k8s.io group.
@@ -37,6 +40,18 @@ func (c *StorageV1beta1Client) StorageClasses() StorageClassInterface {
 	return newStorageClasses(c)
 }
 
+func (c *StorageV1beta1Client) VolumeAttachments() VolumeAttachmentInterface {
+	return newVolumeAttachments(c)
+}
+
+func (c *StorageV1beta1Client) VolumeSnapshots(namespace string) VolumeSnapshotInterface {
+	return newVolumeSnapshots(c, namespace)
+}
+
+func (c *StorageV1beta1Client) VolumeSnapshotContents() VolumeSnapshotContentInterface {
+	return newVolumeSnapshotContents(c)
+}
+
 // NewForConfig creates a new StorageV1beta1Client for the given config.
 func NewForConfig(c *rest.Config) (*StorageV1beta1Client, error) {
 	config := *c
@@ -23,6 +23,7 @@ import (
 
 type AppsInterface interface {
 	RESTClient() rest.Interface
+	ControllerRevisionsGetter
 	StatefulSetsGetter
 }
 
@@ -31,6 +32,10 @@ type AppsClient struct {
 	restClient rest.Interface
 }
 
+func (c *AppsClient) ControllerRevisions(namespace string) ControllerRevisionInterface {
+	return newControllerRevisions(c, namespace)
+}
+
 func (c *AppsClient) StatefulSets(namespace string) StatefulSetInterface {
 	return newStatefulSets(c, namespace)
 }
@@ -30,6 +30,18 @@ func (c *FakeStorage) StorageClasses() internalversion.StorageClassInterface {
 	return &FakeStorageClasses{c}
 }
 
+func (c *FakeStorage) VolumeAttachments() internalversion.VolumeAttachmentInterface {
+	return &FakeVolumeAttachments{c}
+}
+
+func (c *FakeStorage) VolumeSnapshots(namespace string) internalversion.VolumeSnapshotInterface {
+	return &FakeVolumeSnapshots{c, namespace}
+}
+
+func (c *FakeStorage) VolumeSnapshotContents() internalversion.VolumeSnapshotContentInterface {
+	return &FakeVolumeSnapshotContents{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeStorage) RESTClient() rest.Interface {
@@ -0,0 +1,110 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	storage "k8s.io/kubernetes/pkg/apis/storage"
+)
+
+// FakeVolumeSnapshotContents implements VolumeSnapshotContentInterface
+type FakeVolumeSnapshotContents struct {
+	Fake *FakeStorage
+}
+
+var volumesnapshotcontentsResource = schema.GroupVersionResource{Group: "storage.k8s.io", Version: "", Resource: "volumesnapshotcontents"}
+
+func (c *FakeVolumeSnapshotContents) Create(volumeSnapshotContent *storage.VolumeSnapshotContent) (result *storage.VolumeSnapshotContent, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(volumesnapshotcontentsResource, volumeSnapshotContent), &storage.VolumeSnapshotContent{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*storage.VolumeSnapshotContent), err
+}
+
+func (c *FakeVolumeSnapshotContents) Update(volumeSnapshotContent *storage.VolumeSnapshotContent) (result *storage.VolumeSnapshotContent, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(volumesnapshotcontentsResource, volumeSnapshotContent), &storage.VolumeSnapshotContent{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*storage.VolumeSnapshotContent), err
+}
+
+func (c *FakeVolumeSnapshotContents) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(volumesnapshotcontentsResource, name), &storage.VolumeSnapshotContent{})
+	return err
+}
+
+func (c *FakeVolumeSnapshotContents) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(volumesnapshotcontentsResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &storage.VolumeSnapshotContentList{})
+	return err
+}
+
+func (c *FakeVolumeSnapshotContents) Get(name string, options v1.GetOptions) (result *storage.VolumeSnapshotContent, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(volumesnapshotcontentsResource, name), &storage.VolumeSnapshotContent{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*storage.VolumeSnapshotContent), err
+}
+
+func (c *FakeVolumeSnapshotContents) List(opts v1.ListOptions) (result *storage.VolumeSnapshotContentList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(volumesnapshotcontentsResource, opts), &storage.VolumeSnapshotContentList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &storage.VolumeSnapshotContentList{}
+	for _, item := range obj.(*storage.VolumeSnapshotContentList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested volumeSnapshotContents.
+func (c *FakeVolumeSnapshotContents) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(volumesnapshotcontentsResource, opts))
+}
+
+// Patch applies the patch and returns the patched volumeSnapshotContent.
+func (c *FakeVolumeSnapshotContents) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *storage.VolumeSnapshotContent, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(volumesnapshotcontentsResource, name, data, subresources...), &storage.VolumeSnapshotContent{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*storage.VolumeSnapshotContent), err
+}
@@ -17,3 +17,9 @@ limitations under the License.
 package internalversion
 
 type StorageClassExpansion interface{}
+
+type VolumeAttachmentExpansion interface{}
+
+type VolumeSnapshotExpansion interface{}
+
+type VolumeSnapshotContentExpansion interface{}
@@ -24,6 +24,9 @@ import (
 type StorageInterface interface {
 	RESTClient() rest.Interface
 	StorageClassesGetter
+	VolumeAttachmentsGetter
+	VolumeSnapshotsGetter
+	VolumeSnapshotContentsGetter
 }
 
 // StorageClient is used to interact with features provided by the storage.k8s.io group.
@@ -35,6 +38,18 @@ func (c *StorageClient) StorageClasses() StorageClassInterface {
 	return newStorageClasses(c)
 }
 
+func (c *StorageClient) VolumeAttachments() VolumeAttachmentInterface {
+	return newVolumeAttachments(c)
+}
+
+func (c *StorageClient) VolumeSnapshots(namespace string) VolumeSnapshotInterface {
+	return newVolumeSnapshots(c, namespace)
+}
+
+func (c *StorageClient) VolumeSnapshotContents() VolumeSnapshotContentInterface {
+	return newVolumeSnapshotContents(c)
+}
+
 // NewForConfig creates a new StorageClient for the given config.
 func NewForConfig(c *rest.Config) (*StorageClient, error) {
 	config := *c
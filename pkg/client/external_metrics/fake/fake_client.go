@@ -0,0 +1,91 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/testing"
+	"k8s.io/kubernetes/pkg/apis/external_metrics/v1beta1"
+	emclient "k8s.io/kubernetes/pkg/client/external_metrics"
+)
+
+type ListActionImpl struct {
+	testing.ListActionImpl
+	MetricName    string
+	LabelSelector labels.Selector
+}
+
+type ListAction interface {
+	testing.ListAction
+	GetMetricName() string
+	GetLabelSelector() labels.Selector
+}
+
+func (i ListActionImpl) GetMetricName() string {
+	return i.MetricName
+}
+
+func (i ListActionImpl) GetLabelSelector() labels.Selector {
+	return i.LabelSelector
+}
+
+func NewListAction(namespace, metricName string, labelSelector labels.Selector) ListActionImpl {
+	resource := schema.GroupVersionResource{
+		Group:    v1beta1.SchemeGroupVersion.Group,
+		Version:  v1beta1.SchemeGroupVersion.Version,
+		Resource: metricName,
+	}
+	return ListActionImpl{
+		ListActionImpl: testing.ListActionImpl{
+			ActionImpl: testing.ActionImpl{
+				Namespace: namespace,
+				Verb:      "list",
+				Resource:  resource,
+			},
+		},
+		MetricName:    metricName,
+		LabelSelector: labelSelector,
+	}
+}
+
+type FakeExternalMetricsClient struct {
+	testing.Fake
+}
+
+func (c *FakeExternalMetricsClient) NamespacedMetrics(namespace string) emclient.MetricsInterface {
+	return &fakeNamespacedMetrics{
+		Fake: c,
+		ns:   namespace,
+	}
+}
+
+type fakeNamespacedMetrics struct {
+	Fake *FakeExternalMetricsClient
+	ns   string
+}
+
+func (m *fakeNamespacedMetrics) List(metricName string, metricSelector labels.Selector) (*v1beta1.ExternalMetricValueList, error) {
+	obj, err := m.Fake.
+		Invokes(NewListAction(m.ns, metricName, metricSelector), &v1beta1.ExternalMetricValueList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*v1beta1.ExternalMetricValueList), err
+}
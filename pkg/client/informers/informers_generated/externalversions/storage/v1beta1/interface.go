@@ -26,6 +26,12 @@ import (
 type Interface interface {
 	// StorageClasses returns a StorageClassInformer.
 	StorageClasses() StorageClassInformer
+	// VolumeAttachments returns a VolumeAttachmentInformer.
+	VolumeAttachments() VolumeAttachmentInformer
+	// VolumeSnapshots returns a VolumeSnapshotInformer.
+	VolumeSnapshots() VolumeSnapshotInformer
+	// VolumeSnapshotContents returns a VolumeSnapshotContentInformer.
+	VolumeSnapshotContents() VolumeSnapshotContentInformer
 }
 
 type version struct {
@@ -41,3 +47,18 @@ func New(f internalinterfaces.SharedInformerFactory) Interface {
 func (v *version) StorageClasses() StorageClassInformer {
 	return &storageClassInformer{factory: v.SharedInformerFactory}
 }
+
+// VolumeAttachments returns a VolumeAttachmentInformer.
+func (v *version) VolumeAttachments() VolumeAttachmentInformer {
+	return &volumeAttachmentInformer{factory: v.SharedInformerFactory}
+}
+
+// VolumeSnapshots returns a VolumeSnapshotInformer.
+func (v *version) VolumeSnapshots() VolumeSnapshotInformer {
+	return &volumeSnapshotInformer{factory: v.SharedInformerFactory}
+}
+
+// VolumeSnapshotContents returns a VolumeSnapshotContentInformer.
+func (v *version) VolumeSnapshotContents() VolumeSnapshotContentInformer {
+	return &volumeSnapshotContentInformer{factory: v.SharedInformerFactory}
+}
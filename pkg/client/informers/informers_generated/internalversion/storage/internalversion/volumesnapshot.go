@@ -0,0 +1,68 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by informer-gen
+
+package internalversion
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+	storage "k8s.io/kubernetes/pkg/apis/storage"
+	internalclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	internalinterfaces "k8s.io/kubernetes/pkg/client/informers/informers_generated/internalversion/internalinterfaces"
+	internalversion "k8s.io/kubernetes/pkg/client/listers/storage/internalversion"
+	time "time"
+)
+
+// VolumeSnapshotInformer provides access to a shared informer and lister for
+// VolumeSnapshots.
+type VolumeSnapshotInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() internalversion.VolumeSnapshotLister
+}
+
+type volumeSnapshotInformer struct {
+	factory internalinterfaces.SharedInformerFactory
+}
+
+func newVolumeSnapshotInformer(client internalclientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	sharedIndexInformer := cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				return client.Storage().VolumeSnapshots(v1.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				return client.Storage().VolumeSnapshots(v1.NamespaceAll).Watch(options)
+			},
+		},
+		&storage.VolumeSnapshot{},
+		resyncPeriod,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+	)
+
+	return sharedIndexInformer
+}
+
+func (f *volumeSnapshotInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&storage.VolumeSnapshot{}, newVolumeSnapshotInformer)
+}
+
+func (f *volumeSnapshotInformer) Lister() internalversion.VolumeSnapshotLister {
+	return internalversion.NewVolumeSnapshotLister(f.Informer().GetIndexer())
+}
@@ -17,3 +17,11 @@ limitations under the License.
 // This file was automatically generated by lister-gen
 
 package internalversion
+
+// ControllerRevisionListerExpansion allows custom methods to be added to
+// ControllerRevisionLister.
+type ControllerRevisionListerExpansion interface{}
+
+// ControllerRevisionNamespaceListerExpansion allows custom methods to be added to
+// ControllerRevisionNamespaeLister.
+type ControllerRevisionNamespaceListerExpansion interface{}
@@ -21,3 +21,19 @@ package internalversion
 // StorageClassListerExpansion allows custom methods to be added to
 // StorageClassLister.
 type StorageClassListerExpansion interface{}
+
+// VolumeAttachmentListerExpansion allows custom methods to be added to
+// VolumeAttachmentLister.
+type VolumeAttachmentListerExpansion interface{}
+
+// VolumeSnapshotListerExpansion allows custom methods to be added to
+// VolumeSnapshotLister.
+type VolumeSnapshotListerExpansion interface{}
+
+// VolumeSnapshotNamespaceListerExpansion allows custom methods to be added to
+// VolumeSnapshotNamespaeLister.
+type VolumeSnapshotNamespaceListerExpansion interface{}
+
+// VolumeSnapshotContentListerExpansion allows custom methods to be added to
+// VolumeSnapshotContentLister.
+type VolumeSnapshotContentListerExpansion interface{}
@@ -30,6 +30,7 @@ import (
 
 	"gopkg.in/gcfg.v1"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -145,6 +146,9 @@ type Disks interface {
 	// DeleteDisk deletes PD.
 	DeleteDisk(diskToDelete string) error
 
+	// ResizeDisk resizes PD and returns new disk size
+	ResizeDisk(diskToResize string, oldSize resource.Quantity, newSize resource.Quantity) (resource.Quantity, error)
+
 	// GetAutoLabelsForPD returns labels to apply to PersistentVolume
 	// representing this PD, namely failure domain and zone.
 	// zone can be provided to specify the zone for the PD,
@@ -2514,6 +2518,33 @@ func (gce *GCECloud) DeleteDisk(diskToDelete string) error {
 	return err
 }
 
+// ResizeDisk expands given disk and returns new disk size
+func (gce *GCECloud) ResizeDisk(diskToResize string, oldSize resource.Quantity, newSize resource.Quantity) (resource.Quantity, error) {
+	disk, err := gce.getDiskByNameUnknownZone(diskToResize)
+	if err != nil {
+		return oldSize, err
+	}
+
+	// GCE resizes in chunks of GBs (not GiB)
+	requestGB := volume.RoundUpSize(newSize.Value(), 1000*1000*1000)
+	newSizeQuant := resource.MustParse(fmt.Sprintf("%dG", requestGB))
+
+	// If disk is already of size equal or greater than requested size, we simply return
+	if oldSize.Cmp(newSizeQuant) >= 0 {
+		return newSizeQuant, nil
+	}
+
+	diskResizeRequest := &compute.DisksResizeRequest{SizeGb: requestGB}
+	resizeOp, err := gce.service.Disks.Resize(gce.projectID, disk.Zone, disk.Name, diskResizeRequest).Do()
+	if err != nil {
+		return oldSize, err
+	}
+	if err := gce.waitForZoneOp(resizeOp, disk.Zone); err != nil {
+		return oldSize, err
+	}
+	return newSizeQuant, nil
+}
+
 // isGCEError returns true if given error is a googleapi.Error with given
 // reason (e.g. "resourceInUseByAnotherResource")
 func isGCEError(err error, reason string) bool {
@@ -36,3 +36,24 @@ func getCertApprovalCondition(status *certificates.CertificateSigningRequestStat
 	}
 	return
 }
+
+// chainedApprover runs a list of approvers in order, so that each can approve
+// the kind of requests it knows about.
+type chainedApprover []AutoApprover
+
+// NewChainedApprover creates an approver that passes every CSR through all the
+// given approvers in order. Approvers skip requests that are already approved
+// or denied.
+func NewChainedApprover(approvers ...AutoApprover) AutoApprover {
+	return chainedApprover(approvers)
+}
+
+func (c chainedApprover) AutoApprove(csr *certificates.CertificateSigningRequest) (*certificates.CertificateSigningRequest, error) {
+	var err error
+	for _, approver := range c {
+		if csr, err = approver.AutoApprove(csr); err != nil {
+			return nil, err
+		}
+	}
+	return csr, nil
+}
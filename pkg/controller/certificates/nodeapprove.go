@@ -0,0 +1,154 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/golang/glog"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/api/v1"
+	certificates "k8s.io/kubernetes/pkg/apis/certificates/v1beta1"
+	coreinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/core/v1"
+	corelisters "k8s.io/kubernetes/pkg/client/listers/core/v1"
+)
+
+const nodeUserPrefix = "system:node:"
+
+// nodeApprover implements AutoApprover for certificates requested by a Kubelet
+// for itself: serving certificates for the addresses of its Node, and renewals
+// of its client certificate.
+type nodeApprover struct {
+	nodeLister  corelisters.NodeLister
+	nodesSynced cache.InformerSynced
+}
+
+// NewNodeApprover creates an approver that accepts CSRs a node makes for its
+// own identity. Serving certificates are only approved if every requested
+// subject alternative name is an address of the Node object.
+func NewNodeApprover(nodeInformer coreinformers.NodeInformer) AutoApprover {
+	return &nodeApprover{
+		nodeLister:  nodeInformer.Lister(),
+		nodesSynced: nodeInformer.Informer().HasSynced,
+	}
+}
+
+func (a *nodeApprover) AutoApprove(csr *certificates.CertificateSigningRequest) (*certificates.CertificateSigningRequest, error) {
+	// short-circuit if we're already approved or denied
+	if approved, denied := getCertApprovalCondition(&csr.Status); approved || denied {
+		return csr, nil
+	}
+	// only nodes may request certificates for themselves
+	if !strings.HasPrefix(csr.Spec.Username, nodeUserPrefix) {
+		return csr, nil
+	}
+
+	x509cr, err := certificates.ParseCSR(csr)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("unable to parse csr %q: %v", csr.Name, err))
+		return csr, nil
+	}
+	if !reflect.DeepEqual([]string{"system:nodes"}, x509cr.Subject.Organization) {
+		return csr, nil
+	}
+	if x509cr.Subject.CommonName != csr.Spec.Username {
+		return csr, nil
+	}
+	if len(x509cr.EmailAddresses) != 0 {
+		return csr, nil
+	}
+
+	switch {
+	case hasExactUsages(csr, kubeletClientUsages):
+		if len(x509cr.DNSNames)+len(x509cr.IPAddresses) != 0 {
+			return csr, nil
+		}
+		csr.Status.Conditions = append(csr.Status.Conditions, certificates.CertificateSigningRequestCondition{
+			Type:    certificates.CertificateApproved,
+			Reason:  "AutoApproved",
+			Message: "Auto approving kubelet client certificate renewal requested by the node itself",
+		})
+	case hasExactUsages(csr, kubeletServerUsages):
+		if len(x509cr.DNSNames)+len(x509cr.IPAddresses) == 0 {
+			return csr, nil
+		}
+		if !a.nodesSynced() {
+			return nil, fmt.Errorf("node cache not synced yet, unable to validate csr %q", csr.Name)
+		}
+		nodeName := strings.TrimPrefix(csr.Spec.Username, nodeUserPrefix)
+		node, err := a.nodeLister.Get(nodeName)
+		if errors.IsNotFound(err) {
+			glog.V(4).Infof("Not approving serving certificate request %q for unknown node %q", csr.Name, nodeName)
+			return csr, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := validateServingNames(x509cr, node); err != nil {
+			glog.V(4).Infof("Not approving serving certificate request %q: %v", csr.Name, err)
+			return csr, nil
+		}
+		csr.Status.Conditions = append(csr.Status.Conditions, certificates.CertificateSigningRequestCondition{
+			Type:    certificates.CertificateApproved,
+			Reason:  "AutoApproved",
+			Message: fmt.Sprintf("Auto approving kubelet serving certificate for the addresses of node %q", nodeName),
+		})
+	}
+	return csr, nil
+}
+
+var kubeletServerUsages = []certificates.KeyUsage{
+	certificates.UsageKeyEncipherment,
+	certificates.UsageDigitalSignature,
+	certificates.UsageServerAuth,
+}
+
+// validateServingNames checks that every subject alternative name requested
+// is an address of the node.
+func validateServingNames(x509cr *x509.CertificateRequest, node *v1.Node) error {
+	hostnames := sets.NewString()
+	ips := sets.NewString()
+	for _, address := range node.Status.Addresses {
+		switch address.Type {
+		case v1.NodeHostName, v1.NodeInternalDNS, v1.NodeExternalDNS:
+			hostnames.Insert(address.Address)
+		case v1.NodeInternalIP, v1.NodeExternalIP, v1.NodeLegacyHostIP:
+			if ip := net.ParseIP(address.Address); ip != nil {
+				ips.Insert(ip.String())
+			}
+		}
+	}
+	for _, name := range x509cr.DNSNames {
+		if !hostnames.Has(name) {
+			return fmt.Errorf("DNS name %q is not an address of node %q", name, node.Name)
+		}
+	}
+	for _, ip := range x509cr.IPAddresses {
+		if !ips.Has(ip.String()) {
+			return fmt.Errorf("IP address %q is not an address of node %q", ip, node.Name)
+		}
+	}
+	return nil
+}
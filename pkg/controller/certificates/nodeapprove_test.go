@@ -0,0 +1,187 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/cert"
+	"k8s.io/kubernetes/pkg/api/v1"
+	certificates "k8s.io/kubernetes/pkg/apis/certificates/v1beta1"
+	corelisters "k8s.io/kubernetes/pkg/client/listers/core/v1"
+)
+
+func newNodeCSR(t *testing.T, username string, template *x509.CertificateRequest, usages []certificates.KeyUsage) *certificates.CertificateSigningRequest {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	csrPEM, err := cert.MakeCSRFromTemplate(key, template)
+	if err != nil {
+		t.Fatalf("unable to create csr: %v", err)
+	}
+	return &certificates.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "csr"},
+		Spec: certificates.CertificateSigningRequestSpec{
+			Username: username,
+			Request:  csrPEM,
+			Usages:   usages,
+		},
+	}
+}
+
+func TestNodeApprover(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	indexer.Add(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: v1.NodeStatus{
+			Addresses: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: v1.NodeHostName, Address: "node-1.example.com"},
+			},
+		},
+	})
+	approver := &nodeApprover{
+		nodeLister:  corelisters.NewNodeLister(indexer),
+		nodesSynced: alwaysReady,
+	}
+
+	subject := pkix.Name{CommonName: "system:node:node-1", Organization: []string{"system:nodes"}}
+	cases := []struct {
+		name     string
+		username string
+		template *x509.CertificateRequest
+		usages   []certificates.KeyUsage
+		approved bool
+	}{
+		{
+			name:     "serving certificate for node addresses",
+			username: "system:node:node-1",
+			template: &x509.CertificateRequest{
+				Subject:     subject,
+				DNSNames:    []string{"node-1.example.com"},
+				IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			},
+			usages:   kubeletServerUsages,
+			approved: true,
+		},
+		{
+			name:     "serving certificate for a foreign address",
+			username: "system:node:node-1",
+			template: &x509.CertificateRequest{
+				Subject:     subject,
+				IPAddresses: []net.IP{net.ParseIP("10.0.0.2")},
+			},
+			usages: kubeletServerUsages,
+		},
+		{
+			name:     "serving certificate for a foreign hostname",
+			username: "system:node:node-1",
+			template: &x509.CertificateRequest{
+				Subject:  subject,
+				DNSNames: []string{"kubernetes.default"},
+			},
+			usages: kubeletServerUsages,
+		},
+		{
+			name:     "serving certificate without names",
+			username: "system:node:node-1",
+			template: &x509.CertificateRequest{Subject: subject},
+			usages:   kubeletServerUsages,
+		},
+		{
+			name:     "serving certificate requested by another node",
+			username: "system:node:node-2",
+			template: &x509.CertificateRequest{
+				Subject:     subject,
+				IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			},
+			usages: kubeletServerUsages,
+		},
+		{
+			name:     "serving certificate for an unknown node",
+			username: "system:node:node-3",
+			template: &x509.CertificateRequest{
+				Subject:     pkix.Name{CommonName: "system:node:node-3", Organization: []string{"system:nodes"}},
+				IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			},
+			usages: kubeletServerUsages,
+		},
+		{
+			name:     "client certificate renewal",
+			username: "system:node:node-1",
+			template: &x509.CertificateRequest{Subject: subject},
+			usages:   kubeletClientUsages,
+			approved: true,
+		},
+		{
+			name:     "client certificate with names",
+			username: "system:node:node-1",
+			template: &x509.CertificateRequest{
+				Subject:  subject,
+				DNSNames: []string{"node-1.example.com"},
+			},
+			usages: kubeletClientUsages,
+		},
+		{
+			name:     "client certificate requested by a user",
+			username: "alice",
+			template: &x509.CertificateRequest{Subject: subject},
+			usages:   kubeletClientUsages,
+		},
+		{
+			name:     "wrong organization",
+			username: "system:node:node-1",
+			template: &x509.CertificateRequest{
+				Subject: pkix.Name{CommonName: "system:node:node-1", Organization: []string{"system:masters"}},
+			},
+			usages: kubeletClientUsages,
+		},
+	}
+	for _, c := range cases {
+		csr, err := approver.AutoApprove(newNodeCSR(t, c.username, c.template, c.usages))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if approved := IsCertificateRequestApproved(csr); approved != c.approved {
+			t.Errorf("%s: expected approved=%v, got %v", c.name, c.approved, approved)
+		}
+	}
+}
+
+func TestNodeApproverNotSynced(t *testing.T) {
+	approver := &nodeApprover{
+		nodeLister:  corelisters.NewNodeLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		nodesSynced: func() bool { return false },
+	}
+	csr := newNodeCSR(t, "system:node:node-1", &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "system:node:node-1", Organization: []string{"system:nodes"}},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+	}, kubeletServerUsages)
+	if _, err := approver.AutoApprove(csr); err == nil {
+		t.Errorf("expected an error before the node cache is synced")
+	}
+}
@@ -17,14 +17,14 @@ limitations under the License.
 package cronjob
 
 /*
-I did not use watch or expectations.  Those add a lot of corner cases, and we aren't
+I did not use expectations.  Those add a lot of corner cases, and we aren't
 expecting a large volume of jobs or scheduledJobs.  (We are favoring correctness
 over scalability.  If we find a single controller thread is too slow because
-there are a lot of Jobs or CronJobs, we we can parallelize by Namespace.
-If we find the load on the API server is too high, we can use a watch and
-UndeltaStore.)
+there are a lot of Jobs or CronJobs, we we can add workers.)
 
-Just periodically list jobs and SJs, and then reconcile them.
+CronJobs and Jobs are watched through shared informers.  A CronJob is synced
+when it or one of its Jobs changes, and is requeued for the next time its
+schedule fires, so there is no need to periodically relist everything.
 
 */
 
@@ -32,11 +32,15 @@ import (
 	"fmt"
 	"sort"
 	"time"
+	// Embed the IANA time zone database, so that the time zones of CronJobs
+	// do not depend on the files installed on the controller's host.
+	_ "time/tzdata"
 
 	"github.com/golang/glog"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -44,14 +48,28 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 	clientv1 "k8s.io/client-go/pkg/api/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
 	batch "k8s.io/kubernetes/pkg/apis/batch/v2alpha1"
 	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	batchinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/batch/v2alpha1"
+	batchv2alpha1listers "k8s.io/kubernetes/pkg/client/listers/batch/v2alpha1"
+	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/kubernetes/pkg/util/metrics"
 )
 
+// nextScheduleDelta is added to the delay until the next schedule time of a
+// CronJob when requeueing it, so that the schedule time has passed when the
+// CronJob is synced again.
+const nextScheduleDelta = 100 * time.Millisecond
+
+// defaultCatchUpLimit is the number of missed schedule times started for
+// CronJobs with the RunAll catch up policy and no catchUpLimit.
+const defaultCatchUpLimit = 100
+
 // Utilities for dealing with Jobs and CronJobs and time.
 
 type CronJobController struct {
@@ -60,9 +78,26 @@ type CronJobController struct {
 	sjControl  sjControlInterface
 	podControl podControlInterface
 	recorder   record.EventRecorder
+
+	// To allow injection of syncCronJob for testing.
+	syncHandler func(key string) error
+
+	sjLister batchv2alpha1listers.CronJobLister
+	// sjListerSynced returns true if the CronJob store has been synced at least once.
+	sjListerSynced cache.InformerSynced
+	jobLister      batchv2alpha1listers.JobLister
+	// jobListerSynced returns true if the Job store has been synced at least once.
+	jobListerSynced cache.InformerSynced
+
+	// CronJobs that need to be synced, either because they or their Jobs
+	// changed, or because their schedule fires.
+	queue workqueue.RateLimitingInterface
+
+	// now returns the current time, to facilitate testing.
+	now func() time.Time
 }
 
-func NewCronJobController(kubeClient clientset.Interface) *CronJobController {
+func NewCronJobController(sjInformer batchinformers.CronJobInformer, jobInformer batchinformers.JobInformer, kubeClient clientset.Interface) *CronJobController {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(glog.Infof)
 	// TODO: remove the wrapper when every clients have moved to use the clientset.
@@ -78,51 +113,202 @@ func NewCronJobController(kubeClient clientset.Interface) *CronJobController {
 		sjControl:  &realSJControl{KubeClient: kubeClient},
 		podControl: &realPodControl{KubeClient: kubeClient},
 		recorder:   eventBroadcaster.NewRecorder(api.Scheme, clientv1.EventSource{Component: "cronjob-controller"}),
-	}
-
-	return jm
-}
-
-func NewCronJobControllerFromClient(kubeClient clientset.Interface) *CronJobController {
-	jm := NewCronJobController(kubeClient)
+		queue:      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cronjob"),
+		now:        time.Now,
+	}
+
+	sjInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: jm.enqueueCronJob,
+		UpdateFunc: func(old, cur interface{}) {
+			jm.enqueueCronJob(cur)
+		},
+		DeleteFunc: jm.enqueueCronJob,
+	})
+	jm.sjLister = sjInformer.Lister()
+	jm.sjListerSynced = sjInformer.Informer().HasSynced
+
+	jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: jm.addJob,
+		UpdateFunc: func(old, cur interface{}) {
+			jm.addJob(cur)
+		},
+		DeleteFunc: jm.removeJob,
+	})
+	jm.jobLister = jobInformer.Lister()
+	jm.jobListerSynced = jobInformer.Informer().HasSynced
+
+	jm.syncHandler = jm.syncCronJob
 	return jm
 }
 
 // Run the main goroutine responsible for watching and syncing jobs.
 func (jm *CronJobController) Run(stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
+	defer jm.queue.ShutDown()
+
 	glog.Infof("Starting CronJob Manager")
-	// Check things every 10 second.
-	go wait.Until(jm.syncAll, 10*time.Second, stopCh)
+
+	if !cache.WaitForCacheSync(stopCh, jm.sjListerSynced, jm.jobListerSynced) {
+		utilruntime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
+		return
+	}
+
+	go wait.Until(jm.worker, time.Second, stopCh)
+
 	<-stopCh
 	glog.Infof("Shutting down CronJob Manager")
 }
 
-// syncAll lists all the CronJobs and Jobs and reconciles them.
-func (jm *CronJobController) syncAll() {
-	sjl, err := jm.kubeClient.BatchV2alpha1().CronJobs(metav1.NamespaceAll).List(metav1.ListOptions{})
+// obj could be an *batch.CronJob, or a DeletionFinalStateUnknown marker item.
+func (jm *CronJobController) enqueueCronJob(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
 	if err != nil {
-		glog.Errorf("Error listing cronjobs: %v", err)
+		utilruntime.HandleError(fmt.Errorf("Couldn't get key for object %+v: %v", obj, err))
 		return
 	}
-	sjs := sjl.Items
-	glog.V(4).Infof("Found %d cronjobs", len(sjs))
+	jm.queue.Add(key)
+}
 
-	jl, err := jm.kubeClient.BatchV2alpha1().Jobs(metav1.NamespaceAll).List(metav1.ListOptions{})
+// enqueueCronJobAfter requeues a CronJob for the given time.
+func (jm *CronJobController) enqueueCronJobAfter(sj *batch.CronJob, t time.Time) {
+	key, err := controller.KeyFunc(sj)
 	if err != nil {
-		glog.Errorf("Error listing jobs")
+		utilruntime.HandleError(fmt.Errorf("Couldn't get key for object %+v: %v", sj, err))
 		return
 	}
-	js := jl.Items
-	glog.V(4).Infof("Found %d jobs", len(js))
-
-	jobsBySj := groupJobsByParent(sjs, js)
-	glog.V(4).Infof("Found %d groups", len(jobsBySj))
+	jm.queue.AddAfter(key, t.Sub(jm.now())+nextScheduleDelta)
+}
 
+// getJobCronJob returns the CronJob that created the given job, or nil.
+func (jm *CronJobController) getJobCronJob(job *batch.Job) *batch.CronJob {
+	parentUID, found := getParentUIDFromJob(*job)
+	if !found {
+		return nil
+	}
+	sjs, err := jm.sjLister.CronJobs(job.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil
+	}
 	for _, sj := range sjs {
-		syncOne(&sj, jobsBySj[sj.UID], time.Now(), jm.jobControl, jm.sjControl, jm.podControl, jm.recorder)
-		cleanupFinishedJobs(&sj, jobsBySj[sj.UID], jm.jobControl, jm.sjControl, jm.podControl, jm.recorder)
+		if sj.UID == parentUID {
+			return sj
+		}
+	}
+	return nil
+}
+
+// When a job is created or updated, enqueue the CronJob that created it.
+func (jm *CronJobController) addJob(obj interface{}) {
+	job := obj.(*batch.Job)
+	if sj := jm.getJobCronJob(job); sj != nil {
+		jm.enqueueCronJob(sj)
+	}
+}
+
+// When a job is deleted, enqueue the CronJob that created it.
+// obj could be an *batch.Job, or a DeletionFinalStateUnknown marker item.
+func (jm *CronJobController) removeJob(obj interface{}) {
+	job, ok := obj.(*batch.Job)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("Couldn't get object from tombstone %+v", obj))
+			return
+		}
+		job, ok = tombstone.Obj.(*batch.Job)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("Tombstone contained object that is not a job %+v", obj))
+			return
+		}
+	}
+	if sj := jm.getJobCronJob(job); sj != nil {
+		jm.enqueueCronJob(sj)
+	}
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and marks them done.
+// It enforces that the syncHandler is never invoked concurrently with the same key.
+func (jm *CronJobController) worker() {
+	for jm.processNextWorkItem() {
+	}
+}
+
+func (jm *CronJobController) processNextWorkItem() bool {
+	key, quit := jm.queue.Get()
+	if quit {
+		return false
+	}
+	defer jm.queue.Done(key)
+
+	err := jm.syncHandler(key.(string))
+	if err == nil {
+		jm.queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("Error syncing cronjob: %v", err))
+	jm.queue.AddRateLimited(key)
+
+	return true
+}
+
+// syncCronJob reconciles the CronJob with the given key with the Jobs it
+// created, and requeues it for the next time its schedule fires.
+func (jm *CronJobController) syncCronJob(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished syncing cronjob %q (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	sharedSJ, err := jm.sjLister.CronJobs(ns).Get(name)
+	if errors.IsNotFound(err) {
+		glog.V(4).Infof("CronJob has been deleted: %v", key)
+		return nil
 	}
+	if err != nil {
+		return err
+	}
+	obj, err := api.Scheme.DeepCopy(sharedSJ)
+	if err != nil {
+		return err
+	}
+	sj := obj.(*batch.CronJob)
+
+	jobs, err := jm.jobLister.Jobs(ns).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	js := []batch.Job{}
+	for _, job := range jobs {
+		if parentUID, found := getParentUIDFromJob(*job); found && parentUID == sj.UID {
+			js = append(js, *job)
+		}
+	}
+
+	now := jm.now()
+	if err := syncOne(sj, js, now, jm.jobControl, jm.sjControl, jm.podControl, jm.recorder); err != nil {
+		return err
+	}
+	cleanupFinishedJobs(sj, js, jm.jobControl, jm.sjControl, jm.podControl, jm.recorder)
+
+	if sj.Spec.Suspend != nil && *sj.Spec.Suspend {
+		// Resuming the CronJob updates it, which enqueues it again.
+		return nil
+	}
+	next, err := getNextScheduleTime(*sj, now)
+	if err != nil {
+		glog.V(2).Infof("Not requeueing %s: %v", key, err)
+		return nil
+	}
+	if next != nil {
+		glog.V(4).Infof("Requeueing %s for %v", key, *next)
+		jm.enqueueCronJobAfter(sj, *next)
+	}
+	return nil
 }
 
 // cleanupFinishedJobs cleanups finished jobs created by a CronJob
@@ -190,7 +376,8 @@ func removeOldestJobs(sj *batch.CronJob, js []batch.Job, jc jobControlInterface,
 // All known jobs created by "sj" should be included in "js".
 // The current time is passed in to facilitate testing.
 // It has no receiver, to facilitate testing.
-func syncOne(sj *batch.CronJob, js []batch.Job, now time.Time, jc jobControlInterface, sjc sjControlInterface, pc podControlInterface, recorder record.EventRecorder) {
+// An error is returned if the CronJob should be synced again soon.
+func syncOne(sj *batch.CronJob, js []batch.Job, now time.Time, jc jobControlInterface, sjc sjControlInterface, pc podControlInterface, recorder record.EventRecorder) error {
 	nameForLog := fmt.Sprintf("%s/%s", sj.Namespace, sj.Name)
 
 	childrenJobs := make(map[types.UID]bool)
@@ -230,44 +417,100 @@ func syncOne(sj *batch.CronJob, js []batch.Job, now time.Time, jc jobControlInte
 	updatedSJ, err := sjc.UpdateStatus(sj)
 	if err != nil {
 		glog.Errorf("Unable to update status for %s (rv = %s): %v", nameForLog, sj.ResourceVersion, err)
-		return
+		return err
 	}
 	*sj = *updatedSJ
 
 	if sj.Spec.Suspend != nil && *sj.Spec.Suspend {
 		glog.V(4).Infof("Not starting job for %s because it is suspended", nameForLog)
-		return
+		return nil
 	}
-	times, err := getRecentUnmetScheduleTimes(*sj, now)
+
+	// Only look for as many unmet start times as the catch up policy may
+	// start, plus one to tell whether older ones were missed.
+	limit := 1
+	switch sj.Spec.CatchUpPolicy {
+	case batch.RunAllCatchUp:
+		limit = defaultCatchUpLimit
+		if sj.Spec.CatchUpLimit != nil {
+			limit = int(*sj.Spec.CatchUpLimit)
+		}
+		limit++
+	case batch.SkipCatchUp:
+		limit = 2
+	}
+	times, err := getRecentUnmetScheduleTimes(*sj, now, limit)
 	if err != nil {
 		recorder.Eventf(sj, v1.EventTypeWarning, "FailedNeedsStart", "Cannot determine if job needs to be started: %v", err)
 		glog.Errorf("Cannot determine if %s needs to be started: %v", nameForLog, err)
+		return nil
 	}
-	// TODO: handle multiple unmet start times, from oldest to newest, updating status as needed.
 	if len(times) == 0 {
 		glog.V(4).Infof("No unmet start times for %s", nameForLog)
-		return
+		return nil
 	}
-	if len(times) > 1 {
-		glog.V(4).Infof("Multiple unmet start times for %s so only starting last one", nameForLog)
+
+	switch sj.Spec.CatchUpPolicy {
+	case batch.RunAllCatchUp:
+		if len(times) == limit {
+			// Older start times than the catchUpLimit most recent ones are not started.
+			missSchedule(sj, times[0], sjc, recorder, fmt.Sprintf("Missed scheduled time to start a job: %s, older than the %d most recent ones", times[0].Format(time.RFC1123Z), limit-1))
+			times = times[1:]
+		}
+	case batch.SkipCatchUp:
+		if len(times) > 1 {
+			glog.V(4).Infof("Multiple unmet start times for %s so not starting any", nameForLog)
+			missSchedule(sj, times[len(times)-1], sjc, recorder, fmt.Sprintf("Missed multiple scheduled times to start a job, skipping them up to %s", times[len(times)-1].Format(time.RFC1123Z)))
+			return nil
+		}
+	default:
+		if len(times) > 1 {
+			glog.V(4).Infof("Multiple unmet start times for %s so only starting last one", nameForLog)
+		}
+		times = times[len(times)-1:]
+	}
+
+	for _, scheduledTime := range times {
+		if started, err := startJob(sj, scheduledTime, now, jc, sjc, pc, recorder); !started || err != nil {
+			return err
+		}
 	}
-	scheduledTime := times[len(times)-1]
+	return nil
+}
+
+// missSchedule records in the status of sj that the schedule times up to
+// scheduledTime will not be started.
+func missSchedule(sj *batch.CronJob, scheduledTime time.Time, sjc sjControlInterface, recorder record.EventRecorder, message string) {
+	nameForLog := fmt.Sprintf("%s/%s", sj.Namespace, sj.Name)
+	// Use a warning level event because it indicates a problem with the
+	// controller (restart or long queue), and is not expected by user either.
+	recorder.Event(sj, v1.EventTypeWarning, "MissSchedule", message)
+	// Setting LastMissedTime keeps the miss from being noticed again the next
+	// time the CronJob is synced, and lets the user see that there was a
+	// missed execution.
+	sj.Status.LastMissedTime = &metav1.Time{Time: scheduledTime}
+	updatedSJ, err := sjc.UpdateStatus(sj)
+	if err != nil {
+		glog.Infof("Unable to update status for %s (rv = %s): %v", nameForLog, sj.ResourceVersion, err)
+		return
+	}
+	*sj = *updatedSJ
+}
+
+// startJob starts the job of sj for scheduledTime, subject to the starting
+// deadline and the concurrency policy.  It returns whether the job was
+// started, and an error if starting it should be retried soon.
+func startJob(sj *batch.CronJob, scheduledTime, now time.Time, jc jobControlInterface, sjc sjControlInterface, pc podControlInterface, recorder record.EventRecorder) (bool, error) {
+	nameForLog := fmt.Sprintf("%s/%s", sj.Namespace, sj.Name)
+
 	tooLate := false
 	if sj.Spec.StartingDeadlineSeconds != nil {
 		tooLate = scheduledTime.Add(time.Second * time.Duration(*sj.Spec.StartingDeadlineSeconds)).Before(now)
 	}
 	if tooLate {
 		glog.V(4).Infof("Missed starting window for %s", nameForLog)
-		// TODO: generate an event for a miss.  Use a warning level event because it indicates a
-		// problem with the controller (restart or long queue), and is not expected by user either.
-		// Since we don't set LastScheduleTime when not scheduling, we are going to keep noticing
-		// the miss every cycle.  In order to avoid sending multiple events, and to avoid processing
-		// the sj again and again, we could set a Status.LastMissedTime when we notice a miss.
-		// Then, when we call getRecentUnmetScheduleTimes, we can take max(creationTimestamp,
-		// Status.LastScheduleTime, Status.LastMissedTime), and then so we won't generate
-		// and event the next time we process it, and also so the user looking at the status
-		// can see easily that there was a missed execution.
-		return
+		missSchedule(sj, scheduledTime, sjc, recorder, fmt.Sprintf("Missed starting window for scheduled time: %s", scheduledTime.Format(time.RFC1123Z)))
+		return false, nil
 	}
 	if sj.Spec.ConcurrencyPolicy == batch.ForbidConcurrent && len(sj.Status.Active) > 0 {
 		// Regardless which source of information we use for the set of active jobs,
@@ -280,7 +523,7 @@ func syncOne(sj *batch.CronJob, js []batch.Job, now time.Time, jc jobControlInte
 		// With replace, we could use a name that is deterministic per execution time.
 		// But that would mean that you could not inspect prior successes or failures of Forbid jobs.
 		glog.V(4).Infof("Not starting job for %s because of prior execution still running and concurrency policy is Forbid", nameForLog)
-		return
+		return false, nil
 	}
 	if sj.Spec.ConcurrencyPolicy == batch.ReplaceConcurrent {
 		for _, j := range sj.Status.Active {
@@ -291,10 +534,10 @@ func syncOne(sj *batch.CronJob, js []batch.Job, now time.Time, jc jobControlInte
 			job, err := jc.GetJob(j.Namespace, j.Name)
 			if err != nil {
 				recorder.Eventf(sj, v1.EventTypeWarning, "FailedGet", "Get job: %v", err)
-				return
+				return false, err
 			}
 			if !deleteJob(sj, job, jc, pc, recorder, "") {
-				return
+				return false, fmt.Errorf("unable to delete job %s of %s", job.Name, nameForLog)
 			}
 		}
 	}
@@ -302,12 +545,12 @@ func syncOne(sj *batch.CronJob, js []batch.Job, now time.Time, jc jobControlInte
 	jobReq, err := getJobFromTemplate(sj, scheduledTime)
 	if err != nil {
 		glog.Errorf("Unable to make Job from template in %s: %v", nameForLog, err)
-		return
+		return false, nil
 	}
 	jobResp, err := jc.CreateJob(sj.Namespace, jobReq)
 	if err != nil {
 		recorder.Eventf(sj, v1.EventTypeWarning, "FailedCreate", "Error creating job: %v", err)
-		return
+		return false, err
 	}
 	glog.V(4).Infof("Created Job %s for %s", jobResp.Name, nameForLog)
 	recorder.Eventf(sj, v1.EventTypeNormal, "SuccessfulCreate", "Created job %v", jobResp.Name)
@@ -317,7 +560,7 @@ func syncOne(sj *batch.CronJob, js []batch.Job, now time.Time, jc jobControlInte
 	// If this process restarts at this point (after posting a job, but
 	// before updating the status), then we might try to start the job on
 	// the next time.  Actually, if we relist the SJs and Jobs on the next
-	// iteration of syncAll, we might not see our own status update, and
+	// sync, we might not see our own status update, and
 	// then post one again.  So, we need to use the job name as a lock to
 	// prevent us from making the job twice (name the job with hash of its
 	// scheduled time).
@@ -330,11 +573,15 @@ func syncOne(sj *batch.CronJob, js []batch.Job, now time.Time, jc jobControlInte
 		sj.Status.Active = append(sj.Status.Active, *ref)
 	}
 	sj.Status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
-	if _, err := sjc.UpdateStatus(sj); err != nil {
+	updatedSJ, err := sjc.UpdateStatus(sj)
+	if err != nil {
 		glog.Infof("Unable to update status for %s (rv = %s): %v", nameForLog, sj.ResourceVersion, err)
+		return true, err
 	}
+	// Keep the resource version current for the next status update.
+	*sj = *updatedSJ
 
-	return
+	return true, nil
 }
 
 // deleteJob reaps a job, deleting the job, the pobs and the reference in the active list
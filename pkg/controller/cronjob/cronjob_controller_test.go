@@ -104,6 +104,7 @@ func cronJob() batch.CronJob {
 		Spec: batch.CronJobSpec{
 			Schedule:          "* * * * ?",
 			ConcurrencyPolicy: batch.AllowConcurrent,
+			CatchUpPolicy:     batch.RunLatestCatchUp,
 			JobTemplate: batch.JobTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      map[string]string{"a": "b"},
@@ -223,14 +224,13 @@ func TestSyncOne_RunOrNot(t *testing.T) {
 		"still active, is time, past deadline":     {A, F, onTheHour, shortDead, T, T, justAfterTheHour(), F, F, 1, 0},
 		"still active, is time, not past deadline": {A, F, onTheHour, longDead, T, T, justAfterTheHour(), T, F, 2, 0},
 
-		// Controller should fail to schedule these, as there are too many missed starting times
-		// and either no deadline or a too long deadline.
-		"prev ran but done, long overdue, not past deadline, A": {A, F, onTheHour, longDead, T, F, weekAfterTheHour(), F, F, 0, 1},
-		"prev ran but done, long overdue, not past deadline, R": {R, F, onTheHour, longDead, T, F, weekAfterTheHour(), F, F, 0, 1},
-		"prev ran but done, long overdue, not past deadline, F": {f, F, onTheHour, longDead, T, F, weekAfterTheHour(), F, F, 0, 1},
-		"prev ran but done, long overdue, no deadline, A":       {A, F, onTheHour, noDead, T, F, weekAfterTheHour(), F, F, 0, 1},
-		"prev ran but done, long overdue, no deadline, R":       {R, F, onTheHour, noDead, T, F, weekAfterTheHour(), F, F, 0, 1},
-		"prev ran but done, long overdue, no deadline, F":       {f, F, onTheHour, noDead, T, F, weekAfterTheHour(), F, F, 0, 1},
+		// Controller should only schedule the most recent of the many missed starting times.
+		"prev ran but done, long overdue, not past deadline, A": {A, F, onTheHour, longDead, T, F, weekAfterTheHour(), T, F, 1, 0},
+		"prev ran but done, long overdue, not past deadline, R": {R, F, onTheHour, longDead, T, F, weekAfterTheHour(), T, F, 1, 0},
+		"prev ran but done, long overdue, not past deadline, F": {f, F, onTheHour, longDead, T, F, weekAfterTheHour(), T, F, 1, 0},
+		"prev ran but done, long overdue, no deadline, A":       {A, F, onTheHour, noDead, T, F, weekAfterTheHour(), T, F, 1, 0},
+		"prev ran but done, long overdue, no deadline, R":       {R, F, onTheHour, noDead, T, F, weekAfterTheHour(), T, F, 1, 0},
+		"prev ran but done, long overdue, no deadline, F":       {f, F, onTheHour, noDead, T, F, weekAfterTheHour(), T, F, 1, 0},
 
 		"prev ran but done, long overdue, past medium deadline, A": {A, F, onTheHour, mediumDead, T, F, weekAfterTheHour(), T, F, 1, 0},
 		"prev ran but done, long overdue, past short deadline, A":  {A, F, onTheHour, shortDead, T, F, weekAfterTheHour(), T, F, 1, 0},
@@ -330,6 +330,107 @@ func TestSyncOne_RunOrNot(t *testing.T) {
 	}
 }
 
+func TestSyncOne_CatchUp(t *testing.T) {
+	three := int32(3)
+
+	testCases := map[string]struct {
+		// sj spec
+		catchUpPolicy batch.CatchUpPolicy
+		catchUpLimit  *int32
+
+		// environment
+		now time.Time
+
+		// expectations
+		expectCreates    int
+		expectMissed     *time.Time
+		expectedWarnings int
+	}{
+		"one missed, RunLatest":         {batch.RunLatestCatchUp, nil, justAfterTheHour(), 1, nil, 0},
+		"one missed, RunAll":            {batch.RunAllCatchUp, nil, justAfterTheHour(), 1, nil, 0},
+		"one missed, Skip":              {batch.SkipCatchUp, nil, justAfterTheHour(), 1, nil, 0},
+		"long overdue, RunLatest":       {batch.RunLatestCatchUp, nil, weekAfterTheHour(), 1, nil, 0},
+		"long overdue, RunAll":          {batch.RunAllCatchUp, nil, weekAfterTheHour(), 100, timePtr(weekAfterTheHour().Add(-100 * time.Hour)), 1},
+		"long overdue, RunAll, limit 3": {batch.RunAllCatchUp, &three, weekAfterTheHour(), 3, timePtr(weekAfterTheHour().Add(-3 * time.Hour)), 1},
+		"long overdue, Skip":            {batch.SkipCatchUp, nil, weekAfterTheHour(), 0, timePtr(weekAfterTheHour()), 1},
+		"two hours overdue, RunAll":     {batch.RunAllCatchUp, nil, justAfterTheHour().Add(time.Hour), 2, nil, 0},
+		"two hours overdue, RunLatest":  {batch.RunLatestCatchUp, nil, justAfterTheHour().Add(time.Hour), 1, nil, 0},
+		"two hours overdue, Skip":       {batch.SkipCatchUp, nil, justAfterTheHour().Add(time.Hour), 0, timePtr(topOfTheHour().Add(time.Hour)), 1},
+		"not time yet, RunAll, limit 3": {batch.RunAllCatchUp, &three, justBeforeTheHour(), 0, nil, 0},
+		"not time yet, Skip":            {batch.SkipCatchUp, nil, justBeforeTheHour(), 0, nil, 0},
+	}
+	for name, tc := range testCases {
+		sj := cronJob()
+		sj.Spec.Schedule = onTheHour
+		sj.Spec.CatchUpPolicy = tc.catchUpPolicy
+		sj.Spec.CatchUpLimit = tc.catchUpLimit
+		sj.ObjectMeta.CreationTimestamp = metav1.Time{Time: justBeforeThePriorHour()}
+		sj.Status.LastScheduleTime = &metav1.Time{Time: justAfterThePriorHour()}
+
+		jc := &fakeJobControl{}
+		sjc := &fakeSJControl{}
+		pc := &fakePodControl{}
+		recorder := record.NewFakeRecorder(200)
+
+		if err := syncOne(&sj, nil, tc.now, jc, sjc, pc, recorder); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if len(jc.Jobs) != tc.expectCreates {
+			t.Errorf("%s: expected %d jobs started, actually %v", name, tc.expectCreates, len(jc.Jobs))
+		}
+		for i := 1; i < len(jc.Jobs); i++ {
+			if jc.Jobs[i-1].Name >= jc.Jobs[i].Name {
+				t.Errorf("%s: expected jobs to be started oldest first, got %s before %s", name, jc.Jobs[i-1].Name, jc.Jobs[i].Name)
+			}
+		}
+
+		lastUpdate := sjc.Updates[len(sjc.Updates)-1]
+		if tc.expectMissed == nil && lastUpdate.Status.LastMissedTime != nil {
+			t.Errorf("%s: expected no missed time, got %v", name, lastUpdate.Status.LastMissedTime)
+		}
+		if tc.expectMissed != nil && (lastUpdate.Status.LastMissedTime == nil || !lastUpdate.Status.LastMissedTime.Time.Equal(*tc.expectMissed)) {
+			t.Errorf("%s: expected missed time %v, got %v", name, *tc.expectMissed, lastUpdate.Status.LastMissedTime)
+		}
+		if tc.expectCreates > 0 {
+			if len(lastUpdate.Status.Active) != tc.expectCreates {
+				t.Errorf("%s: expected Active size %d, got %d", name, tc.expectCreates, len(lastUpdate.Status.Active))
+			}
+			remaining, err := getRecentUnmetScheduleTimes(sj, tc.now, 1)
+			if err != nil || len(remaining) != 0 {
+				t.Errorf("%s: expected all start times to be handled, got %v, %v", name, remaining, err)
+			}
+		}
+
+		numWarnings := 0
+		for i := len(recorder.Events); i > 0; i-- {
+			if e := <-recorder.Events; strings.HasPrefix(e, v1.EventTypeWarning) {
+				numWarnings++
+			}
+		}
+		if numWarnings != tc.expectedWarnings {
+			t.Errorf("%s: expected %d warnings, actually %v", name, tc.expectedWarnings, numWarnings)
+		}
+
+		// A second sync at the same time must not start or miss anything again.
+		jc.Clear()
+		if err := syncOne(&sj, nil, tc.now, jc, sjc, pc, recorder); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if len(jc.Jobs) != 0 {
+			t.Errorf("%s: expected no jobs started by second sync, actually %v", name, len(jc.Jobs))
+		}
+		for i := len(recorder.Events); i > 0; i-- {
+			if e := <-recorder.Events; strings.HasPrefix(e, v1.EventTypeWarning) {
+				t.Errorf("%s: expected no warnings from second sync, got %q", name, e)
+			}
+		}
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
 type CleanupJobSpec struct {
 	StartTime           string
 	IsFinished          bool
@@ -116,17 +116,23 @@ func getNextStartTimeAfter(schedule string, now time.Time) (time.Time, error) {
 	return sched.Next(now), nil
 }
 
-// getRecentUnmetScheduleTimes gets a slice of times (from oldest to latest) that have passed when a Job should have started but did not.
-//
-// If there are too many (>100) unstarted times, just give up and return an empty slice.
-// If there were missed times prior to the last known start time, then those are not returned.
-func getRecentUnmetScheduleTimes(sj batch.CronJob, now time.Time) ([]time.Time, error) {
-	starts := []time.Time{}
-	sched, err := cron.ParseStandard(sj.Spec.Schedule)
+// getLocation returns the location the schedule of a CronJob is evaluated in.
+// CronJobs without a time zone use the location of now, which is the local
+// time zone of the controller.
+func getLocation(sj *batch.CronJob, now time.Time) (*time.Location, error) {
+	if sj.Spec.TimeZone == nil {
+		return now.Location(), nil
+	}
+	loc, err := time.LoadLocation(*sj.Spec.TimeZone)
 	if err != nil {
-		return starts, fmt.Errorf("Unparseable schedule: %s : %s", sj.Spec.Schedule, err)
+		return nil, fmt.Errorf("Unknown time zone: %s : %s", *sj.Spec.TimeZone, err)
 	}
+	return loc, nil
+}
 
+// getEarliestScheduleTime returns the time after which schedule times of a
+// CronJob have not been handled yet.
+func getEarliestScheduleTime(sj batch.CronJob, now time.Time) time.Time {
 	var earliestTime time.Time
 	if sj.Status.LastScheduleTime != nil {
 		earliestTime = sj.Status.LastScheduleTime.Time
@@ -139,6 +145,10 @@ func getRecentUnmetScheduleTimes(sj batch.CronJob, now time.Time) ([]time.Time,
 		// CronJob as last known start time.
 		earliestTime = sj.ObjectMeta.CreationTimestamp.Time
 	}
+	// Schedule times up to the last missed one were deliberately not started.
+	if sj.Status.LastMissedTime != nil && sj.Status.LastMissedTime.After(earliestTime) {
+		earliestTime = sj.Status.LastMissedTime.Time
+	}
 	if sj.Spec.StartingDeadlineSeconds != nil {
 		// Controller is not going to schedule anything below this point
 		schedulingDeadline := now.Add(-time.Second * time.Duration(*sj.Spec.StartingDeadlineSeconds))
@@ -147,35 +157,83 @@ func getRecentUnmetScheduleTimes(sj batch.CronJob, now time.Time) ([]time.Time,
 			earliestTime = schedulingDeadline
 		}
 	}
-	if earliestTime.After(now) {
-		return []time.Time{}, nil
+	return earliestTime
+}
+
+// getRecentUnmetScheduleTimes gets a slice of times (from oldest to latest) that have passed when a Job should have started but did not.
+//
+// At most the limit most recent times are returned, older unmet times are ignored.
+// If there were missed times prior to the last known start time, then those are not returned.
+func getRecentUnmetScheduleTimes(sj batch.CronJob, now time.Time, limit int) ([]time.Time, error) {
+	starts := []time.Time{}
+	sched, err := cron.ParseStandard(sj.Spec.Schedule)
+	if err != nil {
+		return starts, fmt.Errorf("Unparseable schedule: %s : %s", sj.Spec.Schedule, err)
+	}
+	loc, err := getLocation(&sj, now)
+	if err != nil {
+		return starts, err
+	}
+
+	earliestTime := getEarliestScheduleTime(sj, now)
+	if earliestTime.After(now) || limit <= 0 {
+		return starts, nil
 	}
 
-	for t := sched.Next(earliestTime); !t.After(now); t = sched.Next(t) {
-		starts = append(starts, t)
-		// An object might miss several starts.  For example, if
-		// controller gets wedged on friday at 5:01pm when everyone has
-		// gone home, and someone comes in on tuesday AM and discovers
-		// the problem and restarts the controller, then all the hourly
-		// jobs, more than 80 of them for one hourly scheduledJob, should
-		// all start running with no further intervention (if the scheduledJob
-		// allows concurrency and late starts).
-		//
-		// However, if there is a bug somewhere, or incorrect clock
-		// on controller's server or apiservers (for setting creationTimestamp)
-		// then there could be so many missed start times (it could be off
-		// by decades or more), that it would eat up all the CPU and memory
-		// of this controller. In that case, we want to not try to list
-		// all the misseded start times.
-		//
-		// I've somewhat arbitrarily picked 100, as more than 80, but
-		// but less than "lots".
-		if len(starts) > 100 {
-			// We can't get the most recent times so just return an empty slice
-			return []time.Time{}, fmt.Errorf("Too many missed start time (> 100). Set or decrease .spec.startingDeadlineSeconds or check clock skew.")
+	// An object might miss several starts.  For example, if controller gets
+	// wedged on friday at 5:01pm when everyone has gone home, and someone
+	// comes in on tuesday AM and discovers the problem and restarts the
+	// controller, then there are more than 80 missed starts for one hourly
+	// scheduledJob.  If there is a bug somewhere, or an incorrect clock on
+	// the controller's server or apiservers (for setting creationTimestamp),
+	// it could even be off by decades or more.
+	//
+	// Listing all of them from earliestTime on could eat up all the CPU and
+	// memory of this controller, so search backwards from now instead, in a
+	// window that doubles until it holds enough start times or reaches
+	// earliestTime.
+	span := now.Sub(earliestTime)
+	window := time.Minute
+	for {
+		windowStart := earliestTime
+		if window < span {
+			windowStart = now.Add(-window)
 		}
+		starts = starts[:0]
+		for t := sched.Next(windowStart.In(loc)); !t.IsZero() && !t.After(now); t = sched.Next(t) {
+			starts = append(starts, t)
+			if len(starts) > limit {
+				starts = starts[1:]
+			}
+		}
+		if len(starts) == limit || windowStart.Equal(earliestTime) {
+			return starts, nil
+		}
+		// Avoid overflowing the window when earliestTime is centuries ago.
+		if window > span/2 {
+			window = span
+		} else {
+			window *= 2
+		}
+	}
+}
+
+// getNextScheduleTime gets the next time after now a Job of the CronJob
+// should be started at, or nil if the schedule never fires again.
+func getNextScheduleTime(sj batch.CronJob, now time.Time) (*time.Time, error) {
+	sched, err := cron.ParseStandard(sj.Spec.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("Unparseable schedule: %s : %s", sj.Spec.Schedule, err)
+	}
+	loc, err := getLocation(&sj, now)
+	if err != nil {
+		return nil, err
+	}
+	t := sched.Next(now.In(loc))
+	if t.IsZero() {
+		return nil, nil
 	}
-	return starts, nil
+	return &t, nil
 }
 
 // XXX unit test this
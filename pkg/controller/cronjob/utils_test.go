@@ -284,7 +284,7 @@ func TestGetRecentUnmetScheduleTimes(t *testing.T) {
 		sj.ObjectMeta.CreationTimestamp = metav1.Time{Time: T1.Add(-10 * time.Minute)}
 		// Current time is more than creation time, but less than T1.
 		now := T1.Add(-7 * time.Minute)
-		times, err := getRecentUnmetScheduleTimes(sj, now)
+		times, err := getRecentUnmetScheduleTimes(sj, now, 100)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
@@ -298,7 +298,7 @@ func TestGetRecentUnmetScheduleTimes(t *testing.T) {
 		sj.ObjectMeta.CreationTimestamp = metav1.Time{Time: T1.Add(-10 * time.Minute)}
 		// Current time is after T1
 		now := T1.Add(2 * time.Second)
-		times, err := getRecentUnmetScheduleTimes(sj, now)
+		times, err := getRecentUnmetScheduleTimes(sj, now, 100)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
@@ -316,7 +316,7 @@ func TestGetRecentUnmetScheduleTimes(t *testing.T) {
 		sj.Status.LastScheduleTime = &metav1.Time{Time: T1}
 		// Current time is after T1
 		now := T1.Add(2 * time.Minute)
-		times, err := getRecentUnmetScheduleTimes(sj, now)
+		times, err := getRecentUnmetScheduleTimes(sj, now, 100)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
@@ -332,7 +332,7 @@ func TestGetRecentUnmetScheduleTimes(t *testing.T) {
 		sj.Status.LastScheduleTime = &metav1.Time{Time: T1}
 		// Current time is after T1 and after T2
 		now := T2.Add(5 * time.Minute)
-		times, err := getRecentUnmetScheduleTimes(sj, now)
+		times, err := getRecentUnmetScheduleTimes(sj, now, 100)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
@@ -348,7 +348,7 @@ func TestGetRecentUnmetScheduleTimes(t *testing.T) {
 		sj.Status.LastScheduleTime = &metav1.Time{Time: T1.Add(-1 * time.Hour)}
 		// Current time is after T1 and after T2
 		now := T2.Add(5 * time.Minute)
-		times, err := getRecentUnmetScheduleTimes(sj, now)
+		times, err := getRecentUnmetScheduleTimes(sj, now, 100)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
@@ -368,10 +368,53 @@ func TestGetRecentUnmetScheduleTimes(t *testing.T) {
 		sj.ObjectMeta.CreationTimestamp = metav1.Time{Time: T1.Add(-2 * time.Hour)}
 		sj.Status.LastScheduleTime = &metav1.Time{Time: T1.Add(-1 * time.Hour)}
 		now := T2.Add(10 * 24 * time.Hour)
-		_, err := getRecentUnmetScheduleTimes(sj, now)
-		if err == nil {
-			t.Errorf("unexpected lack of error")
+		times, err := getRecentUnmetScheduleTimes(sj, now, 100)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// Only the most recent start times are returned.
+		if len(times) != 100 {
+			t.Errorf("expected 100 start times, got: %v", len(times))
+		} else {
+			if !times[99].Equal(T2.Add(10 * 24 * time.Hour)) {
+				t.Errorf("expected: %v, got: %v", T2.Add(10*24*time.Hour), times[99])
+			}
+			if !times[0].Equal(T2.Add(10*24*time.Hour - 99*time.Hour)) {
+				t.Errorf("expected: %v, got: %v", T2.Add(10*24*time.Hour-99*time.Hour), times[0])
+			}
+		}
+	}
+	{
+		// Case 6b: now is decades ahead of last start time, and only the latest start time is needed.
+		sj.ObjectMeta.CreationTimestamp = metav1.Time{Time: T1.Add(-2 * time.Hour)}
+		sj.Status.LastScheduleTime = &metav1.Time{Time: T1.Add(-1 * time.Hour)}
+		now := T2.AddDate(50, 0, 0).Add(30 * time.Minute)
+		times, err := getRecentUnmetScheduleTimes(sj, now, 1)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if len(times) != 1 {
+			t.Errorf("expected 1 start time, got: %v", times)
+		} else if !times[0].Equal(T2.AddDate(50, 0, 0)) {
+			t.Errorf("expected: %v, got: %v", T2.AddDate(50, 0, 0), times[0])
+		}
+	}
+	{
+		// Case 6c: a missed start time was recorded after the last start time.
+		sj.ObjectMeta.CreationTimestamp = metav1.Time{Time: T1.Add(-2 * time.Hour)}
+		sj.Status.LastScheduleTime = &metav1.Time{Time: T1.Add(-1 * time.Hour)}
+		sj.Status.LastMissedTime = &metav1.Time{Time: T1}
+		now := T2.Add(5 * time.Minute)
+		times, err := getRecentUnmetScheduleTimes(sj, now, 100)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if len(times) != 1 {
+			t.Errorf("expected 1 start time, got: %v", times)
+		} else if !times[0].Equal(T2) {
+			t.Errorf("expected: %v, got: %v", T2, times[0])
 		}
+		sj.Status.LastMissedTime = nil
 	}
 	{
 		// Case 7: now is way way ahead of last start time, but there is a short deadline.
@@ -381,10 +424,71 @@ func TestGetRecentUnmetScheduleTimes(t *testing.T) {
 		// Deadline is short
 		deadline := int64(2 * 60 * 60)
 		sj.Spec.StartingDeadlineSeconds = &deadline
-		_, err := getRecentUnmetScheduleTimes(sj, now)
+		_, err := getRecentUnmetScheduleTimes(sj, now, 100)
 		if err != nil {
 			t.Errorf("unexpected error")
 		}
+		sj.Spec.StartingDeadlineSeconds = nil
+	}
+	{
+		// Case 8: the schedule is evaluated in the time zone of the CronJob.
+		sj.Spec.Schedule = "0 9 * * ?"
+		timeZone := "Asia/Tokyo"
+		sj.Spec.TimeZone = &timeZone
+		sj.ObjectMeta.CreationTimestamp = metav1.Time{Time: T1.Add(-2 * time.Hour)}
+		sj.Status.LastScheduleTime = nil
+		// 9:00 in Tokyo is midnight UTC.
+		now := T1.Add(14*time.Hour + 5*time.Minute)
+		times, err := getRecentUnmetScheduleTimes(sj, now, 100)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		expected := T1.Add(14 * time.Hour)
+		if len(times) != 1 {
+			t.Errorf("expected 1 start time, got: %v", times)
+		} else if !times[0].Equal(expected) {
+			t.Errorf("expected: %v, got: %v", expected, times[0])
+		}
+	}
+
+}
+
+func TestGetNextScheduleTime(t *testing.T) {
+	now, err := time.Parse(time.RFC3339, "2016-05-19T10:30:00Z")
+	if err != nil {
+		t.Errorf("test setup error: %v", err)
+	}
+	newYork := "America/New_York"
+
+	testCases := map[string]struct {
+		schedule string
+		timeZone *string
+		expected string
+	}{
+		"hourly":                    {"0 * * * ?", nil, "2016-05-19T11:00:00Z"},
+		"daily in time zone":        {"0 9 * * ?", &newYork, "2016-05-19T13:00:00Z"},
+		"daily in time zone, later": {"0 6 * * ?", &newYork, "2016-05-20T10:00:00Z"},
+	}
+	for name, tc := range testCases {
+		sj := batch.CronJob{
+			Spec: batch.CronJobSpec{
+				Schedule: tc.schedule,
+				TimeZone: tc.timeZone,
+			},
+		}
+		next, err := getNextScheduleTime(sj, now)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		expected, _ := time.Parse(time.RFC3339, tc.expected)
+		if next == nil || !next.Equal(expected) {
+			t.Errorf("%s: expected %v, got %v", name, expected, next)
+		}
 	}
 
+	sj := batch.CronJob{Spec: batch.CronJobSpec{Schedule: "0 0 30 2 ?"}}
+	if next, err := getNextScheduleTime(sj, now); err != nil || next != nil {
+		t.Errorf("expected no next schedule time for a schedule that never fires, got %v, %v", next, err)
+	}
 }
@@ -17,22 +17,37 @@ limitations under the License.
 package daemon
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/golang/glog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	intstrutil "k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
+	apps "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
 	extensions "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
 	"k8s.io/kubernetes/pkg/controller/daemon/util"
+	"k8s.io/kubernetes/pkg/controller/history"
 )
 
+// controllerKind contains the schema.GroupVersionKind for this controller type.
+var controllerKind = extensions.SchemeGroupVersion.WithKind("DaemonSet")
+
+// patchCodec is the codec used to encode the DaemonSets recorded in ControllerRevisions.
+var patchCodec = api.Codecs.LegacyCodec(extensions.SchemeGroupVersion)
+
+// defaultRevisionHistoryLimit is the number of old ControllerRevisions retained
+// when a DaemonSet does not specify spec.revisionHistoryLimit.
+const defaultRevisionHistoryLimit = 10
+
 // rollingUpdate deletes old daemon set pods making sure that no more than
 // ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable pods are unavailable
-func (dsc *DaemonSetsController) rollingUpdate(ds *extensions.DaemonSet) error {
-	newPods, oldPods, err := dsc.getAllDaemonSetPods(ds)
+func (dsc *DaemonSetsController) rollingUpdate(ds *extensions.DaemonSet, hash string) error {
+	newPods, oldPods, err := dsc.getAllDaemonSetPods(ds, hash)
 	allPods := append(oldPods, newPods...)
 
 	maxUnavailable, numUnavailable, err := dsc.getUnavailableNumbers(ds, allPods)
@@ -63,11 +78,157 @@ func (dsc *DaemonSetsController) rollingUpdate(ds *extensions.DaemonSet) error {
 		podsToDelete = append(podsToDelete, pod.Name)
 		numUnavailable++
 	}
-	errors := dsc.syncNodes(ds, podsToDelete, []string{})
+	errors := dsc.syncNodes(ds, podsToDelete, []string{}, hash)
 	return utilerrors.NewAggregate(errors)
 }
 
-func (dsc *DaemonSetsController) getAllDaemonSetPods(ds *extensions.DaemonSet) ([]*v1.Pod, []*v1.Pod, error) {
+// constructHistory finds all ControllerRevisions controlled by ds and returns the revision that matches the current
+// pod template of ds, along with the remaining revisions sorted by Revision. If no revision matches the current
+// template, a new one is created. If the matching revision is not the most recent one (i.e. the DaemonSet has been
+// rolled back), its Revision is bumped so that it becomes the most recent.
+func (dsc *DaemonSetsController) constructHistory(ds *extensions.DaemonSet) (*apps.ControllerRevision, []*apps.ControllerRevision, error) {
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	revisions, err := dsc.controllerHistory.ListControllerRevisions(ds, selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	history.SortControllerRevisions(revisions)
+
+	updateRevision, err := newRevision(ds, nextRevision(revisions))
+	if err != nil {
+		return nil, nil, err
+	}
+	var cur *apps.ControllerRevision
+	equalRevisions := history.FindEqualRevisions(revisions, updateRevision)
+	equalCount := len(equalRevisions)
+	revisionCount := len(revisions)
+	if equalCount > 0 && history.EqualRevision(revisions[revisionCount-1], equalRevisions[equalCount-1]) {
+		// the current template is the same as the last revision
+		cur = revisions[revisionCount-1]
+	} else if equalCount > 0 {
+		// the current template is equivalent to an older revision, this is a rollback
+		cur, err = dsc.controllerHistory.UpdateControllerRevision(equalRevisions[equalCount-1], updateRevision.Revision)
+	} else {
+		// the current template has never been recorded
+		cur, err = dsc.controllerHistory.CreateControllerRevision(ds, updateRevision)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var old []*apps.ControllerRevision
+	for i := range revisions {
+		if revisions[i].Name != cur.Name {
+			old = append(old, revisions[i])
+		}
+	}
+	return cur, old, nil
+}
+
+// cleanupHistory deletes the oldest ControllerRevisions in old until no more than ds.Spec.RevisionHistoryLimit
+// remain. Revisions that are still referenced by a live daemon pod are never deleted. old must be sorted by Revision.
+func (dsc *DaemonSetsController) cleanupHistory(ds *extensions.DaemonSet, old []*apps.ControllerRevision) error {
+	limit := defaultRevisionHistoryLimit
+	if ds.Spec.RevisionHistoryLimit != nil {
+		limit = int(*ds.Spec.RevisionHistoryLimit)
+	}
+	toKill := len(old) - limit
+	if toKill <= 0 {
+		return nil
+	}
+
+	nodeToDaemonPods, err := dsc.getNodesToDaemonPods(ds)
+	if err != nil {
+		return fmt.Errorf("error getting node to daemon pod mapping for daemon set %#v: %v", ds, err)
+	}
+	liveHashes := make(map[string]bool)
+	for _, pods := range nodeToDaemonPods {
+		for _, pod := range pods {
+			if hash := pod.Labels[extensions.DefaultDaemonSetUniqueLabelKey]; len(hash) > 0 {
+				liveHashes[hash] = true
+			}
+		}
+	}
+
+	for i := 0; i < len(old) && toKill > 0; i++ {
+		if liveHashes[old[i].Labels[history.ControllerRevisionHashLabel]] {
+			continue
+		}
+		if err := dsc.controllerHistory.DeleteControllerRevision(old[i]); err != nil {
+			return err
+		}
+		toKill--
+	}
+	return nil
+}
+
+// getPatch returns a strategic merge patch that can be applied to restore a DaemonSet to a
+// previous version. If the returned error is nil the patch is valid. The current state that we save is just the
+// PodSpecTemplate. We can modify this later to encompass more state (or less) and remain compatible with previously
+// recorded patches.
+func getPatch(ds *extensions.DaemonSet) ([]byte, error) {
+	obj, err := api.Scheme.DeepCopy(ds)
+	if err != nil {
+		return nil, err
+	}
+	str, err := runtime.Encode(patchCodec, obj.(*extensions.DaemonSet))
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(str, &raw); err != nil {
+		return nil, err
+	}
+	objCopy := make(map[string]interface{})
+	specCopy := make(map[string]interface{})
+	spec := raw["spec"].(map[string]interface{})
+	template := spec["template"].(map[string]interface{})
+	specCopy["template"] = template
+	template["$patch"] = "replace"
+	objCopy["spec"] = specCopy
+	return json.Marshal(objCopy)
+}
+
+// newRevision creates a new ControllerRevision containing a patch that reapplies the pod template of ds.
+// The Revision of the returned ControllerRevision is set to revision. The annotations of ds are copied
+// to the returned ControllerRevision so that its change-cause is preserved.
+func newRevision(ds *extensions.DaemonSet, revision int64) (*apps.ControllerRevision, error) {
+	patch, err := getPatch(ds)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := history.NewControllerRevision(ds,
+		controllerKind,
+		ds.Spec.Template.Labels,
+		runtime.RawExtension{Raw: patch},
+		revision)
+	if err != nil {
+		return nil, err
+	}
+	if cr.ObjectMeta.Annotations == nil {
+		cr.ObjectMeta.Annotations = make(map[string]string)
+	}
+	for key, value := range ds.Annotations {
+		cr.ObjectMeta.Annotations[key] = value
+	}
+	return cr, nil
+}
+
+// nextRevision finds the next valid revision number based on revisions. If the length of revisions
+// is 0 this is 1. Otherwise, it is 1 greater than the largest revision's Revision. This method
+// assumes that revisions has been sorted by Revision.
+func nextRevision(revisions []*apps.ControllerRevision) int64 {
+	count := len(revisions)
+	if count <= 0 {
+		return 1
+	}
+	return revisions[count-1].Revision + 1
+}
+
+func (dsc *DaemonSetsController) getAllDaemonSetPods(ds *extensions.DaemonSet, hash string) ([]*v1.Pod, []*v1.Pod, error) {
 	var newPods []*v1.Pod
 	var oldPods []*v1.Pod
 
@@ -80,7 +241,7 @@ func (dsc *DaemonSetsController) getAllDaemonSetPods(ds *extensions.DaemonSet) (
 		return newPods, oldPods, fmt.Errorf("Couldn't get list of pods for daemon set %#v: %v", ds, err)
 	}
 	for _, pod := range daemonPods {
-		if util.IsPodUpdated(ds.Spec.TemplateGeneration, pod) {
+		if util.IsPodUpdated(ds.Spec.TemplateGeneration, pod, hash) {
 			newPods = append(newPods, pod)
 		} else {
 			oldPods = append(oldPods, pod)
@@ -19,8 +19,12 @@ package daemon
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/api/v1"
+	apps "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
 	extensions "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	"k8s.io/kubernetes/pkg/controller/history"
 )
 
 func TestDaemonSetUpdatesPods(t *testing.T) {
@@ -133,3 +137,132 @@ func TestDaemonSetUpdatesNoTemplateChanged(t *testing.T) {
 	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0)
 	clearExpectations(t, manager, ds, podControl)
 }
+
+func TestDaemonSetRecordsHistory(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore, 0, 5, nil)
+	ds := newDaemonSet("foo")
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 5, 0)
+
+	revisions := listRevisions(t, manager, ds)
+	if len(revisions) != 1 {
+		t.Fatalf("expected 1 revision, got %d", len(revisions))
+	}
+	if revisions[0].Revision != 1 {
+		t.Errorf("expected revision 1, got %d", revisions[0].Revision)
+	}
+	hash := revisions[0].Labels[history.ControllerRevisionHashLabel]
+	for _, obj := range podControl.podStore.List() {
+		if podHash := obj.(*v1.Pod).Labels[extensions.DefaultDaemonSetUniqueLabelKey]; podHash != hash {
+			t.Errorf("expected pod to be labeled with hash %s, got %s", hash, podHash)
+		}
+	}
+
+	ds.Spec.Template.Spec.Containers[0].Image = "foo2/bar2"
+	ds.Spec.TemplateGeneration++
+	manager.dsStore.Update(ds)
+	clearExpectations(t, manager, ds, podControl)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0)
+
+	revisions = listRevisions(t, manager, ds)
+	if len(revisions) != 2 {
+		t.Fatalf("expected 2 revisions, got %d", len(revisions))
+	}
+	if revisions[1].Revision != 2 {
+		t.Errorf("expected revision 2, got %d", revisions[1].Revision)
+	}
+}
+
+func TestDaemonSetRollback(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	maxUnavailable := 2
+	addNodes(manager.nodeStore, 0, 5, nil)
+	ds := newDaemonSet("foo")
+	ds.Spec.UpdateStrategy.Type = extensions.RollingUpdateDaemonSetStrategyType
+	intStr := intstr.FromInt(maxUnavailable)
+	ds.Spec.UpdateStrategy.RollingUpdate = &extensions.RollingUpdateDaemonSet{MaxUnavailable: &intStr}
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 5, 0)
+	markPodsReady(podControl.podStore)
+
+	// update the template, replacing maxUnavailable pods
+	ds.Spec.Template.Spec.Containers[0].Image = "foo2/bar2"
+	ds.Spec.TemplateGeneration++
+	manager.dsStore.Update(ds)
+	clearExpectations(t, manager, ds, podControl)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, maxUnavailable)
+	clearExpectations(t, manager, ds, podControl)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, maxUnavailable, 0)
+	markPodsReady(podControl.podStore)
+
+	// roll back to the original template, only the updated pods should be replaced
+	ds.Spec.Template.Spec.Containers[0].Image = "foo/bar"
+	ds.Spec.TemplateGeneration++
+	manager.dsStore.Update(ds)
+	clearExpectations(t, manager, ds, podControl)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, maxUnavailable)
+	clearExpectations(t, manager, ds, podControl)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, maxUnavailable, 0)
+	markPodsReady(podControl.podStore)
+	clearExpectations(t, manager, ds, podControl)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0)
+
+	revisions := listRevisions(t, manager, ds)
+	if len(revisions) != 2 {
+		t.Fatalf("expected 2 revisions, got %d", len(revisions))
+	}
+	cur := revisions[len(revisions)-1]
+	if cur.Revision != 3 {
+		t.Errorf("expected the rolled back revision to be renumbered to 3, got %d", cur.Revision)
+	}
+	hash := cur.Labels[history.ControllerRevisionHashLabel]
+	for _, obj := range podControl.podStore.List() {
+		if podHash := obj.(*v1.Pod).Labels[extensions.DefaultDaemonSetUniqueLabelKey]; podHash != hash {
+			t.Errorf("expected pod to be labeled with hash %s, got %s", hash, podHash)
+		}
+	}
+}
+
+func TestDaemonSetCleanupHistory(t *testing.T) {
+	manager, podControl, _ := newTestController()
+	addNodes(manager.nodeStore, 0, 5, nil)
+	ds := newDaemonSet("foo")
+	limit := int32(1)
+	ds.Spec.RevisionHistoryLimit = &limit
+	manager.dsStore.Add(ds)
+	syncAndValidateDaemonSets(t, manager, ds, podControl, 5, 0)
+
+	// with the OnDelete strategy the pods keep running the first revision
+	for _, image := range []string{"foo2/bar2", "foo3/bar3", "foo4/bar4"} {
+		ds.Spec.Template.Spec.Containers[0].Image = image
+		ds.Spec.TemplateGeneration++
+		manager.dsStore.Update(ds)
+		clearExpectations(t, manager, ds, podControl)
+		syncAndValidateDaemonSets(t, manager, ds, podControl, 0, 0)
+	}
+
+	revisions := listRevisions(t, manager, ds)
+	if len(revisions) != 2 {
+		t.Fatalf("expected 2 revisions, got %d", len(revisions))
+	}
+	if revisions[0].Revision != 1 {
+		t.Errorf("expected the live revision 1 to be retained, got %d", revisions[0].Revision)
+	}
+	if revisions[1].Revision != 4 {
+		t.Errorf("expected the current revision 4 to be retained, got %d", revisions[1].Revision)
+	}
+}
+
+func listRevisions(t *testing.T, manager *daemonSetsController, ds *extensions.DaemonSet) []*apps.ControllerRevision {
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revisions, err := manager.controllerHistory.ListControllerRevisions(ds, selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	history.SortControllerRevisions(revisions)
+	return revisions
+}
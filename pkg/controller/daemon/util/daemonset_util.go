@@ -26,9 +26,10 @@ import (
 	labelsutil "k8s.io/kubernetes/pkg/util/labels"
 )
 
-// GetPodTemplateWithHash returns copy of provided template with additional
-// label which contains hash of provided template
-func GetPodTemplateWithGeneration(template v1.PodTemplateSpec, generation int64) v1.PodTemplateSpec {
+// CreatePodTemplate returns copy of provided template with additional
+// labels which contain the template generation and the hash of the
+// ControllerRevision the template was recorded in
+func CreatePodTemplate(template v1.PodTemplateSpec, generation int64, hash string) v1.PodTemplateSpec {
 	obj, _ := api.Scheme.DeepCopy(template)
 	newTemplate := obj.(v1.PodTemplateSpec)
 	templateGenerationStr := fmt.Sprint(generation)
@@ -37,14 +38,20 @@ func GetPodTemplateWithGeneration(template v1.PodTemplateSpec, generation int64)
 		extensions.DaemonSetTemplateGenerationKey,
 		templateGenerationStr,
 	)
+	if len(hash) > 0 {
+		newTemplate.ObjectMeta.Labels[extensions.DefaultDaemonSetUniqueLabelKey] = hash
+	}
 	return newTemplate
 }
 
-// IsPodUpdate checks if pod contains label with provided hash
-func IsPodUpdated(dsTemplateGeneration int64, pod *v1.Pod) bool {
+// IsPodUpdated checks if pod contains label value that either matches the
+// provided template generation or the provided ControllerRevision hash
+func IsPodUpdated(dsTemplateGeneration int64, pod *v1.Pod, hash string) bool {
 	podTemplateGeneration, generationExists := pod.ObjectMeta.Labels[extensions.DaemonSetTemplateGenerationKey]
 	dsTemplateGenerationStr := fmt.Sprint(dsTemplateGeneration)
-	return generationExists && podTemplateGeneration == dsTemplateGenerationStr
+	templateMatches := generationExists && podTemplateGeneration == dsTemplateGenerationStr
+	hashMatches := len(hash) > 0 && pod.ObjectMeta.Labels[extensions.DefaultDaemonSetUniqueLabelKey] == hash
+	return hashMatches || templateMatches
 }
 
 // SplitByAvailablePods splits provided daemon set pods by availabilty
@@ -50,43 +50,81 @@ func TestIsPodUpdated(t *testing.T) {
 	tests := []struct {
 		templateGeneration int64
 		pod                *v1.Pod
+		hash               string
 		isUpdated          bool
 	}{
 		{
 			int64(12345),
 			newPod("pod1", "node1", map[string]string{extensions.DaemonSetTemplateGenerationKey: "12345"}),
+			"",
 			true,
 		},
 		{
 			int64(12355),
 			newPod("pod1", "node1", map[string]string{extensions.DaemonSetTemplateGenerationKey: "12345"}),
+			"",
 			false,
 		},
 		{
 			int64(12355),
 			newPod("pod1", "node1", map[string]string{}),
+			"",
 			false,
 		},
 		{
 			int64(12355),
 			newPod("pod1", "node1", nil),
+			"",
 			false,
 		},
+		{
+			int64(12355),
+			newPod("pod1", "node1", map[string]string{extensions.DaemonSetTemplateGenerationKey: "12345", extensions.DefaultDaemonSetUniqueLabelKey: "54321"}),
+			"54321",
+			true,
+		},
+		{
+			int64(12355),
+			newPod("pod1", "node1", map[string]string{extensions.DaemonSetTemplateGenerationKey: "12345", extensions.DefaultDaemonSetUniqueLabelKey: "54321"}),
+			"12345",
+			false,
+		},
+		{
+			int64(12345),
+			newPod("pod1", "node1", map[string]string{extensions.DaemonSetTemplateGenerationKey: "12345", extensions.DefaultDaemonSetUniqueLabelKey: "54321"}),
+			"12345",
+			true,
+		},
 	}
 	for _, test := range tests {
-		updated := IsPodUpdated(test.templateGeneration, test.pod)
+		updated := IsPodUpdated(test.templateGeneration, test.pod, test.hash)
 		if updated != test.isUpdated {
-			t.Errorf("IsPodUpdated returned wrong value. Expected %t, got %t. TemplateGeneration: %d", test.isUpdated, updated, test.templateGeneration)
+			t.Errorf("IsPodUpdated returned wrong value. Expected %t, got %t. TemplateGeneration: %d, hash: %q", test.isUpdated, updated, test.templateGeneration, test.hash)
 		}
 	}
 }
 
-func TestGetPodTemplateWithGeneration(t *testing.T) {
-	generation := int64(1)
-	podTemplateSpec := v1.PodTemplateSpec{}
-	newPodTemplate := GetPodTemplateWithGeneration(podTemplateSpec, generation)
-	label, exists := newPodTemplate.ObjectMeta.Labels[extensions.DaemonSetTemplateGenerationKey]
-	if !exists || label != fmt.Sprint(generation) {
-		t.Errorf("Error in getting podTemplateSpec with label generation. Exists: %t, label: %s", exists, label)
+func TestCreatePodTemplate(t *testing.T) {
+	tests := []struct {
+		templateGeneration int64
+		hash               string
+	}{
+		{int64(1), ""},
+		{int64(2), "12345"},
+	}
+	for _, test := range tests {
+		podTemplateSpec := v1.PodTemplateSpec{}
+		newPodTemplate := CreatePodTemplate(podTemplateSpec, test.templateGeneration, test.hash)
+		label, exists := newPodTemplate.ObjectMeta.Labels[extensions.DaemonSetTemplateGenerationKey]
+		if !exists || label != fmt.Sprint(test.templateGeneration) {
+			t.Errorf("Error in getting podTemplateSpec with label generation. Exists: %t, label: %s", exists, label)
+		}
+		hash, exists := newPodTemplate.ObjectMeta.Labels[extensions.DefaultDaemonSetUniqueLabelKey]
+		if len(test.hash) == 0 && exists {
+			t.Errorf("Expected no hash label, got %s", hash)
+		}
+		if len(test.hash) > 0 && (!exists || hash != test.hash) {
+			t.Errorf("Error in getting podTemplateSpec with label hash. Exists: %t, label: %s", exists, hash)
+		}
 	}
 }
@@ -17,16 +17,21 @@ limitations under the License.
 package disruption
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 	clientv1 "k8s.io/client-go/pkg/api/v1"
 	"k8s.io/client-go/tools/cache"
@@ -94,6 +99,14 @@ type DisruptionController struct {
 	recorder    record.EventRecorder
 
 	getUpdater func() updater
+
+	// getScale returns the scale of the controller referenced by the given
+	// ControllerRef, for controllers of kinds the disruption controller does
+	// not watch. Can be replaced in tests.
+	getScale scaleGetter
+	// mapper maps the kinds of the controllers to their resources, it caches
+	// the discovery information.
+	mapper *discovery.DeferredDiscoveryRESTMapper
 }
 
 // controllerAndScale is used to return (controller, scale) pairs from the
@@ -107,6 +120,19 @@ type controllerAndScale struct {
 // controllers and their scale.
 type podControllerFinder func(*v1.Pod) ([]controllerAndScale, error)
 
+// scaleGetter is a function type that returns the scale of the controller
+// referenced by a ControllerRef in the given namespace.
+type scaleGetter func(namespace string, controllerRef *metav1.OwnerReference) (int32, error)
+
+// scaleSubresource holds the fields of the scale subresource that are the same
+// in all of the API groups serving it.
+type scaleSubresource struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     struct {
+		Replicas int32 `json:"replicas"`
+	} `json:"spec"`
+}
+
 func NewDisruptionController(
 	podInformer coreinformers.PodInformer,
 	pdbInformer policyinformers.PodDisruptionBudgetInformer,
@@ -125,6 +151,10 @@ func NewDisruptionController(
 	dc.recorder = dc.broadcaster.NewRecorder(api.Scheme, clientv1.EventSource{Component: "controllermanager"})
 
 	dc.getUpdater = func() updater { return dc.writePdbStatus }
+	dc.getScale = dc.getScaleFromSubresource
+	if kubeClient != nil {
+		dc.mapper = discovery.NewDeferredDiscoveryRESTMapper(cached.NewMemCacheClient(kubeClient.Discovery()), meta.InterfacesForUnstructured)
+	}
 
 	podInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    dc.addPod,
@@ -160,18 +190,54 @@ func NewDisruptionController(
 	return dc
 }
 
-// TODO(mml): When controllerRef is implemented (#2210), we *could* simply
-// return controllers without their scales, and access scale type-generically
-// via the scale subresource.  That may not be as much of a win as it sounds,
-// however.  We are accessing everything through the pkg/client/cache API that
-// we have to set up and tune to the types we know we'll be accessing anyway,
-// and we may well need further tweaks just to be able to access scale
-// subresources.
+// The finders only cover the controller kinds whose listers the disruption
+// controller already maintains. Pods owned by any other kind of controller are
+// resolved through their ControllerRef and the scale subresource of the owner,
+// see getScaleFromSubresource.
 func (dc *DisruptionController) finders() []podControllerFinder {
 	return []podControllerFinder{dc.getPodReplicationControllers, dc.getPodDeployments, dc.getPodReplicaSets,
 		dc.getPodStatefulSets}
 }
 
+// getScaleFromSubresource reads the scale subresource of the controller
+// referenced by controllerRef. The resource serving the controller's kind is
+// looked up through the discovery based RESTMapper, so any controller that
+// implements the scale subresource is supported.
+func (dc *DisruptionController) getScaleFromSubresource(namespace string, controllerRef *metav1.OwnerReference) (int32, error) {
+	gv, err := schema.ParseGroupVersion(controllerRef.APIVersion)
+	if err != nil {
+		return 0, err
+	}
+	mapping, err := dc.mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: controllerRef.Kind}, gv.Version)
+	if err != nil {
+		// The kind may have been registered since the discovery information
+		// was cached, retrieve it again on the next sync.
+		dc.mapper.Reset()
+		return 0, fmt.Errorf("no resource found for %s %q in %s: %v", controllerRef.Kind, controllerRef.Name, controllerRef.APIVersion, err)
+	}
+
+	prefix := "/apis"
+	if len(gv.Group) == 0 {
+		prefix = "/api"
+	}
+	data, err := dc.kubeClient.Discovery().RESTClient().Get().
+		AbsPath(prefix, gv.Group, gv.Version, "namespaces", namespace, mapping.Resource, controllerRef.Name, "scale").
+		SetHeader("Accept", "application/json").
+		DoRaw()
+	if err != nil {
+		return 0, err
+	}
+	scale := &scaleSubresource{}
+	if err := json.Unmarshal(data, scale); err != nil {
+		return 0, err
+	}
+	// The controller may have been recreated with the same name.
+	if len(scale.Metadata.UID) != 0 && scale.Metadata.UID != controllerRef.UID {
+		return 0, fmt.Errorf("%s %q has UID %v, expected %v", controllerRef.Kind, controllerRef.Name, scale.Metadata.UID, controllerRef.UID)
+	}
+	return scale.Spec.Replicas, nil
+}
+
 // getPodReplicaSets finds replicasets which have no matching deployments.
 func (dc *DisruptionController) getPodReplicaSets(pod *v1.Pod) ([]controllerAndScale, error) {
 	cas := []controllerAndScale{}
@@ -550,6 +616,20 @@ func (dc *DisruptionController) getExpectedPodCount(pdb *policy.PodDisruptionBud
 					controllerCount++
 				}
 			}
+			if controllerCount == 0 {
+				if controllerRef := controller.GetControllerOf(pod); controllerRef != nil {
+					if _, found := controllerScale[controllerRef.UID]; !found {
+						var scale int32
+						scale, err = dc.getScale(pod.Namespace, controllerRef)
+						if err != nil {
+							dc.recorder.Event(pdb, v1.EventTypeWarning, "CalculateExpectedPodCountFailed", err.Error())
+							return
+						}
+						controllerScale[controllerRef.UID] = scale
+					}
+					controllerCount++
+				}
+			}
 			if controllerCount == 0 {
 				err = fmt.Errorf("asked for percentage, but found no controllers for pod %q", pod.Name)
 				dc.recorder.Event(pdb, v1.EventTypeWarning, "NoControllers", err.Error())
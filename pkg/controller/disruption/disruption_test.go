@@ -496,6 +496,55 @@ func TestStatefulSetController(t *testing.T) {
 	}
 }
 
+func TestScaleSubresourceController(t *testing.T) {
+	dc, ps := newFakeDisruptionController()
+
+	// The pods are owned by a controller of a kind the disruption controller
+	// does not watch, so its scale is read from the scale subresource.
+	isController := true
+	controllerRef := metav1.OwnerReference{
+		APIVersion: "example.com/v1",
+		Kind:       "CustomController",
+		Name:       "foobar",
+		UID:        uuid.NewUUID(),
+		Controller: &isController,
+	}
+	scaleCalls := 0
+	dc.getScale = func(namespace string, ref *metav1.OwnerReference) (int32, error) {
+		scaleCalls++
+		if namespace != metav1.NamespaceDefault || !reflect.DeepEqual(*ref, controllerRef) {
+			return 0, fmt.Errorf("unexpected scale request for %s/%s", namespace, ref.Name)
+		}
+		return 3, nil
+	}
+
+	// 34% should round up to 2
+	pdb, pdbName := newPodDisruptionBudget(t, intstr.FromString("34%"))
+	add(t, dc.pdbStore, pdb)
+
+	for i := int32(0); i < 3; i++ {
+		pod, _ := newPod(t, fmt.Sprintf("foobar %d", i))
+		pod.OwnerReferences = []metav1.OwnerReference{controllerRef}
+		add(t, dc.podStore, pod)
+		dc.sync(pdbName)
+		if i < 2 {
+			ps.VerifyPdbStatus(t, pdbName, 0, i+1, 2, 3, map[string]metav1.Time{})
+		} else {
+			ps.VerifyPdbStatus(t, pdbName, 1, 3, 2, 3, map[string]metav1.Time{})
+		}
+	}
+	// One scale request per sync, no matter how many pods the controller owns.
+	if scaleCalls != 3 {
+		t.Errorf("expected 3 scale requests, got %d", scaleCalls)
+	}
+
+	dc.getScale = func(namespace string, ref *metav1.OwnerReference) (int32, error) {
+		return 0, fmt.Errorf("%s %q does not implement the scale subresource", ref.Kind, ref.Name)
+	}
+	dc.sync(pdbName)
+	ps.VerifyDisruptionAllowed(t, pdbName, 0)
+}
+
 func TestTwoControllers(t *testing.T) {
 	// Most of this test is in verifying intermediate cases as we define the
 	// three controllers and create the pods.
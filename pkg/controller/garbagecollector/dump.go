@@ -0,0 +1,187 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package garbagecollector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// debugNode is the serialized form of a node of the dependency graph.
+type debugNode struct {
+	UID                types.UID   `json:"uid"`
+	APIVersion         string      `json:"apiVersion"`
+	Kind               string      `json:"kind"`
+	Namespace          string      `json:"namespace,omitempty"`
+	Name               string      `json:"name"`
+	BeingDeleted       bool        `json:"beingDeleted,omitempty"`
+	DeletingDependents bool        `json:"deletingDependents,omitempty"`
+	Owners             []types.UID `json:"owners,omitempty"`
+	BlockingOwners     []types.UID `json:"blockingOwners,omitempty"`
+	Dependents         []types.UID `json:"dependents,omitempty"`
+}
+
+func toDebugNode(n *node) debugNode {
+	ret := debugNode{
+		UID:                n.identity.UID,
+		APIVersion:         n.identity.APIVersion,
+		Kind:               n.identity.Kind,
+		Namespace:          n.identity.Namespace,
+		Name:               n.identity.Name,
+		BeingDeleted:       n.isBeingDeleted(),
+		DeletingDependents: n.isDeletingDependents(),
+	}
+	for _, owner := range n.getOwners() {
+		ret.Owners = append(ret.Owners, owner.UID)
+		if owner.BlockOwnerDeletion != nil && *owner.BlockOwnerDeletion {
+			ret.BlockingOwners = append(ret.BlockingOwners, owner.UID)
+		}
+	}
+	for _, dep := range n.getDependents() {
+		ret.Dependents = append(ret.Dependents, dep.identity.UID)
+	}
+	sort.Sort(uidSlice(ret.Dependents))
+	return ret
+}
+
+type uidSlice []types.UID
+
+func (s uidSlice) Len() int           { return len(s) }
+func (s uidSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s uidSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type nodesByUID []*node
+
+func (s nodesByUID) Len() int           { return len(s) }
+func (s nodesByUID) Less(i, j int) bool { return s[i].identity.UID < s[j].identity.UID }
+func (s nodesByUID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// selectNodes returns the nodes of the graph sorted by UID. If uids is not
+// empty, only the nodes with those UIDs and the nodes transitively connected
+// to them through owner references are returned.
+func (gb *GraphBuilder) selectNodes(uids []types.UID) []*node {
+	var nodes []*node
+	if len(uids) == 0 {
+		nodes = gb.uidToNode.List()
+	} else {
+		visited := map[types.UID]*node{}
+		queue := []types.UID{}
+		queue = append(queue, uids...)
+		for len(queue) > 0 {
+			uid := queue[0]
+			queue = queue[1:]
+			if _, ok := visited[uid]; ok {
+				continue
+			}
+			n, ok := gb.uidToNode.Read(uid)
+			if !ok {
+				continue
+			}
+			visited[uid] = n
+			for _, owner := range n.getOwners() {
+				queue = append(queue, owner.UID)
+			}
+			for _, dep := range n.getDependents() {
+				queue = append(queue, dep.identity.UID)
+			}
+		}
+		for _, n := range visited {
+			nodes = append(nodes, n)
+		}
+	}
+	sort.Sort(nodesByUID(nodes))
+	return nodes
+}
+
+// dotID quotes s so that it can be used as an ID in the DOT language.
+func dotID(s string) string {
+	return `"` + strings.Replace(s, `"`, `\"`, -1) + `"`
+}
+
+// toDOT renders the given nodes as a directed graph in the DOT language, with
+// an edge from each dependent to each of its owners. Blocking owner references
+// are drawn with a bold edge, objects being deleted are drawn in red.
+func toDOT(nodes []debugNode) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("digraph dependencies {\n")
+	for _, n := range nodes {
+		label := fmt.Sprintf("uid=%s\\nnamespace=%s\\n%s.%s\\nname=%s", n.UID, n.Namespace, n.Kind, n.APIVersion, n.Name)
+		attrs := []string{"label=" + dotID(label)}
+		if n.BeingDeleted {
+			attrs = append(attrs, `color="red"`)
+		}
+		if n.DeletingDependents {
+			attrs = append(attrs, `style="dashed"`)
+		}
+		fmt.Fprintf(&buf, "  %s [%s];\n", dotID(string(n.UID)), strings.Join(attrs, ", "))
+	}
+	for _, n := range nodes {
+		blocking := map[types.UID]bool{}
+		for _, uid := range n.BlockingOwners {
+			blocking[uid] = true
+		}
+		for _, owner := range n.Owners {
+			attrs := ""
+			if blocking[owner] {
+				attrs = ` [style="bold"]`
+			}
+			fmt.Fprintf(&buf, "  %s -> %s%s;\n", dotID(string(n.UID)), dotID(string(owner)), attrs)
+		}
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// DebuggingHandler returns an http.Handler which dumps the dependency graph of
+// the garbage collector. The graph is written in the DOT language, or as JSON
+// if the "format=json" query parameter is set. The dump can be limited to the
+// objects connected to the objects given by one or more "uid" query parameters.
+func (gc *GarbageCollector) DebuggingHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var uids []types.UID
+		for _, uid := range req.URL.Query()["uid"] {
+			uids = append(uids, types.UID(uid))
+		}
+
+		var nodes []debugNode
+		for _, n := range gc.dependencyGraphBuilder.selectNodes(uids) {
+			nodes = append(nodes, toDebugNode(n))
+		}
+
+		switch format := req.URL.Query().Get("format"); format {
+		case "", "dot":
+			w.Header().Set("Content-Type", "text/vnd.graphviz")
+			w.Write(toDOT(nodes))
+		case "json":
+			data, err := json.MarshalIndent(nodes, "", "  ")
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(data)
+		default:
+			http.Error(w, fmt.Sprintf("unknown format %q, must be one of dot or json", format), http.StatusBadRequest)
+		}
+	})
+}
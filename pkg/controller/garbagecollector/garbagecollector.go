@@ -18,6 +18,8 @@ package garbagecollector
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -33,7 +35,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/controller/garbagecollector/metaonly"
 	// install the prometheus plugin
 	_ "k8s.io/kubernetes/pkg/util/workqueue/prometheus"
@@ -43,6 +47,10 @@ import (
 
 const ResourceResyncTime time.Duration = 0
 
+// maxReportedDependents is the maximum number of dependents listed in an event
+// about the dependents of an owner.
+const maxReportedDependents = 3
+
 // GarbageCollector runs reflectors to watch for changes of managed API
 // objects, funnels the results to a single-threaded dependencyGraphBuilder,
 // which builds a graph caching the dependencies among objects. Triggered by the
@@ -69,9 +77,12 @@ type GarbageCollector struct {
 	registeredRateLimiter *RegisteredRateLimiter
 	// GC caches the owners that do not exist according to the API server.
 	absentOwnerCache *UIDCache
+	// eventRecorder reports the progress of foreground deletions and
+	// orphaning on the owners.
+	eventRecorder record.EventRecorder
 }
 
-func NewGarbageCollector(metaOnlyClientPool dynamic.ClientPool, clientPool dynamic.ClientPool, mapper meta.RESTMapper, deletableResources map[schema.GroupVersionResource]struct{}) (*GarbageCollector, error) {
+func NewGarbageCollector(metaOnlyClientPool dynamic.ClientPool, clientPool dynamic.ClientPool, mapper meta.RESTMapper, deletableResources map[schema.GroupVersionResource]struct{}, eventRecorder record.EventRecorder) (*GarbageCollector, error) {
 	attemptToDelete := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "garbage_collector_attempt_to_delete")
 	attemptToOrphan := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "garbage_collector_attempt_to_orphan")
 	absentOwnerCache := NewUIDCache(500)
@@ -82,6 +93,7 @@ func NewGarbageCollector(metaOnlyClientPool dynamic.ClientPool, clientPool dynam
 		attemptToOrphan:       attemptToOrphan,
 		registeredRateLimiter: NewRegisteredRateLimiter(deletableResources),
 		absentOwnerCache:      absentOwnerCache,
+		eventRecorder:         eventRecorder,
 	}
 	gb := &GraphBuilder{
 		metaOnlyClientPool:                  metaOnlyClientPool,
@@ -315,8 +327,13 @@ func (gc *GarbageCollector) attemptToDeleteItem(item *node) error {
 		// ownerReferences, otherwise the referenced objects will be stuck with
 		// the FinalizerDeletingDependents and never get deleted.
 		patch := deleteOwnerRefPatch(item.identity.UID, append(ownerRefsToUIDs(dangling), ownerRefsToUIDs(waitingForDependentsDeletion)...)...)
-		_, err = gc.patchObject(item.identity, patch)
-		return err
+		if _, err = gc.patchObject(item.identity, patch); err != nil {
+			return err
+		}
+		// the dangling references are only counted once they are removed,
+		// so that retries don't count them again.
+		DanglingReferences.Add(float64(len(dangling)))
+		return nil
 	case len(waitingForDependentsDeletion) != 0 && item.dependentsLength() != 0:
 		deps := item.getDependents()
 		for _, dep := range deps {
@@ -344,13 +361,21 @@ func (gc *GarbageCollector) attemptToDeleteItem(item *node) error {
 		// FinalizerDeletingDependents from the item, resulting in the final
 		// deletion of the item.
 		policy := metav1.DeletePropagationForeground
-		return gc.deleteObject(item.identity, &policy)
+		if err := gc.deleteObject(item.identity, &policy); err != nil {
+			return err
+		}
+		DanglingReferences.Add(float64(len(dangling)))
+		return nil
 	default:
 		// item doesn't have any solid owner, so it needs to be garbage
 		// collected. Also, none of item's owners is waiting for the deletion of
 		// the dependents, so GC deletes item with Default.
 		glog.V(2).Infof("delete object %s with Default", item.identity)
-		return gc.deleteObject(item.identity, nil)
+		if err := gc.deleteObject(item.identity, nil); err != nil {
+			return err
+		}
+		DanglingReferences.Add(float64(len(dangling)))
+		return nil
 	}
 }
 
@@ -361,6 +386,13 @@ func (gc *GarbageCollector) processDeletingDependentsItem(item *node) error {
 		glog.V(2).Infof("remove DeleteDependents finalizer for item %s", item.identity)
 		return gc.removeFinalizer(item, metav1.FinalizerDeleteDependents)
 	}
+	// the item is processed again every time one of its dependents is
+	// deleted, only report that its deletion is blocked the first time.
+	if item.markBlocked() {
+		BlockedDeletions.Inc()
+		gc.eventRecorder.Eventf(objectReferenceToMetadataOnlyObject(item.identity), v1.EventTypeNormal, "WaitingForDependentsDeletion",
+			"Waiting for the deletion of %d blocking dependent(s): %s", len(blockingDependents), describeDependents(blockingDependents))
+	}
 	for _, dep := range blockingDependents {
 		if !dep.isDeletingDependents() {
 			glog.V(2).Infof("adding %s to attemptToDelete, because its owner %s is deletingDependents", dep.identity, item.identity)
@@ -370,9 +402,22 @@ func (gc *GarbageCollector) processDeletingDependentsItem(item *node) error {
 	return nil
 }
 
+// describeDependents returns a human readable, sorted list of at most
+// maxReportedDependents of the given dependents.
+func describeDependents(dependents []*node) string {
+	names := make([]string, 0, len(dependents))
+	for _, dep := range dependents {
+		names = append(names, fmt.Sprintf("%s %s", dep.identity.Kind, dep.identity.Name))
+	}
+	sort.Strings(names)
+	if len(names) > maxReportedDependents {
+		return fmt.Sprintf("%s and %d more", strings.Join(names[:maxReportedDependents], ", "), len(names)-maxReportedDependents)
+	}
+	return strings.Join(names, ", ")
+}
+
 // dependents are copies of pointers to the owner's dependents, they don't need to be locked.
 func (gc *GarbageCollector) orphanDependents(owner objectReference, dependents []*node) error {
-	var failedDependents []objectReference
 	var errorsSlice []error
 	for _, dependent := range dependents {
 		// the dependent.identity.UID is used as precondition
@@ -384,7 +429,7 @@ func (gc *GarbageCollector) orphanDependents(owner objectReference, dependents [
 			errorsSlice = append(errorsSlice, fmt.Errorf("orphaning %s failed with %v", dependent.identity, err))
 		}
 	}
-	if len(failedDependents) != 0 {
+	if len(errorsSlice) != 0 {
 		return fmt.Errorf("failed to orphan dependents of owner %s, got errors: %s", owner, utilerrors.NewAggregate(errorsSlice).Error())
 	}
 	glog.V(5).Infof("successfully updated all dependents of owner %s", owner)
@@ -426,6 +471,11 @@ func (gc *GarbageCollector) attemptToOrphanWorker() bool {
 		gc.attemptToOrphan.AddRateLimited(item)
 		return true
 	}
+	if len(dependents) != 0 {
+		OrphanedObjects.Add(float64(len(dependents)))
+		gc.eventRecorder.Eventf(objectReferenceToMetadataOnlyObject(owner.identity), v1.EventTypeNormal, "OrphanedDependents",
+			"Orphaned %d dependent(s): %s", len(dependents), describeDependents(dependents))
+	}
 	// update the owner, remove "orphaningFinalizer" from its finalizers list
 	err = gc.removeFinalizer(owner, metav1.FinalizerOrphanDependents)
 	if err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package garbagecollector
 
 import (
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/client-go/dynamic"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
@@ -51,7 +53,7 @@ func TestNewGarbageCollector(t *testing.T) {
 	config.ContentConfig.NegotiatedSerializer = nil
 	clientPool := dynamic.NewClientPool(config, api.Registry.RESTMapper(), dynamic.LegacyAPIPathResolverFunc)
 	podResource := map[schema.GroupVersionResource]struct{}{schema.GroupVersionResource{Version: "v1", Resource: "pods"}: {}}
-	gc, err := NewGarbageCollector(metaOnlyClientPool, clientPool, api.Registry.RESTMapper(), podResource)
+	gc, err := NewGarbageCollector(metaOnlyClientPool, clientPool, api.Registry.RESTMapper(), podResource, &record.FakeRecorder{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -115,7 +117,7 @@ func setupGC(t *testing.T, config *restclient.Config) *GarbageCollector {
 	config.ContentConfig.NegotiatedSerializer = nil
 	clientPool := dynamic.NewClientPool(config, api.Registry.RESTMapper(), dynamic.LegacyAPIPathResolverFunc)
 	podResource := map[schema.GroupVersionResource]struct{}{schema.GroupVersionResource{Version: "v1", Resource: "pods"}: {}}
-	gc, err := NewGarbageCollector(metaOnlyClientPool, clientPool, api.Registry.RESTMapper(), podResource)
+	gc, err := NewGarbageCollector(metaOnlyClientPool, clientPool, api.Registry.RESTMapper(), podResource, &record.FakeRecorder{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -312,6 +314,139 @@ func TestProcessEvent(t *testing.T) {
 	}
 }
 
+func newTestGraphBuilder() *GraphBuilder {
+	return &GraphBuilder{
+		graphChanges: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		uidToNode: &concurrentUIDToNode{
+			uidToNodeLock: sync.RWMutex{},
+			uidToNode:     make(map[types.UID]*node),
+		},
+		attemptToDelete:  workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		absentOwnerCache: NewUIDCache(2),
+	}
+}
+
+func TestDebuggingHandler(t *testing.T) {
+	gb := newTestGraphBuilder()
+	for _, e := range []event{
+		createEvent(addEvent, "1", []string{}),
+		createEvent(addEvent, "2", []string{"1"}),
+		createEvent(addEvent, "3", []string{"2"}),
+		createEvent(addEvent, "4", []string{}),
+		createEvent(addEvent, "5", []string{"4"}),
+	} {
+		e := e
+		gb.graphChanges.Add(&e)
+		gb.processGraphChanges()
+	}
+	gc := &GarbageCollector{dependencyGraphBuilder: gb}
+	srv := httptest.NewServer(gc.DebuggingHandler())
+	defer srv.Close()
+
+	get := func(query string) (int, string) {
+		resp, err := http.Get(srv.URL + "?" + query)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return resp.StatusCode, string(body)
+	}
+
+	code, body := get("format=json&uid=3")
+	if code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", code, body)
+	}
+	var nodes []debugNode
+	if err := json.Unmarshal([]byte(body), &nodes); err != nil {
+		t.Fatalf("unexpected error decoding %q: %v", body, err)
+	}
+	var uids []types.UID
+	for _, n := range nodes {
+		uids = append(uids, n.UID)
+	}
+	if e, a := []types.UID{"1", "2", "3"}, uids; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected the nodes connected to uid 3 %v, got %v", e, a)
+	}
+	if e, a := []types.UID{"2"}, nodes[0].Dependents; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected dependents %v of node 1, got %v", e, a)
+	}
+	if e, a := []types.UID{"1"}, nodes[1].Owners; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected owners %v of node 2, got %v", e, a)
+	}
+
+	code, body = get("")
+	if code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", code, body)
+	}
+	if !strings.HasPrefix(body, "digraph") {
+		t.Errorf("expected a DOT graph, got %q", body)
+	}
+	for _, edge := range []string{`"2" -> "1"`, `"3" -> "2"`, `"5" -> "4"`} {
+		if !strings.Contains(body, edge) {
+			t.Errorf("expected edge %s in %q", edge, body)
+		}
+	}
+
+	if code, _ := get("format=yaml"); code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for an unknown format, got %d", code)
+	}
+}
+
+func TestProcessDeletingDependentsItemReportsBlockingDependents(t *testing.T) {
+	blockOwnerDeletion := true
+	owner := &node{
+		identity: objectReference{
+			OwnerReference: metav1.OwnerReference{Kind: "ReplicationController", APIVersion: "v1", Name: "rc", UID: "owner"},
+			Namespace:      "ns1",
+		},
+		dependents: make(map[*node]struct{}),
+	}
+	for _, name := range []string{"pod-e", "pod-d", "pod-c", "pod-b", "pod-a"} {
+		owner.addDependent(&node{
+			identity: objectReference{
+				OwnerReference: metav1.OwnerReference{Kind: "Pod", APIVersion: "v1", Name: name, UID: types.UID(name)},
+				Namespace:      "ns1",
+			},
+			owners: []metav1.OwnerReference{{UID: "owner", BlockOwnerDeletion: &blockOwnerDeletion}},
+		})
+	}
+	recorder := record.NewFakeRecorder(10)
+	gc := &GarbageCollector{
+		attemptToDelete: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		eventRecorder:   recorder,
+	}
+
+	if err := gc.processDeletingDependentsItem(owner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 5, gc.attemptToDelete.Len(); e != a {
+		t.Errorf("expected %d dependents to be queued for deletion, got %d", e, a)
+	}
+	select {
+	case event := <-recorder.Events:
+		expected := "Normal WaitingForDependentsDeletion Waiting for the deletion of 5 blocking dependent(s): Pod pod-a, Pod pod-b, Pod pod-c and 2 more"
+		if event != expected {
+			t.Errorf("expected event %q, got %q", expected, event)
+		}
+	default:
+		t.Errorf("expected an event to be recorded")
+	}
+
+	// the owner is processed again, e.g. when one of its dependents is deleted
+	if err := gc.processDeletingDependentsItem(owner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case event := <-recorder.Events:
+		t.Errorf("expected the blocked deletion to be reported only once, got %q", event)
+	default:
+	}
+}
+
 // TestDependentsRace relies on golang's data race detector to check if there is
 // data race among in the dependents field.
 func TestDependentsRace(t *testing.T) {
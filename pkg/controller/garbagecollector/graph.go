@@ -53,9 +53,17 @@ type node struct {
 	// this records if the object's deletionTimestamp is non-nil.
 	beingDeleted     bool
 	beingDeletedLock sync.RWMutex
+	// this records if the deletion of the object was found blocked by its
+	// dependents, so that it is only reported once.
+	blocked     bool
+	blockedLock sync.Mutex
 	// when processing an Update event, we need to compare the updated
-	// ownerReferences with the owners recorded in the graph.
-	owners []metav1.OwnerReference
+	// ownerReferences with the owners recorded in the graph. The owners are
+	// only written by the GraphBuilder, but they are read concurrently by the
+	// workers and the debug handler, so readers outside of the GraphBuilder
+	// need to use getOwners.
+	owners     []metav1.OwnerReference
+	ownersLock sync.RWMutex
 }
 
 // An object is on a one way trip to its final deletion if it starts being
@@ -72,6 +80,18 @@ func (n *node) isBeingDeleted() bool {
 	return n.beingDeleted
 }
 
+// markBlocked records that the deletion of n is blocked by its dependents, and
+// returns true if it was not known to be blocked before.
+func (n *node) markBlocked() bool {
+	n.blockedLock.Lock()
+	defer n.blockedLock.Unlock()
+	if n.blocked {
+		return false
+	}
+	n.blocked = true
+	return true
+}
+
 func (n *node) markDeletingDependents() {
 	n.deletingDependentsLock.Lock()
 	defer n.deletingDependentsLock.Unlock()
@@ -84,6 +104,21 @@ func (n *node) isDeletingDependents() bool {
 	return n.deletingDependents
 }
 
+func (n *node) setOwners(owners []metav1.OwnerReference) {
+	n.ownersLock.Lock()
+	defer n.ownersLock.Unlock()
+	n.owners = owners
+}
+
+// getOwners returns a copy of the owners of n.
+func (n *node) getOwners() []metav1.OwnerReference {
+	n.ownersLock.RLock()
+	defer n.ownersLock.RUnlock()
+	ret := make([]metav1.OwnerReference, len(n.owners))
+	copy(ret, n.owners)
+	return ret
+}
+
 func (ownerNode *node) addDependent(dependent *node) {
 	ownerNode.dependentsLock.Lock()
 	defer ownerNode.dependentsLock.Unlock()
@@ -125,7 +160,7 @@ func (n *node) blockingDependents() []*node {
 	dependents := n.getDependents()
 	var ret []*node
 	for _, dep := range dependents {
-		for _, owner := range dep.owners {
+		for _, owner := range dep.getOwners() {
 			if owner.UID == n.identity.UID && owner.BlockOwnerDeletion != nil && *owner.BlockOwnerDeletion {
 				ret = append(ret, dep)
 			}
@@ -157,3 +192,14 @@ func (m *concurrentUIDToNode) Delete(uid types.UID) {
 	defer m.uidToNodeLock.Unlock()
 	delete(m.uidToNode, uid)
 }
+
+// List returns a snapshot of all the nodes in the graph.
+func (m *concurrentUIDToNode) List() []*node {
+	m.uidToNodeLock.RLock()
+	defer m.uidToNodeLock.RUnlock()
+	ret := make([]*node, 0, len(m.uidToNode))
+	for _, n := range m.uidToNode {
+		ret = append(ret, n)
+	}
+	return ret
+}
@@ -30,6 +30,9 @@ const (
 	EventProcessingLatencyKey  = "event_processing_latency_microseconds"
 	DirtyProcessingLatencyKey  = "dirty_processing_latency_microseconds"
 	OrphanProcessingLatencyKey = "orphan_processing_latency_microseconds"
+	DanglingReferencesKey      = "dangling_owner_references_total"
+	OrphanedObjectsKey         = "orphaned_objects_total"
+	BlockedDeletionsKey        = "blocked_foreground_deletions_total"
 )
 
 var (
@@ -54,6 +57,27 @@ var (
 			Help:      "Time in microseconds of an item spend in the orphanQueue",
 		},
 	)
+	DanglingReferences = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: GarbageCollectSubsystem,
+			Name:      DanglingReferencesKey,
+			Help:      "Number of owner references found pointing to objects that no longer exist",
+		},
+	)
+	OrphanedObjects = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: GarbageCollectSubsystem,
+			Name:      OrphanedObjectsKey,
+			Help:      "Number of dependents whose owner reference was removed when their owner was deleted with the Orphan propagation policy",
+		},
+	)
+	BlockedDeletions = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: GarbageCollectSubsystem,
+			Name:      BlockedDeletionsKey,
+			Help:      "Number of times the foreground deletion of an owner was found blocked by dependents that still exist",
+		},
+	)
 )
 
 var registerMetrics sync.Once
@@ -65,6 +89,9 @@ func Register() {
 		prometheus.MustRegister(EventProcessingLatency)
 		prometheus.MustRegister(DirtyProcessingLatency)
 		prometheus.MustRegister(OrphanProcessingLatency)
+		prometheus.MustRegister(DanglingReferences)
+		prometheus.MustRegister(OrphanedObjects)
+		prometheus.MustRegister(BlockedDeletions)
 	})
 }
 
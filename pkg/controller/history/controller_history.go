@@ -0,0 +1,369 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/api"
+	apps "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	appsinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/apps/v1beta1"
+	appslisters "k8s.io/kubernetes/pkg/client/listers/apps/v1beta1"
+	"k8s.io/kubernetes/pkg/client/retry"
+	"k8s.io/kubernetes/pkg/controller"
+	hashutil "k8s.io/kubernetes/pkg/util/hash"
+)
+
+// ControllerRevisionHashLabel is the label used to indicate the hash value of a ControllerRevision's Data.
+const ControllerRevisionHashLabel = "controller.kubernetes.io/hash"
+
+// ControllerRevisionName returns the Name for a ControllerRevision in the form prefix-hash. If the length
+// of prefix is greater than 223 bytes, it is truncated to allow for a name that is no larger than 253 bytes.
+func ControllerRevisionName(prefix string, hash uint32) string {
+	if len(prefix) > 223 {
+		prefix = prefix[:223]
+	}
+	return fmt.Sprintf("%s-%d", prefix, hash)
+}
+
+// NewControllerRevision returns a ControllerRevision with a ControllerRef pointing to parent and indicating that
+// parent is of parentKind. The ControllerRevision has labels matching templateLabels, its Data is set to data, and
+// its Revision is set to revision. If the returned error is nil, the returned ControllerRevision is valid. If the
+// returned error is not nil, the returned ControllerRevision is invalid for use.
+func NewControllerRevision(parent metav1.Object,
+	parentKind schema.GroupVersionKind,
+	templateLabels map[string]string,
+	data runtime.RawExtension,
+	revision int64) (*apps.ControllerRevision, error) {
+	labelMap := make(map[string]string)
+	for k, v := range templateLabels {
+		labelMap[k] = v
+	}
+	blockOwnerDeletion := true
+	isController := true
+	cr := &apps.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: labelMap,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         parentKind.GroupVersion().String(),
+					Kind:               parentKind.Kind,
+					Name:               parent.GetName(),
+					UID:                parent.GetUID(),
+					BlockOwnerDeletion: &blockOwnerDeletion,
+					Controller:         &isController,
+				},
+			},
+		},
+		Data:     data,
+		Revision: revision,
+	}
+	hash := HashControllerRevision(cr, nil)
+	cr.Name = ControllerRevisionName(parent.GetName(), hash)
+	cr.Labels[ControllerRevisionHashLabel] = strconv.FormatInt(int64(hash), 10)
+	return cr, nil
+}
+
+// HashControllerRevision hashes the contents of revision's Data using FNV hashing. If probe is not nil, the value
+// of probe is written to the hash as well, which allows a new hash to be generated on a name collision.
+func HashControllerRevision(revision *apps.ControllerRevision, probe *uint32) uint32 {
+	hf := fnv.New32()
+	if revision.Data.Object != nil {
+		hashutil.DeepHashObject(hf, revision.Data.Object)
+	}
+	if len(revision.Data.Raw) > 0 {
+		hf.Write(revision.Data.Raw)
+	}
+	if probe != nil {
+		hf.Write([]byte(strconv.FormatInt(int64(*probe), 10)))
+	}
+	return hf.Sum32()
+}
+
+// SortControllerRevisions sorts revisions by their Revision property.
+func SortControllerRevisions(revisions []*apps.ControllerRevision) {
+	sort.Sort(byRevision(revisions))
+}
+
+// EqualRevision returns true if lhs and rhs are either both nil, or both point to non-nil ControllerRevisions that
+// contain semantically equivalent data. Otherwise this method returns false.
+func EqualRevision(lhs *apps.ControllerRevision, rhs *apps.ControllerRevision) bool {
+	var lhsHash, rhsHash *uint32
+	if lhs == nil || rhs == nil {
+		return lhs == rhs
+	}
+	if hs, found := lhs.Labels[ControllerRevisionHashLabel]; found {
+		hash, err := strconv.ParseInt(hs, 10, 32)
+		if err == nil {
+			lhsHash = new(uint32)
+			*lhsHash = uint32(hash)
+		}
+	}
+	if hs, found := rhs.Labels[ControllerRevisionHashLabel]; found {
+		hash, err := strconv.ParseInt(hs, 10, 32)
+		if err == nil {
+			rhsHash = new(uint32)
+			*rhsHash = uint32(hash)
+		}
+	}
+	if lhsHash != nil && rhsHash != nil && *lhsHash != *rhsHash {
+		return false
+	}
+	return bytes.Equal(lhs.Data.Raw, rhs.Data.Raw) && apiequality.Semantic.DeepEqual(lhs.Data.Object, rhs.Data.Object)
+}
+
+// FindEqualRevisions returns all ControllerRevisions in revisions that are equal to needle using EqualRevision as the
+// equality test. The returned slice preserves the order of revisions.
+func FindEqualRevisions(revisions []*apps.ControllerRevision, needle *apps.ControllerRevision) []*apps.ControllerRevision {
+	var eq []*apps.ControllerRevision
+	for i := range revisions {
+		if EqualRevision(revisions[i], needle) {
+			eq = append(eq, revisions[i])
+		}
+	}
+	return eq
+}
+
+// byRevision implements sort.Interface to allow ControllerRevisions to be sorted by Revision.
+type byRevision []*apps.ControllerRevision
+
+func (br byRevision) Len() int {
+	return len(br)
+}
+
+func (br byRevision) Less(i, j int) bool {
+	if br[i].Revision == br[j].Revision {
+		return br[i].Name < br[j].Name
+	}
+	return br[i].Revision < br[j].Revision
+}
+
+func (br byRevision) Swap(i, j int) {
+	br[i], br[j] = br[j], br[i]
+}
+
+// Interface provides an interface allowing for management of a Controller's history as realized by recorded
+// ControllerRevisions. An instance of Interface can be retrieved from NewHistory. Implementations must treat all
+// pointer parameters as "in" parameter, and they must not be mutated.
+type Interface interface {
+	// ListControllerRevisions lists all ControllerRevisions matching selector and owned by parent. If the returned
+	// error is nil the returned slice of ControllerRevisions is valid. If the returned error is not nil, the
+	// returned slice is not valid.
+	ListControllerRevisions(parent metav1.Object, selector labels.Selector) ([]*apps.ControllerRevision, error)
+	// CreateControllerRevision attempts to create the revision as owned by parent. If the name of revision is
+	// already in use by a ControllerRevision with different Data, the name is regenerated with a new hash probe
+	// until a free name is found. If the returned error is nil, the returned ControllerRevision is valid. If the
+	// returned error is not nil, the returned ControllerRevision is invalid for use.
+	CreateControllerRevision(parent metav1.Object, revision *apps.ControllerRevision) (*apps.ControllerRevision, error)
+	// DeleteControllerRevision attempts to delete revision. If the returned error is not nil, deletion has failed.
+	DeleteControllerRevision(revision *apps.ControllerRevision) error
+	// UpdateControllerRevision updates revision such that its Revision is equal to newRevision. Implementations
+	// may retry on conflict. If the returned error is nil, the update was successful and returned ControllerRevision
+	// is valid. If the returned error is not nil, the update failed and the returned ControllerRevision is invalid.
+	UpdateControllerRevision(revision *apps.ControllerRevision, newRevision int64) (*apps.ControllerRevision, error)
+}
+
+// NewHistory returns an instance of Interface that uses client to communicate with the API Server and lister to list
+// ControllerRevisions. This method should be used to create an Interface for all scenarios other than testing.
+func NewHistory(client clientset.Interface, lister appslisters.ControllerRevisionLister) Interface {
+	return &realHistory{client, lister}
+}
+
+// NewFakeHistory returns an instance of Interface that uses informer to create, update, list, and delete
+// ControllerRevisions. This method should be used to create an Interface for testing purposes.
+func NewFakeHistory(informer appsinformers.ControllerRevisionInformer) Interface {
+	return &fakeHistory{informer.Informer().GetIndexer(), informer.Lister()}
+}
+
+type realHistory struct {
+	client clientset.Interface
+	lister appslisters.ControllerRevisionLister
+}
+
+func (rh *realHistory) ListControllerRevisions(parent metav1.Object, selector labels.Selector) ([]*apps.ControllerRevision, error) {
+	// List all revisions in the namespace that match the selector
+	history, err := rh.lister.ControllerRevisions(parent.GetNamespace()).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return filterOwned(parent.GetUID(), history), nil
+}
+
+func (rh *realHistory) CreateControllerRevision(parent metav1.Object, revision *apps.ControllerRevision) (*apps.ControllerRevision, error) {
+	// Clone the input
+	obj, err := api.Scheme.DeepCopy(revision)
+	if err != nil {
+		return nil, err
+	}
+	clone := obj.(*apps.ControllerRevision)
+	clone.Namespace = parent.GetNamespace()
+
+	// Continue to attempt to create the revision, updating the name with a new hash on each collision
+	var probe uint32
+	for {
+		created, err := rh.client.AppsV1beta1().ControllerRevisions(clone.Namespace).Create(clone)
+		if !errors.IsAlreadyExists(err) {
+			return created, err
+		}
+		existing, getErr := rh.client.AppsV1beta1().ControllerRevisions(clone.Namespace).Get(clone.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return nil, getErr
+		}
+		if EqualRevision(existing, clone) && isOwnedBy(parent.GetUID(), existing) {
+			// the revision was already created, possibly by a previous sync
+			return existing, nil
+		}
+		probe++
+		clone.Name = ControllerRevisionName(parent.GetName(), HashControllerRevision(revision, &probe))
+	}
+}
+
+func (rh *realHistory) UpdateControllerRevision(revision *apps.ControllerRevision, newRevision int64) (*apps.ControllerRevision, error) {
+	obj, err := api.Scheme.DeepCopy(revision)
+	if err != nil {
+		return nil, err
+	}
+	clone := obj.(*apps.ControllerRevision)
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if clone.Revision == newRevision {
+			return nil
+		}
+		clone.Revision = newRevision
+		updated, updateErr := rh.client.AppsV1beta1().ControllerRevisions(clone.Namespace).Update(clone)
+		if updateErr == nil {
+			clone = updated
+			return nil
+		}
+		if updated, err := rh.lister.ControllerRevisions(clone.Namespace).Get(clone.Name); err == nil {
+			// make a copy so we don't mutate the shared cache
+			if obj, err := api.Scheme.DeepCopy(updated); err == nil {
+				clone = obj.(*apps.ControllerRevision)
+			}
+		}
+		return updateErr
+	})
+	return clone, err
+}
+
+func (rh *realHistory) DeleteControllerRevision(revision *apps.ControllerRevision) error {
+	return rh.client.AppsV1beta1().ControllerRevisions(revision.Namespace).Delete(revision.Name, nil)
+}
+
+type fakeHistory struct {
+	indexer cache.Indexer
+	lister  appslisters.ControllerRevisionLister
+}
+
+func (fh *fakeHistory) ListControllerRevisions(parent metav1.Object, selector labels.Selector) ([]*apps.ControllerRevision, error) {
+	history, err := fh.lister.ControllerRevisions(parent.GetNamespace()).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return filterOwned(parent.GetUID(), history), nil
+}
+
+func (fh *fakeHistory) addRevision(revision *apps.ControllerRevision) (*apps.ControllerRevision, error) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(revision)
+	if err != nil {
+		return nil, err
+	}
+	obj, found, err := fh.indexer.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		foundRevision := obj.(*apps.ControllerRevision)
+		return foundRevision, errors.NewAlreadyExists(apps.Resource("controllerrevision"), revision.Name)
+	}
+	return revision, fh.indexer.Update(revision)
+}
+
+func (fh *fakeHistory) CreateControllerRevision(parent metav1.Object, revision *apps.ControllerRevision) (*apps.ControllerRevision, error) {
+	obj, err := api.Scheme.DeepCopy(revision)
+	if err != nil {
+		return nil, err
+	}
+	clone := obj.(*apps.ControllerRevision)
+	clone.Namespace = parent.GetNamespace()
+	var probe uint32
+	for {
+		created, err := fh.addRevision(clone)
+		if !errors.IsAlreadyExists(err) {
+			return created, err
+		}
+		if EqualRevision(created, clone) && isOwnedBy(parent.GetUID(), created) {
+			return created, nil
+		}
+		probe++
+		clone.Name = ControllerRevisionName(parent.GetName(), HashControllerRevision(revision, &probe))
+	}
+}
+
+func (fh *fakeHistory) DeleteControllerRevision(revision *apps.ControllerRevision) error {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(revision)
+	if err != nil {
+		return err
+	}
+	obj, found, err := fh.indexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NewNotFound(apps.Resource("controllerrevisions"), revision.Name)
+	}
+	return fh.indexer.Delete(obj)
+}
+
+func (fh *fakeHistory) UpdateControllerRevision(revision *apps.ControllerRevision, newRevision int64) (*apps.ControllerRevision, error) {
+	obj, err := api.Scheme.DeepCopy(revision)
+	if err != nil {
+		return nil, err
+	}
+	clone := obj.(*apps.ControllerRevision)
+	clone.Revision = newRevision
+	return clone, fh.indexer.Update(clone)
+}
+
+// filterOwned returns the revisions in history whose ControllerRef points to the object with uid.
+func filterOwned(uid types.UID, history []*apps.ControllerRevision) []*apps.ControllerRevision {
+	var owned []*apps.ControllerRevision
+	for i := range history {
+		if isOwnedBy(uid, history[i]) {
+			owned = append(owned, history[i])
+		}
+	}
+	return owned
+}
+
+// isOwnedBy returns true if the ControllerRef of revision points to the object with uid.
+func isOwnedBy(uid types.UID, revision *apps.ControllerRevision) bool {
+	ref := controller.GetControllerOf(revision)
+	return ref != nil && ref.UID == uid
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	apps "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+	informers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions"
+	"k8s.io/kubernetes/pkg/controller"
+)
+
+func TestNewControllerRevision(t *testing.T) {
+	ss := newStatefulSet(3, "ss", types.UID("test"), map[string]string{"foo": "bar"})
+	revision, err := NewControllerRevision(ss, parentKind, ss.Spec.Template.Labels, rawTemplate(&ss.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.Labels["foo"] != "bar" {
+		t.Errorf("expected revision to have the template labels, got %v", revision.Labels)
+	}
+	if _, found := revision.Labels[ControllerRevisionHashLabel]; !found {
+		t.Errorf("expected revision to have label %s", ControllerRevisionHashLabel)
+	}
+	if expected := ControllerRevisionName(ss.Name, HashControllerRevision(revision, nil)); revision.Name != expected {
+		t.Errorf("expected name %s, got %s", expected, revision.Name)
+	}
+	ref := controller.GetControllerOf(revision)
+	if ref == nil || ref.UID != ss.UID || ref.Kind != "StatefulSet" {
+		t.Errorf("expected revision to be controlled by %s, got %+v", ss.Name, ref)
+	}
+	if revision.Revision != 1 {
+		t.Errorf("expected revision 1, got %d", revision.Revision)
+	}
+	// the template labels must not be shared with the revision
+	ss.Spec.Template.Labels["foo"] = "baz"
+	if revision.Labels["foo"] != "bar" {
+		t.Error("revision labels are shared with the template")
+	}
+}
+
+func TestControllerRevisionName(t *testing.T) {
+	long := string(bytes.Repeat([]byte("a"), 300))
+	if name := ControllerRevisionName(long, 1); len(name) > 253 {
+		t.Errorf("expected name to be truncated, got length %d", len(name))
+	}
+	if name := ControllerRevisionName("ss", 1234); name != "ss-1234" {
+		t.Errorf("expected ss-1234, got %s", name)
+	}
+}
+
+func TestHashControllerRevision(t *testing.T) {
+	ss := newStatefulSet(3, "ss", types.UID("test"), map[string]string{"foo": "bar"})
+	revision, err := NewControllerRevision(ss, parentKind, ss.Spec.Template.Labels, rawTemplate(&ss.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var probe uint32
+	hash := HashControllerRevision(revision, nil)
+	if hash != HashControllerRevision(revision, nil) {
+		t.Error("expected hashing to be stable")
+	}
+	if hash == HashControllerRevision(revision, &probe) {
+		t.Error("expected probe to change the hash")
+	}
+	probed := HashControllerRevision(revision, &probe)
+	probe++
+	if probed == HashControllerRevision(revision, &probe) {
+		t.Error("expected different probes to produce different hashes")
+	}
+	ss.Spec.Template.Spec.Containers[0].Image = "foo"
+	modified, err := NewControllerRevision(ss, parentKind, ss.Spec.Template.Labels, rawTemplate(&ss.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash == HashControllerRevision(modified, nil) {
+		t.Error("expected different data to produce different hashes")
+	}
+}
+
+func TestEqualRevision(t *testing.T) {
+	ss1 := newStatefulSet(3, "ss1", types.UID("ss1"), map[string]string{"foo": "bar"})
+	ss2 := newStatefulSet(3, "ss2", types.UID("ss2"), map[string]string{"goo": "car"})
+	ss1Rev1, err := NewControllerRevision(ss1, parentKind, ss1.Spec.Template.Labels, rawTemplate(&ss1.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss1Rev2, err := NewControllerRevision(ss1, parentKind, ss1.Spec.Template.Labels, rawTemplate(&ss1.Spec.Template), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss2Rev1, err := NewControllerRevision(ss2, parentKind, ss2.Spec.Template.Labels, rawTemplate(&ss2.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss1Rev2.Labels[ControllerRevisionHashLabel] = "invalid"
+
+	tests := []struct {
+		name  string
+		lhs   *apps.ControllerRevision
+		rhs   *apps.ControllerRevision
+		equal bool
+	}{
+		{"both nil", nil, nil, true},
+		{"lhs nil", nil, ss1Rev1, false},
+		{"rhs nil", ss1Rev1, nil, false},
+		{"same revision", ss1Rev1, ss1Rev1, true},
+		{"equal data with different revisions", ss1Rev1, ss1Rev2, true},
+		{"different data", ss1Rev1, ss2Rev1, false},
+	}
+	for _, test := range tests {
+		if equal := EqualRevision(test.lhs, test.rhs); equal != test.equal {
+			t.Errorf("%s: expected %v, got %v", test.name, test.equal, equal)
+		}
+	}
+}
+
+func TestFindEqualRevisions(t *testing.T) {
+	ss1 := newStatefulSet(3, "ss1", types.UID("ss1"), map[string]string{"foo": "bar"})
+	ss2 := newStatefulSet(3, "ss2", types.UID("ss2"), map[string]string{"goo": "car"})
+	ss1Rev1, err := NewControllerRevision(ss1, parentKind, ss1.Spec.Template.Labels, rawTemplate(&ss1.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss1Rev2, err := NewControllerRevision(ss1, parentKind, ss1.Spec.Template.Labels, rawTemplate(&ss1.Spec.Template), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss2Rev1, err := NewControllerRevision(ss2, parentKind, ss2.Spec.Template.Labels, rawTemplate(&ss2.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := FindEqualRevisions([]*apps.ControllerRevision{ss1Rev1, ss2Rev1, ss1Rev2}, ss1Rev1)
+	if len(found) != 2 || found[0] != ss1Rev1 || found[1] != ss1Rev2 {
+		t.Errorf("expected to find %s and %s in order, got %v", ss1Rev1.Name, ss1Rev2.Name, found)
+	}
+	if found := FindEqualRevisions([]*apps.ControllerRevision{ss1Rev1, ss1Rev2}, ss2Rev1); len(found) != 0 {
+		t.Errorf("expected no equal revisions, got %v", found)
+	}
+}
+
+func TestSortControllerRevisions(t *testing.T) {
+	ss := newStatefulSet(3, "ss", types.UID("test"), map[string]string{"foo": "bar"})
+	var revisions []*apps.ControllerRevision
+	for _, revision := range []int64{3, 1, 2, 2} {
+		cr, err := NewControllerRevision(ss, parentKind, ss.Spec.Template.Labels, rawTemplate(&ss.Spec.Template), revision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		revisions = append(revisions, cr)
+	}
+	revisions[0].Name = "c"
+	revisions[1].Name = "d"
+	revisions[2].Name = "b"
+	revisions[3].Name = "a"
+	SortControllerRevisions(revisions)
+	// revisions are ordered by Revision, using the name as a tie breaker
+	expected := []string{"d", "a", "b", "c"}
+	for i := range revisions {
+		if revisions[i].Name != expected[i] {
+			t.Errorf("expected %s at position %d, got %s", expected[i], i, revisions[i].Name)
+		}
+	}
+}
+
+func TestRealHistory(t *testing.T) {
+	ss1 := newStatefulSet(3, "ss1", types.UID("ss1"), map[string]string{"foo": "bar"})
+	ss2 := newStatefulSet(3, "ss2", types.UID("ss2"), map[string]string{"foo": "bar"})
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+	informer := informerFactory.Apps().V1beta1().ControllerRevisions()
+	history := NewHistory(client, informer.Lister())
+	testHistory(t, history, informer.Informer().GetIndexer().Add, ss1, ss2)
+}
+
+func TestFakeHistory(t *testing.T) {
+	ss1 := newStatefulSet(3, "ss1", types.UID("ss1"), map[string]string{"foo": "bar"})
+	ss2 := newStatefulSet(3, "ss2", types.UID("ss2"), map[string]string{"foo": "bar"})
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+	informer := informerFactory.Apps().V1beta1().ControllerRevisions()
+	history := NewFakeHistory(informer)
+	testHistory(t, history, func(interface{}) error { return nil }, ss1, ss2)
+}
+
+// testHistory exercises an Interface. sync is used to make created revisions visible to listing.
+func testHistory(t *testing.T, history Interface, sync func(interface{}) error, ss1, ss2 *apps.StatefulSet) {
+	rev1, err := NewControllerRevision(ss1, parentKind, ss1.Spec.Template.Labels, rawTemplate(&ss1.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := history.CreateControllerRevision(ss1, rev1)
+	if err != nil {
+		t.Fatalf("failed to create revision: %v", err)
+	}
+	if created.Namespace != ss1.Namespace || created.Name != rev1.Name {
+		t.Errorf("expected %s/%s to be created, got %s/%s", ss1.Namespace, rev1.Name, created.Namespace, created.Name)
+	}
+	if err := sync(created); err != nil {
+		t.Fatal(err)
+	}
+
+	// creating an equal revision returns the existing one
+	again, err := history.CreateControllerRevision(ss1, rev1)
+	if err != nil {
+		t.Fatalf("failed to create revision: %v", err)
+	}
+	if again.Name != created.Name {
+		t.Errorf("expected %s to be returned, got %s", created.Name, again.Name)
+	}
+
+	// a revision with a colliding name but different data is created under a new name
+	ss1.Spec.Template.Spec.Containers[0].Image = "foo"
+	collision, err := NewControllerRevision(ss1, parentKind, ss1.Spec.Template.Labels, rawTemplate(&ss1.Spec.Template), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	collision.Name = rev1.Name
+	rev2, err := history.CreateControllerRevision(ss1, collision)
+	if err != nil {
+		t.Fatalf("failed to create revision: %v", err)
+	}
+	if rev2.Name == rev1.Name {
+		t.Errorf("expected a new name on collision, got %s", rev2.Name)
+	}
+	if err := sync(rev2); err != nil {
+		t.Fatal(err)
+	}
+
+	// revisions of other parents are not listed
+	other, err := NewControllerRevision(ss2, parentKind, ss2.Spec.Template.Labels, rawTemplate(&ss2.Spec.Template), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err = history.CreateControllerRevision(ss2, other)
+	if err != nil {
+		t.Fatalf("failed to create revision: %v", err)
+	}
+	if err := sync(other); err != nil {
+		t.Fatal(err)
+	}
+	selector := labels.SelectorFromSet(ss1.Spec.Template.Labels)
+	revisions, err := history.ListControllerRevisions(ss1, selector)
+	if err != nil {
+		t.Fatalf("failed to list revisions: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Errorf("expected 2 revisions for %s, got %d", ss1.Name, len(revisions))
+	}
+
+	updated, err := history.UpdateControllerRevision(created, 3)
+	if err != nil {
+		t.Fatalf("failed to update revision: %v", err)
+	}
+	if updated.Revision != 3 {
+		t.Errorf("expected revision 3, got %d", updated.Revision)
+	}
+	if created.Revision != 1 {
+		t.Error("update mutated its input")
+	}
+
+	if err := history.DeleteControllerRevision(rev2); err != nil {
+		t.Errorf("failed to delete revision: %v", err)
+	}
+}
+
+var parentKind = apps.SchemeGroupVersion.WithKind("StatefulSet")
+
+func rawTemplate(template *v1.PodTemplateSpec) runtime.RawExtension {
+	buf := new(bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(template); err != nil {
+		panic(err)
+	}
+	return runtime.RawExtension{Raw: buf.Bytes()}
+}
+
+func newStatefulSet(replicas int, name string, uid types.UID, labels map[string]string) *apps.StatefulSet {
+	return &apps.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: "apps/v1beta1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: v1.NamespaceDefault,
+			UID:       uid,
+		},
+		Spec: apps.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Replicas: func() *int32 { i := int32(replicas); return &i }(),
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "nginx",
+							Image: "nginx",
+						},
+					},
+				},
+			},
+			ServiceName: "governingsvc",
+		},
+	}
+}
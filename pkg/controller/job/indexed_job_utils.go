@@ -0,0 +1,180 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/v1"
+	batch "k8s.io/kubernetes/pkg/apis/batch/v1"
+)
+
+// isIndexedJob returns whether the job tracks completions per index.
+func isIndexedJob(job *batch.Job) bool {
+	return job.Spec.CompletionMode != nil && *job.Spec.CompletionMode == batch.IndexedCompletion
+}
+
+// getCompletionIndex returns the completion index of the pod, or -1 if the
+// pod has no valid completion index annotation.
+func getCompletionIndex(pod *v1.Pod) int {
+	value, ok := pod.Annotations[batch.JobCompletionIndexAnnotation]
+	if !ok {
+		return -1
+	}
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 {
+		return -1
+	}
+	return index
+}
+
+// getSucceededIndexes returns the completion indexes of the job that have at
+// least one succeeded pod.
+func getSucceededIndexes(job *batch.Job, pods []*v1.Pod) sets.Int {
+	completions := int(*job.Spec.Completions)
+	succeeded := sets.NewInt()
+	for _, pod := range pods {
+		if pod.Status.Phase != v1.PodSucceeded {
+			continue
+		}
+		if index := getCompletionIndex(pod); index >= 0 && index < completions {
+			succeeded.Insert(index)
+		}
+	}
+	return succeeded
+}
+
+// formatIndexes returns the indexes in the format of .status.completedIndexes:
+// increasing decimal numbers separated by commas, where runs of three or more
+// consecutive numbers are written as "first-last".
+func formatIndexes(indexes sets.Int) string {
+	var buf bytes.Buffer
+	sorted := indexes.List()
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] == sorted[j]+1 {
+			j++
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte(',')
+		}
+		switch j - i {
+		case 0:
+			fmt.Fprintf(&buf, "%d", sorted[i])
+		case 1:
+			fmt.Fprintf(&buf, "%d,%d", sorted[i], sorted[j])
+		default:
+			fmt.Fprintf(&buf, "%d-%d", sorted[i], sorted[j])
+		}
+		i = j + 1
+	}
+	return buf.String()
+}
+
+// splitActivePodsByIndex returns the active pods to keep, keyed by their
+// completion index, and the pods to delete because their index is missing,
+// out of range, duplicated or already succeeded.
+func splitActivePodsByIndex(activePods []*v1.Pod, succeeded sets.Int, completions int) (map[int]*v1.Pod, []*v1.Pod) {
+	active := map[int]*v1.Pod{}
+	var extra []*v1.Pod
+	for _, pod := range activePods {
+		index := getCompletionIndex(pod)
+		if index < 0 || index >= completions || succeeded.Has(index) {
+			extra = append(extra, pod)
+			continue
+		}
+		if _, found := active[index]; found {
+			extra = append(extra, pod)
+			continue
+		}
+		active[index] = pod
+	}
+	return active, extra
+}
+
+// getPendingIndexes returns up to count of the lowest completion indexes that
+// have neither succeeded nor an active pod.
+func getPendingIndexes(active map[int]*v1.Pod, succeeded sets.Int, count, completions int) []int {
+	var pending []int
+	for index := 0; index < completions && len(pending) < count; index++ {
+		if _, found := active[index]; found || succeeded.Has(index) {
+			continue
+		}
+		pending = append(pending, index)
+	}
+	return pending
+}
+
+// getHighestIndexes returns the count highest indexes of the active pods.
+func getHighestIndexes(active map[int]*v1.Pod, count int) []int {
+	indexes := make([]int, 0, len(active))
+	for index := range active {
+		indexes = append(indexes, index)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(indexes)))
+	if count < len(indexes) {
+		indexes = indexes[:count]
+	}
+	return indexes
+}
+
+// podTemplateForIndex returns a copy of the pod template of the job that
+// carries the completion index in an annotation, in an environment variable
+// of every container and, when it is a valid DNS label and the template does
+// not set one, in the hostname.
+func podTemplateForIndex(job *batch.Job, index int) (*v1.PodTemplateSpec, error) {
+	obj, err := api.Scheme.DeepCopy(&job.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	template := obj.(*v1.PodTemplateSpec)
+	value := strconv.Itoa(index)
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[batch.JobCompletionIndexAnnotation] = value
+	if len(template.Spec.Hostname) == 0 {
+		hostname := fmt.Sprintf("%s-%d", job.Name, index)
+		if len(validation.IsDNS1123Label(hostname)) == 0 {
+			template.Spec.Hostname = hostname
+		}
+	}
+	for i := range template.Spec.InitContainers {
+		addCompletionIndexEnv(&template.Spec.InitContainers[i], value)
+	}
+	for i := range template.Spec.Containers {
+		addCompletionIndexEnv(&template.Spec.Containers[i], value)
+	}
+	return template, nil
+}
+
+// addCompletionIndexEnv sets the completion index environment variable in the
+// container, unless the container already defines it.
+func addCompletionIndexEnv(container *v1.Container, index string) {
+	for _, env := range container.Env {
+		if env.Name == batch.JobCompletionIndexEnvName {
+			return
+		}
+	}
+	container.Env = append(container.Env, v1.EnvVar{Name: batch.JobCompletionIndexEnvName, Value: index})
+}
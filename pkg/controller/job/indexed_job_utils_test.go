@@ -0,0 +1,85 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/api/v1"
+	batch "k8s.io/kubernetes/pkg/apis/batch/v1"
+)
+
+func TestFormatIndexes(t *testing.T) {
+	testCases := []struct {
+		indexes  []int
+		expected string
+	}{
+		{nil, ""},
+		{[]int{0}, "0"},
+		{[]int{0, 1}, "0,1"},
+		{[]int{0, 1, 2}, "0-2"},
+		{[]int{7, 1, 4, 3, 5}, "1,3-5,7"},
+		{[]int{0, 2, 3, 5, 6, 7, 8, 10}, "0,2,3,5-8,10"},
+	}
+	for _, tc := range testCases {
+		if got := formatIndexes(sets.NewInt(tc.indexes...)); got != tc.expected {
+			t.Errorf("formatIndexes(%v): expected %q, got %q", tc.indexes, tc.expected, got)
+		}
+	}
+}
+
+func TestPodTemplateForIndex(t *testing.T) {
+	job := newJob(1, 3)
+	job.Spec.Template.Spec.InitContainers = []v1.Container{{Name: "init"}}
+	job.Spec.Template.Spec.Containers = []v1.Container{
+		{Name: "main"},
+		{Name: "custom", Env: []v1.EnvVar{{Name: batch.JobCompletionIndexEnvName, Value: "custom"}}},
+	}
+
+	template, err := podTemplateForIndex(job, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := template.Annotations[batch.JobCompletionIndexAnnotation]; got != "2" {
+		t.Errorf("Expected completion index annotation 2, got %q", got)
+	}
+	if template.Spec.Hostname != "foobar-2" {
+		t.Errorf("Expected hostname foobar-2, got %q", template.Spec.Hostname)
+	}
+	for _, c := range []v1.Container{template.Spec.InitContainers[0], template.Spec.Containers[0]} {
+		if len(c.Env) != 1 || c.Env[0].Name != batch.JobCompletionIndexEnvName || c.Env[0].Value != "2" {
+			t.Errorf("Expected container %s to get the completion index env var, got %v", c.Name, c.Env)
+		}
+	}
+	if env := template.Spec.Containers[1].Env; len(env) != 1 || env[0].Value != "custom" {
+		t.Errorf("Expected the env var defined by the container to be kept, got %v", env)
+	}
+	if len(job.Spec.Template.Annotations) != 0 || len(job.Spec.Template.Spec.Containers[0].Env) != 0 {
+		t.Errorf("Expected the pod template of the job to be left unmodified")
+	}
+
+	job.Name = strings.Repeat("x", 63)
+	template, err = podTemplateForIndex(job, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(template.Spec.Hostname) != 0 {
+		t.Errorf("Expected no hostname for a job name too long for a DNS label, got %q", template.Spec.Hostname)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 	clientv1 "k8s.io/client-go/pkg/api/v1"
@@ -46,6 +47,15 @@ import (
 	"github.com/golang/glog"
 )
 
+const (
+	// DefaultJobBackOff is the delay before the pods of a job are recreated
+	// after its first failure.
+	DefaultJobBackOff = 10 * time.Second
+	// MaxJobBackOff is the maximum delay before the pods of a job are recreated
+	// after repeated failures.
+	MaxJobBackOff = 360 * time.Second
+)
+
 type JobController struct {
 	kubeClient clientset.Interface
 	podControl controller.PodControlInterface
@@ -320,6 +330,12 @@ func (jm *JobController) syncJob(key string) error {
 	activePods := controller.FilterActivePods(pods)
 	active := int32(len(activePods))
 	succeeded, failed := getStatus(pods)
+	var succeededIndexes sets.Int
+	if isIndexedJob(&job) {
+		// Only one succeeded pod counts for each completion index.
+		succeededIndexes = getSucceededIndexes(&job, pods)
+		succeeded = int32(succeededIndexes.Len())
+	}
 	conditions := len(job.Status.Conditions)
 	if job.Status.StartTime == nil {
 		now := metav1.Now()
@@ -329,7 +345,17 @@ func (jm *JobController) syncJob(key string) error {
 	if IsJobFinished(&job) {
 		return nil
 	}
-	if pastActiveDeadline(&job) {
+	failures := getFailures(&job, pods, activePods)
+	var failureReason, failureMessage string
+	switch {
+	case pastActiveDeadline(&job):
+		failureReason, failureMessage = "DeadlineExceeded", "Job was active longer than specified deadline"
+	case len(failures.failJobMessage) > 0:
+		failureReason, failureMessage = "PodFailurePolicy", failures.failJobMessage
+	case job.Spec.BackoffLimit != nil && failures.counted+failures.restarts > *job.Spec.BackoffLimit:
+		failureReason, failureMessage = "BackoffLimitExceeded", "Job has reached the specified backoff limit"
+	}
+	if len(failureReason) > 0 {
 		// TODO: below code should be replaced with pod termination resulting in
 		// pod failures, rather than killing pods. Unfortunately none such solution
 		// exists ATM. There's an open discussion in the topic in
@@ -350,11 +376,20 @@ func (jm *JobController) syncJob(key string) error {
 		// update status values accordingly
 		failed += active
 		active = 0
-		job.Status.Conditions = append(job.Status.Conditions, newCondition(batch.JobFailed, "DeadlineExceeded", "Job was active longer than specified deadline"))
-		jm.recorder.Event(&job, v1.EventTypeNormal, "DeadlineExceeded", "Job was active longer than specified deadline")
+		job.Status.Conditions = append(job.Status.Conditions, newCondition(batch.JobFailed, failureReason, failureMessage))
+		jm.recorder.Event(&job, v1.EventTypeNormal, failureReason, failureMessage)
 	} else {
 		if jobNeedsSync && job.DeletionTimestamp == nil {
-			active = jm.manageJob(activePods, succeeded, &job)
+			// Replacements for failed pods are created only once the backoff
+			// that follows the most recent failure has passed.
+			if delay := failures.backoffRemaining(); delay > 0 && active < *job.Spec.Parallelism {
+				glog.V(4).Infof("Delaying pod creation for job %q by %v after %d failed pod(s)", key, delay, failures.counted)
+				jm.queue.AddAfter(key, delay)
+			} else if isIndexedJob(&job) {
+				active = jm.manageIndexedJob(activePods, succeededIndexes, &job)
+			} else {
+				active = jm.manageJob(activePods, succeeded, &job)
+			}
 		}
 		completions := succeeded
 		complete := false
@@ -390,16 +425,29 @@ func (jm *JobController) syncJob(key string) error {
 		}
 	}
 
+	completedIndexes := job.Status.CompletedIndexes
+	if isIndexedJob(&job) {
+		completedIndexes = formatIndexes(succeededIndexes)
+	}
+
 	// no need to update the job if the status hasn't changed since last time
-	if job.Status.Active != active || job.Status.Succeeded != succeeded || job.Status.Failed != failed || len(job.Status.Conditions) != conditions {
+	if job.Status.Active != active || job.Status.Succeeded != succeeded || job.Status.Failed != failed || len(job.Status.Conditions) != conditions || job.Status.CompletedIndexes != completedIndexes {
 		job.Status.Active = active
 		job.Status.Succeeded = succeeded
 		job.Status.Failed = failed
+		job.Status.CompletedIndexes = completedIndexes
 
 		if err := jm.updateHandler(&job); err != nil {
 			return err
 		}
 	}
+
+	// Make sure the job is synced again once its deadline passes, even if
+	// none of its pods change in the meantime.
+	if !IsJobFinished(&job) && job.Spec.ActiveDeadlineSeconds != nil {
+		allowedDuration := time.Duration(*job.Spec.ActiveDeadlineSeconds) * time.Second
+		jm.queue.AddAfter(key, job.Status.StartTime.Add(allowedDuration).Sub(time.Now()))
+	}
 	return nil
 }
 
@@ -426,6 +474,136 @@ func newCondition(conditionType batch.JobConditionType, reason, message string)
 	}
 }
 
+// jobFailures summarizes the failures of a job's pods as seen through the
+// pod failure policy of the job.
+type jobFailures struct {
+	// counted is the number of failed pods counted towards the backoff limit.
+	counted int32
+	// restarts is the number of container restarts in active pods, for jobs
+	// whose pods are restarted in place on failure.
+	restarts int32
+	// last is the time of the most recent counted pod failure.
+	last time.Time
+	// failJobMessage describes the failure of a pod matching a FailJob rule.
+	failJobMessage string
+}
+
+// backoffRemaining returns how long the creation of replacement pods should
+// still be delayed after the most recent counted failure.
+func (f *jobFailures) backoffRemaining() time.Duration {
+	if f.counted == 0 || f.last.IsZero() {
+		return 0
+	}
+	return f.last.Add(getBackoff(f.counted)).Sub(time.Now())
+}
+
+// getBackoff returns the delay before replacement pods are created after the
+// given number of failed pods. The delay starts at DefaultJobBackOff and doubles
+// with every failure, up to MaxJobBackOff.
+func getBackoff(failures int32) time.Duration {
+	backoff := DefaultJobBackOff
+	for i := int32(1); i < failures; i++ {
+		backoff *= 2
+		if backoff >= MaxJobBackOff {
+			return MaxJobBackOff
+		}
+	}
+	return backoff
+}
+
+// getFailures evaluates the failed pods of a job against its pod failure policy.
+func getFailures(job *batch.Job, pods, activePods []*v1.Pod) jobFailures {
+	failures := jobFailures{}
+	for _, pod := range pods {
+		if pod.Status.Phase != v1.PodFailed {
+			continue
+		}
+		action, message := getPodFailureAction(job.Spec.PodFailurePolicy, pod)
+		switch action {
+		case batch.PodFailurePolicyActionIgnore:
+			continue
+		case batch.PodFailurePolicyActionFailJob:
+			if len(failures.failJobMessage) == 0 {
+				failures.failJobMessage = message
+			}
+		}
+		failures.counted++
+		if finished := getPodFinishTime(pod); finished.After(failures.last) {
+			failures.last = finished
+		}
+	}
+	if job.Spec.Template.Spec.RestartPolicy == v1.RestartPolicyOnFailure {
+		for _, pod := range activePods {
+			for _, cs := range pod.Status.InitContainerStatuses {
+				failures.restarts += cs.RestartCount
+			}
+			for _, cs := range pod.Status.ContainerStatuses {
+				failures.restarts += cs.RestartCount
+			}
+		}
+	}
+	return failures
+}
+
+// getPodFailureAction returns the action of the first rule of the policy
+// matching the failed pod, along with a message describing the match.
+// Failures not matched by any rule are counted.
+func getPodFailureAction(policy *batch.PodFailurePolicy, pod *v1.Pod) (batch.PodFailurePolicyAction, string) {
+	if policy == nil {
+		return batch.PodFailurePolicyActionCount, ""
+	}
+	for i, rule := range policy.Rules {
+		if cs := matchOnExitCodes(&rule.OnExitCodes, pod); cs != nil {
+			message := fmt.Sprintf("Container %s for pod %s/%s failed with exit code %d matching %s rule at index %d",
+				cs.Name, pod.Namespace, pod.Name, cs.State.Terminated.ExitCode, rule.Action, i)
+			return rule.Action, message
+		}
+	}
+	return batch.PodFailurePolicyActionCount, ""
+}
+
+// matchOnExitCodes returns the status of the first terminated container of
+// the pod whose exit code satisfies the requirement, or nil if there is none.
+func matchOnExitCodes(requirement *batch.PodFailurePolicyOnExitCodesRequirement, pod *v1.Pod) *v1.ContainerStatus {
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for i := range statuses {
+			cs := &statuses[i]
+			if requirement.ContainerName != nil && *requirement.ContainerName != cs.Name {
+				continue
+			}
+			terminated := cs.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			listed := false
+			for _, value := range requirement.Values {
+				if value == terminated.ExitCode {
+					listed = true
+					break
+				}
+			}
+			if listed == (requirement.Operator == batch.PodFailurePolicyOnExitCodesOpIn) {
+				return cs
+			}
+		}
+	}
+	return nil
+}
+
+// getPodFinishTime returns the time the last container of the failed pod
+// terminated, or the zero time if it is not known.
+func getPodFinishTime(pod *v1.Pod) time.Time {
+	finished := time.Time{}
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, cs := range statuses {
+			if cs.State.Terminated != nil && cs.State.Terminated.FinishedAt.After(finished) {
+				finished = cs.State.Terminated.FinishedAt.Time
+			}
+		}
+	}
+	return finished
+}
+
 // getStatus returns no of succeeded and failed pods running a job
 func getStatus(pods []*v1.Pod) (succeeded, failed int32) {
 	succeeded = int32(filterPods(pods, v1.PodSucceeded))
@@ -522,6 +700,90 @@ func (jm *JobController) manageJob(activePods []*v1.Pod, succeeded int32, job *b
 	return active
 }
 
+// manageIndexedJob is the counterpart of manageJob for jobs whose completions
+// are tracked per index. It deletes active pods whose completion index is
+// invalid, duplicated or already succeeded, and creates pods for the lowest
+// indexes that have neither succeeded nor an active pod, so that only failed
+// indexes are retried.
+// Does NOT modify <activePods>.
+func (jm *JobController) manageIndexedJob(activePods []*v1.Pod, succeededIndexes sets.Int, job *batch.Job) int32 {
+	var activeLock sync.Mutex
+	active := int32(len(activePods))
+	parallelism := int(*job.Spec.Parallelism)
+	completions := int(*job.Spec.Completions)
+	jobKey, err := controller.KeyFunc(job)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("Couldn't get key for job %#v: %v", job, err))
+		return 0
+	}
+
+	activeIndexes, podsToDelete := splitActivePodsByIndex(activePods, succeededIndexes, completions)
+	wantActive := completions - succeededIndexes.Len()
+	if wantActive > parallelism {
+		wantActive = parallelism
+	}
+	if excess := len(activeIndexes) - wantActive; excess > 0 {
+		for _, index := range getHighestIndexes(activeIndexes, excess) {
+			podsToDelete = append(podsToDelete, activeIndexes[index])
+			delete(activeIndexes, index)
+		}
+	}
+
+	if diff := int32(len(podsToDelete)); diff > 0 {
+		jm.expectations.ExpectDeletions(jobKey, int(diff))
+		glog.V(4).Infof("Too many pods running indexed job %q, deleting %d", jobKey, diff)
+
+		active -= diff
+		wait := sync.WaitGroup{}
+		wait.Add(int(diff))
+		for _, pod := range podsToDelete {
+			go func(pod *v1.Pod) {
+				defer wait.Done()
+				if err := jm.podControl.DeletePod(job.Namespace, pod.Name, job); err != nil {
+					defer utilruntime.HandleError(err)
+					// Decrement the expected number of deletes because the informer won't observe this deletion
+					jm.expectations.DeletionObserved(jobKey)
+					activeLock.Lock()
+					active++
+					activeLock.Unlock()
+				}
+			}(pod)
+		}
+		wait.Wait()
+		return active
+	}
+
+	pendingIndexes := getPendingIndexes(activeIndexes, succeededIndexes, wantActive-len(activeIndexes), completions)
+	if diff := int32(len(pendingIndexes)); diff > 0 {
+		jm.expectations.ExpectCreations(jobKey, int(diff))
+		glog.V(4).Infof("Too few pods running indexed job %q, creating indexes %v", jobKey, pendingIndexes)
+
+		active += diff
+		wait := sync.WaitGroup{}
+		wait.Add(int(diff))
+		for _, index := range pendingIndexes {
+			go func(index int) {
+				defer wait.Done()
+				template, err := podTemplateForIndex(job, index)
+				if err == nil {
+					err = jm.podControl.CreatePods(job.Namespace, template, job)
+				}
+				if err != nil {
+					defer utilruntime.HandleError(err)
+					// Decrement the expected number of creates because the informer won't observe this pod
+					jm.expectations.CreationObserved(jobKey)
+					activeLock.Lock()
+					active--
+					activeLock.Unlock()
+				}
+			}(index)
+		}
+		wait.Wait()
+	}
+
+	return active
+}
+
 func (jm *JobController) updateJobStatus(job *batch.Job) error {
 	_, err := jm.kubeClient.Batch().Jobs(job.Namespace).UpdateStatus(job)
 	return err
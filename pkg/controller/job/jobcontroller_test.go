@@ -24,6 +24,7 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	restclient "k8s.io/client-go/rest"
@@ -394,6 +395,445 @@ func TestSyncJobPastDeadline(t *testing.T) {
 	}
 }
 
+func TestSyncJobPastBackoffLimit(t *testing.T) {
+	testCases := map[string]struct {
+		// job setup
+		parallelism  int32
+		completions  int32
+		backoffLimit int32
+
+		// pod setup
+		activePods    int32
+		succeededPods int32
+		failedPods    int32
+
+		// expectations
+		expectedCreations int32
+		expectedDeletions int32
+		expectedActive    int32
+		expectedFailed    int32
+		expectedCondition bool
+	}{
+		"backoffLimit 0 with a single failure": {
+			1, 1, 0,
+			0, 0, 1,
+			0, 0, 0, 1, true,
+		},
+		"backoffLimit exceeded kills remaining pods": {
+			2, 5, 2,
+			1, 1, 3,
+			0, 1, 0, 4, true,
+		},
+		"backoffLimit not exceeded": {
+			2, 5, 3,
+			1, 0, 3,
+			1, 0, 2, 3, false,
+		},
+	}
+
+	for name, tc := range testCases {
+		// job manager setup
+		clientset := clientset.NewForConfigOrDie(&restclient.Config{Host: "", ContentConfig: restclient.ContentConfig{GroupVersion: &api.Registry.GroupOrDie(v1.GroupName).GroupVersion}})
+		manager, sharedInformerFactory := newJobControllerFromClient(clientset, controller.NoResyncPeriodFunc)
+		fakePodControl := controller.FakePodControl{}
+		manager.podControl = &fakePodControl
+		manager.podStoreSynced = alwaysReady
+		manager.jobStoreSynced = alwaysReady
+		var actual *batch.Job
+		manager.updateHandler = func(job *batch.Job) error {
+			actual = job
+			return nil
+		}
+
+		// job & pods setup
+		job := newJob(tc.parallelism, tc.completions)
+		job.Spec.BackoffLimit = &tc.backoffLimit
+		sharedInformerFactory.Batch().V1().Jobs().Informer().GetIndexer().Add(job)
+		podIndexer := sharedInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+		for _, pod := range newPodList(tc.activePods, v1.PodRunning, job) {
+			podIndexer.Add(&pod)
+		}
+		for _, pod := range newPodList(tc.succeededPods, v1.PodSucceeded, job) {
+			podIndexer.Add(&pod)
+		}
+		for _, pod := range newPodList(tc.failedPods, v1.PodFailed, job) {
+			podIndexer.Add(&pod)
+		}
+
+		// run
+		err := manager.syncJob(getKey(job, t))
+		if err != nil {
+			t.Errorf("%s: unexpected error when syncing jobs %v", name, err)
+		}
+
+		// validate created/deleted pods
+		if int32(len(fakePodControl.Templates)) != tc.expectedCreations {
+			t.Errorf("%s: unexpected number of creates.  Expected %d, saw %d\n", name, tc.expectedCreations, len(fakePodControl.Templates))
+		}
+		if int32(len(fakePodControl.DeletePodName)) != tc.expectedDeletions {
+			t.Errorf("%s: unexpected number of deletes.  Expected %d, saw %d\n", name, tc.expectedDeletions, len(fakePodControl.DeletePodName))
+		}
+		// validate status
+		if actual.Status.Active != tc.expectedActive {
+			t.Errorf("%s: unexpected number of active pods.  Expected %d, saw %d\n", name, tc.expectedActive, actual.Status.Active)
+		}
+		if actual.Status.Failed != tc.expectedFailed {
+			t.Errorf("%s: unexpected number of failed pods.  Expected %d, saw %d\n", name, tc.expectedFailed, actual.Status.Failed)
+		}
+		// validate conditions
+		if tc.expectedCondition != getConditionReason(actual, batch.JobFailed, "BackoffLimitExceeded") {
+			t.Errorf("%s: expected BackoffLimitExceeded fail condition %v.  Got %#v", name, tc.expectedCondition, actual.Status.Conditions)
+		}
+	}
+}
+
+// newFailedPod returns a failed pod of the job whose single container exited
+// with the given code at the given time.
+func newFailedPod(job *batch.Job, exitCode int32, finishedAt time.Time) *v1.Pod {
+	pod := newPodList(1, v1.PodFailed, job)[0]
+	pod.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name: "main",
+		State: v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{
+				ExitCode:   exitCode,
+				FinishedAt: metav1.NewTime(finishedAt),
+			},
+		},
+	}}
+	return &pod
+}
+
+func TestSyncJobPodFailurePolicy(t *testing.T) {
+	mainContainer := "main"
+	otherContainer := "other"
+	longAgo := time.Now().Add(-time.Hour)
+	testCases := map[string]struct {
+		rules    []batch.PodFailurePolicyRule
+		exitCode int32
+
+		expectedCreations int32
+		expectedReason    string
+	}{
+		"non-retryable exit code fails the job": {
+			rules: []batch.PodFailurePolicyRule{{
+				Action: batch.PodFailurePolicyActionFailJob,
+				OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+					ContainerName: &mainContainer,
+					Operator:      batch.PodFailurePolicyOnExitCodesOpIn,
+					Values:        []int32{3, 42},
+				},
+			}},
+			exitCode:          42,
+			expectedCreations: 0,
+			expectedReason:    "PodFailurePolicy",
+		},
+		"exit code of another container is not matched": {
+			rules: []batch.PodFailurePolicyRule{{
+				Action: batch.PodFailurePolicyActionFailJob,
+				OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+					ContainerName: &otherContainer,
+					Operator:      batch.PodFailurePolicyOnExitCodesOpIn,
+					Values:        []int32{42},
+				},
+			}},
+			exitCode:          42,
+			expectedCreations: 0,
+			expectedReason:    "BackoffLimitExceeded",
+		},
+		"exit codes not listed are non-retryable": {
+			rules: []batch.PodFailurePolicyRule{{
+				Action: batch.PodFailurePolicyActionFailJob,
+				OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+					Operator: batch.PodFailurePolicyOnExitCodesOpNotIn,
+					Values:   []int32{1},
+				},
+			}},
+			exitCode:          2,
+			expectedCreations: 0,
+			expectedReason:    "PodFailurePolicy",
+		},
+		"first matching rule wins": {
+			rules: []batch.PodFailurePolicyRule{
+				{
+					Action: batch.PodFailurePolicyActionIgnore,
+					OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+						Operator: batch.PodFailurePolicyOnExitCodesOpIn,
+						Values:   []int32{42},
+					},
+				},
+				{
+					Action: batch.PodFailurePolicyActionFailJob,
+					OnExitCodes: batch.PodFailurePolicyOnExitCodesRequirement{
+						Operator: batch.PodFailurePolicyOnExitCodesOpIn,
+						Values:   []int32{42},
+					},
+				},
+			},
+			exitCode:          42,
+			expectedCreations: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		// job manager setup
+		clientset := clientset.NewForConfigOrDie(&restclient.Config{Host: "", ContentConfig: restclient.ContentConfig{GroupVersion: &api.Registry.GroupOrDie(v1.GroupName).GroupVersion}})
+		manager, sharedInformerFactory := newJobControllerFromClient(clientset, controller.NoResyncPeriodFunc)
+		fakePodControl := controller.FakePodControl{}
+		manager.podControl = &fakePodControl
+		manager.podStoreSynced = alwaysReady
+		manager.jobStoreSynced = alwaysReady
+		var actual *batch.Job
+		manager.updateHandler = func(job *batch.Job) error {
+			actual = job
+			return nil
+		}
+
+		// job & pods setup; the ignored failure must not exceed a backoff
+		// limit of 0, while a counted one does.
+		job := newJob(1, 1)
+		backoffLimit := int32(0)
+		job.Spec.BackoffLimit = &backoffLimit
+		job.Spec.PodFailurePolicy = &batch.PodFailurePolicy{Rules: tc.rules}
+		sharedInformerFactory.Batch().V1().Jobs().Informer().GetIndexer().Add(job)
+		podIndexer := sharedInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+		podIndexer.Add(newFailedPod(job, tc.exitCode, longAgo))
+
+		// run
+		err := manager.syncJob(getKey(job, t))
+		if err != nil {
+			t.Errorf("%s: unexpected error when syncing jobs %v", name, err)
+		}
+
+		if int32(len(fakePodControl.Templates)) != tc.expectedCreations {
+			t.Errorf("%s: unexpected number of creates.  Expected %d, saw %d\n", name, tc.expectedCreations, len(fakePodControl.Templates))
+		}
+		if len(tc.expectedReason) == 0 {
+			if getCondition(actual, batch.JobFailed) {
+				t.Errorf("%s: unexpected fail condition.  Got %#v", name, actual.Status.Conditions)
+			}
+		} else if !getConditionReason(actual, batch.JobFailed, tc.expectedReason) {
+			t.Errorf("%s: expected fail condition with reason %s.  Got %#v", name, tc.expectedReason, actual.Status.Conditions)
+		}
+	}
+}
+
+func TestSyncJobFailureBackoff(t *testing.T) {
+	testCases := map[string]struct {
+		failedPods int
+		finishedAt time.Time
+
+		expectedCreations int
+	}{
+		"recent failure delays creation": {
+			failedPods:        1,
+			finishedAt:        time.Now(),
+			expectedCreations: 0,
+		},
+		"backoff after the first failure has passed": {
+			failedPods:        1,
+			finishedAt:        time.Now().Add(-DefaultJobBackOff),
+			expectedCreations: 1,
+		},
+		"backoff grows with the number of failures": {
+			failedPods:        3,
+			finishedAt:        time.Now().Add(-2 * DefaultJobBackOff),
+			expectedCreations: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		// job manager setup
+		clientset := clientset.NewForConfigOrDie(&restclient.Config{Host: "", ContentConfig: restclient.ContentConfig{GroupVersion: &api.Registry.GroupOrDie(v1.GroupName).GroupVersion}})
+		manager, sharedInformerFactory := newJobControllerFromClient(clientset, controller.NoResyncPeriodFunc)
+		fakePodControl := controller.FakePodControl{}
+		manager.podControl = &fakePodControl
+		manager.podStoreSynced = alwaysReady
+		manager.jobStoreSynced = alwaysReady
+		manager.updateHandler = func(job *batch.Job) error { return nil }
+
+		// job & pods setup
+		job := newJob(1, 5)
+		sharedInformerFactory.Batch().V1().Jobs().Informer().GetIndexer().Add(job)
+		podIndexer := sharedInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+		for i := 0; i < tc.failedPods; i++ {
+			podIndexer.Add(newFailedPod(job, 1, tc.finishedAt))
+		}
+
+		// run
+		err := manager.syncJob(getKey(job, t))
+		if err != nil {
+			t.Errorf("%s: unexpected error when syncing jobs %v", name, err)
+		}
+		if len(fakePodControl.Templates) != tc.expectedCreations {
+			t.Errorf("%s: unexpected number of creates.  Expected %d, saw %d\n", name, tc.expectedCreations, len(fakePodControl.Templates))
+		}
+	}
+}
+
+func TestGetBackoff(t *testing.T) {
+	testCases := []struct {
+		failures int32
+		expected time.Duration
+	}{
+		{1, DefaultJobBackOff},
+		{2, 2 * DefaultJobBackOff},
+		{3, 4 * DefaultJobBackOff},
+		{10, MaxJobBackOff},
+	}
+	for _, tc := range testCases {
+		if got := getBackoff(tc.failures); got != tc.expected {
+			t.Errorf("getBackoff(%d): expected %v, got %v", tc.failures, tc.expected, got)
+		}
+	}
+}
+
+// newIndexedPod returns a pod of the job in the given phase that holds the
+// given completion index.
+func newIndexedPod(job *batch.Job, phase v1.PodPhase, index string) *v1.Pod {
+	pod := newPodList(1, phase, job)[0]
+	pod.Annotations = map[string]string{batch.JobCompletionIndexAnnotation: index}
+	return &pod
+}
+
+func TestSyncIndexedJob(t *testing.T) {
+	type indexedPod struct {
+		phase v1.PodPhase
+		index string
+	}
+	testCases := map[string]struct {
+		parallelism int32
+		completions int32
+		pods        []indexedPod
+
+		expectedCreatedIndexes   []string
+		expectedDeletions        int
+		expectedActive           int32
+		expectedSucceeded        int32
+		expectedCompletedIndexes string
+		expectedComplete         bool
+	}{
+		"job start": {
+			parallelism:            2,
+			completions:            5,
+			expectedCreatedIndexes: []string{"0", "1"},
+			expectedActive:         2,
+		},
+		"failed index is recreated": {
+			parallelism: 2,
+			completions: 5,
+			pods: []indexedPod{
+				{v1.PodSucceeded, "0"},
+				{v1.PodFailed, "1"},
+				{v1.PodRunning, "3"},
+			},
+			expectedCreatedIndexes:   []string{"1"},
+			expectedActive:           2,
+			expectedSucceeded:        1,
+			expectedCompletedIndexes: "0",
+		},
+		"duplicated and invalid indexes are deleted": {
+			parallelism: 3,
+			completions: 5,
+			pods: []indexedPod{
+				{v1.PodSucceeded, "0"},
+				{v1.PodRunning, "0"},
+				{v1.PodRunning, "1"},
+				{v1.PodRunning, "1"},
+				{v1.PodRunning, "7"},
+				{v1.PodRunning, "invalid"},
+			},
+			expectedDeletions:        4,
+			expectedActive:           1,
+			expectedSucceeded:        1,
+			expectedCompletedIndexes: "0",
+		},
+		"job finish counts each index once": {
+			parallelism: 2,
+			completions: 5,
+			pods: []indexedPod{
+				{v1.PodSucceeded, "0"},
+				{v1.PodSucceeded, "1"},
+				{v1.PodSucceeded, "2"},
+				{v1.PodSucceeded, "2"},
+				{v1.PodSucceeded, "3"},
+				{v1.PodSucceeded, "4"},
+			},
+			expectedSucceeded:        5,
+			expectedCompletedIndexes: "0-4",
+			expectedComplete:         true,
+		},
+	}
+
+	for name, tc := range testCases {
+		// job manager setup
+		clientset := clientset.NewForConfigOrDie(&restclient.Config{Host: "", ContentConfig: restclient.ContentConfig{GroupVersion: &api.Registry.GroupOrDie(v1.GroupName).GroupVersion}})
+		manager, sharedInformerFactory := newJobControllerFromClient(clientset, controller.NoResyncPeriodFunc)
+		fakePodControl := controller.FakePodControl{}
+		manager.podControl = &fakePodControl
+		manager.podStoreSynced = alwaysReady
+		manager.jobStoreSynced = alwaysReady
+		var actual *batch.Job
+		manager.updateHandler = func(job *batch.Job) error {
+			actual = job
+			return nil
+		}
+
+		// job & pods setup
+		job := newJob(tc.parallelism, tc.completions)
+		mode := batch.IndexedCompletion
+		job.Spec.CompletionMode = &mode
+		sharedInformerFactory.Batch().V1().Jobs().Informer().GetIndexer().Add(job)
+		podIndexer := sharedInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+		for _, pod := range tc.pods {
+			podIndexer.Add(newIndexedPod(job, pod.phase, pod.index))
+		}
+
+		// run
+		err := manager.syncJob(getKey(job, t))
+		if err != nil {
+			t.Errorf("%s: unexpected error when syncing jobs %v", name, err)
+		}
+
+		// validate created/deleted pods
+		created := sets.NewString()
+		for _, template := range fakePodControl.Templates {
+			index := template.Annotations[batch.JobCompletionIndexAnnotation]
+			created.Insert(index)
+			if hostname := fmt.Sprintf("%s-%s", job.Name, index); template.Spec.Hostname != hostname {
+				t.Errorf("%s: expected hostname %s, got %s", name, hostname, template.Spec.Hostname)
+			}
+		}
+		if !created.Equal(sets.NewString(tc.expectedCreatedIndexes...)) || len(fakePodControl.Templates) != len(tc.expectedCreatedIndexes) {
+			t.Errorf("%s: expected created indexes %v, got %v", name, tc.expectedCreatedIndexes, created.List())
+		}
+		if len(fakePodControl.DeletePodName) != tc.expectedDeletions {
+			t.Errorf("%s: unexpected number of deletes.  Expected %d, saw %d\n", name, tc.expectedDeletions, len(fakePodControl.DeletePodName))
+		}
+		// validate status
+		if actual.Status.Active != tc.expectedActive {
+			t.Errorf("%s: unexpected number of active pods.  Expected %d, saw %d\n", name, tc.expectedActive, actual.Status.Active)
+		}
+		if actual.Status.Succeeded != tc.expectedSucceeded {
+			t.Errorf("%s: unexpected number of succeeded pods.  Expected %d, saw %d\n", name, tc.expectedSucceeded, actual.Status.Succeeded)
+		}
+		if actual.Status.CompletedIndexes != tc.expectedCompletedIndexes {
+			t.Errorf("%s: unexpected completed indexes.  Expected %q, saw %q\n", name, tc.expectedCompletedIndexes, actual.Status.CompletedIndexes)
+		}
+		if tc.expectedComplete != getCondition(actual, batch.JobComplete) {
+			t.Errorf("%s: expected completion condition %v.  Got %#v", name, tc.expectedComplete, actual.Status.Conditions)
+		}
+	}
+}
+
+func getConditionReason(job *batch.Job, condition batch.JobConditionType, reason string) bool {
+	for _, v := range job.Status.Conditions {
+		if v.Type == condition && v.Status == v1.ConditionTrue && v.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
 func getCondition(job *batch.Job, condition batch.JobConditionType) bool {
 	for _, v := range job.Status.Conditions {
 		if v.Type == condition && v.Status == v1.ConditionTrue {
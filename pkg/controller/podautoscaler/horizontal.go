@@ -74,6 +74,20 @@ func UnsafeConvertToVersionVia(obj runtime.Object, externalVersion schema.GroupV
 	return objExt, err
 }
 
+// timestampedRecommendation is a replica count proposed by the metrics of an HPA
+// at a given time, before it was stabilized and rate limited.
+type timestampedRecommendation struct {
+	recommendation int32
+	timestamp      time.Time
+}
+
+// timestampedScaleEvent is a change in the number of replicas of the target of an HPA.
+// The change is always positive, the direction is given by the list holding the event.
+type timestampedScaleEvent struct {
+	replicaChange int32
+	timestamp     time.Time
+}
+
 type HorizontalController struct {
 	scaleNamespacer extensionsclient.ScalesGetter
 	hpaNamespacer   autoscalingclient.HorizontalPodAutoscalersGetter
@@ -85,9 +99,27 @@ type HorizontalController struct {
 	// NewHorizontalController.
 	hpaLister       autoscalinglisters.HorizontalPodAutoscalerLister
 	hpaListerSynced cache.InformerSynced
+
+	// The history below is keyed by the namespace/name of the HPA. It is only
+	// accessed from the informer event handlers, which are never run concurrently.
+
+	// recommendations holds the latest unstabilized recommendations of each HPA.
+	recommendations map[string][]timestampedRecommendation
+	// scaleUpEvents and scaleDownEvents hold the latest rescales of each HPA
+	// which has scaling behavior configured.
+	scaleUpEvents   map[string][]timestampedScaleEvent
+	scaleDownEvents map[string][]timestampedScaleEvent
+
+	// now returns the current time, it may be replaced in tests.
+	now func() time.Time
 }
 
-var downscaleForbiddenWindow = 5 * time.Minute
+// downscaleStabilisationWindow is the period over which the highest recommendation is
+// used when scaling down an HPA which does not specify any scaling behavior.
+var downscaleStabilisationWindow = 5 * time.Minute
+
+// upscaleForbiddenWindow is the period after a rescale during which an HPA which does not
+// specify any scaling behavior is not scaled up.
 var upscaleForbiddenWindow = 3 * time.Minute
 
 func NewHorizontalController(
@@ -108,6 +140,10 @@ func NewHorizontalController(
 		eventRecorder:   recorder,
 		scaleNamespacer: scaleNamespacer,
 		hpaNamespacer:   hpaNamespacer,
+		recommendations: map[string][]timestampedRecommendation{},
+		scaleUpEvents:   map[string][]timestampedScaleEvent{},
+		scaleDownEvents: map[string][]timestampedScaleEvent{},
+		now:             time.Now,
 	}
 
 	hpaInformer.Informer().AddEventHandlerWithResyncPeriod(
@@ -126,7 +162,7 @@ func NewHorizontalController(
 					glog.Warningf("Failed to reconcile %s: %v", hpa.Name, err)
 				}
 			},
-			// We are not interested in deletions.
+			DeleteFunc: controller.deleteAutoscaler,
 		},
 		resyncPeriod,
 	)
@@ -136,6 +172,18 @@ func NewHorizontalController(
 	return controller
 }
 
+// deleteAutoscaler forgets the recommendation and scale event history of a deleted HPA.
+func (a *HorizontalController) deleteAutoscaler(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	delete(a.recommendations, key)
+	delete(a.scaleUpEvents, key)
+	delete(a.scaleDownEvents, key)
+}
+
 func (a *HorizontalController) Run(stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 
@@ -259,6 +307,42 @@ func (a *HorizontalController) computeReplicasForMetrics(hpa *autoscalingv2.Hori
 					},
 				}
 			}
+		case autoscalingv2.ExternalMetricSourceType:
+			if metricSpec.External.TargetAverageValue != nil {
+				replicaCountProposal, utilizationProposal, timestampProposal, err = a.replicaCalc.GetExternalPerPodMetricReplicas(currentReplicas, metricSpec.External.TargetAverageValue.MilliValue(), metricSpec.External.MetricName, hpa.Namespace, metricSpec.External.MetricSelector)
+				if err != nil {
+					a.eventRecorder.Event(hpa, v1.EventTypeWarning, "FailedGetExternalMetric", err.Error())
+					return 0, "", nil, time.Time{}, fmt.Errorf("failed to get external metric %s: %v", metricSpec.External.MetricName, err)
+				}
+				metricNameProposal = fmt.Sprintf("external metric %s(%+v)", metricSpec.External.MetricName, metricSpec.External.MetricSelector)
+				statuses[i] = autoscalingv2.MetricStatus{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricStatus{
+						MetricSelector:      metricSpec.External.MetricSelector,
+						MetricName:          metricSpec.External.MetricName,
+						CurrentAverageValue: resource.NewMilliQuantity(utilizationProposal, resource.DecimalSI),
+					},
+				}
+			} else if metricSpec.External.TargetValue != nil {
+				replicaCountProposal, utilizationProposal, timestampProposal, err = a.replicaCalc.GetExternalMetricReplicas(currentReplicas, metricSpec.External.TargetValue.MilliValue(), metricSpec.External.MetricName, hpa.Namespace, metricSpec.External.MetricSelector)
+				if err != nil {
+					a.eventRecorder.Event(hpa, v1.EventTypeWarning, "FailedGetExternalMetric", err.Error())
+					return 0, "", nil, time.Time{}, fmt.Errorf("failed to get external metric %s: %v", metricSpec.External.MetricName, err)
+				}
+				metricNameProposal = fmt.Sprintf("external metric %s(%+v)", metricSpec.External.MetricName, metricSpec.External.MetricSelector)
+				statuses[i] = autoscalingv2.MetricStatus{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricStatus{
+						MetricSelector: metricSpec.External.MetricSelector,
+						MetricName:     metricSpec.External.MetricName,
+						CurrentValue:   *resource.NewMilliQuantity(utilizationProposal, resource.DecimalSI),
+					},
+				}
+			} else {
+				errMsg := "invalid external metric source: neither a value target nor an average value target was set"
+				a.eventRecorder.Event(hpa, v1.EventTypeWarning, "FailedGetExternalMetric", errMsg)
+				return 0, "", nil, time.Time{}, fmt.Errorf("%s", errMsg)
+			}
 		default:
 			errMsg := fmt.Sprintf("unknown metric source type %q", string(metricSpec.Type))
 			a.eventRecorder.Event(hpa, v1.EventTypeWarning, "InvalidMetricSourceType", errMsg)
@@ -292,13 +376,21 @@ func (a *HorizontalController) reconcileAutoscaler(hpav1Shared *autoscalingv1.Ho
 	}
 	hpa := hpaRaw.(*autoscalingv2.HorizontalPodAutoscaler)
 
+	key, err := cache.MetaNamespaceKeyFunc(hpa)
+	if err != nil {
+		return fmt.Errorf("couldn't get key for HPA %s: %v", hpa.Name, err)
+	}
+
 	reference := fmt.Sprintf("%s/%s/%s", hpa.Spec.ScaleTargetRef.Kind, hpa.Namespace, hpa.Spec.ScaleTargetRef.Name)
 
 	scale, err := a.scaleNamespacer.Scales(hpa.Namespace).Get(hpa.Spec.ScaleTargetRef.Kind, hpa.Spec.ScaleTargetRef.Name)
 	if err != nil {
 		a.eventRecorder.Event(hpa, v1.EventTypeWarning, "FailedGetScale", err.Error())
+		setCondition(hpa, autoscalingv2.AbleToScale, v1.ConditionFalse, "FailedGetScale", "the HPA controller was unable to get the target's current scale: %v", err)
+		a.updateStatusIfNeeded(hpa)
 		return fmt.Errorf("failed to query scale subresource for %s: %v", reference, err)
 	}
+	setCondition(hpa, autoscalingv2.AbleToScale, v1.ConditionTrue, "SucceededGetScale", "the HPA controller was able to get the target's current scale")
 	currentReplicas := scale.Status.Replicas
 
 	var metricStatuses []autoscalingv2.MetricStatus
@@ -308,7 +400,7 @@ func (a *HorizontalController) reconcileAutoscaler(hpav1Shared *autoscalingv1.Ho
 
 	desiredReplicas := int32(0)
 	rescaleReason := ""
-	timestamp := time.Now()
+	timestamp := a.now()
 
 	rescale := true
 
@@ -316,6 +408,7 @@ func (a *HorizontalController) reconcileAutoscaler(hpav1Shared *autoscalingv1.Ho
 		// Autoscaling is disabled for this resource
 		desiredReplicas = 0
 		rescale = false
+		setCondition(hpa, autoscalingv2.ScalingActive, v1.ConditionFalse, "ScalingDisabled", "scaling is disabled since the replica count of the target is zero")
 	} else if currentReplicas > hpa.Spec.MaxReplicas {
 		rescaleReason = "Current number of replicas above Spec.MaxReplicas"
 		desiredReplicas = hpa.Spec.MaxReplicas
@@ -328,10 +421,12 @@ func (a *HorizontalController) reconcileAutoscaler(hpav1Shared *autoscalingv1.Ho
 	} else {
 		metricDesiredReplicas, metricName, metricStatuses, metricTimestamp, err = a.computeReplicasForMetrics(hpa, scale, hpa.Spec.Metrics)
 		if err != nil {
+			setCondition(hpa, autoscalingv2.ScalingActive, v1.ConditionFalse, "FailedComputeMetricsReplicas", "the HPA was unable to compute the replica count: %v", err)
 			a.updateCurrentReplicasInStatus(hpa, currentReplicas)
 			a.eventRecorder.Event(hpa, v1.EventTypeWarning, "FailedComputeMetricsReplicas", err.Error())
 			return fmt.Errorf("failed to compute desired number of replicas based on listed metrics for %s: %v", reference, err)
 		}
+		setCondition(hpa, autoscalingv2.ScalingActive, v1.ConditionTrue, "ValidMetricFound", "the HPA was able to successfully calculate a replica count from %s", metricName)
 
 		glog.V(4).Infof("proposing %v desired replicas (based on %s from %s) for %s", metricDesiredReplicas, metricName, timestamp, reference)
 
@@ -348,26 +443,20 @@ func (a *HorizontalController) reconcileAutoscaler(hpav1Shared *autoscalingv1.Ho
 			rescaleReason = "All metrics below target"
 		}
 
-		if hpa.Spec.MinReplicas != nil && desiredReplicas < *hpa.Spec.MinReplicas {
-			desiredReplicas = *hpa.Spec.MinReplicas
-		}
-
-		//  never scale down to 0, reserved for disabling autoscaling
-		if desiredReplicas == 0 {
-			desiredReplicas = 1
-		}
-
-		if desiredReplicas > hpa.Spec.MaxReplicas {
-			desiredReplicas = hpa.Spec.MaxReplicas
+		a.seedRecommendations(hpa, key, currentReplicas)
+		if hpa.Spec.Behavior == nil {
+			desiredReplicas = a.normalizeDesiredReplicas(hpa, key, currentReplicas, desiredReplicas)
+		} else {
+			desiredReplicas = a.normalizeDesiredReplicasWithBehaviors(hpa, key, currentReplicas, desiredReplicas)
 		}
 
-		// Do not upscale too much to prevent incorrect rapid increase of the number of master replicas caused by
-		// bogus CPU usage report from heapster/kubelet (like in issue #32304).
-		if desiredReplicas > calculateScaleUpLimit(currentReplicas) {
-			desiredReplicas = calculateScaleUpLimit(currentReplicas)
+		rescale = desiredReplicas != currentReplicas
+		// Going up only if there was no rescaling in the last upscaleForbiddenWindow, unless the
+		// scaling behavior of the HPA replaces it.
+		if rescale && hpa.Spec.Behavior == nil && desiredReplicas > currentReplicas && upscaleForbidden(hpa, timestamp) {
+			setCondition(hpa, autoscalingv2.AbleToScale, v1.ConditionFalse, "BackoffUp", "the time since the previous scale is still within the upscale forbidden window")
+			rescale = false
 		}
-
-		rescale = shouldScale(hpa, currentReplicas, desiredReplicas, timestamp)
 	}
 
 	if rescale {
@@ -375,8 +464,12 @@ func (a *HorizontalController) reconcileAutoscaler(hpav1Shared *autoscalingv1.Ho
 		_, err = a.scaleNamespacer.Scales(hpa.Namespace).Update(hpa.Spec.ScaleTargetRef.Kind, scale)
 		if err != nil {
 			a.eventRecorder.Eventf(hpa, v1.EventTypeWarning, "FailedRescale", "New size: %d; reason: %s; error: %v", desiredReplicas, rescaleReason, err.Error())
+			setCondition(hpa, autoscalingv2.AbleToScale, v1.ConditionFalse, "FailedUpdateScale", "the HPA controller was unable to update the target scale: %v", err)
+			a.updateCurrentReplicasInStatus(hpa, currentReplicas)
 			return fmt.Errorf("failed to rescale %s: %v", reference, err)
 		}
+		setCondition(hpa, autoscalingv2.AbleToScale, v1.ConditionTrue, "SucceededRescale", "the HPA controller was able to update the target scale to %d", desiredReplicas)
+		a.storeScaleEvent(hpa.Spec.Behavior, key, currentReplicas, desiredReplicas)
 		a.eventRecorder.Eventf(hpa, v1.EventTypeNormal, "SuccessfulRescale", "New size: %d; reason: %s", desiredReplicas, rescaleReason)
 		glog.Infof("Successfull rescale of %s, old size: %d, new size: %d, reason: %s",
 			hpa.Name, currentReplicas, desiredReplicas, rescaleReason)
@@ -388,28 +481,365 @@ func (a *HorizontalController) reconcileAutoscaler(hpav1Shared *autoscalingv1.Ho
 	return a.updateStatus(hpa, currentReplicas, desiredReplicas, metricStatuses, rescale)
 }
 
-func shouldScale(hpa *autoscalingv2.HorizontalPodAutoscaler, currentReplicas, desiredReplicas int32, timestamp time.Time) bool {
-	if desiredReplicas == currentReplicas {
-		return false
+// upscaleForbidden returns true if the last rescale of hpa happened within upscaleForbiddenWindow
+// before timestamp.
+func upscaleForbidden(hpa *autoscalingv2.HorizontalPodAutoscaler, timestamp time.Time) bool {
+	return hpa.Status.LastScaleTime != nil && !hpa.Status.LastScaleTime.Add(upscaleForbiddenWindow).Before(timestamp)
+}
+
+// normalizeDesiredReplicas applies the default scaling rules to the replica count proposed by the
+// metrics of an HPA without scaling behavior: scaling down uses the highest recommendation of the
+// downscale stabilisation window, and scaling up is capped to calculateScaleUpLimit.
+func (a *HorizontalController) normalizeDesiredReplicas(hpa *autoscalingv2.HorizontalPodAutoscaler, key string, currentReplicas, prenormalizedDesiredReplicas int32) int32 {
+	stabilizedRecommendation := a.stabilizeRecommendation(key, prenormalizedDesiredReplicas)
+	if stabilizedRecommendation != prenormalizedDesiredReplicas {
+		a.setConditionWithEvent(hpa, autoscalingv2.AbleToScale, v1.ConditionTrue, "ScaleDownStabilized", "recent recommendations were higher than current one, applying the highest recent recommendation")
+	} else {
+		setCondition(hpa, autoscalingv2.AbleToScale, v1.ConditionTrue, "ReadyForNewScale", "recommended size matches current size")
+	}
+
+	minReplicas := int32(1)
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+
+	desiredReplicas, reason, message := convertDesiredReplicasWithRules(currentReplicas, stabilizedRecommendation, minReplicas, hpa.Spec.MaxReplicas)
+	if desiredReplicas == stabilizedRecommendation {
+		setCondition(hpa, autoscalingv2.ScalingLimited, v1.ConditionFalse, reason, "%s", message)
+	} else {
+		a.setConditionWithEvent(hpa, autoscalingv2.ScalingLimited, v1.ConditionTrue, reason, "%s", message)
+	}
+
+	return desiredReplicas
+}
+
+// stabilizeRecommendation records the given recommendation and returns the highest
+// recommendation of the downscale stabilisation window.
+func (a *HorizontalController) stabilizeRecommendation(key string, prenormalizedDesiredReplicas int32) int32 {
+	now := a.now()
+	a.recordRecommendation(key, prenormalizedDesiredReplicas, now, downscaleStabilisationWindow)
+
+	maxRecommendation := prenormalizedDesiredReplicas
+	cutoff := now.Add(-downscaleStabilisationWindow)
+	for _, rec := range a.recommendations[key] {
+		if rec.timestamp.After(cutoff) && rec.recommendation > maxRecommendation {
+			maxRecommendation = rec.recommendation
+		}
+	}
+	return maxRecommendation
+}
+
+// convertDesiredReplicasWithRules clamps the desired replicas between the minimum and maximum
+// replicas of the HPA, and caps scaling up, returning the reason and message of the limit hit.
+func convertDesiredReplicasWithRules(currentReplicas, desiredReplicas, hpaMinReplicas, hpaMaxReplicas int32) (int32, string, string) {
+	var minimumAllowedReplicas int32
+	var maximumAllowedReplicas int32
+
+	var possibleLimitingCondition string
+	var possibleLimitingReason string
+
+	if hpaMinReplicas == 0 {
+		//  never scale down to 0, reserved for disabling autoscaling
+		minimumAllowedReplicas = 1
+		possibleLimitingReason = "the desired replica count is zero"
+	} else {
+		minimumAllowedReplicas = hpaMinReplicas
+		possibleLimitingReason = "the desired replica count is less than the minimum replica count"
+	}
+
+	// Do not upscale too much to prevent incorrect rapid increase of the number of master replicas caused by
+	// bogus CPU usage report from heapster/kubelet (like in issue #32304).
+	scaleUpLimit := calculateScaleUpLimit(currentReplicas)
+
+	if hpaMaxReplicas > scaleUpLimit {
+		maximumAllowedReplicas = scaleUpLimit
+		possibleLimitingCondition = "ScaleUpLimit"
+	} else {
+		maximumAllowedReplicas = hpaMaxReplicas
+		possibleLimitingCondition = "TooManyReplicas"
+	}
+
+	if desiredReplicas < minimumAllowedReplicas {
+		return minimumAllowedReplicas, "TooFewReplicas", possibleLimitingReason
+	} else if desiredReplicas > maximumAllowedReplicas {
+		if possibleLimitingCondition == "ScaleUpLimit" {
+			return maximumAllowedReplicas, possibleLimitingCondition, "the desired replica count is increasing faster than the maximum scale rate"
+		}
+		return maximumAllowedReplicas, possibleLimitingCondition, "the desired replica count is more than the maximum replica count"
+	}
+
+	return desiredReplicas, "DesiredWithinRange", "the desired count is within the acceptable range"
+}
+
+// normalizeDesiredReplicasWithBehaviors applies the scaling behavior of an HPA to the replica count
+// proposed by its metrics: the proposal is first stabilized over the stabilization windows of both
+// directions, then limited by the scaling policies and the minimum and maximum replicas.
+func (a *HorizontalController) normalizeDesiredReplicasWithBehaviors(hpa *autoscalingv2.HorizontalPodAutoscaler, key string, currentReplicas, prenormalizedDesiredReplicas int32) int32 {
+	scaleUpRules := autoscalingv2.GenerateHPAScaleUpRules(hpa.Spec.Behavior.ScaleUp)
+	scaleDownRules := autoscalingv2.GenerateHPAScaleDownRules(hpa.Spec.Behavior.ScaleDown)
+
+	minReplicas := int32(1)
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+
+	stabilizedRecommendation, reason, message := a.stabilizeRecommendationWithBehaviors(key, currentReplicas, prenormalizedDesiredReplicas, scaleUpRules, scaleDownRules)
+	if stabilizedRecommendation != prenormalizedDesiredReplicas {
+		a.setConditionWithEvent(hpa, autoscalingv2.AbleToScale, v1.ConditionTrue, reason, "%s", message)
+	} else {
+		setCondition(hpa, autoscalingv2.AbleToScale, v1.ConditionTrue, "ReadyForNewScale", "recommended size matches current size")
 	}
 
-	if hpa.Status.LastScaleTime == nil {
-		return true
+	desiredReplicas, reason, message := a.convertDesiredReplicasWithBehaviorRate(key, currentReplicas, stabilizedRecommendation, minReplicas, hpa.Spec.MaxReplicas, scaleUpRules, scaleDownRules)
+	if desiredReplicas == stabilizedRecommendation {
+		setCondition(hpa, autoscalingv2.ScalingLimited, v1.ConditionFalse, reason, "%s", message)
+	} else {
+		a.setConditionWithEvent(hpa, autoscalingv2.ScalingLimited, v1.ConditionTrue, reason, "%s", message)
 	}
 
-	// Going down only if the usageRatio dropped significantly below the target
-	// and there was no rescaling in the last downscaleForbiddenWindow.
-	if desiredReplicas < currentReplicas && hpa.Status.LastScaleTime.Add(downscaleForbiddenWindow).Before(timestamp) {
-		return true
+	return desiredReplicas
+}
+
+// stabilizeRecommendationWithBehaviors records the given recommendation and returns the replica
+// count closest to currentReplicas which is allowed by the recommendations of both stabilization
+// windows: scaling up uses the lowest recommendation of the scale up window, and scaling down
+// uses the highest recommendation of the scale down window.
+func (a *HorizontalController) stabilizeRecommendationWithBehaviors(key string, currentReplicas, prenormalizedDesiredReplicas int32, scaleUpRules, scaleDownRules *autoscalingv2.HPAScalingRules) (int32, string, string) {
+	now := a.now()
+	scaleUpWindow := time.Second * time.Duration(*scaleUpRules.StabilizationWindowSeconds)
+	scaleDownWindow := time.Second * time.Duration(*scaleDownRules.StabilizationWindowSeconds)
+
+	longestWindow := scaleUpWindow
+	if scaleDownWindow > longestWindow {
+		longestWindow = scaleDownWindow
+	}
+	a.recordRecommendation(key, prenormalizedDesiredReplicas, now, longestWindow)
+
+	upRecommendation := prenormalizedDesiredReplicas
+	downRecommendation := prenormalizedDesiredReplicas
+	upCutoff := now.Add(-scaleUpWindow)
+	downCutoff := now.Add(-scaleDownWindow)
+	for _, rec := range a.recommendations[key] {
+		if rec.timestamp.After(upCutoff) && rec.recommendation < upRecommendation {
+			upRecommendation = rec.recommendation
+		}
+		if rec.timestamp.After(downCutoff) && rec.recommendation > downRecommendation {
+			downRecommendation = rec.recommendation
+		}
 	}
 
-	// Going up only if the usage ratio increased significantly above the target
-	// and there was no rescaling in the last upscaleForbiddenWindow.
-	if desiredReplicas > currentReplicas && hpa.Status.LastScaleTime.Add(upscaleForbiddenWindow).Before(timestamp) {
-		return true
+	recommendation := currentReplicas
+	if recommendation < upRecommendation {
+		recommendation = upRecommendation
+	}
+	if recommendation > downRecommendation {
+		recommendation = downRecommendation
 	}
 
-	return false
+	if prenormalizedDesiredReplicas > recommendation {
+		return recommendation, "ScaleUpStabilized", "recent recommendations were lower than current one, applying the lowest recent recommendation"
+	}
+	return recommendation, "ScaleDownStabilized", "recent recommendations were higher than current one, applying the highest recent recommendation"
+}
+
+// seedRecommendations starts the recommendation history of an HPA seen for the first time,
+// e.g. after the controller restarted, with its current replica count at the time of its last
+// rescale, so that it is not scaled down before the stabilization window of that rescale elapsed.
+func (a *HorizontalController) seedRecommendations(hpa *autoscalingv2.HorizontalPodAutoscaler, key string, currentReplicas int32) {
+	if _, found := a.recommendations[key]; found || hpa.Status.LastScaleTime == nil {
+		return
+	}
+	a.recommendations[key] = []timestampedRecommendation{{recommendation: currentReplicas, timestamp: hpa.Status.LastScaleTime.Time}}
+}
+
+// recordRecommendation appends the given recommendation to the history of an HPA,
+// dropping the recommendations which are older than the given window.
+func (a *HorizontalController) recordRecommendation(key string, recommendation int32, now time.Time, window time.Duration) {
+	cutoff := now.Add(-window)
+	recommendations := []timestampedRecommendation{}
+	for _, rec := range a.recommendations[key] {
+		if rec.timestamp.After(cutoff) {
+			recommendations = append(recommendations, rec)
+		}
+	}
+	a.recommendations[key] = append(recommendations, timestampedRecommendation{recommendation: recommendation, timestamp: now})
+}
+
+// convertDesiredReplicasWithBehaviorRate limits the desired replicas by the scaling policies
+// and by the minimum and maximum replicas, returning the reason and message of the limit hit.
+func (a *HorizontalController) convertDesiredReplicasWithBehaviorRate(key string, currentReplicas, desiredReplicas, minReplicas, maxReplicas int32, scaleUpRules, scaleDownRules *autoscalingv2.HPAScalingRules) (int32, string, string) {
+	now := a.now()
+	if desiredReplicas > currentReplicas {
+		scaleUpLimit := calculateScaleUpLimitWithScalingRules(currentReplicas, a.scaleUpEvents[key], scaleUpRules, now)
+		if scaleUpLimit < currentReplicas {
+			// the scale up limit may be lower than the current replicas if they were increased
+			// by something else than the HPA; never scale down while scaling up.
+			scaleUpLimit = currentReplicas
+		}
+		maximumAllowedReplicas := maxReplicas
+		reason, message := "TooManyReplicas", "the desired replica count is more than the maximum replica count"
+		if maximumAllowedReplicas > scaleUpLimit {
+			maximumAllowedReplicas = scaleUpLimit
+			reason, message = "ScaleUpLimit", "the desired replica count is increasing faster than the maximum scale rate"
+		}
+		if desiredReplicas > maximumAllowedReplicas {
+			return maximumAllowedReplicas, reason, message
+		}
+	} else if desiredReplicas < currentReplicas {
+		scaleDownLimit := calculateScaleDownLimitWithScalingRules(currentReplicas, a.scaleDownEvents[key], scaleDownRules, now)
+		if scaleDownLimit > currentReplicas {
+			// the scale down limit may be higher than the current replicas if they were decreased
+			// by something else than the HPA; never scale up while scaling down.
+			scaleDownLimit = currentReplicas
+		}
+		minimumAllowedReplicas := minReplicas
+		reason, message := "TooFewReplicas", "the desired replica count is less than the minimum replica count"
+		if minimumAllowedReplicas < scaleDownLimit {
+			minimumAllowedReplicas = scaleDownLimit
+			reason, message = "ScaleDownLimit", "the desired replica count is decreasing faster than the maximum scale rate"
+		}
+		if desiredReplicas < minimumAllowedReplicas {
+			return minimumAllowedReplicas, reason, message
+		}
+	}
+
+	if desiredReplicas > maxReplicas {
+		return maxReplicas, "TooManyReplicas", "the desired replica count is more than the maximum replica count"
+	}
+	if desiredReplicas < minReplicas {
+		return minReplicas, "TooFewReplicas", "the desired replica count is less than the minimum replica count"
+	}
+	return desiredReplicas, "DesiredWithinRange", "the desired count is within the acceptable range"
+}
+
+// getReplicasChangePerPeriod returns the number of replicas changed by the scale events
+// which happened during the last periodSeconds.
+func getReplicasChangePerPeriod(periodSeconds int32, scaleEvents []timestampedScaleEvent, now time.Time) int32 {
+	cutoff := now.Add(-time.Second * time.Duration(periodSeconds))
+	var replicas int32
+	for _, event := range scaleEvents {
+		if event.timestamp.After(cutoff) {
+			replicas += event.replicaChange
+		}
+	}
+	return replicas
+}
+
+// calculateScaleUpLimitWithScalingRules returns the maximum number of replicas allowed by
+// the scale up policies, given the scale up events of their periods.
+func calculateScaleUpLimitWithScalingRules(currentReplicas int32, scaleEvents []timestampedScaleEvent, scalingRules *autoscalingv2.HPAScalingRules, now time.Time) int32 {
+	var result int32
+	var selectPolicyFn func(int32, int32) int32
+	switch *scalingRules.SelectPolicy {
+	case autoscalingv2.DisabledPolicySelect:
+		return currentReplicas
+	case autoscalingv2.MinPolicySelect:
+		result = math.MaxInt32
+		selectPolicyFn = minInt32
+	default:
+		result = math.MinInt32
+		selectPolicyFn = maxInt32
+	}
+	for _, policy := range scalingRules.Policies {
+		replicasAddedInCurrentPeriod := getReplicasChangePerPeriod(policy.PeriodSeconds, scaleEvents, now)
+		periodStartReplicas := currentReplicas - replicasAddedInCurrentPeriod
+		var proposed int32
+		switch policy.Type {
+		case autoscalingv2.PodsScalingPolicy:
+			proposed = periodStartReplicas + policy.Value
+		case autoscalingv2.PercentScalingPolicy:
+			// the proposal is rounded up, so that a single replica may always be added
+			proposed = int32(math.Ceil(float64(periodStartReplicas) * (1 + float64(policy.Value)/100)))
+		default:
+			continue
+		}
+		result = selectPolicyFn(result, proposed)
+	}
+	return result
+}
+
+// calculateScaleDownLimitWithScalingRules returns the minimum number of replicas allowed by
+// the scale down policies, given the scale down events of their periods.
+func calculateScaleDownLimitWithScalingRules(currentReplicas int32, scaleEvents []timestampedScaleEvent, scalingRules *autoscalingv2.HPAScalingRules, now time.Time) int32 {
+	var result int32
+	var selectPolicyFn func(int32, int32) int32
+	switch *scalingRules.SelectPolicy {
+	case autoscalingv2.DisabledPolicySelect:
+		return currentReplicas
+	case autoscalingv2.MinPolicySelect:
+		result = math.MinInt32
+		selectPolicyFn = maxInt32 // the smallest change gives the highest limit
+	default:
+		result = math.MaxInt32
+		selectPolicyFn = minInt32 // the largest change gives the lowest limit
+	}
+	for _, policy := range scalingRules.Policies {
+		replicasDeletedInCurrentPeriod := getReplicasChangePerPeriod(policy.PeriodSeconds, scaleEvents, now)
+		periodStartReplicas := currentReplicas + replicasDeletedInCurrentPeriod
+		var proposed int32
+		switch policy.Type {
+		case autoscalingv2.PodsScalingPolicy:
+			proposed = periodStartReplicas - policy.Value
+		case autoscalingv2.PercentScalingPolicy:
+			proposed = int32(float64(periodStartReplicas) * (1 - float64(policy.Value)/100))
+		default:
+			continue
+		}
+		result = selectPolicyFn(result, proposed)
+	}
+	return result
+}
+
+// storeScaleEvent records a rescale of an HPA with scaling behavior, dropping the
+// events which are older than the longest policy period of the scaling direction.
+func (a *HorizontalController) storeScaleEvent(behavior *autoscalingv2.HorizontalPodAutoscalerBehavior, key string, prevReplicas, newReplicas int32) {
+	if behavior == nil {
+		// scale events are only used by the scaling policies
+		return
+	}
+	now := a.now()
+	if newReplicas > prevReplicas {
+		rules := autoscalingv2.GenerateHPAScaleUpRules(behavior.ScaleUp)
+		a.scaleUpEvents[key] = appendScaleEvent(a.scaleUpEvents[key], newReplicas-prevReplicas, now, getLongestPolicyPeriod(rules))
+	} else if newReplicas < prevReplicas {
+		rules := autoscalingv2.GenerateHPAScaleDownRules(behavior.ScaleDown)
+		a.scaleDownEvents[key] = appendScaleEvent(a.scaleDownEvents[key], prevReplicas-newReplicas, now, getLongestPolicyPeriod(rules))
+	}
+}
+
+func appendScaleEvent(scaleEvents []timestampedScaleEvent, replicaChange int32, now time.Time, longestPeriodSeconds int32) []timestampedScaleEvent {
+	cutoff := now.Add(-time.Second * time.Duration(longestPeriodSeconds))
+	events := []timestampedScaleEvent{}
+	for _, event := range scaleEvents {
+		if event.timestamp.After(cutoff) {
+			events = append(events, event)
+		}
+	}
+	return append(events, timestampedScaleEvent{replicaChange: replicaChange, timestamp: now})
+}
+
+func getLongestPolicyPeriod(scalingRules *autoscalingv2.HPAScalingRules) int32 {
+	var longestPolicyPeriod int32
+	for _, policy := range scalingRules.Policies {
+		if policy.PeriodSeconds > longestPolicyPeriod {
+			longestPolicyPeriod = policy.PeriodSeconds
+		}
+	}
+	return longestPolicyPeriod
+}
+
+func maxInt32(a, b int32) int32 {
+	if a >= b {
+		return a
+	}
+	return b
+}
+
+func minInt32(a, b int32) int32 {
+	if a <= b {
+		return a
+	}
+	return b
 }
 
 func (a *HorizontalController) updateCurrentReplicasInStatus(hpa *autoscalingv2.HorizontalPodAutoscaler, currentReplicas int32) {
@@ -419,16 +849,25 @@ func (a *HorizontalController) updateCurrentReplicasInStatus(hpa *autoscalingv2.
 	}
 }
 
+// updateStatusIfNeeded writes the status of the HPA without changing its replica counts.
+func (a *HorizontalController) updateStatusIfNeeded(hpa *autoscalingv2.HorizontalPodAutoscaler) {
+	err := a.updateStatus(hpa, hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas, hpa.Status.CurrentMetrics, false)
+	if err != nil {
+		utilruntime.HandleError(err)
+	}
+}
+
 func (a *HorizontalController) updateStatus(hpa *autoscalingv2.HorizontalPodAutoscaler, currentReplicas, desiredReplicas int32, metricStatuses []autoscalingv2.MetricStatus, rescale bool) error {
 	hpa.Status = autoscalingv2.HorizontalPodAutoscalerStatus{
 		CurrentReplicas: currentReplicas,
 		DesiredReplicas: desiredReplicas,
 		LastScaleTime:   hpa.Status.LastScaleTime,
 		CurrentMetrics:  metricStatuses,
+		Conditions:      hpa.Status.Conditions,
 	}
 
 	if rescale {
-		now := metav1.NewTime(time.Now())
+		now := metav1.NewTime(a.now())
 		hpa.Status.LastScaleTime = &now
 	}
 
@@ -448,3 +887,59 @@ func (a *HorizontalController) updateStatus(hpa *autoscalingv2.HorizontalPodAuto
 	glog.V(2).Infof("Successfully updated status for %s", hpa.Name)
 	return nil
 }
+
+// setConditionWithEvent sets the given condition like setCondition, and records an event
+// when the condition was not already set with the same status and reason.
+func (a *HorizontalController) setConditionWithEvent(hpa *autoscalingv2.HorizontalPodAutoscaler, conditionType autoscalingv2.HorizontalPodAutoscalerConditionType, status v1.ConditionStatus, reason, message string, args ...interface{}) {
+	if existing := getCondition(hpa.Status.Conditions, conditionType); existing == nil || existing.Status != status || existing.Reason != reason {
+		a.eventRecorder.Eventf(hpa, v1.EventTypeNormal, reason, message, args...)
+	}
+	setCondition(hpa, conditionType, status, reason, message, args...)
+}
+
+// setCondition sets the specific condition type on the given HPA to the specified value with the given reason
+// and message.  The message and args are treated like a format string.  The condition will be added if it is
+// not present.  The last transition time is only updated when the status of the condition changes.
+func setCondition(hpa *autoscalingv2.HorizontalPodAutoscaler, conditionType autoscalingv2.HorizontalPodAutoscalerConditionType, status v1.ConditionStatus, reason, message string, args ...interface{}) {
+	hpa.Status.Conditions = setConditionInList(hpa.Status.Conditions, conditionType, status, reason, message, args...)
+}
+
+// setConditionInList sets the specific condition type on the given list of conditions to the specified value
+// with the given reason and message, returning the resulting list.
+func setConditionInList(inputList []autoscalingv2.HorizontalPodAutoscalerCondition, conditionType autoscalingv2.HorizontalPodAutoscalerConditionType, status v1.ConditionStatus, reason, message string, args ...interface{}) []autoscalingv2.HorizontalPodAutoscalerCondition {
+	resList := inputList
+	var existingCond *autoscalingv2.HorizontalPodAutoscalerCondition
+	for i, condition := range resList {
+		if condition.Type == conditionType {
+			// can't take a pointer to an iteration variable
+			existingCond = &resList[i]
+			break
+		}
+	}
+
+	if existingCond == nil {
+		resList = append(resList, autoscalingv2.HorizontalPodAutoscalerCondition{
+			Type: conditionType,
+		})
+		existingCond = &resList[len(resList)-1]
+	}
+
+	if existingCond.Status != status {
+		existingCond.LastTransitionTime = metav1.Now()
+	}
+
+	existingCond.Status = status
+	existingCond.Reason = reason
+	existingCond.Message = fmt.Sprintf(message, args...)
+
+	return resList
+}
+
+func getCondition(conditions []autoscalingv2.HorizontalPodAutoscalerCondition, conditionType autoscalingv2.HorizontalPodAutoscalerConditionType) *autoscalingv2.HorizontalPodAutoscalerCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
@@ -32,17 +32,20 @@ import (
 	"k8s.io/client-go/pkg/api"
 	clientv1 "k8s.io/client-go/pkg/api/v1"
 	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubernetes/pkg/api/v1"
 	autoscalingv1 "k8s.io/kubernetes/pkg/apis/autoscaling/v1"
 	autoscalingv2 "k8s.io/kubernetes/pkg/apis/autoscaling/v2alpha1"
 	extensions "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
 	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+	emfake "k8s.io/kubernetes/pkg/client/external_metrics/fake"
 	informers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions"
 	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/kubernetes/pkg/controller/podautoscaler/metrics"
 	metricsfake "k8s.io/metrics/pkg/client/clientset_generated/clientset/fake"
 	cmfake "k8s.io/metrics/pkg/client/custom_metrics/fake"
 
+	emapi "k8s.io/kubernetes/pkg/apis/external_metrics/v1beta1"
 	cmapi "k8s.io/metrics/pkg/apis/custom_metrics/v1alpha1"
 	metricsapi "k8s.io/metrics/pkg/apis/metrics/v1alpha1"
 
@@ -106,7 +109,7 @@ func (tc *testCase) computeCPUCurrent() {
 	tc.CPUCurrent = int32(100 * reported / requested)
 }
 
-func (tc *testCase) prepareTestClient(t *testing.T) (*fake.Clientset, *metricsfake.Clientset, *cmfake.FakeCustomMetricsClient) {
+func (tc *testCase) prepareTestClient(t *testing.T) (*fake.Clientset, *metricsfake.Clientset, *cmfake.FakeCustomMetricsClient, *emfake.FakeExternalMetricsClient) {
 	namespace := "test-namespace"
 	hpaName := "test-hpa"
 	podNamePrefix := "test-pod"
@@ -159,6 +162,7 @@ func (tc *testCase) prepareTestClient(t *testing.T) (*fake.Clientset, *metricsfa
 					Status: autoscalingv2.HorizontalPodAutoscalerStatus{
 						CurrentReplicas: tc.initialReplicas,
 						DesiredReplicas: tc.initialReplicas,
+						LastScaleTime:   tc.lastScaleTime,
 					},
 				},
 			},
@@ -468,7 +472,33 @@ func (tc *testCase) prepareTestClient(t *testing.T) (*fake.Clientset, *metricsfa
 		}
 	})
 
-	return fakeClient, fakeMetricsClient, fakeCMClient
+	fakeEMClient := &emfake.FakeExternalMetricsClient{}
+	fakeEMClient.AddReactor("list", "*", func(action core.Action) (handled bool, ret runtime.Object, err error) {
+		tc.Lock()
+		defer tc.Unlock()
+
+		listAction, wasList := action.(emfake.ListAction)
+		if !wasList {
+			return true, nil, fmt.Errorf("expected a list action, got %v instead", action)
+		}
+
+		metrics := &emapi.ExternalMetricValueList{}
+
+		assert.Equal(t, "qps", listAction.GetMetricName(), "the metric name requested should have been qps, as specified in the metric spec")
+
+		for _, level := range tc.reportedLevels {
+			metric := emapi.ExternalMetricValue{
+				Value:      *resource.NewMilliQuantity(int64(level), resource.DecimalSI),
+				Timestamp:  metav1.Time{Time: time.Now()},
+				MetricName: "qps",
+			}
+			metrics.Items = append(metrics.Items, metric)
+		}
+
+		return true, metrics, nil
+	})
+
+	return fakeClient, fakeMetricsClient, fakeCMClient, fakeEMClient
 }
 
 func (tc *testCase) verifyResults(t *testing.T) {
@@ -483,10 +513,11 @@ func (tc *testCase) verifyResults(t *testing.T) {
 }
 
 func (tc *testCase) runTest(t *testing.T) {
-	testClient, testMetricsClient, testCMClient := tc.prepareTestClient(t)
+	testClient, testMetricsClient, testCMClient, testEMClient := tc.prepareTestClient(t)
 	metricsClient := metrics.NewRESTMetricsClient(
 		testMetricsClient.MetricsV1alpha1(),
 		testCMClient,
+		testEMClient,
 	)
 
 	eventClient := &clientfake.Clientset{}
@@ -562,6 +593,40 @@ func TestScaleUp(t *testing.T) {
 	tc.runTest(t)
 }
 
+func TestScaleUpForbiddenWindow(t *testing.T) {
+	lastScaleTime := metav1.NewTime(time.Now().Add(-time.Minute))
+	tc := testCase{
+		minReplicas:         2,
+		maxReplicas:         6,
+		initialReplicas:     3,
+		desiredReplicas:     3,
+		CPUTarget:           30,
+		verifyCPUCurrent:    true,
+		reportedLevels:      []uint64{300, 500, 700},
+		reportedCPURequests: []resource.Quantity{resource.MustParse("1.0"), resource.MustParse("1.0"), resource.MustParse("1.0")},
+		useMetricsApi:       true,
+		lastScaleTime:       &lastScaleTime,
+	}
+	tc.runTest(t)
+}
+
+func TestScaleUpAfterForbiddenWindow(t *testing.T) {
+	lastScaleTime := metav1.NewTime(time.Now().Add(-upscaleForbiddenWindow - time.Minute))
+	tc := testCase{
+		minReplicas:         2,
+		maxReplicas:         6,
+		initialReplicas:     3,
+		desiredReplicas:     5,
+		CPUTarget:           30,
+		verifyCPUCurrent:    true,
+		reportedLevels:      []uint64{300, 500, 700},
+		reportedCPURequests: []resource.Quantity{resource.MustParse("1.0"), resource.MustParse("1.0"), resource.MustParse("1.0")},
+		useMetricsApi:       true,
+		lastScaleTime:       &lastScaleTime,
+	}
+	tc.runTest(t)
+}
+
 func TestScaleUpUnreadyLessScale(t *testing.T) {
 	tc := testCase{
 		minReplicas:          2,
@@ -730,6 +795,48 @@ func TestScaleUpCMObject(t *testing.T) {
 	tc.runTest(t)
 }
 
+func TestScaleUpCMExternal(t *testing.T) {
+	tc := testCase{
+		minReplicas:     2,
+		maxReplicas:     6,
+		initialReplicas: 4,
+		desiredReplicas: 6,
+		metricsTarget: []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{
+					MetricSelector: &metav1.LabelSelector{},
+					MetricName:     "qps",
+					TargetValue:    resource.NewMilliQuantity(6666, resource.DecimalSI),
+				},
+			},
+		},
+		reportedLevels: []uint64{8600},
+	}
+	tc.runTest(t)
+}
+
+func TestScaleUpPerPodCMExternal(t *testing.T) {
+	tc := testCase{
+		minReplicas:     2,
+		maxReplicas:     6,
+		initialReplicas: 4,
+		desiredReplicas: 6,
+		metricsTarget: []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{
+					MetricSelector:     &metav1.LabelSelector{},
+					MetricName:         "qps",
+					TargetAverageValue: resource.NewMilliQuantity(1500, resource.DecimalSI),
+				},
+			},
+		},
+		reportedLevels: []uint64{8600},
+	}
+	tc.runTest(t)
+}
+
 func TestScaleDown(t *testing.T) {
 	tc := testCase{
 		minReplicas:         2,
@@ -794,6 +901,48 @@ func TestScaleDownCMObject(t *testing.T) {
 	tc.runTest(t)
 }
 
+func TestScaleDownCMExternal(t *testing.T) {
+	tc := testCase{
+		minReplicas:     2,
+		maxReplicas:     6,
+		initialReplicas: 5,
+		desiredReplicas: 3,
+		metricsTarget: []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{
+					MetricSelector: &metav1.LabelSelector{},
+					MetricName:     "qps",
+					TargetValue:    resource.NewMilliQuantity(14400, resource.DecimalSI),
+				},
+			},
+		},
+		reportedLevels: []uint64{8600},
+	}
+	tc.runTest(t)
+}
+
+func TestScaleDownPerPodCMExternal(t *testing.T) {
+	tc := testCase{
+		minReplicas:     2,
+		maxReplicas:     6,
+		initialReplicas: 5,
+		desiredReplicas: 3,
+		metricsTarget: []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{
+					MetricSelector:     &metav1.LabelSelector{},
+					MetricName:         "qps",
+					TargetAverageValue: resource.NewMilliQuantity(3000, resource.DecimalSI),
+				},
+			},
+		},
+		reportedLevels: []uint64{8600},
+	}
+	tc.runTest(t)
+}
+
 func TestScaleDownIgnoresUnreadyPods(t *testing.T) {
 	tc := testCase{
 		minReplicas:          2,
@@ -1156,4 +1305,296 @@ func TestScaleDownRCImmediately(t *testing.T) {
 	tc.runTest(t)
 }
 
+func newBehaviorTestController(now time.Time) *HorizontalController {
+	return &HorizontalController{
+		eventRecorder:   record.NewFakeRecorder(100),
+		recommendations: map[string][]timestampedRecommendation{},
+		scaleUpEvents:   map[string][]timestampedScaleEvent{},
+		scaleDownEvents: map[string][]timestampedScaleEvent{},
+		now:             func() time.Time { return now },
+	}
+}
+
+func newBehaviorTestHPA(minReplicas, maxReplicas int32, behavior *autoscalingv2.HorizontalPodAutoscalerBehavior) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-hpa", Namespace: "test-namespace"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			MinReplicas: &minReplicas,
+			MaxReplicas: maxReplicas,
+			Behavior:    behavior,
+		},
+	}
+}
+
+func TestCalculateScaleUpLimitWithScalingRules(t *testing.T) {
+	now := time.Now()
+	maxPolicy := autoscalingv2.MaxPolicySelect
+	minPolicy := autoscalingv2.MinPolicySelect
+	disabledPolicy := autoscalingv2.DisabledPolicySelect
+	policies := []autoscalingv2.HPAScalingPolicy{
+		{Type: autoscalingv2.PodsScalingPolicy, Value: 4, PeriodSeconds: 60},
+		{Type: autoscalingv2.PercentScalingPolicy, Value: 50, PeriodSeconds: 60},
+	}
+
+	tests := []struct {
+		name            string
+		currentReplicas int32
+		selectPolicy    *autoscalingv2.ScalingPolicySelect
+		events          []timestampedScaleEvent
+		expectedLimit   int32
+	}{
+		{
+			name:            "max policy uses the largest change",
+			currentReplicas: 10,
+			selectPolicy:    &maxPolicy,
+			expectedLimit:   15,
+		},
+		{
+			name:            "min policy uses the smallest change",
+			currentReplicas: 10,
+			selectPolicy:    &minPolicy,
+			expectedLimit:   14,
+		},
+		{
+			name:            "percent change is rounded up",
+			currentReplicas: 1,
+			selectPolicy:    &minPolicy,
+			expectedLimit:   2,
+		},
+		{
+			name:            "replicas added during the period are counted",
+			currentReplicas: 10,
+			selectPolicy:    &maxPolicy,
+			events: []timestampedScaleEvent{
+				{replicaChange: 2, timestamp: now.Add(-30 * time.Second)},
+				{replicaChange: 4, timestamp: now.Add(-2 * time.Minute)},
+			},
+			expectedLimit: 12,
+		},
+		{
+			name:            "disabled policy forbids any change",
+			currentReplicas: 10,
+			selectPolicy:    &disabledPolicy,
+			expectedLimit:   10,
+		},
+	}
+
+	for _, test := range tests {
+		rules := &autoscalingv2.HPAScalingRules{SelectPolicy: test.selectPolicy, Policies: policies}
+		limit := calculateScaleUpLimitWithScalingRules(test.currentReplicas, test.events, rules, now)
+		assert.Equal(t, test.expectedLimit, limit, test.name)
+	}
+}
+
+func TestCalculateScaleDownLimitWithScalingRules(t *testing.T) {
+	now := time.Now()
+	maxPolicy := autoscalingv2.MaxPolicySelect
+	minPolicy := autoscalingv2.MinPolicySelect
+	disabledPolicy := autoscalingv2.DisabledPolicySelect
+	policies := []autoscalingv2.HPAScalingPolicy{
+		{Type: autoscalingv2.PodsScalingPolicy, Value: 2, PeriodSeconds: 60},
+		{Type: autoscalingv2.PercentScalingPolicy, Value: 50, PeriodSeconds: 60},
+	}
+
+	tests := []struct {
+		name            string
+		currentReplicas int32
+		selectPolicy    *autoscalingv2.ScalingPolicySelect
+		events          []timestampedScaleEvent
+		expectedLimit   int32
+	}{
+		{
+			name:            "max policy uses the largest change",
+			currentReplicas: 10,
+			selectPolicy:    &maxPolicy,
+			expectedLimit:   5,
+		},
+		{
+			name:            "min policy uses the smallest change",
+			currentReplicas: 10,
+			selectPolicy:    &minPolicy,
+			expectedLimit:   8,
+		},
+		{
+			name:            "replicas removed during the period are counted",
+			currentReplicas: 8,
+			selectPolicy:    &minPolicy,
+			events: []timestampedScaleEvent{
+				{replicaChange: 2, timestamp: now.Add(-30 * time.Second)},
+			},
+			expectedLimit: 8,
+		},
+		{
+			name:            "disabled policy forbids any change",
+			currentReplicas: 10,
+			selectPolicy:    &disabledPolicy,
+			expectedLimit:   10,
+		},
+	}
+
+	for _, test := range tests {
+		rules := &autoscalingv2.HPAScalingRules{SelectPolicy: test.selectPolicy, Policies: policies}
+		limit := calculateScaleDownLimitWithScalingRules(test.currentReplicas, test.events, rules, now)
+		assert.Equal(t, test.expectedLimit, limit, test.name)
+	}
+}
+
+func TestNormalizeDesiredReplicasWithBehaviors(t *testing.T) {
+	now := time.Now()
+	window := int32(60)
+	disabledPolicy := autoscalingv2.DisabledPolicySelect
+	key := "test-namespace/test-hpa"
+
+	tests := []struct {
+		name             string
+		behavior         *autoscalingv2.HorizontalPodAutoscalerBehavior
+		recommendations  []timestampedRecommendation
+		currentReplicas  int32
+		proposedReplicas int32
+		expectedReplicas int32
+		expectedAble     string
+		expectedLimited  string
+	}{
+		{
+			name:             "scale down uses the highest recommendation of the window",
+			behavior:         &autoscalingv2.HorizontalPodAutoscalerBehavior{},
+			recommendations:  []timestampedRecommendation{{8, now.Add(-time.Minute)}, {9, now.Add(-10 * time.Minute)}},
+			currentReplicas:  10,
+			proposedReplicas: 4,
+			expectedReplicas: 8,
+			expectedAble:     "ScaleDownStabilized",
+			expectedLimited:  "DesiredWithinRange",
+		},
+		{
+			name: "scale up uses the lowest recommendation of the window",
+			behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+				ScaleUp: &autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: &window},
+			},
+			recommendations:  []timestampedRecommendation{{6, now.Add(-30 * time.Second)}, {4, now.Add(-2 * time.Minute)}},
+			currentReplicas:  5,
+			proposedReplicas: 9,
+			expectedReplicas: 6,
+			expectedAble:     "ScaleUpStabilized",
+			expectedLimited:  "DesiredWithinRange",
+		},
+		{
+			name:             "scale up is limited by the default policies",
+			behavior:         &autoscalingv2.HorizontalPodAutoscalerBehavior{},
+			currentReplicas:  2,
+			proposedReplicas: 20,
+			expectedReplicas: 6,
+			expectedAble:     "ReadyForNewScale",
+			expectedLimited:  "ScaleUpLimit",
+		},
+		{
+			name:             "scale up is limited by the maximum replicas",
+			behavior:         &autoscalingv2.HorizontalPodAutoscalerBehavior{},
+			currentReplicas:  8,
+			proposedReplicas: 20,
+			expectedReplicas: 10,
+			expectedAble:     "ReadyForNewScale",
+			expectedLimited:  "TooManyReplicas",
+		},
+		{
+			name: "scale down can be disabled",
+			behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+				ScaleDown: &autoscalingv2.HPAScalingRules{SelectPolicy: &disabledPolicy},
+			},
+			currentReplicas:  8,
+			proposedReplicas: 2,
+			expectedReplicas: 8,
+			expectedAble:     "ReadyForNewScale",
+			expectedLimited:  "ScaleDownLimit",
+		},
+	}
+
+	for _, test := range tests {
+		hpaController := newBehaviorTestController(now)
+		hpaController.recommendations[key] = test.recommendations
+		hpa := newBehaviorTestHPA(1, 10, test.behavior)
+
+		replicas := hpaController.normalizeDesiredReplicasWithBehaviors(hpa, key, test.currentReplicas, test.proposedReplicas)
+		assert.Equal(t, test.expectedReplicas, replicas, test.name)
+
+		ableToScale := getCondition(hpa.Status.Conditions, autoscalingv2.AbleToScale)
+		if assert.NotNil(t, ableToScale, test.name) {
+			assert.Equal(t, test.expectedAble, ableToScale.Reason, test.name)
+		}
+		scalingLimited := getCondition(hpa.Status.Conditions, autoscalingv2.ScalingLimited)
+		if assert.NotNil(t, scalingLimited, test.name) {
+			assert.Equal(t, test.expectedLimited, scalingLimited.Reason, test.name)
+		}
+
+		recommendations := hpaController.recommendations[key]
+		assert.Equal(t, test.proposedReplicas, recommendations[len(recommendations)-1].recommendation, "%s: the proposal should have been recorded", test.name)
+	}
+}
+
+func TestRecommendationsSeededFromLastScaleTime(t *testing.T) {
+	now := time.Now()
+	key := "test-namespace/test-hpa"
+
+	tests := []struct {
+		name             string
+		lastScaleTime    *metav1.Time
+		expectedReplicas int32
+	}{
+		{
+			name:             "recent rescale prevents scaling down",
+			lastScaleTime:    &metav1.Time{Time: now.Add(-time.Minute)},
+			expectedReplicas: 10,
+		},
+		{
+			name:             "old rescale allows scaling down",
+			lastScaleTime:    &metav1.Time{Time: now.Add(-10 * time.Minute)},
+			expectedReplicas: 4,
+		},
+		{
+			name:             "never rescaled",
+			expectedReplicas: 4,
+		},
+	}
+
+	for _, test := range tests {
+		hpaController := newBehaviorTestController(now)
+		hpa := newBehaviorTestHPA(1, 10, nil)
+		hpa.Status.LastScaleTime = test.lastScaleTime
+
+		hpaController.seedRecommendations(hpa, key, 10)
+		replicas := hpaController.normalizeDesiredReplicas(hpa, key, 10, 4)
+		assert.Equal(t, test.expectedReplicas, replicas, test.name)
+
+		// the history is only seeded the first time the HPA is seen
+		hpa.Status.LastScaleTime = &metav1.Time{Time: now}
+		hpaController.seedRecommendations(hpa, key, 10)
+		assert.Equal(t, int32(4), hpaController.recommendations[key][len(hpaController.recommendations[key])-1].recommendation, test.name)
+	}
+}
+
+func TestScaleEventsAreRecordedPerHPA(t *testing.T) {
+	now := time.Now()
+	hpaController := newBehaviorTestController(now)
+	behavior := &autoscalingv2.HorizontalPodAutoscalerBehavior{}
+
+	hpaController.scaleUpEvents["test-namespace/other-hpa"] = []timestampedScaleEvent{{replicaChange: 5, timestamp: now}}
+	hpaController.scaleUpEvents["test-namespace/test-hpa"] = []timestampedScaleEvent{{replicaChange: 3, timestamp: now.Add(-time.Hour)}}
+
+	hpaController.storeScaleEvent(behavior, "test-namespace/test-hpa", 2, 4)
+	hpaController.storeScaleEvent(behavior, "test-namespace/test-hpa", 4, 1)
+	hpaController.storeScaleEvent(nil, "test-namespace/test-hpa", 1, 10)
+
+	assert.Equal(t, []timestampedScaleEvent{{replicaChange: 2, timestamp: now}}, hpaController.scaleUpEvents["test-namespace/test-hpa"], "outdated events should have been dropped")
+	assert.Equal(t, []timestampedScaleEvent{{replicaChange: 3, timestamp: now}}, hpaController.scaleDownEvents["test-namespace/test-hpa"])
+	assert.Len(t, hpaController.scaleUpEvents["test-namespace/other-hpa"], 1, "the events of other HPAs should not be changed")
+
+	hpaController.recommendations["test-namespace/test-hpa"] = []timestampedRecommendation{{recommendation: 3, timestamp: now}}
+	hpaController.deleteAutoscaler(&autoscalingv1.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-hpa", Namespace: "test-namespace"},
+	})
+	assert.NotContains(t, hpaController.recommendations, "test-namespace/test-hpa")
+	assert.NotContains(t, hpaController.scaleUpEvents, "test-namespace/test-hpa")
+	assert.NotContains(t, hpaController.scaleDownEvents, "test-namespace/test-hpa")
+	assert.Contains(t, hpaController.scaleUpEvents, "test-namespace/other-hpa")
+}
+
 // TODO: add more tests
@@ -29,7 +29,8 @@ import (
 type PodMetricsInfo map[string]int64
 
 // MetricsClient knows how to query a remote interface to retrieve container-level
-// resource metrics as well as pod-level arbitrary metrics
+// resource metrics, pod-level and object-level arbitrary metrics, as well as
+// external metrics not tied to any Kubernetes object
 type MetricsClient interface {
 	// GetResourceMetric gets the given resource metric (and an associated oldest timestamp)
 	// for all pods matching the specified selector in the given namespace
@@ -42,4 +43,8 @@ type MetricsClient interface {
 	// GetObjectMetric gets the given metric (and an associated timestamp) for the given
 	// object in the given namespace
 	GetObjectMetric(metricName string, namespace string, objectRef *autoscaling.CrossVersionObjectReference) (int64, time.Time, error)
+
+	// GetExternalMetric gets all the values of a given external metric
+	// that match the specified selector.
+	GetExternalMetric(metricName string, namespace string, selector labels.Selector) ([]int64, time.Time, error)
 }
@@ -28,24 +28,27 @@ import (
 	clientv1 "k8s.io/client-go/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/api/v1"
 	autoscaling "k8s.io/kubernetes/pkg/apis/autoscaling/v2alpha1"
+	externalclient "k8s.io/kubernetes/pkg/client/external_metrics"
 	customapi "k8s.io/metrics/pkg/apis/custom_metrics/v1alpha1"
 	resourceclient "k8s.io/metrics/pkg/client/clientset_generated/clientset/typed/metrics/v1alpha1"
 	customclient "k8s.io/metrics/pkg/client/custom_metrics"
 )
 
-func NewRESTMetricsClient(resourceClient resourceclient.PodMetricsesGetter, customClient customclient.CustomMetricsClient) MetricsClient {
+func NewRESTMetricsClient(resourceClient resourceclient.PodMetricsesGetter, customClient customclient.CustomMetricsClient, externalClient externalclient.ExternalMetricsClient) MetricsClient {
 	return &restMetricsClient{
 		&resourceMetricsClient{resourceClient},
 		&customMetricsClient{customClient},
+		&externalMetricsClient{externalClient},
 	}
 }
 
 // restMetricsClient is a client which supports fetching
-// metrics from both the resource metrics API and the
-// custom metrics API.
+// metrics from the resource metrics API, the
+// custom metrics API and the external metrics API.
 type restMetricsClient struct {
 	*resourceMetricsClient
 	*customMetricsClient
+	*externalMetricsClient
 }
 
 // resourceMetricsClient implements the resource-metrics-related parts of MetricsClient,
@@ -140,3 +143,29 @@ func (c *customMetricsClient) GetObjectMetric(metricName string, namespace strin
 
 	return metricValue.Value.MilliValue(), metricValue.Timestamp.Time, nil
 }
+
+// externalMetricsClient implements the external-metrics-related parts of MetricsClient,
+// using data from the external metrics API.
+type externalMetricsClient struct {
+	client externalclient.ExternalMetricsClient
+}
+
+// GetExternalMetric gets all the values of a given external metric
+// that match the specified selector.
+func (c *externalMetricsClient) GetExternalMetric(metricName, namespace string, selector labels.Selector) ([]int64, time.Time, error) {
+	metrics, err := c.client.NamespacedMetrics(namespace).List(metricName, selector)
+	if err != nil {
+		return []int64{}, time.Time{}, fmt.Errorf("unable to fetch metrics from external metrics API: %v", err)
+	}
+
+	if len(metrics.Items) == 0 {
+		return nil, time.Time{}, fmt.Errorf("no metrics returned from external metrics API")
+	}
+
+	res := make([]int64, 0)
+	for _, m := range metrics.Items {
+		res = append(res, m.Value.MilliValue())
+	}
+	timestamp := metrics.Items[0].Timestamp.Time
+	return res, timestamp, nil
+}
@@ -31,9 +31,11 @@ import (
 	core "k8s.io/client-go/testing"
 	kv1 "k8s.io/kubernetes/pkg/api/v1"
 	autoscalingapi "k8s.io/kubernetes/pkg/apis/autoscaling/v2alpha1"
+	emfake "k8s.io/kubernetes/pkg/client/external_metrics/fake"
 	metricsfake "k8s.io/metrics/pkg/client/clientset_generated/clientset/fake"
 	cmfake "k8s.io/metrics/pkg/client/custom_metrics/fake"
 
+	emapi "k8s.io/kubernetes/pkg/apis/external_metrics/v1beta1"
 	cmapi "k8s.io/metrics/pkg/apis/custom_metrics/v1alpha1"
 	metricsapi "k8s.io/metrics/pkg/apis/metrics/v1alpha1"
 
@@ -45,8 +47,9 @@ import (
 )
 
 type restClientTestCase struct {
-	desiredMetricValues PodMetricsInfo
-	desiredError        error
+	desiredMetricValues         PodMetricsInfo
+	desiredExternalMetricValues []int64
+	desiredError                error
 
 	// "timestamps" here are actually the offset in minutes from a base timestamp
 	targetTimestamp      int
@@ -58,9 +61,12 @@ type restClientTestCase struct {
 	selector     labels.Selector
 	resourceName v1.ResourceName
 	metricName   string
+
+	// metricSelector is only set for external metrics
+	metricSelector *metav1.LabelSelector
 }
 
-func (tc *restClientTestCase) prepareTestClient(t *testing.T) (*metricsfake.Clientset, *cmfake.FakeCustomMetricsClient) {
+func (tc *restClientTestCase) prepareTestClient(t *testing.T) (*metricsfake.Clientset, *cmfake.FakeCustomMetricsClient, *emfake.FakeExternalMetricsClient) {
 	namespace := "test-namespace"
 	tc.namespace = namespace
 	podNamePrefix := "test-pod"
@@ -72,6 +78,7 @@ func (tc *restClientTestCase) prepareTestClient(t *testing.T) (*metricsfake.Clie
 
 	fakeMetricsClient := &metricsfake.Clientset{}
 	fakeCMClient := &cmfake.FakeCustomMetricsClient{}
+	fakeEMClient := &emfake.FakeExternalMetricsClient{}
 
 	if isResource {
 		fakeMetricsClient.AddReactor("list", "podmetricses", func(action core.Action) (handled bool, ret runtime.Object, err error) {
@@ -104,6 +111,30 @@ func (tc *restClientTestCase) prepareTestClient(t *testing.T) (*metricsfake.Clie
 			}
 			return true, metrics, nil
 		})
+	} else if tc.metricSelector != nil {
+		fakeEMClient.AddReactor("list", "*", func(action core.Action) (handled bool, ret runtime.Object, err error) {
+			listAction := action.(emfake.ListAction)
+			assert.Equal(t, tc.metricName, listAction.GetMetricName(), "the metric requested should have matched the one specified")
+			assert.Equal(t, tc.namespace, listAction.GetNamespace(), "the metric should have been requested in the namespace of the HPA")
+
+			selector, err := metav1.LabelSelectorAsSelector(tc.metricSelector)
+			if err != nil {
+				return true, nil, fmt.Errorf("failed to convert label selector specified in test client")
+			}
+			assert.Equal(t, selector, listAction.GetLabelSelector(), "the metric selector should have matched the one specified")
+
+			metrics := emapi.ExternalMetricValueList{}
+			for _, metricPoint := range tc.reportedMetricPoints {
+				timestamp := fixedTimestamp.Add(time.Duration(metricPoint.timestamp) * time.Minute)
+				metric := emapi.ExternalMetricValue{
+					Value:      *resource.NewMilliQuantity(int64(metricPoint.level), resource.DecimalSI),
+					Timestamp:  metav1.Time{Time: timestamp},
+					MetricName: tc.metricName,
+				}
+				metrics.Items = append(metrics.Items, metric)
+			}
+			return true, &metrics, nil
+		})
 	} else {
 		fakeCMClient.AddReactor("get", "*", func(action core.Action) (handled bool, ret runtime.Object, err error) {
 			getForAction := action.(cmfake.GetForAction)
@@ -167,7 +198,7 @@ func (tc *restClientTestCase) prepareTestClient(t *testing.T) (*metricsfake.Clie
 		})
 	}
 
-	return fakeMetricsClient, fakeCMClient
+	return fakeMetricsClient, fakeCMClient, fakeEMClient
 }
 
 func (tc *restClientTestCase) verifyResults(t *testing.T, metrics PodMetricsInfo, timestamp time.Time, err error) {
@@ -185,13 +216,33 @@ func (tc *restClientTestCase) verifyResults(t *testing.T, metrics PodMetricsInfo
 	assert.True(t, targetTimestamp.Equal(timestamp), fmt.Sprintf("the timestamp should be as expected (%s) but was %s", targetTimestamp, timestamp))
 }
 
+func (tc *restClientTestCase) verifyExternalResults(t *testing.T, values []int64, timestamp time.Time, err error) {
+	if tc.desiredError != nil {
+		assert.Error(t, err, "there should be an error retrieving the metrics")
+		assert.Contains(t, fmt.Sprintf("%v", err), fmt.Sprintf("%v", tc.desiredError), "the error message should be as expected")
+		return
+	}
+	assert.NoError(t, err, "there should be no error retrieving the metrics")
+	assert.Equal(t, tc.desiredExternalMetricValues, values, "the metric values should be as expected")
+
+	targetTimestamp := fixedTimestamp.Add(time.Duration(tc.targetTimestamp) * time.Minute)
+	assert.True(t, targetTimestamp.Equal(timestamp), fmt.Sprintf("the timestamp should be as expected (%s) but was %s", targetTimestamp, timestamp))
+}
+
 func (tc *restClientTestCase) runTest(t *testing.T) {
-	testMetricsClient, testCMClient := tc.prepareTestClient(t)
-	metricsClient := NewRESTMetricsClient(testMetricsClient.MetricsV1alpha1(), testCMClient)
+	testMetricsClient, testCMClient, testEMClient := tc.prepareTestClient(t)
+	metricsClient := NewRESTMetricsClient(testMetricsClient.MetricsV1alpha1(), testCMClient, testEMClient)
 	isResource := len(tc.resourceName) > 0
 	if isResource {
 		info, timestamp, err := metricsClient.GetResourceMetric(kv1.ResourceName(tc.resourceName), tc.namespace, tc.selector)
 		tc.verifyResults(t, info, timestamp, err)
+	} else if tc.metricSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(tc.metricSelector)
+		if err != nil {
+			t.Fatalf("unable to convert the metric selector: %v", err)
+		}
+		values, timestamp, err := metricsClient.GetExternalMetric(tc.metricName, tc.namespace, selector)
+		tc.verifyExternalResults(t, values, timestamp, err)
 	} else if tc.singleObject == nil {
 		info, timestamp, err := metricsClient.GetRawMetric(tc.metricName, tc.namespace, tc.selector)
 		tc.verifyResults(t, info, timestamp, err)
@@ -241,6 +292,27 @@ func TestRESTClientSingleObject(t *testing.T) {
 	tc.runTest(t)
 }
 
+func TestRESTClientExternal(t *testing.T) {
+	tc := restClientTestCase{
+		desiredExternalMetricValues: []int64{10000, 20000, 10000},
+		metricName:                  "queue-length",
+		metricSelector:              &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "worker"}},
+		targetTimestamp:             1,
+		reportedMetricPoints:        []metricPoint{{10000, 1}, {20000, 1}, {10000, 1}},
+	}
+	tc.runTest(t)
+}
+
+func TestRESTClientExternalEmptyMetrics(t *testing.T) {
+	tc := restClientTestCase{
+		metricName:           "queue-length",
+		metricSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "worker"}},
+		desiredError:         fmt.Errorf("no metrics returned from external metrics API"),
+		reportedMetricPoints: []metricPoint{},
+	}
+	tc.runTest(t)
+}
+
 func TestRESTClientQpsSumEqualZero(t *testing.T) {
 	tc := restClientTestCase{
 		desiredMetricValues: PodMetricsInfo{
@@ -17,12 +17,13 @@ limitations under the License.
 package statefulset
 
 import (
-	"fmt"
 	"sort"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
 	apps "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
+	"k8s.io/kubernetes/pkg/controller/history"
 
 	"github.com/golang/glog"
 )
@@ -40,31 +41,228 @@ type StatefulSetControlInterface interface {
 
 // NewDefaultStatefulSetControl returns a new instance of the default implementation StatefulSetControlInterface that
 // implements the documented semantics for StatefulSets. podControl is the PodControlInterface used to create, update,
-// and delete Pods and to create PersistentVolumeClaims. You should use an instance returned from
-// NewRealStatefulPodControl() for any scenario other than testing.
-func NewDefaultStatefulSetControl(podControl StatefulPodControlInterface) StatefulSetControlInterface {
-	return &defaultStatefulSetControl{podControl}
+// and delete Pods and to create PersistentVolumeClaims. controllerHistory is the history.Interface used to create,
+// update, and delete ControllerRevisions. You should use an instance returned from NewRealStatefulPodControl() and
+// history.NewHistory() for any scenario other than testing.
+func NewDefaultStatefulSetControl(podControl StatefulPodControlInterface, controllerHistory history.Interface) StatefulSetControlInterface {
+	return &defaultStatefulSetControl{podControl, controllerHistory}
 }
 
 type defaultStatefulSetControl struct {
-	podControl StatefulPodControlInterface
+	podControl        StatefulPodControlInterface
+	controllerHistory history.Interface
 }
 
+// UpdateStatefulSet executes the core logic loop for a stateful set. With OrderedReady pod management, scale up
+// proceeds in ordinal order, no new Pod is created while any Pod is unhealthy, and Pods are terminated in descending
+// order. With Parallel pod management, all missing Pods are created and all condemned Pods are terminated without
+// waiting on their predecessors. Pods that do not match the
+// update revision are replaced according to the set's UpdateStrategy, and the set's revision history is truncated
+// to its RevisionHistoryLimit.
 func (ssc *defaultStatefulSetControl) UpdateStatefulSet(set *apps.StatefulSet, pods []*v1.Pod) error {
+	// list all revisions and sort them
+	revisions, err := ssc.ListRevisions(set)
+	if err != nil {
+		return err
+	}
+	history.SortControllerRevisions(revisions)
+
+	// get the current and update revisions of the set
+	currentRevision, updateRevision, err := ssc.getStatefulSetRevisions(set, revisions)
+	if err != nil {
+		return err
+	}
+
+	// perform the main update function and get the status
+	status, err := ssc.updateStatefulSet(set, currentRevision, updateRevision, pods)
+	if err != nil {
+		return err
+	}
+
+	// update the set's status
+	if err := ssc.updateStatefulSetStatus(set, status); err != nil {
+		return err
+	}
+
+	glog.V(4).Infof("StatefulSet %s/%s pod status replicas=%d currentReplicas=%d updatedReplicas=%d",
+		set.Namespace,
+		set.Name,
+		status.Replicas,
+		status.CurrentReplicas,
+		status.UpdatedReplicas)
+
+	glog.V(4).Infof("StatefulSet %s/%s revisions current=%s update=%s",
+		set.Namespace,
+		set.Name,
+		status.CurrentRevision,
+		status.UpdateRevision)
+
+	// maintain the set's revision history limit
+	return ssc.truncateHistory(set, pods, revisions, currentRevision, updateRevision)
+}
+
+// ListRevisions returns the ControllerRevisions owned by set.
+func (ssc *defaultStatefulSetControl) ListRevisions(set *apps.StatefulSet) ([]*apps.ControllerRevision, error) {
+	selector, err := metav1.LabelSelectorAsSelector(set.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	return ssc.controllerHistory.ListControllerRevisions(set, selector)
+}
+
+// truncateHistory truncates any non-live ControllerRevisions in revisions from set's history. The UpdateRevision and
+// CurrentRevision in set's Status are considered to be live. Any revisions associated with the Pods in pods are also
+// considered to be live. Non-live revisions are deleted, starting with the revision with the lowest Revision, until
+// only RevisionHistoryLimit revisions remain. If the returned error is nil the operation was successful. This method
+// expects that revisions is sorted when supplied.
+func (ssc *defaultStatefulSetControl) truncateHistory(
+	set *apps.StatefulSet,
+	pods []*v1.Pod,
+	revisions []*apps.ControllerRevision,
+	current *apps.ControllerRevision,
+	update *apps.ControllerRevision) error {
+	historic := make([]*apps.ControllerRevision, 0, len(revisions))
+	// mark all live revisions
+	live := map[string]bool{current.Name: true, update.Name: true}
+	for i := range pods {
+		live[getPodRevision(pods[i])] = true
+	}
+	// collect live revisions and historic revisions
+	for i := range revisions {
+		if !live[revisions[i].Name] {
+			historic = append(historic, revisions[i])
+		}
+	}
+	historicLen := len(historic)
+	historyLimit := 10
+	if set.Spec.RevisionHistoryLimit != nil {
+		historyLimit = int(*set.Spec.RevisionHistoryLimit)
+	}
+	if historicLen <= historyLimit {
+		return nil
+	}
+	// delete any non-live history to maintain the revision limit.
+	historic = historic[:(historicLen - historyLimit)]
+	for i := 0; i < len(historic); i++ {
+		if err := ssc.controllerHistory.DeleteControllerRevision(historic[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getStatefulSetRevisions returns the current and update ControllerRevisions for set. This method may create a new
+// revision, or modify the Revision of an existing revision if an update to set is detected. This method expects that
+// revisions is sorted when supplied.
+func (ssc *defaultStatefulSetControl) getStatefulSetRevisions(
+	set *apps.StatefulSet,
+	revisions []*apps.ControllerRevision) (*apps.ControllerRevision, *apps.ControllerRevision, error) {
+	var currentRevision, updateRevision *apps.ControllerRevision
+
+	revisionCount := len(revisions)
+	history.SortControllerRevisions(revisions)
+
+	// create a new revision from the current set
+	updateRevision, err := newRevision(set, nextRevision(revisions))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// find any equivalent revisions
+	equalRevisions := history.FindEqualRevisions(revisions, updateRevision)
+	equalCount := len(equalRevisions)
+
+	if equalCount > 0 && history.EqualRevision(revisions[revisionCount-1], equalRevisions[equalCount-1]) {
+		// if the equivalent revision is immediately prior the update revision has not changed
+		updateRevision = revisions[revisionCount-1]
+	} else if equalCount > 0 {
+		// if the equivalent revision is not immediately prior we will roll back by incrementing the
+		// Revision of the equivalent revision
+		updateRevision, err = ssc.controllerHistory.UpdateControllerRevision(
+			equalRevisions[equalCount-1],
+			updateRevision.Revision)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		//if there is no equivalent revision we create a new one
+		updateRevision, err = ssc.controllerHistory.CreateControllerRevision(set, updateRevision)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// attempt to find the revision that corresponds to the current revision
+	for i := range revisions {
+		if revisions[i].Name == set.Status.CurrentRevision {
+			currentRevision = revisions[i]
+		}
+	}
+
+	// if the current revision is nil we initialize the history by setting it to the update revision
+	if currentRevision == nil {
+		currentRevision = updateRevision
+	}
+
+	return currentRevision, updateRevision, nil
+}
+
+// updateStatefulSet performs the update function for a StatefulSet. This method creates, updates, and deletes Pods in
+// the set in order to conform the system to the target state for the set. The target state always contains
+// set.Spec.Replicas Pods with a Ready Condition. If the UpdateStrategy.Type for the set is
+// RollingUpdateStatefulSetStrategyType then all Pods in the set must be at set.Status.CurrentRevision.
+// If the UpdateStrategy.Type for the set is OnDeleteStatefulSetStrategyType, the target state implies nothing about
+// the revisions of Pods in the set. If the returned error is nil, the returned StatefulSetStatus is valid and the
+// update must be recorded. If the error is not nil, the method should be retried until successful.
+func (ssc *defaultStatefulSetControl) updateStatefulSet(
+	set *apps.StatefulSet,
+	currentRevision *apps.ControllerRevision,
+	updateRevision *apps.ControllerRevision,
+	pods []*v1.Pod) (*apps.StatefulSetStatus, error) {
+	// get the current and update revisions of the set.
+	currentSet, err := applyRevision(set, currentRevision)
+	if err != nil {
+		return nil, err
+	}
+	updateSet, err := applyRevision(set, updateRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the revisions in the returned status
+	status := apps.StatefulSetStatus{}
+	generation := set.Generation
+	status.ObservedGeneration = &generation
+	status.CurrentRevision = currentRevision.Name
+	status.UpdateRevision = updateRevision.Name
+
 	replicaCount := int(*set.Spec.Replicas)
+	// Parallel pod management allows Pods to be created and deleted without waiting on their predecessors
+	monotonic := !allowsBurst(set)
 	// slice that will contain all Pods such that 0 <= getOrdinal(pod) < set.Spec.Replicas
 	replicas := make([]*v1.Pod, replicaCount)
 	// slice that will contain all Pods such that set.Spec.Replicas <= getOrdinal(pod)
 	condemned := make([]*v1.Pod, 0, len(pods))
-	ready := 0
 	unhealthy := 0
+	var firstUnhealthyPod *v1.Pod
 
 	// First we partition pods into two lists valid replicas and condemned Pods
 	for i := range pods {
-		//count the number of running and ready replicas
+		// count the number of running and ready replicas
 		if isRunningAndReady(pods[i]) {
-			ready++
+			status.Replicas++
 		}
+
+		// count the number of current and update replicas
+		if isCreated(pods[i]) && !isTerminated(pods[i]) {
+			if getPodRevision(pods[i]) == currentRevision.Name {
+				status.CurrentReplicas++
+			}
+			if getPodRevision(pods[i]) == updateRevision.Name {
+				status.UpdatedReplicas++
+			}
+		}
+
 		if ord := getOrdinal(pods[i]); 0 <= ord && ord < replicaCount {
 			// if the ordinal of the pod is within the range of the current number of replicas,
 			// insert it at the indirection of its ordinal
@@ -74,64 +272,94 @@ func (ssc *defaultStatefulSetControl) UpdateStatefulSet(set *apps.StatefulSet, p
 			// if the ordinal is greater than the number of replicas add it to the condemned list
 			condemned = append(condemned, pods[i])
 		}
+		// If the ordinal could not be parsed (ord < 0), ignore the Pod.
 	}
 
-	// for any empty indices in the sequence [0,set.Spec.Replicas) create a new Pod
+	// for any empty indices in the sequence [0,set.Spec.Replicas) create a new Pod at the correct revision
 	for ord := 0; ord < replicaCount; ord++ {
 		if replicas[ord] == nil {
-			replicas[ord] = newStatefulSetPod(set, ord)
+			replicas[ord] = newVersionedStatefulSetPod(
+				currentSet,
+				updateSet,
+				currentRevision.Name,
+				updateRevision.Name, ord)
 		}
 	}
 
-	// count the number of unhealthy pods
+	// sort the condemned Pods by their ordinals
+	sort.Sort(ascendingOrdinal(condemned))
+
+	// count the number of unhealthy Pods and find the first one
 	for i := range replicas {
 		if !isHealthy(replicas[i]) {
 			unhealthy++
+			if firstUnhealthyPod == nil {
+				firstUnhealthyPod = replicas[i]
+			}
 		}
 	}
+
 	for i := range condemned {
 		if !isHealthy(condemned[i]) {
 			unhealthy++
+			if firstUnhealthyPod == nil {
+				firstUnhealthyPod = condemned[i]
+			}
 		}
 	}
 
-	// sort the condemned Pods by their ordinals
-	sort.Sort(ascendingOrdinal(condemned))
-
-	// if the current number of replicas has changed update the statefulSets replicas
-	if set.Status.Replicas != int32(ready) {
-		obj, err := api.Scheme.Copy(set)
-		if err != nil {
-			return fmt.Errorf("unable to copy set: %v", err)
-		}
-		set = obj.(*apps.StatefulSet)
-
-		if err := ssc.podControl.UpdateStatefulSetReplicas(set, int32(ready)); err != nil {
-			return err
-		}
+	if unhealthy > 0 {
+		glog.V(4).Infof("StatefulSet %s/%s has %d unhealthy Pods starting with %s",
+			set.Namespace,
+			set.Name,
+			unhealthy,
+			firstUnhealthyPod.Name)
 	}
 
 	// Examine each replica with respect to its ordinal
 	for i := range replicas {
 		// delete and recreate failed pods
 		if isFailed(replicas[i]) {
-			glog.V(2).Infof("StatefulSet %s is recreating failed Pod %s", set.Name, replicas[i].Name)
+			glog.V(2).Infof("StatefulSet %s/%s is recreating failed Pod %s",
+				set.Namespace,
+				set.Name,
+				replicas[i].Name)
 			if err := ssc.podControl.DeleteStatefulPod(set, replicas[i]); err != nil {
-				return err
+				return &status, err
+			}
+			if getPodRevision(replicas[i]) == currentRevision.Name {
+				status.CurrentReplicas--
+			}
+			if getPodRevision(replicas[i]) == updateRevision.Name {
+				status.UpdatedReplicas--
 			}
-			replicas[i] = newStatefulSetPod(set, i)
+			replicas[i] = newVersionedStatefulSetPod(
+				currentSet,
+				updateSet,
+				currentRevision.Name,
+				updateRevision.Name,
+				i)
 		}
-		// If we find a Pod that has not been created we create the Pod immediately and return
+		// If we find a Pod that has not been created we create the Pod. With OrderedReady pod management we
+		// return immediately, with Parallel pod management we continue to create the remaining Pods.
 		if !isCreated(replicas[i]) {
-			return ssc.podControl.CreateStatefulPod(set, replicas[i])
+			if err := ssc.podControl.CreateStatefulPod(set, replicas[i]); err != nil {
+				return &status, err
+			}
+			if monotonic {
+				return &status, nil
+			}
+			continue
 		}
 		// If we have a Pod that has been created but is not running and ready we can not make progress.
 		// We must ensure that all for each Pod, when we create it, all of its predecessors, with respect to its
-		// ordinal, are Running and Ready.
-		if !isRunningAndReady(replicas[i]) {
-			glog.V(2).Infof("StatefulSet %s is waiting for Pod %s to be Running and Ready",
-				set.Name, replicas[i].Name)
-			return nil
+		// ordinal, are Running and Ready. Parallel pod management does not wait on predecessors.
+		if !isRunningAndReady(replicas[i]) && monotonic {
+			glog.V(4).Infof("StatefulSet %s/%s is waiting for Pod %s to be Running and Ready",
+				set.Namespace,
+				set.Name,
+				replicas[i].Name)
+			return &status, nil
 		}
 		// Enforce the StatefulSet invariants
 		if identityMatches(set, replicas[i]) && storageMatches(set, replicas[i]) {
@@ -140,26 +368,111 @@ func (ssc *defaultStatefulSetControl) UpdateStatefulSet(set *apps.StatefulSet, p
 		// Make a deep copy so we don't mutate the shared cache
 		copy, err := api.Scheme.DeepCopy(replicas[i])
 		if err != nil {
-			return err
+			return &status, err
 		}
 		replica := copy.(*v1.Pod)
-		if err := ssc.podControl.UpdateStatefulPod(set, replica); err != nil {
-			return err
+		if err := ssc.podControl.UpdateStatefulPod(updateSet, replica); err != nil {
+			return &status, err
 		}
 	}
 
 	// At this point, all of the current Replicas are Running and Ready, we can consider termination.
 	// We will wait for all predecessors to be Running and Ready prior to attempting a deletion.
 	// We will terminate Pods in a monotonically decreasing order over [len(pods),set.Spec.Replicas).
-	// Note that we do not resurrect Pods in this interval.
-	if unhealthy > 0 {
-		glog.V(2).Infof("StatefulSet %s is waiting on %d Pods", set.Name, unhealthy)
+	// Note that we do not resurrect Pods in this interval. Also note that scaling will take precedence over
+	// updates. With Parallel pod management all condemned Pods are terminated at once.
+	if unhealthy > 0 && monotonic {
+		glog.V(2).Infof("StatefulSet %s/%s is waiting on %d Pods", set.Namespace, set.Name, unhealthy)
+		return &status, nil
+	}
+	for target := len(condemned) - 1; target >= 0; target-- {
+		// Pods that are already terminating need no further action
+		if isTerminated(condemned[target]) {
+			continue
+		}
+		glog.V(2).Infof("StatefulSet %s/%s terminating Pod %s for scale down",
+			set.Namespace,
+			set.Name,
+			condemned[target].Name)
+		if err := ssc.podControl.DeleteStatefulPod(set, condemned[target]); err != nil {
+			return &status, err
+		}
+		if getPodRevision(condemned[target]) == currentRevision.Name {
+			status.CurrentReplicas--
+		}
+		if getPodRevision(condemned[target]) == updateRevision.Name {
+			status.UpdatedReplicas--
+		}
+		if monotonic {
+			return &status, nil
+		}
+	}
+
+	// for the OnDelete strategy we short circuit. Pods will be updated when they are manually deleted.
+	if set.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType {
+		return &status, nil
+	}
+
+	// we compute the minimum ordinal of the target sequence for a destructive update based on the strategy.
+	updateMin := 0
+	if set.Spec.UpdateStrategy.RollingUpdate != nil && set.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+		updateMin = int(*set.Spec.UpdateStrategy.RollingUpdate.Partition)
+	}
+	// we terminate the Pod with the largest ordinal that does not match the update revision.
+	for target := len(replicas) - 1; target >= updateMin; target-- {
+		// delete the Pod if it is not already terminating and does not match the update revision.
+		if getPodRevision(replicas[target]) != updateRevision.Name && !isTerminated(replicas[target]) {
+			glog.V(2).Infof("StatefulSet %s/%s terminating Pod %s for update",
+				set.Namespace,
+				set.Name,
+				replicas[target].Name)
+			if err := ssc.podControl.DeleteStatefulPod(set, replicas[target]); err != nil {
+				return &status, err
+			}
+			if getPodRevision(replicas[target]) == currentRevision.Name {
+				status.CurrentReplicas--
+			}
+			return &status, nil
+		}
+
+		// wait for unhealthy Pods on update
+		if !isHealthy(replicas[target]) {
+			glog.V(4).Infof(
+				"StatefulSet %s/%s is waiting for Pod %s to update",
+				set.Namespace,
+				set.Name,
+				replicas[target].Name)
+			return &status, nil
+		}
+	}
+	return &status, nil
+}
+
+// updateStatefulSetStatus updates set's Status to be equal to status. If status indicates a complete update, it is
+// mutated to indicate completion. If status is semantically equivalent to set's Status no update is performed. If the
+// returned error is nil, the update is successful.
+func (ssc *defaultStatefulSetControl) updateStatefulSetStatus(
+	set *apps.StatefulSet,
+	status *apps.StatefulSetStatus) error {
+
+	// complete any in progress rolling update if necessary
+	completeUpdate(set, status)
+
+	// if the status is not inconsistent do not perform an update
+	if !inconsistentStatus(set, status) {
 		return nil
 	}
-	if target := len(condemned) - 1; target >= 0 {
-		glog.V(2).Infof("StatefulSet %s terminating Pod %s", set.Name, condemned[target])
-		return ssc.podControl.DeleteStatefulPod(set, condemned[target])
+
+	// copy set and update its status
+	obj, err := api.Scheme.Copy(set)
+	if err != nil {
+		return err
+	}
+	set = obj.(*apps.StatefulSet)
+	if err := ssc.podControl.UpdateStatefulSetStatus(set, status); err != nil {
+		return err
 	}
+
 	return nil
 }
 
@@ -19,6 +19,7 @@ package statefulset
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"sort"
 	"strconv"
 	"testing"
@@ -28,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
 	apps "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
 	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
@@ -37,15 +39,18 @@ import (
 	appslisters "k8s.io/kubernetes/pkg/client/listers/apps/v1beta1"
 	corelisters "k8s.io/kubernetes/pkg/client/listers/core/v1"
 	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/controller/history"
 )
 
 func TestDefaultStatefulSetControlCreatesPods(t *testing.T) {
 	set := newStatefulSet(3)
+	set.Generation = 2
 	client := fake.NewSimpleClientset(set)
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -67,6 +72,9 @@ func TestDefaultStatefulSetControlCreatesPods(t *testing.T) {
 	if set.Status.Replicas != 3 {
 		t.Error("Failed to scale statefulset to 3 replicas")
 	}
+	if set.Status.ObservedGeneration == nil || *set.Status.ObservedGeneration != set.Generation {
+		t.Errorf("Failed to set ObservedGeneration to %d", set.Generation)
+	}
 }
 
 func TestStatefulSetControlScaleUp(t *testing.T) {
@@ -75,7 +83,8 @@ func TestStatefulSetControlScaleUp(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -109,7 +118,8 @@ func TestStatefulSetControlScaleDown(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -138,7 +148,8 @@ func TestStatefulSetControlReplacesPods(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -218,7 +229,8 @@ func TestDefaultStatefulSetControlRecreatesFailedPod(t *testing.T) {
 	client := fake.NewSimpleClientset()
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 	set := newStatefulSet(3)
 	selector, err := metav1.LabelSelectorAsSelector(set.Spec.Selector)
 	if err != nil {
@@ -261,7 +273,8 @@ func TestDefaultStatefulSetControlInitAnnotation(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -326,7 +339,8 @@ func TestDefaultStatefulSetControlCreatePodFailure(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 	spc.SetCreateStatefulPodError(apierrors.NewInternalError(errors.New("API server failed")), 2)
 
 	stop := make(chan struct{})
@@ -360,7 +374,8 @@ func TestDefaultStatefulSetControlUpdatePodFailure(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 	spc.SetUpdateStatefulPodError(apierrors.NewInternalError(errors.New("API server failed")), 0)
 
 	stop := make(chan struct{})
@@ -409,7 +424,8 @@ func TestDefaultStatefulSetControlUpdateSetStatusFailure(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 	spc.SetUpdateStatefulSetStatusError(apierrors.NewInternalError(errors.New("API server failed")), 2)
 
 	stop := make(chan struct{})
@@ -443,7 +459,8 @@ func TestDefaultStatefulSetControlPodRecreateDeleteError(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -502,7 +519,8 @@ func TestStatefulSetControlScaleDownDeleteError(t *testing.T) {
 
 	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
 	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
-	ssc := NewDefaultStatefulSetControl(spc)
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -538,6 +556,332 @@ func TestStatefulSetControlScaleDownDeleteError(t *testing.T) {
 	}
 }
 
+func TestStatefulSetControlParallelScaling(t *testing.T) {
+	set := newStatefulSet(3)
+	set.Spec.PodManagementPolicy = apps.ParallelPodManagement
+	client := fake.NewSimpleClientset(set)
+
+	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	informerFactory.Start(stop)
+	cache.WaitForCacheSync(
+		stop,
+		informerFactory.Apps().V1beta1().StatefulSets().Informer().HasSynced,
+		informerFactory.Core().V1().Pods().Informer().HasSynced,
+	)
+
+	// all Pods are created at once
+	if err := ssc.UpdateStatefulSet(set, []*v1.Pod{}); err != nil {
+		t.Fatalf("Failed to update StatefulSet : %s", err)
+	}
+	set, pods, err := getStatefulSetAndPods(set, spc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 3 {
+		t.Fatalf("Expected 3 Pods to be created in parallel, got %d", len(pods))
+	}
+	for ord := range pods {
+		if getOrdinal(pods[ord]) != ord {
+			t.Errorf("Pod %s has an unexpected identity", pods[ord].Name)
+		}
+		if !storageMatches(set, pods[ord]) {
+			t.Errorf("Pod %s does not match the storage of StatefulSet %s", pods[ord].Name, set.Name)
+		}
+	}
+
+	// Pods do not wait on their predecessors
+	if _, err = spc.setPodRunning(set, 2); err != nil {
+		t.Fatal(err)
+	}
+	if pods, err = spc.setPodReady(set, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := ssc.UpdateStatefulSet(set, pods); err != nil {
+		t.Fatalf("Failed to update StatefulSet : %s", err)
+	}
+	if set, err = spc.setsLister.StatefulSets(set.Namespace).Get(set.Name); err != nil {
+		t.Fatal(err)
+	}
+	if set.Status.Replicas != 1 {
+		t.Errorf("Expected 1 ready replica, got %d", set.Status.Replicas)
+	}
+
+	// all condemned Pods are terminated at once, even when they are not ready
+	*set.Spec.Replicas = 0
+	if err := ssc.UpdateStatefulSet(set, pods); err != nil {
+		t.Fatalf("Failed to update StatefulSet : %s", err)
+	}
+	if _, pods, err = getStatefulSetAndPods(set, spc); err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 0 {
+		t.Errorf("Expected all Pods to be deleted in parallel, %d remain", len(pods))
+	}
+}
+
+func setupUpdateTest(t *testing.T, set *apps.StatefulSet) (*fakeStatefulPodControl, StatefulSetControlInterface, chan struct{}) {
+	client := fake.NewSimpleClientset(set)
+	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1beta1().StatefulSets())
+	ssh := history.NewFakeHistory(informerFactory.Apps().V1beta1().ControllerRevisions())
+	ssc := NewDefaultStatefulSetControl(spc, ssh)
+
+	stop := make(chan struct{})
+	informerFactory.Start(stop)
+	cache.WaitForCacheSync(
+		stop,
+		informerFactory.Apps().V1beta1().StatefulSets().Informer().HasSynced,
+		informerFactory.Core().V1().Pods().Informer().HasSynced,
+		informerFactory.Apps().V1beta1().ControllerRevisions().Informer().HasSynced,
+	)
+	if err := scaleUpStatefulSetControl(set, ssc, spc); err != nil {
+		close(stop)
+		t.Fatalf("Failed to turn up StatefulSet : %s", err)
+	}
+	return spc, ssc, stop
+}
+
+func TestStatefulSetControlRollingUpdate(t *testing.T) {
+	set := newStatefulSet(3)
+	spc, ssc, stop := setupUpdateTest(t, set)
+	defer close(stop)
+
+	set, err := updateStatefulSetImage(set, spc, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = updateStatefulSetControl(set, ssc, spc, func(set *apps.StatefulSet, pods []*v1.Pod) error {
+		// Pods are updated in decreasing ordinal order, so the updated Pods must always be a suffix.
+		for i := 1; i < len(pods); i++ {
+			if getPodRevision(pods[i-1]) == set.Status.UpdateRevision &&
+				getPodRevision(pods[i]) != set.Status.UpdateRevision &&
+				set.Status.UpdateRevision != set.Status.CurrentRevision {
+				return fmt.Errorf("Pod %s was updated before %s", pods[i-1].Name, pods[i].Name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, pods, err := getStatefulSetAndPods(set, spc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Status.CurrentRevision != set.Status.UpdateRevision {
+		t.Errorf("Expected the update to complete, current=%s update=%s", set.Status.CurrentRevision, set.Status.UpdateRevision)
+	}
+	if set.Status.CurrentReplicas != 3 || set.Status.UpdatedReplicas != 3 {
+		t.Errorf("Expected 3 current and updated replicas, got %d and %d", set.Status.CurrentReplicas, set.Status.UpdatedReplicas)
+	}
+	for _, pod := range pods {
+		if image := pod.Spec.Containers[0].Image; image != "foo" {
+			t.Errorf("Pod %s has image %s, expected foo", pod.Name, image)
+		}
+		if revision := getPodRevision(pod); revision != set.Status.UpdateRevision {
+			t.Errorf("Pod %s has revision %s, expected %s", pod.Name, revision, set.Status.UpdateRevision)
+		}
+	}
+}
+
+func TestStatefulSetControlRollingUpdateWithPartition(t *testing.T) {
+	set := newStatefulSet(3)
+	spc, ssc, stop := setupUpdateTest(t, set)
+	defer close(stop)
+
+	partition := int32(2)
+	set, err := spc.setsLister.StatefulSets(set.Namespace).Get(set.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set.Spec.UpdateStrategy.RollingUpdate = &apps.RollingUpdateStatefulSetStrategy{Partition: &partition}
+	if set, err = updateStatefulSetImage(set, spc, "foo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := updateStatefulSetControl(set, ssc, spc, nil); err != nil {
+		t.Fatal(err)
+	}
+	set, pods, err := getStatefulSetAndPods(set, spc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Status.CurrentRevision == set.Status.UpdateRevision {
+		t.Error("Expected the update to remain in progress below the partition")
+	}
+	if set.Status.CurrentReplicas != 2 || set.Status.UpdatedReplicas != 1 {
+		t.Errorf("Expected 2 current and 1 updated replicas, got %d and %d", set.Status.CurrentReplicas, set.Status.UpdatedReplicas)
+	}
+	for i, pod := range pods {
+		expected := "nginx"
+		if i >= int(partition) {
+			expected = "foo"
+		}
+		if image := pod.Spec.Containers[0].Image; image != expected {
+			t.Errorf("Pod %s has image %s, expected %s", pod.Name, image, expected)
+		}
+	}
+
+	// Pods below the partition are recreated at the current revision.
+	spc.podsIndexer.Delete(pods[0])
+	if err := updateStatefulSetControl(set, ssc, spc, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, pods, err = getStatefulSetAndPods(set, spc); err != nil {
+		t.Fatal(err)
+	}
+	if image := pods[0].Spec.Containers[0].Image; image != "nginx" {
+		t.Errorf("Pod %s was recreated with image %s, expected nginx", pods[0].Name, image)
+	}
+
+	// Lowering the partition completes the update.
+	partition = 0
+	set.Spec.UpdateStrategy.RollingUpdate.Partition = &partition
+	spc.setsIndexer.Update(set)
+	if err := updateStatefulSetControl(set, ssc, spc, nil); err != nil {
+		t.Fatal(err)
+	}
+	if set, pods, err = getStatefulSetAndPods(set, spc); err != nil {
+		t.Fatal(err)
+	}
+	if set.Status.CurrentRevision != set.Status.UpdateRevision {
+		t.Errorf("Expected the update to complete, current=%s update=%s", set.Status.CurrentRevision, set.Status.UpdateRevision)
+	}
+	for _, pod := range pods {
+		if image := pod.Spec.Containers[0].Image; image != "foo" {
+			t.Errorf("Pod %s has image %s, expected foo", pod.Name, image)
+		}
+	}
+}
+
+func TestStatefulSetControlOnDeleteUpdate(t *testing.T) {
+	set := newStatefulSet(3)
+	set.Spec.UpdateStrategy = apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType}
+	spc, ssc, stop := setupUpdateTest(t, set)
+	defer close(stop)
+
+	set, err := spc.setsLister.StatefulSets(set.Namespace).Get(set.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set, err = updateStatefulSetImage(set, spc, "foo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := updateStatefulSetControl(set, ssc, spc, nil); err != nil {
+		t.Fatal(err)
+	}
+	set, pods, err := getStatefulSetAndPods(set, spc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Status.CurrentReplicas != 3 || set.Status.UpdatedReplicas != 0 {
+		t.Errorf("Expected 3 current and 0 updated replicas, got %d and %d", set.Status.CurrentReplicas, set.Status.UpdatedReplicas)
+	}
+	for _, pod := range pods {
+		if image := pod.Spec.Containers[0].Image; image != "nginx" {
+			t.Errorf("Pod %s was updated to %s without being deleted", pod.Name, image)
+		}
+	}
+
+	// A deleted Pod is recreated at the update revision.
+	spc.podsIndexer.Delete(pods[1])
+	if err := updateStatefulSetControl(set, ssc, spc, nil); err != nil {
+		t.Fatal(err)
+	}
+	if set, pods, err = getStatefulSetAndPods(set, spc); err != nil {
+		t.Fatal(err)
+	}
+	for i, pod := range pods {
+		expected := "nginx"
+		if i == 1 {
+			expected = "foo"
+		}
+		if image := pod.Spec.Containers[0].Image; image != expected {
+			t.Errorf("Pod %s has image %s, expected %s", pod.Name, image, expected)
+		}
+	}
+	if set.Status.CurrentReplicas != 2 || set.Status.UpdatedReplicas != 1 {
+		t.Errorf("Expected 2 current and 1 updated replicas, got %d and %d", set.Status.CurrentReplicas, set.Status.UpdatedReplicas)
+	}
+}
+
+func TestStatefulSetControlRollback(t *testing.T) {
+	set := newStatefulSet(3)
+	spc, ssc, stop := setupUpdateTest(t, set)
+	defer close(stop)
+
+	set, err := spc.setsLister.StatefulSets(set.Namespace).Get(set.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	initialRevision := set.Status.CurrentRevision
+	for _, image := range []string{"foo", "nginx"} {
+		if set, err = updateStatefulSetImage(set, spc, image); err != nil {
+			t.Fatal(err)
+		}
+		if err := updateStatefulSetControl(set, ssc, spc, nil); err != nil {
+			t.Fatal(err)
+		}
+		if set, err = spc.setsLister.StatefulSets(set.Namespace).Get(set.Name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if set.Status.UpdateRevision != initialRevision {
+		t.Errorf("Expected rollback to reuse revision %s, got %s", initialRevision, set.Status.UpdateRevision)
+	}
+	revisions, err := ssc.(*defaultStatefulSetControl).ListRevisions(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	history.SortControllerRevisions(revisions)
+	if len(revisions) != 2 {
+		t.Fatalf("Expected 2 revisions, got %d", len(revisions))
+	}
+	if last := revisions[len(revisions)-1]; last.Name != initialRevision || last.Revision != 3 {
+		t.Errorf("Expected revision %s to be rolled forward to 3, got %s at %d", initialRevision, last.Name, last.Revision)
+	}
+}
+
+func TestStatefulSetControlTruncatesHistory(t *testing.T) {
+	set := newStatefulSet(3)
+	spc, ssc, stop := setupUpdateTest(t, set)
+	defer close(stop)
+
+	set, err := spc.setsLister.StatefulSets(set.Namespace).Get(set.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, image := range []string{"foo", "bar", "baz", "qux"} {
+		if set, err = updateStatefulSetImage(set, spc, image); err != nil {
+			t.Fatal(err)
+		}
+		if err := updateStatefulSetControl(set, ssc, spc, nil); err != nil {
+			t.Fatal(err)
+		}
+		if set, err = spc.setsLister.StatefulSets(set.Namespace).Get(set.Name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	revisions, err := ssc.(*defaultStatefulSetControl).ListRevisions(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the live revision plus RevisionHistoryLimit historic revisions are retained
+	if e, a := int(*set.Spec.RevisionHistoryLimit)+1, len(revisions); e != a {
+		t.Errorf("Expected %d revisions, got %d", e, a)
+	}
+	for _, revision := range revisions {
+		if revision.Revision < 3 {
+			t.Errorf("Expected revision %s at %d to be truncated", revision.Name, revision.Revision)
+		}
+	}
+}
+
 type requestTracker struct {
 	requests int
 	err      error
@@ -769,13 +1113,13 @@ func (spc *fakeStatefulPodControl) DeleteStatefulPod(set *apps.StatefulSet, pod
 	return nil
 }
 
-func (spc *fakeStatefulPodControl) UpdateStatefulSetReplicas(set *apps.StatefulSet, replicas int32) error {
+func (spc *fakeStatefulPodControl) UpdateStatefulSetStatus(set *apps.StatefulSet, status *apps.StatefulSetStatus) error {
 	defer spc.updateStatusTracker.inc()
 	if spc.updateStatusTracker.errorReady() {
 		defer spc.updateStatusTracker.reset()
 		return spc.updateStatusTracker.err
 	}
-	set.Status.Replicas = replicas
+	set.Status = *status
 	spc.setsIndexer.Update(set)
 	return nil
 }
@@ -935,3 +1279,94 @@ func scaleDownStatefulSetControl(set *apps.StatefulSet, ssc StatefulSetControlIn
 	}
 	return assertInvariants(set, spc)
 }
+
+// updateStatefulSetImage sets the image of the first container of set's template to image and records the change in
+// the fake's cache.
+func updateStatefulSetImage(set *apps.StatefulSet, spc *fakeStatefulPodControl, image string) (*apps.StatefulSet, error) {
+	obj, err := api.Scheme.DeepCopy(set)
+	if err != nil {
+		return nil, err
+	}
+	set = obj.(*apps.StatefulSet)
+	set.Spec.Template.Spec.Containers[0].Image = image
+	if err := spc.setsIndexer.Update(set); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// getStatefulSetAndPods returns the cached state of set and its Pods sorted by ordinal.
+func getStatefulSetAndPods(set *apps.StatefulSet, spc *fakeStatefulPodControl) (*apps.StatefulSet, []*v1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(set.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	set, err = spc.setsLister.StatefulSets(set.Namespace).Get(set.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	pods, err := spc.podsLister.Pods(set.Namespace).List(selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Sort(ascendingOrdinal(pods))
+	return set, pods, nil
+}
+
+// updateStatefulSetControl runs the control loop for set, bringing every created Pod to Running and Ready after each
+// iteration, until the loop makes no further progress. verify, if not nil, is called after each iteration. The
+// StatefulSet invariants are asserted once the loop has converged.
+func updateStatefulSetControl(set *apps.StatefulSet,
+	ssc StatefulSetControlInterface,
+	spc *fakeStatefulPodControl,
+	verify func(set *apps.StatefulSet, pods []*v1.Pod) error) error {
+	for i := 0; i < 100; i++ {
+		set, pods, err := getStatefulSetAndPods(set, spc)
+		if err != nil {
+			return err
+		}
+		before := podRevisions(pods)
+		if err := ssc.UpdateStatefulSet(set, pods); err != nil {
+			return err
+		}
+		updated, pods, err := getStatefulSetAndPods(set, spc)
+		if err != nil {
+			return err
+		}
+		progress := inconsistentStatus(set, &updated.Status) || !reflect.DeepEqual(before, podRevisions(pods))
+		for ord := range pods {
+			if pods[ord].Status.Phase != v1.PodRunning {
+				if pods, err = spc.setPodRunning(updated, ord); err != nil {
+					return err
+				}
+				sort.Sort(ascendingOrdinal(pods))
+				progress = true
+			}
+			if !isRunningAndReady(pods[ord]) {
+				if pods, err = spc.setPodReady(updated, ord); err != nil {
+					return err
+				}
+				sort.Sort(ascendingOrdinal(pods))
+				progress = true
+			}
+		}
+		if verify != nil {
+			if err := verify(updated, pods); err != nil {
+				return err
+			}
+		}
+		if !progress {
+			return assertInvariants(updated, spc)
+		}
+	}
+	return fmt.Errorf("StatefulSet %s did not converge", set.Name)
+}
+
+// podRevisions returns the revision of each Pod in pods keyed by the Pod's name.
+func podRevisions(pods []*v1.Pod) map[string]string {
+	revisions := make(map[string]string, len(pods))
+	for i := range pods {
+		revisions[pods[i].Name] = getPodRevision(pods[i])
+	}
+	return revisions
+}
@@ -19,6 +19,7 @@ package statefulset
 import (
 	"fmt"
 	"math/rand"
+	"reflect"
 	"sort"
 	"strconv"
 	"testing"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/v1"
 	podapi "k8s.io/kubernetes/pkg/api/v1/pod"
 	apps "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
+	"k8s.io/kubernetes/pkg/controller/history"
 )
 
 func TestGetParentNameAndOrdinal(t *testing.T) {
@@ -250,6 +252,63 @@ func TestAscendingOrdinal(t *testing.T) {
 	}
 }
 
+func TestNewRevisionAndApplyRevision(t *testing.T) {
+	set := newStatefulSet(3)
+	revision, err := newRevision(set, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.Revision != 1 {
+		t.Errorf("Expected revision 1, got %d", revision.Revision)
+	}
+	if !history.EqualRevision(revision, revision) {
+		t.Error("Revision is not equal to itself")
+	}
+	updated := newStatefulSet(3)
+	updated.Spec.Template.Spec.Containers[0].Image = "foo"
+	updateRevision, err := newRevision(updated, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history.EqualRevision(revision, updateRevision) {
+		t.Error("Revisions of different templates are equal")
+	}
+	restored, err := applyRevision(updated, revision)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.Spec.Template, set.Spec.Template) {
+		t.Errorf("applyRevision failed to restore the template: %v", restored.Spec.Template)
+	}
+	if updated.Spec.Template.Spec.Containers[0].Image != "foo" {
+		t.Error("applyRevision mutated its argument")
+	}
+}
+
+func TestNewVersionedStatefulSetPod(t *testing.T) {
+	currentSet := newStatefulSet(3)
+	partition := int32(2)
+	currentSet.Spec.UpdateStrategy.RollingUpdate = &apps.RollingUpdateStatefulSetStrategy{Partition: &partition}
+	updateSet := newStatefulSet(3)
+	updateSet.Spec.Template.Spec.Containers[0].Image = "foo"
+	for ordinal, expected := range []string{"current", "current", "update"} {
+		pod := newVersionedStatefulSetPod(currentSet, updateSet, "current", "update", ordinal)
+		if revision := getPodRevision(pod); revision != expected {
+			t.Errorf("Pod %s has revision %s, expected %s", pod.Name, revision, expected)
+		}
+	}
+	currentSet.Spec.UpdateStrategy = apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType}
+	for ordinal := 0; ordinal < 3; ordinal++ {
+		pod := newVersionedStatefulSetPod(currentSet, updateSet, "current", "update", ordinal)
+		if revision := getPodRevision(pod); revision != "update" {
+			t.Errorf("Pod %s has revision %s, expected update", pod.Name, revision)
+		}
+		if image := pod.Spec.Containers[0].Image; image != "foo" {
+			t.Errorf("Pod %s has image %s, expected foo", pod.Name, image)
+		}
+	}
+}
+
 func TestOverlappingStatefulSets(t *testing.T) {
 	sets := make([]*apps.StatefulSet, 10)
 	perm := rand.Perm(10)
@@ -338,6 +397,12 @@ func newStatefulSetWithVolumes(replicas int, name string, petMounts []v1.VolumeM
 			Template:             template,
 			VolumeClaimTemplates: claims,
 			ServiceName:          "governingsvc",
+			PodManagementPolicy:  apps.OrderedReadyPodManagement,
+			UpdateStrategy:       apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+			RevisionHistoryLimit: func() *int32 {
+				limit := int32(2)
+				return &limit
+			}(),
 		},
 	}
 }
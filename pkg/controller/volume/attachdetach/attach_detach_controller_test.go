@@ -39,6 +39,7 @@ func Test_NewAttachDetachController_Positive(t *testing.T) {
 		informerFactory.Core().V1().PersistentVolumes(),
 		nil, /* cloud */
 		nil, /* plugins */
+		nil, /* prober */
 		false,
 		time.Second*5)
 
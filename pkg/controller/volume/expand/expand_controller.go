@@ -0,0 +1,344 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package expand implements a controller that expands the volumes of
+// persistent volume claims whose storage request grew after they were bound.
+package expand
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	clientv1 "k8s.io/client-go/pkg/api/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	coreinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/core/v1"
+	corelisters "k8s.io/kubernetes/pkg/client/listers/core/v1"
+	"k8s.io/kubernetes/pkg/cloudprovider"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/util/io"
+	"k8s.io/kubernetes/pkg/util/mount"
+	"k8s.io/kubernetes/pkg/volume"
+	volumeutil "k8s.io/kubernetes/pkg/volume/util"
+)
+
+// ExpandController expands the volumes of bound claims whose storage request
+// is larger than their current capacity.
+type ExpandController interface {
+	Run(workers int, stopCh <-chan struct{})
+}
+
+type expandController struct {
+	// kubeClient is the kube API client used by volumehost to communicate with
+	// the API server.
+	kubeClient clientset.Interface
+
+	// pvcLister is the shared PVC lister used to fetch and store PVC
+	// objects from the API server. It is shared with other controllers and
+	// therefore the PVC objects in its store should be treated as immutable.
+	pvcLister  corelisters.PersistentVolumeClaimLister
+	pvcsSynced cache.InformerSynced
+
+	// pvLister is the shared PV lister used to fetch and store PV objects
+	// from the API server. It is shared with other controllers and therefore
+	// the PV objects in its store should be treated as immutable.
+	pvLister  corelisters.PersistentVolumeLister
+	pvsSynced cache.InformerSynced
+
+	// cloud provider used by volume host
+	cloud cloudprovider.Interface
+
+	// volumePluginMgr used to initialize and fetch volume plugins
+	volumePluginMgr volume.VolumePluginMgr
+
+	// recorder is used to record events in the API server
+	recorder record.EventRecorder
+
+	// claims that need to be expanded
+	queue workqueue.RateLimitingInterface
+}
+
+// NewExpandController returns a new instance of ExpandController.
+func NewExpandController(
+	kubeClient clientset.Interface,
+	pvcInformer coreinformers.PersistentVolumeClaimInformer,
+	pvInformer coreinformers.PersistentVolumeInformer,
+	cloud cloudprovider.Interface,
+	plugins []volume.VolumePlugin) (ExpandController, error) {
+
+	expc := &expandController{
+		kubeClient: kubeClient,
+		cloud:      cloud,
+		pvcLister:  pvcInformer.Lister(),
+		pvcsSynced: pvcInformer.Informer().HasSynced,
+		pvLister:   pvInformer.Lister(),
+		pvsSynced:  pvInformer.Informer().HasSynced,
+		queue:      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "volume_expand"),
+	}
+
+	if err := expc.volumePluginMgr.InitPlugins(plugins, nil /* prober */, expc); err != nil {
+		return nil, fmt.Errorf("Could not initialize volume plugins for Expand Controller: %+v", err)
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: v1core.New(kubeClient.Core().RESTClient()).Events("")})
+	expc.recorder = eventBroadcaster.NewRecorder(api.Scheme, clientv1.EventSource{Component: "volume_expand"})
+
+	pvcInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: expc.enqueueClaim,
+		UpdateFunc: func(_, newObj interface{}) {
+			expc.enqueueClaim(newObj)
+		},
+	})
+
+	return expc, nil
+}
+
+func (expc *expandController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer expc.queue.ShutDown()
+
+	glog.Infof("Starting expand controller")
+	defer glog.Infof("Shutting down expand controller")
+
+	if !cache.WaitForCacheSync(stopCh, expc.pvcsSynced, expc.pvsSynced) {
+		utilruntime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(expc.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+func (expc *expandController) enqueueClaim(obj interface{}) {
+	pvc, ok := obj.(*v1.PersistentVolumeClaim)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("unexpected object type: %v", obj))
+		return
+	}
+	if !volumeutil.ClaimNeedsResize(pvc) {
+		return
+	}
+	key, err := controller.KeyFunc(pvc)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", pvc, err))
+		return
+	}
+	expc.queue.Add(key)
+}
+
+func (expc *expandController) worker() {
+	for expc.processNextWorkItem() {
+	}
+}
+
+func (expc *expandController) processNextWorkItem() bool {
+	key, quit := expc.queue.Get()
+	if quit {
+		return false
+	}
+	defer expc.queue.Done(key)
+
+	err := expc.syncClaim(key.(string))
+	if err == nil {
+		expc.queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("error expanding volume of claim %q: %v", key, err))
+	expc.queue.AddRateLimited(key)
+	return true
+}
+
+// syncClaim expands the volume bound to the claim and records the new size
+// on the volume and, unless the file system still has to be grown by the
+// kubelet, on the claim.
+func (expc *expandController) syncClaim(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	pvc, err := expc.pvcLister.PersistentVolumeClaims(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		glog.V(4).Infof("claim %q has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// The kubelet finishes the resize once the file system has been grown.
+	if !volumeutil.ClaimNeedsResize(pvc) || volumeutil.ClaimHasCondition(pvc, v1.PersistentVolumeClaimFileSystemResizePending) {
+		return nil
+	}
+
+	pv, err := expc.pvLister.Get(pvc.Spec.VolumeName)
+	if err != nil {
+		return fmt.Errorf("error getting volume %q bound to claim %q: %v", pvc.Spec.VolumeName, key, err)
+	}
+	if pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.UID != pvc.UID {
+		return fmt.Errorf("volume %q is not bound to claim %q", pv.Name, key)
+	}
+
+	spec := volume.NewSpecFromPersistentVolume(pv, false)
+	plugin, err := expc.volumePluginMgr.FindExpandablePluginBySpec(spec)
+	if err != nil {
+		return err
+	}
+	if plugin == nil {
+		expc.recorder.Eventf(pvc, v1.EventTypeWarning, "VolumeResizeFailed", "volume %q does not support expansion", pv.Name)
+		return nil
+	}
+
+	if !volumeutil.ClaimHasCondition(pvc, v1.PersistentVolumeClaimResizing) {
+		if pvc, err = volumeutil.MarkResizeInProgress(pvc, expc.kubeClient); err != nil {
+			return fmt.Errorf("error marking claim %q as resizing: %v", key, err)
+		}
+	}
+
+	requestSize := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	pvSize := pv.Spec.Capacity[v1.ResourceStorage]
+	newSize, err := plugin.ExpandVolumeDevice(spec, requestSize, pvSize)
+	if err != nil {
+		expc.recorder.Eventf(pvc, v1.EventTypeWarning, "VolumeResizeFailed", "error expanding volume %q: %v", pv.Name, err)
+		return err
+	}
+	glog.V(2).Infof("expanded volume %q of claim %q to %s", pv.Name, key, newSize.String())
+
+	if newSize.Cmp(pvSize) > 0 {
+		if err := expc.updatePVSize(pv, newSize); err != nil {
+			return err
+		}
+	}
+
+	if plugin.RequiresFSResize() {
+		if _, err := volumeutil.MarkForFSResize(pvc, expc.kubeClient); err != nil {
+			return fmt.Errorf("error marking claim %q for file system resize: %v", key, err)
+		}
+		expc.recorder.Eventf(pvc, v1.EventTypeNormal, "FileSystemResizeRequired", "volume %q has been expanded to %s, waiting for the file system on the node to be resized", pv.Name, newSize.String())
+		return nil
+	}
+
+	if _, err := volumeutil.MarkResizeFinished(pvc, newSize, expc.kubeClient); err != nil {
+		return fmt.Errorf("error updating capacity of claim %q: %v", key, err)
+	}
+	expc.recorder.Eventf(pvc, v1.EventTypeNormal, "VolumeResizeSuccessful", "volume %q has been expanded to %s", pv.Name, newSize.String())
+	return nil
+}
+
+func (expc *expandController) updatePVSize(pv *v1.PersistentVolume, newSize resource.Quantity) error {
+	clone, err := api.Scheme.DeepCopy(pv)
+	if err != nil {
+		return fmt.Errorf("error cloning volume %q: %v", pv.Name, err)
+	}
+	pvClone, ok := clone.(*v1.PersistentVolume)
+	if !ok {
+		return fmt.Errorf("unexpected volume cast error: %v", clone)
+	}
+	pvClone.Spec.Capacity[v1.ResourceStorage] = newSize
+	if _, err := expc.kubeClient.Core().PersistentVolumes().Update(pvClone); err != nil {
+		return fmt.Errorf("error updating capacity of volume %q: %v", pv.Name, err)
+	}
+	return nil
+}
+
+// VolumeHost implementation
+// This is an unfortunate requirement of the current factoring of volume plugin
+// initializing code. It requires kubelet specific methods used by the mounting
+// code to be implemented by all initializers even if the initializer does not
+// do mounting (like this expand controller).
+func (expc *expandController) GetPluginDir(pluginName string) string {
+	return ""
+}
+
+func (expc *expandController) GetPodVolumeDir(podUID types.UID, pluginName, volumeName string) string {
+	return ""
+}
+
+func (expc *expandController) GetPodVolumeDeviceDir(podUID types.UID, pluginName string) string {
+	return ""
+}
+
+func (expc *expandController) GetPodPluginDir(podUID types.UID, pluginName string) string {
+	return ""
+}
+
+func (expc *expandController) GetKubeClient() clientset.Interface {
+	return expc.kubeClient
+}
+
+func (expc *expandController) NewWrapperMounter(volName string, spec volume.Spec, pod *v1.Pod, opts volume.VolumeOptions) (volume.Mounter, error) {
+	return nil, fmt.Errorf("NewWrapperMounter not supported by expand controller's VolumeHost implementation")
+}
+
+func (expc *expandController) NewWrapperUnmounter(volName string, spec volume.Spec, podUID types.UID) (volume.Unmounter, error) {
+	return nil, fmt.Errorf("NewWrapperUnmounter not supported by expand controller's VolumeHost implementation")
+}
+
+func (expc *expandController) GetCloudProvider() cloudprovider.Interface {
+	return expc.cloud
+}
+
+func (expc *expandController) GetMounter() mount.Interface {
+	return nil
+}
+
+func (expc *expandController) GetWriter() io.Writer {
+	return nil
+}
+
+func (expc *expandController) GetHostName() string {
+	return ""
+}
+
+func (expc *expandController) GetHostIP() (net.IP, error) {
+	return nil, fmt.Errorf("GetHostIP() not supported by expand controller's VolumeHost implementation")
+}
+
+func (expc *expandController) GetNodeAllocatable() (v1.ResourceList, error) {
+	return v1.ResourceList{}, nil
+}
+
+func (expc *expandController) GetSecretFunc() func(namespace, name string) (*v1.Secret, error) {
+	return func(_, _ string) (*v1.Secret, error) {
+		return nil, fmt.Errorf("GetSecret unsupported in expandController")
+	}
+}
+
+func (expc *expandController) GetNodeName() types.NodeName {
+	return ""
+}
+
+func (expc *expandController) GetNodeLabels() (map[string]string, error) {
+	return nil, fmt.Errorf("GetNodeLabels() unsupported in expand controller")
+}
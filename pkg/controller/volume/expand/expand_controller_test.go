@@ -0,0 +1,151 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expand
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+	informers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/volume"
+	volumetest "k8s.io/kubernetes/pkg/volume/testing"
+)
+
+func newClaim(request, capacity string, conditions ...v1.PersistentVolumeClaimConditionType) *v1.PersistentVolumeClaim {
+	claim := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "ns", UID: "claim-uid"},
+		Spec: v1.PersistentVolumeClaimSpec{
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(request)},
+			},
+			VolumeName: "volume",
+		},
+		Status: v1.PersistentVolumeClaimStatus{
+			Phase:    v1.ClaimBound,
+			Capacity: v1.ResourceList{v1.ResourceStorage: resource.MustParse(capacity)},
+		},
+	}
+	for _, conditionType := range conditions {
+		claim.Status.Conditions = append(claim.Status.Conditions, v1.PersistentVolumeClaimCondition{Type: conditionType, Status: v1.ConditionTrue})
+	}
+	return claim
+}
+
+func newVolume(capacity string) *v1.PersistentVolume {
+	return &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "volume"},
+		Spec: v1.PersistentVolumeSpec{
+			Capacity: v1.ResourceList{v1.ResourceStorage: resource.MustParse(capacity)},
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{PDName: "disk"},
+			},
+			ClaimRef: &v1.ObjectReference{Namespace: "ns", Name: "claim", UID: "claim-uid"},
+		},
+	}
+}
+
+func TestSyncClaim(t *testing.T) {
+	tests := []struct {
+		name             string
+		claim            *v1.PersistentVolumeClaim
+		volume           *v1.PersistentVolume
+		expectedActions  []string
+		expectedCapacity string
+	}{
+		{
+			name:             "expand claim",
+			claim:            newClaim("2Gi", "1Gi"),
+			volume:           newVolume("1Gi"),
+			expectedActions:  []string{"update persistentvolumeclaims", "update persistentvolumes", "update persistentvolumeclaims"},
+			expectedCapacity: "2Gi",
+		},
+		{
+			name:            "claim does not need resize",
+			claim:           newClaim("1Gi", "1Gi"),
+			volume:          newVolume("1Gi"),
+			expectedActions: []string{},
+		},
+		{
+			name:            "claim waits for file system resize",
+			claim:           newClaim("2Gi", "1Gi", v1.PersistentVolumeClaimFileSystemResizePending),
+			volume:          newVolume("2Gi"),
+			expectedActions: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		client := fake.NewSimpleClientset(test.claim, test.volume)
+		informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+		pvcInformer := informerFactory.Core().V1().PersistentVolumeClaims()
+		pvInformer := informerFactory.Core().V1().PersistentVolumes()
+		pvcInformer.Informer().GetStore().Add(test.claim)
+		pvInformer.Informer().GetStore().Add(test.volume)
+
+		fakePlugin := &volumetest.FakeVolumePlugin{PluginName: "fake-plugin"}
+		ctrl, err := NewExpandController(client, pvcInformer, pvInformer, nil, []volume.VolumePlugin{fakePlugin})
+		if err != nil {
+			t.Fatalf("%s: error creating controller: %v", test.name, err)
+		}
+		expc := ctrl.(*expandController)
+		expc.recorder = record.NewFakeRecorder(10)
+		client.ClearActions()
+
+		if err := expc.syncClaim("ns/claim"); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		actions := client.Actions()
+		if len(actions) != len(test.expectedActions) {
+			t.Errorf("%s: expected actions %v, got %v", test.name, test.expectedActions, actions)
+			continue
+		}
+		for i, action := range actions {
+			if got := action.GetVerb() + " " + action.GetResource().Resource; got != test.expectedActions[i] {
+				t.Errorf("%s: expected action %q, got %q", test.name, test.expectedActions[i], got)
+			}
+		}
+
+		if test.expectedCapacity == "" {
+			continue
+		}
+		claim, err := client.Core().PersistentVolumeClaims("ns").Get("claim", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("%s: error getting claim: %v", test.name, err)
+		}
+		capacity := claim.Status.Capacity[v1.ResourceStorage]
+		if capacity.Cmp(resource.MustParse(test.expectedCapacity)) != 0 {
+			t.Errorf("%s: expected claim capacity %s, got %s", test.name, test.expectedCapacity, capacity.String())
+		}
+		if len(claim.Status.Conditions) != 0 {
+			t.Errorf("%s: expected no claim conditions, got %v", test.name, claim.Status.Conditions)
+		}
+		volume, err := client.Core().PersistentVolumes().Get("volume", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("%s: error getting volume: %v", test.name, err)
+		}
+		volumeCapacity := volume.Spec.Capacity[v1.ResourceStorage]
+		if volumeCapacity.Cmp(resource.MustParse(test.expectedCapacity)) != 0 {
+			t.Errorf("%s: expected volume capacity %s, got %s", test.name, test.expectedCapacity, volumeCapacity.String())
+		}
+	}
+}
@@ -19,6 +19,7 @@ package persistentvolume
 import (
 	"testing"
 
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kubernetes/pkg/api/v1"
 	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
 )
@@ -500,6 +501,45 @@ func TestSync(t *testing.T) {
 	runSyncTests(t, tests, []*storage.StorageClass{})
 }
 
+// Test binding of claims and volumes with a volume mode while the BlockVolume
+// feature is enabled.
+func TestSyncBlockVolume(t *testing.T) {
+	utilfeature.DefaultFeatureGate.Set("BlockVolume=true")
+	defer utilfeature.DefaultFeatureGate.Set("BlockVolume=false")
+
+	tests := []controllerTest{
+		{
+			// syncClaim binds a block claim to a block volume.
+			"14-1 - successful block binding",
+			withVolumeMode(v1.PersistentVolumeBlock, newVolumeArray("volume14-1", "1Gi", "", "", v1.VolumePending, v1.PersistentVolumeReclaimRetain, classEmpty)),
+			withVolumeMode(v1.PersistentVolumeBlock, newVolumeArray("volume14-1", "1Gi", "uid14-1", "claim14-1", v1.VolumeBound, v1.PersistentVolumeReclaimRetain, classEmpty, annBoundByController)),
+			withClaimVolumeMode(v1.PersistentVolumeBlock, newClaimArray("claim14-1", "uid14-1", "1Gi", "", v1.ClaimPending, nil)),
+			withClaimVolumeMode(v1.PersistentVolumeBlock, newClaimArray("claim14-1", "uid14-1", "1Gi", "volume14-1", v1.ClaimBound, nil, annBoundByController, annBindCompleted)),
+			noevents, noerrors, testSyncClaim,
+		},
+		{
+			// syncClaim does not bind a block claim to a filesystem volume.
+			"14-2 - no filesystem volume for block claim",
+			newVolumeArray("volume14-2", "1Gi", "", "", v1.VolumePending, v1.PersistentVolumeReclaimRetain, classEmpty),
+			newVolumeArray("volume14-2", "1Gi", "", "", v1.VolumePending, v1.PersistentVolumeReclaimRetain, classEmpty),
+			withClaimVolumeMode(v1.PersistentVolumeBlock, newClaimArray("claim14-2", "uid14-2", "1Gi", "", v1.ClaimPending, nil)),
+			withClaimVolumeMode(v1.PersistentVolumeBlock, newClaimArray("claim14-2", "uid14-2", "1Gi", "", v1.ClaimPending, nil)),
+			[]string{"Normal FailedBinding"}, noerrors, testSyncClaim,
+		},
+		{
+			// syncClaim with a filesystem claim pre-bound to a block volume.
+			// Check the claim stays pending.
+			"14-3 - claim prebound to volume with different volume mode",
+			withVolumeMode(v1.PersistentVolumeBlock, newVolumeArray("volume14-3", "1Gi", "", "", v1.VolumePending, v1.PersistentVolumeReclaimRetain, classEmpty)),
+			withVolumeMode(v1.PersistentVolumeBlock, newVolumeArray("volume14-3", "1Gi", "", "", v1.VolumePending, v1.PersistentVolumeReclaimRetain, classEmpty)),
+			newClaimArray("claim14-3", "uid14-3", "1Gi", "volume14-3", v1.ClaimPending, nil),
+			newClaimArray("claim14-3", "uid14-3", "1Gi", "volume14-3", v1.ClaimPending, nil),
+			[]string{"Warning VolumeMismatch"}, noerrors, testSyncClaim,
+		},
+	}
+	runSyncTests(t, tests, []*storage.StorageClass{})
+}
+
 // Test multiple calls to syncClaim/syncVolume and periodic sync of all
 // volume/claims. The test follows this pattern:
 // 0. Load the controller with initial data.
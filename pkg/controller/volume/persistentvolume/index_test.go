@@ -22,6 +22,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/testapi"
 	"k8s.io/kubernetes/pkg/api/v1"
@@ -130,7 +131,7 @@ func TestMatchVolume(t *testing.T) {
 	}
 
 	for name, scenario := range scenarios {
-		volume, err := volList.findBestMatchForClaim(scenario.claim)
+		volume, err := volList.findBestMatchForClaim(scenario.claim, false)
 		if err != nil {
 			t.Errorf("Unexpected error matching volume by claim: %v", err)
 		}
@@ -201,7 +202,7 @@ func TestMatchingWithBoundVolumes(t *testing.T) {
 		},
 	}
 
-	volume, err := volumeIndex.findBestMatchForClaim(claim)
+	volume, err := volumeIndex.findBestMatchForClaim(claim, false)
 	if err != nil {
 		t.Fatalf("Unexpected error matching volume by claim: %v", err)
 	}
@@ -324,27 +325,27 @@ func TestFindingVolumeWithDifferentAccessModes(t *testing.T) {
 	index.store.Add(ebs)
 	index.store.Add(nfs)
 
-	volume, _ := index.findBestMatchForClaim(claim)
+	volume, _ := index.findBestMatchForClaim(claim, false)
 	if volume.Name != ebs.Name {
 		t.Errorf("Expected %s but got volume %s instead", ebs.Name, volume.Name)
 	}
 
 	claim.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce, v1.ReadOnlyMany}
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != gce.Name {
 		t.Errorf("Expected %s but got volume %s instead", gce.Name, volume.Name)
 	}
 
 	// order of the requested modes should not matter
 	claim.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteMany, v1.ReadWriteOnce, v1.ReadOnlyMany}
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != nfs.Name {
 		t.Errorf("Expected %s but got volume %s instead", nfs.Name, volume.Name)
 	}
 
 	// fewer modes requested should still match
 	claim.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteMany}
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != nfs.Name {
 		t.Errorf("Expected %s but got volume %s instead", nfs.Name, volume.Name)
 	}
@@ -352,7 +353,7 @@ func TestFindingVolumeWithDifferentAccessModes(t *testing.T) {
 	// pretend the exact match is bound.  should get the next level up of modes.
 	ebs.Spec.ClaimRef = &v1.ObjectReference{}
 	claim.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != gce.Name {
 		t.Errorf("Expected %s but got volume %s instead", gce.Name, volume.Name)
 	}
@@ -360,7 +361,7 @@ func TestFindingVolumeWithDifferentAccessModes(t *testing.T) {
 	// continue up the levels of modes.
 	gce.Spec.ClaimRef = &v1.ObjectReference{}
 	claim.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != nfs.Name {
 		t.Errorf("Expected %s but got volume %s instead", nfs.Name, volume.Name)
 	}
@@ -368,7 +369,7 @@ func TestFindingVolumeWithDifferentAccessModes(t *testing.T) {
 	// partial mode request
 	gce.Spec.ClaimRef = nil
 	claim.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadOnlyMany}
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != gce.Name {
 		t.Errorf("Expected %s but got volume %s instead", gce.Name, volume.Name)
 	}
@@ -636,14 +637,14 @@ func TestFindingPreboundVolumes(t *testing.T) {
 	index.store.Add(pvBadMode)
 
 	// expected exact match on size
-	volume, _ := index.findBestMatchForClaim(claim)
+	volume, _ := index.findBestMatchForClaim(claim, false)
 	if volume.Name != pv1.Name {
 		t.Errorf("Expected %s but got volume %s instead", pv1.Name, volume.Name)
 	}
 
 	// pretend the exact match is pre-bound.  should get the next size up.
 	pv1.Spec.ClaimRef = &v1.ObjectReference{Name: "foo", Namespace: "bar"}
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != pv5.Name {
 		t.Errorf("Expected %s but got volume %s instead", pv5.Name, volume.Name)
 	}
@@ -651,7 +652,7 @@ func TestFindingPreboundVolumes(t *testing.T) {
 	// pretend the exact match is available but the largest volume is pre-bound to the claim.
 	pv1.Spec.ClaimRef = nil
 	pv8.Spec.ClaimRef = claimRef
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != pv8.Name {
 		t.Errorf("Expected %s but got volume %s instead", pv8.Name, volume.Name)
 	}
@@ -659,7 +660,7 @@ func TestFindingPreboundVolumes(t *testing.T) {
 	// pretend the volume with too small a size is pre-bound to the claim. should get the exact match.
 	pv8.Spec.ClaimRef = nil
 	pvBadSize.Spec.ClaimRef = claimRef
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != pv1.Name {
 		t.Errorf("Expected %s but got volume %s instead", pv1.Name, volume.Name)
 	}
@@ -667,12 +668,101 @@ func TestFindingPreboundVolumes(t *testing.T) {
 	// pretend the volume without the right access mode is pre-bound to the claim. should get the exact match.
 	pvBadSize.Spec.ClaimRef = nil
 	pvBadMode.Spec.ClaimRef = claimRef
-	volume, _ = index.findBestMatchForClaim(claim)
+	volume, _ = index.findBestMatchForClaim(claim, false)
 	if volume.Name != pv1.Name {
 		t.Errorf("Expected %s but got volume %s instead", pv1.Name, volume.Name)
 	}
 }
 
+func TestFindingVolumesWithDelayBinding(t *testing.T) {
+	claim := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "claim01",
+			Namespace: "myns",
+			SelfLink:  testapi.Default.SelfLink("pvc", ""),
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Resources:   v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceName(v1.ResourceStorage): resource.MustParse("1Gi")}},
+		},
+	}
+	claimRef, err := v1.GetReference(api.Scheme, claim)
+	if err != nil {
+		t.Errorf("error getting claimRef: %v", err)
+	}
+
+	pv1 := testVolume("pv1", "1Gi")
+	pv5 := testVolume("pv5", "5Gi")
+
+	index := newPersistentVolumeOrderedIndex()
+	index.store.Add(pv1)
+	index.store.Add(pv5)
+
+	// unbound volumes are left for the scheduler to bind
+	volume, _ := index.findBestMatchForClaim(claim, true)
+	if volume != nil {
+		t.Errorf("Expected no volume but got volume %s instead", volume.Name)
+	}
+
+	// pre-bound volumes are still returned
+	pv5.Spec.ClaimRef = claimRef
+	volume, _ = index.findBestMatchForClaim(claim, true)
+	if volume == nil || volume.Name != pv5.Name {
+		t.Errorf("Expected %s but got volume %v instead", pv5.Name, volume)
+	}
+}
+
+func TestFindingVolumesWithVolumeMode(t *testing.T) {
+	block := v1.PersistentVolumeBlock
+	filesystem := v1.PersistentVolumeFilesystem
+	claim := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "claim01",
+			Namespace: "myns",
+			SelfLink:  testapi.Default.SelfLink("pvc", ""),
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Resources:   v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceName(v1.ResourceStorage): resource.MustParse("1Gi")}},
+			VolumeMode:  &block,
+		},
+	}
+
+	pvDefault := testVolume("pv-default", "1Gi")
+	pvFilesystem := testVolume("pv-filesystem", "1Gi")
+	pvFilesystem.Spec.VolumeMode = &filesystem
+	pvBlock := testVolume("pv-block", "5Gi")
+	pvBlock.Spec.VolumeMode = &block
+
+	index := newPersistentVolumeOrderedIndex()
+	index.store.Add(pvDefault)
+	index.store.Add(pvFilesystem)
+	index.store.Add(pvBlock)
+
+	// volume modes are ignored while the feature is disabled
+	utilfeature.DefaultFeatureGate.Set("BlockVolume=false")
+	volume, _ := index.findBestMatchForClaim(claim, false)
+	if volume == nil || volume.Name == pvBlock.Name {
+		t.Errorf("Expected a 1Gi volume but got volume %v instead", volume)
+	}
+
+	utilfeature.DefaultFeatureGate.Set("BlockVolume=true")
+	defer utilfeature.DefaultFeatureGate.Set("BlockVolume=false")
+
+	// block claims only match block volumes
+	volume, _ = index.findBestMatchForClaim(claim, false)
+	if volume == nil || volume.Name != pvBlock.Name {
+		t.Errorf("Expected %s but got volume %v instead", pvBlock.Name, volume)
+	}
+
+	// claims without a volume mode match filesystem volumes
+	claim.Spec.VolumeMode = nil
+	volume, _ = index.findBestMatchForClaim(claim, false)
+	if volume == nil || volume.Name == pvBlock.Name {
+		t.Errorf("Expected a filesystem volume but got volume %v instead", volume)
+	}
+}
+
 // byCapacity is used to order volumes by ascending storage size
 type byCapacity struct {
 	volumes []*v1.PersistentVolume
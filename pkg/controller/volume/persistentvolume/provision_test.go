@@ -21,8 +21,12 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/kubernetes/pkg/api/v1"
 	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+	vol "k8s.io/kubernetes/pkg/volume"
+	volumetest "k8s.io/kubernetes/pkg/volume/testing"
 )
 
 var class1Parameters = map[string]string{
@@ -427,3 +431,63 @@ func TestDisablingDynamicProvisioner(t *testing.T) {
 		t.Errorf("Expected nil return but got %v", retVal)
 	}
 }
+
+func TestFindSnapshotHandle(t *testing.T) {
+	newSnapshot := func(name, contentName string, ready bool) *storage.VolumeSnapshot {
+		return &storage.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, UID: "snapshot-uid-" + types.UID(name)},
+			Spec:       storage.VolumeSnapshotSpec{PersistentVolumeClaimName: "claim", SnapshotContentName: contentName},
+			Status:     storage.VolumeSnapshotStatus{Ready: ready},
+		}
+	}
+	newContent := func(name, snapshotter, snapshotName string) *storage.VolumeSnapshotContent {
+		return &storage.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: storage.VolumeSnapshotContentSpec{
+				Snapshotter:       snapshotter,
+				SnapshotHandle:    "handle-" + name,
+				VolumeSnapshotRef: &v1.ObjectReference{Namespace: testNamespace, Name: snapshotName, UID: "snapshot-uid-" + types.UID(snapshotName)},
+			},
+		}
+	}
+	client := fake.NewSimpleClientset(
+		newSnapshot("ready", "content-ready", true),
+		newContent("content-ready", "fake-plugin", "ready"),
+		newSnapshot("not-ready", "", false),
+		newSnapshot("other-plugin", "content-other-plugin", true),
+		newContent("content-other-plugin", "kubernetes.io/other", "other-plugin"),
+		newSnapshot("unbound", "content-unbound", true),
+		newContent("content-unbound", "fake-plugin", "other"),
+	)
+	ctrl := newTestController(client, nil, true)
+	snapshottable := &volumetest.FakeVolumePlugin{PluginName: "fake-plugin"}
+
+	tests := []struct {
+		name           string
+		snapshot       string
+		plugin         vol.ProvisionableVolumePlugin
+		expectedHandle string
+		expectErr      bool
+	}{
+		{"ready snapshot", "ready", snapshottable, "handle-content-ready", false},
+		{"plugin can not restore snapshots", "ready", &mockVolumePlugin{}, "", true},
+		{"missing snapshot", "missing", snapshottable, "", true},
+		{"snapshot not ready", "not-ready", snapshottable, "", true},
+		{"snapshot of another plugin", "other-plugin", snapshottable, "", true},
+		{"content bound to another snapshot", "unbound", snapshottable, "", true},
+	}
+	for _, test := range tests {
+		claim := newClaim("claim", "uid", "1Gi", "", v1.ClaimPending, &classGold)
+		claim.Spec.DataSource = &v1.TypedLocalObjectReference{Kind: "VolumeSnapshot", Name: test.snapshot}
+		handle, err := ctrl.findSnapshotHandle(claim, test.plugin)
+		if err != nil && !test.expectErr {
+			t.Errorf("Test %q: unexpected error: %v", test.name, err)
+		}
+		if err == nil && test.expectErr {
+			t.Errorf("Test %q: expected error, got handle %q", test.name, handle)
+		}
+		if handle != test.expectedHandle {
+			t.Errorf("Test %q: expected handle %q, got %q", test.name, test.expectedHandle, handle)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -33,6 +34,7 @@ import (
 	corelisters "k8s.io/kubernetes/pkg/client/listers/core/v1"
 	storagelisters "k8s.io/kubernetes/pkg/client/listers/storage/v1beta1"
 	"k8s.io/kubernetes/pkg/cloudprovider"
+	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/util/goroutinemap"
 	"k8s.io/kubernetes/pkg/util/goroutinemap/exponentialbackoff"
 	vol "k8s.io/kubernetes/pkg/volume"
@@ -225,6 +227,30 @@ func (ctrl *PersistentVolumeController) syncClaim(claim *v1.PersistentVolumeClai
 	}
 }
 
+// shouldDelayBinding returns true if binding of the claim should be left to
+// the scheduler, i.e. the claim's StorageClass requests WaitForFirstConsumer
+// volume binding mode. It is used by both the PV controller and the scheduler
+// volume binder.
+func shouldDelayBinding(claim *v1.PersistentVolumeClaim, classLister storagelisters.StorageClassLister) (bool, error) {
+	className := v1.GetPersistentVolumeClaimClass(claim)
+	if className == "" {
+		return false, nil
+	}
+
+	class, err := classLister.Get(className)
+	if err != nil {
+		return false, nil
+	}
+
+	if class.VolumeBindingMode == nil {
+		return false, fmt.Errorf("VolumeBindingMode not set for StorageClass %q", className)
+	}
+
+	// TODO: add check to handle dynamic provisioning later
+
+	return *class.VolumeBindingMode == storage.VolumeBindingWaitForFirstConsumer, nil
+}
+
 // syncUnboundClaim is the main controller method to decide what to do with an
 // unbound claim.
 func (ctrl *PersistentVolumeController) syncUnboundClaim(claim *v1.PersistentVolumeClaim) error {
@@ -233,8 +259,17 @@ func (ctrl *PersistentVolumeController) syncUnboundClaim(claim *v1.PersistentVol
 	if claim.Spec.VolumeName == "" {
 		// User did not care which PV they get.
 
+		delayBinding := false
+		if utilfeature.DefaultFeatureGate.Enabled(features.VolumeScheduling) {
+			var err error
+			delayBinding, err = shouldDelayBinding(claim, ctrl.classLister)
+			if err != nil {
+				return err
+			}
+		}
+
 		// [Unit test set 1]
-		volume, err := ctrl.volumes.findBestMatchForClaim(claim)
+		volume, err := ctrl.volumes.findBestMatchForClaim(claim, delayBinding)
 		if err != nil {
 			glog.V(2).Infof("synchronizing unbound PersistentVolumeClaim[%s]: Error finding PV for claim: %v", claimToClaimKey(claim), err)
 			return fmt.Errorf("Error finding PV for claim %q: %v", claimToClaimKey(claim), err)
@@ -243,6 +278,15 @@ func (ctrl *PersistentVolumeController) syncUnboundClaim(claim *v1.PersistentVol
 			glog.V(4).Infof("synchronizing unbound PersistentVolumeClaim[%s]: no volume found", claimToClaimKey(claim))
 			// No PV could be found
 			// OBSERVATION: pvc is "Pending", will retry
+			if delayBinding {
+				// The scheduler will bind the claim once a pod using it
+				// has been assigned to a node.
+				ctrl.eventRecorder.Event(claim, v1.EventTypeNormal, "WaitForFirstConsumer", "waiting for first consumer to be created before binding")
+				if _, err = ctrl.updateClaimStatus(claim, v1.ClaimPending, nil); err != nil {
+					return err
+				}
+				return nil
+			}
 			if v1.GetPersistentVolumeClaimClass(claim) != "" || metav1.HasAnnotation(claim.ObjectMeta, v1.AlphaStorageClassAnnotation) {
 				if err = ctrl.provisionClaim(claim); err != nil {
 					return err
@@ -291,6 +335,16 @@ func (ctrl *PersistentVolumeController) syncUnboundClaim(claim *v1.PersistentVol
 				return fmt.Errorf("Cannot convert object from volume cache to volume %q!?: %+v", claim.Spec.VolumeName, obj)
 			}
 			glog.V(4).Infof("synchronizing unbound PersistentVolumeClaim[%s]: volume %q requested and found: %s", claimToClaimKey(claim), claim.Spec.VolumeName, getVolumeStatusForLogging(volume))
+			if checkVolumeModeMismatches(&claim.Spec, &volume.Spec) {
+				// User asked for a PV in a different volume mode
+				// OBSERVATION: pvc is "Pending"
+				glog.V(4).Infof("synchronizing unbound PersistentVolumeClaim[%s]: volume mode of volume %q does not match the claim, will try again next time", claimToClaimKey(claim), claim.Spec.VolumeName)
+				ctrl.eventRecorder.Event(claim, v1.EventTypeWarning, "VolumeMismatch", "Cannot bind to requested volume: volume mode does not match")
+				if _, err = ctrl.updateClaimStatus(claim, v1.ClaimPending, nil); err != nil {
+					return err
+				}
+				return nil
+			}
 			if volume.Spec.ClaimRef == nil {
 				// User asked for a PV that is not claimed
 				// OBSERVATION: pvc is "Pending", pv is "Available"
@@ -722,6 +776,36 @@ func (ctrl *PersistentVolumeController) updateVolumePhaseWithEvent(volume *v1.Pe
 func (ctrl *PersistentVolumeController) bindVolumeToClaim(volume *v1.PersistentVolume, claim *v1.PersistentVolumeClaim) (*v1.PersistentVolume, error) {
 	glog.V(4).Infof("updating PersistentVolume[%s]: binding to %q", volume.Name, claimToClaimKey(claim))
 
+	volumeClone, dirty, err := getBindVolumeToClaim(volume, claim)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save the volume only if something was changed
+	if dirty {
+		glog.V(2).Infof("claim %q bound to volume %q", claimToClaimKey(claim), volume.Name)
+		newVol, err := ctrl.kubeClient.Core().PersistentVolumes().Update(volumeClone)
+		if err != nil {
+			glog.V(4).Infof("updating PersistentVolume[%s]: binding to %q failed: %v", volume.Name, claimToClaimKey(claim), err)
+			return newVol, err
+		}
+		_, err = ctrl.storeVolumeUpdate(newVol)
+		if err != nil {
+			glog.V(4).Infof("updating PersistentVolume[%s]: cannot update internal cache: %v", volume.Name, err)
+			return newVol, err
+		}
+		glog.V(4).Infof("updating PersistentVolume[%s]: bound to %q", newVol.Name, claimToClaimKey(claim))
+		return newVol, nil
+	}
+
+	glog.V(4).Infof("updating PersistentVolume[%s]: already bound to %q", volume.Name, claimToClaimKey(claim))
+	return volume, nil
+}
+
+// getBindVolumeToClaim returns a new volume which is bound to given claim. In
+// addition, it returns a bool which indicates whether we made modification on
+// original volume.
+func getBindVolumeToClaim(volume *v1.PersistentVolume, claim *v1.PersistentVolumeClaim) (*v1.PersistentVolume, bool, error) {
 	dirty := false
 
 	// Check if the volume was already bound (either by user or by controller)
@@ -734,11 +818,11 @@ func (ctrl *PersistentVolumeController) bindVolumeToClaim(volume *v1.PersistentV
 	// modify these, therefore create a copy.
 	clone, err := api.Scheme.DeepCopy(volume)
 	if err != nil {
-		return nil, fmt.Errorf("Error cloning pv: %v", err)
+		return nil, false, fmt.Errorf("Error cloning pv: %v", err)
 	}
 	volumeClone, ok := clone.(*v1.PersistentVolume)
 	if !ok {
-		return nil, fmt.Errorf("Unexpected volume cast error : %v", volumeClone)
+		return nil, false, fmt.Errorf("Unexpected volume cast error : %v", volumeClone)
 	}
 
 	// Bind the volume to the claim if it is not bound yet
@@ -749,7 +833,7 @@ func (ctrl *PersistentVolumeController) bindVolumeToClaim(volume *v1.PersistentV
 
 		claimRef, err := v1.GetReference(api.Scheme, claim)
 		if err != nil {
-			return nil, fmt.Errorf("Unexpected error getting claim reference: %v", err)
+			return nil, false, fmt.Errorf("Unexpected error getting claim reference: %v", err)
 		}
 		volumeClone.Spec.ClaimRef = claimRef
 		dirty = true
@@ -761,25 +845,7 @@ func (ctrl *PersistentVolumeController) bindVolumeToClaim(volume *v1.PersistentV
 		dirty = true
 	}
 
-	// Save the volume only if something was changed
-	if dirty {
-		glog.V(2).Infof("claim %q bound to volume %q", claimToClaimKey(claim), volume.Name)
-		newVol, err := ctrl.kubeClient.Core().PersistentVolumes().Update(volumeClone)
-		if err != nil {
-			glog.V(4).Infof("updating PersistentVolume[%s]: binding to %q failed: %v", volume.Name, claimToClaimKey(claim), err)
-			return newVol, err
-		}
-		_, err = ctrl.storeVolumeUpdate(newVol)
-		if err != nil {
-			glog.V(4).Infof("updating PersistentVolume[%s]: cannot update internal cache: %v", volume.Name, err)
-			return newVol, err
-		}
-		glog.V(4).Infof("updating PersistentVolume[%s]: bound to %q", newVol.Name, claimToClaimKey(claim))
-		return newVol, nil
-	}
-
-	glog.V(4).Infof("updating PersistentVolume[%s]: already bound to %q", volume.Name, claimToClaimKey(claim))
-	return volume, nil
+	return volumeClone, dirty, nil
 }
 
 // bindClaimToVolume modifies the given claim to be bound to a volume and
@@ -1297,6 +1363,17 @@ func (ctrl *PersistentVolumeController) provisionClaimOperation(claimObj interfa
 		Parameters:                    storageClass.Parameters,
 	}
 
+	if claim.Spec.DataSource != nil && utilfeature.DefaultFeatureGate.Enabled(features.VolumeSnapshotDataSource) {
+		handle, err := ctrl.findSnapshotHandle(claim, plugin)
+		if err != nil {
+			strerr := fmt.Sprintf("Failed to provision volume from data source %q: %v", claim.Spec.DataSource.Name, err)
+			glog.V(2).Infof("failed to find snapshot for claim %q: %v", claimToClaimKey(claim), err)
+			ctrl.eventRecorder.Event(claim, v1.EventTypeWarning, "ProvisioningFailed", strerr)
+			return
+		}
+		options.SnapshotHandle = handle
+	}
+
 	// Provision the volume
 	provisioner, err := plugin.NewProvisioner(options)
 	if err != nil {
@@ -1471,6 +1548,33 @@ func (ctrl *PersistentVolumeController) findProvisionablePlugin(claim *v1.Persis
 	return plugin, class, nil
 }
 
+// findSnapshotHandle returns the handle of the snapshot the claim's data
+// source refers to.  The snapshot must be ready and must have been taken by
+// the given plugin, which restores it into the provisioned volume.
+func (ctrl *PersistentVolumeController) findSnapshotHandle(claim *v1.PersistentVolumeClaim, plugin vol.ProvisionableVolumePlugin) (string, error) {
+	if _, ok := plugin.(vol.SnapshottableVolumePlugin); !ok {
+		return "", fmt.Errorf("volume plugin %q does not support provisioning from snapshots", plugin.GetPluginName())
+	}
+	snapshot, err := ctrl.kubeClient.StorageV1beta1().VolumeSnapshots(claim.Namespace).Get(claim.Spec.DataSource.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if !snapshot.Status.Ready || snapshot.Spec.SnapshotContentName == "" {
+		return "", fmt.Errorf("snapshot %q is not ready", snapshot.Name)
+	}
+	content, err := ctrl.kubeClient.StorageV1beta1().VolumeSnapshotContents().Get(snapshot.Spec.SnapshotContentName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if ref := content.Spec.VolumeSnapshotRef; ref == nil || ref.UID != snapshot.UID {
+		return "", fmt.Errorf("snapshot content %q is not bound to snapshot %q", content.Name, snapshot.Name)
+	}
+	if content.Spec.Snapshotter != plugin.GetPluginName() {
+		return "", fmt.Errorf("snapshot %q was taken by %q and can not be restored by %q", snapshot.Name, content.Spec.Snapshotter, plugin.GetPluginName())
+	}
+	return content.Spec.SnapshotHandle, nil
+}
+
 // findAlphaProvisionablePlugin returns a volume plugin compatible with
 // Kubernetes 1.3.
 // TODO: remove in Kubernetes 1.5
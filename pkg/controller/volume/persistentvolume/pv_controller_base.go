@@ -92,7 +92,7 @@ func NewController(p ControllerParameters) *PersistentVolumeController {
 		volumeQueue:                   workqueue.NewNamed("volumes"),
 	}
 
-	controller.volumePluginMgr.InitPlugins(p.VolumePlugins, controller)
+	controller.volumePluginMgr.InitPlugins(p.VolumePlugins, nil /* prober */, controller)
 	if controller.alphaProvisioner != nil {
 		if err := controller.alphaProvisioner.Init(controller); err != nil {
 			glog.Errorf("PersistentVolumeController: error initializing alpha provisioner plugin: %v", err)
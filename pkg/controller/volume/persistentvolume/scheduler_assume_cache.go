@@ -0,0 +1,129 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/glog"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+// pvAssumeCache is a cache on top of the PV informer which lets the
+// scheduler record the PVs it pre-binds before the informer sees the updates,
+// so that the following pods are not matched against the same PVs.
+//
+// An assumed PV is dropped as soon as the informer has a different version of
+// it, i.e. the update or a later change was observed, or when it is restored
+// because the update failed.
+type pvAssumeCache struct {
+	// store is the store of the PV informer.
+	store cache.Store
+
+	// mutex protects assumed.
+	mutex sync.Mutex
+	// assumed holds the assumed PVs, keyed by name.
+	assumed map[string]*v1.PersistentVolume
+}
+
+func newPVAssumeCache(store cache.Store) *pvAssumeCache {
+	return &pvAssumeCache{
+		store:   store,
+		assumed: map[string]*v1.PersistentVolume{},
+	}
+}
+
+// latest returns the assumed version of pv, if it has one that was made from
+// the version of pv in the informer, and pv otherwise.
+func (c *pvAssumeCache) latest(pv *v1.PersistentVolume) *v1.PersistentVolume {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	assumed, ok := c.assumed[pv.Name]
+	if !ok {
+		return pv
+	}
+	if assumed.ResourceVersion != pv.ResourceVersion {
+		glog.V(4).Infof("pvAssumeCache: informer has a new version of PV %q, dropping the assumed one", pv.Name)
+		delete(c.assumed, pv.Name)
+		return pv
+	}
+	return assumed
+}
+
+// GetPV returns the latest version of the named PV.
+func (c *pvAssumeCache) GetPV(name string) (*v1.PersistentVolume, error) {
+	obj, exists, err := c.store.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		c.Restore(name)
+		return nil, fmt.Errorf("PV %q not found", name)
+	}
+	pv, ok := obj.(*v1.PersistentVolume)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object %#v to PV", obj)
+	}
+	return c.latest(pv), nil
+}
+
+// ListPVs returns the latest version of all the PVs.
+func (c *pvAssumeCache) ListPVs() []*v1.PersistentVolume {
+	pvs := []*v1.PersistentVolume{}
+	for _, obj := range c.store.List() {
+		pv, ok := obj.(*v1.PersistentVolume)
+		if !ok {
+			glog.Errorf("pvAssumeCache: cannot convert object %#v to PV", obj)
+			continue
+		}
+		pvs = append(pvs, c.latest(pv))
+	}
+	return pvs
+}
+
+// Assume records pv as the latest version of the PV until the informer sees a
+// new one. pv must have been made from the version in the informer.
+func (c *pvAssumeCache) Assume(pv *v1.PersistentVolume) error {
+	stored, err := c.GetPV(pv.Name)
+	if err != nil {
+		return err
+	}
+	if stored.ResourceVersion != pv.ResourceVersion {
+		return fmt.Errorf("PV %q is out of sync: version %q, cached version %q", pv.Name, pv.ResourceVersion, stored.ResourceVersion)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.assumed[pv.Name] = pv
+	glog.V(4).Infof("pvAssumeCache: assumed PV %q, version %q", pv.Name, pv.ResourceVersion)
+	return nil
+}
+
+// Restore drops the assumed version of the named PV, if any.
+func (c *pvAssumeCache) Restore(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.assumed[name]; ok {
+		delete(c.assumed, name)
+		glog.V(4).Infof("pvAssumeCache: restored PV %q", name)
+	}
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	coreinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/core/v1"
+	storageinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/storage/v1beta1"
+	corelisters "k8s.io/kubernetes/pkg/client/listers/core/v1"
+	storagelisters "k8s.io/kubernetes/pkg/client/listers/storage/v1beta1"
+	volumeutil "k8s.io/kubernetes/pkg/volume/util"
+)
+
+// SchedulerVolumeBinder is used by the scheduler to handle PVC/PV binding
+// and dynamic provisioning.  The binding decisions are integrated into the pod scheduling
+// workflow so that the PV NodeAffinity is also considered along with the pod's other
+// scheduling requirements.
+//
+// This integrates into the existing default scheduler workflow as follows:
+// 1. The scheduler takes a Pod off the scheduler queue and processes it serially:
+//    a. Invokes all predicate functions, parallelized across nodes.  FindPodVolumes() is invoked here.
+//    b. Invokes all priority functions.
+//    c. Selects the best node for the Pod.
+//    d. Binds the Pod's volumes.  BindPodVolumes() is invoked here.
+//    e. Binds the Pod to the selected node.
+// 2. The PV controller completes the binding of the PVCs that were pre-bound
+//    to PVs in step 1d.
+type SchedulerVolumeBinder interface {
+	// FindPodVolumes checks if all of a Pod's PVCs can be satisfied by the node.
+	//
+	// If a PVC is bound, it checks if the PV's NodeAffinity matches the Node.
+	// Otherwise, it tries to find an available PV to bind to the PVC.
+	//
+	// It returns true if there are matching PVs that can satisfy all of the Pod's PVCs, and returns true
+	// if bound volumes satisfy the PV NodeAffinity.
+	//
+	// This function is called by the volume binding scheduler predicate and can be called in parallel
+	FindPodVolumes(pod *v1.Pod, node *v1.Node) (unboundVolumesSatisfied, boundVolumesSatisfied bool, err error)
+
+	// BindPodVolumes finds matching PVs for the Pod's unbound PVCs on the
+	// node the Pod was assumed to, and pre-binds them by setting PV.ClaimRef.
+	// The PV controller then completes the binding.
+	//
+	// This function is called serially by the scheduler after a node has been
+	// chosen for the Pod.
+	BindPodVolumes(assumedPod *v1.Pod) error
+}
+
+type volumeBinder struct {
+	kubeClient  clientset.Interface
+	classLister storagelisters.StorageClassLister
+	nodeLister  corelisters.NodeLister
+	pvcLister   corelisters.PersistentVolumeClaimLister
+	// pvCache holds the PVs pre-bound by BindPodVolumes until the informer
+	// sees the updates.
+	pvCache *pvAssumeCache
+}
+
+// bindingInfo holds a binding between PV and PVC.
+type bindingInfo struct {
+	pvc *v1.PersistentVolumeClaim
+	pv  *v1.PersistentVolume
+}
+
+// NewVolumeBinder sets up all the caches needed for the scheduler to make volume binding decisions.
+func NewVolumeBinder(
+	kubeClient clientset.Interface,
+	pvcInformer coreinformers.PersistentVolumeClaimInformer,
+	pvInformer coreinformers.PersistentVolumeInformer,
+	nodeInformer coreinformers.NodeInformer,
+	storageClassInformer storageinformers.StorageClassInformer) SchedulerVolumeBinder {
+
+	return &volumeBinder{
+		kubeClient:  kubeClient,
+		classLister: storageClassInformer.Lister(),
+		nodeLister:  nodeInformer.Lister(),
+		pvcLister:   pvcInformer.Lister(),
+		pvCache:     newPVAssumeCache(pvInformer.Informer().GetStore()),
+	}
+}
+
+// FindPodVolumes implements SchedulerVolumeBinder.FindPodVolumes.
+func (b *volumeBinder) FindPodVolumes(pod *v1.Pod, node *v1.Node) (unboundVolumesSatisfied, boundVolumesSatisfied bool, err error) {
+	podName := getPodName(pod)
+
+	glog.V(4).Infof("FindPodVolumes for pod %q, node %q", podName, node.Name)
+
+	// Initialize to true for pods that don't have volumes
+	unboundVolumesSatisfied = true
+	boundVolumesSatisfied = true
+
+	boundClaims, claimsToBind, err := b.getPodVolumes(pod)
+	if err != nil {
+		return false, false, err
+	}
+
+	// Check PV node affinity on bound volumes
+	if len(boundClaims) > 0 {
+		boundVolumesSatisfied, err = b.checkBoundClaims(boundClaims, node, podName)
+		if err != nil {
+			return false, false, err
+		}
+	}
+
+	// Find PVs for unbound volumes
+	if len(claimsToBind) > 0 {
+		_, unboundVolumesSatisfied, err = b.findMatchingVolumes(claimsToBind, node, podName)
+		if err != nil {
+			return false, false, err
+		}
+	}
+
+	return unboundVolumesSatisfied, boundVolumesSatisfied, nil
+}
+
+// BindPodVolumes implements SchedulerVolumeBinder.BindPodVolumes.
+func (b *volumeBinder) BindPodVolumes(assumedPod *v1.Pod) error {
+	podName := getPodName(assumedPod)
+
+	glog.V(4).Infof("BindPodVolumes for pod %q, node %q", podName, assumedPod.Spec.NodeName)
+
+	_, claimsToBind, err := b.getPodVolumes(assumedPod)
+	if err != nil {
+		return err
+	}
+	if len(claimsToBind) == 0 {
+		return nil
+	}
+
+	node, err := b.nodeLister.Get(assumedPod.Spec.NodeName)
+	if err != nil {
+		return fmt.Errorf("error getting node %q for pod %q: %v", assumedPod.Spec.NodeName, podName, err)
+	}
+
+	bindings, satisfied, err := b.findMatchingVolumes(claimsToBind, node, podName)
+	if err != nil {
+		return err
+	}
+	if !satisfied {
+		return fmt.Errorf("no matching PersistentVolumes found for pod %q on node %q", podName, node.Name)
+	}
+
+	// Assume all the bindings first, so that the PVs are not matched by
+	// other pods while they are written.
+	assumedBindings := []*bindingInfo{}
+	for _, binding := range bindings {
+		newPV, dirty, err := getBindVolumeToClaim(binding.pv, binding.pvc)
+		if err != nil {
+			b.revertAssumedPVs(assumedBindings)
+			return err
+		}
+		if !dirty {
+			continue
+		}
+		if err := b.pvCache.Assume(newPV); err != nil {
+			b.revertAssumedPVs(assumedBindings)
+			return err
+		}
+		assumedBindings = append(assumedBindings, &bindingInfo{pvc: binding.pvc, pv: newPV})
+	}
+
+	for i, binding := range assumedBindings {
+		glog.V(5).Infof("BindPodVolumes: pre-binding PV %q to PVC %q for pod %q", binding.pv.Name, getPVCName(binding.pvc), podName)
+		if _, err := b.kubeClient.Core().PersistentVolumes().Update(binding.pv); err != nil {
+			// The PVs which were not pre-bound are available again.
+			b.revertAssumedPVs(assumedBindings[i:])
+			return fmt.Errorf("error pre-binding PV %q to PVC %q: %v", binding.pv.Name, getPVCName(binding.pvc), err)
+		}
+	}
+	return nil
+}
+
+// revertAssumedPVs drops the assumed versions of the PVs of the bindings.
+func (b *volumeBinder) revertAssumedPVs(bindings []*bindingInfo) {
+	for _, binding := range bindings {
+		b.pvCache.Restore(binding.pv.Name)
+	}
+}
+
+func getPodName(pod *v1.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+func getPVCName(pvc *v1.PersistentVolumeClaim) string {
+	return pvc.Namespace + "/" + pvc.Name
+}
+
+// getPodVolumes returns a pod's PVCs separated into bound and unbound
+// (delayed binding) ones.  It returns an error if the pod references an
+// unbound PVC that is not using delayed binding, because the PV controller
+// is responsible for binding those.
+func (b *volumeBinder) getPodVolumes(pod *v1.Pod) (boundClaims, unboundClaims []*v1.PersistentVolumeClaim, err error) {
+	boundClaims = []*v1.PersistentVolumeClaim{}
+	unboundClaims = []*v1.PersistentVolumeClaim{}
+
+	for _, vol := range pod.Spec.Volumes {
+		if vol.PersistentVolumeClaim == nil {
+			continue
+		}
+		pvcName := vol.PersistentVolumeClaim.ClaimName
+		pvc, err := b.pvcLister.PersistentVolumeClaims(pod.Namespace).Get(pvcName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting PVC %q: %v", pvcName, err)
+		}
+
+		if pvc.Spec.VolumeName != "" {
+			boundClaims = append(boundClaims, pvc)
+			continue
+		}
+
+		delayBinding, err := shouldDelayBinding(pvc, b.classLister)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !delayBinding {
+			return nil, nil, fmt.Errorf("pod has unbound PersistentVolumeClaims")
+		}
+		unboundClaims = append(unboundClaims, pvc)
+	}
+	return boundClaims, unboundClaims, nil
+}
+
+func (b *volumeBinder) checkBoundClaims(claims []*v1.PersistentVolumeClaim, node *v1.Node, podName string) (bool, error) {
+	for _, pvc := range claims {
+		pvName := pvc.Spec.VolumeName
+		pv, err := b.pvCache.GetPV(pvName)
+		if err != nil {
+			return false, fmt.Errorf("error getting PV %q: %v", pvName, err)
+		}
+
+		if err := volumeutil.CheckNodeAffinity(pv, node.Labels); err != nil {
+			glog.V(4).Infof("PersistentVolume %q, Node %q mismatch for Pod %q: %v", pvName, node.Name, podName, err)
+			return false, nil
+		}
+		glog.V(5).Infof("PersistentVolume %q, Node %q matches for Pod %q", pvName, node.Name, podName)
+	}
+
+	glog.V(4).Infof("All bound volumes for Pod %q match with Node %q", podName, node.Name)
+	return true, nil
+}
+
+// findMatchingVolumes tries to find an available PV on the node for each of
+// the claims.  Each chosen PV is excluded from matching the remaining claims.
+func (b *volumeBinder) findMatchingVolumes(claimsToBind []*v1.PersistentVolumeClaim, node *v1.Node, podName string) ([]*bindingInfo, bool, error) {
+	allPVs := b.pvCache.ListPVs()
+
+	bindings := []*bindingInfo{}
+	chosenPVs := map[string]*v1.PersistentVolume{}
+
+	for _, pvc := range claimsToBind {
+		pv, err := findMatchingVolume(pvc, allPVs, node, chosenPVs, true)
+		if err != nil {
+			return nil, false, err
+		}
+		if pv == nil {
+			glog.V(4).Infof("No matching volumes for PVC %q on node %q", getPVCName(pvc), node.Name)
+			return nil, false, nil
+		}
+
+		// matching PV needs to be excluded so we don't select it again
+		chosenPVs[pv.Name] = pv
+		bindings = append(bindings, &bindingInfo{pvc: pvc, pv: pv})
+		glog.V(5).Infof("Found matching PV %q for PVC %q on node %q for pod %q", pv.Name, getPVCName(pvc), node.Name, podName)
+	}
+
+	glog.V(4).Infof("Found matching volumes for pod %q on node %q", podName, node.Name)
+	return bindings, true, nil
+}
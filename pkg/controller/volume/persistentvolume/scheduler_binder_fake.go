@@ -0,0 +1,54 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+// FakeVolumeBinderConfig holds the results returned by a fake
+// SchedulerVolumeBinder.
+type FakeVolumeBinderConfig struct {
+	FindUnboundSatisfied bool
+	FindBoundSatisfied   bool
+	FindErr              error
+	BindErr              error
+}
+
+// NewFakeVolumeBinder returns a FakeVolumeBinder that answers with the
+// results in config.
+func NewFakeVolumeBinder(config *FakeVolumeBinderConfig) *FakeVolumeBinder {
+	return &FakeVolumeBinder{
+		config: config,
+	}
+}
+
+// FakeVolumeBinder is a SchedulerVolumeBinder that returns preconfigured
+// results.
+type FakeVolumeBinder struct {
+	config     *FakeVolumeBinderConfig
+	BindCalled bool
+}
+
+func (b *FakeVolumeBinder) FindPodVolumes(pod *v1.Pod, node *v1.Node) (unboundVolumesSatisfied, boundVolumesSatisfied bool, err error) {
+	return b.config.FindUnboundSatisfied, b.config.FindBoundSatisfied, b.config.FindErr
+}
+
+func (b *FakeVolumeBinder) BindPodVolumes(assumedPod *v1.Pod) error {
+	b.BindCalled = true
+	return b.config.BindErr
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	core "k8s.io/client-go/testing"
+	"k8s.io/kubernetes/pkg/api/v1"
+	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+	informers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions"
+	"k8s.io/kubernetes/pkg/controller"
+)
+
+var (
+	waitClass      = "waitClass"
+	immediateClass = "immediateClass"
+
+	unboundPVC          = makeTestPVC("unbound-pvc", "1G", "", waitClass)
+	unboundPVC2         = makeTestPVC("unbound-pvc2", "5G", "", waitClass)
+	immediateUnboundPVC = makeTestPVC("immediate-unbound-pvc", "1G", "", immediateClass)
+	boundPVC            = makeTestPVC("bound-pvc", "1G", "pv-bound", waitClass)
+
+	pvNode1a     = makeTestPV("pv-node1a", "node1", "5G", nil, waitClass)
+	pvNode1b     = makeTestPV("pv-node1b", "node1", "10G", nil, waitClass)
+	pvNode2      = makeTestPV("pv-node2", "node2", "1G", nil, waitClass)
+	pvBoundNode1 = makeTestPV("pv-bound", "node1", "1G", boundPVC, waitClass)
+
+	node1 = makeTestNode("node1", map[string]string{nodeLabelKey: "node1"})
+	node2 = makeTestNode("node2", map[string]string{nodeLabelKey: "node2"})
+)
+
+const nodeLabelKey = "nodeKey"
+
+type testEnv struct {
+	client *fake.Clientset
+	binder SchedulerVolumeBinder
+}
+
+func newTestBinder(t *testing.T, pvcs []*v1.PersistentVolumeClaim, pvs []*v1.PersistentVolume) *testEnv {
+	client := &fake.Clientset{}
+	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+
+	nodeInformer := informerFactory.Core().V1().Nodes()
+	pvcInformer := informerFactory.Core().V1().PersistentVolumeClaims()
+	pvInformer := informerFactory.Core().V1().PersistentVolumes()
+	classInformer := informerFactory.Storage().V1beta1().StorageClasses()
+
+	binder := NewVolumeBinder(client, pvcInformer, pvInformer, nodeInformer, classInformer)
+
+	waitMode := storage.VolumeBindingWaitForFirstConsumer
+	immediateMode := storage.VolumeBindingImmediate
+	classes := []*storage.StorageClass{
+		{
+			ObjectMeta:        metav1.ObjectMeta{Name: waitClass},
+			VolumeBindingMode: &waitMode,
+		},
+		{
+			ObjectMeta:        metav1.ObjectMeta{Name: immediateClass},
+			VolumeBindingMode: &immediateMode,
+		},
+	}
+	for _, class := range classes {
+		if err := classInformer.Informer().GetIndexer().Add(class); err != nil {
+			t.Fatalf("Failed to add storage class to internal cache: %v", err)
+		}
+	}
+	for _, node := range []*v1.Node{node1, node2} {
+		if err := nodeInformer.Informer().GetIndexer().Add(node); err != nil {
+			t.Fatalf("Failed to add node to internal cache: %v", err)
+		}
+	}
+	for _, pvc := range pvcs {
+		if err := pvcInformer.Informer().GetIndexer().Add(pvc); err != nil {
+			t.Fatalf("Failed to add PVC to internal cache: %v", err)
+		}
+	}
+	for _, pv := range pvs {
+		if err := pvInformer.Informer().GetIndexer().Add(pv); err != nil {
+			t.Fatalf("Failed to add PV to internal cache: %v", err)
+		}
+	}
+
+	return &testEnv{client: client, binder: binder}
+}
+
+func makeTestPVC(name, size, pvName, className string) *v1.PersistentVolumeClaim {
+	pvc := newClaim(name, name+"-uid", size, pvName, v1.ClaimPending, &className)
+	pvc.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+	return pvc
+}
+
+func makeTestPV(name, node, capacity string, boundToPVC *v1.PersistentVolumeClaim, className string) *v1.PersistentVolume {
+	pv := newVolume(name, capacity, "", "", v1.VolumeAvailable, v1.PersistentVolumeReclaimRetain, className)
+	pv.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+	pv.Spec.NodeAffinity = &v1.VolumeNodeAffinity{
+		Required: &v1.NodeSelector{
+			NodeSelectorTerms: []v1.NodeSelectorTerm{
+				{
+					MatchExpressions: []v1.NodeSelectorRequirement{
+						{
+							Key:      nodeLabelKey,
+							Operator: v1.NodeSelectorOpIn,
+							Values:   []string{node},
+						},
+					},
+				},
+			},
+		},
+	}
+	if boundToPVC != nil {
+		pv.Spec.ClaimRef = &v1.ObjectReference{
+			Name:      boundToPVC.Name,
+			Namespace: boundToPVC.Namespace,
+			UID:       boundToPVC.UID,
+		}
+		pv.Status.Phase = v1.VolumeBound
+	}
+	return pv
+}
+
+func makeTestNode(name string, labels map[string]string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
+func makePod(pvcs []*v1.PersistentVolumeClaim) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: testNamespace,
+		},
+	}
+
+	volumes := []v1.Volume{}
+	for i, pvc := range pvcs {
+		volumes = append(volumes, v1.Volume{
+			Name: string(rune('a' + i)),
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvc.Name,
+				},
+			},
+		})
+	}
+	pod.Spec.Volumes = volumes
+	pod.Spec.NodeName = "node1"
+	return pod
+}
+
+func TestFindPodVolumes(t *testing.T) {
+	scenarios := map[string]struct {
+		podPVCs []*v1.PersistentVolumeClaim
+		pvs     []*v1.PersistentVolume
+		node    *v1.Node
+		// Do not add the pod's PVCs to the cache
+		skipPVCCache bool
+
+		expectedUnbound bool
+		expectedBound   bool
+		shouldFail      bool
+	}{
+		"no-volumes": {
+			podPVCs:         []*v1.PersistentVolumeClaim{},
+			node:            node1,
+			expectedUnbound: true,
+			expectedBound:   true,
+		},
+		"bound-pvc-node-match": {
+			podPVCs:         []*v1.PersistentVolumeClaim{boundPVC},
+			pvs:             []*v1.PersistentVolume{pvBoundNode1},
+			node:            node1,
+			expectedUnbound: true,
+			expectedBound:   true,
+		},
+		"bound-pvc-node-mismatch": {
+			podPVCs:         []*v1.PersistentVolumeClaim{boundPVC},
+			pvs:             []*v1.PersistentVolume{pvBoundNode1},
+			node:            node2,
+			expectedUnbound: true,
+			expectedBound:   false,
+		},
+		"bound-pvc-pv-not-found": {
+			podPVCs:    []*v1.PersistentVolumeClaim{boundPVC},
+			node:       node1,
+			shouldFail: true,
+		},
+		"immediate-unbound-pvc": {
+			podPVCs:    []*v1.PersistentVolumeClaim{immediateUnboundPVC},
+			node:       node1,
+			shouldFail: true,
+		},
+		"unbound-pvc-match": {
+			podPVCs:         []*v1.PersistentVolumeClaim{unboundPVC},
+			pvs:             []*v1.PersistentVolume{pvNode1a, pvNode2},
+			node:            node1,
+			expectedUnbound: true,
+			expectedBound:   true,
+		},
+		"unbound-pvc-no-match-on-node": {
+			podPVCs:         []*v1.PersistentVolumeClaim{unboundPVC},
+			pvs:             []*v1.PersistentVolume{pvNode1a},
+			node:            node2,
+			expectedUnbound: false,
+			expectedBound:   true,
+		},
+		"two-unbound-pvcs": {
+			podPVCs:         []*v1.PersistentVolumeClaim{unboundPVC, unboundPVC2},
+			pvs:             []*v1.PersistentVolume{pvNode1a, pvNode1b},
+			node:            node1,
+			expectedUnbound: true,
+			expectedBound:   true,
+		},
+		"two-unbound-pvcs-one-pv": {
+			podPVCs:         []*v1.PersistentVolumeClaim{unboundPVC, unboundPVC2},
+			pvs:             []*v1.PersistentVolume{pvNode1a},
+			node:            node1,
+			expectedUnbound: false,
+			expectedBound:   true,
+		},
+		"unbound-and-bound-pvcs": {
+			podPVCs:         []*v1.PersistentVolumeClaim{boundPVC, unboundPVC},
+			pvs:             []*v1.PersistentVolume{pvBoundNode1, pvNode1a},
+			node:            node1,
+			expectedUnbound: true,
+			expectedBound:   true,
+		},
+		"pvc-not-found": {
+			podPVCs:      []*v1.PersistentVolumeClaim{unboundPVC},
+			node:         node1,
+			skipPVCCache: true,
+			shouldFail:   true,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		pvcs := scenario.podPVCs
+		if scenario.skipPVCCache {
+			pvcs = nil
+		}
+		env := newTestBinder(t, pvcs, scenario.pvs)
+		pod := makePod(scenario.podPVCs)
+
+		unboundSatisfied, boundSatisfied, err := env.binder.FindPodVolumes(pod, scenario.node)
+		if !scenario.shouldFail && err != nil {
+			t.Errorf("Test %q failed: returned error: %v", name, err)
+		}
+		if scenario.shouldFail && err == nil {
+			t.Errorf("Test %q failed: returned success but expected error", name)
+		}
+		if err != nil {
+			continue
+		}
+		if boundSatisfied != scenario.expectedBound {
+			t.Errorf("Test %q failed: expected boundSatsified %v, got %v", name, scenario.expectedBound, boundSatisfied)
+		}
+		if unboundSatisfied != scenario.expectedUnbound {
+			t.Errorf("Test %q failed: expected unboundSatsified %v, got %v", name, scenario.expectedUnbound, unboundSatisfied)
+		}
+	}
+}
+
+func TestBindPodVolumes(t *testing.T) {
+	scenarios := map[string]struct {
+		podPVCs []*v1.PersistentVolumeClaim
+		pvs     []*v1.PersistentVolume
+
+		expectedBoundPVs map[string]string
+		shouldFail       bool
+	}{
+		"no-volumes": {
+			podPVCs:          []*v1.PersistentVolumeClaim{},
+			expectedBoundPVs: map[string]string{},
+		},
+		"bound-pvc": {
+			podPVCs:          []*v1.PersistentVolumeClaim{boundPVC},
+			pvs:              []*v1.PersistentVolume{pvBoundNode1},
+			expectedBoundPVs: map[string]string{},
+		},
+		"unbound-pvc": {
+			podPVCs:          []*v1.PersistentVolumeClaim{unboundPVC},
+			pvs:              []*v1.PersistentVolume{pvNode1a, pvNode2},
+			expectedBoundPVs: map[string]string{"pv-node1a": "unbound-pvc"},
+		},
+		"two-unbound-pvcs": {
+			podPVCs:          []*v1.PersistentVolumeClaim{unboundPVC, unboundPVC2},
+			pvs:              []*v1.PersistentVolume{pvNode1a, pvNode1b},
+			expectedBoundPVs: map[string]string{"pv-node1a": "unbound-pvc", "pv-node1b": "unbound-pvc2"},
+		},
+		"unbound-pvc-no-match": {
+			podPVCs:    []*v1.PersistentVolumeClaim{unboundPVC},
+			pvs:        []*v1.PersistentVolume{pvNode2},
+			shouldFail: true,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		env := newTestBinder(t, scenario.podPVCs, scenario.pvs)
+		boundPVs := map[string]string{}
+		env.client.AddReactor("update", "persistentvolumes", func(action core.Action) (bool, runtime.Object, error) {
+			pv := action.(core.UpdateAction).GetObject().(*v1.PersistentVolume)
+			if pv.Spec.ClaimRef != nil {
+				boundPVs[pv.Name] = pv.Spec.ClaimRef.Name
+			}
+			return true, pv, nil
+		})
+		pod := makePod(scenario.podPVCs)
+
+		err := env.binder.BindPodVolumes(pod)
+		if !scenario.shouldFail && err != nil {
+			t.Errorf("Test %q failed: returned error: %v", name, err)
+		}
+		if scenario.shouldFail && err == nil {
+			t.Errorf("Test %q failed: returned success but expected error", name)
+		}
+		if err != nil {
+			continue
+		}
+		if len(boundPVs) != len(scenario.expectedBoundPVs) {
+			t.Errorf("Test %q failed: expected %d bound PVs, got %v", name, len(scenario.expectedBoundPVs), boundPVs)
+		}
+		for pvName, pvcName := range scenario.expectedBoundPVs {
+			if boundPVs[pvName] != pvcName {
+				t.Errorf("Test %q failed: expected PV %q bound to %q, got %q", name, pvName, pvcName, boundPVs[pvName])
+			}
+		}
+	}
+}
+
+func TestBindPodVolumesAssumesPVs(t *testing.T) {
+	scenarios := map[string]struct {
+		updateErr error
+
+		expectedSatisfied bool
+	}{
+		"update-succeeds": {
+			// the PV is pre-bound to the first pod's PVC
+			expectedSatisfied: false,
+		},
+		"update-fails": {
+			// the PV is available again
+			updateErr:         fmt.Errorf("update failed"),
+			expectedSatisfied: true,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		env := newTestBinder(t, []*v1.PersistentVolumeClaim{unboundPVC, unboundPVC2}, []*v1.PersistentVolume{pvNode1a})
+		env.client.AddReactor("update", "persistentvolumes", func(action core.Action) (bool, runtime.Object, error) {
+			if scenario.updateErr != nil {
+				return true, nil, scenario.updateErr
+			}
+			return true, action.(core.UpdateAction).GetObject(), nil
+		})
+
+		err := env.binder.BindPodVolumes(makePod([]*v1.PersistentVolumeClaim{unboundPVC}))
+		if scenario.updateErr == nil && err != nil {
+			t.Errorf("Test %q failed: returned error: %v", name, err)
+		}
+		if scenario.updateErr != nil && err == nil {
+			t.Errorf("Test %q failed: returned success but expected error", name)
+		}
+
+		// The informer has not seen the update yet.
+		satisfied, _, err := env.binder.FindPodVolumes(makePod([]*v1.PersistentVolumeClaim{unboundPVC2}), node1)
+		if err != nil {
+			t.Errorf("Test %q failed: FindPodVolumes returned error: %v", name, err)
+		}
+		if satisfied != scenario.expectedSatisfied {
+			t.Errorf("Test %q failed: expected unbound volumes satisfied %v, got %v", name, scenario.expectedSatisfied, satisfied)
+		}
+	}
+}
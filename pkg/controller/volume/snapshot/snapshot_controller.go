@@ -0,0 +1,523 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshot implements a controller that takes the snapshots requested
+// by VolumeSnapshot objects through volume plugins and deletes them once the
+// VolumeSnapshot objects are gone.
+package snapshot
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"time"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	clientv1 "k8s.io/client-go/pkg/api/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/v1"
+	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	coreinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/core/v1"
+	storageinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/storage/v1beta1"
+	corelisters "k8s.io/kubernetes/pkg/client/listers/core/v1"
+	storagelisters "k8s.io/kubernetes/pkg/client/listers/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/cloudprovider"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/util/io"
+	"k8s.io/kubernetes/pkg/util/mount"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+// SnapshotController takes the snapshots requested by VolumeSnapshot objects
+// and deletes the snapshots of VolumeSnapshot objects that were deleted.
+type SnapshotController interface {
+	Run(workers int, stopCh <-chan struct{})
+}
+
+type snapshotController struct {
+	// kubeClient is the kube API client used by volumehost to communicate with
+	// the API server.
+	kubeClient clientset.Interface
+
+	// snapshotLister and contentLister are the shared listers of
+	// VolumeSnapshot and VolumeSnapshotContent objects.  Their objects should
+	// be treated as immutable.
+	snapshotLister  storagelisters.VolumeSnapshotLister
+	snapshotsSynced cache.InformerSynced
+	contentLister   storagelisters.VolumeSnapshotContentLister
+	contentsSynced  cache.InformerSynced
+
+	// pvcLister and pvLister are used to find the volume of the claim a
+	// snapshot is taken from.  Their objects should be treated as immutable.
+	pvcLister  corelisters.PersistentVolumeClaimLister
+	pvcsSynced cache.InformerSynced
+	pvLister   corelisters.PersistentVolumeLister
+	pvsSynced  cache.InformerSynced
+
+	// cloud provider used by volume host
+	cloud cloudprovider.Interface
+
+	// volumePluginMgr used to initialize and fetch volume plugins
+	volumePluginMgr volume.VolumePluginMgr
+
+	// recorder is used to record events in the API server
+	recorder record.EventRecorder
+
+	// snapshots that need to be taken
+	snapshotQueue workqueue.RateLimitingInterface
+	// contents whose snapshot may have been deleted
+	contentQueue workqueue.RateLimitingInterface
+}
+
+// NewSnapshotController returns a new instance of SnapshotController.
+func NewSnapshotController(
+	kubeClient clientset.Interface,
+	snapshotInformer storageinformers.VolumeSnapshotInformer,
+	contentInformer storageinformers.VolumeSnapshotContentInformer,
+	pvcInformer coreinformers.PersistentVolumeClaimInformer,
+	pvInformer coreinformers.PersistentVolumeInformer,
+	cloud cloudprovider.Interface,
+	plugins []volume.VolumePlugin) (SnapshotController, error) {
+
+	sc := &snapshotController{
+		kubeClient:      kubeClient,
+		cloud:           cloud,
+		snapshotLister:  snapshotInformer.Lister(),
+		snapshotsSynced: snapshotInformer.Informer().HasSynced,
+		contentLister:   contentInformer.Lister(),
+		contentsSynced:  contentInformer.Informer().HasSynced,
+		pvcLister:       pvcInformer.Lister(),
+		pvcsSynced:      pvcInformer.Informer().HasSynced,
+		pvLister:        pvInformer.Lister(),
+		pvsSynced:       pvInformer.Informer().HasSynced,
+		snapshotQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "volume_snapshot"),
+		contentQueue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "volume_snapshot_content"),
+	}
+
+	if err := sc.volumePluginMgr.InitPlugins(plugins, nil /* prober */, sc); err != nil {
+		return nil, fmt.Errorf("Could not initialize volume plugins for Snapshot Controller: %+v", err)
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(glog.Infof)
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: v1core.New(kubeClient.Core().RESTClient()).Events("")})
+	sc.recorder = eventBroadcaster.NewRecorder(api.Scheme, clientv1.EventSource{Component: "volume_snapshot"})
+
+	snapshotInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: sc.enqueueSnapshot,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Status updates are written by the controller itself, failed
+			// snapshots are retried by the rate limited queue.
+			oldSnapshot, oldOk := oldObj.(*storage.VolumeSnapshot)
+			newSnapshot, newOk := newObj.(*storage.VolumeSnapshot)
+			if oldOk && newOk && reflect.DeepEqual(oldSnapshot.Spec, newSnapshot.Spec) {
+				return
+			}
+			sc.enqueueSnapshot(newObj)
+		},
+		DeleteFunc: sc.enqueueSnapshotContent,
+	})
+	// Contents are immutable, so only their creation is of interest: it
+	// covers the snapshots deleted while the controller was not running.
+	contentInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: sc.enqueueContent,
+	})
+
+	return sc, nil
+}
+
+func (sc *snapshotController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer sc.snapshotQueue.ShutDown()
+	defer sc.contentQueue.ShutDown()
+
+	glog.Infof("Starting snapshot controller")
+	defer glog.Infof("Shutting down snapshot controller")
+
+	if !cache.WaitForCacheSync(stopCh, sc.snapshotsSynced, sc.contentsSynced, sc.pvcsSynced, sc.pvsSynced) {
+		utilruntime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(sc.snapshotWorker, time.Second, stopCh)
+		go wait.Until(sc.contentWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+func (sc *snapshotController) enqueueSnapshot(obj interface{}) {
+	snapshot, ok := obj.(*storage.VolumeSnapshot)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("unexpected object type: %v", obj))
+		return
+	}
+	if snapshot.Status.Ready {
+		return
+	}
+	key, err := controller.KeyFunc(snapshot)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", snapshot, err))
+		return
+	}
+	sc.snapshotQueue.Add(key)
+}
+
+// enqueueSnapshotContent queues the content bound to a deleted snapshot.
+func (sc *snapshotController) enqueueSnapshotContent(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	snapshot, ok := obj.(*storage.VolumeSnapshot)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("unexpected object type: %v", obj))
+		return
+	}
+	if len(snapshot.Spec.SnapshotContentName) > 0 {
+		sc.contentQueue.Add(snapshot.Spec.SnapshotContentName)
+	}
+}
+
+func (sc *snapshotController) enqueueContent(obj interface{}) {
+	content, ok := obj.(*storage.VolumeSnapshotContent)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("unexpected object type: %v", obj))
+		return
+	}
+	sc.contentQueue.Add(content.Name)
+}
+
+func (sc *snapshotController) snapshotWorker() {
+	for processNextWorkItem(sc.snapshotQueue, sc.syncSnapshot) {
+	}
+}
+
+func (sc *snapshotController) contentWorker() {
+	for processNextWorkItem(sc.contentQueue, sc.syncContent) {
+	}
+}
+
+func processNextWorkItem(queue workqueue.RateLimitingInterface, sync func(key string) error) bool {
+	key, quit := queue.Get()
+	if quit {
+		return false
+	}
+	defer queue.Done(key)
+
+	err := sync(key.(string))
+	if err == nil {
+		queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("error syncing volume snapshot %q: %v", key, err))
+	queue.AddRateLimited(key)
+	return true
+}
+
+// getContentNameForSnapshot returns the deterministic name of the content
+// holding the snapshot, so that a snapshot taken before a failed binding is
+// found again on the next sync.
+func getContentNameForSnapshot(snapshot *storage.VolumeSnapshot) string {
+	return "snapcontent-" + string(snapshot.UID)
+}
+
+// syncSnapshot takes the snapshot requested by a VolumeSnapshot, records it
+// in a VolumeSnapshotContent and binds the two objects.
+func (sc *snapshotController) syncSnapshot(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	snapshot, err := sc.snapshotLister.VolumeSnapshots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		glog.V(4).Infof("snapshot %q has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if snapshot.Status.Ready {
+		return nil
+	}
+
+	contentName := snapshot.Spec.SnapshotContentName
+	if len(contentName) == 0 {
+		contentName = getContentNameForSnapshot(snapshot)
+	}
+	content, err := sc.contentLister.Get(contentName)
+	if apierrors.IsNotFound(err) {
+		if len(snapshot.Spec.SnapshotContentName) > 0 {
+			sc.recorder.Eventf(snapshot, v1.EventTypeWarning, "SnapshotContentMissing", "snapshot content %q is missing", contentName)
+			return nil
+		}
+		content, err = sc.takeSnapshot(snapshot, contentName)
+	}
+	if err != nil {
+		return err
+	}
+	if content.Spec.VolumeSnapshotRef == nil || content.Spec.VolumeSnapshotRef.UID != snapshot.UID {
+		return fmt.Errorf("snapshot content %q is not bound to snapshot %q", content.Name, key)
+	}
+	return sc.bindSnapshot(snapshot, content)
+}
+
+// takeSnapshot snapshots the volume bound to the claim of the snapshot and
+// creates the content holding it.
+func (sc *snapshotController) takeSnapshot(snapshot *storage.VolumeSnapshot, contentName string) (*storage.VolumeSnapshotContent, error) {
+	key := snapshot.Namespace + "/" + snapshot.Name
+	pvc, err := sc.pvcLister.PersistentVolumeClaims(snapshot.Namespace).Get(snapshot.Spec.PersistentVolumeClaimName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting claim %q of snapshot %q: %v", snapshot.Spec.PersistentVolumeClaimName, key, err)
+	}
+	if pvc.Status.Phase != v1.ClaimBound {
+		return nil, fmt.Errorf("claim %q of snapshot %q is not bound", pvc.Name, key)
+	}
+	pv, err := sc.pvLister.Get(pvc.Spec.VolumeName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting volume %q bound to claim %q: %v", pvc.Spec.VolumeName, pvc.Name, err)
+	}
+	if pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.UID != pvc.UID {
+		return nil, fmt.Errorf("volume %q is not bound to claim %q", pv.Name, pvc.Name)
+	}
+
+	spec := volume.NewSpecFromPersistentVolume(pv, false)
+	plugin, err := sc.volumePluginMgr.FindSnapshottablePluginBySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if plugin == nil {
+		err := fmt.Errorf("volume %q does not support snapshots", pv.Name)
+		sc.recorder.Event(snapshot, v1.EventTypeWarning, "SnapshotCreationFailed", err.Error())
+		if updateErr := sc.updateSnapshotError(snapshot, err); updateErr != nil {
+			glog.V(4).Infof("error updating status of snapshot %q: %v", key, updateErr)
+		}
+		return nil, err
+	}
+
+	handle, err := plugin.CreateSnapshot(spec, contentName)
+	if err != nil {
+		err = fmt.Errorf("error taking snapshot of volume %q: %v", pv.Name, err)
+		sc.recorder.Event(snapshot, v1.EventTypeWarning, "SnapshotCreationFailed", err.Error())
+		if updateErr := sc.updateSnapshotError(snapshot, err); updateErr != nil {
+			glog.V(4).Infof("error updating status of snapshot %q: %v", key, updateErr)
+		}
+		return nil, err
+	}
+	glog.V(2).Infof("took snapshot %q of volume %q for snapshot %q", handle, pv.Name, key)
+
+	snapshotRef, err := v1.GetReference(api.Scheme, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	content := &storage.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: contentName,
+		},
+		Spec: storage.VolumeSnapshotContentSpec{
+			Snapshotter:          plugin.GetPluginName(),
+			SnapshotHandle:       handle,
+			PersistentVolumeName: pv.Name,
+			VolumeSnapshotRef:    snapshotRef,
+		},
+	}
+	newContent, err := sc.kubeClient.StorageV1beta1().VolumeSnapshotContents().Create(content)
+	if err != nil {
+		// Do not leak the snapshot, it is taken again on the next sync.
+		if deleteErr := plugin.DeleteSnapshot(handle); deleteErr != nil {
+			glog.Errorf("error deleting snapshot %q of volume %q: %v", handle, pv.Name, deleteErr)
+		}
+		return nil, fmt.Errorf("error creating snapshot content %q: %v", contentName, err)
+	}
+	return newContent, nil
+}
+
+// bindSnapshot points the snapshot to its content and marks it ready.
+func (sc *snapshotController) bindSnapshot(snapshot *storage.VolumeSnapshot, content *storage.VolumeSnapshotContent) error {
+	clone, err := api.Scheme.DeepCopy(snapshot)
+	if err != nil {
+		return fmt.Errorf("error cloning snapshot %q: %v", snapshot.Name, err)
+	}
+	snapshotClone, ok := clone.(*storage.VolumeSnapshot)
+	if !ok {
+		return fmt.Errorf("unexpected snapshot cast error: %v", clone)
+	}
+	if snapshotClone.Spec.SnapshotContentName != content.Name {
+		snapshotClone.Spec.SnapshotContentName = content.Name
+		snapshotClone, err = sc.kubeClient.StorageV1beta1().VolumeSnapshots(snapshot.Namespace).Update(snapshotClone)
+		if err != nil {
+			return fmt.Errorf("error binding snapshot %q to content %q: %v", snapshot.Name, content.Name, err)
+		}
+	}
+
+	creationTime := content.CreationTimestamp
+	snapshotClone.Status = storage.VolumeSnapshotStatus{
+		CreationTime: &creationTime,
+		Ready:        true,
+	}
+	if _, err := sc.kubeClient.StorageV1beta1().VolumeSnapshots(snapshot.Namespace).UpdateStatus(snapshotClone); err != nil {
+		return fmt.Errorf("error marking snapshot %q ready: %v", snapshot.Name, err)
+	}
+	sc.recorder.Eventf(snapshot, v1.EventTypeNormal, "SnapshotCreated", "snapshot of claim %q has been taken", snapshot.Spec.PersistentVolumeClaimName)
+	return nil
+}
+
+func (sc *snapshotController) updateSnapshotError(snapshot *storage.VolumeSnapshot, snapshotErr error) error {
+	clone, err := api.Scheme.DeepCopy(snapshot)
+	if err != nil {
+		return fmt.Errorf("error cloning snapshot %q: %v", snapshot.Name, err)
+	}
+	snapshotClone, ok := clone.(*storage.VolumeSnapshot)
+	if !ok {
+		return fmt.Errorf("unexpected snapshot cast error: %v", clone)
+	}
+	snapshotClone.Status.Error = &storage.VolumeError{
+		Time:    metav1.Now(),
+		Message: snapshotErr.Error(),
+	}
+	_, err = sc.kubeClient.StorageV1beta1().VolumeSnapshots(snapshot.Namespace).UpdateStatus(snapshotClone)
+	return err
+}
+
+// syncContent deletes the content and the snapshot it holds once the
+// VolumeSnapshot it is bound to has been deleted.
+func (sc *snapshotController) syncContent(name string) error {
+	content, err := sc.contentLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		glog.V(4).Infof("snapshot content %q has been deleted", name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ref := content.Spec.VolumeSnapshotRef
+	if ref == nil {
+		// Not created by the controller, leave it alone.
+		return nil
+	}
+
+	// The lister may not know about the snapshot yet, so check with the API
+	// server before deleting anything.
+	snapshot, err := sc.kubeClient.StorageV1beta1().VolumeSnapshots(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if err == nil && snapshot.UID == ref.UID {
+		return nil
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	plugin, err := sc.volumePluginMgr.FindSnapshottablePluginByName(content.Spec.Snapshotter)
+	if err == nil && plugin == nil {
+		err = fmt.Errorf("volume plugin %q does not support snapshots", content.Spec.Snapshotter)
+	}
+	if err == nil {
+		err = plugin.DeleteSnapshot(content.Spec.SnapshotHandle)
+	}
+	if err != nil {
+		sc.recorder.Eventf(content, v1.EventTypeWarning, "SnapshotDeletionFailed", "error deleting snapshot %q: %v", content.Spec.SnapshotHandle, err)
+		return err
+	}
+	glog.V(2).Infof("deleted snapshot %q of snapshot content %q", content.Spec.SnapshotHandle, name)
+
+	err = sc.kubeClient.StorageV1beta1().VolumeSnapshotContents().Delete(name, nil)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting snapshot content %q: %v", name, err)
+	}
+	return nil
+}
+
+// VolumeHost implementation
+// This is an unfortunate requirement of the current factoring of volume plugin
+// initializing code. It requires kubelet specific methods used by the mounting
+// code to be implemented by all initializers even if the initializer does not
+// do mounting (like this snapshot controller).
+func (sc *snapshotController) GetPluginDir(pluginName string) string {
+	return ""
+}
+
+func (sc *snapshotController) GetPodVolumeDir(podUID types.UID, pluginName, volumeName string) string {
+	return ""
+}
+
+func (sc *snapshotController) GetPodVolumeDeviceDir(podUID types.UID, pluginName string) string {
+	return ""
+}
+
+func (sc *snapshotController) GetPodPluginDir(podUID types.UID, pluginName string) string {
+	return ""
+}
+
+func (sc *snapshotController) GetKubeClient() clientset.Interface {
+	return sc.kubeClient
+}
+
+func (sc *snapshotController) NewWrapperMounter(volName string, spec volume.Spec, pod *v1.Pod, opts volume.VolumeOptions) (volume.Mounter, error) {
+	return nil, fmt.Errorf("NewWrapperMounter not supported by snapshot controller's VolumeHost implementation")
+}
+
+func (sc *snapshotController) NewWrapperUnmounter(volName string, spec volume.Spec, podUID types.UID) (volume.Unmounter, error) {
+	return nil, fmt.Errorf("NewWrapperUnmounter not supported by snapshot controller's VolumeHost implementation")
+}
+
+func (sc *snapshotController) GetCloudProvider() cloudprovider.Interface {
+	return sc.cloud
+}
+
+func (sc *snapshotController) GetMounter() mount.Interface {
+	return nil
+}
+
+func (sc *snapshotController) GetWriter() io.Writer {
+	return nil
+}
+
+func (sc *snapshotController) GetHostName() string {
+	return ""
+}
+
+func (sc *snapshotController) GetHostIP() (net.IP, error) {
+	return nil, fmt.Errorf("GetHostIP() not supported by snapshot controller's VolumeHost implementation")
+}
+
+func (sc *snapshotController) GetNodeAllocatable() (v1.ResourceList, error) {
+	return v1.ResourceList{}, nil
+}
+
+func (sc *snapshotController) GetSecretFunc() func(namespace, name string) (*v1.Secret, error) {
+	return func(_, _ string) (*v1.Secret, error) {
+		return nil, fmt.Errorf("GetSecret unsupported in snapshotController")
+	}
+}
+
+func (sc *snapshotController) GetNodeName() types.NodeName {
+	return ""
+}
+
+func (sc *snapshotController) GetNodeLabels() (map[string]string, error) {
+	return nil, fmt.Errorf("GetNodeLabels() unsupported in snapshot controller")
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/api/v1"
+	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+	informers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/volume"
+	volumetest "k8s.io/kubernetes/pkg/volume/testing"
+)
+
+func newSnapshot(contentName string, ready bool) *storage.VolumeSnapshot {
+	return &storage.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "snapshot",
+			Namespace: "ns",
+			UID:       "snapshot-uid",
+			SelfLink:  "/apis/storage.k8s.io/v1beta1/namespaces/ns/volumesnapshots/snapshot",
+		},
+		Spec: storage.VolumeSnapshotSpec{
+			PersistentVolumeClaimName: "claim",
+			SnapshotContentName:       contentName,
+		},
+		Status: storage.VolumeSnapshotStatus{Ready: ready},
+	}
+}
+
+func newContent(snapshotUID string) *storage.VolumeSnapshotContent {
+	return &storage.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: "snapcontent-snapshot-uid"},
+		Spec: storage.VolumeSnapshotContentSpec{
+			Snapshotter:          "fake-plugin",
+			SnapshotHandle:       "fake-snapcontent-snapshot-uid",
+			PersistentVolumeName: "volume",
+			VolumeSnapshotRef:    &v1.ObjectReference{Kind: "VolumeSnapshot", Namespace: "ns", Name: "snapshot", UID: types.UID(snapshotUID)},
+		},
+	}
+}
+
+func newClaim(phase v1.PersistentVolumeClaimPhase) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "ns", UID: "claim-uid"},
+		Spec:       v1.PersistentVolumeClaimSpec{VolumeName: "volume"},
+		Status:     v1.PersistentVolumeClaimStatus{Phase: phase},
+	}
+}
+
+func newVolume() *v1.PersistentVolume {
+	return &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "volume"},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				HostPath: &v1.HostPathVolumeSource{Path: "/tmp/volume"},
+			},
+			ClaimRef: &v1.ObjectReference{Namespace: "ns", Name: "claim", UID: "claim-uid"},
+		},
+	}
+}
+
+type testObjects struct {
+	snapshots []*storage.VolumeSnapshot
+	contents  []*storage.VolumeSnapshotContent
+	claims    []*v1.PersistentVolumeClaim
+	volumes   []*v1.PersistentVolume
+}
+
+func newTestController(t *testing.T, objects testObjects) (*snapshotController, *fake.Clientset, *volumetest.FakeVolumePlugin) {
+	var clientObjects []runtime.Object
+	for _, o := range objects.snapshots {
+		clientObjects = append(clientObjects, o)
+	}
+	for _, o := range objects.contents {
+		clientObjects = append(clientObjects, o)
+	}
+	for _, o := range objects.claims {
+		clientObjects = append(clientObjects, o)
+	}
+	for _, o := range objects.volumes {
+		clientObjects = append(clientObjects, o)
+	}
+	client := fake.NewSimpleClientset(clientObjects...)
+	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+	snapshotInformer := informerFactory.Storage().V1beta1().VolumeSnapshots()
+	contentInformer := informerFactory.Storage().V1beta1().VolumeSnapshotContents()
+	pvcInformer := informerFactory.Core().V1().PersistentVolumeClaims()
+	pvInformer := informerFactory.Core().V1().PersistentVolumes()
+	for _, o := range objects.snapshots {
+		snapshotInformer.Informer().GetStore().Add(o)
+	}
+	for _, o := range objects.contents {
+		contentInformer.Informer().GetStore().Add(o)
+	}
+	for _, o := range objects.claims {
+		pvcInformer.Informer().GetStore().Add(o)
+	}
+	for _, o := range objects.volumes {
+		pvInformer.Informer().GetStore().Add(o)
+	}
+
+	fakePlugin := &volumetest.FakeVolumePlugin{PluginName: "fake-plugin"}
+	ctrl, err := NewSnapshotController(client, snapshotInformer, contentInformer, pvcInformer, pvInformer, nil, []volume.VolumePlugin{fakePlugin})
+	if err != nil {
+		t.Fatalf("error creating controller: %v", err)
+	}
+	sc := ctrl.(*snapshotController)
+	sc.recorder = record.NewFakeRecorder(10)
+	client.ClearActions()
+	return sc, client, fakePlugin
+}
+
+func getActions(client *fake.Clientset) []string {
+	actions := []string{}
+	for _, action := range client.Actions() {
+		got := action.GetVerb() + " " + action.GetResource().Resource
+		if subresource := action.GetSubresource(); subresource != "" {
+			got += "/" + subresource
+		}
+		actions = append(actions, got)
+	}
+	return actions
+}
+
+func TestSyncSnapshot(t *testing.T) {
+	tests := []struct {
+		name              string
+		objects           testObjects
+		expectErr         bool
+		expectedActions   []string
+		expectedSnapshots []string
+		expectReady       bool
+	}{
+		{
+			name: "take snapshot",
+			objects: testObjects{
+				snapshots: []*storage.VolumeSnapshot{newSnapshot("", false)},
+				claims:    []*v1.PersistentVolumeClaim{newClaim(v1.ClaimBound)},
+				volumes:   []*v1.PersistentVolume{newVolume()},
+			},
+			expectedActions:   []string{"create volumesnapshotcontents", "update volumesnapshots", "update volumesnapshots/status"},
+			expectedSnapshots: []string{"fake-snapcontent-snapshot-uid"},
+			expectReady:       true,
+		},
+		{
+			name: "bind content taken by a previous sync",
+			objects: testObjects{
+				snapshots: []*storage.VolumeSnapshot{newSnapshot("", false)},
+				contents:  []*storage.VolumeSnapshotContent{newContent("snapshot-uid")},
+				claims:    []*v1.PersistentVolumeClaim{newClaim(v1.ClaimBound)},
+				volumes:   []*v1.PersistentVolume{newVolume()},
+			},
+			expectedActions: []string{"update volumesnapshots", "update volumesnapshots/status"},
+			expectReady:     true,
+		},
+		{
+			name: "snapshot already ready",
+			objects: testObjects{
+				snapshots: []*storage.VolumeSnapshot{newSnapshot("snapcontent-snapshot-uid", true)},
+				contents:  []*storage.VolumeSnapshotContent{newContent("snapshot-uid")},
+			},
+			expectedActions: []string{},
+			expectReady:     true,
+		},
+		{
+			name: "claim not bound",
+			objects: testObjects{
+				snapshots: []*storage.VolumeSnapshot{newSnapshot("", false)},
+				claims:    []*v1.PersistentVolumeClaim{newClaim(v1.ClaimPending)},
+				volumes:   []*v1.PersistentVolume{newVolume()},
+			},
+			expectErr:       true,
+			expectedActions: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		sc, client, plugin := newTestController(t, test.objects)
+		err := sc.syncSnapshot("ns/snapshot")
+		if err != nil && !test.expectErr {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if err == nil && test.expectErr {
+			t.Errorf("%s: expected error", test.name)
+			continue
+		}
+		if actions := getActions(client); !reflect.DeepEqual(actions, test.expectedActions) {
+			t.Errorf("%s: expected actions %v, got %v", test.name, test.expectedActions, actions)
+		}
+		if len(plugin.CreatedSnapshots) != len(test.expectedSnapshots) || (len(test.expectedSnapshots) > 0 && !reflect.DeepEqual(plugin.CreatedSnapshots, test.expectedSnapshots)) {
+			t.Errorf("%s: expected snapshots %v to be taken, got %v", test.name, test.expectedSnapshots, plugin.CreatedSnapshots)
+		}
+		if test.expectErr {
+			continue
+		}
+		snapshot, err := client.StorageV1beta1().VolumeSnapshots("ns").Get("snapshot", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("%s: error getting snapshot: %v", test.name, err)
+		}
+		if snapshot.Status.Ready != test.expectReady {
+			t.Errorf("%s: expected ready %v, got %v", test.name, test.expectReady, snapshot.Status.Ready)
+		}
+		if test.expectReady && snapshot.Spec.SnapshotContentName != "snapcontent-snapshot-uid" {
+			t.Errorf("%s: expected snapshot bound to %q, got %q", test.name, "snapcontent-snapshot-uid", snapshot.Spec.SnapshotContentName)
+		}
+	}
+}
+
+// nonSnapshottablePlugin hides the snapshot methods of the plugin it wraps.
+type nonSnapshottablePlugin struct {
+	volume.VolumePlugin
+}
+
+func TestSyncSnapshotUnsupportedPlugin(t *testing.T) {
+	sc, client, plugin := newTestController(t, testObjects{
+		snapshots: []*storage.VolumeSnapshot{newSnapshot("", false)},
+		claims:    []*v1.PersistentVolumeClaim{newClaim(v1.ClaimBound)},
+		volumes:   []*v1.PersistentVolume{newVolume()},
+	})
+	sc.volumePluginMgr = volume.VolumePluginMgr{}
+	if err := sc.volumePluginMgr.InitPlugins([]volume.VolumePlugin{nonSnapshottablePlugin{plugin}}, nil /* prober */, sc); err != nil {
+		t.Fatalf("error initializing plugins: %v", err)
+	}
+
+	if err := sc.syncSnapshot("ns/snapshot"); err == nil {
+		t.Errorf("expected error")
+	}
+	expectedActions := []string{"update volumesnapshots/status"}
+	if actions := getActions(client); !reflect.DeepEqual(actions, expectedActions) {
+		t.Errorf("expected actions %v, got %v", expectedActions, actions)
+	}
+	snapshot, err := client.StorageV1beta1().VolumeSnapshots("ns").Get("snapshot", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting snapshot: %v", err)
+	}
+	if snapshot.Status.Ready || snapshot.Status.Error == nil {
+		t.Errorf("expected snapshot not ready with an error, got status %+v", snapshot.Status)
+	}
+}
+
+func TestSyncContent(t *testing.T) {
+	tests := []struct {
+		name             string
+		objects          testObjects
+		expectedActions  []string
+		expectedDeletion bool
+	}{
+		{
+			name: "snapshot exists",
+			objects: testObjects{
+				snapshots: []*storage.VolumeSnapshot{newSnapshot("snapcontent-snapshot-uid", true)},
+				contents:  []*storage.VolumeSnapshotContent{newContent("snapshot-uid")},
+			},
+			expectedActions: []string{"get volumesnapshots"},
+		},
+		{
+			name: "snapshot deleted",
+			objects: testObjects{
+				contents: []*storage.VolumeSnapshotContent{newContent("snapshot-uid")},
+			},
+			expectedActions:  []string{"get volumesnapshots", "delete volumesnapshotcontents"},
+			expectedDeletion: true,
+		},
+	}
+
+	for _, test := range tests {
+		sc, client, plugin := newTestController(t, test.objects)
+		if err := sc.syncContent("snapcontent-snapshot-uid"); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if actions := getActions(client); !reflect.DeepEqual(actions, test.expectedActions) {
+			t.Errorf("%s: expected actions %v, got %v", test.name, test.expectedActions, actions)
+		}
+		if deleted := len(plugin.DeletedSnapshots) > 0; deleted != test.expectedDeletion {
+			t.Errorf("%s: expected snapshot deletion %v, got deleted snapshots %v", test.name, test.expectedDeletion, plugin.DeletedSnapshots)
+		}
+	}
+}
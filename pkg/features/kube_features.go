@@ -51,6 +51,13 @@ const (
 	// redirects from the backend (Kubelet) for streaming requests (exec/attach/port-forward).
 	StreamingProxyRedirects utilfeature.Feature = genericfeatures.StreamingProxyRedirects
 
+	// owner: @apelisse
+	// alpha: v1.7
+	//
+	// ServerSideApply enables the apply patch type, which merges a configuration
+	// on the server and tracks which manager owns each field of an object.
+	ServerSideApply utilfeature.Feature = genericfeatures.ServerSideApply
+
 	// owner: @pweil-
 	// alpha: v1.5
 	//
@@ -81,6 +88,76 @@ const (
 	// Only Nvidia GPUs are supported as of v1.6.
 	// Works only with Docker Container Runtime.
 	Accelerators utilfeature.Feature = "Accelerators"
+
+	// owner: @derekwaynecarr
+	// alpha: v1.7
+	//
+	// Enable pods to consume pre-allocated huge pages.
+	HugePages utilfeature.Feature = "HugePages"
+
+	// owner: @jcbsmpsn
+	// alpha: v1.7
+	//
+	// Enable the kubelet to request its serving certificate through the
+	// certificates API and rotate it before it expires.
+	RotateKubeletServerCertificate utilfeature.Feature = "RotateKubeletServerCertificate"
+
+	// owner: @jcbsmpsn
+	// alpha: v1.7
+	//
+	// Enable the kubelet to rotate its client certificate through the
+	// certificates API before it expires.
+	RotateKubeletClientCertificate utilfeature.Feature = "RotateKubeletClientCertificate"
+
+	// owner: @saad-ali
+	// alpha: v1.7
+	//
+	// Enable the CSI volume plugin, which talks to external storage drivers
+	// over gRPC, and the CSI persistent volume source.
+	CSIPersistentVolume utilfeature.Feature = "CSIPersistentVolume"
+
+	// owner: @msau42
+	// alpha: v1.7
+	//
+	// A new volume type that supports local disks on a node.
+	PersistentLocalVolumes utilfeature.Feature = "PersistentLocalVolumes"
+
+	// owner: @msau42
+	// alpha: v1.7
+	//
+	// Take the node affinity of PersistentVolumes and the unbound claims of a
+	// pod into account when scheduling it, and delay the binding of claims
+	// whose StorageClass waits for the first consumer.
+	VolumeScheduling utilfeature.Feature = "VolumeScheduling"
+
+	// owner: @gnufied
+	// alpha: v1.7
+	//
+	// Allow the storage request of a bound PersistentVolumeClaim to grow and
+	// resize the underlying volume and its filesystem.
+	ExpandPersistentVolumes utilfeature.Feature = "ExpandPersistentVolumes"
+
+	// owner: @jingxu97
+	// alpha: v1.7
+	//
+	// Enable the snapshot controller, which takes VolumeSnapshots through
+	// volume plugins, and the provisioning of PersistentVolumeClaims from a
+	// VolumeSnapshot data source.
+	VolumeSnapshotDataSource utilfeature.Feature = "VolumeSnapshotDataSource"
+
+	// owner: @screeley44
+	// alpha: v1.7
+	//
+	// Allow PersistentVolumes and PersistentVolumeClaims with a Block
+	// volumeMode and the mapping of their raw block devices into containers
+	// through volumeDevices.
+	BlockVolume utilfeature.Feature = "BlockVolume"
+
+	// owner: @jsafrane
+	// alpha: v1.7
+	//
+	// Enable mount propagation of volumes.
+	MountPropagation utilfeature.Feature = "MountPropagation"
 )
 
 func init() {
@@ -99,8 +176,19 @@ var defaultKubernetesFeatureGates = map[utilfeature.Feature]utilfeature.FeatureS
 	ExperimentalCriticalPodAnnotation:           {Default: false, PreRelease: utilfeature.Alpha},
 	AffinityInAnnotations:                       {Default: false, PreRelease: utilfeature.Alpha},
 	Accelerators:                                {Default: false, PreRelease: utilfeature.Alpha},
+	HugePages:                                   {Default: false, PreRelease: utilfeature.Alpha},
+	RotateKubeletServerCertificate:              {Default: false, PreRelease: utilfeature.Alpha},
+	RotateKubeletClientCertificate:              {Default: false, PreRelease: utilfeature.Alpha},
+	CSIPersistentVolume:                         {Default: false, PreRelease: utilfeature.Alpha},
+	PersistentLocalVolumes:                      {Default: false, PreRelease: utilfeature.Alpha},
+	VolumeScheduling:                            {Default: false, PreRelease: utilfeature.Alpha},
+	ExpandPersistentVolumes:                     {Default: false, PreRelease: utilfeature.Alpha},
+	VolumeSnapshotDataSource:                    {Default: false, PreRelease: utilfeature.Alpha},
+	BlockVolume:                                 {Default: false, PreRelease: utilfeature.Alpha},
+	MountPropagation:                            {Default: false, PreRelease: utilfeature.Alpha},
 
 	// inherited features from generic apiserver, relisted here to get a conflict if it is changed
 	// unintentionally on either side:
 	StreamingProxyRedirects: {Default: true, PreRelease: utilfeature.Beta},
+	ServerSideApply:         {Default: false, PreRelease: utilfeature.Alpha},
 }
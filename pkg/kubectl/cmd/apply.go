@@ -19,6 +19,7 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -58,6 +59,8 @@ type ApplyOptions struct {
 	GracePeriod     int
 	PruneResources  []pruneResource
 	Timeout         time.Duration
+	ServerSide      bool
+	ForceConflicts  bool
 }
 
 const (
@@ -69,6 +72,10 @@ const (
 	triesBeforeBackOff = 1
 
 	warningNoLastAppliedConfigAnnotation = "Warning: kubectl apply should be used on resource created by either kubectl create --save-config or kubectl apply\n"
+
+	// serverSideFieldManager is the name of the manager of the fields applied
+	// by kubectl apply --server-side.
+	serverSideFieldManager = "kubectl"
 )
 
 var (
@@ -93,7 +100,11 @@ var (
 		kubectl apply --prune -f manifest.yaml -l app=nginx
 
 		# Apply the configuration in manifest.yaml and delete all the other configmaps that are not in the file.
-		kubectl apply --prune -f manifest.yaml --all --prune-whitelist=core/v1/ConfigMap`)
+		kubectl apply --prune -f manifest.yaml --all --prune-whitelist=core/v1/ConfigMap
+
+		# Note: --server-side is still in Alpha
+		# Let the server merge the configuration in pod.yaml into the pod, taking over the fields owned by other managers.
+		kubectl apply --server-side --force-conflicts -f ./pod.yaml`)
 )
 
 func NewCmdApply(f cmdutil.Factory, out, errOut io.Writer) *cobra.Command {
@@ -107,6 +118,7 @@ func NewCmdApply(f cmdutil.Factory, out, errOut io.Writer) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			cmdutil.CheckErr(validateArgs(cmd, args))
 			cmdutil.CheckErr(validatePruneAll(options.Prune, cmdutil.GetFlagBool(cmd, "all"), options.Selector))
+			cmdutil.CheckErr(validateServerSide(&options, cmdutil.GetDryRunFlag(cmd)))
 			cmdutil.CheckErr(RunApply(f, cmd, out, errOut, &options))
 		},
 	}
@@ -124,6 +136,8 @@ func NewCmdApply(f cmdutil.Factory, out, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringVarP(&options.Selector, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.")
 	cmd.Flags().Bool("all", false, "[-all] to select all the specified resources.")
 	cmd.Flags().StringArray("prune-whitelist", []string{}, "Overwrite the default whitelist with <group/version/kind> for --prune")
+	cmd.Flags().BoolVar(&options.ServerSide, "server-side", false, "If true, the configuration is merged into the resource by the server, which tracks the fields owned by each manager. This is an alpha feature.")
+	cmd.Flags().BoolVar(&options.ForceConflicts, "force-conflicts", false, "Only relevant during a server-side apply. If true, take over the fields owned by other managers instead of failing with a conflict.")
 	cmdutil.AddDryRunFlag(cmd)
 	cmdutil.AddPrinterFlags(cmd)
 	cmdutil.AddRecordFlag(cmd)
@@ -151,6 +165,25 @@ func validatePruneAll(prune, all bool, selector string) error {
 	return nil
 }
 
+func validateServerSide(options *ApplyOptions, dryRun bool) error {
+	if options.ServerSide {
+		if options.Prune {
+			return fmt.Errorf("--prune is not supported with --server-side")
+		}
+		if options.Force {
+			return fmt.Errorf("--force is not supported with --server-side, use --force-conflicts instead")
+		}
+		// The merge happens on the server, there is nothing to show without
+		// sending the configuration.
+		if dryRun {
+			return fmt.Errorf("--dry-run is not supported with --server-side")
+		}
+	} else if options.ForceConflicts {
+		return fmt.Errorf("--force-conflicts only applies with --server-side")
+	}
+	return nil
+}
+
 func parsePruneResources(mapper meta.RESTMapper, gvks []string) ([]pruneResource, error) {
 	pruneResources := []pruneResource{}
 	for _, groupVersionKind := range gvks {
@@ -240,6 +273,23 @@ func RunApply(f cmdutil.Factory, cmd *cobra.Command, out, errOut io.Writer, opti
 			visitedNamespaces.Insert(info.Namespace)
 		}
 
+		if options.ServerSide {
+			if cmdutil.ShouldRecord(cmd, info) {
+				if err := cmdutil.RecordChangeCause(info.Object, f.Command(cmd, false)); err != nil {
+					return cmdutil.AddSourceToErr("applying", info.Source, err)
+				}
+			}
+			if err := serverSideApply(info, encoder, options.ForceConflicts); err != nil {
+				return cmdutil.AddSourceToErr("applying", info.Source, err)
+			}
+			count++
+			if len(output) > 0 && !shortOutput {
+				return cmdutil.PrintResourceInfoForCommand(cmd, info, f, out)
+			}
+			cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, false, "serverside-applied")
+			return nil
+		}
+
 		// Get the modified configuration of the object. Embed the result
 		// as an annotation in the modified configuration, so that it will appear
 		// in the patch sent to the server.
@@ -390,6 +440,34 @@ func RunApply(f cmdutil.Factory, cmd *cobra.Command, out, errOut io.Writer, opti
 	return nil
 }
 
+// serverSideApply sends the configuration in info to the server as an apply
+// patch, which creates the resource if it doesn't exist, and refreshes info
+// with the result.
+func serverSideApply(info *resource.Info, encoder runtime.Encoder, force bool) error {
+	data, err := runtime.Encode(encoder, info.Object)
+	if err != nil {
+		return err
+	}
+	helper := resource.NewHelper(info.Client, info.Mapping)
+	obj, err := helper.RESTClient.Patch(types.ApplyPatchType).
+		NamespaceIfScoped(info.Namespace, helper.NamespaceScoped).
+		Resource(helper.Resource).
+		Name(info.Name).
+		Param("fieldManager", serverSideFieldManager).
+		Param("force", strconv.FormatBool(force)).
+		Body(data).
+		Do().
+		Get()
+	if err != nil {
+		if errors.IsConflict(err) && !force {
+			return fmt.Errorf("%v\nPlease review the fields above, they are owned by other managers. Run the command again with --force-conflicts to take them over.", err)
+		}
+		return err
+	}
+	info.Refresh(obj, true)
+	return nil
+}
+
 type pruneResource struct {
 	group      string
 	version    string
@@ -555,21 +633,34 @@ type patcher struct {
 }
 
 func (p *patcher) patchSimple(obj runtime.Object, modified []byte, source, namespace, name string) ([]byte, runtime.Object, error) {
+	patch, patchType, err := computeApplyPatch(obj, modified, p.mapping, p.encoder, p.overwrite, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	patchedObj, err := p.helper.Patch(namespace, name, patchType, patch)
+	return patch, patchedObj, err
+}
+
+// computeApplyPatch returns the patch that apply sends to the server to bring
+// obj, the current configuration of the object from the server, to the
+// modified configuration, along with the type of the patch.
+func computeApplyPatch(obj runtime.Object, modified []byte, mapping *meta.RESTMapping, encoder runtime.Encoder, overwrite bool, source string) ([]byte, types.PatchType, error) {
 	// Serialize the current configuration of the object from the server.
-	current, err := runtime.Encode(p.encoder, obj)
+	current, err := runtime.Encode(encoder, obj)
 	if err != nil {
-		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("serializing current configuration from:\n%v\nfor:", obj), source, err)
+		return nil, "", cmdutil.AddSourceToErr(fmt.Sprintf("serializing current configuration from:\n%v\nfor:", obj), source, err)
 	}
 
 	// Retrieve the original configuration of the object from the annotation.
-	original, err := kubectl.GetOriginalConfiguration(p.mapping, obj)
+	original, err := kubectl.GetOriginalConfiguration(mapping, obj)
 	if err != nil {
-		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("retrieving original configuration from:\n%v\nfor:", obj), source, err)
+		return nil, "", cmdutil.AddSourceToErr(fmt.Sprintf("retrieving original configuration from:\n%v\nfor:", obj), source, err)
 	}
 
 	// Create the versioned struct from the type defined in the restmapping
 	// (which is the API version we'll be submitting the patch to)
-	versionedObject, err := api.Scheme.New(p.mapping.GroupVersionKind)
+	versionedObject, err := api.Scheme.New(mapping.GroupVersionKind)
 	var patchType types.PatchType
 	var patch []byte
 	createPatchErrFormat := "creating patch with:\noriginal:\n%s\nmodified:\n%s\ncurrent:\n%s\nfor:"
@@ -582,23 +673,21 @@ func (p *patcher) patchSimple(obj runtime.Object, modified []byte, source, names
 		patch, err = jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, current, preconditions...)
 		if err != nil {
 			if mergepatch.IsPreconditionFailed(err) {
-				return nil, nil, fmt.Errorf("%s", "At least one of apiVersion, kind and name was changed")
+				return nil, "", fmt.Errorf("%s", "At least one of apiVersion, kind and name was changed")
 			}
-			return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf(createPatchErrFormat, original, modified, current), source, err)
+			return nil, "", cmdutil.AddSourceToErr(fmt.Sprintf(createPatchErrFormat, original, modified, current), source, err)
 		}
 	case err != nil:
-		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("getting instance of versioned object for %v:", p.mapping.GroupVersionKind), source, err)
+		return nil, "", cmdutil.AddSourceToErr(fmt.Sprintf("getting instance of versioned object for %v:", mapping.GroupVersionKind), source, err)
 	case err == nil:
 		// Compute a three way strategic merge patch to send to server.
 		patchType = types.StrategicMergePatchType
-		patch, err = strategicpatch.CreateThreeWayMergePatch(original, modified, current, versionedObject, p.overwrite)
+		patch, err = strategicpatch.CreateThreeWayMergePatch(original, modified, current, versionedObject, overwrite)
 		if err != nil {
-			return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf(createPatchErrFormat, original, modified, current), source, err)
+			return nil, "", cmdutil.AddSourceToErr(fmt.Sprintf(createPatchErrFormat, original, modified, current), source, err)
 		}
 	}
-
-	patchedObj, err := p.helper.Patch(namespace, name, patchType, patch)
-	return patch, patchedObj, err
+	return patch, patchType, nil
 }
 
 func (p *patcher) patch(current runtime.Object, modified []byte, source, namespace, name string) ([]byte, runtime.Object, error) {
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest/fake"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/annotations"
@@ -435,6 +436,79 @@ func TestApplyObject(t *testing.T) {
 	}
 }
 
+func TestApplyServerSide(t *testing.T) {
+	initTestErrorHandler(t)
+	nameRC, currentRC := readAndAnnotateReplicationController(t, filenameRC)
+	pathRC := "/namespaces/test/replicationcontrollers/" + nameRC
+
+	f, tf, _, _ := cmdtesting.NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.UnstructuredClient = &fake.RESTClient{
+		APIRegistry:          api.Registry,
+		NegotiatedSerializer: unstructuredSerializer,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == pathRC && m == "PATCH":
+				if contentType := req.Header.Get("Content-Type"); contentType != string(types.ApplyPatchType) {
+					t.Fatalf("unexpected content type: %s", contentType)
+				}
+				if manager := req.URL.Query().Get("fieldManager"); manager != serverSideFieldManager {
+					t.Fatalf("unexpected field manager: %s", manager)
+				}
+				if force := req.URL.Query().Get("force"); force != "true" {
+					t.Fatalf("unexpected force: %s", force)
+				}
+				bodyRC := ioutil.NopCloser(bytes.NewReader(currentRC))
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: bodyRC}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+	errBuf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdApply(f, buf, errBuf)
+	cmd.Flags().Set("filename", filenameRC)
+	cmd.Flags().Set("server-side", "true")
+	cmd.Flags().Set("force-conflicts", "true")
+	cmd.Flags().Set("output", "name")
+	cmd.Run(cmd, []string{})
+
+	expectRC := "replicationcontroller/" + nameRC + "\n"
+	if buf.String() != expectRC {
+		t.Fatalf("unexpected output: %s\nexpected: %s", buf.String(), expectRC)
+	}
+}
+
+func TestValidateServerSide(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   ApplyOptions
+		dryRun    bool
+		expectErr bool
+	}{
+		{name: "server-side", options: ApplyOptions{ServerSide: true, ForceConflicts: true}},
+		{name: "client-side dry-run", options: ApplyOptions{}, dryRun: true},
+		{name: "server-side dry-run", options: ApplyOptions{ServerSide: true}, dryRun: true, expectErr: true},
+		{name: "server-side prune", options: ApplyOptions{ServerSide: true, Prune: true}, expectErr: true},
+		{name: "server-side force", options: ApplyOptions{ServerSide: true, Force: true}, expectErr: true},
+		{name: "client-side force-conflicts", options: ApplyOptions{ForceConflicts: true}, expectErr: true},
+	}
+
+	for _, test := range tests {
+		err := validateServerSide(&test.options, test.dryRun)
+		if err != nil && !test.expectErr {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if err == nil && test.expectErr {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
 func TestApplyObjectOutput(t *testing.T) {
 	initTestErrorHandler(t)
 	nameRC, currentRC := readAndAnnotateReplicationController(t, filenameRC)
@@ -303,6 +303,7 @@ func NewKubectlCommand(f cmdutil.Factory, in io.Reader, out, err io.Writer) *cob
 			Message: "Advanced Commands:",
 			Commands: []*cobra.Command{
 				NewCmdApply(f, out, err),
+				NewCmdDiff(f, out, err),
 				NewCmdPatch(f, out),
 				NewCmdReplace(f, out),
 				NewCmdConvert(f, out),
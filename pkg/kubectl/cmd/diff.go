@@ -0,0 +1,321 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	utilexec "k8s.io/kubernetes/pkg/util/exec"
+	"k8s.io/kubernetes/pkg/util/i18n"
+)
+
+const warningNoLastAppliedConfigDiff = "Warning: %s/%s has no last-applied configuration, it may be managed with kubectl apply --server-side. " +
+	"The fields removed from its configuration are not shown as deleted.\n"
+
+var (
+	diff_long = templates.LongDesc(`
+		Diff the configurations in the given files against the live objects.
+
+		For each object, the patch that a client-side kubectl apply would send is
+		computed from the configuration, the live object and its last-applied
+		configuration, and merged locally into the live object. The live and the
+		merged objects are written as YAML files to two temporary directories,
+		which are then compared. Objects that don't exist yet are compared against
+		an empty file.
+
+		The merged objects are not computed by the server, so they don't include
+		the changes made by defaulting or admission. Objects without a
+		last-applied configuration, such as those managed with
+		kubectl apply --server-side, are merged as if nothing had been applied to
+		them before, and a warning is printed for them.
+
+		The directories are compared with "diff -u -N" by default. Another program
+		can be used by setting the KUBECTL_EXTERNAL_DIFF environment variable to
+		the command to run; the two directories are appended to its arguments.
+
+		The exit status is that of the diff program: with diff, 0 if there are no
+		differences and 1 if there are. Other programs, such as meld, may exit with
+		0 even when there are differences. The exit status is 2 if kubectl or diff
+		fail with an error.`)
+
+	diff_example = templates.Examples(`
+		# Show the changes that applying pod.json would make.
+		kubectl diff -f pod.json
+
+		# Compare the objects in manifest.yaml with meld.
+		KUBECTL_EXTERNAL_DIFF=meld kubectl diff -f manifest.yaml`)
+)
+
+// DiffOptions are the options of the diff command.
+type DiffOptions struct {
+	FilenameOptions resource.FilenameOptions
+}
+
+// NewCmdDiff creates a command object for the "diff" action, which shows the
+// changes that applying the given configurations would make.
+func NewCmdDiff(f cmdutil.Factory, out, errOut io.Writer) *cobra.Command {
+	var options DiffOptions
+	diff := &DiffProgram{
+		Exec:   utilexec.New(),
+		Stdout: out,
+		Stderr: errOut,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "diff -f FILENAME",
+		Short:   i18n.T("Diff the live objects against the configurations that would be applied"),
+		Long:    diff_long,
+		Example: diff_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckDiffErr(validateArgs(cmd, args))
+			cmdutil.CheckDiffErr(RunDiff(f, cmd, errOut, diff, &options))
+		},
+	}
+
+	usage := "that contains the configuration to diff"
+	cmdutil.AddFilenameOptionFlags(cmd, &options.FilenameOptions, usage)
+	cmd.MarkFlagRequired("filename")
+	cmdutil.AddValidateFlags(cmd)
+	cmdutil.AddInclude3rdPartyFlags(cmd)
+
+	return cmd
+}
+
+// DiffProgram runs the program that compares the live and the merged objects.
+// Its output is written directly to Stdout and Stderr, so that interactive
+// programs get the terminal.
+type DiffProgram struct {
+	Exec   utilexec.Interface
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// getCommand returns the diff program to run and its arguments, which are
+// taken from the KUBECTL_EXTERNAL_DIFF environment variable if it is set.
+func (d *DiffProgram) getCommand() (string, []string) {
+	diff := strings.Fields(os.Getenv("KUBECTL_EXTERNAL_DIFF"))
+	if len(diff) == 0 {
+		return "diff", []string{"-u", "-N"}
+	}
+	return diff[0], diff[1:]
+}
+
+// Run compares the contents of the from and to directories, and returns the
+// exit error of the diff program if they differ.
+func (d *DiffProgram) Run(from, to string) error {
+	name, args := d.getCommand()
+	cmd := d.Exec.Command(name, append(args, from, to)...)
+	cmd.SetStdout(d.Stdout)
+	cmd.SetStderr(d.Stderr)
+	return cmd.Run()
+}
+
+// diffDirectory is a temporary directory holding one version of the objects
+// being compared.
+type diffDirectory struct {
+	name string
+}
+
+// newDiffDirectory creates a temporary directory whose name starts with prefix.
+func newDiffDirectory(prefix string) (*diffDirectory, error) {
+	name, err := ioutil.TempDir("", prefix+"-")
+	if err != nil {
+		return nil, err
+	}
+	return &diffDirectory{name: name}, nil
+}
+
+// writeObject writes obj as YAML to the file called name in the directory.
+func (d *diffDirectory) writeObject(name string, obj map[string]interface{}) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(d.name, name), data, 0600)
+}
+
+// delete removes the directory and its contents.
+func (d *diffDirectory) delete() error {
+	return os.RemoveAll(d.name)
+}
+
+// diffFileName returns the name of the files holding the versions of the
+// object in info, which is unique among the objects being compared.
+func diffFileName(info *resource.Info) string {
+	gvk := info.Mapping.GroupVersionKind
+	var parts []string
+	for _, part := range []string{gvk.Group, gvk.Version, gvk.Kind, info.Namespace, info.Name} {
+		if len(part) > 0 {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// RunDiff writes the live and the merged versions of the objects in the
+// given files to temporary directories, and compares them with diff.
+func RunDiff(f cmdutil.Factory, cmd *cobra.Command, errOut io.Writer, diff *DiffProgram, options *DiffOptions) error {
+	schema, err := f.Validator(cmdutil.GetFlagBool(cmd, "validate"), cmdutil.GetFlagString(cmd, "schema-cache-dir"))
+	if err != nil {
+		return err
+	}
+
+	cmdNamespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer, err := f.UnstructuredObject()
+	if err != nil {
+		return err
+	}
+
+	r := resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.UnstructuredClientForMapping), unstructured.UnstructuredJSONScheme).
+		Schema(schema).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, &options.FilenameOptions).
+		Flatten().
+		Do()
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	live, err := newDiffDirectory("LIVE")
+	if err != nil {
+		return err
+	}
+	defer live.delete()
+	merged, err := newDiffDirectory("MERGED")
+	if err != nil {
+		return err
+	}
+	defer merged.delete()
+
+	encoder := f.JSONEncoder()
+	err = r.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+
+		liveObj, mergedObj, err := diffObjects(info, encoder)
+		if err != nil {
+			return err
+		}
+		name := diffFileName(info)
+		if liveObj != nil {
+			if original, err := kubectl.GetOriginalConfiguration(info.Mapping, info.Object); err == nil && original == nil {
+				fmt.Fprintf(errOut, warningNoLastAppliedConfigDiff, info.Mapping.Resource, info.Name)
+			}
+			if err := live.writeObject(name, liveObj); err != nil {
+				return err
+			}
+		}
+		return merged.writeObject(name, mergedObj)
+	})
+	if err != nil {
+		return err
+	}
+
+	return diff.Run(live.name, merged.name)
+}
+
+// diffObjects returns the live version of the object in info, or nil if it
+// doesn't exist, and the version that applying the configuration in info
+// would persist. The merged version is computed by applying locally the
+// patch that kubectl apply would send.
+func diffObjects(info *resource.Info, encoder runtime.Encoder) (map[string]interface{}, map[string]interface{}, error) {
+	modified, err := kubectl.GetModifiedConfiguration(info, true, encoder)
+	if err != nil {
+		return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("retrieving modified configuration from:\n%v\nfor:", info), info.Source, err)
+	}
+
+	if err := info.Get(); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, nil, cmdutil.AddSourceToErr(fmt.Sprintf("retrieving current configuration of:\n%v\nfrom server for:", info), info.Source, err)
+		}
+		// The object would be created from the modified configuration.
+		mergedObj, err := decodeDiffObject(modified)
+		return nil, mergedObj, err
+	}
+
+	current, err := runtime.Encode(encoder, info.Object)
+	if err != nil {
+		return nil, nil, err
+	}
+	patch, patchType, err := computeApplyPatch(info.Object, modified, info.Mapping, encoder, true, info.Source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var patched []byte
+	switch patchType {
+	case types.StrategicMergePatchType:
+		versionedObject, err := api.Scheme.New(info.Mapping.GroupVersionKind)
+		if err != nil {
+			return nil, nil, err
+		}
+		patched, err = strategicpatch.StrategicMergePatch(current, patch, versionedObject)
+		if err != nil {
+			return nil, nil, err
+		}
+	default:
+		patched, err = jsonpatch.MergePatch(current, patch)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	liveObj, err := decodeDiffObject(current)
+	if err != nil {
+		return nil, nil, err
+	}
+	mergedObj, err := decodeDiffObject(patched)
+	if err != nil {
+		return nil, nil, err
+	}
+	return liveObj, mergedObj, nil
+}
+
+// decodeDiffObject decodes the JSON object in data.
+func decodeDiffObject(data []byte) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
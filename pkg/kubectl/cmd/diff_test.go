@@ -0,0 +1,188 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/rest/fake"
+	"k8s.io/kubernetes/pkg/api"
+	cmdtesting "k8s.io/kubernetes/pkg/kubectl/cmd/testing"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	utilexec "k8s.io/kubernetes/pkg/util/exec"
+)
+
+func TestDiffProgram(t *testing.T) {
+	testCases := []struct {
+		env          string
+		expectedArgv []string
+	}{
+		{
+			env:          "",
+			expectedArgv: []string{"diff", "-u", "-N", "from", "to"},
+		},
+		{
+			env:          "colordiff -u  -N",
+			expectedArgv: []string{"colordiff", "-u", "-N", "from", "to"},
+		},
+	}
+
+	defer os.Setenv("KUBECTL_EXTERNAL_DIFF", os.Getenv("KUBECTL_EXTERNAL_DIFF"))
+	for _, tc := range testCases {
+		os.Setenv("KUBECTL_EXTERNAL_DIFF", tc.env)
+
+		var argv []string
+		fcmd := utilexec.FakeCmd{
+			RunScript: []utilexec.FakeRunAction{
+				func() ([]byte, []byte, error) {
+					return []byte("some diff"), []byte("some warning"), &utilexec.FakeExitError{Status: 1}
+				},
+			},
+		}
+		fexec := utilexec.FakeExec{
+			CommandScript: []utilexec.FakeCommandAction{
+				func(cmd string, args ...string) utilexec.Cmd {
+					argv = append([]string{cmd}, args...)
+					return utilexec.InitFakeCmd(&fcmd, cmd, args...)
+				},
+			},
+		}
+		buf := bytes.NewBuffer([]byte{})
+		errBuf := bytes.NewBuffer([]byte{})
+		diff := &DiffProgram{Exec: &fexec, Stdout: buf, Stderr: errBuf}
+
+		err := diff.Run("from", "to")
+		if exitErr, ok := err.(utilexec.ExitError); !ok || exitErr.ExitStatus() != 1 {
+			t.Errorf("%q: expected exit status 1, got %v", tc.env, err)
+		}
+		if !reflect.DeepEqual(tc.expectedArgv, argv) {
+			t.Errorf("%q: expected command %v, got %v", tc.env, tc.expectedArgv, argv)
+		}
+		if buf.String() != "some diff" {
+			t.Errorf("%q: unexpected output: %s", tc.env, buf.String())
+		}
+		if errBuf.String() != "some warning" {
+			t.Errorf("%q: unexpected error output: %s", tc.env, errBuf.String())
+		}
+	}
+}
+
+func TestDiffObject(t *testing.T) {
+	testCases := []struct {
+		name string
+		// annotate sets the last-applied configuration of the live object
+		annotate        bool
+		expectedWarning bool
+	}{
+		{
+			name:     "applied object",
+			annotate: true,
+		},
+		{
+			name:            "object without last-applied configuration",
+			expectedWarning: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		initTestErrorHandler(t)
+		nameRC, currentRC := readReplicationController(t, filenameRC)
+		if tc.annotate {
+			nameRC, currentRC = readAndAnnotateReplicationController(t, filenameRC)
+		}
+		pathRC := "/namespaces/test/replicationcontrollers/" + nameRC
+
+		// the live object was scaled since it was last applied
+		liveRC := map[string]interface{}{}
+		if err := json.Unmarshal(currentRC, &liveRC); err != nil {
+			t.Fatal(err)
+		}
+		liveRC["spec"].(map[string]interface{})["replicas"] = 3
+		currentRC, err := json.Marshal(liveRC)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		f, tf, _, _ := cmdtesting.NewAPIFactory()
+		tf.UnstructuredClient = &fake.RESTClient{
+			APIRegistry:          api.Registry,
+			NegotiatedSerializer: unstructuredSerializer,
+			Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				switch p, m := req.URL.Path, req.Method; {
+				case p == pathRC && m == "GET":
+					bodyRC := ioutil.NopCloser(bytes.NewReader(currentRC))
+					return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: bodyRC}, nil
+				default:
+					t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+					return nil, nil
+				}
+			}),
+		}
+		tf.Namespace = "test"
+
+		files := map[string]string{}
+		fcmd := utilexec.FakeCmd{
+			RunScript: []utilexec.FakeRunAction{
+				func() ([]byte, []byte, error) { return nil, nil, &utilexec.FakeExitError{Status: 1} },
+			},
+		}
+		fexec := utilexec.FakeExec{
+			CommandScript: []utilexec.FakeCommandAction{
+				func(cmd string, args ...string) utilexec.Cmd {
+					// the directories are removed once the diff program exits
+					for _, dir := range args[len(args)-2:] {
+						name := filepath.Join(dir, "v1.ReplicationController.test."+nameRC)
+						data, err := ioutil.ReadFile(name)
+						if err != nil {
+							t.Fatalf("unexpected error: %v", err)
+						}
+						files[strings.SplitN(filepath.Base(dir), "-", 2)[0]] = string(data)
+					}
+					return utilexec.InitFakeCmd(&fcmd, cmd, args...)
+				},
+			},
+		}
+		buf := bytes.NewBuffer([]byte{})
+		errBuf := bytes.NewBuffer([]byte{})
+		diff := &DiffProgram{Exec: &fexec, Stdout: buf, Stderr: errBuf}
+
+		cmd := NewCmdDiff(f, buf, errBuf)
+		options := &DiffOptions{FilenameOptions: resource.FilenameOptions{Filenames: []string{filenameRC}}}
+		err = RunDiff(f, cmd, errBuf, diff, options)
+		if exitErr, ok := err.(utilexec.ExitError); !ok || exitErr.ExitStatus() != 1 {
+			t.Errorf("%s: expected exit status 1, got %v", tc.name, err)
+		}
+
+		if !strings.Contains(files["LIVE"], "replicas: 3") {
+			t.Errorf("%s: expected the live object to have 3 replicas:\n%s", tc.name, files["LIVE"])
+		}
+		if !strings.Contains(files["MERGED"], "replicas: 1") {
+			t.Errorf("%s: expected the merged object to have 1 replica:\n%s", tc.name, files["MERGED"])
+		}
+		if warned := strings.Contains(errBuf.String(), "has no last-applied configuration"); warned != tc.expectedWarning {
+			t.Errorf("%s: expected warning %v, got %q", tc.name, tc.expectedWarning, errBuf.String())
+		}
+	}
+}
@@ -28,7 +28,7 @@ import (
 
 var (
 	rollout_long = templates.LongDesc(`
-		Manage a deployment using subcommands like "kubectl rollout undo deployment/abc"`)
+		Manage the rollout of a resource using subcommands like "kubectl rollout undo deployment/abc"`)
 
 	rollout_example = templates.Examples(`
 		# Rollback to the previous deployment
@@ -37,6 +37,7 @@ var (
 	rollout_valid_resources = dedent.Dedent(`
 		Valid resource types include:
 		   * deployments
+		   * daemonsets
 		`)
 )
 
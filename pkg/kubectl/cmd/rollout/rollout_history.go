@@ -38,13 +38,16 @@ var (
 		kubectl rollout history deployment/abc
 
 		# View the details of deployment revision 3
-		kubectl rollout history deployment/abc --revision=3`)
+		kubectl rollout history deployment/abc --revision=3
+
+		# View the rollout history of a daemonset
+		kubectl rollout history daemonset/abc`)
 )
 
 func NewCmdRolloutHistory(f cmdutil.Factory, out io.Writer) *cobra.Command {
 	options := &resource.FilenameOptions{}
 
-	validArgs := []string{"deployment"}
+	validArgs := []string{"deployment", "daemonset"}
 	argAliases := kubectl.ResourceAliases(validArgs)
 
 	cmd := &cobra.Command{
@@ -44,13 +44,16 @@ var (
 
 	status_example = templates.Examples(`
 		# Watch the rollout status of a deployment
-		kubectl rollout status deployment/nginx`)
+		kubectl rollout status deployment/nginx
+
+		# Watch the rollout status of a daemonset
+		kubectl rollout status daemonset/fluentd`)
 )
 
 func NewCmdRolloutStatus(f cmdutil.Factory, out io.Writer) *cobra.Command {
 	options := &resource.FilenameOptions{}
 
-	validArgs := []string{"deployment"}
+	validArgs := []string{"deployment", "daemonset"}
 	argAliases := kubectl.ResourceAliases(validArgs)
 
 	cmd := &cobra.Command{
@@ -58,13 +58,16 @@ var (
 		kubectl rollout undo deployment/abc --to-revision=3
 
 		# Rollback to the previous deployment with dry-run
-		kubectl rollout undo --dry-run=true deployment/abc`)
+		kubectl rollout undo --dry-run=true deployment/abc
+
+		# Rollback to daemonset revision 3
+		kubectl rollout undo daemonset/abc --to-revision=3`)
 )
 
 func NewCmdRolloutUndo(f cmdutil.Factory, out io.Writer) *cobra.Command {
 	options := &UndoOptions{}
 
-	validArgs := []string{"deployment"}
+	validArgs := []string{"deployment", "daemonset"}
 	argAliases := kubectl.ResourceAliases(validArgs)
 
 	cmd := &cobra.Command{
@@ -119,6 +119,25 @@ func CheckErr(err error) {
 	checkErr("", err, fatalErrHandler)
 }
 
+// CheckDiffErr works like CheckErr, but exits with status 2 instead of 1 on
+// errors, so that they can be told apart from the differences found by
+// kubectl diff. The exit status of the diff program is kept.
+func CheckDiffErr(err error) {
+	checkDiffErr(err, fatalErrHandler)
+}
+
+// checkDiffErr formats a given error as a string and calls the passed
+// handleErr func with that string and an exit code of kubectl diff.
+func checkDiffErr(err error, handleErr func(string, int)) {
+	if _, ok := err.(utilexec.ExitError); ok {
+		checkErr("", err, handleErr)
+		return
+	}
+	checkErr("", err, func(msg string, code int) {
+		handleErr(msg, code+1)
+	})
+}
+
 // checkErrWithPrefix works like CheckErr, but adds a caller-defined prefix to non-nil errors
 func checkErrWithPrefix(prefix string, err error) {
 	checkErr(prefix, err, fatalErrHandler)
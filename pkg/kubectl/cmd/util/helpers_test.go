@@ -283,6 +283,35 @@ func TestCheckExitError(t *testing.T) {
 	})
 }
 
+func TestCheckDiffErr(t *testing.T) {
+	tests := []checkErrTestCase{
+		{
+			uexec.CodeExitError{Err: fmt.Errorf("exit status 1"), Code: 1},
+			"",
+			1,
+		},
+		{
+			fmt.Errorf("some error"),
+			"error: some error",
+			2,
+		},
+	}
+	for _, test := range tests {
+		var errReturned string
+		var codeReturned int
+		checkDiffErr(test.err, func(err string, code int) {
+			errReturned = err
+			codeReturned = code
+		})
+		if errReturned != test.expectedErr {
+			t.Errorf("Got: %s, expected: %s", errReturned, test.expectedErr)
+		}
+		if codeReturned != test.expectedCode {
+			t.Errorf("Got: %d, expected: %d", codeReturned, test.expectedCode)
+		}
+	}
+}
+
 func testCheckError(t *testing.T, tests []checkErrTestCase) {
 	var errReturned string
 	var codeReturned int
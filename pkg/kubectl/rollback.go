@@ -18,22 +18,27 @@ package kubectl
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/apis/apps"
+	appsv1beta1 "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	externalextensions "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
 	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
 	deploymentutil "k8s.io/kubernetes/pkg/controller/deployment/util"
+	"k8s.io/kubernetes/pkg/controller/history"
 	printersinternal "k8s.io/kubernetes/pkg/printers/internalversion"
 	sliceutil "k8s.io/kubernetes/pkg/util/slice"
 )
@@ -47,6 +52,8 @@ func RollbackerFor(kind schema.GroupKind, c clientset.Interface) (Rollbacker, er
 	switch kind {
 	case extensions.Kind("Deployment"), apps.Kind("Deployment"):
 		return &DeploymentRollbacker{c}, nil
+	case extensions.Kind("DaemonSet"):
+		return &DaemonSetRollbacker{c}, nil
 	}
 	return nil, fmt.Errorf("no rollbacker has been implemented for %q", kind)
 }
@@ -93,6 +100,83 @@ func (r *DeploymentRollbacker) Rollback(obj runtime.Object, updatedAnnotations m
 	return result, err
 }
 
+type DaemonSetRollbacker struct {
+	c clientset.Interface
+}
+
+func (r *DaemonSetRollbacker) Rollback(obj runtime.Object, updatedAnnotations map[string]string, toRevision int64, dryRun bool) (string, error) {
+	if toRevision < 0 {
+		return "", fmt.Errorf("unable to find specified revision %v in history", toRevision)
+	}
+	ds, ok := obj.(*extensions.DaemonSet)
+	if !ok {
+		return "", fmt.Errorf("passed object is not a DaemonSet: %#v", obj)
+	}
+	versionedClient := versionedClientsetForDaemonSet(r.c)
+	versionedDS, allHistory, err := controlledHistories(versionedClient, ds.Namespace, ds.Name)
+	if err != nil {
+		return "", fmt.Errorf("unable to find history controlled by daemon set %s: %v", ds.Name, err)
+	}
+	if toRevision == 0 && len(allHistory) <= 1 {
+		return "", fmt.Errorf("no last revision to roll back to")
+	}
+
+	// Find the history to roll back to
+	var toHistory *appsv1beta1.ControllerRevision
+	if toRevision == 0 {
+		// If toRevision == 0, find the revision before the current one
+		history.SortControllerRevisions(allHistory)
+		toHistory = allHistory[len(allHistory)-2]
+	} else {
+		for _, h := range allHistory {
+			if h.Revision == toRevision {
+				toHistory = h
+				break
+			}
+		}
+		if toHistory == nil {
+			return "", fmt.Errorf("unable to find specified revision %v in history", toRevision)
+		}
+	}
+
+	appliedDS, err := applyHistory(versionedDS, toHistory)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse history %s: %v", toHistory.Name, err)
+	}
+	if dryRun {
+		return printTemplate(&appliedDS.Spec.Template)
+	}
+
+	// Skip the rollback if the revision already matches the current daemon set
+	if apiequality.Semantic.DeepEqual(appliedDS.Spec.Template, versionedDS.Spec.Template) {
+		return fmt.Sprintf("skipped rollback (current template already matches revision %d)", toHistory.Revision), nil
+	}
+
+	// Restore the pod template recorded in the revision
+	patch, err := daemonSetRollbackPatch(toHistory, updatedAnnotations)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse history %s: %v", toHistory.Name, err)
+	}
+	if _, err = versionedClient.ExtensionsV1beta1().DaemonSets(ds.Namespace).Patch(ds.Name, types.StrategicMergePatchType, patch); err != nil {
+		return "", fmt.Errorf("failed restoring revision %d: %v", toHistory.Revision, err)
+	}
+	return "rolled back", nil
+}
+
+// daemonSetRollbackPatch returns the patch restoring the pod template recorded in history, which also sets the
+// given annotations on the daemon set, as rolling back a deployment does.
+func daemonSetRollbackPatch(history *appsv1beta1.ControllerRevision, annotations map[string]string) ([]byte, error) {
+	if len(annotations) == 0 {
+		return history.Data.Raw, nil
+	}
+	patch := map[string]interface{}{}
+	if err := json.Unmarshal(history.Data.Raw, &patch); err != nil {
+		return nil, err
+	}
+	patch["metadata"] = map[string]interface{}{"annotations": annotations}
+	return json.Marshal(patch)
+}
+
 // watchRollbackEvent watches for rollback events and returns rollback result
 func watchRollbackEvent(w watch.Interface) string {
 	signals := make(chan os.Signal, 1)
@@ -167,13 +251,7 @@ func simpleDryRun(deployment *extensions.Deployment, c clientset.Interface, toRe
 		if !ok {
 			return "", fmt.Errorf("unable to find specified revision")
 		}
-		buf := bytes.NewBuffer([]byte{})
-		internalTemplate := &api.PodTemplateSpec{}
-		if err := v1.Convert_v1_PodTemplateSpec_To_api_PodTemplateSpec(template, internalTemplate, nil); err != nil {
-			return "", fmt.Errorf("failed to convert podtemplate, %v", err)
-		}
-		printersinternal.DescribePodTemplate(internalTemplate, buf)
-		return buf.String(), nil
+		return printTemplate(template)
 	}
 
 	// Sort the revisionToSpec map by revision
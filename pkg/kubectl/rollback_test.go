@@ -0,0 +1,68 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/kubernetes/pkg/api/v1"
+	appsv1beta1 "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
+	extensionsv1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func TestDaemonSetRollbackPatch(t *testing.T) {
+	ds := &extensionsv1beta1.DaemonSet{}
+	ds.Annotations = map[string]string{"foo": "bar"}
+	ds.Spec.Template.Spec.Containers = []v1.Container{{Name: "app", Image: "app:2"}}
+	history := &appsv1beta1.ControllerRevision{
+		Data: runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"spec":{"containers":[{"name":"app","image":"app:1"}]}},"$patch":"replace"}}`)},
+	}
+	changeCause := map[string]string{ChangeCauseAnnotation: "kubectl rollout undo daemonset/app"}
+
+	patch, err := daemonSetRollbackPatch(history, changeCause)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dsBytes, err := json.Marshal(ds)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	patched, err := strategicpatch.StrategicMergePatch(dsBytes, patch, ds)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := &extensionsv1beta1.DaemonSet{}
+	if err := json.Unmarshal(patched, result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedAnnotations := map[string]string{"foo": "bar", ChangeCauseAnnotation: "kubectl rollout undo daemonset/app"}
+	if !reflect.DeepEqual(expectedAnnotations, result.Annotations) {
+		t.Errorf("expected annotations %v, got %v", expectedAnnotations, result.Annotations)
+	}
+	if image := result.Spec.Template.Spec.Containers[0].Image; image != "app:1" {
+		t.Errorf("expected the template of the revision to be restored, got image %s", image)
+	}
+
+	if patch, err := daemonSetRollbackPatch(history, nil); err != nil || string(patch) != string(history.Data.Raw) {
+		t.Errorf("expected the revision as the patch without annotations, got %s err=%v", patch, err)
+	}
+}
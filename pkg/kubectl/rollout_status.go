@@ -87,12 +87,15 @@ func (s *DeploymentStatusViewer) Status(namespace, name string, revision int64)
 
 // Status returns a message describing daemon set status, and a bool value indicating if the status is considered done
 func (s *DaemonSetStatusViewer) Status(namespace, name string, revision int64) (string, bool, error) {
-	//ignoring revision as DaemonSets does not have history yet
+	//ignoring revision as DaemonSets does not have a revision annotation to pin to
 
 	daemon, err := s.c.DaemonSets(namespace).Get(name, metav1.GetOptions{})
 	if err != nil {
 		return "", false, err
 	}
+	if daemon.Spec.UpdateStrategy.Type != extensions.RollingUpdateDaemonSetStrategyType {
+		return "", true, fmt.Errorf("status is available only for RollingUpdate strategy type")
+	}
 	if daemon.Generation <= daemon.Status.ObservedGeneration {
 		if daemon.Status.UpdatedNumberScheduled < daemon.Status.DesiredNumberScheduled {
 			return fmt.Sprintf("Waiting for rollout to finish: %d out of %d new pod have been updated...\n", daemon.Status.UpdatedNumberScheduled, daemon.Status.DesiredNumberScheduled), false, nil
@@ -136,3 +136,116 @@ func TestDeploymentStatusViewerStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestDaemonSetStatusViewerStatus(t *testing.T) {
+	tests := []struct {
+		generation int64
+		status     extensions.DaemonSetStatus
+		msg        string
+		done       bool
+	}{
+		{
+			generation: 0,
+			status: extensions.DaemonSetStatus{
+				ObservedGeneration:     1,
+				UpdatedNumberScheduled: 0,
+				DesiredNumberScheduled: 1,
+				NumberAvailable:        0,
+			},
+
+			msg:  "Waiting for rollout to finish: 0 out of 1 new pod have been updated...\n",
+			done: false,
+		},
+		{
+			generation: 1,
+			status: extensions.DaemonSetStatus{
+				ObservedGeneration:     1,
+				UpdatedNumberScheduled: 2,
+				DesiredNumberScheduled: 2,
+				NumberAvailable:        1,
+			},
+
+			msg:  "Waiting for rollout to finish: 1 of 2 updated pods are available...\n",
+			done: false,
+		},
+		{
+			generation: 1,
+			status: extensions.DaemonSetStatus{
+				ObservedGeneration:     1,
+				UpdatedNumberScheduled: 2,
+				DesiredNumberScheduled: 2,
+				NumberAvailable:        2,
+			},
+
+			msg:  "daemon set \"foo\" successfully rolled out\n",
+			done: true,
+		},
+		{
+			generation: 2,
+			status: extensions.DaemonSetStatus{
+				ObservedGeneration:     1,
+				UpdatedNumberScheduled: 2,
+				DesiredNumberScheduled: 2,
+				NumberAvailable:        2,
+			},
+
+			msg:  "Waiting for daemon set spec update to be observed...\n",
+			done: false,
+		},
+	}
+
+	for _, test := range tests {
+		d := &extensions.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "bar",
+				Name:       "foo",
+				UID:        "8764ae47-9092-11e4-8393-42010af018ff",
+				Generation: test.generation,
+			},
+			Spec: extensions.DaemonSetSpec{
+				UpdateStrategy: extensions.DaemonSetUpdateStrategy{
+					Type: extensions.RollingUpdateDaemonSetStrategyType,
+				},
+			},
+			Status: test.status,
+		}
+		client := fake.NewSimpleClientset(d).Extensions()
+		dsv := &DaemonSetStatusViewer{c: client}
+		msg, done, err := dsv.Status("bar", "foo", 0)
+		if err != nil {
+			t.Fatalf("DaemonSetStatusViewer.Status(): %v", err)
+		}
+		if done != test.done || msg != test.msg {
+			t.Errorf("DaemonSetStatusViewer.Status() for daemon set with generation %d and status %+v returned %q, %t, want %q, %t",
+				test.generation,
+				test.status,
+				msg,
+				done,
+				test.msg,
+				test.done,
+			)
+		}
+	}
+}
+
+func TestDaemonSetStatusViewerStatusWithWrongUpdateStrategyType(t *testing.T) {
+	d := &extensions.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "bar",
+			Name:      "foo",
+			UID:       "8764ae47-9092-11e4-8393-42010af018ff",
+		},
+		Spec: extensions.DaemonSetSpec{
+			UpdateStrategy: extensions.DaemonSetUpdateStrategy{
+				Type: extensions.OnDeleteDaemonSetStrategyType,
+			},
+		},
+	}
+	client := fake.NewSimpleClientset(d).Extensions()
+	dsv := &DaemonSetStatusViewer{c: client}
+	msg, done, err := dsv.Status("bar", "foo", 0)
+	errMsg := "status is available only for RollingUpdate strategy type"
+	if err == nil || err.Error() != errMsg {
+		t.Errorf("DaemonSetStatusViewer.Status() for daemon set with OnDelete strategy returned %q, %t, %v, want error %q", msg, done, err, errMsg)
+	}
+}
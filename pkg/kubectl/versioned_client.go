@@ -18,6 +18,7 @@ package kubectl
 
 import (
 	externalclientset "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	apps "k8s.io/kubernetes/pkg/client/clientset_generated/clientset/typed/apps/v1beta1"
 	core "k8s.io/kubernetes/pkg/client/clientset_generated/clientset/typed/core/v1"
 	extensions "k8s.io/kubernetes/pkg/client/clientset_generated/clientset/typed/extensions/v1beta1"
 	internalclientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
@@ -32,3 +33,13 @@ func versionedClientsetForDeployment(internalClient internalclientset.Interface)
 		ExtensionsV1beta1Client: extensions.New(internalClient.Extensions().RESTClient()),
 	}
 }
+
+func versionedClientsetForDaemonSet(internalClient internalclientset.Interface) externalclientset.Interface {
+	if internalClient == nil {
+		return &externalclientset.Clientset{}
+	}
+	return &externalclientset.Clientset{
+		AppsV1beta1Client:       apps.New(internalClient.Apps().RESTClient()),
+		ExtensionsV1beta1Client: extensions.New(internalClient.Extensions().RESTClient()),
+	}
+}
@@ -157,6 +157,34 @@ func (x Protocol) String() string {
 }
 func (Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{0} }
 
+type MountPropagation int32
+
+const (
+	// No mount propagation ("private" in Linux terminology).
+	MountPropagation_PROPAGATION_PRIVATE MountPropagation = 0
+	// Mounts get propagated from the host to the container ("rslave" in Linux).
+	MountPropagation_PROPAGATION_HOST_TO_CONTAINER MountPropagation = 1
+	// Mounts get propagated from the host to the container and from the
+	// container to the host ("rshared" in Linux).
+	MountPropagation_PROPAGATION_BIDIRECTIONAL MountPropagation = 2
+)
+
+var MountPropagation_name = map[int32]string{
+	0: "PROPAGATION_PRIVATE",
+	1: "PROPAGATION_HOST_TO_CONTAINER",
+	2: "PROPAGATION_BIDIRECTIONAL",
+}
+var MountPropagation_value = map[string]int32{
+	"PROPAGATION_PRIVATE":           0,
+	"PROPAGATION_HOST_TO_CONTAINER": 1,
+	"PROPAGATION_BIDIRECTIONAL":     2,
+}
+
+func (x MountPropagation) String() string {
+	return proto.EnumName(MountPropagation_name, int32(x))
+}
+func (MountPropagation) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{1} }
+
 type PodSandboxState int32
 
 const (
@@ -176,7 +204,7 @@ var PodSandboxState_value = map[string]int32{
 func (x PodSandboxState) String() string {
 	return proto.EnumName(PodSandboxState_name, int32(x))
 }
-func (PodSandboxState) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{1} }
+func (PodSandboxState) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{2} }
 
 type ContainerState int32
 
@@ -203,7 +231,7 @@ var ContainerState_value = map[string]int32{
 func (x ContainerState) String() string {
 	return proto.EnumName(ContainerState_name, int32(x))
 }
-func (ContainerState) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{2} }
+func (ContainerState) EnumDescriptor() ([]byte, []int) { return fileDescriptorApi, []int{3} }
 
 type VersionRequest struct {
 	// Version of the kubelet runtime API.
@@ -356,6 +384,8 @@ type Mount struct {
 	Readonly bool `protobuf:"varint,3,opt,name=readonly,proto3" json:"readonly,omitempty"`
 	// If set, the mount needs SELinux relabeling.
 	SelinuxRelabel bool `protobuf:"varint,4,opt,name=selinux_relabel,json=selinuxRelabel,proto3" json:"selinux_relabel,omitempty"`
+	// Requested propagation mode.
+	Propagation MountPropagation `protobuf:"varint,5,opt,name=propagation,proto3,enum=runtime.MountPropagation" json:"propagation,omitempty"`
 }
 
 func (m *Mount) Reset()                    { *m = Mount{} }
@@ -390,6 +420,13 @@ func (m *Mount) GetSelinuxRelabel() bool {
 	return false
 }
 
+func (m *Mount) GetPropagation() MountPropagation {
+	if m != nil {
+		return m.Propagation
+	}
+	return MountPropagation_PROPAGATION_PRIVATE
+}
+
 // NamespaceOption provides options for Linux namespaces.
 type NamespaceOption struct {
 	// If set, use the host's network namespace.
@@ -2441,6 +2478,9 @@ type Image struct {
 	// User name that will run the command(s). This is used if UID is not set
 	// and no user is specified when creating container.
 	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	// Key-value pairs attached to the image by its author, if the runtime
+	// reports them.
+	Labels map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *Image) Reset()                    { *m = Image{} }
@@ -2489,6 +2529,13 @@ func (m *Image) GetUsername() string {
 	return ""
 }
 
+func (m *Image) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type ListImagesResponse struct {
 	// List of images.
 	Images []*Image `protobuf:"bytes,1,rep,name=images" json:"images,omitempty"`
@@ -2902,6 +2949,7 @@ func init() {
 	proto.RegisterType((*StatusRequest)(nil), "runtime.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "runtime.StatusResponse")
 	proto.RegisterEnum("runtime.Protocol", Protocol_name, Protocol_value)
+	proto.RegisterEnum("runtime.MountPropagation", MountPropagation_name, MountPropagation_value)
 	proto.RegisterEnum("runtime.PodSandboxState", PodSandboxState_name, PodSandboxState_value)
 	proto.RegisterEnum("runtime.ContainerState", ContainerState_name, ContainerState_value)
 }
@@ -4005,6 +4053,11 @@ func (m *Mount) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Propagation != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Propagation))
+	}
 	return i, nil
 }
 
@@ -6556,6 +6609,23 @@ func (m *Image) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApi(dAtA, i, uint64(len(m.Username)))
 		i += copy(dAtA[i:], m.Username)
 	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -7156,6 +7226,9 @@ func (m *Mount) Size() (n int) {
 	if m.SelinuxRelabel {
 		n += 2
 	}
+	if m.Propagation != 0 {
+		n += 1 + sovApi(uint64(m.Propagation))
+	}
 	return n
 }
 
@@ -8228,6 +8301,14 @@ func (m *Image) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -8492,6 +8573,7 @@ func (this *Mount) String() string {
 		`HostPath:` + fmt.Sprintf("%v", this.HostPath) + `,`,
 		`Readonly:` + fmt.Sprintf("%v", this.Readonly) + `,`,
 		`SelinuxRelabel:` + fmt.Sprintf("%v", this.SelinuxRelabel) + `,`,
+		`Propagation:` + fmt.Sprintf("%v", this.Propagation) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9329,6 +9411,16 @@ func (this *Image) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
 	s := strings.Join([]string{`&Image{`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`RepoTags:` + fmt.Sprintf("%v", this.RepoTags) + `,`,
@@ -9336,6 +9428,7 @@ func (this *Image) String() string {
 		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
 		`Uid:` + strings.Replace(fmt.Sprintf("%v", this.Uid), "Int64Value", "Int64Value", 1) + `,`,
 		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10160,6 +10253,25 @@ func (m *Mount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SelinuxRelabel = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propagation", wireType)
+			}
+			m.Propagation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Propagation |= (MountPropagation(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -19027,6 +19139,122 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			}
 			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthApi
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthApi
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Labels[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Labels[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -20654,226 +20882,231 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 3528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x3a, 0x4d, 0x73, 0x1b, 0xc7,
-	0x95, 0x04, 0x40, 0x82, 0xc0, 0x03, 0x01, 0x82, 0x4d, 0x8a, 0x84, 0x40, 0x89, 0xa2, 0xc6, 0x92,
-	0x2c, 0xc9, 0x16, 0x2d, 0xd1, 0x5e, 0x69, 0x2d, 0x5b, 0xb2, 0x61, 0x92, 0x72, 0xd1, 0x92, 0x20,
-	0x7a, 0x20, 0x79, 0xed, 0xf5, 0x61, 0x76, 0x88, 0x69, 0x82, 0x23, 0x01, 0x33, 0xe3, 0x99, 0x86,
-	0x2c, 0xee, 0x69, 0x8f, 0x7b, 0xd9, 0x2a, 0xef, 0x71, 0x6f, 0x7b, 0x48, 0x95, 0x2b, 0x49, 0x55,
-	0x0e, 0xa9, 0x4a, 0x25, 0x3f, 0xc1, 0x55, 0xa9, 0x54, 0xe5, 0x90, 0x43, 0x72, 0x8b, 0x95, 0x7b,
-	0x7e, 0x43, 0xaa, 0xbf, 0x66, 0x7a, 0xbe, 0x28, 0x52, 0x76, 0xc5, 0xba, 0x4d, 0xbf, 0x7e, 0xfd,
-	0xfa, 0xf5, 0x7b, 0xaf, 0xdf, 0xd7, 0x34, 0x54, 0x4d, 0xcf, 0x5e, 0xf3, 0x7c, 0x97, 0xb8, 0x68,
-	0xda, 0x1f, 0x3b, 0xc4, 0x1e, 0xe1, 0xf6, 0x95, 0x81, 0x4d, 0xf6, 0xc7, 0xbb, 0x6b, 0x7d, 0x77,
-	0xf4, 0xd6, 0xc0, 0x1d, 0xb8, 0x6f, 0xb1, 0xf9, 0xdd, 0xf1, 0x1e, 0x1b, 0xb1, 0x01, 0xfb, 0xe2,
-	0xeb, 0xb4, 0xcb, 0xd0, 0xf8, 0x0c, 0xfb, 0x81, 0xed, 0x3a, 0x3a, 0xfe, 0x6a, 0x8c, 0x03, 0x82,
-	0x5a, 0x30, 0xfd, 0x94, 0x43, 0x5a, 0x85, 0xd5, 0xc2, 0xc5, 0xaa, 0x2e, 0x87, 0xda, 0xb7, 0x05,
-	0x98, 0x0d, 0x91, 0x03, 0xcf, 0x75, 0x02, 0x9c, 0x8f, 0x8d, 0xce, 0xc2, 0x8c, 0xe0, 0xc9, 0x70,
-	0xcc, 0x11, 0x6e, 0x15, 0xd9, 0x74, 0x4d, 0xc0, 0xba, 0xe6, 0x08, 0xa3, 0xd7, 0x61, 0x56, 0xa2,
-	0x48, 0x22, 0x25, 0x86, 0xd5, 0x10, 0x60, 0xb1, 0x1b, 0x5a, 0x83, 0x79, 0x89, 0x68, 0x7a, 0x76,
-	0x88, 0x3c, 0xc9, 0x90, 0xe7, 0xc4, 0x54, 0xc7, 0xb3, 0x05, 0xbe, 0xf6, 0x25, 0x54, 0x37, 0xbb,
-	0xbd, 0x0d, 0xd7, 0xd9, 0xb3, 0x07, 0x94, 0xc5, 0x00, 0xfb, 0x74, 0x4d, 0xab, 0xb0, 0x5a, 0xa2,
-	0x2c, 0x8a, 0x21, 0x6a, 0x43, 0x25, 0xc0, 0xa6, 0xdf, 0xdf, 0xc7, 0x41, 0xab, 0xc8, 0xa6, 0xc2,
-	0x31, 0x5d, 0xe5, 0x7a, 0xc4, 0x76, 0x9d, 0xa0, 0x55, 0xe2, 0xab, 0xc4, 0x50, 0xfb, 0xbf, 0x02,
-	0xd4, 0x76, 0x5c, 0x9f, 0xdc, 0x37, 0x3d, 0xcf, 0x76, 0x06, 0xe8, 0x0a, 0x54, 0x98, 0x2c, 0xfb,
-	0xee, 0x90, 0xc9, 0xa0, 0xb1, 0x3e, 0xb7, 0x26, 0x58, 0x5a, 0xdb, 0x11, 0x13, 0x7a, 0x88, 0x82,
-	0xce, 0x43, 0xa3, 0xef, 0x3a, 0xc4, 0xb4, 0x1d, 0xec, 0x1b, 0x9e, 0xeb, 0x13, 0x26, 0x99, 0x29,
-	0xbd, 0x1e, 0x42, 0x29, 0x71, 0xb4, 0x0c, 0xd5, 0x7d, 0x37, 0x20, 0x1c, 0xa3, 0xc4, 0x30, 0x2a,
-	0x14, 0xc0, 0x26, 0x97, 0x60, 0x9a, 0x4d, 0xda, 0x9e, 0x90, 0x41, 0x99, 0x0e, 0xb7, 0x3d, 0xed,
-	0x9b, 0x02, 0x4c, 0xdd, 0x77, 0xc7, 0x0e, 0x49, 0x6c, 0x63, 0x92, 0x7d, 0xa1, 0x1f, 0x65, 0x1b,
-	0x93, 0xec, 0x47, 0xdb, 0x50, 0x0c, 0xae, 0x22, 0xbe, 0x0d, 0x9d, 0x6c, 0x43, 0xc5, 0xc7, 0xa6,
-	0xe5, 0x3a, 0xc3, 0x03, 0xc6, 0x42, 0x45, 0x0f, 0xc7, 0x54, 0x77, 0x01, 0x1e, 0xda, 0xce, 0xf8,
-	0x99, 0xe1, 0xe3, 0xa1, 0xb9, 0x8b, 0x87, 0x8c, 0x95, 0x8a, 0xde, 0x10, 0x60, 0x9d, 0x43, 0xb5,
-	0xc7, 0x30, 0x4b, 0x95, 0x1d, 0x78, 0x66, 0x1f, 0x3f, 0x60, 0x22, 0xa4, 0xa6, 0xc1, 0x36, 0x75,
-	0x30, 0xf9, 0xda, 0xf5, 0x9f, 0x30, 0xce, 0x2a, 0x7a, 0x8d, 0xc2, 0xba, 0x1c, 0x84, 0x4e, 0x42,
-	0x85, 0xf3, 0x65, 0x5b, 0x8c, 0xad, 0x8a, 0xce, 0x4e, 0xbc, 0x63, 0x5b, 0xe1, 0x94, 0xed, 0xf5,
-	0x05, 0x57, 0xd3, 0xfc, 0xf4, 0x7d, 0x4d, 0x03, 0xd8, 0x76, 0xc8, 0xf5, 0x77, 0x3e, 0x33, 0x87,
-	0x63, 0x8c, 0x16, 0x60, 0xea, 0x29, 0xfd, 0x60, 0xf4, 0x4b, 0x3a, 0x1f, 0x68, 0x7f, 0x2a, 0xc2,
-	0xf2, 0x3d, 0xca, 0x60, 0xcf, 0x74, 0xac, 0x5d, 0xf7, 0x59, 0x0f, 0xf7, 0xc7, 0xbe, 0x4d, 0x0e,
-	0x36, 0x5c, 0x87, 0xe0, 0x67, 0x04, 0x6d, 0xc1, 0x9c, 0x23, 0xf9, 0x35, 0xa4, 0x09, 0x50, 0x0a,
-	0xb5, 0xf5, 0x56, 0xa8, 0xd7, 0xc4, 0x89, 0xf4, 0xa6, 0x13, 0x07, 0x04, 0xe8, 0x83, 0x48, 0x3e,
-	0x92, 0x48, 0x91, 0x11, 0x59, 0x0c, 0x89, 0xf4, 0xb6, 0x18, 0x1f, 0x82, 0x84, 0x94, 0x9b, 0x24,
-	0xf0, 0x36, 0xd0, 0xbb, 0x62, 0x98, 0x81, 0x31, 0x0e, 0xb0, 0xcf, 0x4e, 0x5a, 0x5b, 0x9f, 0x0f,
-	0x17, 0x47, 0xe7, 0xd4, 0xab, 0xfe, 0xd8, 0xe9, 0x04, 0x8f, 0x02, 0xec, 0xb3, 0x1b, 0x25, 0x34,
-	0x64, 0xf8, 0xae, 0x4b, 0xf6, 0x02, 0xa9, 0x15, 0x09, 0xd6, 0x19, 0x14, 0xbd, 0x05, 0xf3, 0xc1,
-	0xd8, 0xf3, 0x86, 0x78, 0x84, 0x1d, 0x62, 0x0e, 0x8d, 0x81, 0xef, 0x8e, 0xbd, 0xa0, 0x35, 0xb5,
-	0x5a, 0xba, 0x58, 0xd2, 0x91, 0x3a, 0xf5, 0x31, 0x9b, 0x41, 0x2b, 0x00, 0x9e, 0x6f, 0x3f, 0xb5,
-	0x87, 0x78, 0x80, 0xad, 0x56, 0x99, 0x11, 0x55, 0x20, 0xda, 0xff, 0x14, 0xe0, 0x04, 0x3b, 0xce,
-	0x8e, 0x6b, 0x09, 0xc9, 0x8a, 0xfb, 0xf7, 0x1a, 0xd4, 0xfb, 0x8c, 0xbc, 0xe1, 0x99, 0x3e, 0x76,
-	0x88, 0x30, 0xc4, 0x19, 0x0e, 0xdc, 0x61, 0x30, 0xf4, 0x00, 0x9a, 0x81, 0x50, 0x84, 0xd1, 0xe7,
-	0x9a, 0x10, 0xf2, 0x3a, 0x17, 0x1e, 0xf9, 0x10, 0xad, 0xe9, 0xb3, 0x41, 0x1c, 0xa0, 0xf9, 0x80,
-	0x22, 0x4e, 0xee, 0x63, 0x62, 0x5a, 0x26, 0x31, 0x11, 0x82, 0x49, 0xe6, 0x8c, 0x38, 0x0b, 0xec,
-	0x1b, 0x35, 0xa1, 0x34, 0x16, 0x56, 0x56, 0xd5, 0xe9, 0x27, 0x3a, 0x05, 0xd5, 0x50, 0x9f, 0xc2,
-	0x23, 0x45, 0x00, 0xea, 0x19, 0x4c, 0x42, 0xf0, 0xc8, 0x23, 0x4c, 0xb6, 0x75, 0x5d, 0x0e, 0xb5,
-	0xdf, 0x4d, 0x42, 0x33, 0x75, 0xfc, 0x1b, 0x50, 0x19, 0x89, 0xed, 0x85, 0x19, 0x2d, 0x47, 0xee,
-	0x21, 0xc5, 0xa1, 0x1e, 0x22, 0xd3, 0xdb, 0x47, 0xed, 0x5a, 0x71, 0x9e, 0xe1, 0x98, 0xca, 0x74,
-	0xe8, 0x0e, 0x0c, 0xcb, 0xf6, 0x71, 0x9f, 0xb8, 0xfe, 0x81, 0xe0, 0x72, 0x66, 0xe8, 0x0e, 0x36,
-	0x25, 0x0c, 0x5d, 0x03, 0xb0, 0x9c, 0x80, 0x8a, 0x73, 0xcf, 0x1e, 0x30, 0x5e, 0x6b, 0xeb, 0x28,
-	0xdc, 0x3b, 0x74, 0x90, 0x7a, 0xd5, 0x72, 0x02, 0xc1, 0xec, 0xbb, 0x50, 0xa7, 0x0e, 0xc7, 0x18,
-	0x71, 0xdf, 0xc6, 0x0d, 0xa2, 0xb6, 0xbe, 0xa0, 0x70, 0x1c, 0x3a, 0x3e, 0x7d, 0xc6, 0x8b, 0x06,
-	0x01, 0xba, 0x05, 0x65, 0x76, 0xe1, 0x83, 0x56, 0x99, 0xad, 0x39, 0x9f, 0x71, 0x4a, 0xbe, 0xcb,
-	0xda, 0x3d, 0x86, 0xb7, 0xe5, 0x10, 0xff, 0x40, 0x17, 0x8b, 0xd0, 0x3d, 0xa8, 0x99, 0x8e, 0xe3,
-	0x12, 0x93, 0xdf, 0x95, 0x69, 0x46, 0xe3, 0x72, 0x3e, 0x8d, 0x4e, 0x84, 0xcc, 0x09, 0xa9, 0xcb,
-	0xd1, 0x3b, 0x30, 0xc5, 0x2e, 0x53, 0xab, 0xc2, 0x4e, 0xbd, 0x12, 0xb7, 0xa1, 0x24, 0x31, 0x9d,
-	0x23, 0xb7, 0xdf, 0x85, 0x9a, 0xc2, 0x1a, 0x35, 0x8c, 0x27, 0xf8, 0x40, 0xd8, 0x0a, 0xfd, 0x8c,
-	0x3c, 0x0a, 0xd7, 0x07, 0x1f, 0xdc, 0x2c, 0xfe, 0x6b, 0xa1, 0x7d, 0x1b, 0x9a, 0x49, 0x8e, 0x8e,
-	0xb3, 0x5e, 0xdb, 0x86, 0x05, 0x7d, 0xec, 0x44, 0x8c, 0xc9, 0x68, 0x7c, 0x0d, 0xca, 0x42, 0x7f,
-	0xdc, 0x76, 0x4e, 0xe6, 0x4a, 0x44, 0x17, 0x88, 0xda, 0x2d, 0x38, 0x91, 0x20, 0x25, 0x62, 0xf5,
-	0x39, 0x68, 0x78, 0xae, 0x65, 0x04, 0x1c, 0x6c, 0xd8, 0x96, 0xbc, 0x89, 0x5e, 0x88, 0xbb, 0x6d,
-	0xd1, 0xe5, 0x3d, 0xe2, 0x7a, 0x69, 0x56, 0x8e, 0xb6, 0xbc, 0x05, 0x8b, 0xc9, 0xe5, 0x7c, 0x7b,
-	0xed, 0x03, 0x58, 0xd2, 0xf1, 0xc8, 0x7d, 0x8a, 0x5f, 0x96, 0x74, 0x1b, 0x5a, 0x69, 0x02, 0x11,
-	0xf1, 0x08, 0xda, 0x23, 0x26, 0x19, 0x07, 0xc7, 0x23, 0x7e, 0x49, 0x25, 0x20, 0xa2, 0x10, 0xa7,
-	0x83, 0x1a, 0x50, 0xb4, 0x3d, 0xb1, 0xa8, 0x68, 0x7b, 0xda, 0x17, 0x50, 0xed, 0xaa, 0xde, 0x40,
-	0x0d, 0x63, 0x55, 0x5d, 0x0e, 0xd1, 0x7a, 0x94, 0x41, 0x14, 0x5f, 0x10, 0x3e, 0xc2, 0xdc, 0xe2,
-	0x6e, 0xca, 0x89, 0x0a, 0x1e, 0xd6, 0x01, 0x42, 0x0f, 0x24, 0xc3, 0x11, 0x4a, 0xd3, 0xd3, 0x15,
-	0x2c, 0xed, 0x67, 0x31, 0x77, 0xa4, 0x1c, 0xc6, 0x0a, 0x0f, 0x63, 0xc5, 0xdc, 0x53, 0xf1, 0x38,
-	0xee, 0x69, 0x0d, 0xa6, 0x02, 0x62, 0x12, 0xee, 0x20, 0x1b, 0xca, 0xe1, 0xe2, 0x5b, 0x62, 0x9d,
-	0xa3, 0xa1, 0xd3, 0x00, 0x7d, 0x1f, 0x9b, 0x04, 0x5b, 0x86, 0xc9, 0x3d, 0x67, 0x49, 0xaf, 0x0a,
-	0x48, 0x87, 0xa0, 0x9b, 0x91, 0x1c, 0xa7, 0x18, 0x1b, 0xab, 0x19, 0x04, 0x63, 0x7a, 0x89, 0x24,
-	0x1d, 0xde, 0xf6, 0xf2, 0xe1, 0xb7, 0x5d, 0xac, 0xe3, 0xc8, 0x8a, 0xc3, 0x9a, 0xce, 0x75, 0x58,
-	0x7c, 0xc5, 0x51, 0x1c, 0x56, 0x25, 0xd7, 0x61, 0x09, 0x1a, 0x87, 0x3a, 0xac, 0x9f, 0xd2, 0xf5,
-	0xdc, 0x87, 0x56, 0xfa, 0xea, 0x08, 0x97, 0x71, 0x0d, 0xca, 0x01, 0x83, 0x1c, 0xe2, 0x7e, 0xc4,
-	0x12, 0x81, 0xa8, 0xdd, 0x81, 0x85, 0x84, 0x05, 0xf0, 0x6c, 0x2c, 0xb4, 0x97, 0xc2, 0x91, 0xec,
-	0x45, 0xfb, 0x7b, 0x41, 0xb5, 0xde, 0x3b, 0xf6, 0x90, 0x60, 0x3f, 0x65, 0xbd, 0x6f, 0x4b, 0xa2,
-	0xdc, 0x74, 0x4f, 0xe7, 0x11, 0xe5, 0x89, 0x92, 0xb0, 0xc4, 0x1e, 0x34, 0x98, 0x0e, 0x8d, 0x00,
-	0x0f, 0x59, 0xa8, 0x64, 0x19, 0x7e, 0x6d, 0xfd, 0xcd, 0x8c, 0xd5, 0x7c, 0x5f, 0x6e, 0x00, 0x3d,
-	0x81, 0xce, 0xd5, 0x57, 0x1f, 0xaa, 0xb0, 0xf6, 0x87, 0x80, 0xd2, 0x48, 0xc7, 0xd2, 0xc3, 0x27,
-	0xf4, 0xee, 0xd3, 0x04, 0x3f, 0x23, 0x06, 0xec, 0x31, 0x36, 0x0e, 0x51, 0x02, 0xe7, 0x53, 0x17,
-	0x88, 0xda, 0xff, 0x97, 0x00, 0xa2, 0xc9, 0x57, 0xf6, 0xd2, 0xdf, 0x08, 0xaf, 0x20, 0xcf, 0x33,
-	0xce, 0x64, 0xd0, 0xcb, 0xbc, 0x7c, 0x77, 0xe2, 0x97, 0x8f, 0x67, 0x1c, 0xe7, 0xb2, 0x56, 0xbf,
-	0xb2, 0xd7, 0x6e, 0x03, 0x16, 0x93, 0xea, 0x16, 0x97, 0xee, 0x12, 0x4c, 0xd9, 0x04, 0x8f, 0x78,
-	0xb9, 0xaa, 0xe6, 0xfc, 0x0a, 0x2e, 0xc7, 0xd0, 0xce, 0x42, 0x75, 0x7b, 0x64, 0x0e, 0x70, 0xcf,
-	0xc3, 0x7d, 0xba, 0x97, 0x4d, 0x07, 0x62, 0x7f, 0x3e, 0xd0, 0xd6, 0xa1, 0x72, 0x17, 0x1f, 0xf0,
-	0x3b, 0x78, 0x44, 0xfe, 0xb4, 0xdf, 0x17, 0x60, 0x89, 0xf9, 0xce, 0x0d, 0x59, 0x2c, 0xea, 0x38,
-	0x70, 0xc7, 0x7e, 0x1f, 0x07, 0x4c, 0xa5, 0xde, 0xd8, 0xf0, 0xb0, 0x6f, 0xbb, 0x96, 0x28, 0xad,
-	0xaa, 0x7d, 0x6f, 0xbc, 0xc3, 0x00, 0xb4, 0xa0, 0xa4, 0xd3, 0x5f, 0x8d, 0x5d, 0x61, 0x5b, 0x25,
-	0xbd, 0xd2, 0xf7, 0xc6, 0x9f, 0xd2, 0xb1, 0x5c, 0x1b, 0xec, 0x9b, 0x3e, 0x0e, 0x98, 0x0d, 0xf1,
-	0xb5, 0x3d, 0x06, 0x40, 0xd7, 0xe0, 0xc4, 0x08, 0x8f, 0x5c, 0xff, 0xc0, 0x18, 0xda, 0x23, 0x9b,
-	0x18, 0xb6, 0x63, 0xec, 0x1e, 0x10, 0x1c, 0x08, 0xc3, 0x41, 0x7c, 0xf2, 0x1e, 0x9d, 0xdb, 0x76,
-	0x3e, 0xa2, 0x33, 0x48, 0x83, 0xba, 0xeb, 0x8e, 0x8c, 0xa0, 0xef, 0xfa, 0xd8, 0x30, 0xad, 0xc7,
-	0x2c, 0x78, 0x94, 0xf4, 0x9a, 0xeb, 0x8e, 0x7a, 0x14, 0xd6, 0xb1, 0x1e, 0x6b, 0x26, 0xd4, 0x63,
-	0xa5, 0x16, 0xad, 0x02, 0x58, 0x4d, 0x25, 0xaa, 0x00, 0xfa, 0x4d, 0x61, 0xbe, 0x3b, 0x94, 0x72,
-	0x60, 0xdf, 0x14, 0x46, 0x0e, 0x3c, 0x59, 0x02, 0xb0, 0x6f, 0x2a, 0xb0, 0x21, 0x7e, 0x2a, 0xaa,
-	0xdd, 0xaa, 0xce, 0x07, 0x9a, 0x05, 0xb0, 0x61, 0x7a, 0xe6, 0xae, 0x3d, 0xb4, 0xc9, 0x01, 0xba,
-	0x04, 0x4d, 0xd3, 0xb2, 0x8c, 0xbe, 0x84, 0xd8, 0x58, 0xb6, 0x1e, 0x66, 0x4d, 0xcb, 0xda, 0x50,
-	0xc0, 0xe8, 0x0d, 0x98, 0xb3, 0x7c, 0xd7, 0x8b, 0xe3, 0xf2, 0x5e, 0x44, 0x93, 0x4e, 0xa8, 0xc8,
-	0xda, 0x6f, 0x4b, 0x70, 0x3a, 0xae, 0x96, 0x64, 0xf1, 0x7a, 0x03, 0x66, 0x12, 0xbb, 0xc6, 0xab,
-	0xc6, 0x88, 0x49, 0x3d, 0x86, 0x98, 0x28, 0xef, 0x8a, 0xc9, 0xf2, 0x2e, 0xbb, 0x2a, 0x2e, 0xfd,
-	0x18, 0x55, 0xf1, 0xe4, 0x0f, 0xa9, 0x8a, 0xa7, 0x8e, 0x54, 0x15, 0x5f, 0x60, 0x7d, 0x26, 0xb9,
-	0x88, 0x15, 0x54, 0x65, 0xde, 0x0c, 0x09, 0x71, 0x1c, 0xd9, 0x8f, 0x4a, 0x54, 0xcf, 0xd3, 0xc7,
-	0xa9, 0x9e, 0x2b, 0x79, 0xd5, 0xb3, 0xf6, 0xf3, 0x02, 0x2c, 0xc4, 0x35, 0x27, 0x0a, 0xae, 0xdb,
-	0x50, 0xf5, 0xe5, 0xd5, 0x12, 0xda, 0x5a, 0x8d, 0xa7, 0x2f, 0xe9, 0x2b, 0xa8, 0x47, 0x4b, 0xd0,
-	0xa7, 0xb9, 0x75, 0xf3, 0x85, 0x1c, 0x32, 0x2f, 0xac, 0x9c, 0x3b, 0x30, 0x17, 0x22, 0x1f, 0x5a,
-	0x38, 0x2b, 0x85, 0x70, 0x31, 0x5e, 0x08, 0x3b, 0x50, 0xde, 0xc4, 0x4f, 0xed, 0x3e, 0xfe, 0x51,
-	0xda, 0x50, 0xab, 0x50, 0xf3, 0xb0, 0x3f, 0xb2, 0x83, 0x20, 0xb4, 0xba, 0xaa, 0xae, 0x82, 0xb4,
-	0xbf, 0x4c, 0xc1, 0x6c, 0x52, 0xb2, 0xd7, 0x53, 0x75, 0x77, 0x3b, 0xba, 0x06, 0xc9, 0xf3, 0x29,
-	0x21, 0xee, 0xa2, 0xf4, 0xa2, 0xc5, 0x44, 0x92, 0x1d, 0x3a, 0x5a, 0xe1, 0x59, 0xe9, 0xf9, 0xfb,
-	0xee, 0x68, 0x64, 0x3a, 0x96, 0x6c, 0x11, 0x8a, 0x21, 0x95, 0x96, 0xe9, 0x0f, 0xa8, 0x6d, 0x53,
-	0x30, 0xfb, 0x46, 0x67, 0xa0, 0x46, 0x93, 0x55, 0xdb, 0x61, 0x65, 0x3b, 0xb3, 0xdc, 0xaa, 0x0e,
-	0x02, 0xb4, 0x69, 0xfb, 0xe8, 0x3c, 0x4c, 0x62, 0xe7, 0xa9, 0x0c, 0x66, 0x51, 0x0f, 0x51, 0x7a,
-	0x6f, 0x9d, 0x4d, 0xa3, 0x0b, 0x50, 0x1e, 0xb9, 0x63, 0x87, 0xc8, 0xb4, 0xb5, 0x11, 0x22, 0xb2,
-	0xc6, 0x9f, 0x2e, 0x66, 0xd1, 0x25, 0x98, 0xb6, 0x98, 0x0e, 0x64, 0x6e, 0x3a, 0x1b, 0x95, 0xfe,
-	0x0c, 0xae, 0xcb, 0x79, 0xf4, 0x7e, 0x18, 0x86, 0xab, 0x89, 0x40, 0x9a, 0x10, 0x6a, 0x66, 0x2c,
-	0xbe, 0x1b, 0x8f, 0xc5, 0xc0, 0x48, 0x5c, 0xca, 0x25, 0x71, 0x78, 0xe1, 0x7e, 0x12, 0x2a, 0x43,
-	0x77, 0xc0, 0xed, 0xa0, 0xc6, 0xeb, 0xa9, 0xa1, 0x3b, 0x60, 0x66, 0xb0, 0x40, 0x73, 0x0f, 0xcb,
-	0x76, 0x5a, 0x33, 0xec, 0x4e, 0xf2, 0x01, 0x0d, 0x29, 0xec, 0xc3, 0x70, 0x9d, 0x3e, 0x6e, 0xd5,
-	0xd9, 0x54, 0x95, 0x41, 0x1e, 0x38, 0x7d, 0x16, 0xf1, 0x08, 0x39, 0x68, 0x35, 0x18, 0x9c, 0x7e,
-	0xd2, 0x94, 0x91, 0x17, 0x0b, 0xb3, 0x89, 0x94, 0x31, 0xeb, 0x7e, 0xbe, 0x02, 0x9d, 0x81, 0x5f,
-	0x17, 0x60, 0x71, 0x83, 0x65, 0x4c, 0x8a, 0x27, 0x38, 0x46, 0x65, 0x8b, 0xae, 0x86, 0x2d, 0x84,
-	0x64, 0x19, 0x9a, 0x3c, 0xac, 0xc0, 0x43, 0x1f, 0x42, 0x43, 0xd2, 0x14, 0x2b, 0x4b, 0x2f, 0x6a,
-	0x3e, 0xd4, 0x03, 0x75, 0xa8, 0xbd, 0x0f, 0x4b, 0x29, 0x9e, 0x45, 0x76, 0x73, 0x16, 0x66, 0x22,
-	0x8f, 0x10, 0xb2, 0x5c, 0x0b, 0x61, 0xdb, 0x96, 0x76, 0x13, 0x4e, 0xf4, 0x88, 0xe9, 0x93, 0xd4,
-	0x81, 0x8f, 0xb0, 0x96, 0xf5, 0x1f, 0xe2, 0x6b, 0x45, 0x8b, 0xa0, 0x07, 0x0b, 0x3d, 0xe2, 0x7a,
-	0x2f, 0x41, 0x94, 0xde, 0x74, 0x7a, 0x6c, 0x77, 0x4c, 0x44, 0x4a, 0x23, 0x87, 0xda, 0x12, 0xef,
-	0x96, 0xa4, 0x77, 0x7b, 0x0f, 0x16, 0x79, 0xb3, 0xe2, 0x65, 0x0e, 0x71, 0x52, 0xb6, 0x4a, 0xd2,
-	0x74, 0x37, 0x61, 0x3e, 0x72, 0xe5, 0x51, 0x75, 0x75, 0x25, 0x5e, 0x5d, 0x2d, 0xa5, 0x75, 0x1c,
-	0x2b, 0xae, 0xfe, 0xb7, 0xa8, 0x38, 0xcc, 0x9c, 0xda, 0x6a, 0x3d, 0x5e, 0x5b, 0x9d, 0xca, 0x21,
-	0x19, 0x2b, 0xad, 0xd2, 0x16, 0x59, 0xca, 0xb0, 0x48, 0x3d, 0x55, 0x80, 0x4d, 0x32, 0xa7, 0xf1,
-	0x46, 0x7a, 0x8b, 0x7f, 0x62, 0xfd, 0xb5, 0xcd, 0xeb, 0xaf, 0x70, 0xeb, 0xb0, 0x81, 0x74, 0x35,
-	0x51, 0x7f, 0xb5, 0xf2, 0xd8, 0x0c, 0xcb, 0xaf, 0xff, 0x9e, 0x84, 0x6a, 0x38, 0x97, 0x12, 0x6c,
-	0x5a, 0x48, 0xc5, 0x0c, 0x21, 0xa9, 0xf1, 0xab, 0xf4, 0x32, 0xf1, 0x6b, 0xf2, 0x45, 0xf1, 0x6b,
-	0x19, 0xaa, 0xec, 0xc3, 0xf0, 0xf1, 0x9e, 0x88, 0x47, 0x15, 0x06, 0xd0, 0xf1, 0x5e, 0x64, 0x50,
-	0xe5, 0xa3, 0x18, 0x54, 0xa2, 0xd0, 0x9b, 0x4e, 0x16, 0x7a, 0xd7, 0xc3, 0x08, 0xc3, 0x63, 0xd1,
-	0x4a, 0x9a, 0x5c, 0x66, 0x6c, 0xd9, 0x8a, 0xc7, 0x16, 0x1e, 0x9e, 0x5e, 0xcb, 0x58, 0xfc, 0xca,
-	0x96, 0x79, 0xf7, 0x78, 0x99, 0xa7, 0x5a, 0x95, 0x70, 0x84, 0xeb, 0x00, 0xe1, 0x9d, 0x97, 0xb5,
-	0x1e, 0x4a, 0x1f, 0x4d, 0x57, 0xb0, 0xa8, 0x57, 0x89, 0xc9, 0x3f, 0xea, 0x72, 0x1e, 0xc1, 0xab,
-	0xfc, 0x52, 0xcd, 0x92, 0x72, 0xda, 0x81, 0xd7, 0x53, 0x9d, 0x81, 0xa3, 0x59, 0xdd, 0x95, 0x78,
-	0x63, 0xe0, 0x78, 0xe6, 0x92, 0xea, 0x0b, 0xb0, 0xa0, 0x6e, 0xfa, 0x62, 0x9a, 0x97, 0x74, 0x55,
-	0x01, 0xe9, 0x10, 0x9a, 0x4a, 0xed, 0xd9, 0x8e, 0x1d, 0xec, 0xf3, 0xf9, 0x32, 0x9b, 0x07, 0x09,
-	0xea, 0xb0, 0x9f, 0xa7, 0xf8, 0x99, 0x4d, 0x8c, 0xbe, 0x6b, 0x61, 0x66, 0x8c, 0x53, 0x7a, 0x85,
-	0x02, 0x36, 0x5c, 0x0b, 0x47, 0x17, 0xa4, 0x72, 0xac, 0x0b, 0x52, 0x4d, 0x5c, 0x90, 0x45, 0x28,
-	0xfb, 0xd8, 0x0c, 0x5c, 0xa7, 0x05, 0xfc, 0x17, 0x2c, 0x1f, 0xd1, 0x58, 0x31, 0xc2, 0x41, 0x40,
-	0x37, 0x10, 0x09, 0x8c, 0x18, 0x2a, 0x69, 0xd6, 0x4c, 0x5e, 0x9a, 0x75, 0x48, 0xbf, 0x31, 0x91,
-	0x66, 0xd5, 0xf3, 0xd2, 0xac, 0xa3, 0xb4, 0x1b, 0x95, 0x24, 0xb2, 0x71, 0x58, 0x12, 0xf9, 0x53,
-	0x5e, 0x9c, 0xbb, 0xb0, 0x94, 0x32, 0x75, 0x71, 0x73, 0xae, 0x26, 0xba, 0x92, 0xad, 0x3c, 0x29,
-	0x84, 0x4d, 0xc9, 0xff, 0x80, 0xd9, 0xad, 0x67, 0xb8, 0xdf, 0x3b, 0x70, 0xfa, 0xc7, 0x08, 0xfb,
-	0x4d, 0x28, 0xf5, 0x47, 0x96, 0x28, 0xc7, 0xe9, 0xa7, 0x9a, 0x08, 0x94, 0xe2, 0x89, 0x80, 0x01,
-	0xcd, 0x68, 0x07, 0xc1, 0xe7, 0x22, 0xe5, 0xd3, 0xa2, 0xc8, 0x94, 0xf8, 0x8c, 0x2e, 0x46, 0x02,
-	0x8e, 0x7d, 0x9f, 0x9d, 0x9a, 0xc3, 0xb1, 0xef, 0xc7, 0xcd, 0xb6, 0x14, 0x37, 0x5b, 0xed, 0x31,
-	0xd4, 0xe8, 0x06, 0x3f, 0x88, 0x7d, 0x91, 0x0d, 0x97, 0xa2, 0x6c, 0x38, 0x4c, 0xaa, 0x27, 0x95,
-	0xa4, 0x5a, 0x5b, 0x85, 0x19, 0xbe, 0x97, 0x38, 0x48, 0x13, 0x4a, 0x63, 0x7f, 0x28, 0xf5, 0x36,
-	0xf6, 0x87, 0xda, 0xbf, 0x43, 0xbd, 0x43, 0x88, 0xd9, 0xdf, 0x3f, 0x06, 0x3f, 0xe1, 0x5e, 0x45,
-	0x35, 0x81, 0x4f, 0xf1, 0xa4, 0x69, 0xd0, 0x90, 0xb4, 0x73, 0xf7, 0xef, 0x02, 0xda, 0x71, 0x7d,
-	0x72, 0xc7, 0xf5, 0xbf, 0x36, 0x7d, 0xeb, 0x78, 0x09, 0x31, 0x82, 0x49, 0xf1, 0xee, 0xa2, 0x74,
-	0x71, 0x4a, 0x67, 0xdf, 0xda, 0xeb, 0x30, 0x1f, 0xa3, 0x97, 0xbb, 0xf1, 0x0d, 0xa8, 0x31, 0x3f,
-	0x21, 0x92, 0xa6, 0x8b, 0x6a, 0xcf, 0xed, 0x30, 0x67, 0x42, 0xcb, 0x6a, 0x1a, 0x08, 0x18, 0x3c,
-	0xf4, 0xda, 0x6f, 0x26, 0x52, 0x8b, 0x85, 0xf8, 0xfa, 0x44, 0x5a, 0xf1, 0xab, 0x02, 0x4c, 0x31,
-	0x78, 0xca, 0x6d, 0x2f, 0x43, 0xd5, 0xc7, 0x9e, 0x6b, 0x10, 0x73, 0x10, 0x3e, 0x65, 0xa1, 0x80,
-	0x87, 0xe6, 0x20, 0x60, 0x2f, 0x71, 0xe8, 0xa4, 0x65, 0x0f, 0x70, 0x40, 0xe4, 0x7b, 0x96, 0x1a,
-	0x85, 0x6d, 0x72, 0x10, 0x15, 0x49, 0x60, 0xff, 0x27, 0xcf, 0x19, 0x26, 0x75, 0xf6, 0x8d, 0xce,
-	0xf3, 0xff, 0xe2, 0x87, 0xb4, 0x58, 0xd8, 0xcf, 0xf2, 0x36, 0x54, 0x12, 0x5d, 0x95, 0x70, 0xac,
-	0xbd, 0x0f, 0x48, 0x3d, 0xb3, 0x10, 0xea, 0x05, 0x28, 0x33, 0x91, 0xc8, 0xa0, 0xd7, 0x88, 0x1f,
-	0x5a, 0x17, 0xb3, 0xda, 0x6d, 0x40, 0x5c, 0x8a, 0xb1, 0x40, 0x77, 0x74, 0x89, 0xbf, 0x07, 0xf3,
-	0xb1, 0xf5, 0xe1, 0x6f, 0xd0, 0x18, 0x81, 0xe4, 0xee, 0x62, 0xf1, 0x1f, 0x0a, 0x00, 0x9d, 0x31,
-	0xd9, 0x17, 0xdd, 0x04, 0xf5, 0x94, 0x85, 0xf8, 0x29, 0xe9, 0x9c, 0x67, 0x06, 0xc1, 0xd7, 0xae,
-	0x2f, 0x33, 0xb9, 0x70, 0xcc, 0x3a, 0x01, 0x63, 0xb2, 0x2f, 0x5b, 0x88, 0xf4, 0x1b, 0x9d, 0x87,
-	0x06, 0x7f, 0x81, 0x64, 0x98, 0x96, 0xe5, 0xe3, 0x20, 0x10, 0xbd, 0xc4, 0x3a, 0x87, 0x76, 0x38,
-	0x90, 0xa2, 0xd9, 0x16, 0x76, 0x88, 0x4d, 0x0e, 0x0c, 0xe2, 0x3e, 0xc1, 0x8e, 0xc8, 0xd1, 0xea,
-	0x12, 0xfa, 0x90, 0x02, 0x29, 0x9a, 0x8f, 0x07, 0x76, 0x40, 0x7c, 0x89, 0x26, 0x7b, 0x5b, 0x02,
-	0xca, 0xd0, 0xb4, 0x6f, 0x0b, 0xd0, 0xdc, 0x19, 0x0f, 0x87, 0xfc, 0x90, 0xc7, 0x95, 0x25, 0x7a,
-	0x5d, 0x9c, 0xa3, 0x98, 0xb0, 0x86, 0x48, 0x44, 0xe2, 0x70, 0x3f, 0xbc, 0x76, 0xbc, 0x0a, 0x73,
-	0x0a, 0xa3, 0x42, 0x69, 0xb1, 0x50, 0x5c, 0x88, 0x87, 0x62, 0x6a, 0x28, 0xbc, 0x5c, 0x7a, 0xb9,
-	0xc3, 0x69, 0x27, 0x60, 0x3e, 0xb6, 0x5e, 0x94, 0x5a, 0x97, 0xa1, 0x2e, 0x7e, 0x38, 0x0a, 0x23,
-	0x38, 0x09, 0x15, 0xea, 0x5e, 0xfa, 0xb6, 0x25, 0x7b, 0xc7, 0xd3, 0x9e, 0x6b, 0x6d, 0xd8, 0x96,
-	0xaf, 0x75, 0xa1, 0xae, 0x73, 0xf2, 0x02, 0xf7, 0x16, 0x34, 0xc4, 0xef, 0x49, 0x23, 0xf6, 0x03,
-	0x3f, 0x6a, 0x74, 0xc6, 0x68, 0xeb, 0x75, 0x47, 0x1d, 0x6a, 0x5f, 0x42, 0xfb, 0x91, 0x67, 0xd1,
-	0x8c, 0x49, 0xa5, 0x2a, 0x8f, 0x76, 0x0b, 0xe4, 0x0b, 0xb9, 0x3c, 0xe2, 0xf1, 0x65, 0x75, 0x5f,
-	0x1d, 0x6a, 0xa7, 0x61, 0x39, 0x93, 0xb8, 0x38, 0xb7, 0x07, 0xcd, 0x68, 0xc2, 0xb2, 0x65, 0xcb,
-	0x9c, 0xb5, 0xc2, 0x0b, 0x4a, 0x2b, 0x7c, 0x31, 0x0c, 0xc3, 0xdc, 0xa1, 0x8b, 0x91, 0x92, 0x19,
-	0x95, 0xf2, 0x32, 0xa3, 0xc9, 0x58, 0x66, 0xa4, 0x7d, 0x12, 0x4a, 0x4f, 0xa4, 0xa5, 0xef, 0xb2,
-	0xdc, 0x98, 0xef, 0x2d, 0xdd, 0xc4, 0xc9, 0x8c, 0xc3, 0x71, 0x0c, 0x5d, 0x41, 0xd6, 0x66, 0xa1,
-	0x1e, 0x73, 0x18, 0xda, 0x87, 0xd0, 0x48, 0x78, 0x80, 0xb5, 0x44, 0xfe, 0x90, 0x12, 0x5b, 0x3c,
-	0x7b, 0xb8, 0x7c, 0x0a, 0x2a, 0xf2, 0x21, 0x1f, 0x9a, 0x86, 0xd2, 0xc3, 0x8d, 0x9d, 0xe6, 0x04,
-	0xfd, 0x78, 0xb4, 0xb9, 0xd3, 0x2c, 0x5c, 0xbe, 0x09, 0xb3, 0x89, 0xbf, 0x5f, 0x68, 0x0e, 0xea,
-	0xbd, 0x4e, 0x77, 0xf3, 0xa3, 0x07, 0x9f, 0x1b, 0xfa, 0x56, 0x67, 0xf3, 0x8b, 0xe6, 0x04, 0x5a,
-	0x80, 0xa6, 0x04, 0x75, 0x1f, 0x3c, 0xe4, 0xd0, 0xc2, 0xe5, 0x27, 0xd0, 0x88, 0x27, 0xc8, 0xe8,
-	0x04, 0xcc, 0x6d, 0x3c, 0xe8, 0x3e, 0xec, 0x6c, 0x77, 0xb7, 0x74, 0x63, 0x43, 0xdf, 0xea, 0x3c,
-	0xdc, 0xda, 0x6c, 0x4e, 0xc4, 0xc1, 0xfa, 0xa3, 0x6e, 0x77, 0xbb, 0xfb, 0x71, 0xb3, 0x40, 0xa9,
-	0x46, 0xe0, 0xad, 0xcf, 0xb7, 0x29, 0x72, 0x31, 0x8e, 0xfc, 0xa8, 0x7b, 0xb7, 0xfb, 0xe0, 0xdf,
-	0xba, 0xcd, 0xd2, 0xfa, 0x2f, 0x6a, 0xd0, 0x90, 0x07, 0xc4, 0x3e, 0x6b, 0xcf, 0xde, 0x86, 0x69,
-	0xf9, 0xc6, 0x32, 0x4a, 0xd9, 0xe3, 0x0f, 0x42, 0xdb, 0xad, 0xf4, 0x84, 0x30, 0x94, 0x09, 0xb4,
-	0xc3, 0x14, 0xa7, 0xfc, 0x69, 0x3c, 0xad, 0x8a, 0x32, 0xf5, 0x2b, 0xb3, 0xbd, 0x92, 0x37, 0x1d,
-	0x52, 0xec, 0x51, 0x6d, 0xa9, 0xef, 0x47, 0x50, 0xb4, 0x26, 0xf3, 0x5d, 0x4a, 0xfb, 0x4c, 0xee,
-	0x7c, 0x48, 0xf4, 0x0b, 0x68, 0x26, 0x5f, 0x8e, 0xa0, 0xa8, 0xcd, 0x9e, 0xf3, 0x2a, 0xa5, 0x7d,
-	0xf6, 0x10, 0x0c, 0x95, 0x74, 0xea, 0x8d, 0xc5, 0x6a, 0xfe, 0x5f, 0xf2, 0x14, 0xe9, 0xbc, 0x5f,
-	0xef, 0x5c, 0x14, 0xf1, 0x3f, 0x84, 0x48, 0x7d, 0xd9, 0x90, 0xf1, 0xa7, 0x58, 0x11, 0x45, 0xf6,
-	0xaf, 0x45, 0x6d, 0x02, 0x7d, 0x06, 0xb3, 0x89, 0xce, 0x1c, 0x8a, 0x56, 0x65, 0xf7, 0x19, 0xdb,
-	0xab, 0xf9, 0x08, 0x71, 0xbd, 0xa9, 0x7d, 0xb7, 0x98, 0xde, 0x32, 0x9a, 0x79, 0x31, 0xbd, 0x65,
-	0x36, 0xec, 0x98, 0x79, 0xc5, 0xba, 0x6b, 0x8a, 0x79, 0x65, 0xb5, 0xf2, 0xda, 0x2b, 0x79, 0xd3,
-	0xea, 0xf1, 0x13, 0x9d, 0x35, 0xe5, 0xf8, 0xd9, 0x0d, 0xbb, 0xf6, 0x6a, 0x3e, 0x42, 0x52, 0x57,
-	0x51, 0x99, 0x9f, 0xd0, 0x55, 0xaa, 0xab, 0x94, 0xd0, 0x55, 0xba, 0x3f, 0x20, 0x74, 0x95, 0xa8,
-	0xd7, 0xcf, 0xe4, 0x96, 0x3a, 0x69, 0x5d, 0x65, 0x57, 0x4f, 0xda, 0x04, 0xea, 0x40, 0x45, 0xd6,
-	0x2a, 0x28, 0xba, 0xdd, 0x89, 0x02, 0xa9, 0x7d, 0x32, 0x63, 0x26, 0x24, 0xf1, 0x2f, 0x30, 0x49,
-	0xa1, 0x68, 0x21, 0x86, 0x24, 0x97, 0x9e, 0x48, 0x40, 0xc3, 0x65, 0xef, 0x41, 0x99, 0xa7, 0xf6,
-	0x28, 0xf2, 0xb9, 0xb1, 0x3a, 0xa2, 0xbd, 0x94, 0x82, 0x87, 0x8b, 0x3f, 0xe1, 0xef, 0xae, 0x45,
-	0x8e, 0x8e, 0x96, 0x63, 0x8f, 0x12, 0xe3, 0x95, 0x40, 0xfb, 0x54, 0xf6, 0x64, 0x48, 0x6b, 0x17,
-	0xe6, 0x33, 0x42, 0x20, 0x8a, 0x5a, 0x4b, 0xf9, 0xd1, 0xb7, 0x7d, 0xee, 0x70, 0x24, 0xf5, 0xb0,
-	0x42, 0x6b, 0x8b, 0xaa, 0xa9, 0x2b, 0xca, 0x5a, 0x4a, 0xc1, 0xe5, 0xe2, 0xf5, 0xdf, 0x14, 0x61,
-	0x86, 0x27, 0x2a, 0xc2, 0x55, 0x7f, 0x0c, 0x10, 0xe5, 0xd2, 0xa8, 0x1d, 0xb3, 0x9e, 0x58, 0x51,
-	0xd1, 0x5e, 0xce, 0x9c, 0x53, 0xc5, 0xa8, 0xa4, 0xc5, 0x8a, 0x18, 0xd3, 0xc9, 0xb6, 0x22, 0xc6,
-	0x8c, 0x4c, 0x5a, 0x9b, 0x40, 0x9b, 0x50, 0x0d, 0x73, 0x35, 0xa4, 0xa4, 0x78, 0x89, 0x44, 0xb3,
-	0xdd, 0xce, 0x9a, 0x52, 0x39, 0x52, 0xf2, 0x2f, 0x85, 0xa3, 0x74, 0x56, 0xa7, 0x70, 0x94, 0x95,
-	0xb2, 0x4d, 0x7c, 0x74, 0xea, 0xbb, 0xef, 0x57, 0x0a, 0x7f, 0xfe, 0x7e, 0x65, 0xe2, 0xbf, 0x9e,
-	0xaf, 0x14, 0xbe, 0x7b, 0xbe, 0x52, 0xf8, 0xe3, 0xf3, 0x95, 0xc2, 0x5f, 0x9f, 0xaf, 0x14, 0xbe,
-	0xf9, 0xdb, 0xca, 0xc4, 0x6e, 0x99, 0x3d, 0xc3, 0x7f, 0xfb, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x60, 0x93, 0xd6, 0x21, 0x3a, 0x31, 0x00, 0x00,
+	// 3616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x3b, 0x4d, 0x73, 0x1b, 0xc7,
+	0xb1, 0x04, 0x40, 0x82, 0x40, 0x83, 0x00, 0xc1, 0x21, 0x45, 0x82, 0xa0, 0x44, 0x51, 0x6b, 0x49,
+	0x96, 0x64, 0x8b, 0x96, 0x68, 0x3f, 0xe9, 0x59, 0xb2, 0x64, 0x43, 0x24, 0xa5, 0x07, 0x4b, 0x02,
+	0xe9, 0x05, 0xa5, 0x67, 0x3f, 0x1f, 0xf6, 0x2d, 0xb1, 0x43, 0x70, 0x25, 0x60, 0x77, 0xbd, 0xbb,
+	0x90, 0xc5, 0x77, 0x7a, 0xc7, 0x5c, 0x52, 0x95, 0x1c, 0x73, 0x48, 0x55, 0x0e, 0xa9, 0x4a, 0x25,
+	0xb9, 0xa5, 0x2a, 0x95, 0xfc, 0x04, 0x57, 0xa5, 0x5c, 0x95, 0x43, 0x0e, 0xc9, 0x2d, 0x56, 0xee,
+	0xf9, 0x0d, 0xa9, 0xf9, 0xd8, 0xd9, 0xd9, 0x2f, 0x8a, 0x94, 0x5c, 0xb1, 0x6e, 0x3b, 0xdd, 0x3d,
+	0x3d, 0x3d, 0xdd, 0x3d, 0x3d, 0xdd, 0x8d, 0x01, 0x94, 0x75, 0xc7, 0x5c, 0x75, 0x5c, 0xdb, 0xb7,
+	0xd1, 0xa4, 0x3b, 0xb2, 0x7c, 0x73, 0x88, 0x9b, 0x97, 0xfb, 0xa6, 0xbf, 0x3f, 0xda, 0x5d, 0xed,
+	0xd9, 0xc3, 0xf7, 0xfa, 0x76, 0xdf, 0x7e, 0x8f, 0xe2, 0x77, 0x47, 0x7b, 0x74, 0x44, 0x07, 0xf4,
+	0x8b, 0xcd, 0x53, 0x2e, 0x41, 0xed, 0x31, 0x76, 0x3d, 0xd3, 0xb6, 0x54, 0xfc, 0xd5, 0x08, 0x7b,
+	0x3e, 0x6a, 0xc0, 0xe4, 0x33, 0x06, 0x69, 0xe4, 0x56, 0x72, 0x17, 0xca, 0x6a, 0x30, 0x54, 0x7e,
+	0x95, 0x83, 0x69, 0x41, 0xec, 0x39, 0xb6, 0xe5, 0xe1, 0x6c, 0x6a, 0x74, 0x06, 0xa6, 0xb8, 0x4c,
+	0x9a, 0xa5, 0x0f, 0x71, 0x23, 0x4f, 0xd1, 0x15, 0x0e, 0xeb, 0xe8, 0x43, 0x8c, 0xde, 0x86, 0xe9,
+	0x80, 0x24, 0x60, 0x52, 0xa0, 0x54, 0x35, 0x0e, 0xe6, 0xab, 0xa1, 0x55, 0x98, 0x0d, 0x08, 0x75,
+	0xc7, 0x14, 0xc4, 0xe3, 0x94, 0x78, 0x86, 0xa3, 0x5a, 0x8e, 0xc9, 0xe9, 0x95, 0x2f, 0xa1, 0xbc,
+	0xd1, 0xe9, 0xae, 0xdb, 0xd6, 0x9e, 0xd9, 0x27, 0x22, 0x7a, 0xd8, 0x25, 0x73, 0x1a, 0xb9, 0x95,
+	0x02, 0x11, 0x91, 0x0f, 0x51, 0x13, 0x4a, 0x1e, 0xd6, 0xdd, 0xde, 0x3e, 0xf6, 0x1a, 0x79, 0x8a,
+	0x12, 0x63, 0x32, 0xcb, 0x76, 0x7c, 0xd3, 0xb6, 0xbc, 0x46, 0x81, 0xcd, 0xe2, 0x43, 0xe5, 0x67,
+	0x39, 0xa8, 0x6c, 0xdb, 0xae, 0xff, 0x50, 0x77, 0x1c, 0xd3, 0xea, 0xa3, 0xcb, 0x50, 0xa2, 0xba,
+	0xec, 0xd9, 0x03, 0xaa, 0x83, 0xda, 0xda, 0xcc, 0x2a, 0x17, 0x69, 0x75, 0x9b, 0x23, 0x54, 0x41,
+	0x82, 0xce, 0x41, 0xad, 0x67, 0x5b, 0xbe, 0x6e, 0x5a, 0xd8, 0xd5, 0x1c, 0xdb, 0xf5, 0xa9, 0x66,
+	0x26, 0xd4, 0xaa, 0x80, 0x12, 0xe6, 0x68, 0x09, 0xca, 0xfb, 0xb6, 0xe7, 0x33, 0x8a, 0x02, 0xa5,
+	0x28, 0x11, 0x00, 0x45, 0x2e, 0xc0, 0x24, 0x45, 0x9a, 0x0e, 0xd7, 0x41, 0x91, 0x0c, 0xdb, 0x8e,
+	0xf2, 0x6d, 0x0e, 0x26, 0x1e, 0xda, 0x23, 0xcb, 0x8f, 0x2d, 0xa3, 0xfb, 0xfb, 0xdc, 0x3e, 0xd2,
+	0x32, 0xba, 0xbf, 0x1f, 0x2e, 0x43, 0x28, 0x98, 0x89, 0xd8, 0x32, 0x04, 0xd9, 0x84, 0x92, 0x8b,
+	0x75, 0xc3, 0xb6, 0x06, 0x07, 0x54, 0x84, 0x92, 0x2a, 0xc6, 0xc4, 0x76, 0x1e, 0x1e, 0x98, 0xd6,
+	0xe8, 0xb9, 0xe6, 0xe2, 0x81, 0xbe, 0x8b, 0x07, 0x54, 0x94, 0x92, 0x5a, 0xe3, 0x60, 0x95, 0x41,
+	0xd1, 0x4d, 0xa8, 0x38, 0xae, 0xed, 0xe8, 0x7d, 0x9d, 0xa8, 0xaf, 0x31, 0x41, 0x35, 0xb4, 0x28,
+	0x34, 0x44, 0xa5, 0xdd, 0x0e, 0x09, 0x54, 0x99, 0x5a, 0x79, 0x02, 0xd3, 0xc4, 0x53, 0x3c, 0x47,
+	0xef, 0xe1, 0x2d, 0xc7, 0xe7, 0x7e, 0x45, 0x25, 0xb6, 0xb0, 0xff, 0xb5, 0xed, 0x3e, 0xa5, 0xdb,
+	0x2a, 0xa9, 0x15, 0x02, 0xeb, 0x30, 0x10, 0x5a, 0x84, 0x12, 0xdb, 0x94, 0x69, 0xd0, 0x3d, 0x95,
+	0x54, 0xaa, 0xae, 0x6d, 0xd3, 0x10, 0x28, 0xd3, 0xe9, 0x35, 0x0a, 0x21, 0xaa, 0xed, 0xf4, 0x14,
+	0x05, 0xa0, 0x6d, 0xf9, 0xd7, 0x3e, 0x78, 0xac, 0x0f, 0x46, 0x18, 0xcd, 0xc1, 0xc4, 0x33, 0xf2,
+	0x41, 0xf9, 0x17, 0x54, 0x36, 0x50, 0xfe, 0x92, 0x87, 0xa5, 0x07, 0x64, 0x77, 0x5d, 0xdd, 0x32,
+	0x76, 0xed, 0xe7, 0x5d, 0xdc, 0x1b, 0xb9, 0xa6, 0x7f, 0xb0, 0x6e, 0x5b, 0x3e, 0x7e, 0xee, 0xa3,
+	0x4d, 0x98, 0xb1, 0x02, 0x79, 0xb5, 0xc0, 0x7f, 0x08, 0x87, 0xca, 0x5a, 0x43, 0x6c, 0x39, 0xb6,
+	0x23, 0xb5, 0x6e, 0x45, 0x01, 0x1e, 0xfa, 0x38, 0x54, 0x6e, 0xc0, 0x24, 0x4f, 0x99, 0xcc, 0x0b,
+	0x26, 0xdd, 0x4d, 0x2a, 0x07, 0x67, 0x11, 0x28, 0x3d, 0x60, 0xf0, 0x3e, 0x90, 0x83, 0xa6, 0xe9,
+	0x9e, 0x36, 0xf2, 0xb0, 0x4b, 0x77, 0x5a, 0x59, 0x9b, 0x15, 0x93, 0xc3, 0x7d, 0xaa, 0x65, 0x77,
+	0x64, 0xb5, 0xbc, 0x47, 0x1e, 0x76, 0xe9, 0x71, 0xe4, 0xe6, 0xd5, 0x5c, 0xdb, 0xf6, 0xf7, 0xbc,
+	0xc0, 0xa4, 0x01, 0x58, 0xa5, 0x50, 0xf4, 0x1e, 0xcc, 0x7a, 0x23, 0xc7, 0x19, 0xe0, 0x21, 0xb6,
+	0x7c, 0x7d, 0xa0, 0xf5, 0x5d, 0x7b, 0xe4, 0x78, 0x8d, 0x89, 0x95, 0xc2, 0x85, 0x82, 0x8a, 0x64,
+	0xd4, 0x3d, 0x8a, 0x41, 0xcb, 0x00, 0x8e, 0x6b, 0x3e, 0x33, 0x07, 0xb8, 0x8f, 0x8d, 0x46, 0x91,
+	0x32, 0x95, 0x20, 0xca, 0x8f, 0x73, 0x70, 0x82, 0x6e, 0x67, 0xdb, 0x36, 0xb8, 0x66, 0xf9, 0xe1,
+	0x7d, 0x0b, 0xaa, 0x3d, 0xca, 0x5e, 0x73, 0x74, 0x17, 0x5b, 0x3e, 0xf7, 0xe2, 0x29, 0x06, 0xdc,
+	0xa6, 0x30, 0xb4, 0x05, 0x75, 0x8f, 0x1b, 0x42, 0xeb, 0x31, 0x4b, 0x70, 0x7d, 0x9d, 0x15, 0x5b,
+	0x3e, 0xc4, 0x6a, 0xea, 0xb4, 0x17, 0x05, 0x28, 0x2e, 0xa0, 0x50, 0x92, 0x87, 0xd8, 0xd7, 0x0d,
+	0xdd, 0xd7, 0x11, 0x82, 0x71, 0x1a, 0xc9, 0x98, 0x08, 0xf4, 0x1b, 0xd5, 0xa1, 0x30, 0xe2, 0x5e,
+	0x56, 0x56, 0xc9, 0x27, 0x3a, 0x09, 0x65, 0x61, 0x4f, 0x1e, 0xce, 0x42, 0x00, 0x09, 0x2b, 0xba,
+	0xef, 0xe3, 0xa1, 0xe3, 0x53, 0xdd, 0x56, 0xd5, 0x60, 0xa8, 0xfc, 0x71, 0x1c, 0xea, 0x89, 0xed,
+	0x5f, 0x87, 0xd2, 0x90, 0x2f, 0xcf, 0xdd, 0x68, 0x29, 0x8c, 0x2d, 0x09, 0x09, 0x55, 0x41, 0x4c,
+	0x8e, 0x2e, 0xf1, 0x6b, 0x29, 0xf2, 0x8a, 0x31, 0xd1, 0xe9, 0xc0, 0xee, 0x6b, 0x86, 0xe9, 0xe2,
+	0x9e, 0x6f, 0xbb, 0x07, 0x5c, 0xca, 0xa9, 0x81, 0xdd, 0xdf, 0x08, 0x60, 0xe8, 0x2a, 0x80, 0x61,
+	0x79, 0x44, 0x9d, 0x7b, 0x66, 0x9f, 0xca, 0x5a, 0x59, 0x43, 0x62, 0x6d, 0x11, 0x5d, 0xd5, 0xb2,
+	0x61, 0x79, 0x5c, 0xd8, 0x0f, 0xa1, 0x4a, 0xa2, 0x95, 0x36, 0x64, 0x81, 0x91, 0x39, 0x44, 0x65,
+	0x6d, 0x4e, 0x92, 0x58, 0x44, 0x4d, 0x75, 0xca, 0x09, 0x07, 0x1e, 0xba, 0x05, 0x45, 0x1a, 0x2d,
+	0xbc, 0x46, 0x91, 0xce, 0x39, 0x97, 0xb2, 0x4b, 0xb6, 0xca, 0xea, 0x03, 0x4a, 0xb7, 0x69, 0xf9,
+	0xee, 0x81, 0xca, 0x27, 0xa1, 0x07, 0x50, 0xd1, 0x2d, 0xcb, 0xf6, 0x75, 0x76, 0x56, 0x26, 0x29,
+	0x8f, 0x4b, 0xd9, 0x3c, 0x5a, 0x21, 0x31, 0x63, 0x24, 0x4f, 0x47, 0x1f, 0xc0, 0x04, 0x3d, 0x4c,
+	0x8d, 0x12, 0xdd, 0xf5, 0x72, 0xd4, 0x87, 0xe2, 0xcc, 0x54, 0x46, 0xdc, 0xfc, 0x10, 0x2a, 0x92,
+	0x68, 0xc4, 0x31, 0x9e, 0xe2, 0x03, 0xee, 0x2b, 0xe4, 0x33, 0x8c, 0x28, 0xcc, 0x1e, 0x6c, 0x70,
+	0x23, 0xff, 0x9f, 0xb9, 0xe6, 0x6d, 0xa8, 0xc7, 0x25, 0x3a, 0xce, 0x7c, 0xa5, 0x0d, 0x73, 0xea,
+	0xc8, 0x0a, 0x05, 0x0b, 0xae, 0xf2, 0xab, 0x50, 0xe4, 0xf6, 0x63, 0xbe, 0xb3, 0x98, 0xa9, 0x11,
+	0x95, 0x13, 0x2a, 0xb7, 0xe0, 0x44, 0x8c, 0x15, 0xbf, 0xe8, 0xcf, 0x42, 0xcd, 0xb1, 0x0d, 0xcd,
+	0x63, 0x60, 0xcd, 0x34, 0x82, 0x93, 0xe8, 0x08, 0xda, 0xb6, 0x41, 0xa6, 0x77, 0x7d, 0xdb, 0x49,
+	0x8a, 0x72, 0xb4, 0xe9, 0x0d, 0x98, 0x8f, 0x4f, 0x67, 0xcb, 0x2b, 0x1f, 0xc3, 0x82, 0x8a, 0x87,
+	0xf6, 0x33, 0xfc, 0xaa, 0xac, 0x9b, 0xd0, 0x48, 0x32, 0x08, 0x99, 0x87, 0xd0, 0xae, 0xaf, 0xfb,
+	0x23, 0xef, 0x78, 0xcc, 0x2f, 0xca, 0x0c, 0xf8, 0x2d, 0xc4, 0xf8, 0xa0, 0x1a, 0xe4, 0x4d, 0x87,
+	0x4f, 0xca, 0x9b, 0x8e, 0xf2, 0x05, 0x94, 0x3b, 0x72, 0x34, 0x90, 0xaf, 0xb1, 0xb2, 0x1a, 0x0c,
+	0xd1, 0x5a, 0x98, 0x7e, 0xe4, 0x5f, 0x72, 0x7d, 0x88, 0xc4, 0xe4, 0x7e, 0x22, 0x88, 0x72, 0x19,
+	0xd6, 0x00, 0x44, 0x04, 0x0a, 0xae, 0x23, 0x94, 0xe4, 0xa7, 0x4a, 0x54, 0xca, 0x2f, 0x23, 0xe1,
+	0x48, 0xda, 0x8c, 0x21, 0x36, 0x63, 0x44, 0xc2, 0x53, 0xfe, 0x38, 0xe1, 0x69, 0x15, 0x26, 0x3c,
+	0x5f, 0xf7, 0x59, 0x80, 0xac, 0xad, 0x35, 0x52, 0x66, 0x91, 0x25, 0xb1, 0xca, 0xc8, 0xd0, 0x29,
+	0x80, 0x9e, 0x8b, 0x75, 0x1f, 0x1b, 0x9a, 0xce, 0x22, 0x67, 0x41, 0x2d, 0x73, 0x48, 0xcb, 0x47,
+	0x37, 0x42, 0x3d, 0x4e, 0x50, 0x31, 0x56, 0x52, 0x18, 0x46, 0xec, 0x12, 0x6a, 0x5a, 0x9c, 0xf6,
+	0xe2, 0xe1, 0xa7, 0x9d, 0xcf, 0x63, 0xc4, 0x52, 0xc0, 0x9a, 0xcc, 0x0c, 0x58, 0x6c, 0xc6, 0x51,
+	0x02, 0x56, 0x29, 0x33, 0x60, 0x71, 0x1e, 0x87, 0x06, 0xac, 0x1f, 0x32, 0xf4, 0x3c, 0x84, 0x46,
+	0xf2, 0xe8, 0xf0, 0x90, 0x71, 0x15, 0x8a, 0x1e, 0x85, 0x1c, 0x12, 0x7e, 0xf8, 0x14, 0x4e, 0xa8,
+	0xdc, 0x85, 0xb9, 0x28, 0x0e, 0xb3, 0x6c, 0x4c, 0xf8, 0x4b, 0xee, 0x48, 0xfe, 0xa2, 0xfc, 0x33,
+	0x27, 0x7b, 0xef, 0x5d, 0x73, 0xe0, 0x63, 0x37, 0xe1, 0xbd, 0xef, 0x07, 0x4c, 0x99, 0xeb, 0x9e,
+	0xca, 0x62, 0xca, 0x12, 0x25, 0xee, 0x89, 0x5d, 0xa8, 0x51, 0x1b, 0x6a, 0x1e, 0x1e, 0xd0, 0xab,
+	0x92, 0x96, 0x07, 0x95, 0xb5, 0x77, 0x53, 0x66, 0xb3, 0x75, 0x99, 0x03, 0x74, 0x39, 0x39, 0x33,
+	0x5f, 0x75, 0x20, 0xc3, 0x9a, 0x9f, 0x00, 0x4a, 0x12, 0x1d, 0xcb, 0x0e, 0x9f, 0x92, 0xb3, 0xef,
+	0xf9, 0xe1, 0xda, 0xd2, 0x1d, 0xb0, 0x47, 0xc5, 0x38, 0xc4, 0x08, 0x4c, 0x4e, 0x95, 0x13, 0x2a,
+	0xbf, 0x28, 0x00, 0x84, 0xc8, 0x37, 0xf6, 0xd0, 0x5f, 0x17, 0x47, 0x90, 0xe5, 0x19, 0xa7, 0x53,
+	0xf8, 0xa5, 0x1e, 0xbe, 0xbb, 0xd1, 0xc3, 0xc7, 0x32, 0x8e, 0xb3, 0x69, 0xb3, 0xdf, 0xd8, 0x63,
+	0xb7, 0x0e, 0xf3, 0x71, 0x73, 0xf3, 0x43, 0x77, 0x11, 0x26, 0x4c, 0x1f, 0x0f, 0x59, 0xad, 0x2b,
+	0xe7, 0xfc, 0x12, 0x2d, 0xa3, 0x50, 0xce, 0x40, 0xb9, 0x3d, 0xd4, 0xfb, 0xb8, 0xeb, 0xe0, 0x1e,
+	0x59, 0xcb, 0x24, 0x03, 0xbe, 0x3e, 0x1b, 0x28, 0x6b, 0x50, 0xba, 0x8f, 0x0f, 0xd8, 0x19, 0x3c,
+	0xa2, 0x7c, 0xca, 0x9f, 0x72, 0xb0, 0x40, 0x63, 0xe7, 0x7a, 0x50, 0x69, 0xaa, 0xd8, 0xb3, 0x47,
+	0x6e, 0x0f, 0x7b, 0xd4, 0xa4, 0xce, 0x48, 0x73, 0xb0, 0x6b, 0xda, 0x06, 0x2f, 0xad, 0xca, 0x3d,
+	0x67, 0xb4, 0x4d, 0x01, 0xa4, 0x1a, 0x25, 0xe8, 0xaf, 0x46, 0x36, 0xf7, 0xad, 0x82, 0x5a, 0xea,
+	0x39, 0xa3, 0xcf, 0xc8, 0x38, 0x98, 0xeb, 0xed, 0xeb, 0x2e, 0xf6, 0x1a, 0x05, 0x31, 0xb7, 0x4b,
+	0x01, 0xe8, 0x2a, 0x9c, 0x18, 0xe2, 0xa1, 0xed, 0x1e, 0x68, 0x03, 0x73, 0x68, 0xfa, 0x9a, 0x69,
+	0x69, 0xbb, 0x07, 0x3e, 0xf6, 0xb8, 0xe3, 0x20, 0x86, 0x7c, 0x40, 0x70, 0x6d, 0xeb, 0x0e, 0xc1,
+	0x20, 0x05, 0xaa, 0xb6, 0x3d, 0xd4, 0xbc, 0x9e, 0xed, 0x62, 0x4d, 0x37, 0x9e, 0xd0, 0xcb, 0xa3,
+	0xa0, 0x56, 0x6c, 0x7b, 0xd8, 0x25, 0xb0, 0x96, 0xf1, 0x44, 0xd1, 0xa1, 0x1a, 0x29, 0xb5, 0x48,
+	0x15, 0x40, 0x6b, 0x2a, 0x5e, 0x05, 0x90, 0x6f, 0x02, 0x73, 0xed, 0x41, 0xa0, 0x07, 0xfa, 0x4d,
+	0x60, 0xfe, 0x81, 0x13, 0x94, 0x00, 0xf4, 0x9b, 0x28, 0x6c, 0x80, 0x9f, 0xf1, 0x52, 0xb9, 0xac,
+	0xb2, 0x81, 0x62, 0x00, 0xac, 0xeb, 0x8e, 0xbe, 0x6b, 0x0e, 0x4c, 0xff, 0x00, 0x5d, 0x84, 0xba,
+	0x6e, 0x18, 0x5a, 0x2f, 0x80, 0x98, 0x38, 0xe8, 0x5b, 0x4c, 0xeb, 0x86, 0xb1, 0x2e, 0x81, 0xd1,
+	0x3b, 0x30, 0x63, 0xb8, 0xb6, 0x13, 0xa5, 0x65, 0x8d, 0x8c, 0x3a, 0x41, 0xc8, 0xc4, 0xca, 0x1f,
+	0x0a, 0x70, 0x2a, 0x6a, 0x96, 0x78, 0xf1, 0x7a, 0x1d, 0xa6, 0x62, 0xab, 0x46, 0xab, 0xc6, 0x50,
+	0x48, 0x35, 0x42, 0x18, 0x2b, 0xef, 0xf2, 0xf1, 0xf2, 0x2e, 0xbd, 0x2a, 0x2e, 0x7c, 0x1f, 0x55,
+	0xf1, 0xf8, 0xeb, 0x54, 0xc5, 0x13, 0x47, 0xaa, 0x8a, 0xcf, 0xc3, 0xb4, 0x34, 0x89, 0x16, 0x54,
+	0x45, 0xd6, 0x49, 0x11, 0x34, 0x56, 0xd0, 0xcc, 0x8a, 0x55, 0xcf, 0x93, 0xc7, 0xa9, 0x9e, 0x4b,
+	0x59, 0xd5, 0xb3, 0xf2, 0xeb, 0x1c, 0xcc, 0x45, 0x2d, 0xc7, 0x0b, 0xae, 0xdb, 0x50, 0x76, 0x83,
+	0xa3, 0xd5, 0xc8, 0xc5, 0x12, 0x9f, 0x8c, 0x23, 0xa8, 0x86, 0x53, 0xd0, 0x67, 0x99, 0x75, 0xf3,
+	0xf9, 0x0c, 0x36, 0x2f, 0xad, 0x9c, 0x5b, 0x30, 0x23, 0x88, 0x0f, 0x2d, 0x9c, 0xa5, 0x42, 0x38,
+	0x1f, 0x2d, 0x84, 0x2d, 0x28, 0x6e, 0xe0, 0x67, 0x66, 0x0f, 0x7f, 0x2f, 0x3d, 0xac, 0x15, 0xa8,
+	0x38, 0xd8, 0x1d, 0x9a, 0x9e, 0x27, 0xbc, 0xae, 0xac, 0xca, 0x20, 0xe5, 0x6f, 0x13, 0x30, 0x1d,
+	0xd7, 0xec, 0xb5, 0x44, 0xdd, 0xdd, 0x0c, 0x8f, 0x41, 0x7c, 0x7f, 0xd2, 0x15, 0x77, 0x21, 0x88,
+	0xa2, 0xf9, 0x58, 0x92, 0x2d, 0x02, 0x2d, 0x8f, 0xac, 0x64, 0xff, 0x3d, 0x7b, 0x38, 0xd4, 0x2d,
+	0x23, 0xe8, 0x2f, 0xf2, 0x21, 0xd1, 0x96, 0xee, 0xf6, 0x89, 0x6f, 0x13, 0x30, 0xfd, 0x46, 0xa7,
+	0xa1, 0x42, 0x92, 0x55, 0xd3, 0xa2, 0x65, 0x3b, 0xf5, 0xdc, 0xb2, 0x0a, 0x1c, 0xb4, 0x61, 0xba,
+	0xe8, 0x1c, 0x8c, 0x63, 0xeb, 0x59, 0x70, 0x99, 0x85, 0x0d, 0xc8, 0x20, 0x7a, 0xab, 0x14, 0x8d,
+	0xce, 0x43, 0x71, 0x68, 0x8f, 0x2c, 0x3f, 0x48, 0x5b, 0x6b, 0xd1, 0x3e, 0x9c, 0xca, 0xb1, 0xe8,
+	0x22, 0x4c, 0x1a, 0xd4, 0x06, 0x41, 0x6e, 0x3a, 0x1d, 0x96, 0xfe, 0x14, 0xae, 0x06, 0x78, 0xf4,
+	0x91, 0xb8, 0x86, 0xcb, 0xb1, 0x8b, 0x34, 0xa6, 0xd4, 0xd4, 0xbb, 0xf8, 0x7e, 0xf4, 0x2e, 0x06,
+	0xca, 0xe2, 0x62, 0x26, 0x8b, 0xc3, 0x0b, 0xf7, 0x45, 0x28, 0x91, 0xc6, 0x06, 0xf5, 0x83, 0x0a,
+	0xab, 0xa7, 0x06, 0x76, 0x9f, 0xba, 0xc1, 0x1c, 0xc9, 0x3d, 0x0c, 0xd3, 0x6a, 0x4c, 0xd1, 0x33,
+	0xc9, 0x06, 0xe4, 0x4a, 0xa1, 0x1f, 0x9a, 0x6d, 0xf5, 0x70, 0xa3, 0x4a, 0x51, 0x65, 0x0a, 0xd9,
+	0xb2, 0x7a, 0xf4, 0xc6, 0xf3, 0xfd, 0x83, 0x46, 0x8d, 0xc2, 0xc9, 0x27, 0x49, 0x19, 0x59, 0xb1,
+	0x30, 0x1d, 0x4b, 0x19, 0xd3, 0xce, 0xe7, 0x1b, 0xd0, 0x19, 0xf8, 0x5d, 0x0e, 0xe6, 0xd7, 0x69,
+	0xc6, 0x24, 0x45, 0x82, 0x63, 0x54, 0xb6, 0xe8, 0x8a, 0x68, 0x21, 0xc4, 0xcb, 0xd0, 0xf8, 0x66,
+	0x39, 0x1d, 0xfa, 0x04, 0x6a, 0x01, 0x4f, 0x3e, 0xb3, 0xf0, 0xb2, 0xe6, 0x43, 0xd5, 0x93, 0x87,
+	0xca, 0x47, 0xb0, 0x90, 0x90, 0x99, 0x67, 0x37, 0x67, 0x60, 0x2a, 0x8c, 0x08, 0x42, 0xe4, 0x8a,
+	0x80, 0xb5, 0x0d, 0xe5, 0x06, 0x69, 0x41, 0xe8, 0xae, 0x9f, 0xd8, 0xf0, 0x11, 0xe6, 0xd2, 0xfe,
+	0x43, 0x74, 0x2e, 0x6f, 0x11, 0x74, 0x61, 0x8e, 0x74, 0x26, 0x5e, 0x81, 0x29, 0x39, 0xe9, 0x64,
+	0xdb, 0xf6, 0xc8, 0xe7, 0x29, 0x4d, 0x30, 0x54, 0x16, 0xe0, 0x44, 0x8c, 0x29, 0x5f, 0xed, 0x26,
+	0xcc, 0xb3, 0x66, 0xc5, 0xab, 0x6c, 0x62, 0x11, 0x16, 0x12, 0x93, 0x39, 0xdf, 0x0d, 0x98, 0x15,
+	0x40, 0xa9, 0xba, 0xba, 0x1c, 0xad, 0xae, 0x16, 0x92, 0x36, 0x8e, 0x14, 0x57, 0x3f, 0xcd, 0x4b,
+	0x01, 0x33, 0xa3, 0xb6, 0x5a, 0x8b, 0xd6, 0x56, 0x27, 0x33, 0x58, 0x46, 0x4a, 0xab, 0xa4, 0x47,
+	0x16, 0x52, 0x3c, 0x52, 0x4d, 0x14, 0x60, 0xe3, 0x34, 0x68, 0xbc, 0x93, 0x5c, 0xe2, 0xdf, 0x58,
+	0x7f, 0xb5, 0x59, 0xfd, 0x25, 0x96, 0x16, 0x0d, 0xa4, 0x2b, 0xb1, 0xfa, 0xab, 0x91, 0x25, 0xa6,
+	0x28, 0xbf, 0x7e, 0x34, 0x0e, 0x65, 0x81, 0x4b, 0x28, 0x36, 0xa9, 0xa4, 0x7c, 0x8a, 0x92, 0xe4,
+	0xfb, 0xab, 0xf0, 0x2a, 0xf7, 0xd7, 0xf8, 0xcb, 0xee, 0xaf, 0x25, 0x28, 0xd3, 0x0f, 0xcd, 0xc5,
+	0x7b, 0xfc, 0x3e, 0x2a, 0x51, 0x80, 0x8a, 0xf7, 0x42, 0x87, 0x2a, 0x1e, 0xc5, 0xa1, 0x62, 0x85,
+	0xde, 0x64, 0xbc, 0xd0, 0xbb, 0x26, 0x6e, 0x18, 0x76, 0x17, 0x2d, 0x27, 0xd9, 0xa5, 0xde, 0x2d,
+	0x9b, 0xd1, 0xbb, 0x85, 0x5d, 0x4f, 0x6f, 0xa5, 0x4c, 0x7e, 0x63, 0xcb, 0xbc, 0x07, 0xac, 0xcc,
+	0x93, 0xbd, 0x8a, 0x07, 0xc2, 0x35, 0x00, 0x71, 0xe6, 0x83, 0x5a, 0x0f, 0x25, 0xb7, 0xa6, 0x4a,
+	0x54, 0x24, 0xaa, 0x44, 0xf4, 0x3f, 0xf2, 0x8e, 0x11, 0x55, 0x7e, 0x2b, 0x67, 0x49, 0x19, 0xed,
+	0xc0, 0x6b, 0x89, 0xce, 0xc0, 0xd1, 0xbc, 0xee, 0x72, 0xb4, 0x31, 0x70, 0x3c, 0x77, 0x49, 0xf4,
+	0x05, 0xe8, 0xa5, 0xae, 0xbb, 0x1c, 0xcd, 0x4a, 0xba, 0x32, 0x87, 0xb4, 0x7c, 0x92, 0x4a, 0xed,
+	0x99, 0x96, 0xe9, 0xed, 0x33, 0x7c, 0x91, 0xe2, 0x21, 0x00, 0xb5, 0xe8, 0x2f, 0xaf, 0xf8, 0xb9,
+	0xe9, 0x6b, 0x3d, 0xdb, 0xc0, 0xd4, 0x19, 0x27, 0xd4, 0x12, 0x01, 0xac, 0xdb, 0x06, 0x0e, 0x0f,
+	0x48, 0xe9, 0x58, 0x07, 0xa4, 0x1c, 0x3b, 0x20, 0xf3, 0x50, 0x74, 0xb1, 0xee, 0xd9, 0x56, 0x03,
+	0x28, 0x86, 0x8f, 0xc8, 0x5d, 0x31, 0xc4, 0x9e, 0x47, 0x16, 0xe0, 0x09, 0x0c, 0x1f, 0x4a, 0x69,
+	0xd6, 0x54, 0x56, 0x9a, 0x75, 0x48, 0xbf, 0x31, 0x96, 0x66, 0x55, 0xb3, 0xd2, 0xac, 0xa3, 0xb4,
+	0x1b, 0xa5, 0x24, 0xb2, 0x76, 0x58, 0x12, 0xf9, 0x43, 0x1e, 0x9c, 0xfb, 0xb0, 0x90, 0x70, 0x75,
+	0x7e, 0x72, 0xae, 0xc4, 0xba, 0x92, 0x8d, 0x2c, 0x2d, 0x88, 0xa6, 0xe4, 0xff, 0xc2, 0xf4, 0xe6,
+	0x73, 0xdc, 0xeb, 0x1e, 0x58, 0xbd, 0x63, 0x5c, 0xfb, 0x75, 0x28, 0xf4, 0x86, 0x06, 0x2f, 0xc7,
+	0xc9, 0xa7, 0x9c, 0x08, 0x14, 0xa2, 0x89, 0x80, 0x06, 0xf5, 0x70, 0x05, 0x2e, 0xe7, 0x3c, 0x91,
+	0xd3, 0x20, 0xc4, 0x84, 0xf9, 0x94, 0xca, 0x47, 0x1c, 0x8e, 0x5d, 0xb7, 0x91, 0x17, 0x70, 0xec,
+	0xba, 0x51, 0xb7, 0x2d, 0x44, 0xdd, 0x56, 0x79, 0x02, 0x15, 0xb2, 0xc0, 0x6b, 0x89, 0xcf, 0xb3,
+	0xe1, 0x42, 0x98, 0x0d, 0x8b, 0xa4, 0x7a, 0x5c, 0x4a, 0xaa, 0x95, 0x15, 0x98, 0x62, 0x6b, 0xf1,
+	0x8d, 0x90, 0x9f, 0x48, 0xdd, 0x41, 0x60, 0xb7, 0x91, 0x3b, 0x50, 0xfe, 0x07, 0xaa, 0x2d, 0xdf,
+	0xd7, 0x7b, 0xfb, 0xc7, 0x90, 0x47, 0xac, 0x95, 0x97, 0xd6, 0x4a, 0xca, 0xa4, 0x28, 0x50, 0x0b,
+	0x78, 0x67, 0xae, 0xdf, 0x21, 0x3f, 0xef, 0xba, 0xfe, 0x5d, 0xdb, 0xfd, 0x5a, 0x77, 0x8d, 0xe3,
+	0x25, 0xc4, 0x08, 0xc6, 0xf9, 0xa3, 0x8d, 0xc2, 0x85, 0x09, 0x95, 0x7e, 0x2b, 0x6f, 0xc3, 0x6c,
+	0x84, 0x5f, 0xe6, 0xc2, 0xd7, 0xa1, 0x42, 0xe3, 0x04, 0x4f, 0x9a, 0x2e, 0xc8, 0x3d, 0xb7, 0xc3,
+	0x82, 0x09, 0x29, 0xab, 0xc9, 0x45, 0x40, 0xe1, 0x22, 0x6a, 0xbf, 0x1b, 0x4b, 0x2d, 0xe6, 0xa2,
+	0xf3, 0x63, 0x69, 0xc5, 0xcf, 0xf3, 0x30, 0x41, 0xe1, 0x89, 0xb0, 0xbd, 0x44, 0xda, 0x08, 0x8e,
+	0xad, 0xf9, 0x7a, 0x5f, 0xbc, 0x83, 0x21, 0x80, 0x1d, 0xbd, 0xef, 0x11, 0xd3, 0x50, 0xa4, 0x61,
+	0xf6, 0xb1, 0xe7, 0x07, 0x8f, 0x61, 0x2a, 0x04, 0xb6, 0xc1, 0x40, 0x44, 0x25, 0x9e, 0xf9, 0x7f,
+	0x2c, 0x67, 0x18, 0x57, 0xe9, 0x37, 0x3a, 0xc7, 0x7e, 0x17, 0x3f, 0xa4, 0xc5, 0x42, 0xf0, 0xe4,
+	0x67, 0xea, 0x58, 0x57, 0x45, 0x8c, 0xd1, 0x5a, 0xec, 0x27, 0x96, 0x66, 0x74, 0x7b, 0x69, 0x71,
+	0xee, 0x35, 0x42, 0x8e, 0xf2, 0x11, 0x20, 0x59, 0xc5, 0xdc, 0x86, 0xe7, 0xa1, 0x48, 0x2d, 0x10,
+	0xdc, 0xb1, 0xb5, 0xa8, 0x10, 0x2a, 0xc7, 0x2a, 0xb7, 0x01, 0x31, 0xa3, 0x45, 0xee, 0xd5, 0xa3,
+	0x1b, 0xf8, 0x26, 0xcc, 0x46, 0xe6, 0x8b, 0x5f, 0x5d, 0x23, 0x0c, 0xe2, 0xab, 0xf3, 0xc9, 0xdf,
+	0xe6, 0x00, 0x5a, 0x23, 0x7f, 0x9f, 0x37, 0x2f, 0x64, 0xa5, 0xe6, 0x62, 0x4a, 0x6d, 0x42, 0xc9,
+	0xd1, 0x3d, 0xef, 0x6b, 0xdb, 0x0d, 0x12, 0x47, 0x31, 0x26, 0x76, 0xd4, 0x47, 0xfe, 0x7e, 0xd0,
+	0xb1, 0x24, 0xdf, 0xa4, 0x05, 0xc3, 0x5e, 0x4b, 0x69, 0xba, 0x61, 0xb8, 0xd8, 0xf3, 0x78, 0xeb,
+	0xb2, 0xca, 0xa0, 0x2d, 0x06, 0x24, 0x64, 0xa6, 0x81, 0x2d, 0x9f, 0x74, 0x92, 0x7c, 0xfb, 0x29,
+	0xb6, 0x78, 0x4a, 0x58, 0x0d, 0xa0, 0x3b, 0x04, 0x48, 0xc8, 0x5c, 0xdc, 0x37, 0x3d, 0xdf, 0x0d,
+	0xc8, 0x82, 0x56, 0x1a, 0x87, 0x52, 0x32, 0xf2, 0xd0, 0xac, 0xbe, 0x3d, 0x1a, 0x0c, 0xd8, 0x26,
+	0x8f, 0xab, 0x4b, 0xf4, 0x36, 0xdf, 0x47, 0x3e, 0xe6, 0x7c, 0xa1, 0x8a, 0xf8, 0xe6, 0x5e, 0xbf,
+	0x54, 0xbd, 0x02, 0x33, 0x92, 0xa0, 0xdc, 0x68, 0x91, 0x9b, 0x3f, 0x17, 0xbd, 0xf9, 0x89, 0xa3,
+	0xb0, 0xea, 0xec, 0xd5, 0x36, 0xa7, 0x9c, 0x80, 0xd9, 0xc8, 0x7c, 0x5e, 0xd9, 0x5d, 0x82, 0x2a,
+	0xff, 0x7d, 0x93, 0x3b, 0xc1, 0x22, 0x94, 0x48, 0x34, 0xeb, 0x99, 0x46, 0xd0, 0xaa, 0x9e, 0x74,
+	0x6c, 0x63, 0xdd, 0x34, 0x5c, 0xa5, 0x03, 0x55, 0x95, 0xb1, 0xe7, 0xb4, 0xb7, 0xa0, 0xc6, 0x7f,
+	0x0d, 0xd5, 0x22, 0xef, 0x05, 0xc2, 0xbe, 0x6a, 0x84, 0xb7, 0x5a, 0xb5, 0xe4, 0xa1, 0xf2, 0x25,
+	0x34, 0x1f, 0x39, 0x86, 0xee, 0xe3, 0x08, 0xd7, 0x60, 0x6b, 0xb7, 0x20, 0x78, 0xcd, 0x97, 0xc5,
+	0x3c, 0x3a, 0xad, 0xea, 0xca, 0x43, 0xe5, 0x14, 0x2c, 0xa5, 0x32, 0xe7, 0xfb, 0x76, 0xa0, 0x1e,
+	0x22, 0x0c, 0x33, 0xe8, 0xd0, 0xd3, 0xce, 0x7b, 0x4e, 0xea, 0xbc, 0xcf, 0x8b, 0x5b, 0x9f, 0xdd,
+	0x1f, 0x7c, 0x24, 0x25, 0x62, 0x85, 0xac, 0x44, 0x6c, 0x3c, 0x92, 0x88, 0x29, 0x9f, 0x0a, 0xed,
+	0xf1, 0x2c, 0xf8, 0x43, 0x9a, 0x8a, 0xb3, 0xb5, 0x83, 0x30, 0xb1, 0x98, 0xb2, 0x39, 0x46, 0xa1,
+	0x4a, 0xc4, 0xca, 0x34, 0x54, 0x23, 0x01, 0x43, 0xf9, 0x04, 0x6a, 0xb1, 0x08, 0xb0, 0x1a, 0x4b,
+	0x57, 0x12, 0x6a, 0x8b, 0x26, 0x2b, 0x97, 0x4e, 0x42, 0x29, 0x78, 0x74, 0x88, 0x26, 0xa1, 0xb0,
+	0xb3, 0xbe, 0x5d, 0x1f, 0x23, 0x1f, 0x8f, 0x36, 0xb6, 0xeb, 0xb9, 0x4b, 0x43, 0xa8, 0xc7, 0x1f,
+	0xdc, 0xa1, 0x05, 0x98, 0xdd, 0x56, 0xb7, 0xb6, 0x5b, 0xf7, 0x5a, 0x3b, 0xed, 0xad, 0x8e, 0xb6,
+	0xad, 0xb6, 0x1f, 0xb7, 0x76, 0x36, 0xeb, 0x63, 0xe8, 0x0c, 0x9c, 0x92, 0x11, 0xff, 0xb5, 0xd5,
+	0xdd, 0xd1, 0x76, 0xb6, 0xb4, 0xf5, 0xad, 0xce, 0x4e, 0xab, 0xdd, 0xd9, 0x54, 0xeb, 0x39, 0x74,
+	0x0a, 0x16, 0x65, 0x92, 0x3b, 0xed, 0x8d, 0xb6, 0xba, 0xb9, 0x4e, 0xbe, 0x5b, 0x0f, 0xea, 0xf9,
+	0x4b, 0x37, 0x60, 0x3a, 0xf6, 0xdb, 0x1e, 0x9a, 0x81, 0x6a, 0xb7, 0xd5, 0xd9, 0xb8, 0xb3, 0xf5,
+	0xb9, 0xa6, 0x6e, 0xb6, 0x36, 0xbe, 0xa8, 0x8f, 0xa1, 0x39, 0xa8, 0x07, 0xa0, 0xce, 0xd6, 0x0e,
+	0x83, 0xe6, 0x2e, 0x3d, 0x85, 0x5a, 0x34, 0xfd, 0x47, 0x27, 0x60, 0x46, 0xac, 0xad, 0xad, 0xab,
+	0x9b, 0xad, 0x9d, 0xcd, 0x8d, 0xfa, 0x58, 0x14, 0xac, 0x3e, 0xea, 0x74, 0xda, 0x9d, 0x7b, 0xf5,
+	0x1c, 0xe1, 0x1a, 0x82, 0x37, 0x3f, 0x6f, 0x13, 0xe2, 0x7c, 0x94, 0xf8, 0x51, 0xe7, 0x7e, 0x67,
+	0xeb, 0xbf, 0x3b, 0xf5, 0xc2, 0xda, 0x6f, 0x2a, 0x50, 0x0b, 0xf4, 0x89, 0x5d, 0xda, 0x7c, 0xbe,
+	0x0d, 0x93, 0xc1, 0xf3, 0xd3, 0xb0, 0x20, 0x89, 0xbe, 0x95, 0x6d, 0x36, 0x92, 0x08, 0xee, 0x97,
+	0x63, 0x68, 0x9b, 0xfa, 0x49, 0xb8, 0x7d, 0x74, 0x4a, 0xb6, 0x5c, 0xe2, 0x87, 0xda, 0xe6, 0x72,
+	0x16, 0x5a, 0x70, 0xec, 0x42, 0x2d, 0xfa, 0x3a, 0x06, 0x85, 0x73, 0x52, 0x5f, 0xdd, 0x34, 0x4f,
+	0x67, 0xe2, 0x05, 0xd3, 0x2f, 0xa0, 0x1e, 0x7f, 0x17, 0x83, 0xc2, 0x1f, 0x11, 0x32, 0xde, 0xdc,
+	0x34, 0xcf, 0x1c, 0x42, 0x21, 0xb3, 0x4e, 0xbc, 0x20, 0x59, 0xc9, 0x7e, 0x03, 0x90, 0x60, 0x9d,
+	0xf5, 0xb0, 0x80, 0xa9, 0x22, 0xfa, 0xfb, 0x27, 0x92, 0xdf, 0x6d, 0x78, 0xfe, 0x61, 0xaa, 0x48,
+	0xff, 0xe1, 0x54, 0x19, 0x43, 0x8f, 0x61, 0x3a, 0xd6, 0x77, 0x44, 0xe1, 0xac, 0xf4, 0x2e, 0x6a,
+	0x73, 0x25, 0x9b, 0x20, 0x6a, 0x37, 0xb9, 0xab, 0x18, 0xb1, 0x5b, 0x4a, 0xab, 0xb2, 0x79, 0x3a,
+	0x13, 0x2f, 0xbb, 0x57, 0xa4, 0x77, 0x28, 0xb9, 0x57, 0x5a, 0xa3, 0xb2, 0xb9, 0x9c, 0x85, 0x96,
+	0xb7, 0x1f, 0xeb, 0x1b, 0x4a, 0xdb, 0x4f, 0x6f, 0x47, 0x36, 0x57, 0xb2, 0x09, 0xe2, 0xb6, 0x12,
+	0x28, 0x2f, 0x66, 0xab, 0x44, 0xcf, 0xac, 0x79, 0x3a, 0x13, 0x1f, 0xb1, 0x55, 0xac, 0x1b, 0x71,
+	0x3a, 0xb3, 0x90, 0x4b, 0xda, 0x2a, 0xbd, 0x36, 0x54, 0xc6, 0x50, 0x0b, 0x4a, 0x41, 0x25, 0x86,
+	0xc2, 0xd3, 0x1d, 0x2b, 0xff, 0x9a, 0x8b, 0x29, 0x18, 0xc1, 0xe2, 0x3f, 0x60, 0x9c, 0x40, 0xd1,
+	0x5c, 0x84, 0x28, 0x98, 0x7a, 0x22, 0x06, 0x15, 0xd3, 0x6e, 0x42, 0x91, 0x15, 0x2e, 0x28, 0x0c,
+	0xf1, 0x91, 0x2a, 0xa9, 0xb9, 0x90, 0x80, 0x8b, 0xc9, 0x9f, 0x42, 0x45, 0xaa, 0x40, 0xd0, 0x52,
+	0xe4, 0xc9, 0x65, 0xb4, 0xce, 0x69, 0x9e, 0x4c, 0x47, 0x0a, 0x5e, 0xbb, 0x30, 0x9b, 0x72, 0xe3,
+	0xa2, 0xb0, 0x71, 0x96, 0x7d, 0xd9, 0x37, 0xcf, 0x1e, 0x4e, 0x24, 0x6f, 0x96, 0x5b, 0x6d, 0x5e,
+	0x76, 0x75, 0xc9, 0x58, 0x0b, 0x09, 0x78, 0x30, 0x79, 0xed, 0xf7, 0x79, 0x98, 0x62, 0x79, 0x11,
+	0x0f, 0xd5, 0xf7, 0x00, 0xc2, 0xd4, 0x1d, 0x35, 0x23, 0xde, 0x13, 0x29, 0x99, 0x9a, 0x4b, 0xa9,
+	0x38, 0x59, 0x8d, 0x52, 0x16, 0x2e, 0xa9, 0x31, 0x99, 0xdb, 0x37, 0x4f, 0xa6, 0x23, 0x05, 0xaf,
+	0x0d, 0x28, 0x8b, 0xd4, 0x10, 0x49, 0x19, 0x65, 0x2c, 0xaf, 0x6d, 0x36, 0xd3, 0x50, 0xb2, 0x44,
+	0x52, 0xba, 0x27, 0x49, 0x94, 0x4c, 0x22, 0x9b, 0x27, 0xd3, 0x91, 0x01, 0xaf, 0x3b, 0x27, 0xbf,
+	0xf9, 0x6e, 0x39, 0xf7, 0xd7, 0xef, 0x96, 0xc7, 0xfe, 0xff, 0xc5, 0x72, 0xee, 0x9b, 0x17, 0xcb,
+	0xb9, 0x3f, 0xbf, 0x58, 0xce, 0xfd, 0xfd, 0xc5, 0x72, 0xee, 0x27, 0xff, 0x58, 0x1e, 0xdb, 0x2d,
+	0xd2, 0x7f, 0x28, 0xbc, 0xff, 0xaf, 0x01, 0x00, 0xb9, 0x95, 0x28, 0x02, 0x55, 0x32, 0x00, 0x00,
 }
@@ -24,6 +24,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -38,16 +39,20 @@ import (
 	certificatesclient "k8s.io/kubernetes/pkg/client/clientset_generated/clientset/typed/certificates/v1beta1"
 )
 
-const (
-	syncPeriod = 1 * time.Hour
-)
-
 // Manager maintains and updates the certificates in use by this certificate
 // manager. In the background it communicates with the API server to get new
 // certificates for certificates about to expire.
 type Manager interface {
+	// SetCertificateSigningRequestClient sets the client interface that is
+	// used for signing new certificates generated as part of rotation. It
+	// allows the client to be provided after the manager was created, when
+	// the client itself depends on the certificate managed here.
+	SetCertificateSigningRequestClient(certificatesclient.CertificateSigningRequestInterface) error
 	// Start the API server status sync loop.
 	Start()
+	// Current returns the currently selected certificate from the
+	// certificate manager, or nil if no certificate is available yet.
+	Current() *tls.Certificate
 	// GetCertificate gets the current certificate from the certificate
 	// manager. This function matches the signature required by
 	// tls.Config.GetCertificate so it can be passed as TLS configuration. A
@@ -56,11 +61,41 @@ type Manager interface {
 	GetCertificate(clientHello *tls.ClientHelloInfo) (*tls.Certificate, error)
 }
 
+// Config is the set of configuration parameters available for a new Manager.
+type Config struct {
+	// CertificateSigningRequestClient will be used for signing new certificate
+	// requests generated when a key rotation occurs. It may be nil, in which
+	// case it must be set later with SetCertificateSigningRequestClient.
+	CertificateSigningRequestClient certificatesclient.CertificateSigningRequestInterface
+	// Template is the CertificateRequest that will be used as a template for
+	// generating certificate signing requests for all new keys generated as
+	// part of rotation.
+	Template *x509.CertificateRequest
+	// GetTemplate returns the CertificateRequest to use for the next
+	// certificate signing request and takes precedence over Template. It
+	// allows the requested names to follow the current state of the host, and
+	// may return nil while no template can be built yet.
+	GetTemplate func() *x509.CertificateRequest
+	// Usages is the types of usages that certificates generated by the manager
+	// can be used for.
+	Usages []certificates.KeyUsage
+	// CertificateStore is a persistent store where the current cert/key is
+	// kept and future cert/key pairs will be persisted after they are
+	// generated.
+	CertificateStore Store
+	// CertificateRotationPercent is the percentage of the certificate lifetime
+	// that must remain before a new certificate is requested. The actual
+	// rotation deadline is jittered to avoid many clients rotating at once. A
+	// value of zero disables rotation.
+	CertificateRotationPercent uint
+}
+
 // Store is responsible for getting and updating the current certificate.
 // Depending on the concrete implementation, the backing store for this
 // behavior may vary.
 type Store interface {
-	// Current returns the currently selected certificate.
+	// Current returns the currently selected certificate. If no certificate
+	// is available, it returns a NoCertKeyError.
 	Current() (*tls.Certificate, error)
 	// Update accepts the PEM data for the cert/key pair and makes the new
 	// cert/key pair the 'current' pair, that will be returned by future calls
@@ -71,6 +106,7 @@ type Store interface {
 type manager struct {
 	certSigningRequestClient certificatesclient.CertificateSigningRequestInterface
 	template                 *x509.CertificateRequest
+	getTemplate              func() *x509.CertificateRequest
 	usages                   []certificates.KeyUsage
 	certStore                Store
 	certAccessLock           sync.RWMutex
@@ -80,28 +116,28 @@ type manager struct {
 
 // NewManager returns a new certificate manager. A certificate manager is
 // responsible for being the authoritative source of certificates in the
-// Kubelet and handling updates due to rotation.
-func NewManager(
-	certSigningRequestClient certificatesclient.CertificateSigningRequestInterface,
-	template *x509.CertificateRequest,
-	usages []certificates.KeyUsage,
-	certificateStore Store,
-	certRotationPercent uint) (Manager, error) {
-
-	cert, err := certificateStore.Current()
+// Kubelet and handling updates due to rotation. If the store does not hold a
+// certificate yet, the manager requests one as soon as it is started.
+func NewManager(config *Config) (Manager, error) {
+	cert, err := config.CertificateStore.Current()
 	if err != nil {
-		return nil, err
+		if _, ok := err.(*NoCertKeyError); !ok {
+			return nil, err
+		}
+		cert = nil
 	}
 
+	certRotationPercent := config.CertificateRotationPercent
 	if certRotationPercent > 100 {
 		certRotationPercent = 100
 	}
 
 	m := manager{
-		certSigningRequestClient: certSigningRequestClient,
-		template:                 template,
-		usages:                   usages,
-		certStore:                certificateStore,
+		certSigningRequestClient: config.CertificateSigningRequestClient,
+		template:                 config.Template,
+		getTemplate:              config.GetTemplate,
+		usages:                   config.Usages,
+		certStore:                config.CertificateStore,
 		cert:                     cert,
 		shouldRotatePercent:      certRotationPercent,
 	}
@@ -122,12 +158,35 @@ func NewManager(
 //    }
 //
 func (m *manager) GetCertificate(clientHello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := m.Current()
+	if cert == nil {
+		return nil, fmt.Errorf("no certificate available")
+	}
+	return cert, nil
+}
+
+// Current returns the currently selected certificate, or nil if no
+// certificate has been obtained yet.
+func (m *manager) Current() *tls.Certificate {
 	m.certAccessLock.RLock()
 	defer m.certAccessLock.RUnlock()
-	return m.cert, nil
+	return m.cert
 }
 
-// Start will start the background work of rotating the certificates.
+// SetCertificateSigningRequestClient sets the client that is used for
+// signing new certificates. It may only be set once, and must be set before
+// the manager is started.
+func (m *manager) SetCertificateSigningRequestClient(certSigningRequestClient certificatesclient.CertificateSigningRequestInterface) error {
+	if m.certSigningRequestClient != nil {
+		return fmt.Errorf("certificate signing request client is already set")
+	}
+	m.certSigningRequestClient = certSigningRequestClient
+	return nil
+}
+
+// Start will start the background work of rotating the certificates. A new
+// certificate is requested immediately if none is available, and otherwise
+// at a jittered deadline before the current certificate expires.
 func (m *manager) Start() {
 	if m.shouldRotatePercent < 1 {
 		glog.V(2).Infof("Certificate rotation is not enabled.")
@@ -145,32 +204,57 @@ func (m *manager) Start() {
 
 	glog.V(2).Infof("Certificate rotation is enabled.")
 	go wait.Forever(func() {
-		for range time.Tick(syncPeriod) {
-			err := m.rotateCerts()
-			if err != nil {
+		if sleepInterval := m.nextRotationDeadline().Sub(time.Now()); sleepInterval > 0 {
+			glog.V(2).Infof("Waiting %v for next certificate rotation", sleepInterval)
+			time.Sleep(sleepInterval)
+		}
+		backoff := wait.Backoff{
+			Duration: 2 * time.Second,
+			Factor:   2,
+			Jitter:   0.1,
+			Steps:    7,
+		}
+		if err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+			if err := m.rotateCerts(); err != nil {
 				glog.Errorf("Could not rotate certificates: %v", err)
+				return false, nil
 			}
+			return true, nil
+		}); err != nil {
+			glog.Errorf("Reached backoff limit, still unable to rotate certificates: %v", err)
 		}
 	}, 0)
 }
 
-// shouldRotate looks at how close the current certificate is to expiring and
-// decides if it is time to rotate or not.
-func (m *manager) shouldRotate() bool {
+// nextRotationDeadline returns the time at which the current certificate
+// should be replaced. The deadline is picked at random between 80% and 100% of
+// the part of the certificate lifetime that may pass before the configured
+// rotation percentage is reached, so that a fleet of kubelets does not rotate
+// all at once. Without a certificate, the deadline is now.
+func (m *manager) nextRotationDeadline() time.Time {
 	m.certAccessLock.RLock()
 	defer m.certAccessLock.RUnlock()
-	notAfter := m.cert.Leaf.NotAfter
-	total := notAfter.Sub(m.cert.Leaf.NotBefore)
-	remaining := notAfter.Sub(time.Now())
-	return remaining < 0 || uint(remaining*100/total) < m.shouldRotatePercent
+	if m.cert == nil || m.cert.Leaf == nil {
+		return time.Now()
+	}
+	notBefore := m.cert.Leaf.NotBefore
+	total := float64(m.cert.Leaf.NotAfter.Sub(notBefore))
+	usable := total * float64(100-m.shouldRotatePercent) / 100
+	return notBefore.Add(time.Duration(usable * (0.8 + 0.2*rand.Float64())))
 }
 
 func (m *manager) rotateCerts() error {
-	if !m.shouldRotate() {
-		return nil
+	glog.V(2).Infof("Rotating certificates")
+
+	template := m.template
+	if m.getTemplate != nil {
+		template = m.getTemplate()
+	}
+	if template == nil {
+		return fmt.Errorf("no certificate request template available yet")
 	}
 
-	csrPEM, keyPEM, err := m.generateCSR()
+	csrPEM, keyPEM, err := m.generateCSR(template)
 	if err != nil {
 		return err
 	}
@@ -193,7 +277,7 @@ func (m *manager) rotateCerts() error {
 	return nil
 }
 
-func (m *manager) generateCSR() (csrPEM []byte, keyPEM []byte, err error) {
+func (m *manager) generateCSR(template *x509.CertificateRequest) (csrPEM []byte, keyPEM []byte, err error) {
 	// Generate a new private key.
 	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
 	if err != nil {
@@ -206,7 +290,7 @@ func (m *manager) generateCSR() (csrPEM []byte, keyPEM []byte, err error) {
 
 	keyPEM = pem.EncodeToMemory(&pem.Block{Type: cert.ECPrivateKeyBlockType, Bytes: der})
 
-	csrPEM, err = cert.MakeCSRFromTemplate(privateKey, m.template)
+	csrPEM, err = cert.MakeCSRFromTemplate(privateKey, template)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to create a csr from the private key: %v", err)
 	}
@@ -85,12 +85,44 @@ func TestNewManagerNoRotation(t *testing.T) {
 	}
 
 	store := &fakeStore{cert: &cert}
-	if _, err := NewManager(nil, &x509.CertificateRequest{}, []certificates.KeyUsage{}, store, 0); err != nil {
+	if _, err := NewManager(&Config{
+		Template:         &x509.CertificateRequest{},
+		Usages:           []certificates.KeyUsage{},
+		CertificateStore: store,
+	}); err != nil {
 		t.Fatalf("Failed to initialize the certificate manager: %v", err)
 	}
 }
 
-func TestShouldRotate(t *testing.T) {
+func TestNewManagerBootstrap(t *testing.T) {
+	noKeyErr := NoCertKeyError("no cert/key available")
+	store := &fakeStore{err: &noKeyErr}
+	m, err := NewManager(&Config{
+		Template:                   &x509.CertificateRequest{},
+		Usages:                     []certificates.KeyUsage{},
+		CertificateStore:           store,
+		CertificateRotationPercent: 10,
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize the certificate manager: %v", err)
+	}
+	if cert := m.Current(); cert != nil {
+		t.Errorf("Expected no certificate, got %v", cert)
+	}
+	if _, err := m.GetCertificate(nil); err == nil {
+		t.Errorf("Expected an error from 'GetCertificate' without a certificate.")
+	}
+	if deadline := m.(*manager).nextRotationDeadline(); deadline.After(time.Now()) {
+		t.Errorf("Expected a certificate to be requested immediately, got deadline %v", deadline)
+	}
+
+	store = &fakeStore{err: fmt.Errorf("unreadable")}
+	if _, err := NewManager(&Config{CertificateStore: store}); err == nil {
+		t.Errorf("Expected an error for a store that cannot be read.")
+	}
+}
+
+func TestNextRotationDeadline(t *testing.T) {
 	now := time.Now()
 	tests := []struct {
 		name         string
@@ -101,6 +133,7 @@ func TestShouldRotate(t *testing.T) {
 		{"half way", now.Add(-24 * time.Hour), now.Add(24 * time.Hour), false},
 		{"nearly there", now.Add(-100 * time.Hour), now.Add(1 * time.Hour), true},
 		{"just started", now.Add(-1 * time.Hour), now.Add(100 * time.Hour), false},
+		{"expired", now.Add(-2 * time.Hour), now.Add(-1 * time.Hour), true},
 	}
 
 	for _, test := range tests {
@@ -116,13 +149,22 @@ func TestShouldRotate(t *testing.T) {
 			shouldRotatePercent: 10,
 		}
 
-		if m.shouldRotate() != test.shouldRotate {
-			t.Errorf("For test case %s, time %v, a certificate issued for (%v, %v) should rotate should be %t.",
+		deadline := m.nextRotationDeadline()
+		if shouldRotate := !deadline.After(now); shouldRotate != test.shouldRotate {
+			t.Errorf("For test case %s, time %v, a certificate issued for (%v, %v) should rotate should be %t, deadline %v.",
 				test.name,
 				now,
 				m.cert.Leaf.NotBefore,
 				m.cert.Leaf.NotAfter,
-				test.shouldRotate)
+				test.shouldRotate,
+				deadline)
+		}
+
+		total := test.notAfter.Sub(test.notBefore)
+		lowerBound := test.notBefore.Add(time.Duration(float64(total) * 0.9 * 0.8))
+		upperBound := test.notBefore.Add(time.Duration(float64(total) * 0.9))
+		if deadline.Before(lowerBound) || deadline.After(upperBound) {
+			t.Errorf("For test case %s, deadline %v is outside of the jitter window (%v, %v).", test.name, deadline, lowerBound, upperBound)
 		}
 	}
 }
@@ -240,9 +282,13 @@ func (w *fakeWatch) ResultChan() <-chan watch.Event {
 
 type fakeStore struct {
 	cert *tls.Certificate
+	err  error
 }
 
 func (s *fakeStore) Current() (*tls.Certificate, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
 	return s.cert, nil
 }
 
@@ -257,3 +303,17 @@ func (s *fakeStore) Update(certPEM, keyPEM []byte) (*tls.Certificate, error) {
 	s.cert = &cert
 	return s.cert, nil
 }
+
+func TestRotateCertWithoutTemplate(t *testing.T) {
+	m := manager{
+		getTemplate: func() *x509.CertificateRequest { return nil },
+		usages:      []certificates.KeyUsage{},
+		certSigningRequestClient: fakeClient{
+			failureType: none,
+		},
+	}
+
+	if err := m.rotateCerts(); err == nil {
+		t.Errorf("Expected an error from 'rotateCerts' without a template.")
+	}
+}
@@ -40,6 +40,12 @@ const (
 	updatedPair   = "updated"
 )
 
+// NoCertKeyError is returned by Current when no cert/key pair is available
+// yet, for example before the first certificate has been requested.
+type NoCertKeyError string
+
+func (e *NoCertKeyError) Error() string { return string(*e) }
+
 type fileStore struct {
 	pairNamePrefix string
 	certDirectory  string
@@ -148,12 +154,14 @@ func (s *fileStore) Current() (*tls.Certificate, error) {
 		return loadX509KeyPair(c, k)
 	}
 
-	return nil, fmt.Errorf("no cert/key files read at %q, (%q, %q) or (%q, %q)",
-		pairFile,
-		s.certFile,
-		s.keyFile,
-		s.certDirectory,
-		s.keyDirectory)
+	noKeyErr := NoCertKeyError(
+		fmt.Sprintf("no cert/key files read at %q, (%q, %q) or (%q, %q)",
+			pairFile,
+			s.certFile,
+			s.keyFile,
+			s.certDirectory,
+			s.keyDirectory))
+	return nil, &noKeyErr
 }
 
 func loadFile(pairFile string) (*tls.Certificate, error) {
@@ -469,3 +469,28 @@ func TestCurrentCertKeyFiles(t *testing.T) {
 		t.Fatalf("Got an empty leaf, expected private data.")
 	}
 }
+
+func TestCurrentNoFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k8s-test-certstore-current")
+	if err != nil {
+		t.Fatalf("Unable to create the test directory %q: %v", dir, err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Errorf("Unable to clean up test directory %q: %v", dir, err)
+		}
+	}()
+
+	store, err := NewFileStore("kubelet", dir, dir, "", "")
+	if err != nil {
+		t.Fatalf("Failed to initialize certificate store: %v", err)
+	}
+
+	cert, err := store.Current()
+	if err == nil {
+		t.Fatalf("Expected an error, got certificate %v", cert)
+	}
+	if _, ok := err.(*NoCertKeyError); !ok {
+		t.Errorf("Expected a NoCertKeyError, got %v", err)
+	}
+}
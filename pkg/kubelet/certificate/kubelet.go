@@ -0,0 +1,168 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/api/v1"
+	certificates "k8s.io/kubernetes/pkg/apis/certificates/v1beta1"
+	"k8s.io/kubernetes/pkg/apis/componentconfig"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	certificatesclient "k8s.io/kubernetes/pkg/client/clientset_generated/clientset/typed/certificates/v1beta1"
+)
+
+const (
+	// kubeletServerPairNamePrefix is the prefix of the files in the
+	// certificate directory holding the kubelet serving cert/key pair.
+	kubeletServerPairNamePrefix = "kubelet-server"
+	// kubeletClientPairNamePrefix is the prefix of the files in the
+	// certificate directory holding the kubelet client cert/key pair.
+	kubeletClientPairNamePrefix = "kubelet-client"
+	// kubeletCertificateRotationPercent is the share of the certificate
+	// lifetime that is left when the kubelet requests a new certificate.
+	kubeletCertificateRotationPercent = 10
+)
+
+// NewKubeletServerCertificateManager creates a certificate manager for the
+// kubelet serving certificate. The certificate is requested through the
+// certificates API on behalf of the node, with the node addresses returned by
+// getAddresses as subject alternative names.
+func NewKubeletServerCertificateManager(kubeClient clientset.Interface, kubeCfg *componentconfig.KubeletConfiguration, nodeName types.NodeName, getAddresses func() []v1.NodeAddress) (Manager, error) {
+	var certSigningRequestClient certificatesclient.CertificateSigningRequestInterface
+	if kubeClient != nil {
+		certSigningRequestClient = kubeClient.Certificates().CertificateSigningRequests()
+	}
+	certificateStore, err := NewFileStore(
+		kubeletServerPairNamePrefix,
+		kubeCfg.CertDirectory,
+		kubeCfg.CertDirectory,
+		kubeCfg.TLSCertFile,
+		kubeCfg.TLSPrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize server certificate store: %v", err)
+	}
+	m, err := NewManager(&Config{
+		CertificateSigningRequestClient: certSigningRequestClient,
+		GetTemplate: func() *x509.CertificateRequest {
+			return newServerCertificateTemplate(nodeName, getAddresses())
+		},
+		Usages: []certificates.KeyUsage{
+			// https://tools.ietf.org/html/rfc5280#section-4.2.1.3
+			//
+			// Digital signature allows the certificate to be used to verify
+			// digital signatures used during TLS negotiation.
+			certificates.UsageDigitalSignature,
+			// KeyEncipherment allows the cert/key pair to be used to encrypt
+			// keys, including the symmetric keys negotiated during TLS setup
+			// and used for data transfer.
+			certificates.UsageKeyEncipherment,
+			// ServerAuth allows the cert to be used by a TLS server to
+			// authenticate itself to a TLS client.
+			certificates.UsageServerAuth,
+		},
+		CertificateStore:           certificateStore,
+		CertificateRotationPercent: kubeletCertificateRotationPercent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize server certificate manager: %v", err)
+	}
+	return m, nil
+}
+
+// NewKubeletClientCertificateManager creates a certificate manager for the
+// kubelet client certificate, starting from the cert/key pair the kubelet was
+// configured or bootstrapped with. The client used to request new
+// certificates depends on the managed certificate, so it is set afterwards
+// with SetCertificateSigningRequestClient.
+func NewKubeletClientCertificateManager(certDirectory string, nodeName types.NodeName, certFile, keyFile string) (Manager, error) {
+	certificateStore, err := NewFileStore(
+		kubeletClientPairNamePrefix,
+		certDirectory,
+		certDirectory,
+		certFile,
+		keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client certificate store: %v", err)
+	}
+	m, err := NewManager(&Config{
+		Template: &x509.CertificateRequest{
+			Subject: pkix.Name{
+				CommonName:   fmt.Sprintf("system:node:%s", nodeName),
+				Organization: []string{"system:nodes"},
+			},
+		},
+		Usages: []certificates.KeyUsage{
+			// https://tools.ietf.org/html/rfc5280#section-4.2.1.3
+			//
+			// DigitalSignature and KeyEncipherment are required to negotiate
+			// TLS, see NewKubeletServerCertificateManager.
+			certificates.UsageDigitalSignature,
+			certificates.UsageKeyEncipherment,
+			// ClientAuth allows the cert to be used by a TLS client to
+			// authenticate itself to the TLS server.
+			certificates.UsageClientAuth,
+		},
+		CertificateStore:           certificateStore,
+		CertificateRotationPercent: kubeletCertificateRotationPercent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client certificate manager: %v", err)
+	}
+	return m, nil
+}
+
+// newServerCertificateTemplate returns the certificate request for a kubelet
+// serving certificate with the given node addresses as subject alternative
+// names, or nil if no address is known yet.
+func newServerCertificateTemplate(nodeName types.NodeName, addresses []v1.NodeAddress) *x509.CertificateRequest {
+	dnsNames := sets.NewString()
+	ipAddresses := sets.NewString()
+	for _, address := range addresses {
+		if len(address.Address) == 0 {
+			continue
+		}
+		switch address.Type {
+		case v1.NodeHostName, v1.NodeInternalDNS, v1.NodeExternalDNS:
+			dnsNames.Insert(address.Address)
+		case v1.NodeInternalIP, v1.NodeExternalIP, v1.NodeLegacyHostIP:
+			if ip := net.ParseIP(address.Address); ip != nil {
+				ipAddresses.Insert(ip.String())
+			}
+		}
+	}
+	if dnsNames.Len() == 0 && ipAddresses.Len() == 0 {
+		return nil
+	}
+
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   fmt.Sprintf("system:node:%s", nodeName),
+			Organization: []string{"system:nodes"},
+		},
+		DNSNames: dnsNames.List(),
+	}
+	for _, ip := range ipAddresses.List() {
+		template.IPAddresses = append(template.IPAddresses, net.ParseIP(ip))
+	}
+	return template
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestNewServerCertificateTemplate(t *testing.T) {
+	if template := newServerCertificateTemplate("node", nil); template != nil {
+		t.Errorf("Expected no template without node addresses, got %v", template)
+	}
+
+	template := newServerCertificateTemplate("node", []v1.NodeAddress{
+		{Type: v1.NodeLegacyHostIP, Address: "10.0.0.1"},
+		{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+		{Type: v1.NodeExternalIP, Address: "1.2.3.4"},
+		{Type: v1.NodeHostName, Address: "node.example.com"},
+		{Type: v1.NodeInternalIP, Address: "not an ip"},
+	})
+	if template == nil {
+		t.Fatalf("Expected a template")
+	}
+	if template.Subject.CommonName != "system:node:node" || !reflect.DeepEqual(template.Subject.Organization, []string{"system:nodes"}) {
+		t.Errorf("Unexpected subject %v", template.Subject)
+	}
+	if !reflect.DeepEqual(template.DNSNames, []string{"node.example.com"}) {
+		t.Errorf("Unexpected DNS names %v", template.DNSNames)
+	}
+	expectedIPs := []net.IP{net.ParseIP("1.2.3.4"), net.ParseIP("10.0.0.1")}
+	if !reflect.DeepEqual(template.IPAddresses, expectedIPs) {
+		t.Errorf("Expected IP addresses %v, got %v", expectedIPs, template.IPAddresses)
+	}
+}
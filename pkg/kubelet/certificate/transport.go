@@ -0,0 +1,97 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	restclient "k8s.io/client-go/rest"
+)
+
+// certificateCheckPeriod is how often the transport checks whether the client
+// certificate was rotated.
+const certificateCheckPeriod = 10 * time.Second
+
+// UpdateTransport instruments a restconfig with a transport that dynamically
+// uses certificates provided by the manager for TLS client auth. When the
+// certificate is rotated, idle connections are closed so that new connections
+// present the new certificate.
+//
+// The config must not already provide a custom transport. Its TLS settings are
+// moved into the transport, since a rest client cannot combine both.
+func UpdateTransport(stopCh <-chan struct{}, clientConfig *restclient.Config, clientCertificateManager Manager) error {
+	if clientConfig.Transport != nil {
+		return fmt.Errorf("there is already a transport configured")
+	}
+	tlsConfig, err := restclient.TLSConfigFor(clientConfig)
+	if err != nil {
+		return fmt.Errorf("unable to configure TLS for the rest client: %v", err)
+	}
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	// Until the manager holds a certificate, fall back to the one the config
+	// was created with.
+	staticCertificates := tlsConfig.Certificates
+	tlsConfig.Certificates = nil
+	tlsConfig.GetClientCertificate = func(requestInfo *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		if cert := clientCertificateManager.Current(); cert != nil {
+			return cert, nil
+		}
+		if len(staticCertificates) > 0 {
+			return &staticCertificates[0], nil
+		}
+		// Let the server decide how to handle a client without a
+		// certificate, as the standard library does.
+		return &tls.Certificate{}, nil
+	}
+
+	t := utilnet.SetTransportDefaults(&http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		TLSHandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConnsPerHost: 25,
+		Dial: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).Dial,
+	})
+
+	lastCert := clientCertificateManager.Current()
+	go wait.Until(func() {
+		curr := clientCertificateManager.Current()
+		if curr == nil || curr == lastCert {
+			return
+		}
+		lastCert = curr
+		glog.Infof("Certificate rotation detected, closing idle connections to use the new client certificate")
+		t.CloseIdleConnections()
+	}, certificateCheckPeriod, stopCh)
+
+	clientConfig.Transport = t
+	// The TLS settings are now part of the transport.
+	clientConfig.TLSClientConfig = restclient.TLSClientConfig{}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/golang/glog"
@@ -29,6 +30,8 @@ import (
 	cgroupsystemd "github.com/opencontainers/runc/libcontainer/cgroups/systemd"
 	libcontainerconfigs "github.com/opencontainers/runc/libcontainer/configs"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	kubefeatures "k8s.io/kubernetes/pkg/features"
 )
 
 // libcontainerCgroupManagerType defines how to interface with libcontainer
@@ -278,14 +281,21 @@ type subsystem interface {
 	Set(path string, cgroup *libcontainerconfigs.Cgroup) error
 }
 
-// Cgroup subsystems we currently support
-var supportedSubsystems = []subsystem{
-	&cgroupfs.MemoryGroup{},
-	&cgroupfs.CpuGroup{},
+// getSupportedSubsystems returns the cgroup subsystems we currently support.
+// The hugetlb subsystem is only managed if huge pages are enabled.
+func getSupportedSubsystems() []subsystem {
+	supportedSubsystems := []subsystem{
+		&cgroupfs.MemoryGroup{},
+		&cgroupfs.CpuGroup{},
+	}
+	if utilfeature.DefaultFeatureGate.Enabled(kubefeatures.HugePages) {
+		supportedSubsystems = append(supportedSubsystems, &cgroupfs.HugetlbGroup{})
+	}
+	return supportedSubsystems
 }
 
 // setSupportedSubsytems sets cgroup resource limits only on the supported
-// subsytems. ie. cpu and memory. We don't use libcontainer's cgroup/fs/Set()
+// subsytems. ie. cpu, memory and hugetlb. We don't use libcontainer's cgroup/fs/Set()
 // method as it doesn't allow us to skip updates on the devices cgroup
 // Allowing or denying all devices by writing 'a' to devices.allow or devices.deny is
 // not possible once the device cgroups has children. Once the pod level cgroup are
@@ -294,7 +304,7 @@ var supportedSubsystems = []subsystem{
 // but this is not possible with libcontainers Set() method
 // See https://github.com/opencontainers/runc/issues/932
 func setSupportedSubsytems(cgroupConfig *libcontainerconfigs.Cgroup) error {
-	for _, sys := range supportedSubsystems {
+	for _, sys := range getSupportedSubsystems() {
 		if _, ok := cgroupConfig.Paths[sys.Name()]; !ok {
 			return fmt.Errorf("Failed to find subsytem mount for subsytem: %v", sys.Name())
 		}
@@ -322,9 +332,42 @@ func (m *cgroupManagerImpl) toResources(resourceConfig *ResourceConfig) *libcont
 	if resourceConfig.CpuPeriod != nil {
 		resources.CpuPeriod = *resourceConfig.CpuPeriod
 	}
+	if utilfeature.DefaultFeatureGate.Enabled(kubefeatures.HugePages) && resourceConfig.HugePageLimit != nil {
+		resources.HugetlbLimit = toHugetlbLimits(resourceConfig.HugePageLimit)
+	}
 	return resources
 }
 
+// toHugetlbLimits converts a map of page size to limit into hugetlb cgroup limits.
+// Page sizes supported on the node that are not present in the map are limited to 0,
+// so a cgroup can only consume the huge pages it explicitly asked for.
+func toHugetlbLimits(hugePageLimit map[int64]int64) []*libcontainerconfigs.HugepageLimit {
+	limits := map[int64]int64{}
+	pageSizes, err := hugePageSizes(hugePagesSysfsPath)
+	if err != nil {
+		glog.Warningf("Failed to read supported huge page sizes: %v", err)
+	}
+	for _, pageSize := range pageSizes {
+		limits[pageSize] = 0
+	}
+	for pageSize, limit := range hugePageLimit {
+		limits[pageSize] = limit
+	}
+	sortedPageSizes := make([]int64, 0, len(limits))
+	for pageSize := range limits {
+		sortedPageSizes = append(sortedPageSizes, pageSize)
+	}
+	sort.Slice(sortedPageSizes, func(i, j int) bool { return sortedPageSizes[i] < sortedPageSizes[j] })
+	result := []*libcontainerconfigs.HugepageLimit{}
+	for _, pageSize := range sortedPageSizes {
+		result = append(result, &libcontainerconfigs.HugepageLimit{
+			Pagesize: hugePageSizeToCgroupName(pageSize),
+			Limit:    uint64(limits[pageSize]),
+		})
+	}
+	return result
+}
+
 // Update updates the cgroup with the specified Cgroup Configuration
 func (m *cgroupManagerImpl) Update(cgroupConfig *CgroupConfig) error {
 	// Extract the cgroup resource parameters
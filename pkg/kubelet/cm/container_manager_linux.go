@@ -23,7 +23,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path"
 	"strconv"
 	"sync"
@@ -38,8 +37,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/kubernetes/pkg/api/v1"
+	kubefeatures "k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/kubelet/cadvisor"
 	cmutil "k8s.io/kubernetes/pkg/kubelet/cm/util"
 	"k8s.io/kubernetes/pkg/kubelet/qos"
@@ -63,6 +64,9 @@ const (
 	dockerPidFile         = "/var/run/docker.pid"
 	containerdProcessName = "docker-containerd"
 	containerdPidFile     = "/run/docker/libcontainerd/docker-containerd.pid"
+
+	// procSwapsPath lists the active swap areas, one per line after a header line.
+	procSwapsPath = "/proc/swaps"
 )
 
 var (
@@ -177,6 +181,27 @@ func validateSystemRequirements(mountUtil mount.Interface) (features, error) {
 	return f, nil
 }
 
+// isSwapOn reads the swaps file at the given path and reports whether any swap
+// area is active. The lines of the file are returned for diagnostics.
+func isSwapOn(swapsPath string) (bool, []string, error) {
+	file, err := os.Open(swapsPath)
+	if err != nil {
+		return false, nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() { // Splits on newlines by default
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return false, nil, err
+	}
+	// If there is more than one line (table headers) in the swaps file, swap is enabled.
+	return len(lines) > 1, lines, nil
+}
+
 // TODO(vmarmol): Add limits to the system containers.
 // Takes the absolute name of the specified containers.
 // Empty container name disables use of the specified container.
@@ -187,31 +212,17 @@ func NewContainerManager(mountUtil mount.Interface, cadvisorInterface cadvisor.I
 	}
 
 	// Check whether swap is enabled. The Kubelet does not support running with swap enabled.
-	cmd := exec.Command("cat", "/proc/swaps")
-	stdout, err := cmd.StdoutPipe()
+	swapOn, swaps, err := isSwapOn(procSwapsPath)
 	if err != nil {
 		return nil, err
 	}
-	if err := cmd.Start(); err != nil {
-		return nil, err
-	}
-	var buf []string
-	scanner := bufio.NewScanner(stdout)
-	for scanner.Scan() { // Splits on newlines by default
-		buf = append(buf, scanner.Text())
-	}
-	if err := cmd.Wait(); err != nil { // Clean up
-		return nil, err
-	}
 
 	// TODO(#34726:1.8.0): Remove the opt-in for failing when swap is enabled.
 	//     Running with swap enabled should be considered an error, but in order to maintain legacy
 	//     behavior we have to require an opt-in to this error for a period of time.
-
-	// If there is more than one line (table headers) in /proc/swaps, swap is enabled and we should error out.
-	if len(buf) > 1 {
+	if swapOn {
 		if failSwapOn {
-			return nil, fmt.Errorf("Running with swap on is not supported, please disable swap! /proc/swaps contained: %v", buf)
+			return nil, fmt.Errorf("Running with swap on is not supported, please disable swap! %s contained: %v", procSwapsPath, swaps)
 		}
 		glog.Warningf("Running with swap on is not supported, please disable swap! " +
 			"This will be a fatal error by default starting in K8s v1.6! " +
@@ -225,6 +236,16 @@ func NewContainerManager(mountUtil mount.Interface, cadvisorInterface cadvisor.I
 	} else {
 		return nil, err
 	}
+	// Huge pages are pre-allocated by the kernel and reported as hugepages-<size> resources.
+	if utilfeature.DefaultFeatureGate.Enabled(kubefeatures.HugePages) {
+		hugePages, err := hugePagesCapacity(hugePagesSysfsPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read huge pages from %q: %v", hugePagesSysfsPath, err)
+		}
+		for rName, rCap := range hugePages {
+			capacity[rName] = rCap
+		}
+	}
 
 	cgroupRoot := nodeConfig.CgroupRoot
 	cgroupManager := NewCgroupManager(subsystems, nodeConfig.CgroupDriver)
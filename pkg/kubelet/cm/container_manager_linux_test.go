@@ -54,6 +54,10 @@ func (mi *fakeMountInterface) GetDeviceNameFromMount(mountPath, pluginDir string
 	return "", nil
 }
 
+func (mi *fakeMountInterface) MakeRShared(path string) error {
+	return nil
+}
+
 func (mi *fakeMountInterface) DeviceOpened(pathname string) (bool, error) {
 	for _, mp := range mi.mountPoints {
 		if mp.Device == pathname {
@@ -179,3 +183,26 @@ func TestSoftRequirementsValidationSuccess(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, f.cpuHardcapping, "cpu hardcapping is expected to be enabled")
 }
+
+func TestIsSwapOn(t *testing.T) {
+	req := require.New(t)
+	tempDir, err := ioutil.TempDir("", "")
+	req.NoError(err)
+	defer os.RemoveAll(tempDir)
+
+	swapsOff := path.Join(tempDir, "swaps-off")
+	req.NoError(ioutil.WriteFile(swapsOff, []byte("Filename\t\t\t\tType\t\tSize\tUsed\tPriority\n"), os.ModePerm))
+	swapOn, _, err := isSwapOn(swapsOff)
+	assert.NoError(t, err)
+	assert.False(t, swapOn, "swap is expected to be disabled")
+
+	swapsOn := path.Join(tempDir, "swaps-on")
+	req.NoError(ioutil.WriteFile(swapsOn, []byte("Filename\t\t\t\tType\t\tSize\tUsed\tPriority\n/dev/sda2\t\t\t\tpartition\t1048572\t0\t-1\n"), os.ModePerm))
+	swapOn, lines, err := isSwapOn(swapsOn)
+	assert.NoError(t, err)
+	assert.True(t, swapOn, "swap is expected to be enabled")
+	assert.Len(t, lines, 2)
+
+	_, _, err = isSwapOn(path.Join(tempDir, "missing"))
+	assert.Error(t, err)
+}
@@ -25,7 +25,9 @@ import (
 
 	libcontainercgroups "github.com/opencontainers/runc/libcontainer/cgroups"
 
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kubernetes/pkg/api/v1"
+	kubefeatures "k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/kubelet/qos"
 )
 
@@ -90,6 +92,8 @@ func ResourceConfigForPod(pod *v1.Pod) *ResourceConfig {
 	memoryLimits := int64(0)
 	memoryLimitsDeclared := true
 	cpuLimitsDeclared := true
+	// map hugepage pagesize (bytes) to limits (bytes)
+	hugePageLimits := map[int64]int64{}
 	for _, container := range pod.Spec.Containers {
 		cpuRequests += container.Resources.Requests.Cpu().MilliValue()
 		cpuLimits += container.Resources.Limits.Cpu().MilliValue()
@@ -100,6 +104,9 @@ func ResourceConfigForPod(pod *v1.Pod) *ResourceConfig {
 		if container.Resources.Limits.Memory().IsZero() {
 			memoryLimitsDeclared = false
 		}
+		for pageSize, limit := range HugePageLimits(container.Resources.Limits) {
+			hugePageLimits[pageSize] += limit
+		}
 	}
 
 	// convert to CFS values
@@ -129,6 +136,10 @@ func ResourceConfigForPod(pod *v1.Pod) *ResourceConfig {
 		shares := int64(MinShares)
 		result.CpuShares = &shares
 	}
+	// huge pages are not overcommitted, so they are limited regardless of the qos class
+	if utilfeature.DefaultFeatureGate.Enabled(kubefeatures.HugePages) {
+		result.HugePageLimit = hugePageLimits
+	}
 	return result
 }
 
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kubernetes/pkg/api/v1"
 )
 
@@ -143,6 +144,42 @@ func TestResourceConfigForPod(t *testing.T) {
 	}
 }
 
+func TestResourceConfigForPodHugePages(t *testing.T) {
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{
+							v1.ResourceMemory:                resource.MustParse("100Mi"),
+							v1.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi"),
+						},
+					},
+				},
+				{
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{
+							v1.ResourceName("hugepages-2Mi"): resource.MustParse("2Mi"),
+						},
+					},
+				},
+			},
+		},
+	}
+	if actual := ResourceConfigForPod(pod); actual.HugePageLimit != nil {
+		t.Errorf("expected no huge page limits with the feature gate disabled, got %v", actual.HugePageLimit)
+	}
+
+	if err := utilfeature.DefaultFeatureGate.Set("HugePages=true"); err != nil {
+		t.Fatalf("failed to enable feature gate for HugePages: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set("HugePages=false")
+	expected := map[int64]int64{2 * 1024 * 1024: 6 * 1024 * 1024}
+	if actual := ResourceConfigForPod(pod); !reflect.DeepEqual(actual.HugePageLimit, expected) {
+		t.Errorf("expected huge page limits %v, got %v", expected, actual.HugePageLimit)
+	}
+}
+
 func TestMilliCPUToQuota(t *testing.T) {
 	testCases := []struct {
 		input  int64
@@ -0,0 +1,140 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	units "github.com/docker/go-units"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+const (
+	// defaultHugePagesSysfsPath is where the kernel reports the pre-allocated
+	// huge pages for every supported page size.
+	defaultHugePagesSysfsPath = "/sys/kernel/mm/hugepages"
+	// hugePagesDirPrefix is the prefix of the per page size sysfs directories, e.g. hugepages-2048kB.
+	hugePagesDirPrefix = "hugepages-"
+	// nrHugePagesFile holds the number of pre-allocated pages of a given size.
+	nrHugePagesFile = "nr_hugepages"
+)
+
+// hugePagesSysfsPath is the sysfs tree consulted for huge pages. It is a variable so tests can use a fake tree.
+var hugePagesSysfsPath = defaultHugePagesSysfsPath
+
+// hugePageSizeUnitList is the unit list the hugetlb cgroup uses to name its per page size files, e.g. hugetlb.2MB.limit_in_bytes.
+var hugePageSizeUnitList = []string{"B", "kB", "MB", "GB", "TB", "PB"}
+
+// hugePagesInfo describes the pre-allocated huge pages of a single page size.
+type hugePagesInfo struct {
+	// PageSize is the page size in bytes.
+	PageSize int64
+	// NumPages is the number of pre-allocated pages.
+	NumPages int64
+}
+
+// readHugePages returns the huge pages pre-allocated on the node, sorted by page size.
+// A missing sysfs tree means the kernel does not support huge pages and is not an error.
+func readHugePages(sysfsPath string) ([]hugePagesInfo, error) {
+	files, err := ioutil.ReadDir(sysfsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	result := []hugePagesInfo{}
+	for _, file := range files {
+		if !strings.HasPrefix(file.Name(), hugePagesDirPrefix) {
+			continue
+		}
+		pageSize, err := units.RAMInBytes(strings.TrimPrefix(file.Name(), hugePagesDirPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse huge page size from %q: %v", file.Name(), err)
+		}
+		data, err := ioutil.ReadFile(filepath.Join(sysfsPath, file.Name(), nrHugePagesFile))
+		if err != nil {
+			return nil, err
+		}
+		numPages, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s for %q: %v", nrHugePagesFile, file.Name(), err)
+		}
+		result = append(result, hugePagesInfo{PageSize: pageSize, NumPages: numPages})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].PageSize < result[j].PageSize })
+	return result, nil
+}
+
+// hugePagesCapacity returns the hugepages-<size> resources backed by the huge pages
+// pre-allocated on the node. The quantity of each resource is expressed in bytes.
+func hugePagesCapacity(sysfsPath string) (v1.ResourceList, error) {
+	hugePages, err := readHugePages(sysfsPath)
+	if err != nil {
+		return nil, err
+	}
+	capacity := v1.ResourceList{}
+	for _, hp := range hugePages {
+		name := v1.HugePageResourceName(*resource.NewQuantity(hp.PageSize, resource.BinarySI))
+		capacity[name] = *resource.NewQuantity(hp.PageSize*hp.NumPages, resource.BinarySI)
+	}
+	return capacity, nil
+}
+
+// hugePageSizes returns the page sizes in bytes supported on the node.
+func hugePageSizes(sysfsPath string) ([]int64, error) {
+	hugePages, err := readHugePages(sysfsPath)
+	if err != nil {
+		return nil, err
+	}
+	pageSizes := make([]int64, 0, len(hugePages))
+	for _, hp := range hugePages {
+		pageSizes = append(pageSizes, hp.PageSize)
+	}
+	return pageSizes, nil
+}
+
+// hugePageSizeToCgroupName converts a page size in bytes into the name the
+// hugetlb cgroup uses for it, e.g. 2097152 becomes "2MB".
+func hugePageSizeToCgroupName(pageSize int64) string {
+	return units.CustomSize("%g%s", float64(pageSize), 1024.0, hugePageSizeUnitList)
+}
+
+// HugePageLimits converts the huge page resources of the resource list into a
+// map of page size in bytes to limit in bytes.
+func HugePageLimits(resourceList v1.ResourceList) map[int64]int64 {
+	hugePageLimits := map[int64]int64{}
+	for k, v := range resourceList {
+		if !v1.IsHugePageResourceName(k) {
+			continue
+		}
+		pageSize, err := v1.HugePageSizeFromResourceName(k)
+		if err != nil {
+			continue
+		}
+		hugePageLimits[pageSize.Value()] += v.Value()
+	}
+	return hugePageLimits
+}
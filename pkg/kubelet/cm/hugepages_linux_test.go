@@ -0,0 +1,152 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	cgroupfs "github.com/opencontainers/runc/libcontainer/cgroups/fs"
+	libcontainerconfigs "github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+// newFakeHugePagesSysfs creates a fake sysfs huge pages tree with the given
+// number of pre-allocated pages per directory name, e.g. hugepages-2048kB.
+func newFakeHugePagesSysfs(t *testing.T, pages map[string]string) string {
+	dir, err := ioutil.TempDir("", "hugepages")
+	require.NoError(t, err)
+	for name, nrPages := range pages {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name, nrHugePagesFile), []byte(nrPages+"\n"), 0644))
+	}
+	return dir
+}
+
+func TestHugePagesCapacity(t *testing.T) {
+	sysfs := newFakeHugePagesSysfs(t, map[string]string{
+		"hugepages-2048kB":    "512",
+		"hugepages-1048576kB": "2",
+	})
+	defer os.RemoveAll(sysfs)
+
+	capacity, err := hugePagesCapacity(sysfs)
+	require.NoError(t, err)
+	expected := v1.ResourceList{
+		v1.ResourceName("hugepages-2Mi"): resource.MustParse("1Gi"),
+		v1.ResourceName("hugepages-1Gi"): resource.MustParse("2Gi"),
+	}
+	require.Len(t, capacity, len(expected))
+	for k, v := range expected {
+		actual, found := capacity[k]
+		assert.True(t, found, "expected %v in capacity", k)
+		assert.Equal(t, 0, v.Cmp(actual), "expected %v for %v, got %v", v.String(), k, actual.String())
+	}
+
+	pageSizes, err := hugePageSizes(sysfs)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2 * 1024 * 1024, 1024 * 1024 * 1024}, pageSizes)
+}
+
+func TestHugePagesCapacityErrors(t *testing.T) {
+	// A missing tree means the kernel does not support huge pages.
+	capacity, err := hugePagesCapacity(filepath.Join(os.TempDir(), "does-not-exist-hugepages"))
+	assert.NoError(t, err)
+	assert.Empty(t, capacity)
+
+	sysfs := newFakeHugePagesSysfs(t, map[string]string{"hugepages-2048kB": "foo"})
+	defer os.RemoveAll(sysfs)
+	_, err = hugePagesCapacity(sysfs)
+	assert.Error(t, err)
+}
+
+func TestHugePageSizeToCgroupName(t *testing.T) {
+	testCases := map[int64]string{
+		64 * 1024:          "64kB",
+		2 * 1024 * 1024:    "2MB",
+		1024 * 1024 * 1024: "1GB",
+	}
+	for pageSize, expected := range testCases {
+		assert.Equal(t, expected, hugePageSizeToCgroupName(pageSize))
+	}
+}
+
+func TestHugePageLimits(t *testing.T) {
+	limits := HugePageLimits(v1.ResourceList{
+		v1.ResourceMemory:                resource.MustParse("1Gi"),
+		v1.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi"),
+		v1.ResourceName("hugepages-1Gi"): resource.MustParse("2Gi"),
+	})
+	expected := map[int64]int64{
+		2 * 1024 * 1024:    4 * 1024 * 1024,
+		1024 * 1024 * 1024: 2 * 1024 * 1024 * 1024,
+	}
+	if !reflect.DeepEqual(expected, limits) {
+		t.Errorf("expected %v, got %v", expected, limits)
+	}
+}
+
+func TestToHugetlbLimitsFakeCgroupfs(t *testing.T) {
+	sysfs := newFakeHugePagesSysfs(t, map[string]string{
+		"hugepages-2048kB":    "512",
+		"hugepages-1048576kB": "2",
+	})
+	defer os.RemoveAll(sysfs)
+	cgroupPath, err := ioutil.TempDir("", "hugetlb")
+	require.NoError(t, err)
+	defer os.RemoveAll(cgroupPath)
+
+	oldPath := hugePagesSysfsPath
+	hugePagesSysfsPath = sysfs
+	defer func() { hugePagesSysfsPath = oldPath }()
+	require.NoError(t, utilfeature.DefaultFeatureGate.Set("HugePages=true"))
+	defer utilfeature.DefaultFeatureGate.Set("HugePages=false")
+
+	m := &cgroupManagerImpl{}
+	resources := m.toResources(&ResourceConfig{
+		HugePageLimit: map[int64]int64{2 * 1024 * 1024: 4 * 1024 * 1024},
+	})
+	// the page size not requested is limited to 0
+	expected := []*libcontainerconfigs.HugepageLimit{
+		{Pagesize: "2MB", Limit: 4 * 1024 * 1024},
+		{Pagesize: "1GB", Limit: 0},
+	}
+	require.Equal(t, expected, resources.HugetlbLimit)
+
+	hugetlb := &cgroupfs.HugetlbGroup{}
+	require.NoError(t, hugetlb.Set(cgroupPath, &libcontainerconfigs.Cgroup{Resources: resources}))
+	for file, value := range map[string]string{
+		"hugetlb.2MB.limit_in_bytes": "4194304",
+		"hugetlb.1GB.limit_in_bytes": "0",
+	} {
+		data, err := ioutil.ReadFile(filepath.Join(cgroupPath, file))
+		require.NoError(t, err)
+		assert.Equal(t, value, string(data))
+	}
+
+	// without huge page limits the hugetlb cgroup is left untouched
+	resources = m.toResources(&ResourceConfig{})
+	assert.Empty(t, resources.HugetlbLimit)
+}
@@ -148,6 +148,7 @@ func (cs *containerdService) CreateContainer(podSandboxID string, containerConfi
 
 	// TODO: Set other configs, such as envs, working directory etc.
 	s := defaultOCISpec(containerID, processArgs, rootfsPath, containerConfig.GetTty())
+	addOCIBindMounts(s, containerConfig.GetMounts())
 
 	data, err := json.Marshal(s)
 	if err != nil {
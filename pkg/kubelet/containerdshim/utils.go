@@ -15,6 +15,7 @@ import (
 
 	"github.com/docker/containerd/api/services/shim"
 	"github.com/docker/containerd/api/types/container"
+	"github.com/golang/glog"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/tonistiigi/fifo"
 	"google.golang.org/grpc"
@@ -148,6 +149,43 @@ func defaultOCISpec(id string, args []string, rootfs string, tty bool) *specs.Sp
 	}
 }
 
+// addOCIBindMounts adds the volume mounts of a container to its OCI spec and
+// translates their requested propagation to the OCI mount options. A mount
+// can only be rslave or rshared when the root of the container is, so the
+// rootfs propagation is relaxed accordingly.
+func addOCIBindMounts(s *specs.Spec, mounts []*runtimeapi.Mount) {
+	for _, m := range mounts {
+		options := []string{"rbind"}
+		if m.GetReadonly() {
+			options = append(options, "ro")
+		} else {
+			options = append(options, "rw")
+		}
+		switch m.GetPropagation() {
+		case runtimeapi.MountPropagation_PROPAGATION_PRIVATE:
+			options = append(options, "rprivate")
+		case runtimeapi.MountPropagation_PROPAGATION_BIDIRECTIONAL:
+			options = append(options, "rshared")
+			s.Linux.RootfsPropagation = "rshared"
+		case runtimeapi.MountPropagation_PROPAGATION_HOST_TO_CONTAINER:
+			options = append(options, "rslave")
+			if s.Linux.RootfsPropagation != "rshared" {
+				s.Linux.RootfsPropagation = "rslave"
+			}
+		default:
+			glog.Warningf("unknown propagation mode for hostPath %q", m.GetHostPath())
+			// Falls back to "private"
+			options = append(options, "rprivate")
+		}
+		s.Mounts = append(s.Mounts, specs.Mount{
+			Destination: m.GetContainerPath(),
+			Type:        "bind",
+			Source:      m.GetHostPath(),
+			Options:     options,
+		})
+	}
+}
+
 func ensureContainerDir(id string) (string, error) {
 	dir := getContainerDir(id)
 	if err := os.MkdirAll(dir, 0700); err != nil {
@@ -0,0 +1,82 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package containerdshim
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+
+	runtimeapi "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/runtime"
+)
+
+func TestAddOCIBindMounts(t *testing.T) {
+	for desc, test := range map[string]struct {
+		mounts                    []*runtimeapi.Mount
+		expectedOptions           [][]string
+		expectedRootfsPropagation string
+	}{
+		"private mounts": {
+			mounts: []*runtimeapi.Mount{
+				{HostPath: "/mnt/1", ContainerPath: "/data/1"},
+				{HostPath: "/mnt/2", ContainerPath: "/data/2", Readonly: true},
+			},
+			expectedOptions: [][]string{
+				{"rbind", "rw", "rprivate"},
+				{"rbind", "ro", "rprivate"},
+			},
+			expectedRootfsPropagation: "",
+		},
+		"host to container mount": {
+			mounts: []*runtimeapi.Mount{
+				{HostPath: "/mnt/1", ContainerPath: "/data/1", Propagation: runtimeapi.MountPropagation_PROPAGATION_HOST_TO_CONTAINER},
+			},
+			expectedOptions: [][]string{
+				{"rbind", "rw", "rslave"},
+			},
+			expectedRootfsPropagation: "rslave",
+		},
+		"bidirectional mount": {
+			mounts: []*runtimeapi.Mount{
+				{HostPath: "/mnt/1", ContainerPath: "/data/1", Propagation: runtimeapi.MountPropagation_PROPAGATION_BIDIRECTIONAL},
+				{HostPath: "/mnt/2", ContainerPath: "/data/2", Propagation: runtimeapi.MountPropagation_PROPAGATION_HOST_TO_CONTAINER},
+			},
+			expectedOptions: [][]string{
+				{"rbind", "rw", "rshared"},
+				{"rbind", "rw", "rslave"},
+			},
+			expectedRootfsPropagation: "rshared",
+		},
+	} {
+		s := defaultOCISpec("test", nil, "/rootfs", false)
+		defaultMounts := len(s.Mounts)
+		addOCIBindMounts(s, test.mounts)
+		if !assert.Len(t, s.Mounts, defaultMounts+len(test.mounts), desc) {
+			continue
+		}
+		for i, m := range test.mounts {
+			assert.Equal(t, specs.Mount{
+				Destination: m.ContainerPath,
+				Type:        "bind",
+				Source:      m.HostPath,
+				Options:     test.expectedOptions[i],
+			}, s.Mounts[defaultMounts+i], desc)
+		}
+		assert.Equal(t, test.expectedRootfsPropagation, s.Linux.RootfsPropagation, desc)
+	}
+}
@@ -47,6 +47,7 @@ func imageToRuntimeAPIImage(image *dockertypes.Image) (*runtimeapi.Image, error)
 		RepoTags:    image.RepoTags,
 		RepoDigests: image.RepoDigests,
 		Size_:       size,
+		Labels:      image.Labels,
 	}, nil
 }
 
@@ -61,6 +62,7 @@ func imageInspectToRuntimeAPIImage(image *dockertypes.ImageInspect) (*runtimeapi
 		RepoTags:    image.RepoTags,
 		RepoDigests: image.RepoDigests,
 		Size_:       size,
+		Labels:      image.Config.Labels,
 	}
 
 	uid, username := getUserFromImageUser(image.Config.User)
@@ -80,5 +80,6 @@ func toRuntimeImage(image *dockertypes.Image) (*kubecontainer.Image, error) {
 		RepoTags:    image.RepoTags,
 		RepoDigests: image.RepoDigests,
 		Size:        image.VirtualSize,
+		Labels:      image.Labels,
 	}, nil
 }
@@ -72,12 +72,14 @@ func TestToRuntimeImage(t *testing.T) {
 		RepoTags:    []string{"abc", "def"},
 		RepoDigests: []string{"123", "456"},
 		VirtualSize: 1234,
+		Labels:      map[string]string{"foo": "bar"},
 	}
 	expected := &kubecontainer.Image{
 		ID:          "aeeea",
 		RepoTags:    []string{"abc", "def"},
 		RepoDigests: []string{"123", "456"},
 		Size:        1234,
+		Labels:      map[string]string{"foo": "bar"},
 	}
 
 	actual, err := toRuntimeImage(original)
@@ -20,10 +20,13 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/golang/glog"
+	cadvisorapiv2 "github.com/google/cadvisor/info/v2"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -34,6 +37,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/container"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/events"
+	"k8s.io/kubernetes/pkg/util/parsers"
 )
 
 // Manages lifecycle of all images.
@@ -51,6 +55,10 @@ type ImageGCManager interface {
 
 	// Delete all unused images and returns the number of bytes freed. The number of bytes freed is always returned.
 	DeleteUnusedImages() (int64, error)
+
+	// Reports which images a garbage collection run would remove right now,
+	// without removing any of them.
+	DryRun() (*ImageGCReport, error)
 }
 
 // A policy for garbage collecting images. Policy defines an allowed band in
@@ -66,8 +74,56 @@ type ImageGCPolicy struct {
 
 	// Minimum age at which an image can be garbage collected.
 	MinAge time.Duration
+
+	// Images that are never garbage collected. An entry may be an image ID,
+	// a repo tag or a repo digest. A repo without a tag matches ":latest".
+	PinnedImages []string
+
+	// Images whose labels match this selector are never garbage collected.
+	// A nil selector pins nothing.
+	PinnedImageSelector labels.Selector
+
+	// Maximum number of unused images to keep, regardless of disk usage.
+	// Pinned images are not counted. Zero means no limit.
+	MaxUnusedImages int
+}
+
+// ImageGCReport describes what image garbage collection would do if it ran
+// now. It is served by the kubelet debugging handlers.
+type ImageGCReport struct {
+	// Percentage of the image filesystem currently in use.
+	UsagePercent int `json:"usagePercent"`
+	// Number of bytes garbage collection would try to free.
+	BytesToFree int64 `json:"bytesToFree"`
+	// Number of bytes that would be freed by removing the images below.
+	BytesFreed int64 `json:"bytesFreed"`
+	// All images known to the garbage collector, in eviction order.
+	Images []ImageGCReportEntry `json:"images"`
+}
+
+// ImageGCReportEntry describes a single image in an ImageGCReport.
+type ImageGCReportEntry struct {
+	ID            string    `json:"id"`
+	RepoTags      []string  `json:"repoTags,omitempty"`
+	Size          int64     `json:"size"`
+	FirstDetected time.Time `json:"firstDetected"`
+	LastUsed      time.Time `json:"lastUsed"`
+	// Whether garbage collection would remove the image.
+	Remove bool `json:"remove"`
+	// Why the image would or would not be removed.
+	Reason string `json:"reason"`
 }
 
+// Reasons reported for each image in an ImageGCReport.
+const (
+	imageGCReasonInUse           = "InUse"
+	imageGCReasonPinned          = "Pinned"
+	imageGCReasonTooYoung        = "TooYoung"
+	imageGCReasonDiskUsage       = "DiskUsage"
+	imageGCReasonMaxUnusedImages = "MaxUnusedImages"
+	imageGCReasonNotNeeded       = "NotNeeded"
+)
+
 type realImageGCManager struct {
 	// Container runtime
 	runtime container.Runtime
@@ -127,6 +183,12 @@ type imageRecord struct {
 
 	// Size of the image in bytes.
 	size int64
+
+	// Other names by which this image is known.
+	repoTags []string
+
+	// Whether the image is exempt from garbage collection by policy.
+	pinned bool
 }
 
 func NewImageGCManager(runtime container.Runtime, cadvisorInterface cadvisor.Interface, recorder record.EventRecorder, nodeRef *clientv1.ObjectReference, policy ImageGCPolicy) (ImageGCManager, error) {
@@ -140,6 +202,9 @@ func NewImageGCManager(runtime container.Runtime, cadvisorInterface cadvisor.Int
 	if policy.LowThresholdPercent > policy.HighThresholdPercent {
 		return nil, fmt.Errorf("LowThresholdPercent %d can not be higher than HighThresholdPercent %d", policy.LowThresholdPercent, policy.HighThresholdPercent)
 	}
+	if policy.MaxUnusedImages < 0 {
+		return nil, fmt.Errorf("invalid MaxUnusedImages %d, must not be negative", policy.MaxUnusedImages)
+	}
 	im := &realImageGCManager{
 		runtime:      runtime,
 		policy:       policy,
@@ -230,6 +295,8 @@ func (im *realImageGCManager) detectImages(detectTime time.Time) error {
 
 		glog.V(5).Infof("Image ID %s has size %d", image.ID, image.Size)
 		im.imageRecords[image.ID].size = image.Size
+		im.imageRecords[image.ID].repoTags = image.RepoTags
+		im.imageRecords[image.ID].pinned = isImagePinned(image, im.policy)
 	}
 
 	// Remove old images from our records.
@@ -249,6 +316,38 @@ func (im *realImageGCManager) GarbageCollect() error {
 	if err != nil {
 		return err
 	}
+	usagePercent, amountToFree, err := im.amountToFree(fsInfo)
+	if err != nil {
+		im.recorder.Event(im.nodeRef, v1.EventTypeWarning, events.InvalidDiskCapacity, err.Error())
+		return err
+	}
+
+	// If over the max threshold, free enough to place us at the lower threshold.
+	if amountToFree > 0 {
+		glog.Infof("[imageGCManager]: Disk usage on image filesystem is at %d%% which is over the high threshold (%d%%). Trying to free %d bytes", usagePercent, im.policy.HighThresholdPercent, amountToFree)
+	}
+	if amountToFree == 0 && im.policy.MaxUnusedImages == 0 {
+		return nil
+	}
+	freed, err := im.freeSpace(amountToFree, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if freed < amountToFree {
+		err := fmt.Errorf("failed to garbage collect required amount of images. Wanted to free %d, but freed %d", amountToFree, freed)
+		im.recorder.Event(im.nodeRef, v1.EventTypeWarning, events.FreeDiskSpaceFailed, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// amountToFree returns the disk usage of the filesystem holding images, as
+// described by fsInfo, and the number of bytes that need to be freed to bring
+// it back under the low threshold. Nothing needs to be freed while usage is
+// below the high threshold. An error is returned if fsInfo reports no capacity.
+func (im *realImageGCManager) amountToFree(fsInfo cadvisorapiv2.FsInfo) (int, int64, error) {
 	capacity := int64(fsInfo.Capacity)
 	available := int64(fsInfo.Available)
 	if available > capacity {
@@ -258,35 +357,61 @@ func (im *realImageGCManager) GarbageCollect() error {
 
 	// Check valid capacity.
 	if capacity == 0 {
-		err := fmt.Errorf("invalid capacity %d on device %q at mount point %q", capacity, fsInfo.Device, fsInfo.Mountpoint)
-		im.recorder.Eventf(im.nodeRef, v1.EventTypeWarning, events.InvalidDiskCapacity, err.Error())
-		return err
+		return 0, 0, fmt.Errorf("invalid capacity %d on device %q at mount point %q", capacity, fsInfo.Device, fsInfo.Mountpoint)
 	}
 
-	// If over the max threshold, free enough to place us at the lower threshold.
 	usagePercent := 100 - int(available*100/capacity)
-	if usagePercent >= im.policy.HighThresholdPercent {
-		amountToFree := capacity*int64(100-im.policy.LowThresholdPercent)/100 - available
-		glog.Infof("[imageGCManager]: Disk usage on %q (%s) is at %d%% which is over the high threshold (%d%%). Trying to free %d bytes", fsInfo.Device, fsInfo.Mountpoint, usagePercent, im.policy.HighThresholdPercent, amountToFree)
-		freed, err := im.freeSpace(amountToFree, time.Now())
-		if err != nil {
-			return err
-		}
-
-		if freed < amountToFree {
-			err := fmt.Errorf("failed to garbage collect required amount of images. Wanted to free %d, but freed %d", amountToFree, freed)
-			im.recorder.Eventf(im.nodeRef, v1.EventTypeWarning, events.FreeDiskSpaceFailed, err.Error())
-			return err
-		}
+	if usagePercent < im.policy.HighThresholdPercent {
+		return usagePercent, 0, nil
 	}
-
-	return nil
+	return usagePercent, capacity*int64(100-im.policy.LowThresholdPercent)/100 - available, nil
 }
 
 func (im *realImageGCManager) DeleteUnusedImages() (int64, error) {
 	return im.freeSpace(math.MaxInt64, time.Now())
 }
 
+func (im *realImageGCManager) DryRun() (*ImageGCReport, error) {
+	fsInfo, err := im.cadvisor.ImagesFsInfo()
+	if err != nil {
+		return nil, err
+	}
+	usagePercent, amountToFree, err := im.amountToFree(fsInfo)
+	if err != nil {
+		return nil, err
+	}
+	freeTime := time.Now()
+	if err := im.detectImages(freeTime); err != nil {
+		return nil, err
+	}
+
+	im.imageRecordsLock.Lock()
+	defer im.imageRecordsLock.Unlock()
+
+	report := &ImageGCReport{
+		UsagePercent: usagePercent,
+		BytesToFree:  amountToFree,
+	}
+	images := im.imagesInEvictionOrder()
+	remove := im.evictionPlan(images, amountToFree, freeTime)
+	for _, image := range images {
+		reason, ok := remove[image.id]
+		if ok {
+			report.BytesFreed += image.size
+		}
+		report.Images = append(report.Images, ImageGCReportEntry{
+			ID:            image.id,
+			RepoTags:      image.repoTags,
+			Size:          image.size,
+			FirstDetected: image.firstDetected,
+			LastUsed:      image.lastUsed,
+			Remove:        ok,
+			Reason:        im.evictionReason(image, freeTime, reason),
+		})
+	}
+	return report, nil
+}
+
 // Tries to free bytesToFree worth of images on the disk.
 //
 // Returns the number of bytes free and an error if any occurred. The number of
@@ -302,7 +427,33 @@ func (im *realImageGCManager) freeSpace(bytesToFree int64, freeTime time.Time) (
 	im.imageRecordsLock.Lock()
 	defer im.imageRecordsLock.Unlock()
 
-	// Get all images in eviction order.
+	// Delete unused images until we've freed up enough space and are within
+	// the limit of unused images.
+	var deletionErrors []error
+	spaceFreed := int64(0)
+	im.evictImages(im.imagesInEvictionOrder(), bytesToFree, freeTime, func(image evictionInfo, reason string) bool {
+		// Remove image. Continue despite errors.
+		glog.Infof("[imageGCManager]: Removing image %q to free %d bytes", image.id, image.size)
+		err := im.runtime.RemoveImage(container.ImageSpec{Image: image.id})
+		if err != nil {
+			deletionErrors = append(deletionErrors, err)
+			return false
+		}
+		delete(im.imageRecords, image.id)
+		spaceFreed += image.size
+		im.recorder.Eventf(im.nodeRef, v1.EventTypeNormal, events.RemovedImage, "Removed unused image %q to free %d bytes (%s)", imageDisplayName(image), image.size, reason)
+		return true
+	})
+
+	if len(deletionErrors) > 0 {
+		return spaceFreed, fmt.Errorf("wanted to free %d, but freed %d space with errors in image deletion: %v", bytesToFree, spaceFreed, errors.NewAggregate(deletionErrors))
+	}
+	return spaceFreed, nil
+}
+
+// imagesInEvictionOrder returns all tracked images, least recently used first.
+// Must be called with imageRecordsLock held.
+func (im *realImageGCManager) imagesInEvictionOrder() []evictionInfo {
 	images := make([]evictionInfo, 0, len(im.imageRecords))
 	for image, record := range im.imageRecords {
 		images = append(images, evictionInfo{
@@ -311,45 +462,87 @@ func (im *realImageGCManager) freeSpace(bytesToFree int64, freeTime time.Time) (
 		})
 	}
 	sort.Sort(byLastUsedAndDetected(images))
+	return images
+}
+
+// evictImages walks images in eviction order and calls remove for each image
+// that should be garbage collected, until bytesToFree bytes have been freed
+// and no more than policy.MaxUnusedImages unused images remain. remove returns
+// whether the image was actually removed; images that fail to be removed do
+// not count towards either goal.
+func (im *realImageGCManager) evictImages(images []evictionInfo, bytesToFree int64, freeTime time.Time, remove func(image evictionInfo, reason string) bool) {
+	excessImages := 0
+	if im.policy.MaxUnusedImages > 0 {
+		unused := 0
+		for _, image := range images {
+			if !isImageInUse(image, freeTime) && !image.pinned {
+				unused++
+			}
+		}
+		excessImages = unused - im.policy.MaxUnusedImages
+	}
 
-	// Delete unused images until we've freed up enough space.
-	var deletionErrors []error
 	spaceFreed := int64(0)
 	for _, image := range images {
+		if spaceFreed >= bytesToFree && excessImages <= 0 {
+			break
+		}
 		glog.V(5).Infof("Evaluating image ID %s for possible garbage collection", image.id)
 		// Images that are currently in used were given a newer lastUsed.
-		if image.lastUsed.Equal(freeTime) || image.lastUsed.After(freeTime) {
+		if isImageInUse(image, freeTime) {
 			glog.V(5).Infof("Image ID %s has lastUsed=%v which is >= freeTime=%v, not eligible for garbage collection", image.id, image.lastUsed, freeTime)
 			break
 		}
 
+		if image.pinned {
+			glog.V(5).Infof("Image ID %s is pinned by the image garbage collection policy, not eligible for garbage collection", image.id)
+			continue
+		}
+
 		// Avoid garbage collect the image if the image is not old enough.
 		// In such a case, the image may have just been pulled down, and will be used by a container right away.
-
 		if freeTime.Sub(image.firstDetected) < im.policy.MinAge {
 			glog.V(5).Infof("Image ID %s has age %v which is less than the policy's minAge of %v, not eligible for garbage collection", image.id, freeTime.Sub(image.firstDetected), im.policy.MinAge)
 			continue
 		}
 
-		// Remove image. Continue despite errors.
-		glog.Infof("[imageGCManager]: Removing image %q to free %d bytes", image.id, image.size)
-		err := im.runtime.RemoveImage(container.ImageSpec{Image: image.id})
-		if err != nil {
-			deletionErrors = append(deletionErrors, err)
-			continue
-		}
-		delete(im.imageRecords, image.id)
-		spaceFreed += image.size
-
+		reason := imageGCReasonDiskUsage
 		if spaceFreed >= bytesToFree {
-			break
+			reason = imageGCReasonMaxUnusedImages
+		}
+		if remove(image, reason) {
+			spaceFreed += image.size
+			excessImages--
 		}
 	}
+}
 
-	if len(deletionErrors) > 0 {
-		return spaceFreed, fmt.Errorf("wanted to free %d, but freed %d space with errors in image deletion: %v", bytesToFree, spaceFreed, errors.NewAggregate(deletionErrors))
+// evictionPlan returns the images garbage collection would remove, keyed by
+// image ID, with the reason for removing each of them.
+func (im *realImageGCManager) evictionPlan(images []evictionInfo, bytesToFree int64, freeTime time.Time) map[string]string {
+	plan := make(map[string]string)
+	im.evictImages(images, bytesToFree, freeTime, func(image evictionInfo, reason string) bool {
+		plan[image.id] = reason
+		return true
+	})
+	return plan
+}
+
+// evictionReason explains the garbage collection decision for an image, given
+// the reason it is being removed, if any.
+func (im *realImageGCManager) evictionReason(image evictionInfo, freeTime time.Time, removeReason string) string {
+	switch {
+	case len(removeReason) > 0:
+		return removeReason
+	case isImageInUse(image, freeTime):
+		return imageGCReasonInUse
+	case image.pinned:
+		return imageGCReasonPinned
+	case freeTime.Sub(image.firstDetected) < im.policy.MinAge:
+		return imageGCReasonTooYoung
+	default:
+		return imageGCReasonNotNeeded
 	}
-	return spaceFreed, nil
 }
 
 type evictionInfo struct {
@@ -377,3 +570,47 @@ func isImageUsed(image container.Image, imagesInUse sets.String) bool {
 	}
 	return false
 }
+
+// imageDisplayName returns the first repo tag of an image, or its ID if the
+// image has no tags.
+func imageDisplayName(image evictionInfo) string {
+	if len(image.repoTags) > 0 {
+		return image.repoTags[0]
+	}
+	return image.id
+}
+
+// isImageInUse returns true if the image was in use by a container when
+// images were last detected at or after freeTime.
+func isImageInUse(image evictionInfo, freeTime time.Time) bool {
+	return image.lastUsed.Equal(freeTime) || image.lastUsed.After(freeTime)
+}
+
+// isImagePinned returns true if the policy exempts the image from garbage
+// collection, either by reference or by label.
+func isImagePinned(image container.Image, policy ImageGCPolicy) bool {
+	if policy.PinnedImageSelector != nil && !policy.PinnedImageSelector.Empty() && policy.PinnedImageSelector.Matches(labels.Set(image.Labels)) {
+		return true
+	}
+	for _, ref := range policy.PinnedImages {
+		if ref == image.ID {
+			return true
+		}
+		names := append(append([]string{}, image.RepoTags...), image.RepoDigests...)
+		for _, name := range names {
+			if name == ref || name == defaultTag(ref) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// defaultTag appends the ":latest" tag to an image reference that has neither
+// a tag nor a digest.
+func defaultTag(ref string) string {
+	if strings.Contains(ref, "@") || strings.Contains(ref[strings.LastIndex(ref, "/")+1:], ":") {
+		return ref
+	}
+	return ref + ":" + parsers.DefaultImageTag
+}
@@ -24,11 +24,14 @@ import (
 	cadvisorapiv2 "github.com/google/cadvisor/info/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/clock"
 	cadvisortest "k8s.io/kubernetes/pkg/kubelet/cadvisor/testing"
 	"k8s.io/kubernetes/pkg/kubelet/container"
 	containertest "k8s.io/kubernetes/pkg/kubelet/container/testing"
+	"k8s.io/kubernetes/pkg/kubelet/events"
 )
 
 var zero time.Time
@@ -463,3 +466,188 @@ func TestGarbageCollectImageNotOldEnough(t *testing.T) {
 	assert.EqualValues(1024, spaceFreed)
 	assert.Len(fakeRuntime.ImageList, 1)
 }
+
+func TestFreeSpacePinnedImagesAreIgnored(t *testing.T) {
+	manager, fakeRuntime, _ := newRealImageGCManager(ImageGCPolicy{
+		PinnedImages: []string{"pause", imageID(2)},
+	})
+	pinnedByTag := makeImage(0, 1024)
+	pinnedByTag.RepoTags = []string{"pause:latest"}
+	fakeRuntime.ImageList = []container.Image{
+		pinnedByTag,
+		makeImage(1, 2048),
+		makeImage(2, 2048),
+	}
+
+	spaceFreed, err := manager.freeSpace(4096, time.Now())
+	assert := assert.New(t)
+	require.NoError(t, err)
+	assert.EqualValues(2048, spaceFreed)
+	require.Len(t, fakeRuntime.ImageList, 2)
+	assert.Equal(imageID(0), fakeRuntime.ImageList[0].ID)
+	assert.Equal(imageID(2), fakeRuntime.ImageList[1].ID)
+}
+
+func TestFreeSpacePinnedImageSelector(t *testing.T) {
+	selector, err := labels.Parse("gc=keep")
+	require.NoError(t, err)
+	manager, fakeRuntime, _ := newRealImageGCManager(ImageGCPolicy{
+		PinnedImageSelector: selector,
+	})
+	pinned := makeImage(0, 1024)
+	pinned.Labels = map[string]string{"gc": "keep"}
+	fakeRuntime.ImageList = []container.Image{
+		pinned,
+		makeImage(1, 2048),
+	}
+
+	spaceFreed, err := manager.DeleteUnusedImages()
+	assert := assert.New(t)
+	require.NoError(t, err)
+	assert.EqualValues(2048, spaceFreed)
+	require.Len(t, fakeRuntime.ImageList, 1)
+	assert.Equal(imageID(0), fakeRuntime.ImageList[0].ID)
+}
+
+func TestIsImagePinned(t *testing.T) {
+	image := container.Image{
+		ID:          "sha256:abc",
+		RepoTags:    []string{"gcr.io/google_containers/pause-amd64:3.0", "localhost:5000/busybox:latest"},
+		RepoDigests: []string{"busybox@sha256:def"},
+	}
+	for _, test := range []struct {
+		ref    string
+		pinned bool
+	}{
+		{"sha256:abc", true},
+		{"gcr.io/google_containers/pause-amd64:3.0", true},
+		{"gcr.io/google_containers/pause-amd64", false},
+		{"localhost:5000/busybox", true},
+		{"localhost:5000/busybox:1.0", false},
+		{"busybox@sha256:def", true},
+		{"busybox", false},
+	} {
+		pinned := isImagePinned(image, ImageGCPolicy{PinnedImages: []string{test.ref}})
+		assert.Equal(t, test.pinned, pinned, "ref %q", test.ref)
+	}
+}
+
+func TestGarbageCollectMaxUnusedImages(t *testing.T) {
+	policy := ImageGCPolicy{
+		HighThresholdPercent: 90,
+		LowThresholdPercent:  80,
+		MaxUnusedImages:      1,
+	}
+	manager, fakeRuntime, mockCadvisor := newRealImageGCManager(policy)
+	recorder := record.NewFakeRecorder(10)
+	manager.recorder = recorder
+
+	// Expect 40% usage, so only the unused image limit applies.
+	mockCadvisor.On("ImagesFsInfo").Return(cadvisorapiv2.FsInfo{
+		Available: 600,
+		Capacity:  1000,
+	}, nil)
+	fakeRuntime.ImageList = []container.Image{
+		makeImage(0, 100),
+		makeImage(1, 100),
+		makeImage(2, 100),
+		makeImage(3, 100),
+	}
+	fakeRuntime.AllPodList = []*containertest.FakePod{
+		{Pod: &container.Pod{
+			Containers: []*container.Container{
+				makeContainer(3),
+			},
+		}},
+	}
+
+	assert := assert.New(t)
+	require.NoError(t, manager.GarbageCollect())
+	// One unused image and the image in use are kept.
+	assert.Len(fakeRuntime.ImageList, 2)
+	assert.Len(recorder.Events, 2)
+	event := <-recorder.Events
+	assert.Contains(event, events.RemovedImage)
+	assert.Contains(event, imageGCReasonMaxUnusedImages)
+}
+
+func TestDryRun(t *testing.T) {
+	policy := ImageGCPolicy{
+		HighThresholdPercent: 90,
+		LowThresholdPercent:  80,
+		MinAge:               time.Hour,
+		PinnedImages:         []string{imageID(1)},
+	}
+	manager, fakeRuntime, mockCadvisor := newRealImageGCManager(policy)
+
+	// Expect 95% usage, 150 bytes to free.
+	mockCadvisor.On("ImagesFsInfo").Return(cadvisorapiv2.FsInfo{
+		Available: 50,
+		Capacity:  1000,
+	}, nil)
+	fakeRuntime.ImageList = []container.Image{
+		makeImage(0, 100),
+		makeImage(1, 100),
+		makeImage(2, 100),
+		makeImage(3, 100),
+	}
+	fakeRuntime.AllPodList = []*containertest.FakePod{
+		{Pod: &container.Pod{
+			Containers: []*container.Container{
+				makeContainer(3),
+			},
+		}},
+	}
+	// Images 0 through 3 are old enough to be collected; image 4 was just pulled.
+	require.NoError(t, manager.detectImages(time.Now().Add(-2*time.Hour)))
+	fakeRuntime.ImageList = append(fakeRuntime.ImageList, makeImage(4, 100))
+
+	report, err := manager.DryRun()
+	require.NoError(t, err)
+	assert := assert.New(t)
+	assert.Equal(95, report.UsagePercent)
+	assert.EqualValues(150, report.BytesToFree)
+	assert.EqualValues(200, report.BytesFreed)
+	// Nothing is actually removed.
+	assert.Len(fakeRuntime.ImageList, 5)
+
+	reasons := map[string]string{}
+	removed := sets.NewString()
+	for _, entry := range report.Images {
+		reasons[entry.ID] = entry.Reason
+		if entry.Remove {
+			removed.Insert(entry.ID)
+		}
+	}
+	assert.Equal(map[string]string{
+		imageID(0): imageGCReasonDiskUsage,
+		imageID(1): imageGCReasonPinned,
+		imageID(2): imageGCReasonDiskUsage,
+		imageID(3): imageGCReasonInUse,
+		imageID(4): imageGCReasonTooYoung,
+	}, reasons)
+	assert.Equal(sets.NewString(imageID(0), imageID(2)), removed)
+}
+
+func TestDryRunInvalidCapacity(t *testing.T) {
+	manager, _, mockCadvisor := newRealImageGCManager(ImageGCPolicy{
+		HighThresholdPercent: 90,
+		LowThresholdPercent:  80,
+	})
+	recorder := record.NewFakeRecorder(10)
+	manager.recorder = recorder
+
+	mockCadvisor.On("ImagesFsInfo").Return(cadvisorapiv2.FsInfo{
+		Available: 0,
+		Capacity:  0,
+	}, nil)
+
+	_, err := manager.DryRun()
+	assert.Error(t, err)
+	// A dry run must not have side effects, so no event is recorded.
+	assert.Len(t, recorder.Events, 0)
+
+	assert.Error(t, manager.GarbageCollect())
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, events.InvalidDiskCapacity)
+}
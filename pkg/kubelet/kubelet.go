@@ -59,6 +59,7 @@ import (
 	"k8s.io/kubernetes/pkg/features"
 	internalapi "k8s.io/kubernetes/pkg/kubelet/api"
 	"k8s.io/kubernetes/pkg/kubelet/cadvisor"
+	"k8s.io/kubernetes/pkg/kubelet/certificate"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
@@ -105,6 +106,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/oom"
 	"k8s.io/kubernetes/pkg/util/procfs"
 	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util/volumepathhandler"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
 )
 
@@ -234,7 +236,10 @@ type KubeletDeps struct {
 	Recorder           record.EventRecorder
 	Writer             kubeio.Writer
 	VolumePlugins      []volume.VolumePlugin
-	TLSOptions         *server.TLSOptions
+	// DynamicPluginProber discovers volume plugins installed while the
+	// kubelet is running, may be nil.
+	DynamicPluginProber volume.DynamicPluginProber
+	TLSOptions          *server.TLSOptions
 }
 
 // makePodSourceConfig creates a config.PodConfig from the given
@@ -344,6 +349,17 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 		MinAge:               kubeCfg.ImageMinimumGCAge.Duration,
 		HighThresholdPercent: int(kubeCfg.ImageGCHighThresholdPercent),
 		LowThresholdPercent:  int(kubeCfg.ImageGCLowThresholdPercent),
+		MaxUnusedImages:      int(kubeCfg.ImageGCMaxUnusedImages),
+		// The pod infra container image is needed to start every pod, never
+		// collect it.
+		PinnedImages: append([]string{kubeCfg.PodInfraContainerImage}, kubeCfg.ImageGCPinnedImages...),
+	}
+	if len(kubeCfg.ImageGCPinnedImageSelector) > 0 {
+		selector, err := labels.Parse(kubeCfg.ImageGCPinnedImageSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid image-gc-pinned-image-selector %q: %v", kubeCfg.ImageGCPinnedImageSelector, err)
+		}
+		imageGCPolicy.PinnedImageSelector = selector
 	}
 
 	diskSpacePolicy := DiskSpacePolicy{
@@ -450,6 +466,7 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 		cgroupsPerQOS:     kubeCfg.CgroupsPerQOS,
 		cgroupRoot:        kubeCfg.CgroupRoot,
 		mounter:           kubeDeps.Mounter,
+		blkUtil:           volumepathhandler.NewBlockVolumePathHandler(),
 		writer:            kubeDeps.Writer,
 		nonMasqueradeCIDR: kubeCfg.NonMasqueradeCIDR,
 		maxPods:           int(kubeCfg.MaxPods),
@@ -714,7 +731,7 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 	}
 
 	// TODO: Factor out "StatsProvider" from Kubelet so we don't have a cyclic dependency
-	klet.resourceAnalyzer = stats.NewResourceAnalyzer(klet, kubeCfg.VolumeStatsAggPeriod.Duration, klet.containerRuntime)
+	klet.resourceAnalyzer = stats.NewResourceAnalyzer(klet, kubeCfg.VolumeStatsAggPeriod.Duration, klet.containerRuntime, kubeDeps.Recorder)
 
 	klet.pleg = pleg.NewGenericPLEG(klet.containerRuntime, plegChannelCapacity, plegRelistPeriod, klet.podCache, clock.RealClock{})
 	klet.runtimeState = newRuntimeState(maxWaitForContainerRuntime)
@@ -746,7 +763,7 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 		kubeDeps.Recorder)
 
 	klet.volumePluginMgr, err =
-		NewInitializedVolumePluginMgr(klet, secretManager, kubeDeps.VolumePlugins)
+		NewInitializedVolumePluginMgr(klet, secretManager, kubeDeps.VolumePlugins, kubeDeps.DynamicPluginProber)
 	if err != nil {
 		return nil, err
 	}
@@ -784,6 +801,14 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 	klet.podKillingCh = make(chan *kubecontainer.PodPair, podKillingChannelCapacity)
 	klet.setNodeStatusFuncs = klet.defaultNodeStatusFuncs()
 
+	if utilfeature.DefaultFeatureGate.Enabled(features.RotateKubeletServerCertificate) && kubeDeps.TLSOptions != nil {
+		klet.serverCertificateManager, err = certificate.NewKubeletServerCertificateManager(klet.kubeClient, kubeCfg, klet.nodeName, klet.getLastObservedNodeAddresses)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize certificate manager: %v", err)
+		}
+		kubeDeps.TLSOptions.Config.GetCertificate = klet.serverCertificateManager.GetCertificate
+	}
+
 	// setup eviction manager
 	evictionManager, evictionAdmitHandler := eviction.NewManager(klet.resourceAnalyzer, evictionConfig, killPodNow(klet.podWorkers, kubeDeps.Recorder), klet.imageManager, kubeDeps.Recorder, nodeRef, klet.clock)
 
@@ -1018,6 +1043,9 @@ type Kubelet struct {
 	// Mounter to use for volumes.
 	mounter mount.Interface
 
+	// blkUtil is used to look up the symbolic links of raw block volumes.
+	blkUtil volumepathhandler.BlockVolumePathHandler
+
 	// Writer interface to use for volumes.
 	writer kubeio.Writer
 
@@ -1143,6 +1171,16 @@ type Kubelet struct {
 	// dockerLegacyService contains some legacy methods for backward compatibility.
 	// It should be set only when docker is using non json-file logging driver.
 	dockerLegacyService dockershim.DockerLegacyService
+
+	// serverCertificateManager requests and rotates the serving certificate
+	// of the Kubelet server through the certificates API. It is nil unless
+	// the RotateKubeletServerCertificate feature is enabled.
+	serverCertificateManager certificate.Manager
+
+	// lastObservedNodeAddresses are the addresses last reported in the node
+	// status, used as subject alternative names of the serving certificate.
+	lastObservedNodeAddressesMux sync.Mutex
+	lastObservedNodeAddresses    []v1.NodeAddress
 }
 
 // setupDataDirs creates:
@@ -1154,6 +1192,11 @@ func (kl *Kubelet) setupDataDirs() error {
 	if err := os.MkdirAll(kl.getRootDir(), 0750); err != nil {
 		return fmt.Errorf("error creating root directory: %v", err)
 	}
+	if utilfeature.DefaultFeatureGate.Enabled(features.MountPropagation) {
+		if err := kl.mounter.MakeRShared(kl.getRootDir()); err != nil {
+			return fmt.Errorf("error configuring root directory: %v", err)
+		}
+	}
 	if err := os.MkdirAll(kl.getPodsDir(), 0750); err != nil {
 		return fmt.Errorf("error creating pods directory: %v", err)
 	}
@@ -1204,7 +1247,7 @@ func (kl *Kubelet) StartGarbageCollection() {
 // Note that the modules here must not depend on modules that are not initialized here.
 func (kl *Kubelet) initializeModules() error {
 	// Step 1: Promethues metrics.
-	metrics.Register(kl.runtimeCache)
+	metrics.Register(kl.runtimeCache, stats.NewVolumeStatsCollector(kl, kl.resourceAnalyzer))
 
 	// Step 2: Setup filesystem directories.
 	if err := kl.setupDataDirs(); err != nil {
@@ -1270,6 +1313,10 @@ func (kl *Kubelet) Run(updates <-chan kubetypes.PodUpdate) {
 		kl.runtimeState.setInitError(err)
 	}
 
+	if kl.serverCertificateManager != nil {
+		kl.serverCertificateManager.Start()
+	}
+
 	// Start volume manager
 	go kl.volumeManager.Run(kl.sourcesReady, wait.NeverStop)
 
@@ -28,6 +28,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/kubelet/images"
 	"k8s.io/kubernetes/pkg/util"
 	nodeutil "k8s.io/kubernetes/pkg/util/node"
 	volumeutil "k8s.io/kubernetes/pkg/volume/util"
@@ -104,6 +105,20 @@ func (kl *Kubelet) getPodVolumeDir(podUID types.UID, pluginName string, volumeNa
 	return filepath.Join(kl.getPodVolumesDir(podUID), pluginName, volumeName)
 }
 
+// getPodVolumeDevicesDir returns the full path to the per-pod data directory
+// under which the symbolic links to raw block devices are created for the
+// specified pod.  This directory may not exist if the pod does not exist.
+func (kl *Kubelet) getPodVolumeDevicesDir(podUID types.UID) string {
+	return filepath.Join(kl.getPodDir(podUID), options.DefaultKubeletVolumeDevicesDirName)
+}
+
+// getPodVolumeDeviceDir returns the full path to the directory which holds
+// the symbolic links to the raw block devices of the named plugin for the
+// specified pod.  This directory may not exist if the pod does not exist.
+func (kl *Kubelet) getPodVolumeDeviceDir(podUID types.UID, pluginName string) string {
+	return filepath.Join(kl.getPodVolumeDevicesDir(podUID), pluginName)
+}
+
 // getPodPluginsDir returns the full path to the per-pod data directory under
 // which plugins may store data for the specified pod.  This directory may not
 // exist if the pod does not exist.
@@ -164,6 +179,12 @@ func (kl *Kubelet) GetRunningPods() ([]*v1.Pod, error) {
 	return apiPods, nil
 }
 
+// GetImageGCReport returns what image garbage collection would remove if it
+// ran now, without removing any images.
+func (kl *Kubelet) GetImageGCReport() (*images.ImageGCReport, error) {
+	return kl.imageManager.DryRun()
+}
+
 // GetPodByFullName gets the pod with the given 'full' name, which
 // incorporates the namespace as well as whether the pod was found.
 func (kl *Kubelet) GetPodByFullName(podFullName string) (*v1.Pod, bool) {
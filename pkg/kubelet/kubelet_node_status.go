@@ -475,6 +475,22 @@ func (kl *Kubelet) setNodeAddress(node *v1.Node) error {
 	return nil
 }
 
+// setLastObservedNodeAddresses records the addresses of the node, so that the
+// serving certificate can be requested for them.
+func (kl *Kubelet) setLastObservedNodeAddresses(node *v1.Node) {
+	kl.lastObservedNodeAddressesMux.Lock()
+	defer kl.lastObservedNodeAddressesMux.Unlock()
+	kl.lastObservedNodeAddresses = node.Status.Addresses
+}
+
+// getLastObservedNodeAddresses returns the addresses last reported in the
+// node status.
+func (kl *Kubelet) getLastObservedNodeAddresses() []v1.NodeAddress {
+	kl.lastObservedNodeAddressesMux.Lock()
+	defer kl.lastObservedNodeAddressesMux.Unlock()
+	return kl.lastObservedNodeAddresses
+}
+
 func (kl *Kubelet) setNodeStatusMachineInfo(node *v1.Node) {
 	// Note: avoid blindly overwriting the capacity in case opaque
 	//       resources are being advertised.
@@ -508,6 +524,13 @@ func (kl *Kubelet) setNodeStatusMachineInfo(node *v1.Node) {
 			node.Status.Capacity[rName] = rCap
 		}
 
+		// populate huge page capacity tracked by the container manager.
+		for rName, rCap := range kl.containerManager.GetCapacity() {
+			if v1.IsHugePageResourceName(rName) {
+				node.Status.Capacity[rName] = rCap
+			}
+		}
+
 		if kl.podsPerCore > 0 {
 			node.Status.Capacity[v1.ResourcePods] = *resource.NewQuantity(
 				int64(math.Min(float64(info.NumCores*kl.podsPerCore), float64(kl.maxPods))), resource.DecimalSI)
@@ -537,6 +560,19 @@ func (kl *Kubelet) setNodeStatusMachineInfo(node *v1.Node) {
 		}
 		node.Status.Allocatable[k] = value
 	}
+	// for every huge page reservation, we need to remove it from allocatable memory
+	for k, v := range node.Status.Capacity {
+		if v1.IsHugePageResourceName(k) {
+			allocatableMemory := node.Status.Allocatable[v1.ResourceMemory]
+			value := *(v.Copy())
+			allocatableMemory.Sub(value)
+			if allocatableMemory.Sign() < 0 {
+				// Negative Allocatable resources don't make sense.
+				allocatableMemory.Set(0)
+			}
+			node.Status.Allocatable[v1.ResourceMemory] = allocatableMemory
+		}
+	}
 }
 
 // Set versioninfo for the node.
@@ -906,6 +942,7 @@ func (kl *Kubelet) defaultNodeStatusFuncs() []func(*v1.Node) error {
 	}
 	return []func(*v1.Node) error{
 		kl.setNodeAddress,
+		withoutError(kl.setLastObservedNodeAddresses),
 		withoutError(kl.setNodeStatusInfo),
 		withoutError(kl.setNodeOODCondition),
 		withoutError(kl.setNodeMemoryPressureCondition),
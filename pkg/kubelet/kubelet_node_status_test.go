@@ -113,12 +113,17 @@ func applyNodeStatusPatch(originalNode *v1.Node, patch []byte) (*v1.Node, error)
 type localCM struct {
 	cm.ContainerManager
 	allocatable v1.ResourceList
+	capacity    v1.ResourceList
 }
 
 func (lcm *localCM) GetNodeAllocatableReservation() v1.ResourceList {
 	return lcm.allocatable
 }
 
+func (lcm *localCM) GetCapacity() v1.ResourceList {
+	return lcm.capacity
+}
+
 func TestUpdateNewNodeStatus(t *testing.T) {
 	// generate one more than maxImagesInNodeStatus in inputImageList
 	inputImageList, expectedImageList := generateTestingImageList(maxImagesInNodeStatus + 1)
@@ -267,6 +272,44 @@ func TestUpdateNewNodeStatus(t *testing.T) {
 
 }
 
+func TestSetNodeStatusMachineInfoHugePages(t *testing.T) {
+	testKubelet := newTestKubelet(t, false /* controllerAttachDetachEnabled */)
+	defer testKubelet.Cleanup()
+	kubelet := testKubelet.kubelet
+	kubelet.containerManager = &localCM{
+		ContainerManager: cm.NewStubContainerManager(),
+		allocatable: v1.ResourceList{
+			v1.ResourceMemory: *resource.NewQuantity(100E6, resource.BinarySI),
+		},
+		capacity: v1.ResourceList{
+			v1.ResourceName("hugepages-2Mi"): resource.MustParse("1Gi"),
+		},
+	}
+	machineInfo := &cadvisorapi.MachineInfo{
+		NumCores:       2,
+		MemoryCapacity: 10 * 1024 * 1024 * 1024,
+	}
+	testKubelet.fakeCadvisor.On("MachineInfo").Return(machineInfo, nil)
+
+	node := &v1.Node{}
+	kubelet.setNodeStatusMachineInfo(node)
+
+	hugePagesCapacity := node.Status.Capacity[v1.ResourceName("hugepages-2Mi")]
+	if hugePagesCapacity.Cmp(resource.MustParse("1Gi")) != 0 {
+		t.Errorf("expected hugepages-2Mi capacity of 1Gi, got %v", hugePagesCapacity.String())
+	}
+	hugePagesAllocatable := node.Status.Allocatable[v1.ResourceName("hugepages-2Mi")]
+	if hugePagesAllocatable.Cmp(resource.MustParse("1Gi")) != 0 {
+		t.Errorf("expected hugepages-2Mi allocatable of 1Gi, got %v", hugePagesAllocatable.String())
+	}
+	// allocatable memory excludes both the reservation and the pre-allocated huge pages
+	expectedMemory := resource.NewQuantity(10*1024*1024*1024-100E6-1024*1024*1024, resource.BinarySI)
+	allocatableMemory := node.Status.Allocatable[v1.ResourceMemory]
+	if allocatableMemory.Cmp(*expectedMemory) != 0 {
+		t.Errorf("expected allocatable memory of %v, got %v", expectedMemory.String(), allocatableMemory.String())
+	}
+}
+
 func TestUpdateNewNodeOutOfDiskStatusWithTransitionFrequency(t *testing.T) {
 	testKubelet := newTestKubelet(t, false /* controllerAttachDetachEnabled */)
 	defer testKubelet.Cleanup()
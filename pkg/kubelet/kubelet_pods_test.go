@@ -31,9 +31,11 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	core "k8s.io/client-go/testing"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
+	runtimeapi "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/runtime"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	containertest "k8s.io/kubernetes/pkg/kubelet/container/testing"
 	"k8s.io/kubernetes/pkg/kubelet/server/portforward"
@@ -113,6 +115,96 @@ func TestMakeMounts(t *testing.T) {
 	assert.Equal(t, expectedMounts, mounts, "mounts of container %+v", container)
 }
 
+func TestMakeMountsPropagation(t *testing.T) {
+	propagationNone := v1.MountPropagationNone
+	propagationHostToContainer := v1.MountPropagationHostToContainer
+	propagationBidirectional := v1.MountPropagationBidirectional
+	propagationInvalid := v1.MountPropagationMode("invalid")
+
+	podVolumes := kubecontainer.VolumeMap{
+		"disk": kubecontainer.VolumeInfo{Mounter: &stubVolume{path: "/mnt/disk"}},
+	}
+	pod := v1.Pod{
+		Spec: v1.PodSpec{
+			HostNetwork: true,
+		},
+	}
+	container := v1.Container{
+		VolumeMounts: []v1.VolumeMount{
+			{
+				MountPath: "/mnt/path1",
+				Name:      "disk",
+			},
+			{
+				MountPath:        "/mnt/path2",
+				Name:             "disk",
+				MountPropagation: &propagationNone,
+			},
+			{
+				MountPath:        "/mnt/path3",
+				Name:             "disk",
+				MountPropagation: &propagationHostToContainer,
+			},
+			{
+				MountPath:        "/mnt/path4",
+				Name:             "disk",
+				MountPropagation: &propagationBidirectional,
+			},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		featureEnabled       bool
+		expectedPropagations []runtimeapi.MountPropagation
+	}{
+		{
+			name:           "feature disabled",
+			featureEnabled: false,
+			expectedPropagations: []runtimeapi.MountPropagation{
+				runtimeapi.MountPropagation_PROPAGATION_PRIVATE,
+				runtimeapi.MountPropagation_PROPAGATION_PRIVATE,
+				runtimeapi.MountPropagation_PROPAGATION_PRIVATE,
+				runtimeapi.MountPropagation_PROPAGATION_PRIVATE,
+			},
+		},
+		{
+			name:           "feature enabled",
+			featureEnabled: true,
+			expectedPropagations: []runtimeapi.MountPropagation{
+				runtimeapi.MountPropagation_PROPAGATION_PRIVATE,
+				runtimeapi.MountPropagation_PROPAGATION_PRIVATE,
+				runtimeapi.MountPropagation_PROPAGATION_HOST_TO_CONTAINER,
+				runtimeapi.MountPropagation_PROPAGATION_BIDIRECTIONAL,
+			},
+		},
+	}
+
+	defer utilfeature.DefaultFeatureGate.Set("MountPropagation=false")
+	for _, test := range tests {
+		utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("MountPropagation=%t", test.featureEnabled))
+		mounts, err := makeMounts(&pod, "/pod", &container, "fakepodname", "", "", podVolumes)
+		require.NoError(t, err, test.name)
+		require.Len(t, mounts, len(test.expectedPropagations), test.name)
+		for i, mount := range mounts {
+			assert.Equal(t, test.expectedPropagations[i], mount.Propagation, "%s: mount %s", test.name, mount.ContainerPath)
+		}
+	}
+
+	invalidContainer := v1.Container{
+		VolumeMounts: []v1.VolumeMount{
+			{
+				MountPath:        "/mnt/path1",
+				Name:             "disk",
+				MountPropagation: &propagationInvalid,
+			},
+		},
+	}
+	utilfeature.DefaultFeatureGate.Set("MountPropagation=true")
+	_, err := makeMounts(&pod, "/pod", &invalidContainer, "fakepodname", "", "", podVolumes)
+	assert.Error(t, err, "invalid mount propagation")
+}
+
 func TestRunInContainerNoSuchPod(t *testing.T) {
 	testKubelet := newTestKubelet(t, false /* controllerAttachDetachEnabled */)
 	defer testKubelet.Cleanup()
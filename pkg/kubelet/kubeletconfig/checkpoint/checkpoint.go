@@ -0,0 +1,100 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/apis/componentconfig"
+	// Need to make sure the componentconfig api is installed so the decoder and defaulting funcs work
+	_ "k8s.io/kubernetes/pkg/apis/componentconfig/install"
+	componentconfigv1alpha1 "k8s.io/kubernetes/pkg/apis/componentconfig/v1alpha1"
+)
+
+// KubeletKey is the key of the ConfigMap data entry that holds the serialized KubeletConfiguration.
+const KubeletKey = "kubelet"
+
+// Checkpoint is a local copy of the object that a config source refers to.
+type Checkpoint interface {
+	// UID returns the UID of the checkpointed object.
+	UID() string
+	// Parse extracts the KubeletConfiguration from the checkpoint, applies defaults,
+	// and converts it to the internal type.
+	Parse() (*componentconfig.KubeletConfiguration, error)
+	// Encode returns the serialized form of the checkpoint.
+	Encode() ([]byte, error)
+}
+
+// configMapCheckpoint is a Checkpoint backed by a ConfigMap.
+type configMapCheckpoint struct {
+	configMap *v1.ConfigMap
+}
+
+// NewConfigMapCheckpoint returns a Checkpoint backed by the given ConfigMap.
+func NewConfigMapCheckpoint(cm *v1.ConfigMap) (Checkpoint, error) {
+	if cm == nil {
+		return nil, fmt.Errorf("ConfigMap must be non-nil to be treated as a checkpoint")
+	}
+	if len(cm.UID) == 0 {
+		return nil, fmt.Errorf("ConfigMap must have a UID to be treated as a checkpoint")
+	}
+	return &configMapCheckpoint{configMap: cm}, nil
+}
+
+// DecodeCheckpoint decodes a Checkpoint previously serialized with Encode.
+func DecodeCheckpoint(data []byte) (Checkpoint, error) {
+	cm := &v1.ConfigMap{}
+	if err := json.Unmarshal(data, cm); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint: %v", err)
+	}
+	return NewConfigMapCheckpoint(cm)
+}
+
+func (c *configMapCheckpoint) UID() string {
+	return string(c.configMap.UID)
+}
+
+func (c *configMapCheckpoint) Parse() (*componentconfig.KubeletConfiguration, error) {
+	data, ok := c.configMap.Data[KubeletKey]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s/%s does not contain the %q key", c.configMap.Namespace, c.configMap.Name, KubeletKey)
+	}
+	return decodeKubeletConfiguration([]byte(data))
+}
+
+func (c *configMapCheckpoint) Encode() ([]byte, error) {
+	return json.Marshal(c.configMap)
+}
+
+// decodeKubeletConfiguration decodes a serialized v1alpha1 KubeletConfiguration, applies
+// the defaults and converts it to the internal type.
+func decodeKubeletConfiguration(data []byte) (*componentconfig.KubeletConfiguration, error) {
+	external := &componentconfigv1alpha1.KubeletConfiguration{}
+	if err := runtime.DecodeInto(api.Codecs.UniversalDecoder(), data, external); err != nil {
+		return nil, fmt.Errorf("failed to decode KubeletConfiguration: %v", err)
+	}
+	api.Scheme.Default(external)
+	kc := &componentconfig.KubeletConfiguration{}
+	if err := api.Scheme.Convert(external, kc, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert KubeletConfiguration: %v", err)
+	}
+	return kc, nil
+}
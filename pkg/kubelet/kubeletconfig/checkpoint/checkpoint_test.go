@@ -0,0 +1,153 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+)
+
+const validConfig = `{"kind":"KubeletConfiguration","apiVersion":"componentconfig/v1alpha1","maxPods":42}`
+
+func newConfigMap(uid, config string) *v1.ConfigMap {
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "kubelet-config", UID: types.UID(uid)},
+		Data:       map[string]string{},
+	}
+	if len(config) > 0 {
+		cm.Data[KubeletKey] = config
+	}
+	return cm
+}
+
+func TestConfigMapCheckpointParse(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    string
+		expectErr bool
+	}{
+		{name: "valid", config: validConfig},
+		{name: "missing key", config: "", expectErr: true},
+		{name: "malformed", config: `{"kind":`, expectErr: true},
+		{name: "wrong type", config: `{"kind":"KubeletConfiguration","apiVersion":"componentconfig/v1alpha1","maxPods":"many"}`, expectErr: true},
+	}
+	for _, tc := range testCases {
+		cp, err := NewConfigMapCheckpoint(newConfigMap("uid", tc.config))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		kc, err := cp.Parse()
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if kc.MaxPods != 42 {
+			t.Errorf("%s: expected MaxPods 42, got %d", tc.name, kc.MaxPods)
+		}
+		// defaults are applied to fields missing from the serialized config
+		if kc.CrashLoopThreshold == 0 {
+			t.Errorf("%s: expected CrashLoopThreshold to be defaulted", tc.name)
+		}
+	}
+}
+
+func TestNewConfigMapCheckpointRequiresUID(t *testing.T) {
+	if _, err := NewConfigMapCheckpoint(newConfigMap("", validConfig)); err == nil {
+		t.Errorf("expected error for a ConfigMap without UID")
+	}
+	if _, err := NewConfigMapCheckpoint(nil); err == nil {
+		t.Errorf("expected error for a nil ConfigMap")
+	}
+}
+
+func TestCheckpointEncodeDecode(t *testing.T) {
+	cp, err := NewConfigMapCheckpoint(newConfigMap("uid", validConfig))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := cp.Encode()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, err := DecodeCheckpoint(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.UID() != "uid" {
+		t.Errorf("expected UID %q, got %q", "uid", decoded.UID())
+	}
+	if _, err := decoded.Parse(); err != nil {
+		t.Errorf("unexpected error parsing decoded checkpoint: %v", err)
+	}
+}
+
+func TestNewRemoteConfigSource(t *testing.T) {
+	testCases := []struct {
+		name      string
+		source    *v1.NodeConfigSource
+		expectErr bool
+	}{
+		{name: "nil", expectErr: true},
+		{name: "empty", source: &v1.NodeConfigSource{}, expectErr: true},
+		{name: "missing uid", source: &v1.NodeConfigSource{ConfigMapRef: &v1.ObjectReference{Namespace: "kube-system", Name: "kubelet-config"}}, expectErr: true},
+		{name: "valid", source: &v1.NodeConfigSource{ConfigMapRef: &v1.ObjectReference{Namespace: "kube-system", Name: "kubelet-config", UID: "uid"}}},
+	}
+	for _, tc := range testCases {
+		_, err := NewRemoteConfigSource(tc.source)
+		if tc.expectErr && err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		}
+		if !tc.expectErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+		}
+	}
+}
+
+func TestRemoteConfigSourceDownload(t *testing.T) {
+	client := fake.NewSimpleClientset(newConfigMap("uid", validConfig))
+
+	source, err := NewRemoteConfigSource(&v1.NodeConfigSource{ConfigMapRef: &v1.ObjectReference{Namespace: "kube-system", Name: "kubelet-config", UID: "uid"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cp, err := source.Download(client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cp.UID() != "uid" {
+		t.Errorf("expected UID %q, got %q", "uid", cp.UID())
+	}
+
+	// the ConfigMap was replaced since the config source was assigned
+	stale, err := NewRemoteConfigSource(&v1.NodeConfigSource{ConfigMapRef: &v1.ObjectReference{Namespace: "kube-system", Name: "kubelet-config", UID: "other"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := stale.Download(client); err == nil {
+		t.Errorf("expected error downloading a ConfigMap with a different UID")
+	}
+}
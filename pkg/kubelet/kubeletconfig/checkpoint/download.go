@@ -0,0 +1,84 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+)
+
+// RemoteConfigSource identifies an object on the API server that holds node configuration.
+type RemoteConfigSource struct {
+	source *v1.NodeConfigSource
+}
+
+// NewRemoteConfigSource validates the given NodeConfigSource and wraps it in a RemoteConfigSource.
+func NewRemoteConfigSource(source *v1.NodeConfigSource) (*RemoteConfigSource, error) {
+	if source == nil {
+		return nil, fmt.Errorf("config source must be non-nil")
+	}
+	ref := source.ConfigMapRef
+	if ref == nil {
+		return nil, fmt.Errorf("exactly one subfield of the config source must be non-nil, but all were nil")
+	}
+	if len(ref.Namespace) == 0 || len(ref.Name) == 0 || len(ref.UID) == 0 {
+		return nil, fmt.Errorf("the namespace, name and uid of the ConfigMap reference must be set, got %s/%s (uid %q)", ref.Namespace, ref.Name, ref.UID)
+	}
+	return &RemoteConfigSource{source: source}, nil
+}
+
+// UID returns the UID of the object the source refers to.
+func (r *RemoteConfigSource) UID() string {
+	return string(r.source.ConfigMapRef.UID)
+}
+
+// NodeConfigSource returns the API representation of the source.
+func (r *RemoteConfigSource) NodeConfigSource() *v1.NodeConfigSource {
+	return r.source
+}
+
+// String returns a human readable description of the source.
+func (r *RemoteConfigSource) String() string {
+	ref := r.source.ConfigMapRef
+	return fmt.Sprintf("ConfigMap %s/%s (UID: %s)", ref.Namespace, ref.Name, ref.UID)
+}
+
+// Equal reports whether both sources refer to the same object.
+func (r *RemoteConfigSource) Equal(other *RemoteConfigSource) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	a, b := r.source.ConfigMapRef, other.source.ConfigMapRef
+	return a.Namespace == b.Namespace && a.Name == b.Name && a.UID == b.UID
+}
+
+// Download fetches the object the source refers to from the API server and returns it as a Checkpoint.
+// It fails if the UID of the object on the API server does not match the UID of the source.
+func (r *RemoteConfigSource) Download(client clientset.Interface) (Checkpoint, error) {
+	ref := r.source.ConfigMapRef
+	cm, err := client.Core().ConfigMaps(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %v", r, err)
+	}
+	if cm.UID != ref.UID {
+		return nil, fmt.Errorf("failed to download %s: the UID of the ConfigMap on the API server is %q", r, cm.UID)
+	}
+	return NewConfigMapCheckpoint(cm)
+}
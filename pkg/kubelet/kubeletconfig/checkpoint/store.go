@@ -0,0 +1,223 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+const (
+	checkpointsDir = "checkpoints"
+	metaDir        = "meta"
+	// currentFile records the config source the Kubelet should use.
+	currentFile = "current"
+	// lastKnownGoodFile records the config source the Kubelet rolls back to.
+	lastKnownGoodFile = "last-known-good"
+)
+
+// Store persists checkpoints and the current and last-known-good config sources.
+type Store interface {
+	// Initialize sets up the storage layout if it does not already exist.
+	Initialize() error
+	// Exists reports whether a checkpoint with the given UID is stored.
+	Exists(uid string) (bool, error)
+	// Save stores the checkpoint, replacing any checkpoint with the same UID.
+	Save(c Checkpoint) error
+	// Load returns the checkpoint with the given UID.
+	Load(uid string) (Checkpoint, error)
+	// Current returns the current config source, or nil if the Kubelet should use its local configuration.
+	Current() (*RemoteConfigSource, error)
+	// CurrentModified returns the last time the current config source was changed.
+	CurrentModified() (time.Time, error)
+	// LastKnownGood returns the last-known-good config source, or nil if the local configuration is last-known-good.
+	LastKnownGood() (*RemoteConfigSource, error)
+	// SetCurrentUpdated sets the current config source and reports whether it changed.
+	// A nil source resets the Kubelet to its local configuration.
+	SetCurrentUpdated(source *RemoteConfigSource) (bool, error)
+	// SetLastKnownGood sets the last-known-good config source.
+	// A nil source resets the last-known-good config to the local configuration.
+	SetLastKnownGood(source *RemoteConfigSource) error
+	// Reset resets the current and last-known-good config sources to the local configuration,
+	// and reports whether the current config source changed.
+	Reset() (bool, error)
+}
+
+// fsStore is a Store backed by a directory on the local filesystem.
+type fsStore struct {
+	dir string
+}
+
+// NewFsStore returns a Store that keeps its data under dir.
+func NewFsStore(dir string) Store {
+	return &fsStore{dir: dir}
+}
+
+func (s *fsStore) Initialize() error {
+	for _, dir := range []string{checkpointsDir, metaDir} {
+		if err := os.MkdirAll(filepath.Join(s.dir, dir), 0700); err != nil {
+			return fmt.Errorf("failed to create directory %q: %v", dir, err)
+		}
+	}
+	for _, file := range []string{currentFile, lastKnownGoodFile} {
+		path := s.metaPath(file)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if err := writeFile(path, nil); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fsStore) Exists(uid string) (bool, error) {
+	_, err := os.Stat(s.checkpointPath(uid))
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to determine whether checkpoint %q exists: %v", uid, err)
+}
+
+func (s *fsStore) Save(c Checkpoint) error {
+	data, err := c.Encode()
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint %q: %v", c.UID(), err)
+	}
+	return writeFile(s.checkpointPath(c.UID()), data)
+}
+
+func (s *fsStore) Load(uid string) (Checkpoint, error) {
+	data, err := ioutil.ReadFile(s.checkpointPath(uid))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint %q: %v", uid, err)
+	}
+	c, err := DecodeCheckpoint(data)
+	if err != nil {
+		return nil, err
+	}
+	if c.UID() != uid {
+		return nil, fmt.Errorf("checkpoint stored as %q has UID %q", uid, c.UID())
+	}
+	return c, nil
+}
+
+func (s *fsStore) Current() (*RemoteConfigSource, error) {
+	return s.readSource(currentFile)
+}
+
+func (s *fsStore) CurrentModified() (time.Time, error) {
+	info, err := os.Stat(s.metaPath(currentFile))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
+
+func (s *fsStore) LastKnownGood() (*RemoteConfigSource, error) {
+	return s.readSource(lastKnownGoodFile)
+}
+
+func (s *fsStore) SetCurrentUpdated(source *RemoteConfigSource) (bool, error) {
+	current, err := s.Current()
+	if err != nil {
+		return false, err
+	}
+	if current.Equal(source) {
+		return false, nil
+	}
+	if err := s.writeSource(currentFile, source); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *fsStore) SetLastKnownGood(source *RemoteConfigSource) error {
+	return s.writeSource(lastKnownGoodFile, source)
+}
+
+func (s *fsStore) Reset() (bool, error) {
+	if err := s.SetLastKnownGood(nil); err != nil {
+		return false, err
+	}
+	return s.SetCurrentUpdated(nil)
+}
+
+func (s *fsStore) readSource(file string) (*RemoteConfigSource, error) {
+	data, err := ioutil.ReadFile(s.metaPath(file))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s config source: %v", file, err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	source := &v1.NodeConfigSource{}
+	if err := json.Unmarshal(data, source); err != nil {
+		return nil, fmt.Errorf("failed to decode %s config source: %v", file, err)
+	}
+	return NewRemoteConfigSource(source)
+}
+
+func (s *fsStore) writeSource(file string, source *RemoteConfigSource) error {
+	var data []byte
+	if source != nil {
+		var err error
+		if data, err = json.Marshal(source.NodeConfigSource()); err != nil {
+			return fmt.Errorf("failed to encode %s config source: %v", file, err)
+		}
+	}
+	return writeFile(s.metaPath(file), data)
+}
+
+func (s *fsStore) checkpointPath(uid string) string {
+	return filepath.Join(s.dir, checkpointsDir, uid)
+}
+
+func (s *fsStore) metaPath(file string) string {
+	return filepath.Join(s.dir, metaDir, file)
+}
+
+// writeFile writes data to a temporary file next to path and renames it into place,
+// so a crash never leaves a partially written file behind.
+func writeFile(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for %q: %v", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %q: %v", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %q: %v", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write %q: %v", path, err)
+	}
+	return nil
+}
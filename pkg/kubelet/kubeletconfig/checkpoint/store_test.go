@@ -0,0 +1,134 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+func newTestStore(t *testing.T) (Store, string) {
+	dir, err := ioutil.TempDir("", "kubeletconfig-store")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	store := NewFsStore(dir)
+	if err := store.Initialize(); err != nil {
+		t.Fatalf("failed to initialize store: %v", err)
+	}
+	return store, dir
+}
+
+func newTestSource(t *testing.T, uid string) *RemoteConfigSource {
+	source, err := NewRemoteConfigSource(&v1.NodeConfigSource{ConfigMapRef: &v1.ObjectReference{Namespace: "kube-system", Name: "kubelet-config", UID: types.UID(uid)}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return source
+}
+
+func TestFsStoreCheckpoints(t *testing.T) {
+	store, dir := newTestStore(t)
+	defer os.RemoveAll(dir)
+
+	exists, err := store.Exists("uid")
+	if err != nil || exists {
+		t.Fatalf("expected no checkpoint, got exists=%v err=%v", exists, err)
+	}
+	cp, err := NewConfigMapCheckpoint(newConfigMap("uid", validConfig))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := store.Save(cp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	exists, err = store.Exists("uid")
+	if err != nil || !exists {
+		t.Fatalf("expected checkpoint to exist, got exists=%v err=%v", exists, err)
+	}
+	loaded, err := store.Load("uid")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loaded.UID() != "uid" {
+		t.Errorf("expected UID %q, got %q", "uid", loaded.UID())
+	}
+	if _, err := store.Load("missing"); err == nil {
+		t.Errorf("expected error loading a missing checkpoint")
+	}
+}
+
+func TestFsStoreCurrentAndLastKnownGood(t *testing.T) {
+	store, dir := newTestStore(t)
+	defer os.RemoveAll(dir)
+
+	current, err := store.Current()
+	if err != nil || current != nil {
+		t.Fatalf("expected empty current config source, got %v err=%v", current, err)
+	}
+
+	a := newTestSource(t, "a")
+	updated, err := store.SetCurrentUpdated(a)
+	if err != nil || !updated {
+		t.Fatalf("expected current to be updated, got updated=%v err=%v", updated, err)
+	}
+	updated, err = store.SetCurrentUpdated(newTestSource(t, "a"))
+	if err != nil || updated {
+		t.Fatalf("expected setting the same source not to update current, got updated=%v err=%v", updated, err)
+	}
+	current, err = store.Current()
+	if err != nil || !current.Equal(a) {
+		t.Fatalf("expected current %v, got %v err=%v", a, current, err)
+	}
+
+	if err := store.SetLastKnownGood(a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lastKnownGood, err := store.LastKnownGood()
+	if err != nil || !lastKnownGood.Equal(a) {
+		t.Fatalf("expected last-known-good %v, got %v err=%v", a, lastKnownGood, err)
+	}
+
+	updated, err = store.Reset()
+	if err != nil || !updated {
+		t.Fatalf("expected reset to update current, got updated=%v err=%v", updated, err)
+	}
+	current, err = store.Current()
+	if err != nil || current != nil {
+		t.Errorf("expected empty current config source after reset, got %v err=%v", current, err)
+	}
+	lastKnownGood, err = store.LastKnownGood()
+	if err != nil || lastKnownGood != nil {
+		t.Errorf("expected empty last-known-good config source after reset, got %v err=%v", lastKnownGood, err)
+	}
+
+	// Initialize must not clobber existing state
+	if _, err := store.SetCurrentUpdated(a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := NewFsStore(dir).Initialize(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	current, err = store.Current()
+	if err != nil || !current.Equal(a) {
+		t.Errorf("expected current %v to survive Initialize, got %v err=%v", a, current, err)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletconfig
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubernetes/pkg/kubelet/kubeletconfig/checkpoint"
+)
+
+const (
+	// syncPeriod is how often the config source and the ConfigOK condition are synced.
+	syncPeriod = 10 * time.Second
+	// syncJitter is the jitter factor applied to syncPeriod.
+	syncJitter = 0.2
+)
+
+// StartSync watches the Node object for config source changes and reports the ConfigOK condition.
+// When the config source changes, the new config is downloaded and checkpointed and the Kubelet restarts to use it.
+func (cc *Controller) StartSync(client clientset.Interface, nodeName string) {
+	if client == nil {
+		glog.Infof("no API client, dynamic Kubelet configuration will not sync")
+		return
+	}
+
+	go wait.JitterUntil(func() {
+		cc.configOK.Sync(client, nodeName)
+	}, syncPeriod, syncJitter, true, wait.NeverStop)
+
+	cc.informer = newSharedNodeInformer(client, nodeName, cc.onNodeEvent)
+	go cc.informer.Run(wait.NeverStop)
+
+	go wait.JitterUntil(func() {
+		select {
+		case <-cc.pendingConfigSource:
+			if err := cc.syncConfigSource(client, nodeName); err != nil {
+				glog.Errorf("failed to sync config source: %v", err)
+				cc.configOK.SetFailedSyncReason(err.Error())
+				// retry on the next tick
+				cc.pokeConfigSourceWorker()
+				return
+			}
+			cc.configOK.ClearFailedSyncReason()
+		default:
+		}
+	}, syncPeriod, syncJitter, true, wait.NeverStop)
+}
+
+// syncConfigSource checkpoints the config source assigned to the node and restarts the Kubelet
+// if the current config source changed.
+func (cc *Controller) syncConfigSource(client clientset.Interface, nodeName string) error {
+	node, err := cc.latestNode(nodeName)
+	if err != nil {
+		return err
+	}
+
+	if node.Spec.ConfigSource == nil {
+		updated, err := cc.checkpointStore.Reset()
+		if err != nil {
+			return err
+		}
+		if updated {
+			glog.Infof("config source was removed from node %q, Kubelet will restart to use its local config", nodeName)
+			cc.restart()
+		}
+		return nil
+	}
+
+	source, err := checkpoint.NewRemoteConfigSource(node.Spec.ConfigSource)
+	if err != nil {
+		return err
+	}
+	exists, err := cc.checkpointStore.Exists(source.UID())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		cp, err := source.Download(client)
+		if err != nil {
+			return err
+		}
+		if err := cc.checkpointStore.Save(cp); err != nil {
+			return err
+		}
+	}
+	updated, err := cc.checkpointStore.SetCurrentUpdated(source)
+	if err != nil {
+		return err
+	}
+	if updated {
+		glog.Infof("config source of node %q changed to %s, Kubelet will restart to use the new config", nodeName, source)
+		cc.restart()
+	}
+	return nil
+}
+
+// latestNode returns the Node object from the informer's store.
+func (cc *Controller) latestNode(nodeName string) (*v1.Node, error) {
+	obj, ok, err := cc.informer.GetStore().GetByKey(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("node %q does not exist in the informer's store", nodeName)
+	}
+	node, ok := obj.(*v1.Node)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast object from the informer's store to a Node")
+	}
+	return node, nil
+}
+
+// onNodeEvent signals the config source worker that the config source may have changed.
+func (cc *Controller) onNodeEvent(obj interface{}) {
+	if _, ok := obj.(*v1.Node); !ok {
+		return
+	}
+	cc.pokeConfigSourceWorker()
+}
+
+// pokeConfigSourceWorker signals the config source worker without blocking.
+func (cc *Controller) pokeConfigSourceWorker() {
+	select {
+	case cc.pendingConfigSource <- true:
+	default:
+	}
+}
+
+// newSharedNodeInformer returns an informer that watches the Node with the given name.
+func newSharedNodeInformer(client clientset.Interface, nodeName string, handler func(obj interface{})) cache.SharedInformer {
+	fieldSelector := fields.Set{api.ObjectNameField: nodeName}.AsSelector()
+	lw := cache.NewListWatchFromClient(client.Core().RESTClient(), "nodes", metav1.NamespaceAll, fieldSelector)
+	informer := cache.NewSharedInformer(lw, &v1.Node{}, 0)
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    handler,
+		UpdateFunc: func(_, newObj interface{}) { handler(newObj) },
+	})
+	return informer
+}
+
+// restartForNewConfig exits the Kubelet so that the process manager restarts it into the new config.
+func restartForNewConfig() {
+	glog.Infof("Kubelet is exiting to restart into its new config")
+	os.Exit(0)
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubeletconfig implements dynamic Kubelet configuration: the Kubelet
+// checkpoints the configuration referenced by its Node object, restarts into
+// it, and rolls back to the last-known-good configuration if it is bad.
+package kubeletconfig
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/clock"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/apis/componentconfig"
+	"k8s.io/kubernetes/pkg/kubelet/kubeletconfig/checkpoint"
+	"k8s.io/kubernetes/pkg/kubelet/kubeletconfig/status"
+)
+
+const (
+	badConfigsFile = "bad-configs.json"
+	startupsFile   = "startups.json"
+)
+
+// Controller manages the configuration the Kubelet runs with.
+type Controller struct {
+	// initConfig is the configuration the Kubelet was started with, e.g. from flags.
+	// It is used when no config source is assigned and as the last resort when rolling back.
+	initConfig *componentconfig.KubeletConfiguration
+
+	// checkpointStore persists the downloaded config sources and the current and last-known-good sources.
+	checkpointStore checkpoint.Store
+	// badConfigs persists the configs that were found to be bad.
+	badConfigs *badConfigTracker
+	// startups persists the startup times of the Kubelet, to detect crash-loops.
+	startups *startupTracker
+
+	// configOK reports the outcome of Bootstrap and of the config source sync in the ConfigOK node condition.
+	configOK *status.ConfigOKCondition
+
+	// informer watches the Node object for config source changes.
+	informer cache.SharedInformer
+	// pendingConfigSource is signaled when the config source of the Node may have changed.
+	pendingConfigSource chan bool
+
+	// restart is called to restart the Kubelet into a new config, tests override it.
+	restart func()
+
+	clock clock.Clock
+}
+
+// NewController returns a Controller that checkpoints config under dynamicConfigDir and falls
+// back to initConfig when no config source is assigned to the node.
+func NewController(initConfig *componentconfig.KubeletConfiguration, dynamicConfigDir string) *Controller {
+	return &Controller{
+		initConfig:          initConfig,
+		checkpointStore:     checkpoint.NewFsStore(dynamicConfigDir),
+		badConfigs:          &badConfigTracker{path: filepath.Join(dynamicConfigDir, badConfigsFile)},
+		startups:            &startupTracker{path: filepath.Join(dynamicConfigDir, startupsFile)},
+		configOK:            status.NewConfigOKCondition(),
+		pendingConfigSource: make(chan bool, 1),
+		restart:             restartForNewConfig,
+		clock:               clock.RealClock{},
+	}
+}
+
+// Bootstrap records the startup of the Kubelet and returns the configuration it should run with.
+// That is the current config if it is valid and not crash-looping, otherwise the last-known-good config.
+func (cc *Controller) Bootstrap() (*componentconfig.KubeletConfiguration, error) {
+	if err := cc.checkpointStore.Initialize(); err != nil {
+		return nil, err
+	}
+	now := cc.clock.Now()
+	if err := cc.startups.recordStartup(now); err != nil {
+		return nil, err
+	}
+
+	current, err := cc.checkpointStore.Current()
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		cc.configOK.Set(status.LocalMessage, status.CurrentEmptyReason, v1.ConditionTrue)
+		return cc.initConfig, nil
+	}
+
+	if entry, err := cc.badConfigs.lookup(current.UID()); err != nil {
+		return nil, err
+	} else if entry != nil {
+		glog.Errorf("current config %s was marked bad at %s: %s", current, entry.Time, entry.Reason)
+		return cc.rollback(entry.Reason)
+	}
+
+	kc, err := cc.loadConfig(current)
+	if err != nil {
+		return cc.markBadAndRollback(current, err.Error())
+	}
+
+	modified, err := cc.checkpointStore.CurrentModified()
+	if err != nil {
+		return nil, err
+	}
+	if trialEnd := modified.Add(kc.ConfigTrialDuration.Duration); now.Before(trialEnd) {
+		startups, err := cc.startups.startupsSince(modified)
+		if err != nil {
+			return nil, err
+		}
+		if startups > kc.CrashLoopThreshold {
+			return cc.markBadAndRollback(current, fmt.Sprintf("Kubelet restarted %d times during the trial period", startups))
+		}
+		cc.promoteAfter(current, trialEnd.Sub(now))
+	} else if err := cc.checkpointStore.SetLastKnownGood(current); err != nil {
+		return nil, err
+	}
+
+	cc.configOK.Set(fmt.Sprintf(status.CurrentMessageFmt, current), status.CurrentOKReason, v1.ConditionTrue)
+	return kc, nil
+}
+
+// promoteAfter makes the current config last-known-good once it has run for d, the rest of its trial period.
+func (cc *Controller) promoteAfter(current *checkpoint.RemoteConfigSource, d time.Duration) {
+	go func() {
+		<-cc.clock.After(d)
+		if err := cc.promote(current); err != nil {
+			glog.Errorf("failed to make config %s last-known-good: %v", current, err)
+		}
+	}()
+}
+
+// promote makes the given config last-known-good, unless it is no longer the current config or it was marked bad.
+func (cc *Controller) promote(source *checkpoint.RemoteConfigSource) error {
+	current, err := cc.checkpointStore.Current()
+	if err != nil {
+		return err
+	}
+	if current == nil || current.UID() != source.UID() {
+		return nil
+	}
+	if entry, err := cc.badConfigs.lookup(source.UID()); err != nil || entry != nil {
+		return err
+	}
+	glog.Infof("config %s passed its trial period, making it last-known-good", source)
+	return cc.checkpointStore.SetLastKnownGood(source)
+}
+
+// loadConfig loads, parses and validates the checkpointed config for the given source.
+func (cc *Controller) loadConfig(source *checkpoint.RemoteConfigSource) (*componentconfig.KubeletConfiguration, error) {
+	cp, err := cc.checkpointStore.Load(source.UID())
+	if err != nil {
+		return nil, err
+	}
+	kc, err := cp.Parse()
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateKubeletConfiguration(kc); err != nil {
+		return nil, fmt.Errorf("invalid KubeletConfiguration: %v", err)
+	}
+	return kc, nil
+}
+
+// markBadAndRollback marks the current config bad and rolls back to the last-known-good config.
+func (cc *Controller) markBadAndRollback(current *checkpoint.RemoteConfigSource, reason string) (*componentconfig.KubeletConfiguration, error) {
+	glog.Errorf("marking current config %s bad: %s", current, reason)
+	if err := cc.badConfigs.markBad(current.UID(), reason, cc.clock.Now()); err != nil {
+		return nil, err
+	}
+	return cc.rollback(reason)
+}
+
+// rollback returns the last-known-good config, or the init config if no config source was ever known to be good.
+func (cc *Controller) rollback(reason string) (*componentconfig.KubeletConfiguration, error) {
+	reason = fmt.Sprintf(status.CurrentBadReasonFmt, reason)
+	lastKnownGood, err := cc.checkpointStore.LastKnownGood()
+	if err != nil {
+		return nil, err
+	}
+	if lastKnownGood == nil {
+		cc.configOK.Set(fmt.Sprintf(status.LastKnownGoodMessageFmt, "local config"), reason, v1.ConditionFalse)
+		return cc.initConfig, nil
+	}
+	kc, err := cc.loadConfig(lastKnownGood)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load last-known-good config %s: %v", lastKnownGood, err)
+	}
+	cc.configOK.Set(fmt.Sprintf(status.LastKnownGoodMessageFmt, lastKnownGood), reason, v1.ConditionFalse)
+	return kc, nil
+}
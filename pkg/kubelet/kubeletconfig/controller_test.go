@@ -0,0 +1,263 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/clock"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/apis/componentconfig"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+	"k8s.io/kubernetes/pkg/kubelet/kubeletconfig/checkpoint"
+	"k8s.io/kubernetes/pkg/kubelet/kubeletconfig/status"
+)
+
+func newConfigMap(uid, config string) *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "kubelet-" + uid, UID: types.UID(uid)},
+		Data:       map[string]string{checkpoint.KubeletKey: config},
+	}
+}
+
+func newConfigSource(uid string) *v1.NodeConfigSource {
+	return &v1.NodeConfigSource{ConfigMapRef: &v1.ObjectReference{Namespace: "kube-system", Name: "kubelet-" + uid, UID: types.UID(uid)}}
+}
+
+func kubeletConfig(maxPods int) string {
+	return fmt.Sprintf(`{"kind":"KubeletConfiguration","apiVersion":"componentconfig/v1alpha1","maxPods":%d,"configTrialDuration":"10m","crashLoopThreshold":2}`, maxPods)
+}
+
+type testController struct {
+	*Controller
+	dir      string
+	clock    *clock.FakeClock
+	restarts int
+}
+
+func newTestController(t *testing.T) *testController {
+	dir, err := ioutil.TempDir("", "kubeletconfig")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	tc := &testController{
+		Controller: NewController(&componentconfig.KubeletConfiguration{MaxPods: 1}, dir),
+		dir:        dir,
+		clock:      clock.NewFakeClock(time.Now()),
+	}
+	tc.Controller.clock = tc.clock
+	tc.Controller.restart = func() { tc.restarts++ }
+	return tc
+}
+
+// assign checkpoints the given ConfigMap and makes it the current config, as a sync would.
+func (tc *testController) assign(t *testing.T, cm *v1.ConfigMap) {
+	cp, err := checkpoint.NewConfigMapCheckpoint(cm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tc.checkpointStore.Initialize(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tc.checkpointStore.Save(cp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	source, err := checkpoint.NewRemoteConfigSource(newConfigSource(string(cm.UID)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := tc.checkpointStore.SetCurrentUpdated(source); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func (tc *testController) bootstrap(t *testing.T, expectedMaxPods int32, expectedStatus v1.ConditionStatus) {
+	kc, err := tc.Bootstrap()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if kc.MaxPods != expectedMaxPods {
+		t.Errorf("expected MaxPods %d, got %d", expectedMaxPods, kc.MaxPods)
+	}
+	if condition := tc.configOK.Condition(); condition.Status != expectedStatus {
+		t.Errorf("expected ConfigOK %s, got %#v", expectedStatus, condition)
+	}
+}
+
+func TestBootstrapLocalConfig(t *testing.T) {
+	tc := newTestController(t)
+	defer os.RemoveAll(tc.dir)
+
+	tc.bootstrap(t, 1, v1.ConditionTrue)
+	if condition := tc.configOK.Condition(); condition.Message != status.LocalMessage {
+		t.Errorf("unexpected condition: %#v", condition)
+	}
+}
+
+func TestBootstrapCurrentConfig(t *testing.T) {
+	tc := newTestController(t)
+	defer os.RemoveAll(tc.dir)
+
+	tc.assign(t, newConfigMap("a", kubeletConfig(10)))
+	tc.bootstrap(t, 10, v1.ConditionTrue)
+
+	// the config becomes last-known-good once it survived its trial period
+	lastKnownGood, err := tc.checkpointStore.LastKnownGood()
+	if err != nil || lastKnownGood != nil {
+		t.Fatalf("expected no last-known-good config during the trial period, got %v err=%v", lastKnownGood, err)
+	}
+	tc.clock.Step(time.Hour)
+	tc.bootstrap(t, 10, v1.ConditionTrue)
+	lastKnownGood, err = tc.checkpointStore.LastKnownGood()
+	if err != nil || lastKnownGood == nil || lastKnownGood.UID() != "a" {
+		t.Fatalf("expected last-known-good config a, got %v err=%v", lastKnownGood, err)
+	}
+}
+
+func TestBootstrapPromotesAfterTrial(t *testing.T) {
+	tc := newTestController(t)
+	defer os.RemoveAll(tc.dir)
+
+	tc.assign(t, newConfigMap("a", kubeletConfig(10)))
+	tc.bootstrap(t, 10, v1.ConditionTrue)
+
+	// the running config becomes last-known-good at the end of its trial period, without a restart
+	if err := wait.Poll(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return tc.clock.HasWaiters(), nil
+	}); err != nil {
+		t.Fatalf("expected a timer for the end of the trial period: %v", err)
+	}
+	tc.clock.Step(time.Hour)
+	if err := wait.Poll(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		lastKnownGood, err := tc.checkpointStore.LastKnownGood()
+		return lastKnownGood != nil && lastKnownGood.UID() == "a", err
+	}); err != nil {
+		t.Fatalf("expected last-known-good config a: %v", err)
+	}
+}
+
+func TestBootstrapInvalidConfigRollsBack(t *testing.T) {
+	testCases := map[string]string{
+		"malformed": `{"kind":`,
+		"invalid":   `{"kind":"KubeletConfiguration","apiVersion":"componentconfig/v1alpha1","crashLoopThreshold":-1}`,
+	}
+	for name, config := range testCases {
+		tc := newTestController(t)
+		defer os.RemoveAll(tc.dir)
+
+		// a is good, b is bad
+		tc.assign(t, newConfigMap("a", kubeletConfig(10)))
+		tc.bootstrap(t, 10, v1.ConditionTrue)
+		tc.clock.Step(time.Hour)
+		tc.bootstrap(t, 10, v1.ConditionTrue)
+
+		tc.assign(t, newConfigMap("b", config))
+		tc.bootstrap(t, 10, v1.ConditionFalse)
+		entry, err := tc.badConfigs.lookup("b")
+		if err != nil || entry == nil {
+			t.Errorf("%s: expected b to be marked bad, got %v err=%v", name, entry, err)
+		}
+	}
+}
+
+func TestBootstrapCrashLoopRollsBack(t *testing.T) {
+	tc := newTestController(t)
+	defer os.RemoveAll(tc.dir)
+
+	tc.assign(t, newConfigMap("a", kubeletConfig(10)))
+	// the threshold is 2 restarts during the trial period
+	tc.clock.Step(time.Second)
+	tc.bootstrap(t, 10, v1.ConditionTrue)
+	tc.clock.Step(time.Second)
+	tc.bootstrap(t, 10, v1.ConditionTrue)
+	tc.clock.Step(time.Second)
+	tc.bootstrap(t, 1, v1.ConditionFalse)
+
+	// the config stays bad, even after the trial period
+	tc.clock.Step(time.Hour)
+	tc.bootstrap(t, 1, v1.ConditionFalse)
+}
+
+func TestSyncConfigSource(t *testing.T) {
+	tc := newTestController(t)
+	defer os.RemoveAll(tc.dir)
+	if err := tc.checkpointStore.Initialize(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+	client := fake.NewSimpleClientset(node, newConfigMap("a", kubeletConfig(10)))
+	tc.informer = newSharedNodeInformer(client, "node", tc.onNodeEvent)
+	setNode := func(source *v1.NodeConfigSource) {
+		node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}, Spec: v1.NodeSpec{ConfigSource: source}}
+		if err := tc.informer.GetStore().Update(node); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// no config source, nothing to do
+	setNode(nil)
+	if err := tc.syncConfigSource(client, "node"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tc.restarts != 0 {
+		t.Errorf("expected no restart, got %d", tc.restarts)
+	}
+
+	// a new config source is downloaded, checkpointed and triggers a restart
+	setNode(newConfigSource("a"))
+	if err := tc.syncConfigSource(client, "node"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tc.restarts != 1 {
+		t.Errorf("expected a restart, got %d", tc.restarts)
+	}
+	if exists, err := tc.checkpointStore.Exists("a"); err != nil || !exists {
+		t.Errorf("expected a to be checkpointed, got exists=%v err=%v", exists, err)
+	}
+	if err := tc.syncConfigSource(client, "node"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tc.restarts != 1 {
+		t.Errorf("expected no restart for an unchanged config source, got %d", tc.restarts)
+	}
+
+	// a config source that cannot be downloaded fails the sync
+	setNode(newConfigSource("missing"))
+	if err := tc.syncConfigSource(client, "node"); err == nil {
+		t.Errorf("expected error syncing a missing ConfigMap")
+	}
+	if tc.restarts != 1 {
+		t.Errorf("expected no restart, got %d", tc.restarts)
+	}
+
+	// removing the config source resets the Kubelet to its local config
+	setNode(nil)
+	if err := tc.syncConfigSource(client, "node"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tc.restarts != 2 {
+		t.Errorf("expected a restart, got %d", tc.restarts)
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	nodeutil "k8s.io/kubernetes/pkg/util/node"
+)
+
+const (
+	// ConfigOKType is the type of the node condition that reports the outcome of dynamic Kubelet configuration.
+	ConfigOKType v1.NodeConditionType = "ConfigOK"
+
+	// LocalMessage is reported when the Kubelet uses its local configuration.
+	LocalMessage = "using local config"
+	// CurrentMessageFmt is reported when the Kubelet uses the current config source.
+	CurrentMessageFmt = "using current config: %s"
+	// LastKnownGoodMessageFmt is reported when the Kubelet rolled back to the last-known-good config source.
+	LastKnownGoodMessageFmt = "using last-known-good config: %s"
+
+	// CurrentEmptyReason is reported when no config source is assigned to the node.
+	CurrentEmptyReason = "current config source is empty"
+	// CurrentOKReason is reported when the current config passed all checks.
+	CurrentOKReason = "current config passed all checks"
+	// CurrentBadReasonFmt is reported when the Kubelet rolled back because the current config is bad.
+	CurrentBadReasonFmt = "current config is bad: %s"
+	// SyncFailedReasonFmt is reported when the Kubelet failed to sync the config source assigned to the node.
+	SyncFailedReasonFmt = "failed to sync config source: %s"
+)
+
+// ConfigOKCondition tracks the ConfigOK node condition and reports it to the API server.
+type ConfigOKCondition struct {
+	lock sync.Mutex
+	// condition is the condition computed when the Kubelet bootstrapped its configuration.
+	condition *v1.NodeCondition
+	// failedSyncReason overrides the reason of condition while the config source cannot be synced.
+	failedSyncReason string
+	// pending is true when condition has changes that have not been reported yet.
+	pending bool
+	// now returns the current time, tests override it.
+	now func() time.Time
+}
+
+// NewConfigOKCondition returns a ConfigOKCondition with no condition set.
+func NewConfigOKCondition() *ConfigOKCondition {
+	return &ConfigOKCondition{now: time.Now}
+}
+
+// Set sets the message, reason and status of the condition.
+func (c *ConfigOKCondition) Set(message, reason string, status v1.ConditionStatus) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := metav1.NewTime(c.now())
+	transitionTime := now
+	if c.condition != nil && c.condition.Status == status {
+		transitionTime = c.condition.LastTransitionTime
+	}
+	c.condition = &v1.NodeCondition{
+		Type:               ConfigOKType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: transitionTime,
+	}
+	c.pending = true
+}
+
+// SetFailedSyncReason marks the condition as failed because the config source could not be synced.
+func (c *ConfigOKCondition) SetFailedSyncReason(reason string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.failedSyncReason == reason {
+		return
+	}
+	c.failedSyncReason = reason
+	c.pending = true
+}
+
+// ClearFailedSyncReason clears a failure previously set with SetFailedSyncReason.
+func (c *ConfigOKCondition) ClearFailedSyncReason() {
+	c.SetFailedSyncReason("")
+}
+
+// Condition returns the condition that should be reported, or nil if no condition was set.
+func (c *ConfigOKCondition) Condition() *v1.NodeCondition {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.conditionLocked()
+}
+
+func (c *ConfigOKCondition) conditionLocked() *v1.NodeCondition {
+	if c.condition == nil {
+		return nil
+	}
+	condition := *c.condition
+	if len(c.failedSyncReason) > 0 {
+		condition.Reason = fmt.Sprintf(SyncFailedReasonFmt, c.failedSyncReason)
+		condition.Status = v1.ConditionFalse
+	}
+	return &condition
+}
+
+// Sync reports the condition to the API server if it changed since the last successful report.
+func (c *ConfigOKCondition) Sync(client clientset.Interface, nodeName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.pending {
+		return
+	}
+	condition := c.conditionLocked()
+	if condition == nil {
+		return
+	}
+	if err := nodeutil.SetNodeCondition(client, types.NodeName(nodeName), *condition); err != nil {
+		glog.Errorf("failed to update the %s condition of node %q: %v", ConfigOKType, nodeName, err)
+		return
+	}
+	c.pending = false
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+)
+
+func TestConfigOKConditionSet(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewConfigOKCondition()
+	c.now = func() time.Time { return now }
+
+	if c.Condition() != nil {
+		t.Fatalf("expected no condition before Set")
+	}
+
+	c.Set(LocalMessage, CurrentEmptyReason, v1.ConditionTrue)
+	condition := c.Condition()
+	if condition.Type != ConfigOKType || condition.Status != v1.ConditionTrue || condition.Message != LocalMessage {
+		t.Errorf("unexpected condition: %#v", condition)
+	}
+	if !condition.LastTransitionTime.Equal(metav1.NewTime(now)) {
+		t.Errorf("expected transition time %v, got %v", now, condition.LastTransitionTime)
+	}
+
+	// the transition time only changes with the status
+	later := now.Add(time.Minute)
+	c.now = func() time.Time { return later }
+	c.Set("other message", CurrentOKReason, v1.ConditionTrue)
+	if condition := c.Condition(); !condition.LastTransitionTime.Equal(metav1.NewTime(now)) {
+		t.Errorf("expected transition time %v, got %v", now, condition.LastTransitionTime)
+	}
+	c.Set("other message", "bad", v1.ConditionFalse)
+	if condition := c.Condition(); !condition.LastTransitionTime.Equal(metav1.NewTime(later)) {
+		t.Errorf("expected transition time %v, got %v", later, condition.LastTransitionTime)
+	}
+}
+
+func TestConfigOKConditionFailedSync(t *testing.T) {
+	c := NewConfigOKCondition()
+	c.Set(LocalMessage, CurrentEmptyReason, v1.ConditionTrue)
+
+	c.SetFailedSyncReason("download failed")
+	condition := c.Condition()
+	if condition.Status != v1.ConditionFalse || condition.Reason != "failed to sync config source: download failed" {
+		t.Errorf("unexpected condition: %#v", condition)
+	}
+
+	c.ClearFailedSyncReason()
+	condition = c.Condition()
+	if condition.Status != v1.ConditionTrue || condition.Reason != CurrentEmptyReason {
+		t.Errorf("unexpected condition: %#v", condition)
+	}
+}
+
+func TestConfigOKConditionSync(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}})
+	c := NewConfigOKCondition()
+
+	// nothing to report yet
+	c.Sync(client, "node")
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Fatalf("expected no actions, got %v", actions)
+	}
+
+	c.Set(LocalMessage, CurrentEmptyReason, v1.ConditionTrue)
+	c.Sync(client, "node")
+	actions := client.Actions()
+	if len(actions) != 1 || actions[0].GetVerb() != "patch" || actions[0].GetSubresource() != "status" {
+		t.Fatalf("expected a single status patch, got %v", actions)
+	}
+
+	// the condition is only reported again when it changes
+	client.ClearActions()
+	c.Sync(client, "node")
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("expected no actions, got %v", actions)
+	}
+	c.SetFailedSyncReason("download failed")
+	c.Sync(client, "node")
+	if actions := client.Actions(); len(actions) != 1 {
+		t.Errorf("expected a single status patch, got %v", actions)
+	}
+}
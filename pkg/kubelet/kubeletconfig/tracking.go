@@ -0,0 +1,140 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// maxRecordedStartups bounds the number of startup timestamps kept on disk.
+const maxRecordedStartups = 1000
+
+// badConfigEntry records when and why a config was marked bad.
+type badConfigEntry struct {
+	Time   time.Time `json:"time"`
+	Reason string    `json:"reason"`
+}
+
+// badConfigTracker persists the UIDs of the configs that were marked bad.
+type badConfigTracker struct {
+	path string
+}
+
+// lookup returns the entry for the config with the given UID, or nil if the config was never marked bad.
+func (t *badConfigTracker) lookup(uid string) (*badConfigEntry, error) {
+	entries, err := t.load()
+	if err != nil {
+		return nil, err
+	}
+	if entry, ok := entries[uid]; ok {
+		return &entry, nil
+	}
+	return nil, nil
+}
+
+// markBad records that the config with the given UID is bad for the given reason.
+func (t *badConfigTracker) markBad(uid, reason string, now time.Time) error {
+	entries, err := t.load()
+	if err != nil {
+		return err
+	}
+	entries[uid] = badConfigEntry{Time: now, Reason: reason}
+	return saveJSON(t.path, entries)
+}
+
+func (t *badConfigTracker) load() (map[string]badConfigEntry, error) {
+	entries := map[string]badConfigEntry{}
+	if err := loadJSON(t.path, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// startupTracker persists the times at which the Kubelet started, to detect crash-loops.
+type startupTracker struct {
+	path string
+}
+
+// recordStartup records a startup at the given time.
+func (t *startupTracker) recordStartup(now time.Time) error {
+	startups, err := t.load()
+	if err != nil {
+		return err
+	}
+	startups = append(startups, now)
+	if len(startups) > maxRecordedStartups {
+		startups = startups[len(startups)-maxRecordedStartups:]
+	}
+	return saveJSON(t.path, startups)
+}
+
+// startupsSince returns the number of startups recorded after the given time.
+func (t *startupTracker) startupsSince(since time.Time) (int32, error) {
+	startups, err := t.load()
+	if err != nil {
+		return 0, err
+	}
+	var count int32
+	for _, startup := range startups {
+		if startup.After(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (t *startupTracker) load() ([]time.Time, error) {
+	startups := []time.Time{}
+	if err := loadJSON(t.path, &startups); err != nil {
+		return nil, err
+	}
+	return startups, nil
+}
+
+// loadJSON decodes the file at path into obj. A missing or empty file leaves obj untouched.
+func loadJSON(path string, obj interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %v", path, err)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("failed to decode %q: %v", path, err)
+	}
+	return nil
+}
+
+// saveJSON encodes obj and writes it to the file at path, replacing its contents.
+func saveJSON(path string, obj interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to encode %q: %v", path, err)
+	}
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write %q: %v", path, err)
+	}
+	return nil
+}
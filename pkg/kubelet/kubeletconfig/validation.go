@@ -0,0 +1,54 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletconfig
+
+import (
+	"fmt"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/apis/componentconfig"
+	"k8s.io/kubernetes/pkg/kubelet/cm"
+)
+
+// ValidateKubeletConfiguration validates the KubeletConfiguration and returns an error if it is invalid.
+func ValidateKubeletConfiguration(kc *componentconfig.KubeletConfiguration) error {
+	allErrors := []error{}
+
+	if !kc.CgroupsPerQOS && len(kc.EnforceNodeAllocatable) > 0 {
+		allErrors = append(allErrors, fmt.Errorf("Node Allocatable enforcement is not supported unless Cgroups Per QOS feature is turned on"))
+	}
+	if kc.SystemCgroups != "" && kc.CgroupRoot == "" {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: system container was specified and cgroup root was not specified"))
+	}
+	for _, val := range kc.EnforceNodeAllocatable {
+		switch val {
+		case cm.NodeAllocatableEnforcementKey:
+		case cm.SystemReservedEnforcementKey:
+		case cm.KubeReservedEnforcementKey:
+			continue
+		default:
+			allErrors = append(allErrors, fmt.Errorf("invalid option %q specified for EnforceNodeAllocatable setting. Valid options are %q, %q or %q", val, cm.NodeAllocatableEnforcementKey, cm.SystemReservedEnforcementKey, cm.KubeReservedEnforcementKey))
+		}
+	}
+	if kc.ConfigTrialDuration.Duration < 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: ConfigTrialDuration must not be negative"))
+	}
+	if kc.CrashLoopThreshold < 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: CrashLoopThreshold must not be negative"))
+	}
+	return utilerrors.NewAggregate(allErrors)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeletconfig
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/apis/componentconfig"
+)
+
+func TestValidateKubeletConfiguration(t *testing.T) {
+	successCases := map[string]componentconfig.KubeletConfiguration{
+		"empty": {},
+		"node allocatable enforcement": {
+			CgroupsPerQOS:          true,
+			EnforceNodeAllocatable: []string{"pods", "system-reserved", "kube-reserved"},
+		},
+	}
+	for name, kc := range successCases {
+		if err := ValidateKubeletConfiguration(&kc); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+
+	errorCases := map[string]componentconfig.KubeletConfiguration{
+		"enforcement without cgroups per qos": {EnforceNodeAllocatable: []string{"pods"}},
+		"unknown enforcement": {
+			CgroupsPerQOS:          true,
+			EnforceNodeAllocatable: []string{"foo"},
+		},
+		"system cgroups without cgroup root": {SystemCgroups: "/system"},
+		"negative crash loop threshold":      {CrashLoopThreshold: -1},
+	}
+	for name, kc := range errorCases {
+		if err := ValidateKubeletConfiguration(&kc); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -56,6 +56,20 @@ func TestListImages(t *testing.T) {
 	assert.Equal(t, expected.List(), actual.List())
 }
 
+func TestListImagesLabels(t *testing.T) {
+	_, fakeImageService, fakeManager, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+
+	labels := map[string]string{"app": "web"}
+	fakeImageService.SetFakeImages([]string{"1111"})
+	fakeImageService.Images["1111"].Labels = labels
+
+	actualImages, err := fakeManager.ListImages()
+	assert.NoError(t, err)
+	assert.Len(t, actualImages, 1)
+	assert.Equal(t, labels, actualImages[0].Labels)
+}
+
 func TestGetImageRef(t *testing.T) {
 	_, fakeImageService, fakeManager, err := createTestRuntimeManager()
 	assert.NoError(t, err)
@@ -93,6 +93,8 @@ func setTestProbe(pod *v1.Pod, probeType probeType, probeSpec v1.Probe) {
 		pod.Spec.Containers[0].ReadinessProbe = &probeSpec
 	case liveness:
 		pod.Spec.Containers[0].LivenessProbe = &probeSpec
+	case startup:
+		pod.Spec.Containers[0].StartupProbe = &probeSpec
 	}
 }
 
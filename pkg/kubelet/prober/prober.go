@@ -35,6 +35,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/util/format"
 	"k8s.io/kubernetes/pkg/probe"
 	execprobe "k8s.io/kubernetes/pkg/probe/exec"
+	grpcprobe "k8s.io/kubernetes/pkg/probe/grpc"
 	httprobe "k8s.io/kubernetes/pkg/probe/http"
 	tcprobe "k8s.io/kubernetes/pkg/probe/tcp"
 	"k8s.io/kubernetes/pkg/util/exec"
@@ -44,11 +45,12 @@ import (
 
 const maxProbeRetries = 3
 
-// Prober helps to check the liveness/readiness of a container.
+// Prober helps to check the liveness/readiness/startup of a container.
 type prober struct {
 	exec   execprobe.ExecProber
 	http   httprobe.HTTPProber
 	tcp    tcprobe.TCPProber
+	grpc   grpcprobe.GRPCProber
 	runner kubecontainer.ContainerCommandRunner
 
 	refManager *kubecontainer.RefManager
@@ -66,6 +68,7 @@ func newProber(
 		exec:       execprobe.New(),
 		http:       httprobe.New(),
 		tcp:        tcprobe.New(),
+		grpc:       grpcprobe.New(),
 		runner:     runner,
 		refManager: refManager,
 		recorder:   recorder,
@@ -80,6 +83,8 @@ func (pb *prober) probe(probeType probeType, pod *v1.Pod, status v1.PodStatus, c
 		probeSpec = container.ReadinessProbe
 	case liveness:
 		probeSpec = container.LivenessProbe
+	case startup:
+		probeSpec = container.StartupProbe
 	default:
 		return results.Failure, fmt.Errorf("Unknown probe type: %q", probeType)
 	}
@@ -171,6 +176,14 @@ func (pb *prober) runProbe(p *v1.Probe, pod *v1.Pod, status v1.PodStatus, contai
 		glog.V(4).Infof("TCP-Probe PodIP: %v, Port: %v, Timeout: %v", status.PodIP, port, timeout)
 		return pb.tcp.Probe(status.PodIP, port, timeout)
 	}
+	if p.GRPC != nil {
+		service := ""
+		if p.GRPC.Service != nil {
+			service = *p.GRPC.Service
+		}
+		glog.V(4).Infof("GRPC-Probe PodIP: %v, Port: %v, Service: %v, Timeout: %v", status.PodIP, p.GRPC.Port, service, timeout)
+		return pb.grpc.Probe(status.PodIP, service, int(p.GRPC.Port), timeout)
+	}
 	glog.Warningf("Failed to find probe builder for container: %v", container)
 	return probe.Unknown, "", fmt.Errorf("Missing probe handler for %s:%s", format.Pod(pod), container.Name)
 }
@@ -233,6 +246,10 @@ func (pb *prober) newExecInContainer(container v1.Container, containerID kubecon
 	}}
 }
 
+func (eic execInContainer) Run() error {
+	return fmt.Errorf("unimplemented")
+}
+
 func (eic execInContainer) CombinedOutput() ([]byte, error) {
 	return eic.run()
 }
@@ -253,6 +270,10 @@ func (eic execInContainer) SetStdout(out io.Writer) {
 	//unimplemented
 }
 
+func (eic execInContainer) SetStderr(out io.Writer) {
+	//unimplemented
+}
+
 func (eic execInContainer) Stop() {
 	//unimplemented
 }
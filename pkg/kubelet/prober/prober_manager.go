@@ -49,8 +49,8 @@ type Manager interface {
 	// It takes a list of "active pods" which should not be cleaned up.
 	CleanupPods(activePods []*v1.Pod)
 
-	// UpdatePodStatus modifies the given PodStatus with the appropriate Ready state for each
-	// container based on container running status, cached probe results and worker states.
+	// UpdatePodStatus modifies the given PodStatus with the appropriate Ready and Started state for
+	// each container based on container running status, cached probe results and worker states.
 	UpdatePodStatus(types.UID, *v1.PodStatus)
 
 	// Start starts the Manager sync loops.
@@ -72,6 +72,9 @@ type manager struct {
 	// livenessManager manages the results of liveness probes
 	livenessManager results.Manager
 
+	// startupManager manages the results of startup probes
+	startupManager results.Manager
+
 	// prober executes the probe actions.
 	prober *prober
 }
@@ -85,11 +88,13 @@ func NewManager(
 
 	prober := newProber(runner, refManager, recorder)
 	readinessManager := results.NewManager()
+	startupManager := results.NewManager()
 	return &manager{
 		statusManager:    statusManager,
 		prober:           prober,
 		readinessManager: readinessManager,
 		livenessManager:  livenessManager,
+		startupManager:   startupManager,
 		workers:          make(map[probeKey]*worker),
 	}
 }
@@ -98,6 +103,8 @@ func NewManager(
 func (m *manager) Start() {
 	// Start syncing readiness.
 	go wait.Forever(m.updateReadiness, 0)
+	// Start syncing startup.
+	go wait.Forever(m.updateStartup, 0)
 }
 
 // Key uniquely identifying container probes
@@ -107,12 +114,13 @@ type probeKey struct {
 	probeType     probeType
 }
 
-// Type of probe (readiness or liveness)
+// Type of probe (liveness, readiness or startup)
 type probeType int
 
 const (
 	liveness probeType = iota
 	readiness
+	startup
 )
 
 // For debugging.
@@ -122,6 +130,8 @@ func (t probeType) String() string {
 		return "Readiness"
 	case liveness:
 		return "Liveness"
+	case startup:
+		return "Startup"
 	default:
 		return "UNKNOWN"
 	}
@@ -158,6 +168,18 @@ func (m *manager) AddPod(pod *v1.Pod) {
 			m.workers[key] = w
 			go w.run()
 		}
+
+		if c.StartupProbe != nil {
+			key.probeType = startup
+			if _, ok := m.workers[key]; ok {
+				glog.Errorf("Startup probe already exists! %v - %v",
+					format.Pod(pod), c.Name)
+				return
+			}
+			w := newWorker(m, startup, pod, c)
+			m.workers[key] = w
+			go w.run()
+		}
 	}
 }
 
@@ -168,7 +190,7 @@ func (m *manager) RemovePod(pod *v1.Pod) {
 	key := probeKey{podUID: pod.UID}
 	for _, c := range pod.Spec.Containers {
 		key.containerName = c.Name
-		for _, probeType := range [...]probeType{readiness, liveness} {
+		for _, probeType := range [...]probeType{readiness, liveness, startup} {
 			key.probeType = probeType
 			if worker, ok := m.workers[key]; ok {
 				worker.stop()
@@ -195,6 +217,23 @@ func (m *manager) CleanupPods(activePods []*v1.Pod) {
 
 func (m *manager) UpdatePodStatus(podUID types.UID, podStatus *v1.PodStatus) {
 	for i, c := range podStatus.ContainerStatuses {
+		var started bool
+		if c.State.Running == nil {
+			started = false
+		} else if result, ok := m.startupManager.Get(kubecontainer.ParseContainerID(c.ContainerID)); ok {
+			started = result == results.Success
+		} else {
+			// The check whether there is a probe which hasn't run yet.
+			_, exists := m.getWorker(podUID, c.Name, startup)
+			started = !exists
+		}
+		podStatus.ContainerStatuses[i].Started = &started
+
+		if !started {
+			podStatus.ContainerStatuses[i].Ready = false
+			continue
+		}
+
 		var ready bool
 		if c.State.Running == nil {
 			ready = false
@@ -245,3 +284,10 @@ func (m *manager) updateReadiness() {
 	ready := update.Result == results.Success
 	m.statusManager.SetContainerReadiness(update.PodUID, update.ContainerID, ready)
 }
+
+func (m *manager) updateStartup() {
+	update := <-m.startupManager.Updates()
+
+	started := update.Result == results.Success
+	m.statusManager.SetContainerStartup(update.PodUID, update.ContainerID, started)
+}
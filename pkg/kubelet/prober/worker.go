@@ -90,6 +90,10 @@ func newWorker(
 		w.spec = container.LivenessProbe
 		w.resultsManager = m.livenessManager
 		w.initialValue = results.Success
+	case startup:
+		w.spec = container.StartupProbe
+		w.resultsManager = m.startupManager
+		w.initialValue = results.Failure
 	}
 
 	return w
@@ -104,7 +108,7 @@ func (w *worker) run() {
 		// Clean up.
 		probeTicker.Stop()
 		if !w.containerID.IsEmpty() {
-			w.resultsManager.Remove(w.containerID)
+			w.removeResults()
 		}
 
 		w.probeManager.removeWorker(w.pod.UID, w.container.Name, w.probeType)
@@ -135,6 +139,15 @@ func (w *worker) stop() {
 	}
 }
 
+// removeResults clears the results recorded by this worker for the last known container.
+func (w *worker) removeResults() {
+	w.resultsManager.Remove(w.containerID)
+	if w.probeType == startup {
+		// A failed startup probe is also recorded as a liveness result.
+		w.probeManager.livenessManager.Remove(w.containerID)
+	}
+}
+
 // doProbe probes the container once and records the result.
 // Returns whether the worker should continue.
 func (w *worker) doProbe() (keepGoing bool) {
@@ -165,7 +178,7 @@ func (w *worker) doProbe() (keepGoing bool) {
 
 	if w.containerID.String() != c.ContainerID {
 		if !w.containerID.IsEmpty() {
-			w.resultsManager.Remove(w.containerID)
+			w.removeResults()
 		}
 		w.containerID = kubecontainer.ParseContainerID(c.ContainerID)
 		w.resultsManager.Set(w.containerID, w.initialValue, w.pod)
@@ -193,6 +206,16 @@ func (w *worker) doProbe() (keepGoing bool) {
 		return true
 	}
 
+	started := c.Started != nil && *c.Started
+	if w.probeType == startup && started {
+		// Stop probing for startup once the container has started.
+		return true
+	}
+	if w.probeType != startup && w.container.StartupProbe != nil && !started {
+		// Hold back other probes until the container has started.
+		return true
+	}
+
 	// TODO: in order for exec probes to correctly handle downward API env, we must be able to reconstruct
 	// the full container environment here, OR we must make a call to the CRI in order to get those environment
 	// values from the running container.
@@ -217,13 +240,19 @@ func (w *worker) doProbe() (keepGoing bool) {
 
 	w.resultsManager.Set(w.containerID, result, w.pod)
 
-	if w.probeType == liveness && result == results.Failure {
-		// The container fails a liveness check, it will need to be restarted.
+	if (w.probeType == liveness || w.probeType == startup) && result == results.Failure {
+		// The container fails a liveness/startup check, it will need to be restarted.
 		// Stop probing until we see a new container ID. This is to reduce the
 		// chance of hitting #21751, where running `docker exec` when a
 		// container is being stopped may lead to corrupted container state.
 		w.onHold = true
 	}
 
+	if w.probeType == startup && result == results.Failure {
+		// The runtimes restart containers based on liveness results, so a
+		// container that fails to start up is reported as not alive.
+		w.probeManager.livenessManager.Set(w.containerID, results.Failure, w.pod)
+	}
+
 	return true
 }
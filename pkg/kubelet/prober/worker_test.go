@@ -297,6 +297,8 @@ func resultsManager(m *manager, probeType probeType) results.Manager {
 		return m.readinessManager
 	case liveness:
 		return m.livenessManager
+	case startup:
+		return m.startupManager
 	}
 	panic(fmt.Errorf("Unhandled case: %v", probeType))
 }
@@ -341,3 +343,73 @@ func TestOnHoldOnLivenessCheckFailure(t *testing.T) {
 		t.Errorf("Prober should not be on hold anymore")
 	}
 }
+
+func TestOnHoldOnStartupCheckFailure(t *testing.T) {
+	m := newTestManager()
+	w := newTestWorker(m, startup, v1.Probe{SuccessThreshold: 1, FailureThreshold: 2})
+	status := getTestRunningStatus()
+	m.statusManager.SetPodStatus(w.pod, getTestRunningStatus())
+
+	// The first failure is below the threshold.
+	m.prober.exec = fakeExecProber{probe.Failure, nil}
+	msg := "first probe"
+	expectContinue(t, w, w.doProbe(), msg)
+	expectResult(t, w, results.Failure, msg)
+	if w.onHold {
+		t.Errorf("Prober should not be on hold below the failure threshold")
+	}
+	if _, ok := m.livenessManager.Get(w.containerID); ok {
+		t.Errorf("Expected no liveness result below the failure threshold")
+	}
+
+	// The second failure reports the container as not alive so it gets restarted.
+	msg = "second probe"
+	expectContinue(t, w, w.doProbe(), msg)
+	expectResult(t, w, results.Failure, msg)
+	if !w.onHold {
+		t.Errorf("Prober should be on hold due to startup check failure")
+	}
+	if result, ok := m.livenessManager.Get(w.containerID); !ok || result != results.Failure {
+		t.Errorf("Expected liveness failure after startup check failure, got %v (found: %v)", result, ok)
+	}
+
+	// Set a new container ID to lift the hold. The next probe will succeed.
+	oldContainerID := w.containerID
+	status.ContainerStatuses[0].ContainerID = "test://newCont_ID"
+	m.statusManager.SetPodStatus(w.pod, status)
+	m.prober.exec = fakeExecProber{probe.Success, nil}
+	msg = "hold lifted"
+	expectContinue(t, w, w.doProbe(), msg)
+	expectResult(t, w, results.Success, msg)
+	if w.onHold {
+		t.Errorf("Prober should not be on hold anymore")
+	}
+	if _, ok := m.livenessManager.Get(oldContainerID); ok {
+		t.Errorf("Expected liveness result of the old container to be removed")
+	}
+}
+
+func TestStartupProbeHoldsBackOtherProbes(t *testing.T) {
+	m := newTestManager()
+	m.prober.exec = fakeExecProber{probe.Failure, nil}
+	for _, probeType := range [...]probeType{liveness, readiness} {
+		w := newTestWorker(m, probeType, v1.Probe{})
+		setTestProbe(w.pod, startup, v1.Probe{})
+		w.container = w.pod.Spec.Containers[0]
+
+		// Not started yet: the probe is not run and the initial value is kept.
+		status := getTestRunningStatus()
+		m.statusManager.SetPodStatus(w.pod, status)
+		msg := "not started"
+		expectContinue(t, w, w.doProbe(), msg)
+		expectResult(t, w, w.initialValue, msg)
+
+		// Started: the probe runs.
+		started := true
+		status.ContainerStatuses[0].Started = &started
+		m.statusManager.SetPodStatus(w.pod, status)
+		msg = "started"
+		expectContinue(t, w, w.doProbe(), msg)
+		expectResult(t, w, results.Failure, msg)
+	}
+}
@@ -51,6 +51,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/v1/validation"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/kubelet/images"
 	"k8s.io/kubernetes/pkg/kubelet/server/portforward"
 	"k8s.io/kubernetes/pkg/kubelet/server/remotecommand"
 	"k8s.io/kubernetes/pkg/kubelet/server/stats"
@@ -166,6 +167,7 @@ type HostInterface interface {
 	GetCachedMachineInfo() (*cadvisorapi.MachineInfo, error)
 	GetPods() []*v1.Pod
 	GetRunningPods() ([]*v1.Pod, error)
+	GetImageGCReport() (*images.ImageGCReport, error)
 	GetPodByName(namespace, name string) (*v1.Pod, bool)
 	RunInContainer(name string, uid types.UID, container string, cmd []string) ([]byte, error)
 	ExecInContainer(name string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool, resize <-chan term.Size, timeout time.Duration) error
@@ -403,6 +405,18 @@ func (s *Server) InstallDebuggingHandlers(criHandler http.Handler) {
 		Operation("getRunningPods"))
 	s.restfulCont.Add(ws)
 
+	// The /imagegc endpoint reports what image garbage collection would
+	// remove without removing anything.
+	ws = new(restful.WebService)
+	ws.
+		Path("/imagegc/").
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("").
+		To(s.getImageGCReport).
+		Operation("getImageGCReport").
+		Writes(images.ImageGCReport{}))
+	s.restfulCont.Add(ws)
+
 	if criHandler != nil {
 		s.restfulCont.Handle("/cri/", criHandler)
 	}
@@ -554,6 +568,16 @@ func (s *Server) getRunningPods(request *restful.Request, response *restful.Resp
 	writeJsonResponse(response, data)
 }
 
+// getImageGCReport returns a dry-run report of image garbage collection.
+func (s *Server) getImageGCReport(request *restful.Request, response *restful.Response) {
+	report, err := s.host.GetImageGCReport()
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	response.WriteEntity(report)
+}
+
 // getLogs handles logs requests against the Kubelet.
 func (s *Server) getLogs(request *restful.Request, response *restful.Response) {
 	s.host.ServeLogs(response, request.Request)
@@ -46,12 +46,14 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/client-go/tools/record"
 	utiltesting "k8s.io/client-go/util/testing"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	kubecontainertesting "k8s.io/kubernetes/pkg/kubelet/container/testing"
+	"k8s.io/kubernetes/pkg/kubelet/images"
 	"k8s.io/kubernetes/pkg/kubelet/server/portforward"
 	"k8s.io/kubernetes/pkg/kubelet/server/remotecommand"
 	"k8s.io/kubernetes/pkg/kubelet/server/stats"
@@ -70,6 +72,7 @@ type fakeKubelet struct {
 	machineInfoFunc                    func() (*cadvisorapi.MachineInfo, error)
 	podsFunc                           func() []*v1.Pod
 	runningPodsFunc                    func() ([]*v1.Pod, error)
+	imageGCReportFunc                  func() (*images.ImageGCReport, error)
 	logFunc                            func(w http.ResponseWriter, req *http.Request)
 	runFunc                            func(podFullName string, uid types.UID, containerName string, cmd []string) ([]byte, error)
 	execFunc                           func(pod string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool) error
@@ -116,6 +119,10 @@ func (fk *fakeKubelet) GetRunningPods() ([]*v1.Pod, error) {
 	return fk.runningPodsFunc()
 }
 
+func (fk *fakeKubelet) GetImageGCReport() (*images.ImageGCReport, error) {
+	return fk.imageGCReportFunc()
+}
+
 func (fk *fakeKubelet) ServeLogs(w http.ResponseWriter, req *http.Request) {
 	fk.logFunc(w, req)
 }
@@ -237,7 +244,7 @@ func newServerTest() *serverTestFramework {
 	}
 	server := NewServer(
 		fw.fakeKubelet,
-		stats.NewResourceAnalyzer(fw.fakeKubelet, time.Minute, &kubecontainertesting.FakeRuntime{}),
+		stats.NewResourceAnalyzer(fw.fakeKubelet, time.Minute, &kubecontainertesting.FakeRuntime{}, &record.FakeRecorder{}),
 		fw.fakeAuth,
 		true,
 		&kubecontainertesting.Mock{},
@@ -478,6 +485,35 @@ func TestServeLogs(t *testing.T) {
 	}
 }
 
+func TestImageGCReport(t *testing.T) {
+	fw := newServerTest()
+	defer fw.testHTTPServer.Close()
+	expectedReport := &images.ImageGCReport{
+		UsagePercent: 95,
+		BytesToFree:  150,
+		BytesFreed:   200,
+		Images: []images.ImageGCReportEntry{
+			{ID: "image-0", Size: 200, Remove: true, Reason: "DiskUsage"},
+		},
+	}
+	fw.fakeKubelet.imageGCReportFunc = func() (*images.ImageGCReport, error) {
+		return expectedReport, nil
+	}
+
+	resp, err := http.Get(fw.testHTTPServer.URL + "/imagegc/")
+	if err != nil {
+		t.Fatalf("Got error GETing: %v", err)
+	}
+	defer resp.Body.Close()
+	var receivedReport images.ImageGCReport
+	if err := json.NewDecoder(resp.Body).Decode(&receivedReport); err != nil {
+		t.Fatalf("received invalid json data: %v", err)
+	}
+	if !reflect.DeepEqual(&receivedReport, expectedReport) {
+		t.Errorf("received wrong data: %#v", receivedReport)
+	}
+}
+
 func TestServeRunInContainer(t *testing.T) {
 	fw := newServerTest()
 	defer fw.testHTTPServer.Close()
@@ -650,6 +686,7 @@ func TestAuthFilters(t *testing.T) {
 			isSubpath(path, "/debug"),
 			isSubpath(path, "/exec"),
 			isSubpath(path, "/healthz"),
+			isSubpath(path, "/imagegc"),
 			isSubpath(path, "/pods"),
 			isSubpath(path, "/portForward"),
 			isSubpath(path, "/run"),
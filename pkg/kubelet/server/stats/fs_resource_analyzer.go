@@ -23,6 +23,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/golang/glog"
 )
@@ -40,6 +41,7 @@ type fsResourceAnalyzerInterface interface {
 type fsResourceAnalyzer struct {
 	statsProvider     StatsProvider
 	calcPeriod        time.Duration
+	eventRecorder     record.EventRecorder
 	cachedVolumeStats atomic.Value
 	startOnce         sync.Once
 }
@@ -47,10 +49,11 @@ type fsResourceAnalyzer struct {
 var _ fsResourceAnalyzerInterface = &fsResourceAnalyzer{}
 
 // newFsResourceAnalyzer returns a new fsResourceAnalyzer implementation
-func newFsResourceAnalyzer(statsProvider StatsProvider, calcVolumePeriod time.Duration) *fsResourceAnalyzer {
+func newFsResourceAnalyzer(statsProvider StatsProvider, calcVolumePeriod time.Duration, eventRecorder record.EventRecorder) *fsResourceAnalyzer {
 	r := &fsResourceAnalyzer{
 		statsProvider: statsProvider,
 		calcPeriod:    calcVolumePeriod,
+		eventRecorder: eventRecorder,
 	}
 	r.cachedVolumeStats.Store(make(Cache))
 	return r
@@ -76,7 +79,7 @@ func (s *fsResourceAnalyzer) updateCachedPodVolumeStats() {
 	// Copy existing entries to new map, creating/starting new entries for pods missing from the cache
 	for _, pod := range s.statsProvider.GetPods() {
 		if value, found := oldCache[pod.GetUID()]; !found {
-			newCache[pod.GetUID()] = newVolumeStatCalculator(s.statsProvider, s.calcPeriod, pod).StartOnce()
+			newCache[pod.GetUID()] = newVolumeStatCalculator(s.statsProvider, s.calcPeriod, pod, s.eventRecorder).StartOnce()
 		} else {
 			newCache[pod.GetUID()] = value
 		}
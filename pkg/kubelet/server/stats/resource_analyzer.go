@@ -19,6 +19,7 @@ package stats
 import (
 	"time"
 
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubernetes/pkg/kubelet/container"
 )
 
@@ -39,8 +40,8 @@ type resourceAnalyzer struct {
 var _ ResourceAnalyzer = &resourceAnalyzer{}
 
 // NewResourceAnalyzer returns a new ResourceAnalyzer
-func NewResourceAnalyzer(statsProvider StatsProvider, calVolumeFrequency time.Duration, runtime container.Runtime) ResourceAnalyzer {
-	fsAnalyzer := newFsResourceAnalyzer(statsProvider, calVolumeFrequency)
+func NewResourceAnalyzer(statsProvider StatsProvider, calVolumeFrequency time.Duration, runtime container.Runtime, eventRecorder record.EventRecorder) ResourceAnalyzer {
+	fsAnalyzer := newFsResourceAnalyzer(statsProvider, calVolumeFrequency, eventRecorder)
 	summaryProvider := NewSummaryProvider(statsProvider, fsAnalyzer, runtime)
 	return &resourceAnalyzer{fsAnalyzer, summaryProvider}
 }
@@ -22,10 +22,13 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
+	"k8s.io/kubernetes/pkg/kubelet/events"
 	"k8s.io/kubernetes/pkg/kubelet/util/format"
 	"k8s.io/kubernetes/pkg/volume"
+	volumeutil "k8s.io/kubernetes/pkg/volume/util"
 
 	"github.com/golang/glog"
 )
@@ -35,10 +38,14 @@ type volumeStatCalculator struct {
 	statsProvider StatsProvider
 	jitterPeriod  time.Duration
 	pod           *v1.Pod
+	eventRecorder record.EventRecorder
 	stopChannel   chan struct{}
 	startO        sync.Once
 	stopO         sync.Once
 	latest        atomic.Value
+	// abnormalVolumes holds the names of the volumes last seen abnormal, so
+	// that an event is only recorded when a volume becomes abnormal.
+	abnormalVolumes map[string]bool
 }
 
 // PodVolumeStats encapsulates all VolumeStats for a pod
@@ -47,12 +54,14 @@ type PodVolumeStats struct {
 }
 
 // newVolumeStatCalculator creates a new VolumeStatCalculator
-func newVolumeStatCalculator(statsProvider StatsProvider, jitterPeriod time.Duration, pod *v1.Pod) *volumeStatCalculator {
+func newVolumeStatCalculator(statsProvider StatsProvider, jitterPeriod time.Duration, pod *v1.Pod, eventRecorder record.EventRecorder) *volumeStatCalculator {
 	return &volumeStatCalculator{
-		statsProvider: statsProvider,
-		jitterPeriod:  jitterPeriod,
-		pod:           pod,
-		stopChannel:   make(chan struct{}),
+		statsProvider:   statsProvider,
+		jitterPeriod:    jitterPeriod,
+		pod:             pod,
+		eventRecorder:   eventRecorder,
+		stopChannel:     make(chan struct{}),
+		abnormalVolumes: make(map[string]bool),
 	}
 }
 
@@ -92,10 +101,19 @@ func (s *volumeStatCalculator) calcAndStoreStats() {
 		return
 	}
 
+	// Get volume specs for the pod - key'd by volume name
+	volumesSpec := make(map[string]v1.Volume)
+	for _, v := range s.pod.Spec.Volumes {
+		volumesSpec[v.Name] = v
+	}
+
 	// Call GetMetrics on each Volume and copy the result to a new VolumeStats.FsStats
-	stats := make([]stats.VolumeStats, 0, len(volumes))
+	volumesStats := make([]stats.VolumeStats, 0, len(volumes))
 	for name, v := range volumes {
 		metric, err := v.GetMetrics()
+		if metric != nil {
+			s.checkVolumeCondition(name, v, metric)
+		}
 		if err != nil {
 			// Expected for Volumes that don't support Metrics
 			if !volume.IsNotSupported(err) {
@@ -103,15 +121,25 @@ func (s *volumeStatCalculator) calcAndStoreStats() {
 			}
 			continue
 		}
-		stats = append(stats, s.parsePodVolumeStats(name, metric))
+		// Lookup the volume spec and add a 'PVCReference' for volumes that reference a PVC
+		volSpec := volumesSpec[name]
+		var pvcRef *stats.PVCReference
+		if pvcSource := volSpec.PersistentVolumeClaim; pvcSource != nil {
+			pvcRef = &stats.PVCReference{
+				Name:      pvcSource.ClaimName,
+				Namespace: s.pod.GetNamespace(),
+			}
+		}
+		volumeStats := s.parsePodVolumeStats(name, pvcRef, metric)
+		volumesStats = append(volumesStats, volumeStats)
 	}
 
 	// Store the new stats
-	s.latest.Store(PodVolumeStats{Volumes: stats})
+	s.latest.Store(PodVolumeStats{Volumes: volumesStats})
 }
 
 // parsePodVolumeStats converts (internal) volume.Metrics to (external) stats.VolumeStats structures
-func (s *volumeStatCalculator) parsePodVolumeStats(podName string, metric *volume.Metrics) stats.VolumeStats {
+func (s *volumeStatCalculator) parsePodVolumeStats(podName string, pvcRef *stats.PVCReference, metric *volume.Metrics) stats.VolumeStats {
 	available := uint64(metric.Available.Value())
 	capacity := uint64(metric.Capacity.Value())
 	used := uint64(metric.Used.Value())
@@ -119,8 +147,40 @@ func (s *volumeStatCalculator) parsePodVolumeStats(podName string, metric *volum
 	inodesFree := uint64(metric.InodesFree.Value())
 	inodesUsed := uint64(metric.InodesUsed.Value())
 	return stats.VolumeStats{
-		Name: podName,
+		Name:   podName,
+		PVCRef: pvcRef,
 		FsStats: stats.FsStats{AvailableBytes: &available, CapacityBytes: &capacity, UsedBytes: &used,
 			Inodes: &inodes, InodesFree: &inodesFree, InodesUsed: &inodesUsed},
 	}
 }
+
+// checkVolumeCondition records a warning event on the pod when a volume
+// becomes abnormal, i.e. when the device backing it is missing or when a
+// writable volume has been remounted read-only.
+func (s *volumeStatCalculator) checkVolumeCondition(name string, v volume.Volume, metric *volume.Metrics) {
+	abnormal := metric.Abnormal != nil && *metric.Abnormal
+	var message string
+	if abnormal {
+		if metric.Message != nil {
+			message = *metric.Message
+		}
+	} else if mounter, ok := v.(volume.Mounter); ok && metric.Abnormal != nil && !mounter.GetAttributes().ReadOnly {
+		// The file system of a writable volume may be remounted read-only by
+		// the kernel after I/O errors.
+		if readOnly, err := volumeutil.FsReadOnly(v.GetPath()); err == nil && readOnly {
+			abnormal = true
+			message = "the volume has been remounted read-only"
+		}
+	}
+
+	if !abnormal {
+		delete(s.abnormalVolumes, name)
+		return
+	}
+	if s.abnormalVolumes[name] {
+		return
+	}
+	s.abnormalVolumes[name] = true
+	glog.Warningf("Volume %s of pod %s is abnormal: %s", name, format.Pod(s.pod), message)
+	s.eventRecorder.Eventf(s.pod, v1.EventTypeWarning, events.VolumeConditionAbnormal, "Volume %s is abnormal: %s", name, message)
+}
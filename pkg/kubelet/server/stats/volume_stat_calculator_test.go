@@ -0,0 +1,190 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	k8sv1 "k8s.io/kubernetes/pkg/api/v1"
+	kubestats "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+const (
+	namespace0  = "test0"
+	pName0      = "pod0"
+	capacity    = int64(10000000)
+	available   = int64(5000000)
+	inodesTotal = int64(2000)
+	inodesFree  = int64(500)
+
+	vol0          = "vol0"
+	vol1          = "vol1"
+	pvcClaimName0 = "pvc-fake0"
+)
+
+var (
+	fakePod = &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pName0,
+			Namespace: namespace0,
+			UID:       "UID" + pName0,
+		},
+		Spec: k8sv1.PodSpec{
+			Volumes: []k8sv1.Volume{
+				{
+					Name: vol0,
+					VolumeSource: k8sv1.VolumeSource{
+						EmptyDir: &k8sv1.EmptyDirVolumeSource{},
+					},
+				},
+				{
+					Name: vol1,
+					VolumeSource: k8sv1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvcClaimName0,
+						},
+					},
+				},
+			},
+		},
+	}
+)
+
+// fakeVolume is a volume.Volume that reports the given metrics.
+type fakeVolume struct {
+	metrics *volume.Metrics
+}
+
+func (v *fakeVolume) GetPath() string { return "/fake/path" }
+
+func (v *fakeVolume) GetMetrics() (*volume.Metrics, error) { return v.metrics, nil }
+
+func newFakeMetrics(abnormal bool) *volume.Metrics {
+	metrics := &volume.Metrics{
+		Available:  resource.NewQuantity(available, resource.BinarySI),
+		Capacity:   resource.NewQuantity(capacity, resource.BinarySI),
+		Used:       resource.NewQuantity(available, resource.BinarySI),
+		Inodes:     resource.NewQuantity(inodesTotal, resource.BinarySI),
+		InodesFree: resource.NewQuantity(inodesFree, resource.BinarySI),
+		InodesUsed: resource.NewQuantity(inodesTotal-inodesFree, resource.BinarySI),
+		Abnormal:   &abnormal,
+	}
+	if abnormal {
+		message := "the device backing the volume is missing"
+		metrics.Message = &message
+	}
+	return metrics
+}
+
+func TestPVCRef(t *testing.T) {
+	mockStats := new(MockStatsProvider)
+	volumes := map[string]volume.Volume{
+		vol0: &fakeVolume{newFakeMetrics(false)},
+		vol1: &fakeVolume{newFakeMetrics(false)},
+	}
+	mockStats.On("ListVolumesForPod", fakePod.UID).Return(volumes, true)
+
+	statsCalculator := newVolumeStatCalculator(mockStats, time.Minute, fakePod, record.NewFakeRecorder(10))
+	statsCalculator.calcAndStoreStats()
+	vs, _ := statsCalculator.GetLatest()
+
+	assert.Len(t, vs.Volumes, 2)
+	for _, v := range vs.Volumes {
+		switch v.Name {
+		case vol0:
+			assert.Nil(t, v.PVCRef)
+		case vol1:
+			assert.Equal(t, &kubestats.PVCReference{Name: pvcClaimName0, Namespace: namespace0}, v.PVCRef)
+		default:
+			t.Errorf("Unexpected volume %q", v.Name)
+		}
+		assert.EqualValues(t, available, *v.AvailableBytes)
+		assert.EqualValues(t, capacity, *v.CapacityBytes)
+		assert.EqualValues(t, inodesFree, *v.InodesFree)
+	}
+}
+
+func TestAbnormalVolumeEvent(t *testing.T) {
+	mockStats := new(MockStatsProvider)
+	abnormalVolume := &fakeVolume{newFakeMetrics(true)}
+	volumes := map[string]volume.Volume{
+		vol1: abnormalVolume,
+	}
+	mockStats.On("ListVolumesForPod", fakePod.UID).Return(volumes, true)
+
+	recorder := record.NewFakeRecorder(10)
+	statsCalculator := newVolumeStatCalculator(mockStats, time.Minute, fakePod, recorder)
+
+	// The event is recorded once when the volume becomes abnormal.
+	statsCalculator.calcAndStoreStats()
+	statsCalculator.calcAndStoreStats()
+	assert.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "VolumeConditionAbnormal")
+
+	// And again once it becomes abnormal after having recovered.
+	abnormalVolume.metrics = newFakeMetrics(false)
+	statsCalculator.calcAndStoreStats()
+	assert.Len(t, recorder.Events, 0)
+	abnormalVolume.metrics = newFakeMetrics(true)
+	statsCalculator.calcAndStoreStats()
+	assert.Len(t, recorder.Events, 1)
+}
+
+func TestVolumeStatsCollector(t *testing.T) {
+	mockStats := new(MockStatsProvider)
+	volumes := map[string]volume.Volume{
+		vol0: &fakeVolume{newFakeMetrics(false)},
+		vol1: &fakeVolume{newFakeMetrics(false)},
+	}
+	mockStats.On("ListVolumesForPod", fakePod.UID).Return(volumes, true)
+	mockStats.On("GetPods").Return([]*k8sv1.Pod{fakePod})
+
+	fsAnalyzer := newFsResourceAnalyzer(mockStats, time.Minute, record.NewFakeRecorder(10))
+	statsCalculator := newVolumeStatCalculator(mockStats, time.Minute, fakePod, record.NewFakeRecorder(10))
+	statsCalculator.calcAndStoreStats()
+	fsAnalyzer.cachedVolumeStats.Store(Cache{fakePod.UID: statsCalculator})
+
+	collector := &volumeStatsCollector{statsProvider: mockStats, fsAnalyzer: fsAnalyzer}
+	ch := make(chan prometheus.Metric, 100)
+	collector.Collect(ch)
+	close(ch)
+
+	// Only the volume backed by a claim is reported, with one gauge per stat.
+	count := 0
+	for m := range ch {
+		count++
+		metric := &dto.Metric{}
+		if err := m.Write(metric); err != nil {
+			t.Fatalf("Failed to write metric: %v", err)
+		}
+		labels := map[string]string{}
+		for _, label := range metric.Label {
+			labels[label.GetName()] = label.GetValue()
+		}
+		assert.Equal(t, map[string]string{"namespace": namespace0, "persistentvolumeclaim": pvcClaimName0}, labels)
+	}
+	assert.Equal(t, 6, count)
+}
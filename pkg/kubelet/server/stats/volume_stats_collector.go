@@ -0,0 +1,113 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stats
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
+	"k8s.io/kubernetes/pkg/kubelet/metrics"
+)
+
+var (
+	volumeStatsCapacityBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName("", metrics.KubeletSubsystem, metrics.VolumeStatsCapacityBytesKey),
+		"Capacity in bytes of the volume",
+		[]string{"namespace", "persistentvolumeclaim"}, nil)
+	volumeStatsAvailableBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName("", metrics.KubeletSubsystem, metrics.VolumeStatsAvailableBytesKey),
+		"Number of available bytes in the volume",
+		[]string{"namespace", "persistentvolumeclaim"}, nil)
+	volumeStatsUsedBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName("", metrics.KubeletSubsystem, metrics.VolumeStatsUsedBytesKey),
+		"Number of used bytes in the volume",
+		[]string{"namespace", "persistentvolumeclaim"}, nil)
+	volumeStatsInodesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName("", metrics.KubeletSubsystem, metrics.VolumeStatsInodesKey),
+		"Maximum number of inodes in the volume",
+		[]string{"namespace", "persistentvolumeclaim"}, nil)
+	volumeStatsInodesFreeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName("", metrics.KubeletSubsystem, metrics.VolumeStatsInodesFreeKey),
+		"Number of free inodes in the volume",
+		[]string{"namespace", "persistentvolumeclaim"}, nil)
+	volumeStatsInodesUsedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName("", metrics.KubeletSubsystem, metrics.VolumeStatsInodesUsedKey),
+		"Number of used inodes in the volume",
+		[]string{"namespace", "persistentvolumeclaim"}, nil)
+)
+
+// volumeStatsCollector collects the usage of the persistent volume claims
+// mounted by the pods on this node from the cached volume stats.
+type volumeStatsCollector struct {
+	statsProvider StatsProvider
+	fsAnalyzer    fsResourceAnalyzerInterface
+}
+
+// NewVolumeStatsCollector creates a prometheus collector that reports the
+// capacity, usage and inodes of the persistent volume claims mounted by the
+// pods on this node, labelled with the namespace and name of the claim.
+func NewVolumeStatsCollector(statsProvider StatsProvider, resourceAnalyzer ResourceAnalyzer) prometheus.Collector {
+	return &volumeStatsCollector{
+		statsProvider: statsProvider,
+		fsAnalyzer:    resourceAnalyzer,
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (collector *volumeStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- volumeStatsCapacityBytesDesc
+	ch <- volumeStatsAvailableBytesDesc
+	ch <- volumeStatsUsedBytesDesc
+	ch <- volumeStatsInodesDesc
+	ch <- volumeStatsInodesFreeDesc
+	ch <- volumeStatsInodesUsedDesc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (collector *volumeStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	addGauge := func(desc *prometheus.Desc, pvcRef *stats.PVCReference, v *uint64) {
+		if v == nil {
+			return
+		}
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(*v), pvcRef.Namespace, pvcRef.Name)
+	}
+	// A claim may be mounted by several pods, report it only once.
+	allPVCs := sets.String{}
+	for _, pod := range collector.statsProvider.GetPods() {
+		volumeStats, found := collector.fsAnalyzer.GetPodVolumeStats(pod.UID)
+		if !found {
+			continue
+		}
+		for _, volumeStat := range volumeStats.Volumes {
+			pvcRef := volumeStat.PVCRef
+			if pvcRef == nil {
+				continue
+			}
+			pvcUniqStr := pvcRef.Namespace + "/" + pvcRef.Name
+			if allPVCs.Has(pvcUniqStr) {
+				continue
+			}
+			addGauge(volumeStatsCapacityBytesDesc, pvcRef, volumeStat.CapacityBytes)
+			addGauge(volumeStatsAvailableBytesDesc, pvcRef, volumeStat.AvailableBytes)
+			addGauge(volumeStatsUsedBytesDesc, pvcRef, volumeStat.UsedBytes)
+			addGauge(volumeStatsInodesDesc, pvcRef, volumeStat.Inodes)
+			addGauge(volumeStatsInodesFreeDesc, pvcRef, volumeStat.InodesFree)
+			addGauge(volumeStatsInodesUsedDesc, pvcRef, volumeStat.InodesUsed)
+			allPVCs.Insert(pvcUniqStr)
+		}
+	}
+}
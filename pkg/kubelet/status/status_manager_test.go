@@ -701,6 +701,83 @@ func TestSetContainerReadiness(t *testing.T) {
 	verifyReadiness("ignore non-existent", &status, true, true, true)
 }
 
+func TestSetContainerStartup(t *testing.T) {
+	cID1 := kubecontainer.ContainerID{Type: "test", ID: "1"}
+	cID2 := kubecontainer.ContainerID{Type: "test", ID: "2"}
+	containerStatuses := []v1.ContainerStatus{
+		{
+			Name:        "c1",
+			ContainerID: cID1.String(),
+		}, {
+			Name:        "c2",
+			ContainerID: cID2.String(),
+		},
+	}
+	status := v1.PodStatus{
+		ContainerStatuses: containerStatuses,
+		Conditions: []v1.PodCondition{{
+			Type:   v1.PodReady,
+			Status: v1.ConditionFalse,
+		}},
+	}
+	pod := getTestPod()
+	pod.Spec.Containers = []v1.Container{{Name: "c1"}, {Name: "c2"}}
+
+	// Verify expected startup of containers.
+	verifyStartup := func(step string, status *v1.PodStatus, c1Started, c2Started bool) {
+		for _, c := range status.ContainerStatuses {
+			started := c.Started != nil && *c.Started
+			switch c.ContainerID {
+			case cID1.String():
+				if started != c1Started {
+					t.Errorf("[%s] Expected startup of c1 to be %v but was %v", step, c1Started, started)
+				}
+			case cID2.String():
+				if started != c2Started {
+					t.Errorf("[%s] Expected startup of c2 to be %v but was %v", step, c2Started, started)
+				}
+			default:
+				t.Fatalf("[%s] Unexpected container: %+v", step, c)
+			}
+		}
+	}
+
+	m := newTestManager(&fake.Clientset{})
+	// Add test pod because the container spec has been changed.
+	m.podManager.AddPod(pod)
+
+	t.Log("Setting startup before status should fail.")
+	m.SetContainerStartup(pod.UID, cID1, true)
+	verifyUpdates(t, m, 0)
+	if status, ok := m.GetPodStatus(pod.UID); ok {
+		t.Errorf("Unexpected PodStatus: %+v", status)
+	}
+
+	t.Log("Setting initial status.")
+	m.SetPodStatus(pod, status)
+	verifyUpdates(t, m, 1)
+	status = expectPodStatus(t, m, pod)
+	verifyStartup("initial", &status, false, false)
+
+	t.Log("Setting container startup should generate update.")
+	m.SetContainerStartup(pod.UID, cID1, true)
+	verifyUpdates(t, m, 1)
+	status = expectPodStatus(t, m, pod)
+	verifyStartup("c1 started", &status, true, false)
+
+	t.Log("Setting unchanged startup should do nothing.")
+	m.SetContainerStartup(pod.UID, cID1, true)
+	verifyUpdates(t, m, 0)
+	status = expectPodStatus(t, m, pod)
+	verifyStartup("unchanged", &status, true, false)
+
+	t.Log("Setting non-existent container startup should fail.")
+	m.SetContainerStartup(pod.UID, kubecontainer.ContainerID{Type: "test", ID: "foo"}, true)
+	verifyUpdates(t, m, 0)
+	status = expectPodStatus(t, m, pod)
+	verifyStartup("ignore non-existent", &status, true, false)
+}
+
 func TestSyncBatchCleanupVersions(t *testing.T) {
 	m := newTestManager(&fake.Clientset{})
 	testPod := getTestPod()
@@ -58,7 +58,7 @@ type ActualStateOfWorld interface {
 	// volume, reset the pod's remountRequired value.
 	// If a volume with the name volumeName does not exist in the list of
 	// attached volumes, an error is returned.
-	AddPodToVolume(podName volumetypes.UniquePodName, podUID types.UID, volumeName v1.UniqueVolumeName, mounter volume.Mounter, outerVolumeSpecName string, volumeGidValue string) error
+	AddPodToVolume(podName volumetypes.UniquePodName, podUID types.UID, volumeName v1.UniqueVolumeName, mounter volume.Mounter, blockVolumeMapper volume.BlockVolumeMapper, outerVolumeSpecName string, volumeGidValue string) error
 
 	// MarkRemountRequired marks each volume that is successfully attached and
 	// mounted for the specified pod as requiring remount (if the plugin for the
@@ -67,6 +67,12 @@ type ActualStateOfWorld interface {
 	// pod update.
 	MarkRemountRequired(podName volumetypes.UniquePodName)
 
+	// MarkFSResizeRequired marks the given volume, which is mounted for the
+	// specified pod, as requiring its file system to be grown. This is a no-op
+	// if the volume is not mounted for the pod yet, the file system is grown
+	// when the volume is mounted.
+	MarkFSResizeRequired(volumeName v1.UniqueVolumeName, podName volumetypes.UniquePodName)
+
 	// SetVolumeGloballyMounted sets the GloballyMounted value for the given
 	// volume. When set to true this value indicates that the volume is mounted
 	// to the underlying device at a global mount point. This global mount point
@@ -105,6 +111,9 @@ type ActualStateOfWorld interface {
 	// the given volume has been successfully mounted to this pod but should be
 	// remounted to reflect changes in the referencing pod. Atomically updating
 	// volumes, depend on this to update the contents of the volume.
+	// If the given volumeName/podName combo exists but the value of
+	// fsResizeRequired is true, a fsResizeRequiredError is returned indicating
+	// the file system of the volume should be grown while it is mounted.
 	// All volume mounting calls should be idempotent so a second mount call for
 	// volumes that do not need to update contents should not fail.
 	PodExistsInVolume(podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) (bool, string, error)
@@ -183,6 +192,13 @@ func IsRemountRequiredError(err error) bool {
 	return ok
 }
 
+// IsFSResizeRequiredError returns true if the specified error is a
+// fsResizeRequiredError.
+func IsFSResizeRequiredError(err error) bool {
+	_, ok := err.(fsResizeRequiredError)
+	return ok
+}
+
 type actualStateOfWorld struct {
 	// nodeName is the name of this node. This value is passed to Attach/Detach
 	nodeName types.NodeName
@@ -254,6 +270,9 @@ type mountedPod struct {
 	// mounter used to mount
 	mounter volume.Mounter
 
+	// blockVolumeMapper used to map a block volume, nil for filesystem volumes
+	blockVolumeMapper volume.BlockVolumeMapper
+
 	// outerVolumeSpecName is the volume.Spec.Name() of the volume as referenced
 	// directly in the pod. If the volume was referenced through a persistent
 	// volume claim, this contains the volume.Spec.Name() of the persistent
@@ -268,6 +287,11 @@ type mountedPod struct {
 	// call for volumes that do not need to update contents should not fail.
 	remountRequired bool
 
+	// fsResizeRequired indicates the underlying volume has been successfully
+	// mounted to this pod but its file system should be grown to the new
+	// capacity of the volume.
+	fsResizeRequired bool
+
 	// volumeGidValue contains the value of the GID annotation, if present.
 	volumeGidValue string
 }
@@ -287,6 +311,7 @@ func (asw *actualStateOfWorld) MarkVolumeAsMounted(
 	podUID types.UID,
 	volumeName v1.UniqueVolumeName,
 	mounter volume.Mounter,
+	blockVolumeMapper volume.BlockVolumeMapper,
 	outerVolumeSpecName string,
 	volumeGidValue string) error {
 	return asw.AddPodToVolume(
@@ -294,6 +319,7 @@ func (asw *actualStateOfWorld) MarkVolumeAsMounted(
 		podUID,
 		volumeName,
 		mounter,
+		blockVolumeMapper,
 		outerVolumeSpecName,
 		volumeGidValue)
 }
@@ -385,6 +411,7 @@ func (asw *actualStateOfWorld) AddPodToVolume(
 	podUID types.UID,
 	volumeName v1.UniqueVolumeName,
 	mounter volume.Mounter,
+	blockVolumeMapper volume.BlockVolumeMapper,
 	outerVolumeSpecName string,
 	volumeGidValue string) error {
 	asw.Lock()
@@ -403,6 +430,7 @@ func (asw *actualStateOfWorld) AddPodToVolume(
 			podName:             podName,
 			podUID:              podUID,
 			mounter:             mounter,
+			blockVolumeMapper:   blockVolumeMapper,
 			outerVolumeSpecName: outerVolumeSpecName,
 			volumeGidValue:      volumeGidValue,
 		}
@@ -446,6 +474,53 @@ func (asw *actualStateOfWorld) MarkRemountRequired(
 	}
 }
 
+func (asw *actualStateOfWorld) MarkFSResizeRequired(
+	volumeName v1.UniqueVolumeName,
+	podName volumetypes.UniquePodName) {
+	asw.Lock()
+	defer asw.Unlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return
+	}
+
+	podObj, podExists := volumeObj.mountedPods[podName]
+	if !podExists || podObj.fsResizeRequired {
+		return
+	}
+
+	glog.V(4).Infof("Volume %q mounted to pod %q requires a file system resize", volumeName, podName)
+	podObj.fsResizeRequired = true
+	asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+}
+
+func (asw *actualStateOfWorld) MarkVolumeAsResized(
+	podName volumetypes.UniquePodName,
+	volumeName v1.UniqueVolumeName) error {
+	asw.Lock()
+	defer asw.Unlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return fmt.Errorf(
+			"no volume with the name %q exists in the list of attached volumes",
+			volumeName)
+	}
+
+	podObj, podExists := volumeObj.mountedPods[podName]
+	if !podExists {
+		return fmt.Errorf(
+			"no pod with the name %q exists in the mounted pods of volume %q",
+			podName,
+			volumeName)
+	}
+
+	podObj.fsResizeRequired = false
+	asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+	return nil
+}
+
 func (asw *actualStateOfWorld) SetVolumeGloballyMounted(
 	volumeName v1.UniqueVolumeName, globallyMounted bool) error {
 	asw.Lock()
@@ -518,6 +593,9 @@ func (asw *actualStateOfWorld) PodExistsInVolume(
 	if podExists && podObj.remountRequired {
 		return true, volumeObj.devicePath, newRemountRequiredError(volumeObj.volumeName, podObj.podName)
 	}
+	if podExists && podObj.fsResizeRequired {
+		return true, volumeObj.devicePath, newFsResizeRequiredError(volumeObj.volumeName, podObj.podName)
+	}
 
 	return podExists, volumeObj.devicePath, nil
 }
@@ -669,6 +747,31 @@ func newRemountRequiredError(
 	}
 }
 
+// Compile-time check to ensure fsResizeRequiredError implements the error interface
+var _ error = fsResizeRequiredError{}
+
+// fsResizeRequiredError is an error returned when PodExistsInVolume() found
+// volume/pod attached/mounted but fsResizeRequired was true, indicating the
+// file system of the given volume should be grown.
+type fsResizeRequiredError struct {
+	volumeName v1.UniqueVolumeName
+	podName    volumetypes.UniquePodName
+}
+
+func (err fsResizeRequiredError) Error() string {
+	return fmt.Sprintf(
+		"volumeName %q is mounted to %q but its file system should be resized",
+		err.volumeName, err.podName)
+}
+
+func newFsResizeRequiredError(
+	volumeName v1.UniqueVolumeName, podName volumetypes.UniquePodName) error {
+	return fsResizeRequiredError{
+		volumeName: volumeName,
+		podName:    podName,
+	}
+}
+
 // getMountedVolume constructs and returns a MountedVolume object from the given
 // mountedPod and attachedVolume objects.
 func getMountedVolume(
@@ -682,5 +785,7 @@ func getMountedVolume(
 			PluginName:          attachedVolume.pluginName,
 			PodUID:              mountedPod.podUID,
 			Mounter:             mountedPod.mounter,
-			VolumeGidValue:      mountedPod.volumeGidValue}}
+			BlockVolumeMapper:   mountedPod.blockVolumeMapper,
+			VolumeGidValue:      mountedPod.volumeGidValue,
+			VolumeSpec:          attachedVolume.spec}}
 }
@@ -30,15 +30,18 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubernetes/pkg/features"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/pod"
 	"k8s.io/kubernetes/pkg/kubelet/status"
 	"k8s.io/kubernetes/pkg/kubelet/util/format"
 	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
 	"k8s.io/kubernetes/pkg/volume"
+	volumeutil "k8s.io/kubernetes/pkg/volume/util"
 	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
 	"k8s.io/kubernetes/pkg/volume/util/volumehelper"
 )
@@ -66,6 +69,8 @@ type DesiredStateOfWorldPopulator interface {
 // podManager - the kubelet podManager that is the source of truth for the pods
 //     that exist on this host
 // desiredStateOfWorld - the cache to populate
+// actualStateOfWorld - the cache of mounted volumes, used to request the file
+//     system of a mounted volume to be grown
 func NewDesiredStateOfWorldPopulator(
 	kubeClient clientset.Interface,
 	loopSleepDuration time.Duration,
@@ -73,6 +78,7 @@ func NewDesiredStateOfWorldPopulator(
 	podManager pod.Manager,
 	podStatusProvider status.PodStatusProvider,
 	desiredStateOfWorld cache.DesiredStateOfWorld,
+	actualStateOfWorld cache.ActualStateOfWorld,
 	kubeContainerRuntime kubecontainer.Runtime,
 	keepTerminatedPodVolumes bool) DesiredStateOfWorldPopulator {
 	return &desiredStateOfWorldPopulator{
@@ -82,6 +88,7 @@ func NewDesiredStateOfWorldPopulator(
 		podManager:                podManager,
 		podStatusProvider:         podStatusProvider,
 		desiredStateOfWorld:       desiredStateOfWorld,
+		actualStateOfWorld:        actualStateOfWorld,
 		pods: processedPods{
 			processedPods: make(map[volumetypes.UniquePodName]bool)},
 		kubeContainerRuntime:     kubeContainerRuntime,
@@ -96,6 +103,7 @@ type desiredStateOfWorldPopulator struct {
 	podManager                pod.Manager
 	podStatusProvider         status.PodStatusProvider
 	desiredStateOfWorld       cache.DesiredStateOfWorld
+	actualStateOfWorld        cache.ActualStateOfWorld
 	pods                      processedPods
 	kubeContainerRuntime      kubecontainer.Runtime
 	timeOfLastGetPodStatus    time.Time
@@ -247,10 +255,15 @@ func (dswp *desiredStateOfWorldPopulator) processPodVolumes(pod *v1.Pod) {
 		return
 	}
 
+	var mountsMap, devicesMap map[string]bool
+	if utilfeature.DefaultFeatureGate.Enabled(features.BlockVolume) {
+		mountsMap, devicesMap = dswp.makeVolumeMap(pod.Spec.InitContainers, pod.Spec.Containers)
+	}
+
 	// Process volume spec for each volume defined in pod
 	for _, podVolume := range pod.Spec.Volumes {
-		volumeSpec, volumeGidValue, err :=
-			dswp.createVolumeSpec(podVolume, pod.Namespace)
+		pvc, volumeSpec, volumeGidValue, err :=
+			dswp.createVolumeSpec(podVolume, pod.Namespace, mountsMap, devicesMap)
 		if err != nil {
 			glog.Errorf(
 				"Error processing volume %q for pod %q: %v",
@@ -261,7 +274,7 @@ func (dswp *desiredStateOfWorldPopulator) processPodVolumes(pod *v1.Pod) {
 		}
 
 		// Add volume to desired state of world
-		_, err = dswp.desiredStateOfWorld.AddPodToVolume(
+		uniqueVolumeName, err := dswp.desiredStateOfWorld.AddPodToVolume(
 			uniquePodName, pod, volumeSpec, podVolume.Name, volumeGidValue)
 		if err != nil {
 			glog.Errorf(
@@ -270,6 +283,7 @@ func (dswp *desiredStateOfWorldPopulator) processPodVolumes(pod *v1.Pod) {
 				volumeSpec.Name(),
 				uniquePodName,
 				err)
+			continue
 		}
 
 		glog.V(10).Infof(
@@ -277,6 +291,13 @@ func (dswp *desiredStateOfWorldPopulator) processPodVolumes(pod *v1.Pod) {
 			podVolume.Name,
 			volumeSpec.Name(),
 			uniquePodName)
+
+		// The file system of a volume which was expanded while it is mounted
+		// is grown by the reconciler.
+		if pvc != nil && utilfeature.DefaultFeatureGate.Enabled(features.ExpandPersistentVolumes) &&
+			volumeutil.ClaimHasCondition(pvc, v1.PersistentVolumeClaimFileSystemResizePending) {
+			dswp.actualStateOfWorld.MarkFSResizeRequired(uniqueVolumeName, uniquePodName)
+		}
 	}
 
 	dswp.markPodProcessed(uniquePodName)
@@ -314,8 +335,9 @@ func (dswp *desiredStateOfWorldPopulator) deleteProcessedPod(
 
 // createVolumeSpec creates and returns a mutatable volume.Spec object for the
 // specified volume. It dereference any PVC to get PV objects, if needed.
+// The PVC is returned too if the volume is a PVC, nil otherwise.
 func (dswp *desiredStateOfWorldPopulator) createVolumeSpec(
-	podVolume v1.Volume, podNamespace string) (*volume.Spec, string, error) {
+	podVolume v1.Volume, podNamespace string, mountsMap, devicesMap map[string]bool) (*v1.PersistentVolumeClaim, *volume.Spec, string, error) {
 	if pvcSource :=
 		podVolume.VolumeSource.PersistentVolumeClaim; pvcSource != nil {
 		glog.V(10).Infof(
@@ -324,15 +346,16 @@ func (dswp *desiredStateOfWorldPopulator) createVolumeSpec(
 			pvcSource.ClaimName)
 
 		// If podVolume is a PVC, fetch the real PV behind the claim
-		pvName, pvcUID, err := dswp.getPVCExtractPV(
+		pvc, err := dswp.getPVCExtractPV(
 			podNamespace, pvcSource.ClaimName)
 		if err != nil {
-			return nil, "", fmt.Errorf(
+			return nil, nil, "", fmt.Errorf(
 				"error processing PVC %q/%q: %v",
 				podNamespace,
 				pvcSource.ClaimName,
 				err)
 		}
+		pvName, pvcUID := pvc.Spec.VolumeName, pvc.UID
 
 		glog.V(10).Infof(
 			"Found bound PV for PVC (ClaimName %q/%q pvcUID %v): pvName=%q",
@@ -345,7 +368,7 @@ func (dswp *desiredStateOfWorldPopulator) createVolumeSpec(
 		volumeSpec, volumeGidValue, err :=
 			dswp.getPVSpec(pvName, pvcSource.ReadOnly, pvcUID)
 		if err != nil {
-			return nil, "", fmt.Errorf(
+			return nil, nil, "", fmt.Errorf(
 				"error processing PVC %q/%q: %v",
 				podNamespace,
 				pvcSource.ClaimName,
@@ -360,35 +383,75 @@ func (dswp *desiredStateOfWorldPopulator) createVolumeSpec(
 			pvcSource.ClaimName,
 			pvcUID)
 
-		return volumeSpec, volumeGidValue, nil
+		// Error if a container is using a Block volume as a filesystem or a
+		// Filesystem volume as a raw block device
+		if utilfeature.DefaultFeatureGate.Enabled(features.BlockVolume) {
+			volumeMode := volumeutil.GetPersistentVolumeMode(&volumeSpec.PersistentVolume.Spec)
+			if mountsMap[podVolume.Name] && volumeMode == v1.PersistentVolumeBlock {
+				return nil, nil, "", fmt.Errorf(
+					"Volume %q has volumeMode %q, but is specified in volumeMounts (claim %q/%q)",
+					podVolume.Name,
+					volumeMode,
+					podNamespace,
+					pvcSource.ClaimName)
+			}
+			if devicesMap[podVolume.Name] && volumeMode == v1.PersistentVolumeFilesystem {
+				return nil, nil, "", fmt.Errorf(
+					"Volume %q has volumeMode %q, but is specified in volumeDevices (claim %q/%q)",
+					podVolume.Name,
+					volumeMode,
+					podNamespace,
+					pvcSource.ClaimName)
+			}
+		}
+
+		return pvc, volumeSpec, volumeGidValue, nil
 	}
 
 	// Do not return the original volume object, since the source could mutate it
 	clonedPodVolumeObj, err := api.Scheme.DeepCopy(&podVolume)
 	if err != nil || clonedPodVolumeObj == nil {
-		return nil, "", fmt.Errorf(
+		return nil, nil, "", fmt.Errorf(
 			"failed to deep copy %q volume object. err=%v", podVolume.Name, err)
 	}
 
 	clonedPodVolume, ok := clonedPodVolumeObj.(*v1.Volume)
 	if !ok {
-		return nil, "", fmt.Errorf(
+		return nil, nil, "", fmt.Errorf(
 			"failed to cast clonedPodVolume %#v to v1.Volume",
 			clonedPodVolumeObj)
 	}
 
-	return volume.NewSpecFromVolume(clonedPodVolume), "", nil
+	return nil, volume.NewSpecFromVolume(clonedPodVolume), "", nil
+}
+
+// makeVolumeMap returns the names of the volumes the given containers use
+// through volumeMounts and through volumeDevices.
+func (dswp *desiredStateOfWorldPopulator) makeVolumeMap(containerLists ...[]v1.Container) (map[string]bool, map[string]bool) {
+	mountsMap := make(map[string]bool)
+	devicesMap := make(map[string]bool)
+	for _, containers := range containerLists {
+		for _, container := range containers {
+			for _, mount := range container.VolumeMounts {
+				mountsMap[mount.Name] = true
+			}
+			for _, device := range container.VolumeDevices {
+				devicesMap[device.Name] = true
+			}
+		}
+	}
+	return mountsMap, devicesMap
 }
 
 // getPVCExtractPV fetches the PVC object with the given namespace and name from
-// the API server extracts the name of the PV it is pointing to and returns it.
+// the API server and returns it once it checked it is pointing to a PV.
 // An error is returned if the PVC object's phase is not "Bound".
 func (dswp *desiredStateOfWorldPopulator) getPVCExtractPV(
-	namespace string, claimName string) (string, types.UID, error) {
+	namespace string, claimName string) (*v1.PersistentVolumeClaim, error) {
 	pvc, err :=
 		dswp.kubeClient.Core().PersistentVolumeClaims(namespace).Get(claimName, metav1.GetOptions{})
 	if err != nil || pvc == nil {
-		return "", "", fmt.Errorf(
+		return nil, fmt.Errorf(
 			"failed to fetch PVC %s/%s from API server. err=%v",
 			namespace,
 			claimName,
@@ -396,7 +459,7 @@ func (dswp *desiredStateOfWorldPopulator) getPVCExtractPV(
 	}
 
 	if pvc.Status.Phase != v1.ClaimBound || pvc.Spec.VolumeName == "" {
-		return "", "", fmt.Errorf(
+		return nil, fmt.Errorf(
 			"PVC %s/%s has non-bound phase (%q) or empty pvc.Spec.VolumeName (%q)",
 			namespace,
 			claimName,
@@ -404,7 +467,7 @@ func (dswp *desiredStateOfWorldPopulator) getPVCExtractPV(
 			pvc.Spec.VolumeName)
 	}
 
-	return pvc.Spec.VolumeName, pvc.UID, nil
+	return pvc, nil
 }
 
 // getPVSpec fetches the PV object with the given name from the API server
@@ -83,6 +83,7 @@ var typesAllowedTags = map[reflect.Type]bool{
 	reflect.TypeOf(metav1.ExportOptions{}):  true,
 	reflect.TypeOf(metav1.ListOptions{}):    true,
 	reflect.TypeOf(metav1.DeleteOptions{}):  true,
+	reflect.TypeOf(runtime.RawExtension{}):  true,
 }
 
 func ensureNoTags(t *testing.T, gvk schema.GroupVersionKind, tp reflect.Type, parents []reflect.Type) {
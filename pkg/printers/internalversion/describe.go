@@ -705,6 +705,49 @@ func printPortworxVolumeSource(pwxVolume *api.PortworxVolumeSource, w *PrefixWri
 		pwxVolume.VolumeID)
 }
 
+func printCSIPersistentVolumeSource(csi *api.CSIPersistentVolumeSource, w *PrefixWriter) {
+	w.Write(LEVEL_2, "Type:\tCSI (a volume managed by an external storage driver)\n"+
+		"    Driver:\t%v\n"+
+		"    VolumeHandle:\t%v\n"+
+		"    FSType:\t%v\n"+
+		"    ReadOnly:\t%v\n",
+		csi.Driver, csi.VolumeHandle, csi.FSType, csi.ReadOnly)
+	printLabelsMultilineWithIndent(w, "    ", "VolumeAttributes", "\t", csi.VolumeAttributes, sets.NewString())
+}
+
+func printLocalVolumeSource(ls *api.LocalVolumeSource, w *PrefixWriter) {
+	w.Write(LEVEL_2, "Type:\tLocalVolume (a persistent volume backed by local storage on a node)\n"+
+		"    Path:\t%v\n",
+		ls.Path)
+}
+
+func printVolumeNodeAffinity(w *PrefixWriter, affinity *api.VolumeNodeAffinity) {
+	w.Write(LEVEL_0, "Node Affinity:\t")
+	if affinity == nil || affinity.Required == nil {
+		w.WriteLine("<none>")
+		return
+	}
+	w.WriteLine("")
+
+	w.Write(LEVEL_1, "Required Terms:\t")
+	if len(affinity.Required.NodeSelectorTerms) == 0 {
+		w.WriteLine("<none>")
+		return
+	}
+	w.WriteLine("")
+	for i, term := range affinity.Required.NodeSelectorTerms {
+		w.Write(LEVEL_2, "Term %v:\t", i)
+		if len(term.MatchExpressions) == 0 {
+			w.WriteLine("<none>")
+			continue
+		}
+		w.WriteLine("")
+		for _, req := range term.MatchExpressions {
+			w.Write(LEVEL_3, "%s %s [%s]\n", req.Key, strings.ToLower(string(req.Operator)), strings.Join(req.Values, ", "))
+		}
+	}
+}
+
 func printISCSIVolumeSource(iscsi *api.ISCSIVolumeSource, w *PrefixWriter) {
 	w.Write(LEVEL_2, "Type:\tISCSI (an ISCSI Disk resource that is attached to a kubelet's host machine and then exposed to the pod)\n"+
 		"    TargetPortal:\t%v\n"+
@@ -822,6 +865,7 @@ func (d *PersistentVolumeDescriber) Describe(namespace, name string, describerSe
 		w.Write(LEVEL_0, "Reclaim Policy:\t%v\n", pv.Spec.PersistentVolumeReclaimPolicy)
 		w.Write(LEVEL_0, "Access Modes:\t%s\n", api.GetAccessModesAsString(pv.Spec.AccessModes))
 		w.Write(LEVEL_0, "Capacity:\t%s\n", storage.String())
+		printVolumeNodeAffinity(w, pv.Spec.NodeAffinity)
 		w.Write(LEVEL_0, "Message:\t%s\n", pv.Status.Message)
 		w.Write(LEVEL_0, "Source:\n")
 
@@ -852,6 +896,10 @@ func (d *PersistentVolumeDescriber) Describe(namespace, name string, describerSe
 			printPhotonPersistentDiskVolumeSource(pv.Spec.PhotonPersistentDisk, w)
 		case pv.Spec.PortworxVolume != nil:
 			printPortworxVolumeSource(pv.Spec.PortworxVolume, w)
+		case pv.Spec.CSI != nil:
+			printCSIPersistentVolumeSource(pv.Spec.CSI, w)
+		case pv.Spec.Local != nil:
+			printLocalVolumeSource(pv.Spec.Local, w)
 		}
 
 		if events != nil {
@@ -896,6 +944,27 @@ func (d *PersistentVolumeClaimDescriber) Describe(namespace, name string, descri
 		printAnnotationsMultiline(w, "Annotations", pvc.Annotations)
 		w.Write(LEVEL_0, "Capacity:\t%s\n", capacity)
 		w.Write(LEVEL_0, "Access Modes:\t%s\n", accessModes)
+		if pvc.Spec.DataSource != nil {
+			w.Write(LEVEL_0, "DataSource:\n")
+			if pvc.Spec.DataSource.APIGroup != nil {
+				w.Write(LEVEL_1, "APIGroup:\t%v\n", *pvc.Spec.DataSource.APIGroup)
+			}
+			w.Write(LEVEL_1, "Kind:\t%v\n", pvc.Spec.DataSource.Kind)
+			w.Write(LEVEL_1, "Name:\t%v\n", pvc.Spec.DataSource.Name)
+		}
+		if len(pvc.Status.Conditions) > 0 {
+			w.Write(LEVEL_0, "Conditions:\n  Type\tStatus\tLastProbeTime\tLastTransitionTime\tReason\tMessage\n")
+			w.Write(LEVEL_1, "----\t------\t-------------\t------------------\t------\t-------\n")
+			for _, c := range pvc.Status.Conditions {
+				w.Write(LEVEL_1, "%v \t%v \t%s \t%s \t%v \t%v\n",
+					c.Type,
+					c.Status,
+					c.LastProbeTime.Time.Format(time.RFC1123Z),
+					c.LastTransitionTime.Time.Format(time.RFC1123Z),
+					c.Reason,
+					c.Message)
+			}
+		}
 		if events != nil {
 			DescribeEvents(events, w)
 		}
@@ -1005,6 +1074,9 @@ func describeContainerState(status api.ContainerStatus, w *PrefixWriter) {
 		describeStatus("Last State", status.LastTerminationState, w)
 	}
 	w.Write(LEVEL_2, "Ready:\t%v\n", printBool(status.Ready))
+	if status.Started != nil {
+		w.Write(LEVEL_2, "Started:\t%v\n", printBool(*status.Started))
+	}
 	w.Write(LEVEL_2, "Restart Count:\t%d\n", status.RestartCount)
 }
 
@@ -1017,6 +1089,10 @@ func describeContainerProbe(container api.Container, w *PrefixWriter) {
 		probe := DescribeProbe(container.ReadinessProbe)
 		w.Write(LEVEL_2, "Readiness:\t%s\n", probe)
 	}
+	if container.StartupProbe != nil {
+		probe := DescribeProbe(container.StartupProbe)
+		w.Write(LEVEL_2, "Startup:\t%s\n", probe)
+	}
 }
 
 func describeContainerVolumes(container api.Container, w *PrefixWriter) {
@@ -1126,6 +1202,11 @@ func DescribeProbe(probe *api.Probe) string {
 		return fmt.Sprintf("http-get %s %s", url.String(), attrs)
 	case probe.TCPSocket != nil:
 		return fmt.Sprintf("tcp-socket :%s %s", probe.TCPSocket.Port.String(), attrs)
+	case probe.GRPC != nil:
+		if probe.GRPC.Service != nil && len(*probe.GRPC.Service) > 0 {
+			return fmt.Sprintf("grpc <pod>:%d service=%s %s", probe.GRPC.Port, *probe.GRPC.Service, attrs)
+		}
+		return fmt.Sprintf("grpc <pod>:%d %s", probe.GRPC.Port, attrs)
 	}
 	return fmt.Sprintf("unknown %s", attrs)
 }
@@ -1354,6 +1435,9 @@ func describeJob(job *batch.Job, events *api.EventList) (string, error) {
 		} else {
 			w.Write(LEVEL_0, "Completions:\t<unset>\n")
 		}
+		if job.Spec.CompletionMode != nil {
+			w.Write(LEVEL_0, "Completion Mode:\t%s\n", *job.Spec.CompletionMode)
+		}
 		if job.Status.StartTime != nil {
 			w.Write(LEVEL_0, "Start Time:\t%s\n", job.Status.StartTime.Time.Format(time.RFC1123Z))
 		}
@@ -1363,6 +1447,13 @@ func describeJob(job *batch.Job, events *api.EventList) (string, error) {
 		printLabelsMultiline(w, "Labels", job.Labels)
 		printAnnotationsMultiline(w, "Annotations", job.Annotations)
 		w.Write(LEVEL_0, "Pods Statuses:\t%d Running / %d Succeeded / %d Failed\n", job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+		if job.Spec.CompletionMode != nil && *job.Spec.CompletionMode == batch.IndexedCompletion {
+			completedIndexes := job.Status.CompletedIndexes
+			if len(completedIndexes) == 0 {
+				completedIndexes = "<none>"
+			}
+			w.Write(LEVEL_0, "Completed Indexes:\t%s\n", completedIndexes)
+		}
 		describeVolumes(job.Spec.Template.Spec.Volumes, w, "")
 		if events != nil {
 			DescribeEvents(events, w)
@@ -1396,7 +1487,16 @@ func describeCronJob(scheduledJob *batch.CronJob, events *api.EventList) (string
 		w.Write(LEVEL_0, "Name:\t%s\n", scheduledJob.Name)
 		w.Write(LEVEL_0, "Namespace:\t%s\n", scheduledJob.Namespace)
 		w.Write(LEVEL_0, "Schedule:\t%s\n", scheduledJob.Spec.Schedule)
+		if scheduledJob.Spec.TimeZone != nil {
+			w.Write(LEVEL_0, "Time Zone:\t%s\n", *scheduledJob.Spec.TimeZone)
+		} else {
+			w.Write(LEVEL_0, "Time Zone:\t<unset>\n")
+		}
 		w.Write(LEVEL_0, "Concurrency Policy:\t%s\n", scheduledJob.Spec.ConcurrencyPolicy)
+		w.Write(LEVEL_0, "Catch Up Policy:\t%s\n", scheduledJob.Spec.CatchUpPolicy)
+		if scheduledJob.Spec.CatchUpLimit != nil {
+			w.Write(LEVEL_0, "Catch Up Limit:\t%d\n", *scheduledJob.Spec.CatchUpLimit)
+		}
 		w.Write(LEVEL_0, "Suspend:\t%s\n", printBoolPtr(scheduledJob.Spec.Suspend))
 		if scheduledJob.Spec.StartingDeadlineSeconds != nil {
 			w.Write(LEVEL_0, "Starting Deadline Seconds:\t%ds\n", *scheduledJob.Spec.StartingDeadlineSeconds)
@@ -1411,6 +1511,9 @@ func describeCronJob(scheduledJob *batch.CronJob, events *api.EventList) (string
 		} else {
 			w.Write(LEVEL_0, "Last Schedule Time:\t<unset>\n")
 		}
+		if scheduledJob.Status.LastMissedTime != nil {
+			w.Write(LEVEL_0, "Last Missed Time:\t%s\n", scheduledJob.Status.LastMissedTime.Time.Format(time.RFC1123Z))
+		}
 		printActiveJobs(w, "Active Jobs", scheduledJob.Status.Active)
 		if events != nil {
 			DescribeEvents(events, w)
@@ -2203,6 +2306,24 @@ func (d *HorizontalPodAutoscalerDescriber) Describe(namespace, name string, desc
 					current = hpa.Status.CurrentMetrics[i].Object.CurrentValue.String()
 				}
 				w.Write(LEVEL_1, "%q on %s/%s:\t%s / %s\n", metric.Object.MetricName, metric.Object.Target.Kind, metric.Object.Target.Name, current, metric.Object.TargetValue.String())
+			case autoscaling.ExternalMetricSourceType:
+				if metric.External.TargetAverageValue != nil {
+					current := "<unknown>"
+					if len(hpa.Status.CurrentMetrics) > i && hpa.Status.CurrentMetrics[i].External != nil && hpa.Status.CurrentMetrics[i].External.CurrentAverageValue != nil {
+						current = hpa.Status.CurrentMetrics[i].External.CurrentAverageValue.String()
+					}
+					w.Write(LEVEL_1, "%q (target average value):\t%s / %s\n", metric.External.MetricName, current, metric.External.TargetAverageValue.String())
+				} else {
+					current := "<unknown>"
+					if len(hpa.Status.CurrentMetrics) > i && hpa.Status.CurrentMetrics[i].External != nil {
+						current = hpa.Status.CurrentMetrics[i].External.CurrentValue.String()
+					}
+					target := "<unset>"
+					if metric.External.TargetValue != nil {
+						target = metric.External.TargetValue.String()
+					}
+					w.Write(LEVEL_1, "%q (target value):\t%s / %s\n", metric.External.MetricName, current, target)
+				}
 			case autoscaling.ResourceMetricSourceType:
 				w.Write(LEVEL_1, "resource %s on pods", string(metric.Resource.Name))
 				if metric.Resource.TargetAverageValue != nil {
@@ -2233,6 +2354,11 @@ func (d *HorizontalPodAutoscalerDescriber) Describe(namespace, name string, desc
 		}
 		w.Write(LEVEL_0, "Min replicas:\t%s\n", minReplicas)
 		w.Write(LEVEL_0, "Max replicas:\t%d\n", hpa.Spec.MaxReplicas)
+		if hpa.Spec.Behavior != nil {
+			w.Write(LEVEL_0, "Behavior:\n")
+			describeHPAScalingRules(w, "Scale Up", hpa.Spec.Behavior.ScaleUp)
+			describeHPAScalingRules(w, "Scale Down", hpa.Spec.Behavior.ScaleDown)
+		}
 
 		// TODO: switch to scale subresource once the required code is submitted.
 		if strings.ToLower(hpa.Spec.ScaleTargetRef.Kind) == "replicationcontroller" {
@@ -2245,6 +2371,15 @@ func (d *HorizontalPodAutoscalerDescriber) Describe(namespace, name string, desc
 			}
 		}
 
+		if len(hpa.Status.Conditions) > 0 {
+			w.Write(LEVEL_0, "Conditions:\n")
+			w.Write(LEVEL_1, "Type\tStatus\tReason\tMessage\n")
+			w.Write(LEVEL_1, "----\t------\t------\t-------\n")
+			for _, c := range hpa.Status.Conditions {
+				w.Write(LEVEL_1, "%v\t%v\t%v\t%v\n", c.Type, c.Status, c.Reason, c.Message)
+			}
+		}
+
 		if describerSettings.ShowEvents {
 			events, _ := d.client.Core().Events(namespace).Search(api.Scheme, hpa)
 			if events != nil {
@@ -2255,6 +2390,26 @@ func (d *HorizontalPodAutoscalerDescriber) Describe(namespace, name string, desc
 	})
 }
 
+func describeHPAScalingRules(w *PrefixWriter, direction string, rules *autoscaling.HPAScalingRules) {
+	if rules == nil {
+		w.Write(LEVEL_1, "%s:\t<default>\n", direction)
+		return
+	}
+	w.Write(LEVEL_1, "%s:\n", direction)
+	if rules.StabilizationWindowSeconds != nil {
+		w.Write(LEVEL_2, "Stabilization Window:\t%d seconds\n", *rules.StabilizationWindowSeconds)
+	}
+	if rules.SelectPolicy != nil {
+		w.Write(LEVEL_2, "Select Policy:\t%s\n", *rules.SelectPolicy)
+	}
+	if len(rules.Policies) > 0 {
+		w.Write(LEVEL_2, "Policies:\n")
+		for _, policy := range rules.Policies {
+			w.Write(LEVEL_3, "- Type: %s\tValue: %d\tPeriod: %d seconds\n", policy.Type, policy.Value, policy.PeriodSeconds)
+		}
+	}
+}
+
 func describeNodeResource(nodeNonTerminatedPodsList *api.PodList, node *api.Node, w *PrefixWriter) error {
 	w.Write(LEVEL_0, "Non-terminated Pods:\t(%d in total)\n", len(nodeNonTerminatedPodsList.Items))
 	w.Write(LEVEL_1, "Namespace\tName\t\tCPU Requests\tCPU Limits\tMemory Requests\tMemory Limits\n")
@@ -2614,6 +2769,12 @@ func (s *StorageClassDescriber) Describe(namespace, name string, describerSettin
 		w.Write(LEVEL_0, "Annotations:\t%s\n", labels.FormatLabels(sc.Annotations))
 		w.Write(LEVEL_0, "Provisioner:\t%s\n", sc.Provisioner)
 		w.Write(LEVEL_0, "Parameters:\t%s\n", labels.FormatLabels(sc.Parameters))
+		if sc.VolumeBindingMode != nil {
+			w.Write(LEVEL_0, "VolumeBindingMode:\t%s\n", *sc.VolumeBindingMode)
+		}
+		if sc.AllowVolumeExpansion != nil {
+			w.Write(LEVEL_0, "AllowVolumeExpansion:\t%t\n", *sc.AllowVolumeExpansion)
+		}
 		if describerSettings.ShowEvents {
 			events, err := s.Core().Events(namespace).Search(api.Scheme, sc)
 			if err != nil {
@@ -645,6 +645,29 @@ func TestPersistentVolumeDescriber(t *testing.T) {
 				},
 			},
 		},
+		"local": {
+			ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+			Spec: api.PersistentVolumeSpec{
+				PersistentVolumeSource: api.PersistentVolumeSource{
+					Local: &api.LocalVolumeSource{},
+				},
+				NodeAffinity: &api.VolumeNodeAffinity{
+					Required: &api.NodeSelector{
+						NodeSelectorTerms: []api.NodeSelectorTerm{
+							{
+								MatchExpressions: []api.NodeSelectorRequirement{
+									{
+										Key:      "foo",
+										Operator: "In",
+										Values:   []string{"val1", "val2"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, pv := range tests {
@@ -718,6 +741,8 @@ func TestDescribeCluster(t *testing.T) {
 }
 
 func TestDescribeStorageClass(t *testing.T) {
+	volumeBindingMode := storage.VolumeBindingWaitForFirstConsumer
+	allowVolumeExpansion := true
 	f := fake.NewSimpleClientset(&storage.StorageClass{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "foo",
@@ -731,13 +756,66 @@ func TestDescribeStorageClass(t *testing.T) {
 			"param1": "value1",
 			"param2": "value2",
 		},
+		VolumeBindingMode:    &volumeBindingMode,
+		AllowVolumeExpansion: &allowVolumeExpansion,
 	})
 	s := StorageClassDescriber{f}
 	out, err := s.Describe("", "foo", printers.DescriberSettings{ShowEvents: true})
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
-	if !strings.Contains(out, "foo") {
+	if !strings.Contains(out, "foo") || !strings.Contains(out, "WaitForFirstConsumer") || !strings.Contains(out, "AllowVolumeExpansion:\ttrue") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribePersistentVolumeClaimConditions(t *testing.T) {
+	f := fake.NewSimpleClientset(&api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			VolumeName: "volume",
+		},
+		Status: api.PersistentVolumeClaimStatus{
+			Phase: api.ClaimBound,
+			Conditions: []api.PersistentVolumeClaimCondition{
+				{Type: api.PersistentVolumeClaimFileSystemResizePending, Status: api.ConditionTrue},
+			},
+		},
+	})
+	d := PersistentVolumeClaimDescriber{f}
+	out, err := d.Describe("foo", "bar", printers.DescriberSettings{ShowEvents: true})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "Conditions:") || !strings.Contains(out, "FileSystemResizePending") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribePersistentVolumeClaimDataSource(t *testing.T) {
+	apiGroup := "storage.k8s.io"
+	f := fake.NewSimpleClientset(&api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			DataSource: &api.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VolumeSnapshot",
+				Name:     "snapshot",
+			},
+		},
+	})
+	d := PersistentVolumeClaimDescriber{f}
+	out, err := d.Describe("foo", "bar", printers.DescriberSettings{ShowEvents: true})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "DataSource:") || !strings.Contains(out, "VolumeSnapshot") || !strings.Contains(out, "snapshot") {
 		t.Errorf("unexpected out: %s", out)
 	}
 }
@@ -770,6 +848,8 @@ func TestDescribeHorizontalPodAutoscaler(t *testing.T) {
 	minReplicasVal := int32(2)
 	targetUtilizationVal := int32(80)
 	currentUtilizationVal := int32(50)
+	stabilizationWindowVal := int32(600)
+	minPolicyVal := autoscaling.MinPolicySelect
 	tests := []struct {
 		name string
 		hpa  autoscaling.HorizontalPodAutoscaler
@@ -924,6 +1004,76 @@ func TestDescribeHorizontalPodAutoscaler(t *testing.T) {
 				},
 			},
 		},
+		{
+			"external source type, target average value (no current)",
+			autoscaling.HorizontalPodAutoscaler{
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{
+						Name: "some-rc",
+						Kind: "ReplicationController",
+					},
+					MinReplicas: &minReplicasVal,
+					MaxReplicas: 10,
+					Metrics: []autoscaling.MetricSpec{
+						{
+							Type: autoscaling.ExternalMetricSourceType,
+							External: &autoscaling.ExternalMetricSource{
+								MetricSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"label": "value"},
+								},
+								MetricName:         "some-external-metric",
+								TargetAverageValue: resource.NewMilliQuantity(100, resource.DecimalSI),
+							},
+						},
+					},
+				},
+				Status: autoscaling.HorizontalPodAutoscalerStatus{
+					CurrentReplicas: 4,
+					DesiredReplicas: 5,
+				},
+			},
+		},
+		{
+			"external source type, target value (with current)",
+			autoscaling.HorizontalPodAutoscaler{
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{
+						Name: "some-rc",
+						Kind: "ReplicationController",
+					},
+					MinReplicas: &minReplicasVal,
+					MaxReplicas: 10,
+					Metrics: []autoscaling.MetricSpec{
+						{
+							Type: autoscaling.ExternalMetricSourceType,
+							External: &autoscaling.ExternalMetricSource{
+								MetricSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"label": "value"},
+								},
+								MetricName:  "some-external-metric",
+								TargetValue: resource.NewMilliQuantity(100, resource.DecimalSI),
+							},
+						},
+					},
+				},
+				Status: autoscaling.HorizontalPodAutoscalerStatus{
+					CurrentReplicas: 4,
+					DesiredReplicas: 5,
+					CurrentMetrics: []autoscaling.MetricStatus{
+						{
+							Type: autoscaling.ExternalMetricSourceType,
+							External: &autoscaling.ExternalMetricStatus{
+								MetricSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"label": "value"},
+								},
+								MetricName:   "some-external-metric",
+								CurrentValue: *resource.NewMilliQuantity(50, resource.DecimalSI),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			"resource source type, target average value (no current)",
 			autoscaling.HorizontalPodAutoscaler{
@@ -1104,6 +1254,41 @@ func TestDescribeHorizontalPodAutoscaler(t *testing.T) {
 				},
 			},
 		},
+		{
+			"scaling behavior and conditions",
+			autoscaling.HorizontalPodAutoscaler{
+				Spec: autoscaling.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscaling.CrossVersionObjectReference{
+						Name: "some-rc",
+						Kind: "ReplicationController",
+					},
+					MinReplicas: &minReplicasVal,
+					MaxReplicas: 10,
+					Behavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+						ScaleDown: &autoscaling.HPAScalingRules{
+							StabilizationWindowSeconds: &stabilizationWindowVal,
+							SelectPolicy:               &minPolicyVal,
+							Policies: []autoscaling.HPAScalingPolicy{
+								{Type: autoscaling.PodsScalingPolicy, Value: 1, PeriodSeconds: 60},
+								{Type: autoscaling.PercentScalingPolicy, Value: 10, PeriodSeconds: 60},
+							},
+						},
+					},
+				},
+				Status: autoscaling.HorizontalPodAutoscalerStatus{
+					CurrentReplicas: 4,
+					DesiredReplicas: 5,
+					Conditions: []autoscaling.HorizontalPodAutoscalerCondition{
+						{
+							Type:    autoscaling.ScalingLimited,
+							Status:  api.ConditionTrue,
+							Reason:  "ScaleDownLimit",
+							Message: "the desired replica count is decreasing faster than the maximum scale rate",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,86 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/kubernetes/pkg/probe"
+
+	"github.com/golang/glog"
+)
+
+func New() GRPCProber {
+	return grpcProber{}
+}
+
+type GRPCProber interface {
+	Probe(host, service string, port int, timeout time.Duration) (probe.Result, string, error)
+}
+
+type grpcProber struct{}
+
+// Probe checks the health of a gRPC service using the standard gRPC health
+// checking protocol (grpc.health.v1.Health/Check).
+func (pr grpcProber) Probe(host, service string, port int, timeout time.Duration) (probe.Result, string, error) {
+	return DoGRPCProbe(net.JoinHostPort(host, strconv.Itoa(port)), service, timeout)
+}
+
+// DoGRPCProbe checks that the service at the address reports itself as serving.
+// If the service responds with SERVING, it returns Success.
+// If the service cannot be reached, does not implement the health checking
+// protocol, or responds with any other status, it returns Failure.
+// This is exported because some other packages may want to do direct gRPC probes.
+func DoGRPCProbe(addr, service string, timeout time.Duration) (probe.Result, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithUserAgent("kube-probe"))
+	if err != nil {
+		// Convert errors to failures to handle timeouts.
+		return probe.Failure, fmt.Sprintf("failed to connect to gRPC service %q: %v", addr, err), nil
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			glog.Errorf("Unexpected error closing gRPC probe connection: %v", err)
+		}
+	}()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		switch grpc.Code(err) {
+		case codes.Unimplemented:
+			return probe.Failure, fmt.Sprintf("gRPC service %q does not implement the grpc.health.v1.Health service", addr), nil
+		case codes.DeadlineExceeded:
+			return probe.Failure, fmt.Sprintf("timeout: health check on %q did not complete within %v", addr, timeout), nil
+		default:
+			return probe.Failure, fmt.Sprintf("health check on %q failed: %v", addr, err), nil
+		}
+	}
+
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return probe.Failure, fmt.Sprintf("service unhealthy (responded with %q)", resp.Status), nil
+	}
+	return probe.Success, "", nil
+}
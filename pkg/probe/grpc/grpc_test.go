@@ -0,0 +1,115 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/kubernetes/pkg/probe"
+)
+
+func TestGRPCHealthChecker(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("serving", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("not-serving", healthpb.HealthCheckResponse_NOT_SERVING)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	// A server that does not implement the health checking protocol.
+	plainListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	plainServer := grpc.NewServer()
+	go plainServer.Serve(plainListener)
+	defer plainServer.Stop()
+
+	port := func(l net.Listener) int {
+		_, portStr, err := net.SplitHostPort(l.Addr().String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return port
+	}
+
+	tests := []struct {
+		name           string
+		port           int
+		service        string
+		expectedStatus probe.Result
+		expectedOutput string
+	}{
+		{"default service", port(listener), "", probe.Success, ""},
+		{"serving service", port(listener), "serving", probe.Success, ""},
+		{"not serving service", port(listener), "not-serving", probe.Failure, "NOT_SERVING"},
+		{"unknown service", port(listener), "unknown", probe.Failure, "unknown service"},
+		{"health checking not implemented", port(plainListener), "", probe.Failure, "does not implement"},
+	}
+
+	prober := New()
+	for _, tt := range tests {
+		status, output, err := prober.Probe("127.0.0.1", tt.service, tt.port, 5*time.Second)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		}
+		if status != tt.expectedStatus {
+			t.Errorf("%s: expected status=%v, got=%v", tt.name, tt.expectedStatus, status)
+		}
+		if !strings.Contains(output, tt.expectedOutput) {
+			t.Errorf("%s: expected output to contain %q, got %q", tt.name, tt.expectedOutput, output)
+		}
+	}
+}
+
+func TestGRPCHealthCheckerConnectionFailure(t *testing.T) {
+	// Reserve a port and close it so nothing is listening on it.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	status, output, err := DoGRPCProbe(addr, "", 100*time.Millisecond)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if status != probe.Failure {
+		t.Errorf("expected status=%v, got=%v", probe.Failure, status)
+	}
+	if !strings.Contains(output, "failed to connect") {
+		t.Errorf("unexpected output: %q", output)
+	}
+}
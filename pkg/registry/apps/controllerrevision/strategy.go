@@ -0,0 +1,108 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllerrevision
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/apps"
+	"k8s.io/kubernetes/pkg/apis/apps/validation"
+)
+
+// strategy implements behavior for ControllerRevision objects
+type strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ControllerRevision
+// objects via the REST API.
+var Strategy = strategy{api.Scheme, names.SimpleNameGenerator}
+
+// NamespaceScoped is true for ControllerRevisions.
+func (strategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+func (strategy) PrepareForCreate(ctx genericapirequest.Context, obj runtime.Object) {
+	_ = obj.(*apps.ControllerRevision)
+}
+
+// Validate validates a new ControllerRevision.
+func (strategy) Validate(ctx genericapirequest.Context, obj runtime.Object) field.ErrorList {
+	revision := obj.(*apps.ControllerRevision)
+	return validation.ValidateControllerRevision(revision)
+}
+
+// Canonicalize normalizes the object after validation.
+func (strategy) Canonicalize(obj runtime.Object) {
+}
+
+// AllowCreateOnUpdate is false for ControllerRevisions.
+func (strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (strategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	_ = obj.(*apps.ControllerRevision)
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (strategy) ValidateUpdate(ctx genericapirequest.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldRevision, newRevision := oldObj.(*apps.ControllerRevision), newObj.(*apps.ControllerRevision)
+	return validation.ValidateControllerRevisionUpdate(newRevision, oldRevision)
+}
+
+// AllowUnconditionalUpdate is the default update policy for ControllerRevision objects.
+func (strategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// ControllerRevisionToSelectableFields returns a field set that represents the object.
+func ControllerRevisionToSelectableFields(revision *apps.ControllerRevision) fields.Set {
+	return generic.ObjectMetaFieldsSet(&revision.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	revision, ok := obj.(*apps.ControllerRevision)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a ControllerRevision.")
+	}
+	return labels.Set(revision.ObjectMeta.Labels), ControllerRevisionToSelectableFields(revision), nil
+}
+
+// MatchControllerRevision is the filter used by the generic etcd backend to watch events
+// from etcd to clients of the apiserver only interested in specific labels/fields.
+func MatchControllerRevision(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
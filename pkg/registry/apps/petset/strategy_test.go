@@ -50,8 +50,10 @@ func TestStatefulSetStrategy(t *testing.T) {
 	ps := &apps.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: metav1.NamespaceDefault},
 		Spec: apps.StatefulSetSpec{
-			Selector: &metav1.LabelSelector{MatchLabels: validSelector},
-			Template: validPodTemplate.Template,
+			Selector:            &metav1.LabelSelector{MatchLabels: validSelector},
+			Template:            validPodTemplate.Template,
+			PodManagementPolicy: apps.OrderedReadyPodManagement,
+			UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType},
 		},
 		Status: apps.StatefulSetStatus{Replicas: 3},
 	}
@@ -69,8 +71,10 @@ func TestStatefulSetStrategy(t *testing.T) {
 	validPs := &apps.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{Name: ps.Name, Namespace: ps.Namespace, ResourceVersion: "1", Generation: 1},
 		Spec: apps.StatefulSetSpec{
-			Selector: ps.Spec.Selector,
-			Template: validPodTemplate.Template,
+			Selector:            ps.Spec.Selector,
+			Template:            validPodTemplate.Template,
+			PodManagementPolicy: apps.OrderedReadyPodManagement,
+			UpdateStrategy:      apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType},
 		},
 		Status: apps.StatefulSetStatus{Replicas: 4},
 	}
@@ -80,6 +84,17 @@ func TestStatefulSetStrategy(t *testing.T) {
 		t.Errorf("Updating spec.Replicas is allowed on a statefulset: %v", errs)
 	}
 
+	// Switching to a rolling update with a partition is allowed
+	validPs.Spec.UpdateStrategy = apps.StatefulSetUpdateStrategy{
+		Type:          apps.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &apps.RollingUpdateStatefulSetStrategy{Partition: 1},
+	}
+	Strategy.PrepareForUpdate(ctx, validPs, ps)
+	errs = Strategy.ValidateUpdate(ctx, validPs, ps)
+	if len(errs) != 0 {
+		t.Errorf("Updating spec.UpdateStrategy is allowed on a statefulset: %v", errs)
+	}
+
 	validPs.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"a": "bar"}}
 	Strategy.PrepareForUpdate(ctx, validPs, ps)
 	errs = Strategy.ValidateUpdate(ctx, validPs, ps)
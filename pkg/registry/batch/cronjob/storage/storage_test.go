@@ -49,6 +49,7 @@ func validNewCronJob() *batch.CronJob {
 		Spec: batch.CronJobSpec{
 			Schedule:          "* * * * ?",
 			ConcurrencyPolicy: batch.AllowConcurrent,
+			CatchUpPolicy:     batch.RunLatestCatchUp,
 			JobTemplate: batch.JobTemplateSpec{
 				Spec: batch.JobSpec{
 					Template: api.PodTemplateSpec{
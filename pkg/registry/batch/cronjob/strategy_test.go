@@ -56,6 +56,7 @@ func TestCronJobStrategy(t *testing.T) {
 		Spec: batch.CronJobSpec{
 			Schedule:          "* * * * ?",
 			ConcurrencyPolicy: batch.AllowConcurrent,
+			CatchUpPolicy:     batch.RunLatestCatchUp,
 			JobTemplate: batch.JobTemplateSpec{
 				Spec: batch.JobSpec{
 					Template: validPodTemplateSpec,
@@ -119,6 +120,7 @@ func TestCronJobStatusStrategy(t *testing.T) {
 		Spec: batch.CronJobSpec{
 			Schedule:          oldSchedule,
 			ConcurrencyPolicy: batch.AllowConcurrent,
+			CatchUpPolicy:     batch.RunLatestCatchUp,
 			JobTemplate: batch.JobTemplateSpec{
 				Spec: batch.JobSpec{
 					Template: validPodTemplateSpec,
@@ -136,6 +138,7 @@ func TestCronJobStatusStrategy(t *testing.T) {
 		Spec: batch.CronJobSpec{
 			Schedule:          "5 5 * * ?",
 			ConcurrencyPolicy: batch.AllowConcurrent,
+			CatchUpPolicy:     batch.RunLatestCatchUp,
 			JobTemplate: batch.JobTemplateSpec{
 				Spec: batch.JobSpec{
 					Template: validPodTemplateSpec,
@@ -62,6 +62,9 @@ const (
 	ServiceAccounts            Resource = "serviceaccounts"
 	Services                   Resource = "services"
 	StorageClasses             Resource = "storageclasses"
+	VolumeAttachments          Resource = "volumeattachments"
+	VolumeSnapshots            Resource = "volumesnapshots"
+	VolumeSnapshotContents     Resource = "volumesnapshotcontents"
 )
 
 // TODO: This shouldn't be a global variable.
@@ -97,6 +97,13 @@ func (r *EvictionREST) Create(ctx genericapirequest.Context, obj runtime.Object)
 		} else if len(pdbs) == 1 {
 			pdb := pdbs[0]
 			pdbName = pdb.Name
+
+			// Unhealthy pods don't count towards the budget, so they may be
+			// deleted without consuming a disruption, depending on the policy.
+			if canEvictUnhealthyPod(pod, &pdb) {
+				return nil
+			}
+
 			// Try to verify-and-decrement
 
 			// If it was false already, or if it becomes false during the course of our retries,
@@ -145,6 +152,23 @@ func (r *EvictionREST) Create(ctx genericapirequest.Context, obj runtime.Object)
 	return &metav1.Status{Status: metav1.StatusSuccess}, nil
 }
 
+// canEvictUnhealthyPod returns true if the given pod is running but not ready,
+// and the unhealthy pod eviction policy of the given PodDisruptionBudget allows
+// evicting it without consuming a disruption.
+func canEvictUnhealthyPod(pod *api.Pod, pdb *policy.PodDisruptionBudget) bool {
+	if pod.Status.Phase != api.PodRunning || api.IsPodReady(pod) {
+		return false
+	}
+	if pdb.Spec.UnhealthyPodEvictionPolicy != nil && *pdb.Spec.UnhealthyPodEvictionPolicy == policy.AlwaysAllow {
+		return true
+	}
+	// IfHealthyBudget, the default: the status must be up to date and
+	// the guarded application must not be disrupted already.
+	return pdb.Status.ObservedGeneration >= pdb.Generation &&
+		pdb.Status.DesiredHealthy > 0 &&
+		pdb.Status.CurrentHealthy >= pdb.Status.DesiredHealthy
+}
+
 // checkAndDecrement checks if the provided PodDisruptionBudget allows any disruption.
 func (r *EvictionREST) checkAndDecrement(namespace string, podName string, pdb policy.PodDisruptionBudget) (ok bool, err error) {
 	if pdb.Status.ObservedGeneration < pdb.Generation {
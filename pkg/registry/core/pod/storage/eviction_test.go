@@ -0,0 +1,79 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/policy"
+)
+
+func TestCanEvictUnhealthyPod(t *testing.T) {
+	ifHealthyBudget := policy.IfHealthyBudget
+	alwaysAllow := policy.AlwaysAllow
+
+	readyPod := &api.Pod{
+		Status: api.PodStatus{
+			Phase:      api.PodRunning,
+			Conditions: []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}},
+		},
+	}
+	unreadyPod := &api.Pod{
+		Status: api.PodStatus{
+			Phase:      api.PodRunning,
+			Conditions: []api.PodCondition{{Type: api.PodReady, Status: api.ConditionFalse}},
+		},
+	}
+	pendingPod := &api.Pod{
+		Status: api.PodStatus{Phase: api.PodPending},
+	}
+	pdb := func(evictionPolicy *policy.UnhealthyPodEvictionPolicyType, currentHealthy, desiredHealthy int32, generation, observedGeneration int64) *policy.PodDisruptionBudget {
+		return &policy.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Generation: generation},
+			Spec:       policy.PodDisruptionBudgetSpec{UnhealthyPodEvictionPolicy: evictionPolicy},
+			Status: policy.PodDisruptionBudgetStatus{
+				ObservedGeneration: observedGeneration,
+				CurrentHealthy:     currentHealthy,
+				DesiredHealthy:     desiredHealthy,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		pod      *api.Pod
+		pdb      *policy.PodDisruptionBudget
+		expected bool
+	}{
+		{"ready pod, always allow", readyPod, pdb(&alwaysAllow, 0, 3, 1, 1), false},
+		{"pending pod, always allow", pendingPod, pdb(&alwaysAllow, 0, 3, 1, 1), false},
+		{"unready pod, always allow, disrupted", unreadyPod, pdb(&alwaysAllow, 1, 3, 1, 1), true},
+		{"unready pod, if healthy budget, healthy", unreadyPod, pdb(&ifHealthyBudget, 3, 3, 1, 1), true},
+		{"unready pod, if healthy budget, disrupted", unreadyPod, pdb(&ifHealthyBudget, 2, 3, 1, 1), false},
+		{"unready pod, if healthy budget, stale status", unreadyPod, pdb(&ifHealthyBudget, 3, 3, 2, 1), false},
+		{"unready pod, default policy, healthy", unreadyPod, pdb(nil, 4, 3, 1, 1), true},
+		{"unready pod, default policy, disrupted", unreadyPod, pdb(nil, 2, 3, 1, 1), false},
+		{"unready pod, default policy, status not computed", unreadyPod, pdb(nil, 0, 0, 1, 1), false},
+	}
+	for _, test := range tests {
+		if actual := canEvictUnhealthyPod(test.pod, test.pdb); actual != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+	}
+}
@@ -26,6 +26,9 @@ import (
 	storageapiv1 "k8s.io/kubernetes/pkg/apis/storage/v1"
 	storageapiv1beta1 "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
 	storageclassstore "k8s.io/kubernetes/pkg/registry/storage/storageclass/storage"
+	volumeattachmentstore "k8s.io/kubernetes/pkg/registry/storage/volumeattachment/storage"
+	volumesnapshotstore "k8s.io/kubernetes/pkg/registry/storage/volumesnapshot/storage"
+	volumesnapshotcontentstore "k8s.io/kubernetes/pkg/registry/storage/volumesnapshotcontent/storage"
 )
 
 type RESTStorageProvider struct {
@@ -55,6 +58,20 @@ func (p RESTStorageProvider) v1beta1Storage(apiResourceConfigSource serverstorag
 		storageClassStorage := storageclassstore.NewREST(restOptionsGetter)
 		storage["storageclasses"] = storageClassStorage
 	}
+	if apiResourceConfigSource.ResourceEnabled(version.WithResource("volumeattachments")) {
+		volumeAttachmentStorage, volumeAttachmentStatusStorage := volumeattachmentstore.NewREST(restOptionsGetter)
+		storage["volumeattachments"] = volumeAttachmentStorage
+		storage["volumeattachments/status"] = volumeAttachmentStatusStorage
+	}
+	if apiResourceConfigSource.ResourceEnabled(version.WithResource("volumesnapshots")) {
+		volumeSnapshotStorage, volumeSnapshotStatusStorage := volumesnapshotstore.NewREST(restOptionsGetter)
+		storage["volumesnapshots"] = volumeSnapshotStorage
+		storage["volumesnapshots/status"] = volumeSnapshotStatusStorage
+	}
+	if apiResourceConfigSource.ResourceEnabled(version.WithResource("volumesnapshotcontents")) {
+		volumeSnapshotContentStorage := volumesnapshotcontentstore.NewREST(restOptionsGetter)
+		storage["volumesnapshotcontents"] = volumeSnapshotContentStorage
+	}
 
 	return storage
 }
@@ -0,0 +1,193 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	etcdtesting "k8s.io/apiserver/pkg/storage/etcd/testing"
+	"k8s.io/kubernetes/pkg/api"
+	storageapi "k8s.io/kubernetes/pkg/apis/storage"
+	"k8s.io/kubernetes/pkg/registry/registrytest"
+)
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *etcdtesting.EtcdTestServer) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, storageapi.GroupName)
+	restOptions := generic.RESTOptions{
+		StorageConfig:           etcdStorage,
+		Decorator:               generic.UndecoratedStorage,
+		DeleteCollectionWorkers: 1,
+		ResourcePrefix:          "volumeattachments",
+	}
+	volumeAttachmentStorage, statusStorage := NewREST(restOptions)
+	return volumeAttachmentStorage, statusStorage, server
+}
+
+func validNewVolumeAttachment(name string) *storageapi.VolumeAttachment {
+	pvName := "foo"
+	return &storageapi.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: storageapi.VolumeAttachmentSpec{
+			Attacher: "valid-attacher",
+			Source: storageapi.VolumeAttachmentSource{
+				PersistentVolumeName: &pvName,
+			},
+			NodeName: "valid-node",
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.Store.DestroyFunc()
+	test := registrytest.New(t, storage.Store).ClusterScope()
+	volumeAttachment := validNewVolumeAttachment("foo")
+	volumeAttachment.ObjectMeta = metav1.ObjectMeta{GenerateName: "foo"}
+	pvName := "foo"
+	test.TestCreate(
+		// valid
+		volumeAttachment,
+		// invalid
+		&storageapi.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{Name: "*BadName!"},
+			Spec: storageapi.VolumeAttachmentSpec{
+				Attacher: "invalid-attacher-!@#$%^&*()",
+				Source: storageapi.VolumeAttachmentSource{
+					PersistentVolumeName: &pvName,
+				},
+				NodeName: "invalid-node-!@#$%^&*()",
+			},
+		},
+	)
+}
+
+func TestUpdate(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.Store.DestroyFunc()
+	test := registrytest.New(t, storage.Store).ClusterScope()
+	test.TestUpdate(
+		// valid
+		validNewVolumeAttachment("foo"),
+		// updateFunc
+		func(obj runtime.Object) runtime.Object {
+			object := obj.(*storageapi.VolumeAttachment)
+			object.ObjectMeta.Labels = map[string]string{"foo": "bar"}
+			return object
+		},
+		//invalid update
+		func(obj runtime.Object) runtime.Object {
+			object := obj.(*storageapi.VolumeAttachment)
+			object.Spec.Attacher = "invalid-attacher-!@#$%^&*()"
+			return object
+		},
+	)
+}
+
+func TestStatusUpdate(t *testing.T) {
+	storage, statusStorage, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.Store.DestroyFunc()
+	ctx := genericapirequest.NewContext()
+	key, _ := storage.KeyFunc(ctx, "foo")
+	volumeAttachment := validNewVolumeAttachment("foo")
+	if err := storage.Storage.Create(ctx, key, volumeAttachment, nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	obj, err := storage.Get(ctx, "foo", &metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get volume attachment: %v", err)
+	}
+	update := obj.(*storageapi.VolumeAttachment)
+	update.Spec.NodeName = "other-node"
+	update.Status.Attached = true
+
+	if _, _, err := statusStorage.Update(ctx, update.Name, rest.DefaultUpdatedObjectInfo(update, api.Scheme)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = storage.Get(ctx, "foo", &metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	volumeAttachmentOut := obj.(*storageapi.VolumeAttachment)
+	if volumeAttachmentOut.Spec.NodeName != "valid-node" {
+		t.Errorf("we expected .spec.nodeName to not be updated but it was updated to %v", volumeAttachmentOut.Spec.NodeName)
+	}
+	if !volumeAttachmentOut.Status.Attached {
+		t.Errorf("we expected .status.attached to be updated to true but it was false")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.Store.DestroyFunc()
+	test := registrytest.New(t, storage.Store).ClusterScope().ReturnDeletedObject()
+	test.TestDelete(validNewVolumeAttachment("foo"))
+}
+
+func TestGet(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.Store.DestroyFunc()
+	test := registrytest.New(t, storage.Store).ClusterScope()
+	test.TestGet(validNewVolumeAttachment("foo"))
+}
+
+func TestList(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.Store.DestroyFunc()
+	test := registrytest.New(t, storage.Store).ClusterScope()
+	test.TestList(validNewVolumeAttachment("foo"))
+}
+
+func TestWatch(t *testing.T) {
+	storage, _, server := newStorage(t)
+	defer server.Terminate(t)
+	defer storage.Store.DestroyFunc()
+	test := registrytest.New(t, storage.Store).ClusterScope()
+	test.TestWatch(
+		validNewVolumeAttachment("foo"),
+		// matching labels
+		[]labels.Set{},
+		// not matching labels
+		[]labels.Set{
+			{"foo": "bar"},
+		},
+		// matching fields
+		[]fields.Set{
+			{"metadata.name": "foo"},
+		},
+		// not matching fields
+		[]fields.Set{
+			{"metadata.name": "bar"},
+		},
+	)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeattachment
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/storage"
+	"k8s.io/kubernetes/pkg/apis/storage/validation"
+)
+
+// volumeAttachmentStrategy implements behavior for VolumeAttachment objects
+type volumeAttachmentStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating
+// VolumeAttachment objects via the REST API.
+var Strategy = volumeAttachmentStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (volumeAttachmentStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate clears the Status field which is not allowed to be set by end users on creation.
+func (volumeAttachmentStrategy) PrepareForCreate(ctx genericapirequest.Context, obj runtime.Object) {
+	volumeAttachment := obj.(*storage.VolumeAttachment)
+	volumeAttachment.Status = storage.VolumeAttachmentStatus{}
+}
+
+func (volumeAttachmentStrategy) Validate(ctx genericapirequest.Context, obj runtime.Object) field.ErrorList {
+	volumeAttachment := obj.(*storage.VolumeAttachment)
+	return validation.ValidateVolumeAttachment(volumeAttachment)
+}
+
+// Canonicalize normalizes the object after validation.
+func (volumeAttachmentStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (volumeAttachmentStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// PrepareForUpdate sets the Status fields which is not allowed to be set by an end user updating a VolumeAttachment
+func (volumeAttachmentStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newVolumeAttachment := obj.(*storage.VolumeAttachment)
+	oldVolumeAttachment := old.(*storage.VolumeAttachment)
+	newVolumeAttachment.Status = oldVolumeAttachment.Status
+}
+
+func (volumeAttachmentStrategy) ValidateUpdate(ctx genericapirequest.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateVolumeAttachmentUpdate(obj.(*storage.VolumeAttachment), old.(*storage.VolumeAttachment))
+}
+
+func (volumeAttachmentStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// volumeAttachmentStatusStrategy implements behavior for the status
+// subresource, which is written by the external attacher.
+type volumeAttachmentStatusStrategy struct {
+	volumeAttachmentStrategy
+}
+
+// StatusStrategy is the default logic that applies when updating the status of
+// VolumeAttachment objects via the REST API.
+var StatusStrategy = volumeAttachmentStatusStrategy{Strategy}
+
+// PrepareForUpdate sets the Spec field which is not allowed to be changed when updating a VolumeAttachment's Status
+func (volumeAttachmentStatusStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newVolumeAttachment := obj.(*storage.VolumeAttachment)
+	oldVolumeAttachment := old.(*storage.VolumeAttachment)
+	newVolumeAttachment.Spec = oldVolumeAttachment.Spec
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	volumeAttachment, ok := obj.(*storage.VolumeAttachment)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not of type VolumeAttachment")
+	}
+	return labels.Set(volumeAttachment.ObjectMeta.Labels), VolumeAttachmentToSelectableFields(volumeAttachment), nil
+}
+
+// MatchVolumeAttachments returns a generic matcher for a given label and field selector.
+func MatchVolumeAttachments(label labels.Selector, field fields.Selector) apistorage.SelectionPredicate {
+	return apistorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// VolumeAttachmentToSelectableFields returns a field set that represents the object
+func VolumeAttachmentToSelectableFields(volumeAttachment *storage.VolumeAttachment) fields.Set {
+	return generic.ObjectMetaFieldsSet(&volumeAttachment.ObjectMeta, false)
+}
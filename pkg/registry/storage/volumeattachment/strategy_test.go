@@ -0,0 +1,109 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeattachment
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/kubernetes/pkg/apis/storage"
+)
+
+func getValidVolumeAttachment(name string) *storage.VolumeAttachment {
+	pvName := "valid-pv"
+	return &storage.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: storage.VolumeAttachmentSpec{
+			Attacher: "valid-attacher",
+			Source: storage.VolumeAttachmentSource{
+				PersistentVolumeName: &pvName,
+			},
+			NodeName: "valid-node",
+		},
+	}
+}
+
+func TestVolumeAttachmentStrategy(t *testing.T) {
+	ctx := genericapirequest.NewDefaultContext()
+	if Strategy.NamespaceScoped() {
+		t.Errorf("VolumeAttachment must not be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("VolumeAttachment should not allow create on update")
+	}
+
+	volumeAttachment := getValidVolumeAttachment("valid-attachment")
+	volumeAttachment.Status.Attached = true
+
+	Strategy.PrepareForCreate(ctx, volumeAttachment)
+	if volumeAttachment.Status.Attached {
+		t.Errorf("status must be cleared on create")
+	}
+
+	errs := Strategy.Validate(ctx, volumeAttachment)
+	if len(errs) != 0 {
+		t.Errorf("unexpected error validating %v", errs)
+	}
+
+	// Status changes are ignored when updating the spec
+	newVolumeAttachment := getValidVolumeAttachment("valid-attachment")
+	newVolumeAttachment.ResourceVersion = "4"
+	newVolumeAttachment.Status.Attached = true
+	Strategy.PrepareForUpdate(ctx, newVolumeAttachment, volumeAttachment)
+	if newVolumeAttachment.Status.Attached {
+		t.Errorf("status must not be changed by a spec update")
+	}
+	errs = Strategy.ValidateUpdate(ctx, newVolumeAttachment, volumeAttachment)
+	if len(errs) != 0 {
+		t.Errorf("unexpected error validating %v", errs)
+	}
+
+	// Spec is immutable
+	newVolumeAttachment = getValidVolumeAttachment("valid-attachment")
+	newVolumeAttachment.ResourceVersion = "4"
+	newVolumeAttachment.Spec.NodeName = "other-node"
+	Strategy.PrepareForUpdate(ctx, newVolumeAttachment, volumeAttachment)
+	errs = Strategy.ValidateUpdate(ctx, newVolumeAttachment, volumeAttachment)
+	if len(errs) == 0 {
+		t.Errorf("Expected a validation error")
+	}
+}
+
+func TestVolumeAttachmentStatusStrategy(t *testing.T) {
+	ctx := genericapirequest.NewDefaultContext()
+
+	volumeAttachment := getValidVolumeAttachment("valid-attachment")
+	newVolumeAttachment := getValidVolumeAttachment("valid-attachment")
+	newVolumeAttachment.ResourceVersion = "4"
+	newVolumeAttachment.Spec.NodeName = "other-node"
+	newVolumeAttachment.Status.Attached = true
+
+	StatusStrategy.PrepareForUpdate(ctx, newVolumeAttachment, volumeAttachment)
+	if newVolumeAttachment.Spec.NodeName != "valid-node" {
+		t.Errorf("spec must not be changed by a status update")
+	}
+	if !newVolumeAttachment.Status.Attached {
+		t.Errorf("status update was dropped")
+	}
+	errs := StatusStrategy.ValidateUpdate(ctx, newVolumeAttachment, volumeAttachment)
+	if len(errs) != 0 {
+		t.Errorf("unexpected error validating %v", errs)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumesnapshot
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/storage"
+	"k8s.io/kubernetes/pkg/apis/storage/validation"
+)
+
+// volumeSnapshotStrategy implements behavior for VolumeSnapshot objects
+type volumeSnapshotStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating
+// VolumeSnapshot objects via the REST API.
+var Strategy = volumeSnapshotStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (volumeSnapshotStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the Status field which is not allowed to be set by end users on creation.
+func (volumeSnapshotStrategy) PrepareForCreate(ctx genericapirequest.Context, obj runtime.Object) {
+	snapshot := obj.(*storage.VolumeSnapshot)
+	snapshot.Status = storage.VolumeSnapshotStatus{}
+}
+
+func (volumeSnapshotStrategy) Validate(ctx genericapirequest.Context, obj runtime.Object) field.ErrorList {
+	snapshot := obj.(*storage.VolumeSnapshot)
+	return validation.ValidateVolumeSnapshot(snapshot)
+}
+
+// Canonicalize normalizes the object after validation.
+func (volumeSnapshotStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (volumeSnapshotStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// PrepareForUpdate sets the Status fields which is not allowed to be set by an end user updating a VolumeSnapshot
+func (volumeSnapshotStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newSnapshot := obj.(*storage.VolumeSnapshot)
+	oldSnapshot := old.(*storage.VolumeSnapshot)
+	newSnapshot.Status = oldSnapshot.Status
+}
+
+func (volumeSnapshotStrategy) ValidateUpdate(ctx genericapirequest.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateVolumeSnapshotUpdate(obj.(*storage.VolumeSnapshot), old.(*storage.VolumeSnapshot))
+}
+
+func (volumeSnapshotStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// volumeSnapshotStatusStrategy implements behavior for the status
+// subresource, which is written by the snapshot controller.
+type volumeSnapshotStatusStrategy struct {
+	volumeSnapshotStrategy
+}
+
+// StatusStrategy is the default logic that applies when updating the status of
+// VolumeSnapshot objects via the REST API.
+var StatusStrategy = volumeSnapshotStatusStrategy{Strategy}
+
+// PrepareForUpdate sets the Spec field which is not allowed to be changed when updating a VolumeSnapshot's Status
+func (volumeSnapshotStatusStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newSnapshot := obj.(*storage.VolumeSnapshot)
+	oldSnapshot := old.(*storage.VolumeSnapshot)
+	newSnapshot.Spec = oldSnapshot.Spec
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	snapshot, ok := obj.(*storage.VolumeSnapshot)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not of type VolumeSnapshot")
+	}
+	return labels.Set(snapshot.ObjectMeta.Labels), VolumeSnapshotToSelectableFields(snapshot), nil
+}
+
+// MatchVolumeSnapshots returns a generic matcher for a given label and field selector.
+func MatchVolumeSnapshots(label labels.Selector, field fields.Selector) apistorage.SelectionPredicate {
+	return apistorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// VolumeSnapshotToSelectableFields returns a field set that represents the object
+func VolumeSnapshotToSelectableFields(snapshot *storage.VolumeSnapshot) fields.Set {
+	return generic.ObjectMetaFieldsSet(&snapshot.ObjectMeta, true)
+}
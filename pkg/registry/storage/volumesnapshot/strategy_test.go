@@ -0,0 +1,106 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumesnapshot
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/kubernetes/pkg/apis/storage"
+)
+
+func getValidVolumeSnapshot(name string) *storage.VolumeSnapshot {
+	return &storage.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: storage.VolumeSnapshotSpec{
+			PersistentVolumeClaimName: "valid-claim",
+		},
+	}
+}
+
+func TestVolumeSnapshotStrategy(t *testing.T) {
+	ctx := genericapirequest.NewDefaultContext()
+	if !Strategy.NamespaceScoped() {
+		t.Errorf("VolumeSnapshot must be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("VolumeSnapshot should not allow create on update")
+	}
+
+	snapshot := getValidVolumeSnapshot("valid-snapshot")
+	snapshot.Status.Ready = true
+
+	Strategy.PrepareForCreate(ctx, snapshot)
+	if snapshot.Status.Ready {
+		t.Errorf("status must be cleared on create")
+	}
+
+	errs := Strategy.Validate(ctx, snapshot)
+	if len(errs) != 0 {
+		t.Errorf("unexpected error validating %v", errs)
+	}
+
+	// Status changes are ignored when updating the spec
+	newSnapshot := getValidVolumeSnapshot("valid-snapshot")
+	newSnapshot.ResourceVersion = "4"
+	newSnapshot.Spec.SnapshotContentName = "snapcontent-valid"
+	newSnapshot.Status.Ready = true
+	Strategy.PrepareForUpdate(ctx, newSnapshot, snapshot)
+	if newSnapshot.Status.Ready {
+		t.Errorf("status must not be changed by a spec update")
+	}
+	errs = Strategy.ValidateUpdate(ctx, newSnapshot, snapshot)
+	if len(errs) != 0 {
+		t.Errorf("unexpected error validating %v", errs)
+	}
+
+	// The claim is immutable
+	newSnapshot = getValidVolumeSnapshot("valid-snapshot")
+	newSnapshot.ResourceVersion = "4"
+	newSnapshot.Spec.PersistentVolumeClaimName = "other-claim"
+	Strategy.PrepareForUpdate(ctx, newSnapshot, snapshot)
+	errs = Strategy.ValidateUpdate(ctx, newSnapshot, snapshot)
+	if len(errs) == 0 {
+		t.Errorf("Expected a validation error")
+	}
+}
+
+func TestVolumeSnapshotStatusStrategy(t *testing.T) {
+	ctx := genericapirequest.NewDefaultContext()
+
+	snapshot := getValidVolumeSnapshot("valid-snapshot")
+	newSnapshot := getValidVolumeSnapshot("valid-snapshot")
+	newSnapshot.ResourceVersion = "4"
+	newSnapshot.Spec.PersistentVolumeClaimName = "other-claim"
+	newSnapshot.Status.Ready = true
+
+	StatusStrategy.PrepareForUpdate(ctx, newSnapshot, snapshot)
+	if newSnapshot.Spec.PersistentVolumeClaimName != "valid-claim" {
+		t.Errorf("spec must not be changed by a status update")
+	}
+	if !newSnapshot.Status.Ready {
+		t.Errorf("status update was dropped")
+	}
+	errs := StatusStrategy.ValidateUpdate(ctx, newSnapshot, snapshot)
+	if len(errs) != 0 {
+		t.Errorf("unexpected error validating %v", errs)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumesnapshotcontent
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apistorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/storage"
+	"k8s.io/kubernetes/pkg/apis/storage/validation"
+)
+
+// volumeSnapshotContentStrategy implements behavior for VolumeSnapshotContent objects
+type volumeSnapshotContentStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating
+// VolumeSnapshotContent objects via the REST API.
+var Strategy = volumeSnapshotContentStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (volumeSnapshotContentStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (volumeSnapshotContentStrategy) PrepareForCreate(ctx genericapirequest.Context, obj runtime.Object) {
+}
+
+func (volumeSnapshotContentStrategy) Validate(ctx genericapirequest.Context, obj runtime.Object) field.ErrorList {
+	content := obj.(*storage.VolumeSnapshotContent)
+	return validation.ValidateVolumeSnapshotContent(content)
+}
+
+// Canonicalize normalizes the object after validation.
+func (volumeSnapshotContentStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (volumeSnapshotContentStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (volumeSnapshotContentStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+}
+
+func (volumeSnapshotContentStrategy) ValidateUpdate(ctx genericapirequest.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateVolumeSnapshotContentUpdate(obj.(*storage.VolumeSnapshotContent), old.(*storage.VolumeSnapshotContent))
+}
+
+func (volumeSnapshotContentStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	content, ok := obj.(*storage.VolumeSnapshotContent)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not of type VolumeSnapshotContent")
+	}
+	return labels.Set(content.ObjectMeta.Labels), VolumeSnapshotContentToSelectableFields(content), nil
+}
+
+// MatchVolumeSnapshotContents returns a generic matcher for a given label and field selector.
+func MatchVolumeSnapshotContents(label labels.Selector, field fields.Selector) apistorage.SelectionPredicate {
+	return apistorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// VolumeSnapshotContentToSelectableFields returns a field set that represents the object
+func VolumeSnapshotContentToSelectableFields(content *storage.VolumeSnapshotContent) fields.Set {
+	return generic.ObjectMetaFieldsSet(&content.ObjectMeta, false)
+}
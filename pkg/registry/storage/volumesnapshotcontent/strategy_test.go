@@ -0,0 +1,74 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumesnapshotcontent
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/kubernetes/pkg/apis/storage"
+)
+
+func getValidVolumeSnapshotContent(name string) *storage.VolumeSnapshotContent {
+	return &storage.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: storage.VolumeSnapshotContentSpec{
+			Snapshotter:    "kubernetes.io/host-path",
+			SnapshotHandle: "/tmp/snapshot",
+		},
+	}
+}
+
+func TestVolumeSnapshotContentStrategy(t *testing.T) {
+	ctx := genericapirequest.NewDefaultContext()
+	if Strategy.NamespaceScoped() {
+		t.Errorf("VolumeSnapshotContent must not be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("VolumeSnapshotContent should not allow create on update")
+	}
+
+	content := getValidVolumeSnapshotContent("valid-content")
+	Strategy.PrepareForCreate(ctx, content)
+	errs := Strategy.Validate(ctx, content)
+	if len(errs) != 0 {
+		t.Errorf("unexpected error validating %v", errs)
+	}
+
+	// Labels may change
+	newContent := getValidVolumeSnapshotContent("valid-content")
+	newContent.ResourceVersion = "4"
+	newContent.Labels = map[string]string{"foo": "bar"}
+	Strategy.PrepareForUpdate(ctx, newContent, content)
+	errs = Strategy.ValidateUpdate(ctx, newContent, content)
+	if len(errs) != 0 {
+		t.Errorf("unexpected error validating %v", errs)
+	}
+
+	// Spec is immutable
+	newContent = getValidVolumeSnapshotContent("valid-content")
+	newContent.ResourceVersion = "4"
+	newContent.Spec.SnapshotHandle = "/tmp/other"
+	Strategy.PrepareForUpdate(ctx, newContent, content)
+	errs = Strategy.ValidateUpdate(ctx, newContent, content)
+	if len(errs) == 0 {
+		t.Errorf("Expected a validation error")
+	}
+}
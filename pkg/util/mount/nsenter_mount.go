@@ -19,6 +19,7 @@ limitations under the License.
 package mount
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -87,9 +88,10 @@ func NewNsenterMounter() *NsenterMounter {
 var _ = Interface(&NsenterMounter{})
 
 const (
-	hostRootFsPath     = "/rootfs"
-	hostProcMountsPath = "/rootfs/proc/1/mounts"
-	nsenterPath        = "nsenter"
+	hostRootFsPath        = "/rootfs"
+	hostProcMountsPath    = "/rootfs/proc/1/mounts"
+	hostProcMountinfoPath = "/rootfs/proc/1/mountinfo"
+	nsenterPath           = "nsenter"
 )
 
 // Mount runs mount(8) in the host's root mount namespace.  Aside from this
@@ -222,6 +224,29 @@ func (n *NsenterMounter) GetDeviceNameFromMount(mountPath, pluginDir string) (st
 	return getDeviceNameFromMount(n, mountPath, pluginDir)
 }
 
+// MakeRShared checks that given path is on a mount with 'rshared' mount
+// propagation in the host's mount namespace. If not, it bind-mounts the path
+// as rshared.
+func (n *NsenterMounter) MakeRShared(path string) error {
+	shared, err := isShared(path, hostProcMountinfoPath)
+	if err != nil {
+		return err
+	}
+	if shared {
+		glog.V(4).Infof("Directory %s is already on a shared mount", path)
+		return nil
+	}
+
+	glog.V(2).Infof("Bind-mounting %q with shared mount propagation", path)
+	if err := n.doNsenterMount(path, path, "", []string{"bind"}); err != nil {
+		return fmt.Errorf("failed to bind-mount %s: %v", path, err)
+	}
+	if err := n.doNsenterMount("", path, "", []string{"rshared"}); err != nil {
+		return fmt.Errorf("failed to make %s rshared: %v", path, err)
+	}
+	return nil
+}
+
 func (n *NsenterMounter) absHostPath(command string) string {
 	path, ok := n.paths[command]
 	if !ok {
@@ -0,0 +1,120 @@
+// +build linux
+
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resizefs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/util/exec"
+	"k8s.io/kubernetes/pkg/util/mount"
+)
+
+type execArgs struct {
+	command string
+	args    []string
+	output  string
+	err     error
+}
+
+func TestResize(t *testing.T) {
+	devicePath := "/dev/foo"
+	deviceMountPath := "/mnt/foo"
+
+	tests := []struct {
+		description  string
+		execScripts  []execArgs
+		expectResize bool
+		expectErr    bool
+	}{
+		{
+			description: "ext4",
+			execScripts: []execArgs{
+				{"lsblk", []string{"-nd", "-o", "FSTYPE", devicePath}, "ext4\n", nil},
+				{"resize2fs", []string{devicePath}, "", nil},
+			},
+			expectResize: true,
+		},
+		{
+			description: "xfs",
+			execScripts: []execArgs{
+				{"lsblk", []string{"-nd", "-o", "FSTYPE", devicePath}, "xfs\n", nil},
+				{"xfs_growfs", []string{"-d", deviceMountPath}, "", nil},
+			},
+			expectResize: true,
+		},
+		{
+			description: "unformatted",
+			execScripts: []execArgs{
+				{"lsblk", []string{"-nd", "-o", "FSTYPE", devicePath}, "", nil},
+			},
+			expectResize: false,
+		},
+		{
+			description: "unsupported format",
+			execScripts: []execArgs{
+				{"lsblk", []string{"-nd", "-o", "FSTYPE", devicePath}, "btrfs\n", nil},
+			},
+			expectErr: true,
+		},
+		{
+			description: "resize2fs failure",
+			execScripts: []execArgs{
+				{"lsblk", []string{"-nd", "-o", "FSTYPE", devicePath}, "ext4\n", nil},
+				{"resize2fs", []string{devicePath}, "bad superblock", fmt.Errorf("exit status 1")},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		commandScripts := []exec.FakeCommandAction{}
+		for _, script := range test.execScripts {
+			expected := script
+			commandScripts = append(commandScripts, func(cmd string, args ...string) exec.Cmd {
+				if cmd != expected.command || !reflect.DeepEqual(args, expected.args) {
+					t.Errorf("%s: unexpected command %s %v, expected %s %v", test.description, cmd, args, expected.command, expected.args)
+				}
+				fake := exec.FakeCmd{
+					CombinedOutputScript: []exec.FakeCombinedOutputAction{
+						func() ([]byte, error) { return []byte(expected.output), expected.err },
+					},
+				}
+				return exec.InitFakeCmd(&fake, cmd, args...)
+			})
+		}
+		fakeExec := &exec.FakeExec{CommandScript: commandScripts}
+		resizer := NewResizeFs(&mount.SafeFormatAndMount{Interface: &mount.FakeMounter{}, Runner: fakeExec})
+
+		resized, err := resizer.Resize(devicePath, deviceMountPath)
+		if err != nil && !test.expectErr {
+			t.Errorf("%s: unexpected error: %v", test.description, err)
+		}
+		if err == nil && test.expectErr {
+			t.Errorf("%s: expected error, got none", test.description)
+		}
+		if resized != test.expectResize {
+			t.Errorf("%s: expected resized to be %v, got %v", test.description, test.expectResize, resized)
+		}
+		if fakeExec.CommandCalls != len(test.execScripts) {
+			t.Errorf("%s: expected %d commands, got %d", test.description, len(test.execScripts), fakeExec.CommandCalls)
+		}
+	}
+}
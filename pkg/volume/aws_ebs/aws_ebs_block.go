@@ -0,0 +1,104 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws_ebs
+
+import (
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	kstrings "k8s.io/kubernetes/pkg/util/strings"
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+var _ volume.BlockVolumePlugin = &awsElasticBlockStorePlugin{}
+
+func (plugin *awsElasticBlockStorePlugin) NewBlockVolumeMapper(spec *volume.Spec, pod *v1.Pod, _ volume.VolumeOptions) (volume.BlockVolumeMapper, error) {
+	if _, _, err := getVolumeSource(spec); err != nil {
+		return nil, err
+	}
+	return &awsElasticBlockStoreMapper{
+		awsElasticBlockStoreBlock: &awsElasticBlockStoreBlock{
+			podUID:  pod.UID,
+			volName: spec.Name(),
+			plugin:  plugin,
+		},
+	}, nil
+}
+
+func (plugin *awsElasticBlockStorePlugin) NewBlockVolumeUnmapper(volName string, podUID types.UID) (volume.BlockVolumeUnmapper, error) {
+	return &awsElasticBlockStoreUnmapper{
+		awsElasticBlockStoreBlock: &awsElasticBlockStoreBlock{
+			podUID:  podUID,
+			volName: volName,
+			plugin:  plugin,
+		},
+	}, nil
+}
+
+// awsElasticBlockStoreBlock holds the paths an EBS raw block volume is
+// mapped to.
+type awsElasticBlockStoreBlock struct {
+	podUID  types.UID
+	volName string
+	plugin  *awsElasticBlockStorePlugin
+}
+
+// GetGlobalMapPath returns the global map path of the EBS volume:
+// plugins/kubernetes.io/aws-ebs/volumeDevices/{volumeID}
+func (b *awsElasticBlockStoreBlock) GetGlobalMapPath(spec *volume.Spec) (string, error) {
+	volumeSource, _, err := getVolumeSource(spec)
+	if err != nil {
+		return "", err
+	}
+	// Clean up the URI to be more fs-friendly, as makeGlobalPDPath does
+	name := strings.Replace(volumeSource.VolumeID, "://", "/", -1)
+	return path.Join(b.plugin.host.GetPluginDir(awsElasticBlockStorePluginName), util.VolumeDevicesInGlobalPath, name), nil
+}
+
+// GetPodDeviceMapPath returns the pod device map path and the name of the
+// link to the device:
+// pods/{podUid}/volumeDevices/kubernetes.io~aws-ebs, {volName}
+func (b *awsElasticBlockStoreBlock) GetPodDeviceMapPath() (string, string) {
+	return b.plugin.host.GetPodVolumeDeviceDir(b.podUID, kstrings.EscapeQualifiedNameForDisk(awsElasticBlockStorePluginName)), b.volName
+}
+
+type awsElasticBlockStoreMapper struct {
+	*awsElasticBlockStoreBlock
+}
+
+var _ volume.BlockVolumeMapper = &awsElasticBlockStoreMapper{}
+
+// SetUpDevice has nothing to do, the EBS volume is attached by the attacher
+// and the device path is returned by WaitForAttach.
+func (b *awsElasticBlockStoreMapper) SetUpDevice() (string, error) {
+	return "", nil
+}
+
+type awsElasticBlockStoreUnmapper struct {
+	*awsElasticBlockStoreBlock
+}
+
+var _ volume.BlockVolumeUnmapper = &awsElasticBlockStoreUnmapper{}
+
+// TearDownDevice has nothing to do, the EBS volume is detached by the
+// detacher.
+func (c *awsElasticBlockStoreUnmapper) TearDownDevice(mapPath, devicePath string) error {
+	return nil
+}
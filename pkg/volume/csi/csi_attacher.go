@@ -0,0 +1,301 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/kubernetes/pkg/api/v1"
+	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+// csiAttacher attaches volumes by creating VolumeAttachment objects, which
+// are acted upon by the external attacher of the driver, and stages them on
+// the node when the driver supports it.
+type csiAttacher struct {
+	plugin        *csiPlugin
+	k8s           clientset.Interface
+	waitSleepTime time.Duration
+}
+
+var _ volume.Attacher = &csiAttacher{}
+var _ volume.Detacher = &csiAttacher{}
+
+func (c *csiAttacher) Attach(spec *volume.Spec, nodeName types.NodeName) (string, error) {
+	source, err := getCSISourceFromSpec(spec)
+	if err != nil {
+		return "", err
+	}
+
+	pvName := spec.PersistentVolume.Name
+	node := string(nodeName)
+	attachID := getAttachmentName(source.VolumeHandle, source.Driver, node)
+	attachment := &storage.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: attachID,
+		},
+		Spec: storage.VolumeAttachmentSpec{
+			NodeName: node,
+			Attacher: source.Driver,
+			Source: storage.VolumeAttachmentSource{
+				PersistentVolumeName: &pvName,
+			},
+		},
+	}
+
+	_, err = c.k8s.StorageV1beta1().VolumeAttachments().Create(attachment)
+	if err != nil && !apierrs.IsAlreadyExists(err) {
+		glog.Errorf("csi: failed to create VolumeAttachment %s for volume %s: %v", attachID, source.VolumeHandle, err)
+		return "", err
+	}
+	glog.V(4).Infof("csi: created VolumeAttachment %s for volume %s on node %s", attachID, source.VolumeHandle, node)
+
+	if err := c.waitForVolumeAttachment(attachID, csiTimeout); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+func (c *csiAttacher) VolumesAreAttached(specs []*volume.Spec, nodeName types.NodeName) (map[*volume.Spec]bool, error) {
+	attached := make(map[*volume.Spec]bool)
+	for _, spec := range specs {
+		source, err := getCSISourceFromSpec(spec)
+		if err != nil {
+			glog.Errorf("csi: failed to get volume source of spec %s: %v", spec.Name(), err)
+			continue
+		}
+
+		attachID := getAttachmentName(source.VolumeHandle, source.Driver, string(nodeName))
+		attachment, err := c.k8s.StorageV1beta1().VolumeAttachments().Get(attachID, metav1.GetOptions{})
+		if err != nil {
+			if apierrs.IsNotFound(err) {
+				attached[spec] = false
+				continue
+			}
+			glog.Errorf("csi: failed to get VolumeAttachment %s: %v", attachID, err)
+			continue
+		}
+		attached[spec] = attachment.Status.Attached
+	}
+	return attached, nil
+}
+
+func (c *csiAttacher) WaitForAttach(spec *volume.Spec, devicePath string, timeout time.Duration) (string, error) {
+	source, err := getCSISourceFromSpec(spec)
+	if err != nil {
+		return "", err
+	}
+
+	attachID := getAttachmentName(source.VolumeHandle, source.Driver, string(c.plugin.host.GetNodeName()))
+	if err := c.waitForVolumeAttachment(attachID, timeout); err != nil {
+		return "", err
+	}
+	return devicePath, nil
+}
+
+// waitForVolumeAttachment polls the VolumeAttachment until the external
+// attacher reports the volume attached or failed to attach it.
+func (c *csiAttacher) waitForVolumeAttachment(attachID string, timeout time.Duration) error {
+	err := wait.PollImmediate(c.waitSleepTime, timeout, func() (bool, error) {
+		attachment, err := c.k8s.StorageV1beta1().VolumeAttachments().Get(attachID, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if attachment.Status.Attached {
+			return true, nil
+		}
+		if attachment.Status.AttachError != nil {
+			return false, fmt.Errorf("attachment %s failed: %s", attachID, attachment.Status.AttachError.Message)
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out waiting for VolumeAttachment %s", attachID)
+	}
+	return err
+}
+
+func (c *csiAttacher) GetDeviceMountPath(spec *volume.Spec) (string, error) {
+	if spec.PersistentVolume == nil {
+		return "", fmt.Errorf("Spec does not reference a CSI volume type")
+	}
+	return makeDeviceMountPath(c.plugin, spec.PersistentVolume.Name), nil
+}
+
+func (c *csiAttacher) MountDevice(spec *volume.Spec, devicePath string, deviceMountPath string) error {
+	source, err := getCSISourceFromSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	client := c.plugin.nodeClient(source.Driver)
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+
+	stageUnstageSet, err := client.NodeSupportsStageUnstage(ctx)
+	if err != nil {
+		return err
+	}
+	if !stageUnstageSet {
+		glog.V(4).Infof("csi: driver %s does not stage volumes, skipping MountDevice", source.Driver)
+		return nil
+	}
+
+	attachID := getAttachmentName(source.VolumeHandle, source.Driver, string(c.plugin.host.GetNodeName()))
+	attachment, err := c.k8s.StorageV1beta1().VolumeAttachments().Get(attachID, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The volume data lets UnmountDevice find the driver without the spec.
+	data := map[string]string{
+		volDataKeySpecName:     spec.Name(),
+		volDataKeyDriverName:   source.Driver,
+		volDataKeyVolumeHandle: source.VolumeHandle,
+	}
+	if err := saveVolumeData(filepath.Dir(deviceMountPath), data); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(deviceMountPath, 0750); err != nil {
+		return err
+	}
+
+	err = client.NodeStageVolume(ctx,
+		source.VolumeHandle,
+		attachment.Status.AttachmentMetadata,
+		deviceMountPath,
+		source.FSType,
+		getAccessMode(spec.PersistentVolume.Spec.AccessModes),
+		source.VolumeAttributes)
+	if err != nil {
+		glog.Errorf("csi: failed to stage volume %s at %s: %v", source.VolumeHandle, deviceMountPath, err)
+		return err
+	}
+	glog.V(4).Infof("csi: staged volume %s at %s", source.VolumeHandle, deviceMountPath)
+	return nil
+}
+
+func (c *csiAttacher) Detach(volumeName string, nodeName types.NodeName) error {
+	driverName, volumeHandle, err := splitVolumeName(volumeName)
+	if err != nil {
+		return err
+	}
+
+	attachID := getAttachmentName(volumeHandle, driverName, string(nodeName))
+	err = c.k8s.StorageV1beta1().VolumeAttachments().Delete(attachID, nil)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil
+		}
+		glog.Errorf("csi: failed to delete VolumeAttachment %s: %v", attachID, err)
+		return err
+	}
+	glog.V(4).Infof("csi: deleted VolumeAttachment %s for volume %s", attachID, volumeHandle)
+
+	return c.waitForVolumeDetachment(attachID, csiTimeout)
+}
+
+// waitForVolumeDetachment polls the VolumeAttachment until the external
+// attacher has detached the volume and the object is gone.
+func (c *csiAttacher) waitForVolumeDetachment(attachID string, timeout time.Duration) error {
+	err := wait.PollImmediate(c.waitSleepTime, timeout, func() (bool, error) {
+		attachment, err := c.k8s.StorageV1beta1().VolumeAttachments().Get(attachID, metav1.GetOptions{})
+		if err != nil {
+			if apierrs.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		if attachment.Status.DetachError != nil {
+			return false, fmt.Errorf("detachment %s failed: %s", attachID, attachment.Status.DetachError.Message)
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out waiting for VolumeAttachment %s to be deleted", attachID)
+	}
+	return err
+}
+
+func (c *csiAttacher) UnmountDevice(deviceMountPath string) error {
+	dataDir := filepath.Dir(deviceMountPath)
+	data, err := loadVolumeData(dataDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// MountDevice did not stage the volume.
+			return nil
+		}
+		return err
+	}
+
+	client := c.plugin.nodeClient(data[volDataKeyDriverName])
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+
+	if err := client.NodeUnstageVolume(ctx, data[volDataKeyVolumeHandle], deviceMountPath); err != nil {
+		glog.Errorf("csi: failed to unstage volume %s from %s: %v", data[volDataKeyVolumeHandle], deviceMountPath, err)
+		return err
+	}
+	glog.V(4).Infof("csi: unstaged volume %s from %s", data[volDataKeyVolumeHandle], deviceMountPath)
+
+	return removeVolumeDataDir(dataDir, deviceMountPath)
+}
+
+// getAttachmentName returns the name of the VolumeAttachment of the volume
+// on the node.
+func getAttachmentName(volumeHandle, driverName, nodeName string) string {
+	result := sha256.Sum256([]byte(fmt.Sprintf("%s%s%s", volumeHandle, driverName, nodeName)))
+	return fmt.Sprintf("csi-%x", result)
+}
+
+// makeDeviceMountPath returns the path a persistent volume is staged to on
+// the node.
+func makeDeviceMountPath(plugin *csiPlugin, pvName string) string {
+	return filepath.Join(plugin.host.GetPluginDir(csiPluginName), "pv", pvName, "globalmount")
+}
+
+// getAccessMode returns the access mode volumes are published with.
+func getAccessMode(accessModes []v1.PersistentVolumeAccessMode) v1.PersistentVolumeAccessMode {
+	if len(accessModes) == 0 {
+		return v1.ReadWriteOnce
+	}
+	return accessModes[0]
+}
+
+// removeVolumeDataDir removes the mount point, the volume data file and
+// their directory.
+func removeVolumeDataDir(dataDir, mountPath string) error {
+	for _, path := range []string{mountPath, filepath.Join(dataDir, volDataFileName), dataDir} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/util/mount"
+	"k8s.io/kubernetes/pkg/volume"
+	csiapi "k8s.io/kubernetes/pkg/volume/csi/api/v1alpha1/driver"
+	"k8s.io/kubernetes/pkg/volume/csi/mock"
+)
+
+const testNode = "node-1"
+
+func newTestAttacher(env *testEnv) *csiAttacher {
+	return &csiAttacher{
+		plugin:        env.plugin,
+		k8s:           env.client,
+		waitSleepTime: 10 * time.Millisecond,
+	}
+}
+
+// runExternalAttacher runs the external attacher of the mock driver until
+// the returned channel is closed.
+func runExternalAttacher(t *testing.T, env *testEnv) chan struct{} {
+	conn, err := grpc.Dial(env.addr, grpc.WithInsecure(), grpc.WithDialer(dial))
+	if err != nil {
+		t.Fatalf("failed to connect to the driver: %v", err)
+	}
+	attacher := mock.NewAttacher(env.client, testDriver, csiapi.NewControllerClient(conn))
+	stopCh := make(chan struct{})
+	go func() {
+		attacher.Run(10*time.Millisecond, stopCh)
+		conn.Close()
+	}()
+	return stopCh
+}
+
+// createAttachment creates a VolumeAttachment reporting the volume attached
+// to the node of the fake host.
+func createAttachment(t *testing.T, env *testEnv, pv string, volumeHandle string, metadata map[string]string) {
+	attachment := &storage.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: getAttachmentName(volumeHandle, testDriver, string(env.host.GetNodeName())),
+		},
+		Spec: storage.VolumeAttachmentSpec{
+			Attacher: testDriver,
+			NodeName: string(env.host.GetNodeName()),
+			Source: storage.VolumeAttachmentSource{
+				PersistentVolumeName: &pv,
+			},
+		},
+		Status: storage.VolumeAttachmentStatus{
+			Attached:           true,
+			AttachmentMetadata: metadata,
+		},
+	}
+	if _, err := env.client.StorageV1beta1().VolumeAttachments().Create(attachment); err != nil {
+		t.Fatalf("failed to create VolumeAttachment: %v", err)
+	}
+}
+
+func TestAttacherAttachDetach(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	stopCh := runExternalAttacher(t, env)
+	defer close(stopCh)
+
+	pv := env.createVolume(t, "pv-1", "vol-1")
+	spec := volume.NewSpecFromPersistentVolume(pv, false)
+	attacher := newTestAttacher(env)
+
+	if _, err := attacher.Attach(spec, testNode); err != nil {
+		t.Fatalf("Attach failed: %v", err)
+	}
+	if !env.driver.IsPublished("vol-1", testNode) {
+		t.Errorf("expected the volume to be published to %s", testNode)
+	}
+
+	attachID := getAttachmentName("vol-1", testDriver, testNode)
+	attachment, err := env.client.StorageV1beta1().VolumeAttachments().Get(attachID, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get VolumeAttachment: %v", err)
+	}
+	if attachment.Spec.Attacher != testDriver || attachment.Spec.NodeName != testNode || *attachment.Spec.Source.PersistentVolumeName != "pv-1" {
+		t.Errorf("unexpected VolumeAttachment spec %+v", attachment.Spec)
+	}
+	if attachment.Status.AttachmentMetadata["path"] == "" {
+		t.Errorf("expected the publish info in the attachment metadata, got %v", attachment.Status.AttachmentMetadata)
+	}
+
+	// Attaching again must succeed.
+	if _, err := attacher.Attach(spec, testNode); err != nil {
+		t.Fatalf("second Attach failed: %v", err)
+	}
+
+	unknown := volume.NewSpecFromPersistentVolume(makeTestPV("pv-2", "vol-2"), false)
+	attached, err := attacher.VolumesAreAttached([]*volume.Spec{spec, unknown}, testNode)
+	if err != nil {
+		t.Fatalf("VolumesAreAttached failed: %v", err)
+	}
+	if !attached[spec] || attached[unknown] {
+		t.Errorf("unexpected attached volumes %v", attached)
+	}
+
+	volumeName, err := env.plugin.GetVolumeName(spec)
+	if err != nil {
+		t.Fatalf("GetVolumeName failed: %v", err)
+	}
+	if err := attacher.Detach(volumeName, testNode); err != nil {
+		t.Fatalf("Detach failed: %v", err)
+	}
+	if _, err := env.client.StorageV1beta1().VolumeAttachments().Get(attachID, metav1.GetOptions{}); !apierrs.IsNotFound(err) {
+		t.Errorf("expected the VolumeAttachment to be deleted, got %v", err)
+	}
+
+	// Detaching again must succeed.
+	if err := attacher.Detach(volumeName, types.NodeName(testNode)); err != nil {
+		t.Fatalf("second Detach failed: %v", err)
+	}
+}
+
+func TestAttacherAttachError(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	stopCh := runExternalAttacher(t, env)
+	defer close(stopCh)
+
+	// The driver does not know the volume, so it fails to publish it.
+	pv := makeTestPV("pv-1", "vol-1")
+	if _, err := env.client.Core().PersistentVolumes().Create(pv); err != nil {
+		t.Fatalf("failed to create PersistentVolume: %v", err)
+	}
+
+	attacher := newTestAttacher(env)
+	if _, err := attacher.Attach(volume.NewSpecFromPersistentVolume(pv, false), testNode); err == nil {
+		t.Fatalf("expected Attach to fail")
+	}
+
+	attachment, err := env.client.StorageV1beta1().VolumeAttachments().Get(getAttachmentName("vol-1", testDriver, testNode), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get VolumeAttachment: %v", err)
+	}
+	if attachment.Status.Attached || attachment.Status.AttachError == nil {
+		t.Errorf("expected an attach error, got status %+v", attachment.Status)
+	}
+}
+
+func TestAttacherMountDevice(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	pv := env.createVolume(t, "pv-1", "vol-1")
+	createAttachment(t, env, "pv-1", "vol-1", nil)
+	spec := volume.NewSpecFromPersistentVolume(pv, false)
+	attacher := newTestAttacher(env)
+
+	if _, err := attacher.WaitForAttach(spec, "", time.Second); err != nil {
+		t.Fatalf("WaitForAttach failed: %v", err)
+	}
+
+	deviceMountPath, err := attacher.GetDeviceMountPath(spec)
+	if err != nil {
+		t.Fatalf("GetDeviceMountPath failed: %v", err)
+	}
+	expectedPath := filepath.Join(env.tmpDir, "plugins", csiPluginName, "pv", "pv-1", "globalmount")
+	if deviceMountPath != expectedPath {
+		t.Errorf("expected device mount path %s, got %s", expectedPath, deviceMountPath)
+	}
+
+	if err := attacher.MountDevice(spec, "", deviceMountPath); err != nil {
+		t.Fatalf("MountDevice failed: %v", err)
+	}
+	mounter := env.host.GetMounter().(*mount.FakeMounter)
+	if notMnt, _ := mounter.IsLikelyNotMountPoint(deviceMountPath); notMnt {
+		t.Errorf("expected the volume to be staged at %s", deviceMountPath)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(deviceMountPath), volDataFileName)); err != nil {
+		t.Errorf("expected the volume data to be saved: %v", err)
+	}
+
+	if err := attacher.UnmountDevice(deviceMountPath); err != nil {
+		t.Fatalf("UnmountDevice failed: %v", err)
+	}
+	if notMnt, _ := mounter.IsLikelyNotMountPoint(deviceMountPath); !notMnt {
+		t.Errorf("expected the volume to be unstaged from %s", deviceMountPath)
+	}
+	if _, err := os.Stat(filepath.Dir(deviceMountPath)); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed, got %v", filepath.Dir(deviceMountPath), err)
+	}
+
+	// Nothing is staged anymore, unmounting again must succeed.
+	if err := attacher.UnmountDevice(deviceMountPath); err != nil {
+		t.Fatalf("second UnmountDevice failed: %v", err)
+	}
+}
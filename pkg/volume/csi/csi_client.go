@@ -0,0 +1,221 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"k8s.io/kubernetes/pkg/api/v1"
+	csiapi "k8s.io/kubernetes/pkg/volume/csi/api/v1alpha1/driver"
+)
+
+// csiClient is the part of the driver API used by the plugin.
+type csiClient interface {
+	CreateVolume(ctx context.Context, name string, requiredBytes int64, accessModes []v1.PersistentVolumeAccessMode, fsType string, parameters map[string]string) (*csiapi.Volume, error)
+	DeleteVolume(ctx context.Context, volumeID string) error
+	NodeSupportsStageUnstage(ctx context.Context) (bool, error)
+	NodeStageVolume(ctx context.Context, volumeID string, publishInfo map[string]string, stagingTargetPath string, fsType string, accessMode v1.PersistentVolumeAccessMode, attributes map[string]string) error
+	NodeUnstageVolume(ctx context.Context, volumeID, stagingTargetPath string) error
+	NodePublishVolume(ctx context.Context, volumeID string, readOnly bool, stagingTargetPath, targetPath string, accessMode v1.PersistentVolumeAccessMode, publishInfo, attributes map[string]string, fsType string, mountOptions []string) error
+	NodeUnpublishVolume(ctx context.Context, volumeID, targetPath string) error
+}
+
+// csiDriverClient implements csiClient over the socket of a driver.
+type csiDriverClient struct {
+	driverName string
+	addr       string
+}
+
+var _ csiClient = &csiDriverClient{}
+
+func newCSIDriverClient(driverName, addr string) csiClient {
+	return &csiDriverClient{
+		driverName: driverName,
+		addr:       addr,
+	}
+}
+
+// dial creates a net.Conn by unix socket addr.
+func dial(addr string, timeout time.Duration) (net.Conn, error) {
+	return net.DialTimeout("unix", addr, timeout)
+}
+
+func (c *csiDriverClient) connect() (*grpc.ClientConn, error) {
+	glog.V(4).Infof("csi: connecting to driver %s at %s", c.driverName, c.addr)
+	conn, err := grpc.Dial(c.addr, grpc.WithInsecure(), grpc.WithTimeout(csiTimeout), grpc.WithDialer(dial))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to CSI driver %s: %v", c.driverName, err)
+	}
+	return conn, nil
+}
+
+func (c *csiDriverClient) CreateVolume(ctx context.Context, name string, requiredBytes int64, accessModes []v1.PersistentVolumeAccessMode, fsType string, parameters map[string]string) (*csiapi.Volume, error) {
+	conn, err := c.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	capabilities := make([]*csiapi.VolumeCapability, 0, len(accessModes))
+	for _, mode := range accessModes {
+		capabilities = append(capabilities, newVolumeCapability(mode, fsType, nil))
+	}
+
+	resp, err := csiapi.NewControllerClient(conn).CreateVolume(ctx, &csiapi.CreateVolumeRequest{
+		Name:               name,
+		CapacityRange:      &csiapi.CapacityRange{RequiredBytes: requiredBytes},
+		VolumeCapabilities: capabilities,
+		Parameters:         parameters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Volume == nil || resp.Volume.Id == "" {
+		return nil, fmt.Errorf("CSI driver %s returned no volume", c.driverName)
+	}
+	return resp.Volume, nil
+}
+
+func (c *csiDriverClient) DeleteVolume(ctx context.Context, volumeID string) error {
+	conn, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = csiapi.NewControllerClient(conn).DeleteVolume(ctx, &csiapi.DeleteVolumeRequest{
+		VolumeId: volumeID,
+	})
+	return err
+}
+
+func (c *csiDriverClient) NodeSupportsStageUnstage(ctx context.Context) (bool, error) {
+	conn, err := c.connect()
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	resp, err := csiapi.NewNodeClient(conn).NodeGetCapabilities(ctx, &csiapi.NodeGetCapabilitiesRequest{})
+	if err != nil {
+		return false, err
+	}
+	for _, capability := range resp.Capabilities {
+		if capability.Rpc != nil && capability.Rpc.Type == csiapi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *csiDriverClient) NodeStageVolume(ctx context.Context, volumeID string, publishInfo map[string]string, stagingTargetPath string, fsType string, accessMode v1.PersistentVolumeAccessMode, attributes map[string]string) error {
+	conn, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = csiapi.NewNodeClient(conn).NodeStageVolume(ctx, &csiapi.NodeStageVolumeRequest{
+		VolumeId:          volumeID,
+		PublishInfo:       publishInfo,
+		StagingTargetPath: stagingTargetPath,
+		VolumeCapability:  newVolumeCapability(accessMode, fsType, nil),
+		VolumeAttributes:  attributes,
+	})
+	return err
+}
+
+func (c *csiDriverClient) NodeUnstageVolume(ctx context.Context, volumeID, stagingTargetPath string) error {
+	conn, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = csiapi.NewNodeClient(conn).NodeUnstageVolume(ctx, &csiapi.NodeUnstageVolumeRequest{
+		VolumeId:          volumeID,
+		StagingTargetPath: stagingTargetPath,
+	})
+	return err
+}
+
+func (c *csiDriverClient) NodePublishVolume(ctx context.Context, volumeID string, readOnly bool, stagingTargetPath, targetPath string, accessMode v1.PersistentVolumeAccessMode, publishInfo, attributes map[string]string, fsType string, mountOptions []string) error {
+	conn, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = csiapi.NewNodeClient(conn).NodePublishVolume(ctx, &csiapi.NodePublishVolumeRequest{
+		VolumeId:          volumeID,
+		PublishInfo:       publishInfo,
+		StagingTargetPath: stagingTargetPath,
+		TargetPath:        targetPath,
+		VolumeCapability:  newVolumeCapability(accessMode, fsType, mountOptions),
+		Readonly:          readOnly,
+		VolumeAttributes:  attributes,
+	})
+	return err
+}
+
+func (c *csiDriverClient) NodeUnpublishVolume(ctx context.Context, volumeID, targetPath string) error {
+	conn, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = csiapi.NewNodeClient(conn).NodeUnpublishVolume(ctx, &csiapi.NodeUnpublishVolumeRequest{
+		VolumeId:   volumeID,
+		TargetPath: targetPath,
+	})
+	return err
+}
+
+// newVolumeCapability returns the mount capability of a volume accessed
+// with the given mode.
+func newVolumeCapability(accessMode v1.PersistentVolumeAccessMode, fsType string, mountFlags []string) *csiapi.VolumeCapability {
+	return &csiapi.VolumeCapability{
+		AccessType: &csiapi.VolumeCapability_Mount{
+			Mount: &csiapi.VolumeCapability_MountVolume{
+				FsType:     fsType,
+				MountFlags: mountFlags,
+			},
+		},
+		AccessMode: &csiapi.VolumeCapability_AccessMode{
+			Mode: asCSIAccessMode(accessMode),
+		},
+	}
+}
+
+func asCSIAccessMode(accessMode v1.PersistentVolumeAccessMode) csiapi.VolumeCapability_AccessMode_Mode {
+	switch accessMode {
+	case v1.ReadWriteOnce:
+		return csiapi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER
+	case v1.ReadOnlyMany:
+		return csiapi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY
+	case v1.ReadWriteMany:
+		return csiapi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER
+	}
+	return csiapi.VolumeCapability_AccessMode_UNKNOWN
+}
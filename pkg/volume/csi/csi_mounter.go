@@ -0,0 +1,162 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubernetes/pkg/util/mount"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+// csiMountMgr publishes a volume to the directory of a pod and unpublishes
+// it from there.
+type csiMountMgr struct {
+	plugin       *csiPlugin
+	k8s          clientset.Interface
+	mounter      mount.Interface
+	csiClient    csiClient
+	podUID       types.UID
+	specName     string
+	driverName   string
+	volumeHandle string
+	fsType       string
+	readOnly     bool
+	attributes   map[string]string
+	accessModes  []v1.PersistentVolumeAccessMode
+	mountOptions []string
+	volume.MetricsNil
+}
+
+var _ volume.Mounter = &csiMountMgr{}
+var _ volume.Unmounter = &csiMountMgr{}
+
+func (c *csiMountMgr) GetPath() string {
+	return getTargetPath(c.podUID, c.specName, c.plugin.host)
+}
+
+func (c *csiMountMgr) CanMount() error {
+	return nil
+}
+
+func (c *csiMountMgr) SetUp(fsGroup *int64) error {
+	return c.SetUpAt(c.GetPath(), fsGroup)
+}
+
+func (c *csiMountMgr) SetUpAt(dir string, fsGroup *int64) error {
+	notMnt, err := c.mounter.IsLikelyNotMountPoint(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if !notMnt {
+		glog.V(4).Infof("csi: volume %s is already published at %s", c.volumeHandle, dir)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+
+	stageUnstageSet, err := c.csiClient.NodeSupportsStageUnstage(ctx)
+	if err != nil {
+		return err
+	}
+	stagingTargetPath := ""
+	if stageUnstageSet {
+		stagingTargetPath = makeDeviceMountPath(c.plugin, c.specName)
+	}
+
+	attachID := getAttachmentName(c.volumeHandle, c.driverName, string(c.plugin.host.GetNodeName()))
+	attachment, err := c.k8s.StorageV1beta1().VolumeAttachments().Get(attachID, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The volume data lets the unmounter and the reconstruction of the
+	// volume spec find the driver after the pod is gone.
+	dataDir := filepath.Dir(dir)
+	data := map[string]string{
+		volDataKeySpecName:     c.specName,
+		volDataKeyDriverName:   c.driverName,
+		volDataKeyVolumeHandle: c.volumeHandle,
+		volDataKeyReadOnly:     strconv.FormatBool(c.readOnly),
+		volDataKeyFSType:       c.fsType,
+	}
+	if err := saveVolumeData(dataDir, data); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+
+	err = c.csiClient.NodePublishVolume(ctx,
+		c.volumeHandle,
+		c.readOnly,
+		stagingTargetPath,
+		dir,
+		getAccessMode(c.accessModes),
+		attachment.Status.AttachmentMetadata,
+		c.attributes,
+		c.fsType,
+		c.mountOptions)
+	if err != nil {
+		glog.Errorf("csi: failed to publish volume %s at %s: %v", c.volumeHandle, dir, err)
+		if removeErr := removeVolumeDataDir(dataDir, dir); removeErr != nil {
+			glog.Errorf("csi: failed to clean up %s: %v", dataDir, removeErr)
+		}
+		return err
+	}
+
+	if !c.readOnly {
+		volume.SetVolumeOwnership(c, fsGroup)
+	}
+	glog.V(4).Infof("csi: published volume %s at %s", c.volumeHandle, dir)
+	return nil
+}
+
+func (c *csiMountMgr) GetAttributes() volume.Attributes {
+	return volume.Attributes{
+		ReadOnly:        c.readOnly,
+		Managed:         !c.readOnly,
+		SupportsSELinux: true,
+	}
+}
+
+func (c *csiMountMgr) TearDown() error {
+	return c.TearDownAt(c.GetPath())
+}
+
+func (c *csiMountMgr) TearDownAt(dir string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+
+	if err := c.csiClient.NodeUnpublishVolume(ctx, c.volumeHandle, dir); err != nil {
+		glog.Errorf("csi: failed to unpublish volume %s from %s: %v", c.volumeHandle, dir, err)
+		return err
+	}
+	glog.V(4).Infof("csi: unpublished volume %s from %s", c.volumeHandle, dir)
+
+	return removeVolumeDataDir(filepath.Dir(dir), dir)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/util/mount"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+func TestMounterSetUpTearDown(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	pv := env.createVolume(t, "pv-1", "vol-1")
+	createAttachment(t, env, "pv-1", "vol-1", nil)
+	spec := volume.NewSpecFromPersistentVolume(pv, true)
+	podUID := types.UID("poduid")
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: podUID}}
+
+	mounter, err := env.plugin.NewMounter(spec, pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Fatalf("NewMounter failed: %v", err)
+	}
+	path := mounter.GetPath()
+	expectedPath := filepath.Join(env.tmpDir, "pods", string(podUID), "volumes", "kubernetes.io~csi", "pv-1", "mount")
+	if path != expectedPath {
+		t.Errorf("expected path %s, got %s", expectedPath, path)
+	}
+	if !mounter.GetAttributes().ReadOnly {
+		t.Errorf("expected a read only mounter")
+	}
+
+	if err := mounter.SetUp(nil); err != nil {
+		t.Fatalf("SetUp failed: %v", err)
+	}
+	fakeMounter := env.host.GetMounter().(*mount.FakeMounter)
+	if notMnt, _ := fakeMounter.IsLikelyNotMountPoint(path); notMnt {
+		t.Errorf("expected the volume to be published at %s", path)
+	}
+	// SetUp must be idempotent.
+	if err := mounter.SetUp(nil); err != nil {
+		t.Fatalf("second SetUp failed: %v", err)
+	}
+
+	reconstructed, err := env.plugin.ConstructVolumeSpec("pv-1", path)
+	if err != nil {
+		t.Fatalf("ConstructVolumeSpec failed: %v", err)
+	}
+	source, err := getCSISourceFromSpec(reconstructed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reconstructed.Name() != "pv-1" || source.Driver != testDriver || source.VolumeHandle != "vol-1" || source.FSType != "ext4" || !reconstructed.ReadOnly {
+		t.Errorf("unexpected reconstructed spec %+v with source %+v", reconstructed, source)
+	}
+
+	unmounter, err := env.plugin.NewUnmounter("pv-1", podUID)
+	if err != nil {
+		t.Fatalf("NewUnmounter failed: %v", err)
+	}
+	if err := unmounter.TearDown(); err != nil {
+		t.Fatalf("TearDown failed: %v", err)
+	}
+	if notMnt, _ := fakeMounter.IsLikelyNotMountPoint(path); !notMnt {
+		t.Errorf("expected the volume to be unpublished from %s", path)
+	}
+	if _, err := os.Stat(filepath.Dir(path)); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed, got %v", filepath.Dir(path), err)
+	}
+}
+
+func TestMounterSetUpNotAttached(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	pv := env.createVolume(t, "pv-1", "vol-1")
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: types.UID("poduid")}}
+	mounter, err := env.plugin.NewMounter(volume.NewSpecFromPersistentVolume(pv, false), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Fatalf("NewMounter failed: %v", err)
+	}
+	if err := mounter.SetUp(nil); err == nil {
+		t.Fatalf("expected SetUp to fail without a VolumeAttachment")
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/util/mount"
+	kstrings "k8s.io/kubernetes/pkg/util/strings"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+const (
+	csiPluginName = "kubernetes.io/csi"
+
+	// csiTimeout is the timeout of a single call to a driver.
+	csiTimeout = 15 * time.Second
+
+	// volNameSep separates the driver name from the volume handle in the
+	// unique volume name, which is passed back to Detach.
+	volNameSep = "^"
+
+	// volDataFileName is the name of the file holding the volume data
+	// needed to tear a volume down once its spec is gone.
+	volDataFileName = "vol_data.json"
+
+	volDataKeySpecName     = "specVolumeName"
+	volDataKeyDriverName   = "driverName"
+	volDataKeyVolumeHandle = "volumeHandle"
+	volDataKeyReadOnly     = "readOnly"
+	volDataKeyFSType       = "fsType"
+)
+
+// csiAddrTemplate is the location of the socket of a driver, it is expected
+// to be created by the driver when it is deployed on the node.
+var csiAddrTemplate = "/var/lib/kubelet/plugins/%v/csi.sock"
+
+// This is the primary entrypoint for volume plugins.
+func ProbeVolumePlugins() []volume.VolumePlugin {
+	return []volume.VolumePlugin{&csiPlugin{}}
+}
+
+// ProbeControllerVolumePlugins returns the plugin used by the controller
+// manager, which provisions and deletes volumes through the controller side
+// of the drivers. controllerAddrTemplate is the location of its socket, with
+// %v standing for the driver name; volumes are neither provisioned nor
+// deleted when it is empty.
+func ProbeControllerVolumePlugins(controllerAddrTemplate string) []volume.VolumePlugin {
+	return []volume.VolumePlugin{&csiPlugin{controllerAddrTemplate: controllerAddrTemplate}}
+}
+
+type csiPlugin struct {
+	host volume.VolumeHost
+	// controllerAddrTemplate is the location of the socket of the controller
+	// side of a driver, see ProbeControllerVolumePlugins.
+	controllerAddrTemplate string
+	// newClient returns a client of the named driver listening on addr, it is
+	// swapped in tests.
+	newClient func(driverName, addr string) csiClient
+}
+
+var _ volume.VolumePlugin = &csiPlugin{}
+var _ volume.PersistentVolumePlugin = &csiPlugin{}
+var _ volume.AttachableVolumePlugin = &csiPlugin{}
+var _ volume.ProvisionableVolumePlugin = &csiPlugin{}
+var _ volume.DeletableVolumePlugin = &csiPlugin{}
+
+func (p *csiPlugin) Init(host volume.VolumeHost) error {
+	p.host = host
+	if p.newClient == nil {
+		p.newClient = newCSIDriverClient
+	}
+	return nil
+}
+
+// nodeClient returns a client of the node side of the named driver.
+func (p *csiPlugin) nodeClient(driverName string) csiClient {
+	return p.newClient(driverName, fmt.Sprintf(csiAddrTemplate, driverName))
+}
+
+// controllerClient returns a client of the controller side of the named
+// driver.
+func (p *csiPlugin) controllerClient(driverName string) (csiClient, error) {
+	if len(p.controllerAddrTemplate) == 0 {
+		return nil, fmt.Errorf("no controller socket is configured for CSI driver %s", driverName)
+	}
+	return p.newClient(driverName, fmt.Sprintf(p.controllerAddrTemplate, driverName)), nil
+}
+
+func (p *csiPlugin) GetPluginName() string {
+	return csiPluginName
+}
+
+// GetVolumeName returns the driver name and the volume handle joined by
+// volNameSep, which identifies the volume across all the drivers.
+func (p *csiPlugin) GetVolumeName(spec *volume.Spec) (string, error) {
+	source, err := getCSISourceFromSpec(spec)
+	if err != nil {
+		return "", err
+	}
+	return makeVolumeName(source.Driver, source.VolumeHandle), nil
+}
+
+func (p *csiPlugin) CanSupport(spec *volume.Spec) bool {
+	return spec.PersistentVolume != nil && spec.PersistentVolume.Spec.CSI != nil
+}
+
+func (p *csiPlugin) RequiresRemount() bool {
+	return false
+}
+
+func (p *csiPlugin) SupportsMountOption() bool {
+	return true
+}
+
+func (p *csiPlugin) GetAccessModes() []v1.PersistentVolumeAccessMode {
+	return []v1.PersistentVolumeAccessMode{
+		v1.ReadWriteOnce,
+		v1.ReadOnlyMany,
+		v1.ReadWriteMany,
+	}
+}
+
+func (p *csiPlugin) NewMounter(spec *volume.Spec, pod *v1.Pod, _ volume.VolumeOptions) (volume.Mounter, error) {
+	source, err := getCSISourceFromSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csiMountMgr{
+		plugin:       p,
+		k8s:          p.host.GetKubeClient(),
+		mounter:      p.host.GetMounter(),
+		csiClient:    p.nodeClient(source.Driver),
+		podUID:       pod.UID,
+		specName:     spec.Name(),
+		driverName:   source.Driver,
+		volumeHandle: source.VolumeHandle,
+		fsType:       source.FSType,
+		readOnly:     spec.ReadOnly || source.ReadOnly,
+		attributes:   source.VolumeAttributes,
+		accessModes:  spec.PersistentVolume.Spec.AccessModes,
+		mountOptions: volume.MountOptionFromSpec(spec),
+	}, nil
+}
+
+func (p *csiPlugin) NewUnmounter(specName string, podUID types.UID) (volume.Unmounter, error) {
+	dataDir := getVolumeDataDir(podUID, specName, p.host)
+	data, err := loadVolumeData(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csiMountMgr{
+		plugin:       p,
+		mounter:      p.host.GetMounter(),
+		csiClient:    p.nodeClient(data[volDataKeyDriverName]),
+		podUID:       podUID,
+		specName:     specName,
+		driverName:   data[volDataKeyDriverName],
+		volumeHandle: data[volDataKeyVolumeHandle],
+	}, nil
+}
+
+// ConstructVolumeSpec rebuilds the spec of a mounted volume from the volume
+// data saved next to its mount point.
+func (p *csiPlugin) ConstructVolumeSpec(volumeName, mountPath string) (*volume.Spec, error) {
+	data, err := loadVolumeData(filepath.Dir(mountPath))
+	if err != nil {
+		return nil, err
+	}
+
+	readOnly := data[volDataKeyReadOnly] == "true"
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: data[volDataKeySpecName],
+		},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{
+					Driver:       data[volDataKeyDriverName],
+					VolumeHandle: data[volDataKeyVolumeHandle],
+					ReadOnly:     readOnly,
+					FSType:       data[volDataKeyFSType],
+				},
+			},
+		},
+	}
+	return volume.NewSpecFromPersistentVolume(pv, readOnly), nil
+}
+
+func (p *csiPlugin) NewAttacher() (volume.Attacher, error) {
+	return &csiAttacher{
+		plugin:        p,
+		k8s:           p.host.GetKubeClient(),
+		waitSleepTime: 1 * time.Second,
+	}, nil
+}
+
+func (p *csiPlugin) NewDetacher() (volume.Detacher, error) {
+	return &csiAttacher{
+		plugin:        p,
+		k8s:           p.host.GetKubeClient(),
+		waitSleepTime: 1 * time.Second,
+	}, nil
+}
+
+func (p *csiPlugin) GetDeviceMountRefs(deviceMountPath string) ([]string, error) {
+	return mount.GetMountRefs(p.host.GetMounter(), deviceMountPath)
+}
+
+func (p *csiPlugin) NewProvisioner(options volume.VolumeOptions) (volume.Provisioner, error) {
+	driverName := options.Parameters[provisionerParamDriver]
+	if driverName == "" {
+		return nil, fmt.Errorf("StorageClass parameter %q is required", provisionerParamDriver)
+	}
+
+	client, err := p.controllerClient(driverName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csiProvisioner{
+		plugin:     p,
+		csiClient:  client,
+		driverName: driverName,
+		options:    options,
+	}, nil
+}
+
+func (p *csiPlugin) NewDeleter(spec *volume.Spec) (volume.Deleter, error) {
+	source, err := getCSISourceFromSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := p.controllerClient(source.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csiDeleter{
+		plugin:       p,
+		csiClient:    client,
+		specName:     spec.Name(),
+		volumeHandle: source.VolumeHandle,
+	}, nil
+}
+
+func getCSISourceFromSpec(spec *volume.Spec) (*v1.CSIPersistentVolumeSource, error) {
+	if spec.PersistentVolume != nil && spec.PersistentVolume.Spec.CSI != nil {
+		return spec.PersistentVolume.Spec.CSI, nil
+	}
+	return nil, fmt.Errorf("Spec does not reference a CSI volume type")
+}
+
+func makeVolumeName(driverName, volumeHandle string) string {
+	return driverName + volNameSep + volumeHandle
+}
+
+// splitVolumeName is the reverse of makeVolumeName.
+func splitVolumeName(volumeName string) (string, string, error) {
+	parts := strings.SplitN(volumeName, volNameSep, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid CSI volume name %q", volumeName)
+	}
+	return parts[0], parts[1], nil
+}
+
+// getVolumeDataDir returns the per-pod directory of the volume, it contains
+// the volume data file and the mount point of the volume.
+func getVolumeDataDir(podUID types.UID, specName string, host volume.VolumeHost) string {
+	return host.GetPodVolumeDir(podUID, kstrings.EscapeQualifiedNameForDisk(csiPluginName), specName)
+}
+
+// getTargetPath returns the path the volume is published to for the pod.
+func getTargetPath(podUID types.UID, specName string, host volume.VolumeHost) string {
+	return filepath.Join(getVolumeDataDir(podUID, specName, host), "mount")
+}
+
+// saveVolumeData writes the volume data file in dir, creating dir if needed.
+func saveVolumeData(dir string, data map[string]string) error {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+	file, err := os.Create(filepath.Join(dir, volDataFileName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := json.NewEncoder(file).Encode(data); err != nil {
+		glog.Errorf("csi: failed to save volume data in %s: %v", dir, err)
+		return err
+	}
+	return nil
+}
+
+// loadVolumeData reads the volume data file from dir.
+func loadVolumeData(dir string) (map[string]string, error) {
+	file, err := os.Open(filepath.Join(dir, volDataFileName))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	data := map[string]string{}
+	if err := json.NewDecoder(file).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to read volume data in %s: %v", dir, err)
+	}
+	return data, nil
+}
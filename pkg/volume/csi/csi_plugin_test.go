@@ -0,0 +1,199 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utiltesting "k8s.io/client-go/util/testing"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset/fake"
+	"k8s.io/kubernetes/pkg/volume"
+	csiapi "k8s.io/kubernetes/pkg/volume/csi/api/v1alpha1/driver"
+	"k8s.io/kubernetes/pkg/volume/csi/mock"
+	volumetest "k8s.io/kubernetes/pkg/volume/testing"
+)
+
+const testDriver = "mock.csi.k8s.io"
+
+// testEnv runs the plugin against the mock driver served on a socket in a
+// temporary directory.
+type testEnv struct {
+	tmpDir   string
+	addr     string
+	client   *fake.Clientset
+	host     volume.VolumeHost
+	plugin   *csiPlugin
+	driver   *mock.Driver
+	server   *grpc.Server
+	origAddr string
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	tmpDir, err := utiltesting.MkTmpdir("csiTest")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+
+	env := &testEnv{
+		tmpDir:   tmpDir,
+		client:   fake.NewSimpleClientset(),
+		origAddr: csiAddrTemplate,
+	}
+	csiAddrTemplate = filepath.Join(tmpDir, "%v.sock")
+	env.addr = fmt.Sprintf(csiAddrTemplate, testDriver)
+
+	env.host = volumetest.NewFakeVolumeHost(tmpDir, env.client, nil)
+	plugMgr := volume.VolumePluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, env.host)
+	plug, err := plugMgr.FindPluginByName(csiPluginName)
+	if err != nil {
+		t.Fatalf("Can't find the plugin by name")
+	}
+	env.plugin = plug.(*csiPlugin)
+
+	dataDir := filepath.Join(tmpDir, "data")
+	if err := os.MkdirAll(dataDir, 0750); err != nil {
+		t.Fatalf("can't make the data dir: %v", err)
+	}
+	env.driver = mock.NewDriver(testDriver, dataDir, env.host.GetMounter())
+	listener, err := net.Listen("unix", env.addr)
+	if err != nil {
+		t.Fatalf("can't listen on %s: %v", env.addr, err)
+	}
+	env.server = env.driver.NewServer()
+	go env.server.Serve(listener)
+	return env
+}
+
+func (e *testEnv) cleanup() {
+	e.server.Stop()
+	csiAddrTemplate = e.origAddr
+	os.RemoveAll(e.tmpDir)
+}
+
+// createVolume creates a volume in the driver and a PersistentVolume for it.
+func (e *testEnv) createVolume(t *testing.T, pvName, volumeID string) *v1.PersistentVolume {
+	_, err := e.driver.CreateVolume(context.Background(), &csiapi.CreateVolumeRequest{Name: volumeID})
+	if err != nil {
+		t.Fatalf("failed to create volume %s: %v", volumeID, err)
+	}
+	pv := makeTestPV(pvName, volumeID)
+	if _, err := e.client.Core().PersistentVolumes().Create(pv); err != nil {
+		t.Fatalf("failed to create PersistentVolume %s: %v", pvName, err)
+	}
+	return pv
+}
+
+func makeTestPV(name, volumeHandle string) *v1.PersistentVolume {
+	return &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1.PersistentVolumeSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{
+					Driver:       testDriver,
+					VolumeHandle: volumeHandle,
+					FSType:       "ext4",
+				},
+			},
+		},
+	}
+}
+
+func TestCanSupport(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	if env.plugin.GetPluginName() != csiPluginName {
+		t.Errorf("Wrong name: %s", env.plugin.GetPluginName())
+	}
+	if !env.plugin.CanSupport(volume.NewSpecFromPersistentVolume(makeTestPV("pv", "vol"), false)) {
+		t.Errorf("Expected true")
+	}
+	if env.plugin.CanSupport(&volume.Spec{PersistentVolume: &v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{}}}}}) {
+		t.Errorf("Expected false")
+	}
+	if env.plugin.CanSupport(&volume.Spec{Volume: &v1.Volume{VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func TestGetVolumeName(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	name, err := env.plugin.GetVolumeName(volume.NewSpecFromPersistentVolume(makeTestPV("pv", "vol-1"), false))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != testDriver+"^vol-1" {
+		t.Errorf("unexpected volume name %q", name)
+	}
+
+	driverName, volumeHandle, err := splitVolumeName(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if driverName != testDriver || volumeHandle != "vol-1" {
+		t.Errorf("unexpected driver %q and volume handle %q", driverName, volumeHandle)
+	}
+
+	for _, invalid := range []string{"", "vol-1", "^vol-1", testDriver + "^"} {
+		if _, _, err := splitVolumeName(invalid); err == nil {
+			t.Errorf("expected an error for volume name %q", invalid)
+		}
+	}
+}
+
+func TestVolumeData(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	dir := filepath.Join(env.tmpDir, "voldata")
+	data := map[string]string{
+		volDataKeySpecName:     "pv",
+		volDataKeyDriverName:   testDriver,
+		volDataKeyVolumeHandle: "vol-1",
+	}
+	if err := saveVolumeData(dir, data); err != nil {
+		t.Fatalf("failed to save volume data: %v", err)
+	}
+	loaded, err := loadVolumeData(dir)
+	if err != nil {
+		t.Fatalf("failed to load volume data: %v", err)
+	}
+	for k, v := range data {
+		if loaded[k] != v {
+			t.Errorf("expected %s=%q, got %q", k, v, loaded[k])
+		}
+	}
+
+	if _, err := loadVolumeData(filepath.Join(env.tmpDir, "missing")); !os.IsNotExist(err) {
+		t.Errorf("expected a not exist error, got %v", err)
+	}
+}
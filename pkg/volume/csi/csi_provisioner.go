@@ -0,0 +1,138 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+const (
+	// provisionerParamDriver is the StorageClass parameter naming the driver
+	// that creates the volumes of the class.
+	provisionerParamDriver = "driver"
+	// provisionerParamFSType is the StorageClass parameter setting the file
+	// system of the created volumes.
+	provisionerParamFSType = "fstype"
+)
+
+type csiProvisioner struct {
+	plugin     *csiPlugin
+	csiClient  csiClient
+	driverName string
+	options    volume.VolumeOptions
+}
+
+var _ volume.Provisioner = &csiProvisioner{}
+
+func (c *csiProvisioner) Provision() (*v1.PersistentVolume, error) {
+	capacity := c.options.PVC.Spec.Resources.Requests[v1.ResourceName(v1.ResourceStorage)]
+	requestBytes := capacity.Value()
+
+	fsType := ""
+	parameters := map[string]string{}
+	for k, v := range c.options.Parameters {
+		switch strings.ToLower(k) {
+		case provisionerParamDriver:
+		case provisionerParamFSType:
+			fsType = v
+		default:
+			parameters[k] = v
+		}
+	}
+
+	accessModes := c.options.PVC.Spec.AccessModes
+	if len(accessModes) == 0 {
+		accessModes = c.plugin.GetAccessModes()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+
+	vol, err := c.csiClient.CreateVolume(ctx, c.options.PVName, requestBytes, accessModes, fsType, parameters)
+	if err != nil {
+		glog.Errorf("csi: driver %s failed to create volume %s: %v", c.driverName, c.options.PVName, err)
+		return nil, err
+	}
+	glog.V(4).Infof("csi: driver %s created volume %s for %s", c.driverName, vol.Id, c.options.PVName)
+
+	capacityBytes := vol.CapacityBytes
+	if capacityBytes == 0 {
+		// The driver does not report the size, assume it honored the request.
+		capacityBytes = requestBytes
+	}
+
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   c.options.PVName,
+			Labels: map[string]string{},
+			Annotations: map[string]string{
+				"kubernetes.io/createdby": "csi-dynamic-provisioner",
+			},
+		},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: c.options.PersistentVolumeReclaimPolicy,
+			AccessModes:                   accessModes,
+			Capacity: v1.ResourceList{
+				v1.ResourceName(v1.ResourceStorage): *resource.NewQuantity(capacityBytes, resource.BinarySI),
+			},
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{
+					Driver:           c.driverName,
+					VolumeHandle:     vol.Id,
+					FSType:           fsType,
+					VolumeAttributes: vol.Attributes,
+				},
+			},
+		},
+	}
+	return pv, nil
+}
+
+type csiDeleter struct {
+	plugin       *csiPlugin
+	csiClient    csiClient
+	specName     string
+	volumeHandle string
+	volume.MetricsNil
+}
+
+var _ volume.Deleter = &csiDeleter{}
+
+func (c *csiDeleter) GetPath() string {
+	return filepath.Join(c.plugin.host.GetPluginDir(csiPluginName), "pv", c.specName)
+}
+
+func (c *csiDeleter) Delete() error {
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+
+	if err := c.csiClient.DeleteVolume(ctx, c.volumeHandle); err != nil {
+		return fmt.Errorf("failed to delete CSI volume %s: %v", c.volumeHandle, err)
+	}
+	glog.V(4).Infof("csi: deleted volume %s", c.volumeHandle)
+	return nil
+}
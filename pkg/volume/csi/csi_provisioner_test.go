@@ -0,0 +1,104 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+func TestProvisionDelete(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	options := volume.VolumeOptions{
+		PVName:                        "pvc-1",
+		PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimDelete,
+		PVC: &v1.PersistentVolumeClaim{
+			Spec: v1.PersistentVolumeClaimSpec{
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceName(v1.ResourceStorage): resource.MustParse("1Gi"),
+					},
+				},
+			},
+		},
+		Parameters: map[string]string{
+			"driver": testDriver,
+			"fstype": "xfs",
+			"tier":   "gold",
+		},
+	}
+
+	if _, err := env.plugin.NewProvisioner(volume.VolumeOptions{}); err == nil {
+		t.Errorf("expected an error without a driver parameter")
+	}
+	if _, err := env.plugin.NewProvisioner(options); err == nil {
+		t.Errorf("expected an error without a controller socket")
+	}
+
+	// the mock driver serves both the controller and the node services
+	env.plugin.controllerAddrTemplate = csiAddrTemplate
+
+	provisioner, err := env.plugin.NewProvisioner(options)
+	if err != nil {
+		t.Fatalf("NewProvisioner failed: %v", err)
+	}
+	pv, err := provisioner.Provision()
+	if err != nil {
+		t.Fatalf("Provision failed: %v", err)
+	}
+
+	source := pv.Spec.CSI
+	if source == nil {
+		t.Fatalf("expected a CSI volume, got %+v", pv.Spec.PersistentVolumeSource)
+	}
+	if source.Driver != testDriver || source.VolumeHandle != "pvc-1" || source.FSType != "xfs" {
+		t.Errorf("unexpected volume source %+v", source)
+	}
+	if len(source.VolumeAttributes) != 1 || source.VolumeAttributes["tier"] != "gold" {
+		t.Errorf("unexpected volume attributes %v", source.VolumeAttributes)
+	}
+	capacity := pv.Spec.Capacity[v1.ResourceName(v1.ResourceStorage)]
+	if capacity.Cmp(resource.MustParse("1Gi")) != 0 {
+		t.Errorf("expected a capacity of 1Gi, got %s", capacity.String())
+	}
+	if pv.Spec.PersistentVolumeReclaimPolicy != v1.PersistentVolumeReclaimDelete {
+		t.Errorf("unexpected reclaim policy %s", pv.Spec.PersistentVolumeReclaimPolicy)
+	}
+	volumePath := filepath.Join(env.tmpDir, "data", "pvc-1")
+	if _, err := os.Stat(volumePath); err != nil {
+		t.Errorf("expected the driver to create %s: %v", volumePath, err)
+	}
+
+	deleter, err := env.plugin.NewDeleter(volume.NewSpecFromPersistentVolume(pv, false))
+	if err != nil {
+		t.Fatalf("NewDeleter failed: %v", err)
+	}
+	if err := deleter.Delete(); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := os.Stat(volumePath); !os.IsNotExist(err) {
+		t.Errorf("expected the driver to remove %s, got %v", volumePath, err)
+	}
+}
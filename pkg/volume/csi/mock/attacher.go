@@ -0,0 +1,205 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	csiapi "k8s.io/kubernetes/pkg/volume/csi/api/v1alpha1/driver"
+)
+
+const callTimeout = 15 * time.Second
+
+// Attacher is the external attacher of a driver. It publishes the volumes of
+// the VolumeAttachments of the driver to their nodes through the controller
+// service and reports the result in their status. A finalizer keeps the
+// VolumeAttachments until their volumes are unpublished.
+type Attacher struct {
+	client     clientset.Interface
+	driverName string
+	controller csiapi.ControllerClient
+	finalizer  string
+}
+
+// NewAttacher returns an external attacher of the VolumeAttachments of the
+// named driver.
+func NewAttacher(client clientset.Interface, driverName string, controller csiapi.ControllerClient) *Attacher {
+	return &Attacher{
+		client:     client,
+		driverName: driverName,
+		controller: controller,
+		finalizer:  "external-attacher/" + driverName,
+	}
+}
+
+// Run syncs the VolumeAttachments of the driver every period until stopCh
+// is closed.
+func (a *Attacher) Run(period time.Duration, stopCh <-chan struct{}) {
+	wait.Until(a.Sync, period, stopCh)
+}
+
+// Sync attaches or detaches the volumes of all the VolumeAttachments of the
+// driver once.
+func (a *Attacher) Sync() {
+	attachments, err := a.client.StorageV1beta1().VolumeAttachments().List(metav1.ListOptions{})
+	if err != nil {
+		glog.Errorf("external attacher %s: failed to list VolumeAttachments: %v", a.driverName, err)
+		return
+	}
+	for i := range attachments.Items {
+		attachment := &attachments.Items[i]
+		if attachment.Spec.Attacher != a.driverName {
+			continue
+		}
+		if err := a.syncAttachment(attachment); err != nil {
+			glog.Errorf("external attacher %s: failed to sync VolumeAttachment %s: %v", a.driverName, attachment.Name, err)
+		}
+	}
+}
+
+func (a *Attacher) syncAttachment(attachment *storage.VolumeAttachment) error {
+	if attachment.DeletionTimestamp != nil {
+		return a.detach(attachment)
+	}
+	if attachment.Status.Attached {
+		return nil
+	}
+	return a.attach(attachment)
+}
+
+func (a *Attacher) attach(attachment *storage.VolumeAttachment) error {
+	if !hasFinalizer(attachment, a.finalizer) {
+		attachment.Finalizers = append(attachment.Finalizers, a.finalizer)
+		updated, err := a.client.StorageV1beta1().VolumeAttachments().Update(attachment)
+		if err != nil {
+			return err
+		}
+		attachment = updated
+	}
+
+	publishInfo, err := a.publish(attachment)
+	if err != nil {
+		attachment.Status.AttachError = &storage.VolumeError{
+			Time:    metav1.Now(),
+			Message: err.Error(),
+		}
+	} else {
+		attachment.Status.Attached = true
+		attachment.Status.AttachmentMetadata = publishInfo
+		attachment.Status.AttachError = nil
+	}
+	if _, updateErr := a.client.StorageV1beta1().VolumeAttachments().UpdateStatus(attachment); updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+func (a *Attacher) publish(attachment *storage.VolumeAttachment) (map[string]string, error) {
+	if attachment.Spec.Source.PersistentVolumeName == nil {
+		return nil, fmt.Errorf("VolumeAttachment does not reference a PersistentVolume")
+	}
+	pv, err := a.client.Core().PersistentVolumes().Get(*attachment.Spec.Source.PersistentVolumeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if pv.Spec.CSI == nil {
+		return nil, fmt.Errorf("PersistentVolume %s is not a CSI volume", pv.Name)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	resp, err := a.controller.ControllerPublishVolume(ctx, &csiapi.ControllerPublishVolumeRequest{
+		VolumeId:         pv.Spec.CSI.VolumeHandle,
+		NodeId:           attachment.Spec.NodeName,
+		Readonly:         pv.Spec.CSI.ReadOnly,
+		VolumeAttributes: pv.Spec.CSI.VolumeAttributes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	glog.V(4).Infof("external attacher %s: attached volume %s to node %s", a.driverName, pv.Spec.CSI.VolumeHandle, attachment.Spec.NodeName)
+	return resp.PublishInfo, nil
+}
+
+func (a *Attacher) detach(attachment *storage.VolumeAttachment) error {
+	if !hasFinalizer(attachment, a.finalizer) {
+		return nil
+	}
+
+	if err := a.unpublish(attachment); err != nil {
+		attachment.Status.DetachError = &storage.VolumeError{
+			Time:    metav1.Now(),
+			Message: err.Error(),
+		}
+		if _, updateErr := a.client.StorageV1beta1().VolumeAttachments().UpdateStatus(attachment); updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+
+	finalizers := []string{}
+	for _, finalizer := range attachment.Finalizers {
+		if finalizer != a.finalizer {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	attachment.Finalizers = finalizers
+	_, err := a.client.StorageV1beta1().VolumeAttachments().Update(attachment)
+	return err
+}
+
+func (a *Attacher) unpublish(attachment *storage.VolumeAttachment) error {
+	if attachment.Spec.Source.PersistentVolumeName == nil {
+		return nil
+	}
+	pv, err := a.client.Core().PersistentVolumes().Get(*attachment.Spec.Source.PersistentVolumeName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if pv.Spec.CSI == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	_, err = a.controller.ControllerUnpublishVolume(ctx, &csiapi.ControllerUnpublishVolumeRequest{
+		VolumeId: pv.Spec.CSI.VolumeHandle,
+		NodeId:   attachment.Spec.NodeName,
+	})
+	if err != nil {
+		return err
+	}
+	glog.V(4).Infof("external attacher %s: detached volume %s from node %s", a.driverName, pv.Spec.CSI.VolumeHandle, attachment.Spec.NodeName)
+	return nil
+}
+
+func hasFinalizer(attachment *storage.VolumeAttachment, finalizer string) bool {
+	for _, f := range attachment.Finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mock implements a CSI driver keeping its volumes in directories
+// of the local file system, and an external attacher for it. They are meant
+// for testing the CSI volume plugin only.
+package mock
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/mount"
+	csiapi "k8s.io/kubernetes/pkg/volume/csi/api/v1alpha1/driver"
+)
+
+const vendorVersion = "0.1.0"
+
+// Driver implements the identity, controller and node services of a CSI
+// driver. Volumes are directories of dataDir, they are staged and published
+// with bind mounts.
+type Driver struct {
+	name    string
+	dataDir string
+	mounter mount.Interface
+
+	lock sync.Mutex
+	// volumes holds the created volumes by id.
+	volumes map[string]*csiapi.Volume
+	// published holds the nodes each volume is published to by volume id.
+	published map[string]sets.String
+}
+
+var _ csiapi.IdentityServer = &Driver{}
+var _ csiapi.ControllerServer = &Driver{}
+var _ csiapi.NodeServer = &Driver{}
+
+// NewDriver returns a driver with the given name storing its volumes in
+// dataDir.
+func NewDriver(name, dataDir string, mounter mount.Interface) *Driver {
+	return &Driver{
+		name:      name,
+		dataDir:   dataDir,
+		mounter:   mounter,
+		volumes:   map[string]*csiapi.Volume{},
+		published: map[string]sets.String{},
+	}
+}
+
+// NewServer returns a gRPC server serving all the services of the driver.
+func (d *Driver) NewServer() *grpc.Server {
+	server := grpc.NewServer()
+	csiapi.RegisterIdentityServer(server, d)
+	csiapi.RegisterControllerServer(server, d)
+	csiapi.RegisterNodeServer(server, d)
+	return server
+}
+
+// IsPublished returns true if the volume is published to the node.
+func (d *Driver) IsPublished(volumeID, nodeID string) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.published[volumeID].Has(nodeID)
+}
+
+func (d *Driver) volumePath(volumeID string) string {
+	return filepath.Join(d.dataDir, volumeID)
+}
+
+// volumeExists returns true if the volume was created by the driver. The
+// node and controller services may run in different processes sharing the
+// data directory, so the directory of the volume is enough. The caller must
+// hold the lock.
+func (d *Driver) volumeExists(volumeID string) bool {
+	if _, found := d.volumes[volumeID]; found {
+		return true
+	}
+	if volumeID == "" || strings.Contains(volumeID, "/") {
+		return false
+	}
+	info, err := os.Stat(d.volumePath(volumeID))
+	return err == nil && info.IsDir()
+}
+
+func (d *Driver) GetPluginInfo(ctx context.Context, req *csiapi.GetPluginInfoRequest) (*csiapi.GetPluginInfoResponse, error) {
+	return &csiapi.GetPluginInfoResponse{
+		Name:          d.name,
+		VendorVersion: vendorVersion,
+	}, nil
+}
+
+func (d *Driver) Probe(ctx context.Context, req *csiapi.ProbeRequest) (*csiapi.ProbeResponse, error) {
+	if _, err := os.Stat(d.dataDir); err != nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "data directory: %v", err)
+	}
+	return &csiapi.ProbeResponse{}, nil
+}
+
+func (d *Driver) CreateVolume(ctx context.Context, req *csiapi.CreateVolumeRequest) (*csiapi.CreateVolumeResponse, error) {
+	if req.Name == "" || strings.Contains(req.Name, "/") {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid volume name %q", req.Name)
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if vol, found := d.volumes[req.Name]; found {
+		return &csiapi.CreateVolumeResponse{Volume: vol}, nil
+	}
+	if err := os.MkdirAll(d.volumePath(req.Name), 0750); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "%v", err)
+	}
+
+	vol := &csiapi.Volume{
+		Id:         req.Name,
+		Attributes: req.Parameters,
+	}
+	if req.CapacityRange != nil {
+		vol.CapacityBytes = req.CapacityRange.RequiredBytes
+	}
+	d.volumes[vol.Id] = vol
+	glog.V(4).Infof("mock driver %s: created volume %s", d.name, vol.Id)
+	return &csiapi.CreateVolumeResponse{Volume: vol}, nil
+}
+
+func (d *Driver) DeleteVolume(ctx context.Context, req *csiapi.DeleteVolumeRequest) (*csiapi.DeleteVolumeResponse, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if _, found := d.volumes[req.VolumeId]; !found {
+		return &csiapi.DeleteVolumeResponse{}, nil
+	}
+	if d.published[req.VolumeId].Len() != 0 {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "volume %s is published to %v", req.VolumeId, d.published[req.VolumeId].List())
+	}
+	if err := os.RemoveAll(d.volumePath(req.VolumeId)); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "%v", err)
+	}
+	delete(d.volumes, req.VolumeId)
+	glog.V(4).Infof("mock driver %s: deleted volume %s", d.name, req.VolumeId)
+	return &csiapi.DeleteVolumeResponse{}, nil
+}
+
+func (d *Driver) ControllerPublishVolume(ctx context.Context, req *csiapi.ControllerPublishVolumeRequest) (*csiapi.ControllerPublishVolumeResponse, error) {
+	if req.NodeId == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "node id is required")
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if !d.volumeExists(req.VolumeId) {
+		return nil, grpc.Errorf(codes.NotFound, "volume %s not found", req.VolumeId)
+	}
+	if d.published[req.VolumeId] == nil {
+		d.published[req.VolumeId] = sets.NewString()
+	}
+	d.published[req.VolumeId].Insert(req.NodeId)
+	glog.V(4).Infof("mock driver %s: published volume %s to node %s", d.name, req.VolumeId, req.NodeId)
+
+	return &csiapi.ControllerPublishVolumeResponse{
+		PublishInfo: map[string]string{
+			"path": d.volumePath(req.VolumeId),
+		},
+	}, nil
+}
+
+func (d *Driver) ControllerUnpublishVolume(ctx context.Context, req *csiapi.ControllerUnpublishVolumeRequest) (*csiapi.ControllerUnpublishVolumeResponse, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if nodes, found := d.published[req.VolumeId]; found {
+		nodes.Delete(req.NodeId)
+	}
+	glog.V(4).Infof("mock driver %s: unpublished volume %s from node %s", d.name, req.VolumeId, req.NodeId)
+	return &csiapi.ControllerUnpublishVolumeResponse{}, nil
+}
+
+func (d *Driver) ControllerGetCapabilities(ctx context.Context, req *csiapi.ControllerGetCapabilitiesRequest) (*csiapi.ControllerGetCapabilitiesResponse, error) {
+	return &csiapi.ControllerGetCapabilitiesResponse{
+		Capabilities: []*csiapi.ControllerServiceCapability{
+			{Rpc: &csiapi.ControllerServiceCapability_RPC{Type: csiapi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME}},
+			{Rpc: &csiapi.ControllerServiceCapability_RPC{Type: csiapi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME}},
+		},
+	}, nil
+}
+
+func (d *Driver) NodeStageVolume(ctx context.Context, req *csiapi.NodeStageVolumeRequest) (*csiapi.NodeStageVolumeResponse, error) {
+	if req.StagingTargetPath == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "staging target path is required")
+	}
+	if err := d.bindMount(req.VolumeId, d.volumePath(req.VolumeId), req.StagingTargetPath, false); err != nil {
+		return nil, err
+	}
+	glog.V(4).Infof("mock driver %s: staged volume %s at %s", d.name, req.VolumeId, req.StagingTargetPath)
+	return &csiapi.NodeStageVolumeResponse{}, nil
+}
+
+func (d *Driver) NodeUnstageVolume(ctx context.Context, req *csiapi.NodeUnstageVolumeRequest) (*csiapi.NodeUnstageVolumeResponse, error) {
+	if err := d.unmount(req.StagingTargetPath); err != nil {
+		return nil, err
+	}
+	glog.V(4).Infof("mock driver %s: unstaged volume %s from %s", d.name, req.VolumeId, req.StagingTargetPath)
+	return &csiapi.NodeUnstageVolumeResponse{}, nil
+}
+
+func (d *Driver) NodePublishVolume(ctx context.Context, req *csiapi.NodePublishVolumeRequest) (*csiapi.NodePublishVolumeResponse, error) {
+	if req.TargetPath == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "target path is required")
+	}
+	source := req.StagingTargetPath
+	if source == "" {
+		source = d.volumePath(req.VolumeId)
+	}
+	if err := d.bindMount(req.VolumeId, source, req.TargetPath, req.Readonly); err != nil {
+		return nil, err
+	}
+	glog.V(4).Infof("mock driver %s: published volume %s at %s", d.name, req.VolumeId, req.TargetPath)
+	return &csiapi.NodePublishVolumeResponse{}, nil
+}
+
+func (d *Driver) NodeUnpublishVolume(ctx context.Context, req *csiapi.NodeUnpublishVolumeRequest) (*csiapi.NodeUnpublishVolumeResponse, error) {
+	if err := d.unmount(req.TargetPath); err != nil {
+		return nil, err
+	}
+	glog.V(4).Infof("mock driver %s: unpublished volume %s from %s", d.name, req.VolumeId, req.TargetPath)
+	return &csiapi.NodeUnpublishVolumeResponse{}, nil
+}
+
+func (d *Driver) NodeGetCapabilities(ctx context.Context, req *csiapi.NodeGetCapabilitiesRequest) (*csiapi.NodeGetCapabilitiesResponse, error) {
+	return &csiapi.NodeGetCapabilitiesResponse{
+		Capabilities: []*csiapi.NodeServiceCapability{
+			{Rpc: &csiapi.NodeServiceCapability_RPC{Type: csiapi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME}},
+		},
+	}, nil
+}
+
+// bindMount mounts source at target unless target is already a mount point.
+func (d *Driver) bindMount(volumeID, source, target string, readOnly bool) error {
+	d.lock.Lock()
+	found := d.volumeExists(volumeID)
+	d.lock.Unlock()
+	if !found {
+		return grpc.Errorf(codes.NotFound, "volume %s not found", volumeID)
+	}
+
+	if err := os.MkdirAll(target, 0750); err != nil {
+		return grpc.Errorf(codes.Internal, "%v", err)
+	}
+	notMnt, err := d.mounter.IsLikelyNotMountPoint(target)
+	if err != nil {
+		return grpc.Errorf(codes.Internal, "%v", err)
+	}
+	if !notMnt {
+		return nil
+	}
+
+	options := []string{"bind"}
+	if readOnly {
+		options = append(options, "ro")
+	}
+	if err := d.mounter.Mount(source, target, "", options); err != nil {
+		return grpc.Errorf(codes.Internal, "%v", err)
+	}
+	return nil
+}
+
+// unmount unmounts target if it is a mount point.
+func (d *Driver) unmount(target string) error {
+	notMnt, err := d.mounter.IsLikelyNotMountPoint(target)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return grpc.Errorf(codes.Internal, "%v", err)
+	}
+	if notMnt {
+		return nil
+	}
+	if err := d.mounter.Unmount(target); err != nil {
+		return grpc.Errorf(codes.Internal, "%v", err)
+	}
+	return nil
+}
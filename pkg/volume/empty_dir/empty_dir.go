@@ -22,6 +22,7 @@ import (
 	"path"
 
 	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/kubernetes/pkg/api/v1"
@@ -155,10 +156,14 @@ type mountDetector interface {
 type storageMedium int
 
 const (
-	mediumUnknown storageMedium = 0 // assume anything we don't explicitly handle is this
-	mediumMemory  storageMedium = 1 // memory (e.g. tmpfs on linux)
+	mediumUnknown   storageMedium = 0 // assume anything we don't explicitly handle is this
+	mediumMemory    storageMedium = 1 // memory (e.g. tmpfs on linux)
+	mediumHugepages storageMedium = 2 // hugepages (e.g. hugetlbfs on linux)
 )
 
+// hugePagesPageSizeMountOption is the hugetlbfs mount option selecting the page size.
+const hugePagesPageSizeMountOption = "pagesize"
+
 // EmptyDir volumes are temporary directories exposed to the pod.
 // These do not persist beyond the lifetime of a pod.
 type emptyDir struct {
@@ -205,7 +210,7 @@ func (ed *emptyDir) SetUpAt(dir string, fsGroup *int64) error {
 	// medium is memory, and a mountpoint is present, then the volume is
 	// ready.
 	if volumeutil.IsReady(ed.getMetaDir()) {
-		if ed.medium == v1.StorageMediumMemory && !notMnt {
+		if (ed.medium == v1.StorageMediumMemory || ed.medium == v1.StorageMediumHugePages) && !notMnt {
 			return nil
 		} else if ed.medium == v1.StorageMediumDefault {
 			return nil
@@ -217,6 +222,8 @@ func (ed *emptyDir) SetUpAt(dir string, fsGroup *int64) error {
 		err = ed.setupDir(dir)
 	case v1.StorageMediumMemory:
 		err = ed.setupTmpfs(dir)
+	case v1.StorageMediumHugePages:
+		err = ed.setupHugepages(dir)
 	default:
 		err = fmt.Errorf("unknown storage medium %q", ed.medium)
 	}
@@ -254,6 +261,62 @@ func (ed *emptyDir) setupTmpfs(dir string) error {
 	return ed.mounter.Mount("tmpfs", dir, "tmpfs", nil /* options */)
 }
 
+// setupHugepages creates a hugepage mount at the specified directory.
+func (ed *emptyDir) setupHugepages(dir string) error {
+	if ed.mounter == nil {
+		return fmt.Errorf("hugepages storage requested, but mounter is nil")
+	}
+	if err := ed.setupDir(dir); err != nil {
+		return err
+	}
+	// Make SetUp idempotent.
+	medium, isMnt, err := ed.mountDetector.GetMountMedium(dir)
+	if err != nil {
+		return err
+	}
+	// If the directory is a mountpoint with medium hugepages, there is no
+	// work to do since we are already in the desired state.
+	if isMnt && medium == mediumHugepages {
+		return nil
+	}
+
+	pageSizeMountOption, err := getPageSizeMountOptionFromPod(ed.pod)
+	if err != nil {
+		return err
+	}
+
+	glog.V(3).Infof("pod %v: mounting hugepages for volume %v", ed.pod.UID, ed.volName)
+	return ed.mounter.Mount("nodev", dir, "hugetlbfs", []string{pageSizeMountOption})
+}
+
+// getPageSizeMountOptionFromPod retrieves the pagesize mount option from the
+// huge page limits of the pod. All containers of the pod must consume huge
+// pages of the same size, so the volume can be backed by a single mount.
+func getPageSizeMountOptionFromPod(pod *v1.Pod) (string, error) {
+	pageSizeFound := false
+	pageSize := resource.Quantity{}
+	for _, container := range pod.Spec.Containers {
+		for name := range container.Resources.Limits {
+			if !v1.IsHugePageResourceName(name) {
+				continue
+			}
+			currentPageSize, err := v1.HugePageSizeFromResourceName(name)
+			if err != nil {
+				return "", err
+			}
+			if pageSizeFound && pageSize.Cmp(currentPageSize) != 0 {
+				return "", fmt.Errorf("multiple pageSizes for huge pages in a single pod are not supported")
+			}
+			pageSize = currentPageSize
+			pageSizeFound = true
+		}
+	}
+	if !pageSizeFound {
+		return "", fmt.Errorf("hugePages storage requested, but there is no resource request for huge pages")
+	}
+	return fmt.Sprintf("%s=%d", hugePagesPageSizeMountOption, pageSize.Value()), nil
+}
+
 // setupDir creates the directory with the specified SELinux context and
 // the default permissions specified by the perm constant.
 func (ed *emptyDir) setupDir(dir string) error {
@@ -316,9 +379,14 @@ func (ed *emptyDir) TearDownAt(dir string) error {
 	if err != nil {
 		return err
 	}
-	if isMnt && medium == mediumMemory {
-		ed.medium = v1.StorageMediumMemory
-		return ed.teardownTmpfs(dir)
+	if isMnt {
+		if medium == mediumMemory {
+			ed.medium = v1.StorageMediumMemory
+			return ed.teardownTmpfs(dir)
+		} else if medium == mediumHugepages {
+			ed.medium = v1.StorageMediumHugePages
+			return ed.teardownHugetlbfs(dir)
+		}
 	}
 	// assume StorageMediumDefault
 	return ed.teardownDefault(dir)
@@ -349,6 +417,19 @@ func (ed *emptyDir) teardownTmpfs(dir string) error {
 	return nil
 }
 
+func (ed *emptyDir) teardownHugetlbfs(dir string) error {
+	if ed.mounter == nil {
+		return fmt.Errorf("hugepages storage requested, but mounter is nil")
+	}
+	if err := ed.mounter.Unmount(dir); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (ed *emptyDir) getMetaDir() string {
 	return path.Join(ed.plugin.host.GetPodPluginDir(ed.pod.UID, strings.EscapeQualifiedNameForDisk(emptyDirPluginName)), ed.volName)
 }
@@ -23,6 +23,7 @@ import (
 	"path"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utiltesting "k8s.io/client-go/util/testing"
@@ -36,7 +37,7 @@ import (
 // Construct an instance of a plugin, by name.
 func makePluginUnderTest(t *testing.T, plugName, basePath string) volume.VolumePlugin {
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(basePath, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(basePath, nil, nil))
 
 	plug, err := plugMgr.FindPluginByName(plugName)
 	if err != nil {
@@ -203,6 +204,109 @@ func doTestPlugin(t *testing.T, config pluginTestConfig) {
 	physicalMounter.ResetLog()
 }
 
+func TestPluginHugepages(t *testing.T) {
+	basePath, err := utiltesting.MkTmpdir("emptydir_volume_test")
+	if err != nil {
+		t.Fatalf("can't make a temp rootdir: %v", err)
+	}
+	defer os.RemoveAll(basePath)
+
+	plug := makePluginUnderTest(t, "kubernetes.io/empty-dir", basePath)
+	spec := &v1.Volume{
+		Name:         "test-volume",
+		VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{Medium: v1.StorageMediumHugePages}},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{UID: types.UID("poduid")},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{
+							v1.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi"),
+						},
+					},
+				},
+			},
+		},
+	}
+	physicalMounter := mount.FakeMounter{}
+	mounter, err := plug.(*emptyDirPlugin).newMounterInternal(volume.NewSpecFromVolume(spec), pod, &physicalMounter, &fakeMountDetector{}, volume.VolumeOptions{})
+	if err != nil {
+		t.Fatalf("Failed to make a new Mounter: %v", err)
+	}
+	if err := mounter.SetUp(nil); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if len(physicalMounter.Log) != 1 {
+		t.Fatalf("Expected 1 physicalMounter call during setup, got %v", len(physicalMounter.Log))
+	}
+	if action := physicalMounter.Log[0]; action.Action != mount.FakeActionMount || action.FSType != "hugetlbfs" {
+		t.Errorf("Unexpected physicalMounter action during setup: %#v", action)
+	}
+	physicalMounter.ResetLog()
+
+	unmounter, err := plug.(*emptyDirPlugin).newUnmounterInternal("test-volume", types.UID("poduid"), &physicalMounter, &fakeMountDetector{medium: mediumHugepages, isMount: true})
+	if err != nil {
+		t.Fatalf("Failed to make a new Unmounter: %v", err)
+	}
+	if err := unmounter.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if len(physicalMounter.Log) != 1 || physicalMounter.Log[0].Action != mount.FakeActionUnmount {
+		t.Errorf("Unexpected physicalMounter actions during teardown: %#v", physicalMounter.Log)
+	}
+}
+
+func TestGetPageSizeMountOptionFromPod(t *testing.T) {
+	podWithLimits := func(limits ...v1.ResourceList) *v1.Pod {
+		pod := &v1.Pod{}
+		for _, l := range limits {
+			pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Resources: v1.ResourceRequirements{Limits: l}})
+		}
+		return pod
+	}
+	testCases := map[string]struct {
+		pod            *v1.Pod
+		expectedOption string
+		shouldFail     bool
+	}{
+		"single page size": {
+			pod: podWithLimits(
+				v1.ResourceList{v1.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi")},
+				v1.ResourceList{v1.ResourceName("hugepages-2Mi"): resource.MustParse("2Mi")},
+			),
+			expectedOption: "pagesize=2097152",
+		},
+		"multiple page sizes": {
+			pod: podWithLimits(
+				v1.ResourceList{v1.ResourceName("hugepages-2Mi"): resource.MustParse("4Mi")},
+				v1.ResourceList{v1.ResourceName("hugepages-1Gi"): resource.MustParse("1Gi")},
+			),
+			shouldFail: true,
+		},
+		"no huge pages": {
+			pod:        podWithLimits(v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")}),
+			shouldFail: true,
+		},
+	}
+	for name, tc := range testCases {
+		option, err := getPageSizeMountOptionFromPod(tc.pod)
+		if tc.shouldFail {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if option != tc.expectedOption {
+			t.Errorf("%s: expected option %q, got %q", name, tc.expectedOption, option)
+		}
+	}
+}
+
 func TestPluginBackCompat(t *testing.T) {
 	basePath, err := utiltesting.MkTmpdir("emptydirTest")
 	if err != nil {
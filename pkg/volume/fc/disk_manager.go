@@ -31,6 +31,9 @@ type diskManager interface {
 	AttachDisk(b fcDiskMounter) error
 	// Detaches the disk from the kubelet's host machine.
 	DetachDisk(disk fcDiskUnmounter, mntPath string) error
+	// Finds the device of the raw block disk on the kubelet's host machine
+	// and returns its path.
+	AttachBlockFCDisk(b fcDiskMapper) (string, error)
 }
 
 // utility to mount a disk based filesystem
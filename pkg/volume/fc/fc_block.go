@@ -0,0 +1,124 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fc
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/util/strings"
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+var _ volume.BlockVolumePlugin = &fcPlugin{}
+
+func (plugin *fcPlugin) NewBlockVolumeMapper(spec *volume.Spec, pod *v1.Pod, _ volume.VolumeOptions) (volume.BlockVolumeMapper, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newBlockVolumeMapperInternal(spec, pod.UID, &FCUtil{})
+}
+
+func (plugin *fcPlugin) newBlockVolumeMapperInternal(spec *volume.Spec, podUID types.UID, manager diskManager) (volume.BlockVolumeMapper, error) {
+	fc, readOnly, err := getVolumeSource(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if fc.Lun == nil {
+		return nil, fmt.Errorf("empty lun")
+	}
+
+	lun := strconv.Itoa(int(*fc.Lun))
+
+	return &fcDiskMapper{
+		fcDisk: &fcDisk{
+			podUID:  podUID,
+			volName: spec.Name(),
+			wwns:    fc.TargetWWNs,
+			lun:     lun,
+			manager: manager,
+			io:      &osIOHandler{},
+			plugin:  plugin},
+		readOnly: readOnly,
+	}, nil
+}
+
+func (plugin *fcPlugin) NewBlockVolumeUnmapper(volName string, podUID types.UID) (volume.BlockVolumeUnmapper, error) {
+	return &fcDiskUnmapper{
+		fcDisk: &fcDisk{
+			podUID:  podUID,
+			volName: volName,
+			manager: &FCUtil{},
+			plugin:  plugin,
+			io:      &osIOHandler{},
+		},
+	}, nil
+}
+
+// GetGlobalMapPath returns the global map path of the fc LUN:
+// plugins/kubernetes.io/fc/volumeDevices/{wwn}-lun-{lun}
+func (fc *fcDisk) GetGlobalMapPath(spec *volume.Spec) (string, error) {
+	volumeSource, _, err := getVolumeSource(spec)
+	if err != nil {
+		return "", err
+	}
+	if volumeSource.Lun == nil || len(volumeSource.TargetWWNs) == 0 {
+		return "", fmt.Errorf("fc: empty lun or target wwns")
+	}
+	lun := strconv.Itoa(int(*volumeSource.Lun))
+	return path.Join(fc.plugin.host.GetPluginDir(fcPluginName), util.VolumeDevicesInGlobalPath, volumeSource.TargetWWNs[0]+"-lun-"+lun), nil
+}
+
+// GetPodDeviceMapPath returns the pod device map path and the name of the
+// link to the device:
+// pods/{podUid}/volumeDevices/kubernetes.io~fc, {volName}
+func (fc *fcDisk) GetPodDeviceMapPath() (string, string) {
+	return fc.plugin.host.GetPodVolumeDeviceDir(fc.podUID, strings.EscapeQualifiedNameForDisk(fcPluginName)), fc.volName
+}
+
+type fcDiskMapper struct {
+	*fcDisk
+	readOnly bool
+}
+
+var _ volume.BlockVolumeMapper = &fcDiskMapper{}
+
+// SetUpDevice finds the fc disk and returns the path of its device.
+func (b *fcDiskMapper) SetUpDevice() (string, error) {
+	devicePath, err := b.manager.AttachBlockFCDisk(*b)
+	if err != nil {
+		glog.Errorf("fc: failed to set up device: %v", err)
+		return "", err
+	}
+	return devicePath, nil
+}
+
+type fcDiskUnmapper struct {
+	*fcDisk
+}
+
+var _ volume.BlockVolumeUnmapper = &fcDiskUnmapper{}
+
+// TearDownDevice has nothing to do, the fc LUN stays visible to the node as
+// it does for file system volumes.
+func (c *fcDiskUnmapper) TearDownDevice(mapPath, devicePath string) error {
+	return nil
+}
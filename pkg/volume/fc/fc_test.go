@@ -19,6 +19,7 @@ package fc
 import (
 	"fmt"
 	"os"
+	"path"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,7 +40,7 @@ func TestCanSupport(t *testing.T) {
 	defer os.RemoveAll(tmpDir)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
 
 	plug, err := plugMgr.FindPluginByName("kubernetes.io/fc")
 	if err != nil {
@@ -61,7 +62,7 @@ func TestGetAccessModes(t *testing.T) {
 	defer os.RemoveAll(tmpDir)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
 
 	plug, err := plugMgr.FindPersistentPluginByName("kubernetes.io/fc")
 	if err != nil {
@@ -124,6 +125,11 @@ func (fake *fakeDiskManager) DetachDisk(c fcDiskUnmounter, mntPath string) error
 	return nil
 }
 
+func (fake *fakeDiskManager) AttachBlockFCDisk(b fcDiskMapper) (string, error) {
+	fake.attachCalled = true
+	return "/dev/sdb", nil
+}
+
 func doTestPlugin(t *testing.T, spec *volume.Spec) {
 	tmpDir, err := utiltesting.MkTmpdir("fc_test")
 	if err != nil {
@@ -132,7 +138,7 @@ func doTestPlugin(t *testing.T, spec *volume.Spec) {
 	defer os.RemoveAll(tmpDir)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
 
 	plug, err := plugMgr.FindPluginByName("kubernetes.io/fc")
 	if err != nil {
@@ -275,7 +281,7 @@ func TestPersistentClaimReadOnlyFlag(t *testing.T) {
 	client := fake.NewSimpleClientset(pv, claim)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, client, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, client, nil))
 	plug, _ := plugMgr.FindPluginByName(fcPluginName)
 
 	// readOnly bool is supplied by persistent-claim volume source when its mounter creates other volumes
@@ -287,3 +293,60 @@ func TestPersistentClaimReadOnlyFlag(t *testing.T) {
 		t.Errorf("Expected true for mounter.IsReadOnly")
 	}
 }
+
+func TestBlockMapper(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("fc_test")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	plugMgr := volume.VolumePluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+
+	lun := int32(0)
+	blockMode := v1.PersistentVolumeBlock
+	spec := volume.NewSpecFromPersistentVolume(&v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "vol1"},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				FC: &v1.FCVolumeSource{
+					TargetWWNs: []string{"500a0981891b8dc5"},
+					Lun:        &lun,
+				},
+			},
+			VolumeMode: &blockMode,
+		},
+	}, false)
+
+	plug, err := plugMgr.FindMapperPluginBySpec(spec)
+	if err != nil || plug == nil {
+		t.Fatalf("Can't find the block plugin: %v", err)
+	}
+	fakeManager := NewFakeDiskManager()
+	defer fakeManager.Cleanup()
+	mapper, err := plug.(*fcPlugin).newBlockVolumeMapperInternal(spec, types.UID("poduid"), fakeManager)
+	if err != nil {
+		t.Fatalf("Failed to make a new Mapper: %v", err)
+	}
+
+	globalMapPath, err := mapper.GetGlobalMapPath(spec)
+	if err != nil {
+		t.Fatalf("GetGlobalMapPath failed: %v", err)
+	}
+	if expected := path.Join(tmpDir, "plugins/kubernetes.io/fc/volumeDevices/500a0981891b8dc5-lun-0"); globalMapPath != expected {
+		t.Errorf("Unexpected global map path, expected %q, got: %q", expected, globalMapPath)
+	}
+	podDeviceMapPath, volName := mapper.GetPodDeviceMapPath()
+	if expected := path.Join(tmpDir, "pods/poduid/volumeDevices/kubernetes.io~fc"); podDeviceMapPath != expected || volName != "vol1" {
+		t.Errorf("Unexpected pod device map path, expected %q, %q, got: %q, %q", expected, "vol1", podDeviceMapPath, volName)
+	}
+
+	devicePath, err := mapper.SetUpDevice()
+	if err != nil {
+		t.Fatalf("SetUpDevice failed: %v", err)
+	}
+	if devicePath != "/dev/sdb" || !fakeManager.attachCalled {
+		t.Errorf("Expected the disk to be attached as /dev/sdb, got %q", devicePath)
+	}
+}
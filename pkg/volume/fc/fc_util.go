@@ -157,22 +157,26 @@ func searchDisk(wwns []string, lun string, io ioHandler) (string, string) {
 	return disk, dm
 }
 
-func (util *FCUtil) AttachDisk(b fcDiskMounter) error {
-	devicePath := ""
-	wwns := b.wwns
-	lun := b.lun
-	io := b.io
-	disk, dm := searchDisk(wwns, lun, io)
+// findDevicePath returns the path of the fc disk, preferring the multipath
+// devicemapper device if there is one.
+func findDevicePath(b fcDisk) (string, error) {
+	disk, dm := searchDisk(b.wwns, b.lun, b.io)
 	// if no disk matches input wwn and lun, exit
 	if disk == "" && dm == "" {
-		return fmt.Errorf("no fc disk found")
+		return "", fmt.Errorf("no fc disk found")
 	}
 
 	// if multipath devicemapper device is found, use it; otherwise use raw disk
 	if dm != "" {
-		devicePath = dm
-	} else {
-		devicePath = disk
+		return dm, nil
+	}
+	return disk, nil
+}
+
+func (util *FCUtil) AttachDisk(b fcDiskMounter) error {
+	devicePath, err := findDevicePath(*b.fcDisk)
+	if err != nil {
+		return err
 	}
 	// mount it
 	globalPDPath := b.manager.MakeGlobalPDName(*b.fcDisk)
@@ -200,3 +204,9 @@ func (util *FCUtil) DetachDisk(c fcDiskUnmounter, mntPath string) error {
 	}
 	return nil
 }
+
+// AttachBlockFCDisk returns the path of the device of a raw block volume.
+// The device is not formatted nor mounted.
+func (util *FCUtil) AttachBlockFCDisk(b fcDiskMapper) (string, error) {
+	return findDevicePath(*b.fcDisk)
+}
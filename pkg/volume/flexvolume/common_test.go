@@ -28,23 +28,30 @@ import (
 	volumetesting "k8s.io/kubernetes/pkg/volume/testing"
 )
 
-func testPlugin() (*flexVolumePlugin, string) {
+func testPlugin() (*flexVolumeAttachablePlugin, string) {
 	rootDir, err := utiltesting.MkTmpdir("flexvolume_test")
 	if err != nil {
 		panic("error creating temp dir: " + err.Error())
 	}
-	return &flexVolumePlugin{
-		driverName:          "test",
-		execPath:            "/plugin",
-		host:                volumetesting.NewFakeVolumeHost(rootDir, nil, nil),
-		unsupportedCommands: []string{},
+	return &flexVolumeAttachablePlugin{
+		flexVolumePlugin: &flexVolumePlugin{
+			driverName:          "test",
+			execPath:            "/plugin",
+			host:                volumetesting.NewFakeVolumeHost(rootDir, nil, nil),
+			capabilities:        *defaultCapabilities(),
+			unsupportedCommands: []string{},
+		},
 	}, rootDir
 }
 
 func assertDriverCall(t *testing.T, output exec.FakeCombinedOutputAction, expectedCommand string, expectedArgs ...string) exec.FakeCommandAction {
+	return assertDriverCallWithExecutable(t, "/plugin/test", output, expectedCommand, expectedArgs...)
+}
+
+func assertDriverCallWithExecutable(t *testing.T, executable string, output exec.FakeCombinedOutputAction, expectedCommand string, expectedArgs ...string) exec.FakeCommandAction {
 	return func(cmd string, args ...string) exec.Cmd {
-		if cmd != "/plugin/test" {
-			t.Errorf("Wrong executable called: got %v, expected %v", cmd, "/plugin/test")
+		if cmd != executable {
+			t.Errorf("Wrong executable called: got %v, expected %v", cmd, executable)
 		}
 		if args[0] != expectedCommand {
 			t.Errorf("Wrong command called: got %v, expected %v", args[0], expectedCommand)
@@ -77,11 +84,11 @@ func fakeResultOutput(result interface{}) exec.FakeCombinedOutputAction {
 }
 
 func successOutput() exec.FakeCombinedOutputAction {
-	return fakeResultOutput(&DriverStatus{StatusSuccess, "", "", "", true})
+	return fakeResultOutput(&DriverStatus{StatusSuccess, "", "", "", true, nil})
 }
 
 func notSupportedOutput() exec.FakeCombinedOutputAction {
-	return fakeResultOutput(&DriverStatus{StatusNotSupported, "", "", "", false})
+	return fakeResultOutput(&DriverStatus{StatusNotSupported, "", "", "", false, nil})
 }
 
 func sameArgs(args, expectedArgs []string) bool {
@@ -126,7 +133,7 @@ func fakePersistentVolumeSpec() *volume.Spec {
 	return volume.NewSpecFromPersistentVolume(vol, false)
 }
 
-func specJson(plugin *flexVolumePlugin, spec *volume.Spec, extraOptions map[string]string) string {
+func specJson(plugin *flexVolumeAttachablePlugin, spec *volume.Spec, extraOptions map[string]string) string {
 	o, err := NewOptionsForDriver(spec, plugin.host, extraOptions)
 	if err != nil {
 		panic("Failed to convert spec: " + err.Error())
@@ -192,6 +192,31 @@ type DriverStatus struct {
 	VolumeName string `json:"volumeName,omitempty"`
 	// Represents volume is attached on the node
 	Attached bool `json:"attached,omitempty"`
+	// Returns capabilities of the driver.
+	// By default we assume all the capabilities are supported.
+	// If the plugin does not support a capability, it can return false for that capability.
+	Capabilities *DriverCapabilities `json:"capabilities,omitempty"`
+}
+
+// DriverCapabilities represents what the driver supports, as reported by
+// the init call.
+type DriverCapabilities struct {
+	// Attach is true if the driver implements attach and detach.
+	Attach bool `json:"attach"`
+	// SELinuxRelabel is true if the files of the volume can be relabeled
+	// for SELinux.
+	SELinuxRelabel bool `json:"selinuxRelabel"`
+	// FSGroup is true if the kubelet should manage the ownership of the
+	// volume for the pod fsGroup.
+	FSGroup bool `json:"fsGroup"`
+}
+
+func defaultCapabilities() *DriverCapabilities {
+	return &DriverCapabilities{
+		Attach:         true,
+		SELinuxRelabel: true,
+		FSGroup:        true,
+	}
 }
 
 // isCmdNotSupportedErr checks if the error corresponds to command not supported by
@@ -207,7 +232,9 @@ func isCmdNotSupportedErr(err error) bool {
 // handleCmdResponse processes the command output and returns the appropriate
 // error code or message.
 func handleCmdResponse(cmd string, output []byte) (*DriverStatus, error) {
-	var status DriverStatus
+	status := DriverStatus{
+		Capabilities: defaultCapabilities(),
+	}
 	if err := json.Unmarshal(output, &status); err != nil {
 		glog.Errorf("Failed to unmarshal output for command: %s, output: %s, error: %s", cmd, string(output), err.Error())
 		return nil, err
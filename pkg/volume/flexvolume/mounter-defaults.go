@@ -30,10 +30,10 @@ type mounterDefaults flexVolumeMounter
 func (f *mounterDefaults) SetUpAt(dir string, fsGroup *int64) error {
 	glog.Warning(logPrefix(f.plugin), "using default SetUpAt to ", dir)
 
-	a, err := f.plugin.NewAttacher()
-	if err != nil {
-		return fmt.Errorf("NewAttacher failed: %v", err)
+	if !f.plugin.capabilities.Attach {
+		return fmt.Errorf("the driver does not support mount and is not attachable")
 	}
+	a := &flexVolumeAttacher{f.plugin}
 	src, err := a.GetDeviceMountPath(f.spec)
 	if err != nil {
 		return fmt.Errorf("GetDeviceMountPath failed: %v", err)
@@ -52,7 +52,7 @@ func (f *mounterDefaults) GetAttributes() volume.Attributes {
 	return volume.Attributes{
 		ReadOnly:        f.readOnly,
 		Managed:         !f.readOnly,
-		SupportsSELinux: true,
+		SupportsSELinux: f.plugin.capabilities.SELinuxRelabel,
 	}
 }
 
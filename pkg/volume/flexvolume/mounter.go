@@ -86,7 +86,7 @@ func (f *flexVolumeMounter) SetUpAt(dir string, fsGroup *int64) error {
 		return err
 	}
 
-	if !f.readOnly {
+	if !f.readOnly && f.plugin.capabilities.FSGroup {
 		volume.SetVolumeOwnership(f, fsGroup)
 	}
 
@@ -17,6 +17,7 @@ limitations under the License.
 package flexvolume
 
 import (
+	"fmt"
 	"path"
 	"strings"
 	"sync"
@@ -37,21 +38,64 @@ type flexVolumePlugin struct {
 	execPath   string
 	host       volume.VolumeHost
 	runner     exec.Interface
+	// capabilities reported by the driver when it was initialized.
+	capabilities DriverCapabilities
 
 	sync.Mutex
 	unsupportedCommands []string
 }
 
-var _ volume.AttachableVolumePlugin = &flexVolumePlugin{}
+// flexVolumeAttachablePlugin is a flexVolumePlugin whose driver supports the
+// attach and detach calls.
+type flexVolumeAttachablePlugin struct {
+	*flexVolumePlugin
+}
+
+var _ volume.AttachableVolumePlugin = &flexVolumeAttachablePlugin{}
 var _ volume.PersistentVolumePlugin = &flexVolumePlugin{}
 
+// PluginFactory creates flexvolume plugins from the drivers installed in the
+// plugin directory.
+type PluginFactory interface {
+	NewFlexVolumePlugin(pluginDir, name string) (volume.VolumePlugin, error)
+}
+
+type pluginFactory struct{}
+
+// NewFlexVolumePlugin calls init on the driver installed in pluginDir/name
+// and returns a plugin that honours the capabilities it reports.
+func (pluginFactory) NewFlexVolumePlugin(pluginDir, name string) (volume.VolumePlugin, error) {
+	return newFlexVolumePlugin(pluginDir, name, exec.New())
+}
+
+func newFlexVolumePlugin(pluginDir, name string, runner exec.Interface) (volume.VolumePlugin, error) {
+	flexPlugin := &flexVolumePlugin{
+		driverName:          utilstrings.UnescapePluginName(name),
+		execPath:            path.Join(pluginDir, name),
+		runner:              runner,
+		unsupportedCommands: []string{},
+	}
+
+	// call the init script
+	call := flexPlugin.NewDriverCall(initCmd)
+	status, err := call.Run()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize flexvolume driver %s: %v", flexPlugin.driverName, err)
+	}
+	flexPlugin.capabilities = *status.Capabilities
+
+	if flexPlugin.capabilities.Attach {
+		return &flexVolumeAttachablePlugin{flexVolumePlugin: flexPlugin}, nil
+	}
+	return flexPlugin, nil
+}
+
 // Init is part of the volume.VolumePlugin interface.
 func (plugin *flexVolumePlugin) Init(host volume.VolumeHost) error {
 	plugin.host = host
-	// call the init script
-	call := plugin.NewDriverCall(initCmd)
-	_, err := call.Run()
-	return err
+	// Hardwired 'success' as any errors from calling init() are caught by
+	// newFlexVolumePlugin().
+	return nil
 }
 
 func (plugin *flexVolumePlugin) getExecutable() string {
@@ -144,13 +188,13 @@ func (plugin *flexVolumePlugin) newUnmounterInternal(volName string, podUID type
 }
 
 // NewAttacher is part of the volume.AttachableVolumePlugin interface.
-func (plugin *flexVolumePlugin) NewAttacher() (volume.Attacher, error) {
-	return &flexVolumeAttacher{plugin}, nil
+func (plugin *flexVolumeAttachablePlugin) NewAttacher() (volume.Attacher, error) {
+	return &flexVolumeAttacher{plugin.flexVolumePlugin}, nil
 }
 
 // NewDetacher is part of the volume.AttachableVolumePlugin interface.
-func (plugin *flexVolumePlugin) NewDetacher() (volume.Detacher, error) {
-	return &flexVolumeDetacher{plugin}, nil
+func (plugin *flexVolumeAttachablePlugin) NewDetacher() (volume.Detacher, error) {
+	return &flexVolumeDetacher{plugin.flexVolumePlugin}, nil
 }
 
 // ConstructVolumeSpec is part of the volume.AttachableVolumePlugin interface.
@@ -189,7 +233,7 @@ func (plugin *flexVolumePlugin) isUnsupported(command string) bool {
 	return false
 }
 
-func (plugin *flexVolumePlugin) GetDeviceMountRefs(deviceMountPath string) ([]string, error) {
+func (plugin *flexVolumeAttachablePlugin) GetDeviceMountRefs(deviceMountPath string) ([]string, error) {
 	mounter := plugin.host.GetMounter()
 	return mount.GetMountRefs(mounter, deviceMountPath)
 }
@@ -20,14 +20,48 @@ import (
 	"testing"
 
 	"k8s.io/kubernetes/pkg/util/exec"
+	"k8s.io/kubernetes/pkg/volume"
 )
 
 func TestInit(t *testing.T) {
-	plugin, _ := testPlugin()
-	plugin.runner = fakeRunner(
-		assertDriverCall(t, successOutput(), "init"),
-	)
-	plugin.Init(plugin.host)
+	plugin, err := newFlexVolumePlugin("/plugin", "test", fakeRunner(
+		assertDriverCallWithExecutable(t, "/plugin/test/test", successOutput(), "init"),
+	))
+	if err != nil {
+		t.Fatalf("newFlexVolumePlugin() failed: %v", err)
+	}
+	// Drivers are attachable unless they report otherwise.
+	if _, ok := plugin.(volume.AttachableVolumePlugin); !ok {
+		t.Errorf("Expected an attachable plugin")
+	}
+}
+
+func TestInitCapabilities(t *testing.T) {
+	plugin, err := newFlexVolumePlugin("/plugin", "test", fakeRunner(
+		assertDriverCallWithExecutable(t, "/plugin/test/test", fakeResultOutput(&DriverStatus{
+			Status:       StatusSuccess,
+			Capabilities: &DriverCapabilities{Attach: false, SELinuxRelabel: false, FSGroup: true},
+		}), "init"),
+	))
+	if err != nil {
+		t.Fatalf("newFlexVolumePlugin() failed: %v", err)
+	}
+	if _, ok := plugin.(volume.AttachableVolumePlugin); ok {
+		t.Errorf("Expected a plugin that is not attachable")
+	}
+	flexPlugin := plugin.(*flexVolumePlugin)
+	if flexPlugin.capabilities.SELinuxRelabel || !flexPlugin.capabilities.FSGroup {
+		t.Errorf("Unexpected capabilities: %+v", flexPlugin.capabilities)
+	}
+}
+
+func TestInitFailure(t *testing.T) {
+	_, err := newFlexVolumePlugin("/plugin", "test", fakeRunner(
+		assertDriverCallWithExecutable(t, "/plugin/test/test", fakeResultOutput(&DriverStatus{Status: StatusFailure}), "init"),
+	))
+	if err == nil {
+		t.Errorf("Expected newFlexVolumePlugin() to fail when init fails")
+	}
 }
 
 func fakeVolumeNameOutput(name string) exec.FakeCombinedOutputAction {
@@ -17,19 +17,26 @@ limitations under the License.
 package flexvolume
 
 import (
+	"fmt"
 	"io/ioutil"
+	"os"
 	"path"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/golang/glog"
 
-	"k8s.io/kubernetes/pkg/util/exec"
-	utilstrings "k8s.io/kubernetes/pkg/util/strings"
 	"k8s.io/kubernetes/pkg/volume"
 )
 
-// This is the primary entrypoint for volume plugins.
-func ProbeVolumePlugins(pluginDir string) []volume.VolumePlugin {
+// probePluginDir creates a plugin for each driver installed in pluginDir.
+func probePluginDir(pluginDir string, factory PluginFactory) ([]volume.VolumePlugin, error) {
 	plugins := []volume.VolumePlugin{}
 
-	files, _ := ioutil.ReadDir(pluginDir)
+	files, err := ioutil.ReadDir(pluginDir)
+	if err != nil {
+		return plugins, err
+	}
 	for _, f := range files {
 		// only directories are counted as plugins
 		// and pluginDir/dirname/dirname should be an executable
@@ -37,14 +44,137 @@ func ProbeVolumePlugins(pluginDir string) []volume.VolumePlugin {
 		// e.g. dirname = vendor~cifs
 		// then, executable will be pluginDir/dirname/cifs
 		if f.IsDir() {
-			execPath := path.Join(pluginDir, f.Name())
-			plugins = append(plugins, &flexVolumePlugin{
-				driverName:          utilstrings.UnescapePluginName(f.Name()),
-				execPath:            execPath,
-				runner:              exec.New(),
-				unsupportedCommands: []string{},
-			})
+			plugin, err := factory.NewFlexVolumePlugin(pluginDir, f.Name())
+			if err != nil {
+				glog.Errorf("Error creating flexvolume plugin from directory %s, skipping: %v", f.Name(), err)
+				continue
+			}
+			plugins = append(plugins, plugin)
+		}
+	}
+	return plugins, nil
+}
+
+// flexVolumeProber watches the driver directory and probes it again when
+// drivers are installed, updated or removed.
+type flexVolumeProber struct {
+	mutex     sync.Mutex
+	pluginDir string
+	factory   PluginFactory
+	watcher   *fsnotify.Watcher
+	// probeNeeded is true if the driver directory changed since the last probe.
+	probeNeeded bool
+}
+
+var _ volume.DynamicPluginProber = &flexVolumeProber{}
+
+// GetDynamicPluginProber returns a prober that discovers the flexvolume
+// drivers installed in pluginDir while the process is running.
+func GetDynamicPluginProber(pluginDir string) volume.DynamicPluginProber {
+	return &flexVolumeProber{
+		pluginDir: pluginDir,
+		factory:   pluginFactory{},
+	}
+}
+
+// Init is part of the volume.DynamicPluginProber interface.
+func (prober *flexVolumeProber) Init() error {
+	if prober.pluginDir == "" {
+		return fmt.Errorf("no flexvolume plugin directory configured")
+	}
+	// The driver directory may be created later on, e.g. by the DaemonSet
+	// installing the first driver, make sure there is something to watch.
+	if err := os.MkdirAll(prober.pluginDir, 0755); err != nil {
+		return fmt.Errorf("error creating flexvolume plugin directory %s: %v", prober.pluginDir, err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("error creating the flexvolume plugin directory watcher: %v", err)
+	}
+	if err := prober.addWatches(watcher, prober.pluginDir); err != nil {
+		watcher.Close()
+		return err
+	}
+	prober.watcher = watcher
+	prober.probeNeeded = true
+
+	go prober.watch()
+	return nil
+}
+
+// Probe is part of the volume.DynamicPluginProber interface.
+func (prober *flexVolumeProber) Probe() (bool, []volume.VolumePlugin, error) {
+	prober.mutex.Lock()
+	defer prober.mutex.Unlock()
+
+	if !prober.probeNeeded {
+		return false, nil, nil
+	}
+	plugins, err := probePluginDir(prober.pluginDir, prober.factory)
+	if err != nil {
+		return false, nil, err
+	}
+	prober.probeNeeded = false
+	return true, plugins, nil
+}
+
+// watch marks the driver directory for probing on every change until the
+// watcher is closed.
+func (prober *flexVolumeProber) watch() {
+	for {
+		select {
+		case event, ok := <-prober.watcher.Events:
+			if !ok {
+				return
+			}
+			prober.handleWatchEvent(event)
+		case err, ok := <-prober.watcher.Errors:
+			if !ok {
+				return
+			}
+			glog.Errorf("Error watching the flexvolume plugin directory %s: %v", prober.pluginDir, err)
+		}
+	}
+}
+
+func (prober *flexVolumeProber) handleWatchEvent(event fsnotify.Event) {
+	glog.V(4).Infof("Flexvolume plugin directory event: %s", event)
+
+	// Drivers are installed in subdirectories of the plugin directory, start
+	// watching them so that updates of the driver executables are noticed.
+	if event.Op&fsnotify.Create == fsnotify.Create {
+		if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+			if err := prober.addWatches(prober.watcher, event.Name); err != nil {
+				glog.Errorf("Error watching flexvolume driver directory %s: %v", event.Name, err)
+			}
+		}
+	}
+
+	prober.mutex.Lock()
+	defer prober.mutex.Unlock()
+	prober.probeNeeded = true
+}
+
+// addWatches watches dir and, if dir is the plugin directory, the driver
+// directories in it.
+func (prober *flexVolumeProber) addWatches(watcher *fsnotify.Watcher, dir string) error {
+	if err := watcher.Add(dir); err != nil {
+		return fmt.Errorf("error watching %s: %v", dir, err)
+	}
+	if dir != prober.pluginDir {
+		return nil
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			if err := watcher.Add(path.Join(dir, f.Name())); err != nil {
+				return fmt.Errorf("error watching %s: %v", path.Join(dir, f.Name()), err)
+			}
 		}
 	}
-	return plugins
+	return nil
 }
@@ -0,0 +1,133 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flexvolume
+
+import (
+	"os"
+	"path"
+	"sort"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	utiltesting "k8s.io/client-go/util/testing"
+	utilstrings "k8s.io/kubernetes/pkg/util/strings"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+// fakePluginFactory creates plugins without calling init on the drivers.
+type fakePluginFactory struct{}
+
+func (fakePluginFactory) NewFlexVolumePlugin(pluginDir, name string) (volume.VolumePlugin, error) {
+	return &flexVolumePlugin{
+		driverName:          utilstrings.UnescapePluginName(name),
+		execPath:            path.Join(pluginDir, name),
+		unsupportedCommands: []string{},
+	}, nil
+}
+
+func pluginNames(plugins []volume.VolumePlugin) []string {
+	names := []string{}
+	for _, plugin := range plugins {
+		names = append(names, plugin.GetPluginName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// waitForProbe probes until the prober reports an update.
+func waitForProbe(t *testing.T, prober volume.DynamicPluginProber) []volume.VolumePlugin {
+	var plugins []volume.VolumePlugin
+	err := wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		updated, probed, err := prober.Probe()
+		plugins = probed
+		return updated, err
+	})
+	if err != nil {
+		t.Fatalf("Prober did not report an update: %v", err)
+	}
+	return plugins
+}
+
+func TestProberAddRemoveDriver(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("flexvolume_test")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	pluginDir := path.Join(tmpDir, "plugins")
+
+	installPluginUnderTest(t, "kubernetes.io", "fakeAttacher", pluginDir, execScriptTempl1, nil)
+	prober := &flexVolumeProber{pluginDir: pluginDir, factory: fakePluginFactory{}}
+	if err := prober.Init(); err != nil {
+		t.Fatalf("Init() failed: %v", err)
+	}
+	defer prober.watcher.Close()
+
+	// The drivers installed before Init are reported by the first probe.
+	updated, plugins, err := prober.Probe()
+	if err != nil || !updated {
+		t.Fatalf("Expected the first probe to report an update, got %v, %v", updated, err)
+	}
+	if names := pluginNames(plugins); len(names) != 1 || names[0] != "kubernetes.io/fakeAttacher" {
+		t.Errorf("Unexpected plugins after the first probe: %v", names)
+	}
+	if updated, _, _ := prober.Probe(); updated {
+		t.Errorf("Expected no update when the plugin directory did not change")
+	}
+
+	// Install a driver.
+	installPluginUnderTest(t, "vendor", "cifs", pluginDir, execScriptTempl2, nil)
+	plugins = waitForProbe(t, prober)
+	if names := pluginNames(plugins); len(names) != 2 || names[1] != "vendor/cifs" {
+		t.Errorf("Unexpected plugins after installing a driver: %v", names)
+	}
+
+	// Remove a driver.
+	if err := os.RemoveAll(path.Join(pluginDir, "kubernetes.io~fakeAttacher")); err != nil {
+		t.Fatalf("Failed to remove driver: %v", err)
+	}
+	plugins = waitForProbe(t, prober)
+	if names := pluginNames(plugins); len(names) != 1 || names[0] != "vendor/cifs" {
+		t.Errorf("Unexpected plugins after removing a driver: %v", names)
+	}
+}
+
+func TestPluginMgrDynamicProbe(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("flexvolume_test")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	prober := &flexVolumeProber{pluginDir: tmpDir, factory: fakePluginFactory{}}
+	plugMgr := volume.VolumePluginMgr{}
+	plugMgr.InitPlugins(nil, prober, nil)
+	defer prober.watcher.Close()
+
+	if _, err := plugMgr.FindPluginByName("kubernetes.io/fakeAttacher"); err == nil {
+		t.Errorf("Expected no plugin before the driver is installed")
+	}
+	installPluginUnderTest(t, "kubernetes.io", "fakeAttacher", tmpDir, execScriptTempl1, nil)
+	err = wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		_, err := plugMgr.FindPluginByName("kubernetes.io/fakeAttacher")
+		return err == nil, nil
+	})
+	if err != nil {
+		t.Errorf("Plugin of the installed driver was not found: %v", err)
+	}
+}
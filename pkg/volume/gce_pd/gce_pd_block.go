@@ -0,0 +1,100 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce_pd
+
+import (
+	"path"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/util/strings"
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+var _ volume.BlockVolumePlugin = &gcePersistentDiskPlugin{}
+
+func (plugin *gcePersistentDiskPlugin) NewBlockVolumeMapper(spec *volume.Spec, pod *v1.Pod, _ volume.VolumeOptions) (volume.BlockVolumeMapper, error) {
+	if _, _, err := getVolumeSource(spec); err != nil {
+		return nil, err
+	}
+	return &gcePersistentDiskMapper{
+		gcePersistentDiskBlock: &gcePersistentDiskBlock{
+			podUID:  pod.UID,
+			volName: spec.Name(),
+			plugin:  plugin,
+		},
+	}, nil
+}
+
+func (plugin *gcePersistentDiskPlugin) NewBlockVolumeUnmapper(volName string, podUID types.UID) (volume.BlockVolumeUnmapper, error) {
+	return &gcePersistentDiskUnmapper{
+		gcePersistentDiskBlock: &gcePersistentDiskBlock{
+			podUID:  podUID,
+			volName: volName,
+			plugin:  plugin,
+		},
+	}, nil
+}
+
+// gcePersistentDiskBlock holds the paths a GCE PD raw block volume is
+// mapped to.
+type gcePersistentDiskBlock struct {
+	podUID  types.UID
+	volName string
+	plugin  *gcePersistentDiskPlugin
+}
+
+// GetGlobalMapPath returns the global map path of the PD:
+// plugins/kubernetes.io/gce-pd/volumeDevices/{pdName}
+func (b *gcePersistentDiskBlock) GetGlobalMapPath(spec *volume.Spec) (string, error) {
+	volumeSource, _, err := getVolumeSource(spec)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(b.plugin.host.GetPluginDir(gcePersistentDiskPluginName), util.VolumeDevicesInGlobalPath, volumeSource.PDName), nil
+}
+
+// GetPodDeviceMapPath returns the pod device map path and the name of the
+// link to the device:
+// pods/{podUid}/volumeDevices/kubernetes.io~gce-pd, {volName}
+func (b *gcePersistentDiskBlock) GetPodDeviceMapPath() (string, string) {
+	return b.plugin.host.GetPodVolumeDeviceDir(b.podUID, strings.EscapeQualifiedNameForDisk(gcePersistentDiskPluginName)), b.volName
+}
+
+type gcePersistentDiskMapper struct {
+	*gcePersistentDiskBlock
+}
+
+var _ volume.BlockVolumeMapper = &gcePersistentDiskMapper{}
+
+// SetUpDevice has nothing to do, the PD is attached by the attacher and the
+// device path is returned by WaitForAttach.
+func (b *gcePersistentDiskMapper) SetUpDevice() (string, error) {
+	return "", nil
+}
+
+type gcePersistentDiskUnmapper struct {
+	*gcePersistentDiskBlock
+}
+
+var _ volume.BlockVolumeUnmapper = &gcePersistentDiskUnmapper{}
+
+// TearDownDevice has nothing to do, the PD is detached by the detacher.
+func (c *gcePersistentDiskUnmapper) TearDownDevice(mapPath, devicePath string) error {
+	return nil
+}
@@ -60,6 +60,7 @@ var _ volume.VolumePlugin = &glusterfsPlugin{}
 var _ volume.PersistentVolumePlugin = &glusterfsPlugin{}
 var _ volume.DeletableVolumePlugin = &glusterfsPlugin{}
 var _ volume.ProvisionableVolumePlugin = &glusterfsPlugin{}
+var _ volume.ExpandableVolumePlugin = &glusterfsPlugin{}
 var _ volume.Provisioner = &glusterfsVolumeProvisioner{}
 var _ volume.Deleter = &glusterfsVolumeDeleter{}
 
@@ -998,3 +999,50 @@ func parseClassParameters(params map[string]string, kubeClient clientset.Interfa
 
 	return &cfg, nil
 }
+
+func (plugin *glusterfsPlugin) ExpandVolumeDevice(spec *volume.Spec, newSize resource.Quantity, oldSize resource.Quantity) (resource.Quantity, error) {
+	pvSpec := spec.PersistentVolume.Spec
+	glog.V(2).Infof("glusterfs: request to expand volume: %s ", pvSpec.Glusterfs.Path)
+	volumeName := pvSpec.Glusterfs.Path
+	volumeId := dstrings.TrimPrefix(volumeName, volPrefix)
+
+	class, err := volutil.GetClassForVolume(plugin.host.GetKubeClient(), spec.PersistentVolume)
+	if err != nil {
+		return oldSize, err
+	}
+	cfg, err := parseClassParameters(class.Parameters, plugin.host.GetKubeClient())
+	if err != nil {
+		return oldSize, err
+	}
+
+	glog.V(4).Infof("glusterfs: expanding volume %q with configuration %+v", volumeId, cfg)
+
+	// heketi expands volumes by a number of GiB on top of the current size
+	newSizeGiB := volume.RoundUpSize(newSize.Value(), 1024*1024*1024)
+	oldSizeGiB := volume.RoundUpSize(oldSize.Value(), 1024*1024*1024)
+	if newSizeGiB <= oldSizeGiB {
+		return oldSize, nil
+	}
+
+	cli := gcli.NewClient(cfg.url, cfg.user, cfg.secretValue)
+	if cli == nil {
+		glog.Errorf("glusterfs: failed to create glusterfs rest client")
+		return oldSize, fmt.Errorf("glusterfs: failed to create glusterfs rest client, REST server authentication failed")
+	}
+
+	volumeExpandReq := &gapi.VolumeExpandRequest{Size: int(newSizeGiB - oldSizeGiB)}
+	volumeInfoRes, err := cli.VolumeExpand(volumeId, volumeExpandReq)
+	if err != nil {
+		glog.Errorf("glusterfs: error when expanding the volume :%v", err)
+		return oldSize, err
+	}
+
+	glog.V(2).Infof("glusterfs: volume %s expanded to new size %d successfully", volumeName, volumeInfoRes.Size)
+	return resource.MustParse(fmt.Sprintf("%dGi", volumeInfoRes.Size)), nil
+}
+
+// RequiresFSResize returns false because gluster volumes are network file
+// systems that grow without any action on the node.
+func (plugin *glusterfsPlugin) RequiresFSResize() bool {
+	return false
+}
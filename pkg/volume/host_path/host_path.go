@@ -18,7 +18,9 @@ package host_path
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"regexp"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,9 +53,14 @@ var _ volume.PersistentVolumePlugin = &hostPathPlugin{}
 var _ volume.RecyclableVolumePlugin = &hostPathPlugin{}
 var _ volume.DeletableVolumePlugin = &hostPathPlugin{}
 var _ volume.ProvisionableVolumePlugin = &hostPathPlugin{}
+var _ volume.SnapshottableVolumePlugin = &hostPathPlugin{}
 
 const (
 	hostPathPluginName = "kubernetes.io/host-path"
+
+	// hostPathSnapshotDir holds the snapshots taken by the plugin, one
+	// directory per snapshot.
+	hostPathSnapshotDir = "/tmp/hostpath_snapshots"
 )
 
 func (plugin *hostPathPlugin) Init(host volume.VolumeHost) error {
@@ -141,6 +148,47 @@ func (plugin *hostPathPlugin) NewProvisioner(options volume.VolumeOptions) (volu
 	return newProvisioner(options, plugin.host, plugin)
 }
 
+// CreateSnapshot for hostPath copies the volume directory to a local
+// /tmp/hostpath_snapshots/%s directory, whose path is the snapshot handle.
+// This is meant for development and testing only and WILL NOT WORK in a
+// multi-node cluster.
+func (plugin *hostPathPlugin) CreateSnapshot(spec *volume.Spec, snapshotName string) (string, error) {
+	source, _, err := getVolumeSource(spec)
+	if err != nil {
+		return "", err
+	}
+	if !tmpPathRegexp.MatchString(source.Path) {
+		return "", fmt.Errorf("host_path snapshotter only supports /tmp/.+ but received provided %s", source.Path)
+	}
+	handle := filepath.Join(hostPathSnapshotDir, snapshotName)
+	if err := copyDir(source.Path, handle); err != nil {
+		os.RemoveAll(handle)
+		return "", fmt.Errorf("failed to snapshot %s: %v", source.Path, err)
+	}
+	return handle, nil
+}
+
+// DeleteSnapshot for hostPath removes the local snapshot directory.
+func (plugin *hostPathPlugin) DeleteSnapshot(handle string) error {
+	snapshotPath, err := getSnapshotPath(handle)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(snapshotPath)
+}
+
+// getSnapshotPath returns the directory of the snapshot with the given
+// handle, which must be a directory right under hostPathSnapshotDir. Handles
+// come from the API, they must not point anywhere else on the host.
+func getSnapshotPath(handle string) (string, error) {
+	snapshotPath := filepath.Clean(handle)
+	name := filepath.Base(snapshotPath)
+	if name == "." || name == ".." || snapshotPath != filepath.Join(hostPathSnapshotDir, name) {
+		return "", fmt.Errorf("host_path snapshotter only supports snapshots in %s but received provided %s", hostPathSnapshotDir, handle)
+	}
+	return snapshotPath, nil
+}
+
 func (plugin *hostPathPlugin) ConstructVolumeSpec(volumeName, mountPath string) (*volume.Spec, error) {
 	hostPathVolume := &v1.Volume{
 		Name: volumeName,
@@ -266,6 +314,17 @@ func (r *hostPathProvisioner) Provision() (*v1.PersistentVolume, error) {
 		pv.Spec.AccessModes = r.plugin.GetAccessModes()
 	}
 
+	if handle := r.options.SnapshotHandle; len(handle) > 0 {
+		snapshotPath, err := getSnapshotPath(handle)
+		if err != nil {
+			return nil, err
+		}
+		if err := copyDir(snapshotPath, fullpath); err != nil {
+			os.RemoveAll(fullpath)
+			return nil, fmt.Errorf("failed to restore snapshot %s: %v", handle, err)
+		}
+		return pv, nil
+	}
 	return pv, os.MkdirAll(pv.Spec.HostPath.Path, 0750)
 }
 
@@ -286,13 +345,60 @@ func (r *hostPathDeleter) GetPath() string {
 // THIS IS FOR TESTING AND LOCAL DEVELOPMENT ONLY!  This message should scare you away from using
 // this deleter for anything other than development and testing.
 func (r *hostPathDeleter) Delete() error {
-	regexp := regexp.MustCompile("/tmp/.+")
-	if !regexp.MatchString(r.GetPath()) {
+	if !tmpPathRegexp.MatchString(r.GetPath()) {
 		return fmt.Errorf("host_path deleter only supports /tmp/.+ but received provided %s", r.GetPath())
 	}
 	return os.RemoveAll(r.GetPath())
 }
 
+var tmpPathRegexp = regexp.MustCompile("/tmp/.+")
+
+// copyDir recursively copies the directory tree at src to dst, which must
+// not exist yet.  Regular files, directories and symlinks are copied with
+// their permissions; other file types are skipped.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		switch mode := info.Mode(); {
+		case mode.IsDir():
+			return os.MkdirAll(target, mode.Perm())
+		case mode&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case mode.IsRegular():
+			return copyFile(path, target, mode.Perm())
+		}
+		return nil
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
 func getVolumeSource(
 	spec *volume.Spec) (*v1.HostPathVolumeSource, bool, error) {
 	if spec.Volume != nil && spec.Volume.HostPath != nil {
@@ -20,7 +20,9 @@ package host_path
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -36,7 +38,7 @@ import (
 
 func TestCanSupport(t *testing.T) {
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), volumetest.NewFakeVolumeHost("fake", nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), nil /* prober */, volumetest.NewFakeVolumeHost("fake", nil, nil))
 
 	plug, err := plugMgr.FindPluginByName("kubernetes.io/host-path")
 	if err != nil {
@@ -58,7 +60,7 @@ func TestCanSupport(t *testing.T) {
 
 func TestGetAccessModes(t *testing.T) {
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), nil /* prober */, volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
 
 	plug, err := plugMgr.FindPersistentPluginByName("kubernetes.io/host-path")
 	if err != nil {
@@ -72,7 +74,7 @@ func TestGetAccessModes(t *testing.T) {
 func TestRecycler(t *testing.T) {
 	plugMgr := volume.VolumePluginMgr{}
 	pluginHost := volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil)
-	plugMgr.InitPlugins([]volume.VolumePlugin{&hostPathPlugin{nil, volume.VolumeConfig{}}}, pluginHost)
+	plugMgr.InitPlugins([]volume.VolumePlugin{&hostPathPlugin{nil, volume.VolumeConfig{}}}, nil /* prober */, pluginHost)
 
 	spec := &volume.Spec{PersistentVolume: &v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/foo"}}}}}
 	_, err := plugMgr.FindRecyclablePluginBySpec(spec)
@@ -91,7 +93,7 @@ func TestDeleter(t *testing.T) {
 	}
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), nil /* prober */, volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
 
 	spec := &volume.Spec{PersistentVolume: &v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{HostPath: &v1.HostPathVolumeSource{Path: tempPath}}}}}
 	plug, err := plugMgr.FindDeletablePluginBySpec(spec)
@@ -125,7 +127,7 @@ func TestDeleterTempDir(t *testing.T) {
 
 	for name, test := range tests {
 		plugMgr := volume.VolumePluginMgr{}
-		plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
+		plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), nil /* prober */, volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
 		spec := &volume.Spec{PersistentVolume: &v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{HostPath: &v1.HostPathVolumeSource{Path: test.path}}}}}
 		plug, _ := plugMgr.FindDeletablePluginBySpec(spec)
 		deleter, _ := plug.NewDeleter(spec)
@@ -145,7 +147,7 @@ func TestProvisioner(t *testing.T) {
 	err := os.MkdirAll(tempPath, 0750)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{ProvisioningEnabled: true}),
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{ProvisioningEnabled: true}), nil, /* prober */
 		volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
 	spec := &volume.Spec{PersistentVolume: &v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{HostPath: &v1.HostPathVolumeSource{Path: tempPath}}}}}
 	plug, err := plugMgr.FindCreatablePluginBySpec(spec)
@@ -182,9 +184,124 @@ func TestProvisioner(t *testing.T) {
 	os.RemoveAll(pv.Spec.HostPath.Path)
 }
 
+func TestSnapshotAndRestore(t *testing.T) {
+	tempPath := fmt.Sprintf("/tmp/hostpath/%s", uuid.NewUUID())
+	defer os.RemoveAll(tempPath)
+	if err := os.MkdirAll(filepath.Join(tempPath, "dir"), 0750); err != nil {
+		t.Fatalf("Failed to create tmp directory for snapshot: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(tempPath, "dir", "file"), []byte("data"), 0640); err != nil {
+		t.Fatalf("Failed to create file for snapshot: %v", err)
+	}
+
+	plugMgr := volume.VolumePluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{ProvisioningEnabled: true}), nil, /* prober */
+		volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
+	spec := &volume.Spec{PersistentVolume: &v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{HostPath: &v1.HostPathVolumeSource{Path: tempPath}}}}}
+	plug, err := plugMgr.FindSnapshottablePluginBySpec(spec)
+	if err != nil || plug == nil {
+		t.Fatalf("Can't find the snapshottable plugin by spec: %v", err)
+	}
+	handle, err := plug.CreateSnapshot(spec, string(uuid.NewUUID()))
+	if err != nil {
+		t.Fatalf("Unexpected error creating snapshot: %v", err)
+	}
+	defer os.RemoveAll(handle)
+
+	// Changes to the volume after the snapshot must not show up in the restored volume.
+	if err := ioutil.WriteFile(filepath.Join(tempPath, "dir", "file"), []byte("changed"), 0640); err != nil {
+		t.Fatalf("Failed to change file after snapshot: %v", err)
+	}
+
+	options := volume.VolumeOptions{
+		PVC: volumetest.CreateTestPVC("1Gi", []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}),
+		PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimDelete,
+		SnapshotHandle:                handle,
+	}
+	creater, err := plug.(volume.ProvisionableVolumePlugin).NewProvisioner(options)
+	if err != nil {
+		t.Fatalf("Failed to make a new Provisioner: %v", err)
+	}
+	pv, err := creater.Provision()
+	if err != nil {
+		t.Fatalf("Unexpected error restoring volume: %v", err)
+	}
+	defer os.RemoveAll(pv.Spec.HostPath.Path)
+	data, err := ioutil.ReadFile(filepath.Join(pv.Spec.HostPath.Path, "dir", "file"))
+	if err != nil {
+		t.Fatalf("Failed to read restored file: %v", err)
+	}
+	if string(data) != "data" {
+		t.Errorf("Expected restored file to contain %q but got %q", "data", string(data))
+	}
+
+	if err := plug.DeleteSnapshot(handle); err != nil {
+		t.Errorf("Unexpected error deleting snapshot: %v", err)
+	}
+	if exists, _ := util.FileExists(handle); exists {
+		t.Errorf("Snapshot expected to be deleted, but was found at %s", handle)
+	}
+	if err := plug.DeleteSnapshot(tempPath); err == nil {
+		t.Errorf("Expected failure deleting a snapshot outside of %s", hostPathSnapshotDir)
+	}
+}
+
+func TestSnapshotHandle(t *testing.T) {
+	tests := []struct {
+		handle       string
+		expectedPath string
+		expectErr    bool
+	}{
+		{handle: "/tmp/hostpath_snapshots/snapshot", expectedPath: "/tmp/hostpath_snapshots/snapshot"},
+		{handle: "/tmp/hostpath_snapshots/snapshot/", expectedPath: "/tmp/hostpath_snapshots/snapshot"},
+		{handle: "/tmp/hostpath_snapshots/..", expectErr: true},
+		{handle: "/tmp/hostpath_snapshots/.", expectErr: true},
+		{handle: "/tmp/hostpath_snapshots/", expectErr: true},
+		{handle: "/tmp/hostpath_snapshots/../hostpath", expectErr: true},
+		{handle: "/tmp/hostpath_snapshots/snapshot/..", expectErr: true},
+		{handle: "/tmp/hostpath_snapshots/snapshot/nested", expectErr: true},
+		{handle: "tmp/hostpath_snapshots/snapshot", expectErr: true},
+		{handle: "/tmp/snapshot", expectErr: true},
+	}
+
+	for _, test := range tests {
+		snapshotPath, err := getSnapshotPath(test.handle)
+		if err != nil && !test.expectErr {
+			t.Errorf("%s: unexpected error: %v", test.handle, err)
+		}
+		if err == nil && test.expectErr {
+			t.Errorf("%s: expected error, got path %s", test.handle, snapshotPath)
+		}
+		if snapshotPath != test.expectedPath {
+			t.Errorf("%s: expected path %q, got %q", test.handle, test.expectedPath, snapshotPath)
+		}
+	}
+
+	plugMgr := volume.VolumePluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{ProvisioningEnabled: true}), nil, /* prober */
+		volumetest.NewFakeVolumeHost("/tmp/fake", nil, nil))
+	plug, err := plugMgr.FindPluginByName(hostPathPluginName)
+	if err != nil {
+		t.Fatalf("Can't find the plugin by name: %v", err)
+	}
+	options := volume.VolumeOptions{
+		PVC: volumetest.CreateTestPVC("1Gi", []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}),
+		PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimDelete,
+		SnapshotHandle:                "/tmp/hostpath_snapshots/..",
+	}
+	creater, err := plug.(volume.ProvisionableVolumePlugin).NewProvisioner(options)
+	if err != nil {
+		t.Fatalf("Failed to make a new Provisioner: %v", err)
+	}
+	if pv, err := creater.Provision(); err == nil {
+		os.RemoveAll(pv.Spec.HostPath.Path)
+		t.Errorf("Expected failure restoring a snapshot outside of %s", hostPathSnapshotDir)
+	}
+}
+
 func TestPlugin(t *testing.T) {
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), volumetest.NewFakeVolumeHost("fake", nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), nil /* prober */, volumetest.NewFakeVolumeHost("fake", nil, nil))
 
 	plug, err := plugMgr.FindPluginByName("kubernetes.io/host-path")
 	if err != nil {
@@ -256,7 +373,7 @@ func TestPersistentClaimReadOnlyFlag(t *testing.T) {
 	client := fake.NewSimpleClientset(pv, claim)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), volumetest.NewFakeVolumeHost("/tmp/fake", client, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{}), nil /* prober */, volumetest.NewFakeVolumeHost("/tmp/fake", client, nil))
 	plug, _ := plugMgr.FindPluginByName(hostPathPluginName)
 
 	// readOnly bool is supplied by persistent-claim volume source when its mounter creates other volumes
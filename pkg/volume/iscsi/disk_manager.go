@@ -31,6 +31,11 @@ type diskManager interface {
 	AttachDisk(b iscsiDiskMounter) error
 	// Detaches the disk from the kubelet's host machine.
 	DetachDisk(disk iscsiDiskUnmounter, mntPath string) error
+	// Attaches the raw block disk to the kubelet's host machine and
+	// returns the path of its device.
+	AttachBlockISCSIDisk(b iscsiDiskMapper) (string, error)
+	// Detaches the raw block disk from the kubelet's host machine.
+	DetachBlockISCSIDisk(disk iscsiDiskUnmapper, mapPath string) error
 }
 
 // utility to mount a disk based filesystem
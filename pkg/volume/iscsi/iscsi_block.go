@@ -0,0 +1,136 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iscsi
+
+import (
+	"strconv"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/util/mount"
+	utilstrings "k8s.io/kubernetes/pkg/util/strings"
+	"k8s.io/kubernetes/pkg/volume"
+	ioutil "k8s.io/kubernetes/pkg/volume/util"
+)
+
+var _ volume.BlockVolumePlugin = &iscsiPlugin{}
+
+func (plugin *iscsiPlugin) NewBlockVolumeMapper(spec *volume.Spec, pod *v1.Pod, _ volume.VolumeOptions) (volume.BlockVolumeMapper, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newBlockVolumeMapperInternal(spec, pod.UID, &ISCSIUtil{})
+}
+
+func (plugin *iscsiPlugin) newBlockVolumeMapperInternal(spec *volume.Spec, podUID types.UID, manager diskManager) (volume.BlockVolumeMapper, error) {
+	iscsi, readOnly, err := getVolumeSource(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	lun := strconv.Itoa(int(iscsi.Lun))
+	portal := portalMounter(iscsi.TargetPortal)
+	var bkportal []string
+	bkportal = append(bkportal, portal)
+	for _, tp := range iscsi.Portals {
+		bkportal = append(bkportal, portalMounter(string(tp)))
+	}
+
+	return &iscsiDiskMapper{
+		iscsiDisk: &iscsiDisk{
+			podUID:  podUID,
+			volName: spec.Name(),
+			portals: bkportal,
+			iqn:     iscsi.IQN,
+			lun:     lun,
+			iface:   iscsi.ISCSIInterface,
+			manager: manager,
+			plugin:  plugin},
+		readOnly:   readOnly,
+		deviceUtil: ioutil.NewDeviceHandler(ioutil.NewIOHandler()),
+	}, nil
+}
+
+func (plugin *iscsiPlugin) NewBlockVolumeUnmapper(volName string, podUID types.UID) (volume.BlockVolumeUnmapper, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newBlockVolumeUnmapperInternal(volName, podUID, &ISCSIUtil{}, plugin.host.GetMounter())
+}
+
+func (plugin *iscsiPlugin) newBlockVolumeUnmapperInternal(volName string, podUID types.UID, manager diskManager, mounter mount.Interface) (volume.BlockVolumeUnmapper, error) {
+	return &iscsiDiskUnmapper{
+		iscsiDisk: &iscsiDisk{
+			podUID:  podUID,
+			volName: volName,
+			manager: manager,
+			plugin:  plugin,
+		},
+		mounter: mounter,
+	}, nil
+}
+
+// GetGlobalMapPath returns the global map path of the iscsi LUN:
+// plugins/kubernetes.io/iscsi/volumeDevices/iface-{iface}/{portal}-{iqn}-lun-{lun}
+func (iscsi *iscsiDisk) GetGlobalMapPath(spec *volume.Spec) (string, error) {
+	volumeSource, _, err := getVolumeSource(spec)
+	if err != nil {
+		return "", err
+	}
+	lun := strconv.Itoa(int(volumeSource.Lun))
+	portal := portalMounter(volumeSource.TargetPortal)
+	return makeVDPDNameInternal(iscsi.plugin.host, portal, volumeSource.IQN, lun, volumeSource.ISCSIInterface), nil
+}
+
+// GetPodDeviceMapPath returns the pod device map path and the name of the
+// link to the device:
+// pods/{podUid}/volumeDevices/kubernetes.io~iscsi, {volName}
+func (iscsi *iscsiDisk) GetPodDeviceMapPath() (string, string) {
+	return iscsi.plugin.host.GetPodVolumeDeviceDir(iscsi.podUID, utilstrings.EscapeQualifiedNameForDisk(iscsiPluginName)), iscsi.volName
+}
+
+type iscsiDiskMapper struct {
+	*iscsiDisk
+	readOnly   bool
+	deviceUtil ioutil.DeviceUtil
+}
+
+var _ volume.BlockVolumeMapper = &iscsiDiskMapper{}
+
+// SetUpDevice logs in to the iscsi target and returns the path of the device.
+func (b *iscsiDiskMapper) SetUpDevice() (string, error) {
+	devicePath, err := b.manager.AttachBlockISCSIDisk(*b)
+	if err != nil {
+		glog.Errorf("iscsi: failed to set up device: %v", err)
+		return "", err
+	}
+	return devicePath, nil
+}
+
+type iscsiDiskUnmapper struct {
+	*iscsiDisk
+	mounter mount.Interface
+}
+
+var _ volume.BlockVolumeUnmapper = &iscsiDiskUnmapper{}
+
+// TearDownDevice logs out of the iscsi target once no other LUN of the
+// target is in use on the node.
+func (c *iscsiDiskUnmapper) TearDownDevice(mapPath, devicePath string) error {
+	if err := c.manager.DetachBlockISCSIDisk(*c, mapPath); err != nil {
+		glog.Errorf("iscsi: failed to tear down device %s: %v", mapPath, err)
+		return err
+	}
+	return nil
+}
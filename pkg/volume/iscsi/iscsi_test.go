@@ -19,6 +19,7 @@ package iscsi
 import (
 	"fmt"
 	"os"
+	"path"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,7 +40,7 @@ func TestCanSupport(t *testing.T) {
 	defer os.RemoveAll(tmpDir)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
 
 	plug, err := plugMgr.FindPluginByName("kubernetes.io/iscsi")
 	if err != nil {
@@ -61,7 +62,7 @@ func TestGetAccessModes(t *testing.T) {
 	defer os.RemoveAll(tmpDir)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
 
 	plug, err := plugMgr.FindPersistentPluginByName("kubernetes.io/iscsi")
 	if err != nil {
@@ -124,6 +125,16 @@ func (fake *fakeDiskManager) DetachDisk(c iscsiDiskUnmounter, mntPath string) er
 	return nil
 }
 
+func (fake *fakeDiskManager) AttachBlockISCSIDisk(b iscsiDiskMapper) (string, error) {
+	fake.attachCalled = true
+	return "/dev/sdb", nil
+}
+
+func (fake *fakeDiskManager) DetachBlockISCSIDisk(c iscsiDiskUnmapper, mapPath string) error {
+	fake.detachCalled = true
+	return nil
+}
+
 func doTestPlugin(t *testing.T, spec *volume.Spec) {
 	tmpDir, err := utiltesting.MkTmpdir("iscsi_test")
 	if err != nil {
@@ -132,7 +143,7 @@ func doTestPlugin(t *testing.T, spec *volume.Spec) {
 	defer os.RemoveAll(tmpDir)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
 
 	plug, err := plugMgr.FindPluginByName("kubernetes.io/iscsi")
 	if err != nil {
@@ -275,7 +286,7 @@ func TestPersistentClaimReadOnlyFlag(t *testing.T) {
 	client := fake.NewSimpleClientset(pv, claim)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, client, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, client, nil))
 	plug, _ := plugMgr.FindPluginByName(iscsiPluginName)
 
 	// readOnly bool is supplied by persistent-claim volume source when its mounter creates other volumes
@@ -296,3 +307,73 @@ func TestPortalMounter(t *testing.T) {
 		t.Errorf("wrong portal: %s", portal)
 	}
 }
+
+func TestBlockMapper(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("iscsi_test")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	plugMgr := volume.VolumePluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+
+	blockMode := v1.PersistentVolumeBlock
+	spec := volume.NewSpecFromPersistentVolume(&v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "vol1"},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				ISCSI: &v1.ISCSIVolumeSource{
+					TargetPortal:   "127.0.0.1:3260",
+					IQN:            "iqn.2014-12.server:storage.target01",
+					Lun:            0,
+					ISCSIInterface: "default",
+				},
+			},
+			VolumeMode: &blockMode,
+		},
+	}, false)
+
+	plug, err := plugMgr.FindMapperPluginBySpec(spec)
+	if err != nil || plug == nil {
+		t.Fatalf("Can't find the block plugin: %v", err)
+	}
+	fakeManager := NewFakeDiskManager()
+	defer fakeManager.Cleanup()
+	mapper, err := plug.(*iscsiPlugin).newBlockVolumeMapperInternal(spec, types.UID("poduid"), fakeManager)
+	if err != nil {
+		t.Fatalf("Failed to make a new Mapper: %v", err)
+	}
+
+	globalMapPath, err := mapper.GetGlobalMapPath(spec)
+	if err != nil {
+		t.Fatalf("GetGlobalMapPath failed: %v", err)
+	}
+	expectedGlobalMapPath := path.Join(tmpDir, "plugins/kubernetes.io/iscsi/volumeDevices/iface-default/127.0.0.1:3260-iqn.2014-12.server:storage.target01-lun-0")
+	if globalMapPath != expectedGlobalMapPath {
+		t.Errorf("Unexpected global map path, expected %q, got: %q", expectedGlobalMapPath, globalMapPath)
+	}
+	podDeviceMapPath, volName := mapper.GetPodDeviceMapPath()
+	if expected := path.Join(tmpDir, "pods/poduid/volumeDevices/kubernetes.io~iscsi"); podDeviceMapPath != expected || volName != "vol1" {
+		t.Errorf("Unexpected pod device map path, expected %q, %q, got: %q, %q", expected, "vol1", podDeviceMapPath, volName)
+	}
+
+	devicePath, err := mapper.SetUpDevice()
+	if err != nil {
+		t.Fatalf("SetUpDevice failed: %v", err)
+	}
+	if devicePath != "/dev/sdb" || !fakeManager.attachCalled {
+		t.Errorf("Expected the disk to be attached as /dev/sdb, got %q", devicePath)
+	}
+
+	unmapper, err := plug.(*iscsiPlugin).newBlockVolumeUnmapperInternal("vol1", types.UID("poduid"), fakeManager, &mount.FakeMounter{})
+	if err != nil {
+		t.Fatalf("Failed to make a new Unmapper: %v", err)
+	}
+	if err := unmapper.TearDownDevice(globalMapPath, devicePath); err != nil {
+		t.Fatalf("TearDownDevice failed: %v", err)
+	}
+	if !fakeManager.detachCalled {
+		t.Errorf("Expected the disk to be detached")
+	}
+}
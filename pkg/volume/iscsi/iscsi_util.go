@@ -29,6 +29,7 @@ import (
 	"github.com/golang/glog"
 	"k8s.io/kubernetes/pkg/util/mount"
 	"k8s.io/kubernetes/pkg/volume"
+	ioutil "k8s.io/kubernetes/pkg/volume/util"
 )
 
 // stat a path, if not exists, retry maxRetries times
@@ -95,6 +96,11 @@ func makePDNameInternal(host volume.VolumeHost, portal string, iqn string, lun s
 	return path.Join(host.GetPluginDir(iscsiPluginName), "iface-"+iface, portal+"-"+iqn+"-lun-"+lun)
 }
 
+// make a directory like /var/lib/kubelet/plugins/kubernetes.io/iscsi/volumeDevices/iface_name/portal-some_iqn-lun-lun_id
+func makeVDPDNameInternal(host volume.VolumeHost, portal string, iqn string, lun string, iface string) string {
+	return path.Join(host.GetPluginDir(iscsiPluginName), ioutil.VolumeDevicesInGlobalPath, "iface-"+iface, portal+"-"+iqn+"-lun-"+lun)
+}
+
 type ISCSIUtil struct{}
 
 func (util *ISCSIUtil) MakeGlobalPDName(iscsi iscsiDisk) string {
@@ -102,6 +108,41 @@ func (util *ISCSIUtil) MakeGlobalPDName(iscsi iscsiDisk) string {
 }
 
 func (util *ISCSIUtil) AttachDisk(b iscsiDiskMounter) error {
+	devicePath, err := attachISCSIDevice(*b.iscsiDisk, b.deviceUtil)
+	if err != nil {
+		return err
+	}
+
+	// mount it
+	globalPDPath := b.manager.MakeGlobalPDName(*b.iscsiDisk)
+	notMnt, err := b.mounter.IsLikelyNotMountPoint(globalPDPath)
+	if !notMnt {
+		glog.Infof("iscsi: %s already mounted", globalPDPath)
+		return nil
+	}
+
+	if err := os.MkdirAll(globalPDPath, 0750); err != nil {
+		glog.Errorf("iscsi: failed to mkdir %s, error", globalPDPath)
+		return err
+	}
+
+	err = b.mounter.FormatAndMount(devicePath, globalPDPath, b.fsType, nil)
+	if err != nil {
+		glog.Errorf("iscsi: failed to mount iscsi volume %s [%s] to %s, error %v", devicePath, b.fsType, globalPDPath, err)
+	}
+
+	return err
+}
+
+// AttachBlockISCSIDisk logs in to the target of a raw block volume and
+// returns the path of its device. The device is not formatted nor mounted.
+func (util *ISCSIUtil) AttachBlockISCSIDisk(b iscsiDiskMapper) (string, error) {
+	return attachISCSIDevice(*b.iscsiDisk, b.deviceUtil)
+}
+
+// attachISCSIDevice logs in to the iscsi target if needed and returns the
+// path of the device, preferring the multipath device if there is one.
+func attachISCSIDevice(b iscsiDisk, deviceUtil ioutil.DeviceUtil) (string, error) {
 	var devicePath string
 	var devicePaths []string
 	var iscsiTransport string
@@ -109,7 +150,7 @@ func (util *ISCSIUtil) AttachDisk(b iscsiDiskMounter) error {
 	out, err := b.plugin.execCommand("iscsiadm", []string{"-m", "iface", "-I", b.iface, "-o", "show"})
 	if err != nil {
 		glog.Errorf("iscsi: could not read iface %s error: %s", b.iface, string(out))
-		return err
+		return "", err
 	}
 
 	iscsiTransport = extractTransportname(string(out))
@@ -125,7 +166,7 @@ func (util *ISCSIUtil) AttachDisk(b iscsiDiskMounter) error {
 
 		if iscsiTransport == "" {
 			glog.Errorf("iscsi: could not find transport name in iface %s", b.iface)
-			return errors.New(fmt.Sprintf("Could not parse iface file for %s", b.iface))
+			return "", errors.New(fmt.Sprintf("Could not parse iface file for %s", b.iface))
 		} else if iscsiTransport == "tcp" {
 			devicePath = strings.Join([]string{"/dev/disk/by-path/ip", tp, "iscsi", b.iqn, "lun", b.lun}, "-")
 		} else {
@@ -159,43 +200,25 @@ func (util *ISCSIUtil) AttachDisk(b iscsiDiskMounter) error {
 
 	if len(devicePaths) == 0 {
 		glog.Errorf("iscsi: failed to get any path for iscsi disk")
-		return errors.New("failed to get any path for iscsi disk")
+		return "", errors.New("failed to get any path for iscsi disk")
 	}
 
 	//Make sure we use a valid devicepath to find mpio device.
 	devicePath = devicePaths[0]
 
-	// mount it
-	globalPDPath := b.manager.MakeGlobalPDName(*b.iscsiDisk)
-	notMnt, err := b.mounter.IsLikelyNotMountPoint(globalPDPath)
-	if !notMnt {
-		glog.Infof("iscsi: %s already mounted", globalPDPath)
-		return nil
-	}
-
-	if err := os.MkdirAll(globalPDPath, 0750); err != nil {
-		glog.Errorf("iscsi: failed to mkdir %s, error", globalPDPath)
-		return err
-	}
-
 	for _, path := range devicePaths {
 		// There shouldnt be any empty device paths. However adding this check
 		// for safer side to avoid the possibility of an empty entry.
 		if path == "" {
 			continue
 		}
-		// check if the dev is using mpio and if so mount it via the dm-XX device
-		if mappedDevicePath := b.deviceUtil.FindMultipathDeviceForDevice(path); mappedDevicePath != "" {
+		// check if the dev is using mpio and if so use the dm-XX device
+		if mappedDevicePath := deviceUtil.FindMultipathDeviceForDevice(path); mappedDevicePath != "" {
 			devicePath = mappedDevicePath
 			break
 		}
 	}
-	err = b.mounter.FormatAndMount(devicePath, globalPDPath, b.fsType, nil)
-	if err != nil {
-		glog.Errorf("iscsi: failed to mount iscsi volume %s [%s] to %s, error %v", devicePath, b.fsType, globalPDPath, err)
-	}
-
-	return err
+	return devicePath, nil
 }
 
 func (util *ISCSIUtil) DetachDisk(c iscsiDiskUnmounter, mntPath string) error {
@@ -245,6 +268,52 @@ func (util *ISCSIUtil) DetachDisk(c iscsiDiskUnmounter, mntPath string) error {
 	return nil
 }
 
+// DetachBlockISCSIDisk logs out of the target of a raw block volume once no
+// other LUN of the target is mapped or mounted on the node.
+func (util *ISCSIUtil) DetachBlockISCSIDisk(c iscsiDiskUnmapper, mapPath string) error {
+	device, prefix, err := extractDeviceAndPrefix(mapPath)
+	if err != nil {
+		return err
+	}
+	portal, iqn, err := extractPortalAndIqn(device)
+	if err != nil {
+		return err
+	}
+	iface, found := extractIface(mapPath)
+	if !found {
+		return fmt.Errorf("iscsi detach disk: no iface in %s", mapPath)
+	}
+
+	// Other LUNs of the target may still be mapped as raw block volumes...
+	blkRefs, err := filepath.Glob(prefix + "-lun-*")
+	if err != nil {
+		return err
+	}
+	for _, ref := range blkRefs {
+		if ref != mapPath {
+			glog.V(4).Infof("iscsi: target %s iqn %s is still mapped at %s", portal, iqn, ref)
+			return nil
+		}
+	}
+	// ... or mounted as file systems
+	mntPrefix := path.Join(c.plugin.host.GetPluginDir(iscsiPluginName), "iface-"+iface, portal+"-"+iqn)
+	refCount, err := getDevicePrefixRefCount(c.mounter, mntPrefix)
+	if err != nil {
+		return err
+	}
+	if refCount != 0 {
+		glog.V(4).Infof("iscsi: target %s iqn %s is still mounted", portal, iqn)
+		return nil
+	}
+
+	glog.Infof("iscsi: log out target %s iqn %s iface %s", portal, iqn, iface)
+	out, err := c.plugin.execCommand("iscsiadm", []string{"-m", "node", "-p", portal, "-T", iqn, "-I", iface, "--logout"})
+	if err != nil {
+		glog.Errorf("iscsi: failed to detach disk Error: %s", string(out))
+	}
+	return nil
+}
+
 func extractTransportname(ifaceOutput string) (iscsiTransport string) {
 	re := regexp.MustCompile(`iface.transport_name = (.*)\n`)
 
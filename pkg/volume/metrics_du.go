@@ -45,17 +45,19 @@ func (md *metricsDu) GetMetrics() (*Metrics, error) {
 		return metrics, NewNoPathDefinedError()
 	}
 
-	err := md.runDu(metrics)
+	// Gather the filesystem info first so that the volume condition is set
+	// even when "du" or "find" fail because the device is gone.
+	err := md.getFsInfo(metrics)
 	if err != nil {
 		return metrics, err
 	}
 
-	err = md.runFind(metrics)
+	err = md.runDu(metrics)
 	if err != nil {
 		return metrics, err
 	}
 
-	err = md.getFsInfo(metrics)
+	err = md.runFind(metrics)
 	if err != nil {
 		return metrics, err
 	}
@@ -88,8 +90,10 @@ func (md *metricsDu) runFind(metrics *Metrics) error {
 func (md *metricsDu) getFsInfo(metrics *Metrics) error {
 	available, capacity, _, inodes, inodesFree, _, err := util.FsInfo(md.path)
 	if err != nil {
+		setVolumeCondition(metrics, err)
 		return NewFsInfoFailedError(err)
 	}
+	setVolumeCondition(metrics, nil)
 	metrics.Available = resource.NewQuantity(available, resource.BinarySI)
 	metrics.Capacity = resource.NewQuantity(capacity, resource.BinarySI)
 	metrics.Inodes = resource.NewQuantity(inodes, resource.BinarySI)
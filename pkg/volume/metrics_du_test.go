@@ -62,6 +62,9 @@ func TestMetricsDuGetCapacity(t *testing.T) {
 	if a := actual.Available.Value(); a <= 0 {
 		t.Errorf("Expected Available %d to be greater than 0.", a)
 	}
+	if actual.Abnormal == nil || *actual.Abnormal {
+		t.Errorf("Expected the volume condition to be normal, got %v", actual.Abnormal)
+	}
 
 	// Write a file and expect Used to increase
 	ioutil.WriteFile(filepath.Join(tmpDir, "f1"), []byte("Hello World"), os.ModeTemporary)
@@ -18,6 +18,8 @@ package volume
 
 import (
 	"fmt"
+
+	"k8s.io/kubernetes/pkg/volume/util"
 )
 
 const (
@@ -75,3 +77,19 @@ func isErrCode(err error, code int) bool {
 	}
 	return false
 }
+
+// setVolumeCondition sets the condition of metrics from the error returned
+// while gathering the filesystem info of the volume. The volume is abnormal if
+// the error shows that the device backing it is gone, the condition is left
+// unknown for any other error.
+func setVolumeCondition(metrics *Metrics, err error) {
+	if err != nil && !util.IsDeviceMissingError(err) {
+		return
+	}
+	abnormal := err != nil
+	metrics.Abnormal = &abnormal
+	if abnormal {
+		message := fmt.Sprintf("the device backing the volume is missing: %v", err)
+		metrics.Message = &message
+	}
+}
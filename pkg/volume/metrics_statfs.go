@@ -56,8 +56,10 @@ func (md *metricsStatFS) GetMetrics() (*Metrics, error) {
 func (md *metricsStatFS) getFsInfo(metrics *Metrics) error {
 	available, capacity, usage, inodes, inodesFree, inodesUsed, err := util.FsInfo(md.path)
 	if err != nil {
+		setVolumeCondition(metrics, err)
 		return NewFsInfoFailedError(err)
 	}
+	setVolumeCondition(metrics, nil)
 	metrics.Available = resource.NewQuantity(available, resource.BinarySI)
 	metrics.Capacity = resource.NewQuantity(capacity, resource.BinarySI)
 	metrics.Used = resource.NewQuantity(usage, resource.BinarySI)
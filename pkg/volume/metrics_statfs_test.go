@@ -18,6 +18,7 @@ package volume
 
 import (
 	"os"
+	"syscall"
 	"testing"
 
 	utiltesting "k8s.io/client-go/util/testing"
@@ -61,5 +62,20 @@ func TestGetMetricsStatFS(t *testing.T) {
 	if a := actual.Available.Value(); a <= 0 {
 		t.Errorf("Expected Available %d to be greater than 0.", a)
 	}
+	if actual.Abnormal == nil || *actual.Abnormal {
+		t.Errorf("Expected the volume condition to be normal, actual %v", actual.Abnormal)
+	}
+}
 
+func TestSetVolumeCondition(t *testing.T) {
+	metrics := &Metrics{}
+	setVolumeCondition(metrics, syscall.ENOENT)
+	if metrics.Abnormal != nil || metrics.Message != nil {
+		t.Errorf("Expected an unknown volume condition for ENOENT, actual %v", *metrics)
+	}
+
+	setVolumeCondition(metrics, syscall.ENOTCONN)
+	if metrics.Abnormal == nil || !*metrics.Abnormal || metrics.Message == nil {
+		t.Errorf("Expected an abnormal volume condition for ENOTCONN, actual %v", *metrics)
+	}
 }
@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -58,6 +59,10 @@ type VolumeOptions struct {
 	CloudTags *map[string]string
 	// Volume provisioning parameters from StorageClass
 	Parameters map[string]string
+	// SnapshotHandle identifies the snapshot the new volume must be restored
+	// from, as returned by CreateSnapshot of the provisioning plugin.  It is
+	// empty when the claim has no data source.
+	SnapshotHandle string
 }
 
 // VolumePlugin is an interface to volume plugins that can be used on a
@@ -174,6 +179,48 @@ type AttachableVolumePlugin interface {
 	GetDeviceMountRefs(deviceMountPath string) ([]string, error)
 }
 
+// ExpandableVolumePlugin is an extended interface of VolumePlugin and is used
+// for volumes that can be expanded after they have been provisioned.
+type ExpandableVolumePlugin interface {
+	VolumePlugin
+	// ExpandVolumeDevice grows the underlying storage of the volume to at
+	// least newSize and returns the size the volume actually has afterwards.
+	ExpandVolumeDevice(spec *Spec, newSize resource.Quantity, oldSize resource.Quantity) (resource.Quantity, error)
+	// RequiresFSResize returns true if the file system on the volume has to
+	// be grown by the kubelet after the device has been expanded.
+	RequiresFSResize() bool
+}
+
+// SnapshottableVolumePlugin is an extended interface of VolumePlugin and is
+// used for volumes that can be snapshotted.  Provisioners of such plugins
+// must restore VolumeOptions.SnapshotHandle into the volumes they create.
+type SnapshottableVolumePlugin interface {
+	VolumePlugin
+	// CreateSnapshot takes a point-in-time snapshot of the volume and returns
+	// a handle identifying it.  snapshotName is unique in the cluster and may
+	// be used to name the snapshot in the storage backend.
+	CreateSnapshot(spec *Spec, snapshotName string) (string, error)
+	// DeleteSnapshot removes the snapshot identified by handle.
+	DeleteSnapshot(handle string) error
+}
+
+// BlockVolumePlugin is an extend interface of VolumePlugin and is used for
+// block volumes support, i.e. persistent volumes in Block volume mode whose
+// raw devices are mapped into containers instead of being mounted.
+type BlockVolumePlugin interface {
+	VolumePlugin
+	// NewBlockVolumeMapper creates a new volume.BlockVolumeMapper from an API
+	// specification.  Ownership of the spec pointer in *not* transferred.
+	// - spec: The v1.Volume spec
+	// - pod: The enclosing pod
+	NewBlockVolumeMapper(spec *Spec, podRef *v1.Pod, opts VolumeOptions) (BlockVolumeMapper, error)
+	// NewBlockVolumeUnmapper creates a new volume.BlockVolumeUnmapper from
+	// recoverable state.
+	// - name: The volume name, as per the v1.Volume spec.
+	// - podUID: The UID of the enclosing pod
+	NewBlockVolumeUnmapper(name string, podUID types.UID) (BlockVolumeUnmapper, error)
+}
+
 // VolumeHost is an interface that plugins can use to access the kubelet.
 type VolumeHost interface {
 	// GetPluginDir returns the absolute path to a directory under which
@@ -188,6 +235,12 @@ type VolumeHost interface {
 	// might not exist.
 	GetPodVolumeDir(podUID types.UID, pluginName string, volumeName string) string
 
+	// GetPodVolumeDeviceDir returns the absolute path a directory which
+	// holds the symbolic links to the raw block devices of the named
+	// plugin for the given pod.  If the specified pod does not exist, the
+	// result of this call might not exist.
+	GetPodVolumeDeviceDir(podUID types.UID, pluginName string) string
+
 	// GetPodPluginDir returns the absolute path to a directory under which
 	// a given plugin may store data for a given pod.  If the specified pod
 	// does not exist, the result of this call might not exist.  This
@@ -228,12 +281,32 @@ type VolumeHost interface {
 
 	// Returns a function that returns a secret.
 	GetSecretFunc() func(namespace, name string) (*v1.Secret, error)
+
+	// Returns the name of the node the host is running on.
+	GetNodeName() types.NodeName
+
+	// Returns the labels on the node the host is running on.
+	GetNodeLabels() (map[string]string, error)
 }
 
 // VolumePluginMgr tracks registered plugins.
 type VolumePluginMgr struct {
-	mutex   sync.Mutex
-	plugins map[string]VolumePlugin
+	mutex         sync.Mutex
+	plugins       map[string]VolumePlugin
+	prober        DynamicPluginProber
+	probedPlugins []VolumePlugin
+	Host          VolumeHost
+}
+
+// DynamicPluginProber discovers volume plugins that may be installed or
+// removed while the process is running, e.g. flexvolume drivers.
+type DynamicPluginProber interface {
+	// Init sets up the prober, e.g. starts watching for new plugins.
+	Init() error
+
+	// Probe returns true and the full list of the currently installed
+	// plugins if they changed since the last call, false otherwise.
+	Probe() (updated bool, plugins []VolumePlugin, err error)
 }
 
 // Spec is an internal representation of a volume.  All API volume types translate to Spec.
@@ -327,15 +400,26 @@ func NewSpecFromPersistentVolume(pv *v1.PersistentVolume, readOnly bool) *Spec {
 
 // InitPlugins initializes each plugin.  All plugins must have unique names.
 // This must be called exactly once before any New* methods are called on any
-// plugins.
-func (pm *VolumePluginMgr) InitPlugins(plugins []VolumePlugin, host VolumeHost) error {
+// plugins.  The prober, if not nil, is used to discover plugins that are
+// installed or removed later on.
+func (pm *VolumePluginMgr) InitPlugins(plugins []VolumePlugin, prober DynamicPluginProber, host VolumeHost) error {
 	pm.mutex.Lock()
 	defer pm.mutex.Unlock()
 
+	pm.Host = host
 	if pm.plugins == nil {
 		pm.plugins = map[string]VolumePlugin{}
 	}
 
+	if prober != nil {
+		if err := prober.Init(); err != nil {
+			// Prober init failure should not affect the initialization of other plugins.
+			glog.Errorf("Error initializing dynamic plugin prober: %s", err)
+		} else {
+			pm.prober = prober
+		}
+	}
+
 	allErrs := []error{}
 	for _, plugin := range plugins {
 		name := plugin.GetPluginName()
@@ -360,6 +444,41 @@ func (pm *VolumePluginMgr) InitPlugins(plugins []VolumePlugin, host VolumeHost)
 	return utilerrors.NewAggregate(allErrs)
 }
 
+// refreshProbedPlugins replaces the dynamically probed plugins if the prober
+// reports that they changed.  Must be called with pm.mutex held.
+func (pm *VolumePluginMgr) refreshProbedPlugins() {
+	if pm.prober == nil {
+		return
+	}
+	updated, plugins, err := pm.prober.Probe()
+	if err != nil {
+		glog.Errorf("Error dynamically probing plugins: %s", err)
+		return // Use cached plugins upon failure.
+	}
+	if !updated {
+		return
+	}
+
+	pm.probedPlugins = []VolumePlugin{}
+	for _, plugin := range plugins {
+		name := plugin.GetPluginName()
+		if errs := validation.IsQualifiedName(name); len(errs) != 0 {
+			glog.Errorf("Dynamically probed volume plugin has invalid name: %q: %s", name, strings.Join(errs, ";"))
+			continue
+		}
+		if _, found := pm.plugins[name]; found {
+			glog.Errorf("Dynamically probed volume plugin %q conflicts with a registered plugin", name)
+			continue
+		}
+		if err := plugin.Init(pm.Host); err != nil {
+			glog.Errorf("Failed to load dynamically probed volume plugin %s, error: %s", name, err.Error())
+			continue
+		}
+		pm.probedPlugins = append(pm.probedPlugins, plugin)
+		glog.V(1).Infof("Loaded dynamically probed volume plugin %q", name)
+	}
+}
+
 // FindPluginBySpec looks for a plugin that can support a given volume
 // specification.  If no plugins can support or more than one plugin can
 // support it, return error.
@@ -367,19 +486,30 @@ func (pm *VolumePluginMgr) FindPluginBySpec(spec *Spec) (VolumePlugin, error) {
 	pm.mutex.Lock()
 	defer pm.mutex.Unlock()
 
-	matches := []string{}
+	matchedPluginNames := []string{}
+	matches := []VolumePlugin{}
 	for k, v := range pm.plugins {
 		if v.CanSupport(spec) {
-			matches = append(matches, k)
+			matchedPluginNames = append(matchedPluginNames, k)
+			matches = append(matches, v)
+		}
+	}
+
+	pm.refreshProbedPlugins()
+	for _, plugin := range pm.probedPlugins {
+		if plugin.CanSupport(spec) {
+			matchedPluginNames = append(matchedPluginNames, plugin.GetPluginName())
+			matches = append(matches, plugin)
 		}
 	}
+
 	if len(matches) == 0 {
 		return nil, fmt.Errorf("no volume plugin matched")
 	}
 	if len(matches) > 1 {
-		return nil, fmt.Errorf("multiple volume plugins matched: %s", strings.Join(matches, ","))
+		return nil, fmt.Errorf("multiple volume plugins matched: %s", strings.Join(matchedPluginNames, ","))
 	}
-	return pm.plugins[matches[0]], nil
+	return matches[0], nil
 }
 
 // FindPluginByName fetches a plugin by name or by legacy name.  If no plugin
@@ -389,19 +519,30 @@ func (pm *VolumePluginMgr) FindPluginByName(name string) (VolumePlugin, error) {
 	defer pm.mutex.Unlock()
 
 	// Once we can get rid of legacy names we can reduce this to a map lookup.
-	matches := []string{}
+	matchedPluginNames := []string{}
+	matches := []VolumePlugin{}
 	for k, v := range pm.plugins {
 		if v.GetPluginName() == name {
-			matches = append(matches, k)
+			matchedPluginNames = append(matchedPluginNames, k)
+			matches = append(matches, v)
+		}
+	}
+
+	pm.refreshProbedPlugins()
+	for _, plugin := range pm.probedPlugins {
+		if plugin.GetPluginName() == name {
+			matchedPluginNames = append(matchedPluginNames, plugin.GetPluginName())
+			matches = append(matches, plugin)
 		}
 	}
+
 	if len(matches) == 0 {
 		return nil, fmt.Errorf("no volume plugin matched")
 	}
 	if len(matches) > 1 {
-		return nil, fmt.Errorf("multiple volume plugins matched: %s", strings.Join(matches, ","))
+		return nil, fmt.Errorf("multiple volume plugins matched: %s", strings.Join(matchedPluginNames, ","))
 	}
-	return pm.plugins[matches[0]], nil
+	return matches[0], nil
 }
 
 // FindPersistentPluginBySpec looks for a persistent volume plugin that can
@@ -526,6 +667,90 @@ func (pm *VolumePluginMgr) FindAttachablePluginByName(name string) (AttachableVo
 	return nil, nil
 }
 
+// FindExpandablePluginBySpec fetches a persistent volume plugin by spec.
+// Unlike the other "FindPlugin" methods, this does not return error if no
+// plugin is found, because most volumes can not be expanded.
+func (pm *VolumePluginMgr) FindExpandablePluginBySpec(spec *Spec) (ExpandableVolumePlugin, error) {
+	volumePlugin, err := pm.FindPluginBySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if expandablePlugin, ok := volumePlugin.(ExpandableVolumePlugin); ok {
+		return expandablePlugin, nil
+	}
+	return nil, nil
+}
+
+// FindExpandablePluginByName fetches a persistent volume plugin by name.
+// Like FindExpandablePluginBySpec, it returns nil without an error if the
+// plugin can not expand volumes.
+func (pm *VolumePluginMgr) FindExpandablePluginByName(name string) (ExpandableVolumePlugin, error) {
+	volumePlugin, err := pm.FindPluginByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if expandablePlugin, ok := volumePlugin.(ExpandableVolumePlugin); ok {
+		return expandablePlugin, nil
+	}
+	return nil, nil
+}
+
+// FindSnapshottablePluginBySpec fetches a persistent volume plugin by spec.
+// Like FindExpandablePluginBySpec, it returns nil without an error if the
+// plugin can not snapshot volumes.
+func (pm *VolumePluginMgr) FindSnapshottablePluginBySpec(spec *Spec) (SnapshottableVolumePlugin, error) {
+	volumePlugin, err := pm.FindPluginBySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if snapshottablePlugin, ok := volumePlugin.(SnapshottableVolumePlugin); ok {
+		return snapshottablePlugin, nil
+	}
+	return nil, nil
+}
+
+// FindSnapshottablePluginByName fetches a persistent volume plugin by name.
+// Like FindSnapshottablePluginBySpec, it returns nil without an error if the
+// plugin can not snapshot volumes.
+func (pm *VolumePluginMgr) FindSnapshottablePluginByName(name string) (SnapshottableVolumePlugin, error) {
+	volumePlugin, err := pm.FindPluginByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if snapshottablePlugin, ok := volumePlugin.(SnapshottableVolumePlugin); ok {
+		return snapshottablePlugin, nil
+	}
+	return nil, nil
+}
+
+// FindMapperPluginBySpec fetches a block volume plugin by spec.  Like
+// FindExpandablePluginBySpec, it returns nil without an error if the plugin
+// can not map raw block devices.
+func (pm *VolumePluginMgr) FindMapperPluginBySpec(spec *Spec) (BlockVolumePlugin, error) {
+	volumePlugin, err := pm.FindPluginBySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if blockVolumePlugin, ok := volumePlugin.(BlockVolumePlugin); ok {
+		return blockVolumePlugin, nil
+	}
+	return nil, nil
+}
+
+// FindMapperPluginByName fetches a block volume plugin by name.  Like
+// FindMapperPluginBySpec, it returns nil without an error if the plugin can
+// not map raw block devices.
+func (pm *VolumePluginMgr) FindMapperPluginByName(name string) (BlockVolumePlugin, error) {
+	volumePlugin, err := pm.FindPluginByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if blockVolumePlugin, ok := volumePlugin.(BlockVolumePlugin); ok {
+		return blockVolumePlugin, nil
+	}
+	return nil, nil
+}
+
 // NewPersistentVolumeRecyclerPodTemplate creates a template for a recycler
 // pod.  By default, a recycler pod simply runs "rm -rf" on a volume and tests
 // for emptiness.  Most attributes of the template will be correct for most
@@ -99,7 +99,7 @@ func newTestPlugin() []VolumePlugin {
 
 func TestVolumePluginMgrFunc(t *testing.T) {
 	vpm := VolumePluginMgr{}
-	vpm.InitPlugins(newTestPlugin(), nil)
+	vpm.InitPlugins(newTestPlugin(), nil /* prober */, nil)
 
 	plug, err := vpm.FindPluginByName("testPlugin")
 	if err != nil {
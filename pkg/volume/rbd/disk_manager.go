@@ -38,6 +38,11 @@ type diskManager interface {
 	AttachDisk(disk rbdMounter) error
 	// Detaches the disk from the kubelet's host machine.
 	DetachDisk(disk rbdUnmounter, mntPath string) error
+	// Attaches the raw block disk to the kubelet's host machine and returns
+	// the path of its device.
+	AttachBlockDisk(disk rbdDiskMapper) (string, error)
+	// Detaches the raw block disk from the kubelet's host machine.
+	DetachBlockDisk(disk rbdDiskUnmapper, mapPath string) error
 	// Creates a rbd image
 	CreateImage(provisioner *rbdVolumeProvisioner) (r *v1.RBDVolumeSource, volumeSizeGB int, err error)
 	// Deletes a rbd image
@@ -0,0 +1,144 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbd
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/util/exec"
+	"k8s.io/kubernetes/pkg/util/mount"
+	"k8s.io/kubernetes/pkg/util/strings"
+	"k8s.io/kubernetes/pkg/volume"
+	volutil "k8s.io/kubernetes/pkg/volume/util"
+)
+
+var _ volume.BlockVolumePlugin = &rbdPlugin{}
+
+func (plugin *rbdPlugin) NewBlockVolumeMapper(spec *volume.Spec, pod *v1.Pod, _ volume.VolumeOptions) (volume.BlockVolumeMapper, error) {
+	var secret string
+	var err error
+	source, _ := plugin.getRBDVolumeSource(spec)
+
+	if source.SecretRef != nil {
+		if secret, err = parsePodSecret(pod, source.SecretRef.Name, plugin.host.GetKubeClient()); err != nil {
+			glog.Errorf("Couldn't get secret from %v/%v", pod.Namespace, source.SecretRef)
+			return nil, err
+		}
+	}
+
+	// Inject real implementations here, test through the internal function.
+	return plugin.newBlockVolumeMapperInternal(spec, pod.UID, &RBDUtil{}, plugin.host.GetMounter(), secret)
+}
+
+func (plugin *rbdPlugin) newBlockVolumeMapperInternal(spec *volume.Spec, podUID types.UID, manager diskManager, mounter mount.Interface, secret string) (volume.BlockVolumeMapper, error) {
+	source, readOnly := plugin.getRBDVolumeSource(spec)
+
+	return &rbdDiskMapper{
+		rbdMounter: &rbdMounter{
+			rbd: &rbd{
+				podUID:   podUID,
+				volName:  spec.Name(),
+				Image:    source.RBDImage,
+				Pool:     source.RBDPool,
+				ReadOnly: readOnly,
+				manager:  manager,
+				mounter:  &mount.SafeFormatAndMount{Interface: mounter, Runner: exec.New()},
+				plugin:   plugin,
+			},
+			Mon:     source.CephMonitors,
+			Id:      source.RadosUser,
+			Keyring: source.Keyring,
+			Secret:  secret,
+		},
+		spec: spec,
+	}, nil
+}
+
+func (plugin *rbdPlugin) NewBlockVolumeUnmapper(volName string, podUID types.UID) (volume.BlockVolumeUnmapper, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newBlockVolumeUnmapperInternal(volName, podUID, &RBDUtil{}, plugin.host.GetMounter())
+}
+
+func (plugin *rbdPlugin) newBlockVolumeUnmapperInternal(volName string, podUID types.UID, manager diskManager, mounter mount.Interface) (volume.BlockVolumeUnmapper, error) {
+	return &rbdDiskUnmapper{
+		rbdMounter: &rbdMounter{
+			rbd: &rbd{
+				podUID:  podUID,
+				volName: volName,
+				manager: manager,
+				mounter: &mount.SafeFormatAndMount{Interface: mounter, Runner: exec.New()},
+				plugin:  plugin,
+			},
+			Mon: make([]string, 0),
+		},
+	}, nil
+}
+
+// GetGlobalMapPath returns the global map path of the rbd image:
+// plugins/kubernetes.io/rbd/volumeDevices/{pool}-image-{image}
+func (rbd *rbd) GetGlobalMapPath(spec *volume.Spec) (string, error) {
+	source, _ := rbd.plugin.getRBDVolumeSource(spec)
+	if source == nil {
+		return "", fmt.Errorf("rbd: spec does not reference a RBD volume type")
+	}
+	return path.Join(rbd.plugin.host.GetPluginDir(rbdPluginName), volutil.VolumeDevicesInGlobalPath, source.RBDPool+"-image-"+source.RBDImage), nil
+}
+
+// GetPodDeviceMapPath returns the pod device map path and the name of the
+// link to the device:
+// pods/{podUid}/volumeDevices/kubernetes.io~rbd, {volName}
+func (rbd *rbd) GetPodDeviceMapPath() (string, string) {
+	return rbd.plugin.host.GetPodVolumeDeviceDir(rbd.podUID, strings.EscapeQualifiedNameForDisk(rbdPluginName)), rbd.volName
+}
+
+type rbdDiskMapper struct {
+	*rbdMounter
+	spec *volume.Spec
+}
+
+var _ volume.BlockVolumeMapper = &rbdDiskMapper{}
+
+// SetUpDevice maps the rbd image to the node and returns the path of its
+// device.
+func (b *rbdDiskMapper) SetUpDevice() (string, error) {
+	devicePath, err := b.manager.AttachBlockDisk(*b)
+	if err != nil {
+		glog.Errorf("rbd: failed to set up device: %v", err)
+		return "", err
+	}
+	return devicePath, nil
+}
+
+type rbdDiskUnmapper struct {
+	*rbdMounter
+}
+
+var _ volume.BlockVolumeUnmapper = &rbdDiskUnmapper{}
+
+// TearDownDevice unmaps the rbd image from the node once no pod maps it
+// anymore.
+func (c *rbdDiskUnmapper) TearDownDevice(mapPath, devicePath string) error {
+	if err := c.manager.DetachBlockDisk(*c, mapPath); err != nil {
+		return fmt.Errorf("rbd: failed to detach disk %s: %v", mapPath, err)
+	}
+	glog.V(4).Infof("rbd: %s is unmapped", mapPath)
+	return nil
+}
@@ -19,6 +19,7 @@ package rbd
 import (
 	"fmt"
 	"os"
+	"path"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,7 +40,7 @@ func TestCanSupport(t *testing.T) {
 	defer os.RemoveAll(tmpDir)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
 
 	plug, err := plugMgr.FindPluginByName("kubernetes.io/rbd")
 	if err != nil {
@@ -88,6 +89,14 @@ func (fake *fakeDiskManager) DetachDisk(c rbdUnmounter, mntPath string) error {
 	return nil
 }
 
+func (fake *fakeDiskManager) AttachBlockDisk(b rbdDiskMapper) (string, error) {
+	return "/dev/rbd0", nil
+}
+
+func (fake *fakeDiskManager) DetachBlockDisk(c rbdDiskUnmapper, mapPath string) error {
+	return nil
+}
+
 func (fake *fakeDiskManager) CreateImage(provisioner *rbdVolumeProvisioner) (r *v1.RBDVolumeSource, volumeSizeGB int, err error) {
 	return nil, 0, fmt.Errorf("not implemented")
 }
@@ -104,7 +113,7 @@ func doTestPlugin(t *testing.T, spec *volume.Spec) {
 	defer os.RemoveAll(tmpDir)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
 
 	plug, err := plugMgr.FindPluginByName("kubernetes.io/rbd")
 	if err != nil {
@@ -228,7 +237,7 @@ func TestPersistentClaimReadOnlyFlag(t *testing.T) {
 	client := fake.NewSimpleClientset(pv, claim)
 
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(), volumetest.NewFakeVolumeHost(tmpDir, client, nil))
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, client, nil))
 	plug, _ := plugMgr.FindPluginByName(rbdPluginName)
 
 	// readOnly bool is supplied by persistent-claim volume source when its mounter creates other volumes
@@ -240,3 +249,68 @@ func TestPersistentClaimReadOnlyFlag(t *testing.T) {
 		t.Errorf("Expected true for mounter.IsReadOnly")
 	}
 }
+
+func TestBlockMapper(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("rbd_test")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	plugMgr := volume.VolumePluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), nil /* prober */, volumetest.NewFakeVolumeHost(tmpDir, nil, nil))
+
+	blockMode := v1.PersistentVolumeBlock
+	spec := volume.NewSpecFromPersistentVolume(&v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "vol1"},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				RBD: &v1.RBDVolumeSource{
+					CephMonitors: []string{"a", "b"},
+					RBDPool:      "pool1",
+					RBDImage:     "image1",
+				},
+			},
+			VolumeMode: &blockMode,
+		},
+	}, false)
+
+	plug, err := plugMgr.FindMapperPluginBySpec(spec)
+	if err != nil || plug == nil {
+		t.Fatalf("Can't find the block plugin: %v", err)
+	}
+	fakeManager := NewFakeDiskManager()
+	defer fakeManager.Cleanup()
+	mapper, err := plug.(*rbdPlugin).newBlockVolumeMapperInternal(spec, types.UID("poduid"), fakeManager, &mount.FakeMounter{}, "secrets")
+	if err != nil {
+		t.Fatalf("Failed to make a new Mapper: %v", err)
+	}
+
+	globalMapPath, err := mapper.GetGlobalMapPath(spec)
+	if err != nil {
+		t.Fatalf("GetGlobalMapPath failed: %v", err)
+	}
+	if expected := path.Join(tmpDir, "plugins/kubernetes.io/rbd/volumeDevices/pool1-image-image1"); globalMapPath != expected {
+		t.Errorf("Unexpected global map path, expected %q, got: %q", expected, globalMapPath)
+	}
+	podDeviceMapPath, volName := mapper.GetPodDeviceMapPath()
+	if expected := path.Join(tmpDir, "pods/poduid/volumeDevices/kubernetes.io~rbd"); podDeviceMapPath != expected || volName != "vol1" {
+		t.Errorf("Unexpected pod device map path, expected %q, %q, got: %q, %q", expected, "vol1", podDeviceMapPath, volName)
+	}
+
+	devicePath, err := mapper.SetUpDevice()
+	if err != nil {
+		t.Fatalf("SetUpDevice failed: %v", err)
+	}
+	if devicePath != "/dev/rbd0" {
+		t.Errorf("Expected the image to be mapped as /dev/rbd0, got %q", devicePath)
+	}
+
+	unmapper, err := plug.(*rbdPlugin).newBlockVolumeUnmapperInternal("vol1", types.UID("poduid"), fakeManager, &mount.FakeMounter{})
+	if err != nil {
+		t.Fatalf("Failed to make a new Unmapper: %v", err)
+	}
+	if err := unmapper.TearDownDevice(globalMapPath, devicePath); err != nil {
+		t.Errorf("TearDownDevice failed: %v", err)
+	}
+}
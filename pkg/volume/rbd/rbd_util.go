@@ -243,7 +243,6 @@ func (util *RBDUtil) defencing(c rbdUnmounter) error {
 
 func (util *RBDUtil) AttachDisk(b rbdMounter) error {
 	var err error
-	var output []byte
 
 	// create mount point
 	globalPDPath := b.manager.MakeGlobalPDName(*b.rbd)
@@ -259,24 +258,43 @@ func (util *RBDUtil) AttachDisk(b rbdMounter) error {
 		return fmt.Errorf("rbd: failed to mkdir %s, error", globalPDPath)
 	}
 
+	devicePath, err := util.mapImage(b, globalPDPath)
+	if err != nil {
+		return err
+	}
+
+	// mount it
+	if err = b.mounter.FormatAndMount(devicePath, globalPDPath, b.fsType, nil); err != nil {
+		err = fmt.Errorf("rbd: failed to mount rbd volume %s [%s] to %s, error %v", devicePath, b.fsType, globalPDPath, err)
+	}
+	return err
+}
+
+// mapImage maps the rbd image to the node if it is not mapped yet and returns
+// the path of its device. The image config is persisted in persistDir so that
+// the lock can be removed when the image is unmapped.
+func (util *RBDUtil) mapImage(b rbdMounter, persistDir string) (string, error) {
+	var err error
+	var output []byte
+
 	devicePath, found := waitForPath(b.Pool, b.Image, 1)
 	if !found {
 		// modprobe
 		_, err = b.plugin.execCommand("modprobe", []string{"rbd"})
 		if err != nil {
-			return fmt.Errorf("rbd: failed to modprobe rbd error:%v", err)
+			return "", fmt.Errorf("rbd: failed to modprobe rbd error:%v", err)
 		}
 
 		// fence off other mappers
 		if err = util.fencing(b); err != nil {
-			return fmt.Errorf("rbd: image %s is locked by other nodes", b.Image)
+			return "", fmt.Errorf("rbd: image %s is locked by other nodes", b.Image)
 		}
 		// rbd lock remove needs ceph and image config
 		// but kubelet doesn't get them from apiserver during teardown
 		// so persit rbd config so upon disk detach, rbd lock can be removed
 		// since rbd json is persisted in the same local directory that is used as rbd mountpoint later,
 		// the json file remains invisible during rbd mount and thus won't be removed accidentally.
-		util.persistRBD(b, globalPDPath)
+		util.persistRBD(b, persistDir)
 
 		// rbd map
 		l := len(b.Mon)
@@ -299,19 +317,14 @@ func (util *RBDUtil) AttachDisk(b rbdMounter) error {
 			glog.V(1).Infof("rbd: map error %v %s", err, string(output))
 		}
 		if err != nil {
-			return fmt.Errorf("rbd: map failed %v %s", err, string(output))
+			return "", fmt.Errorf("rbd: map failed %v %s", err, string(output))
 		}
 		devicePath, found = waitForPath(b.Pool, b.Image, 10)
 		if !found {
-			return errors.New("Could not map image: Timeout after 10s")
+			return "", errors.New("Could not map image: Timeout after 10s")
 		}
 	}
-
-	// mount it
-	if err = b.mounter.FormatAndMount(devicePath, globalPDPath, b.fsType, nil); err != nil {
-		err = fmt.Errorf("rbd: failed to mount rbd volume %s [%s] to %s, error %v", devicePath, b.fsType, globalPDPath, err)
-	}
-	return err
+	return devicePath, nil
 }
 
 func (util *RBDUtil) DetachDisk(c rbdUnmounter, mntPath string) error {
@@ -341,6 +354,38 @@ func (util *RBDUtil) DetachDisk(c rbdUnmounter, mntPath string) error {
 	return nil
 }
 
+// AttachBlockDisk maps the rbd image of a raw block volume to the node and
+// returns the path of its device. The device is not formatted nor mounted.
+func (util *RBDUtil) AttachBlockDisk(b rbdDiskMapper) (string, error) {
+	globalMapPath, err := b.GetGlobalMapPath(b.spec)
+	if err != nil {
+		return "", err
+	}
+	if err = os.MkdirAll(globalMapPath, 0750); err != nil {
+		return "", fmt.Errorf("rbd: failed to mkdir %s, error", globalMapPath)
+	}
+	return util.mapImage(*b.rbdMounter, globalMapPath)
+}
+
+// DetachBlockDisk unmaps the rbd image of a raw block volume from the node
+// and removes its lock. The image config is loaded from the global map path.
+func (util *RBDUtil) DetachBlockDisk(c rbdDiskUnmapper, mapPath string) error {
+	if err := util.loadRBD(c.rbdMounter, mapPath); err != nil {
+		return err
+	}
+	device, found := getDevFromImageAndPool(c.Pool, c.Image)
+	if found {
+		// rbd unmap
+		if _, err := c.plugin.execCommand("rbd", []string{"unmap", device}); err != nil {
+			return fmt.Errorf("rbd: failed to unmap device %s:Error: %v", device, err)
+		}
+		glog.Infof("rbd: successfully unmap device %s", device)
+	}
+	// remove rbd lock
+	util.defencing(rbdUnmounter{c.rbdMounter})
+	return os.Remove(path.Join(mapPath, "rbd.json"))
+}
+
 func (util *RBDUtil) CreateImage(p *rbdVolumeProvisioner) (r *v1.RBDVolumeSource, size int, err error) {
 	var output []byte
 	capacity := p.options.PVC.Spec.Resources.Requests[v1.ResourceName(v1.ResourceStorage)]
@@ -55,7 +55,7 @@ func NewFakeVolumeHost(rootDir string, kubeClient clientset.Interface, plugins [
 	host := &fakeVolumeHost{rootDir: rootDir, kubeClient: kubeClient, cloud: nil}
 	host.mounter = &mount.FakeMounter{}
 	host.writer = &io.StdWriter{}
-	host.pluginMgr.InitPlugins(plugins, host)
+	host.pluginMgr.InitPlugins(plugins, nil /* prober */, host)
 	return host
 }
 
@@ -67,6 +67,10 @@ func (f *fakeVolumeHost) GetPodVolumeDir(podUID types.UID, pluginName, volumeNam
 	return path.Join(f.rootDir, "pods", string(podUID), "volumes", pluginName, volumeName)
 }
 
+func (f *fakeVolumeHost) GetPodVolumeDeviceDir(podUID types.UID, pluginName string) string {
+	return path.Join(f.rootDir, "pods", string(podUID), "volumeDevices", pluginName)
+}
+
 func (f *fakeVolumeHost) GetPodPluginDir(podUID types.UID, pluginName string) string {
 	return path.Join(f.rootDir, "pods", string(podUID), "plugins", pluginName)
 }
@@ -133,6 +137,14 @@ func (f *fakeVolumeHost) GetSecretFunc() func(namespace, name string) (*v1.Secre
 	}
 }
 
+func (f *fakeVolumeHost) GetNodeName() types.NodeName {
+	return types.NodeName(f.GetHostName())
+}
+
+func (f *fakeVolumeHost) GetNodeLabels() (map[string]string, error) {
+	return map[string]string{"test-label": "test-value"}, nil
+}
+
 func ProbeVolumePlugins(config VolumeConfig) []VolumePlugin {
 	if _, ok := config.OtherAttributes["fake-property"]; ok {
 		return []VolumePlugin{
@@ -158,6 +170,9 @@ type FakeVolumePlugin struct {
 	LastProvisionerOptions VolumeOptions
 	NewAttacherCallCount   int
 	NewDetacherCallCount   int
+	// Handles of the snapshots taken and deleted through the plugin.
+	CreatedSnapshots []string
+	DeletedSnapshots []string
 
 	Mounters   []*FakeVolume
 	Unmounters []*FakeVolume
@@ -170,6 +185,8 @@ var _ RecyclableVolumePlugin = &FakeVolumePlugin{}
 var _ DeletableVolumePlugin = &FakeVolumePlugin{}
 var _ ProvisionableVolumePlugin = &FakeVolumePlugin{}
 var _ AttachableVolumePlugin = &FakeVolumePlugin{}
+var _ ExpandableVolumePlugin = &FakeVolumePlugin{}
+var _ SnapshottableVolumePlugin = &FakeVolumePlugin{}
 
 func (plugin *FakeVolumePlugin) getFakeVolume(list *[]*FakeVolume) *FakeVolume {
 	volume := &FakeVolume{}
@@ -294,6 +311,31 @@ func (plugin *FakeVolumePlugin) NewProvisioner(options VolumeOptions) (Provision
 	return &FakeProvisioner{options, plugin.Host}, nil
 }
 
+// ExpandVolumeDevice expands the volume to exactly the requested size.
+func (plugin *FakeVolumePlugin) ExpandVolumeDevice(spec *Spec, newSize resource.Quantity, oldSize resource.Quantity) (resource.Quantity, error) {
+	return newSize, nil
+}
+
+func (plugin *FakeVolumePlugin) RequiresFSResize() bool {
+	return false
+}
+
+// CreateSnapshot records the snapshot and returns a handle derived from its name.
+func (plugin *FakeVolumePlugin) CreateSnapshot(spec *Spec, snapshotName string) (string, error) {
+	plugin.Lock()
+	defer plugin.Unlock()
+	handle := "fake-" + snapshotName
+	plugin.CreatedSnapshots = append(plugin.CreatedSnapshots, handle)
+	return handle, nil
+}
+
+func (plugin *FakeVolumePlugin) DeleteSnapshot(handle string) error {
+	plugin.Lock()
+	defer plugin.Unlock()
+	plugin.DeletedSnapshots = append(plugin.DeletedSnapshots, handle)
+	return nil
+}
+
 func (plugin *FakeVolumePlugin) GetAccessModes() []v1.PersistentVolumeAccessMode {
 	return []v1.PersistentVolumeAccessMode{}
 }
@@ -725,7 +767,7 @@ func GetTestVolumePluginMgr(
 		nil, /* plugins */
 	)
 	plugins := ProbeVolumePlugins(VolumeConfig{})
-	if err := v.pluginMgr.InitPlugins(plugins, v); err != nil {
+	if err := v.pluginMgr.InitPlugins(plugins, nil /* prober */, v); err != nil {
 		t.Fatal(err)
 	}
 
@@ -750,3 +792,59 @@ func CreateTestPVC(capacity string, accessModes []v1.PersistentVolumeAccessMode)
 	}
 	return &claim
 }
+
+// VerifyBlockMapperPaths checks the paths used by the block volume mapper
+// and unmapper of a plugin whose devices are set up by its attacher. spec must
+// be a persistent volume named "pv1" in block mode, globalMapDir is the
+// directory its device is expected to be mapped to, relative to the plugin
+// directory.
+func VerifyBlockMapperPaths(t *testing.T, plugins []VolumePlugin, pluginName string, spec *Spec, globalMapDir string) {
+	tmpDir, err := utiltesting.MkTmpdir("blockMapperTest")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	plugMgr := VolumePluginMgr{}
+	plugMgr.InitPlugins(plugins, nil /* prober */, NewFakeVolumeHost(tmpDir, nil, nil))
+
+	plug, err := plugMgr.FindMapperPluginBySpec(spec)
+	if err != nil || plug == nil {
+		t.Fatalf("Can't find the block plugin: %v", err)
+	}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: types.UID("poduid")}}
+	mapper, err := plug.NewBlockVolumeMapper(spec, pod, VolumeOptions{})
+	if err != nil {
+		t.Fatalf("Failed to make a new Mapper: %v", err)
+	}
+
+	globalMapPath, err := mapper.GetGlobalMapPath(spec)
+	if err != nil {
+		t.Fatalf("GetGlobalMapPath failed: %v", err)
+	}
+	if expected := path.Join(tmpDir, "plugins", pluginName, "volumeDevices", globalMapDir); globalMapPath != expected {
+		t.Errorf("Unexpected global map path, expected %q, got: %q", expected, globalMapPath)
+	}
+	podDeviceMapPath, volName := mapper.GetPodDeviceMapPath()
+	if expected := path.Join(tmpDir, "pods/poduid/volumeDevices", utilstrings.EscapeQualifiedNameForDisk(pluginName)); podDeviceMapPath != expected {
+		t.Errorf("Unexpected pod device map path, expected %q, got: %q", expected, podDeviceMapPath)
+	}
+	if volName != "pv1" {
+		t.Errorf("Unexpected volume name, expected %q, got: %q", "pv1", volName)
+	}
+
+	devicePath, err := mapper.SetUpDevice()
+	if err != nil || devicePath != "" {
+		t.Errorf("Expected SetUpDevice to leave the device to the attacher, got %q, %v", devicePath, err)
+	}
+
+	unmapper, err := plug.NewBlockVolumeUnmapper("pv1", pod.UID)
+	if err != nil {
+		t.Fatalf("Failed to make a new Unmapper: %v", err)
+	}
+	if unmapperPath, _ := unmapper.GetPodDeviceMapPath(); unmapperPath != podDeviceMapPath {
+		t.Errorf("Expected unmapper pod device map path %q, got: %q", podDeviceMapPath, unmapperPath)
+	}
+	if err := unmapper.TearDownDevice(globalMapPath, "/dev/sdb"); err != nil {
+		t.Errorf("TearDownDevice failed: %v", err)
+	}
+}
@@ -53,6 +53,27 @@ func FsInfo(path string) (int64, int64, int64, int64, int64, int64, error) {
 	return available, capacity, usage, inodes, inodesFree, inodesUsed, nil
 }
 
+// FsReadOnly returns true if the filesystem that path resides upon is
+// mounted read-only.
+func FsReadOnly(path string) (bool, error) {
+	statfs := &syscall.Statfs_t{}
+	if err := syscall.Statfs(path, statfs); err != nil {
+		return false, err
+	}
+	// ST_RDONLY on linux and MNT_RDONLY on darwin share the same value.
+	return uint64(statfs.Flags)&0x1 != 0, nil
+}
+
+// IsDeviceMissingError returns true if err, as returned by a filesystem call
+// on a mounted volume, indicates that the device backing the volume is gone.
+func IsDeviceMissingError(err error) bool {
+	switch err {
+	case syscall.EIO, syscall.ENODEV, syscall.ENXIO, syscall.ENOTCONN, syscall.ESTALE:
+		return true
+	}
+	return false
+}
+
 func Du(path string) (*resource.Quantity, error) {
 	// Uses the same niceness level as cadvisor.fs does when running du
 	// Uses -B 1 to always scale to a blocksize of 1 byte
@@ -29,6 +29,14 @@ func FsInfo(path string) (int64, int64, int64, int64, int64, int64, error) {
 	return 0, 0, 0, 0, 0, 0, fmt.Errorf("FsInfo not supported for this build.")
 }
 
+func FsReadOnly(path string) (bool, error) {
+	return false, fmt.Errorf("FsReadOnly not supported for this build.")
+}
+
+func IsDeviceMissingError(err error) bool {
+	return false
+}
+
 func Du(path string) (*resource.Quantity, error) {
 	return nil, fmt.Errorf("Du not supported for this build.")
 }
@@ -24,12 +24,18 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubernetes/pkg/features"
 	kevents "k8s.io/kubernetes/pkg/kubelet/events"
+	"k8s.io/kubernetes/pkg/util/exec"
 	"k8s.io/kubernetes/pkg/util/mount"
+	"k8s.io/kubernetes/pkg/util/resizefs"
 	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/kubernetes/pkg/volume/util/volumepathhandler"
 )
 
 var _ OperationGenerator = &operationGenerator{}
@@ -50,19 +56,25 @@ type operationGenerator struct {
 	// which verifies that the components (binaries, etc.) required to mount
 	// the volume are available on the underlying node before attempting mount.
 	checkNodeCapabilitiesBeforeMount bool
+
+	// blkUtil provides the symbolic link operations used to map raw block
+	// volumes
+	blkUtil volumepathhandler.BlockVolumePathHandler
 }
 
 // NewOperationGenerator is returns instance of operationGenerator
 func NewOperationGenerator(kubeClient clientset.Interface,
 	volumePluginMgr *volume.VolumePluginMgr,
 	recorder record.EventRecorder,
-	checkNodeCapabilitiesBeforeMount bool) OperationGenerator {
+	checkNodeCapabilitiesBeforeMount bool,
+	blkUtil volumepathhandler.BlockVolumePathHandler) OperationGenerator {
 
 	return &operationGenerator{
-		kubeClient:      kubeClient,
-		volumePluginMgr: volumePluginMgr,
-		recorder:        recorder,
+		kubeClient:                       kubeClient,
+		volumePluginMgr:                  volumePluginMgr,
+		recorder:                         recorder,
 		checkNodeCapabilitiesBeforeMount: checkNodeCapabilitiesBeforeMount,
+		blkUtil:                          blkUtil,
 	}
 }
 
@@ -88,6 +100,18 @@ type OperationGenerator interface {
 
 	// Generates the function needed to check if the attach_detach controller has attached the volume plugin
 	GenerateVerifyControllerAttachedVolumeFunc(volumeToMount VolumeToMount, nodeName types.NodeName, actualStateOfWorld ActualStateOfWorldAttacherUpdater) (func() error, error)
+
+	// Generates the MapVolume function needed to map a raw block volume into a pod
+	GenerateMapVolumeFunc(waitForAttachTimeout time.Duration, volumeToMount VolumeToMount, actualStateOfWorldMounterUpdater ActualStateOfWorldMounterUpdater) (func() error, error)
+
+	// Generates the UnmapVolume function needed to unmap a raw block volume from a pod
+	GenerateUnmapVolumeFunc(volumeToUnmount MountedVolume, actualStateOfWorld ActualStateOfWorldMounterUpdater) (func() error, error)
+
+	// Generates the UnmapDevice function needed to tear down the device of a raw block volume
+	GenerateUnmapDeviceFunc(deviceToDetach AttachedVolume, actualStateOfWorld ActualStateOfWorldMounterUpdater, mounter mount.Interface) (func() error, error)
+
+	// Generates the function needed to grow the file system of a volume which is mounted to a pod
+	GenerateExpandVolumeFSWithoutUnmountingFunc(volumeToMount VolumeToMount, actualStateOfWorld ActualStateOfWorldMounterUpdater) (func() error, error)
 }
 
 func (og *operationGenerator) GenerateVolumesAreAttachedFunc(
@@ -389,6 +413,10 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 		return nil, mountCheckError
 	}
 
+	if affinityErr := checkNodeAffinity(og, volumeToMount); affinityErr != nil {
+		return nil, affinityErr
+	}
+
 	// Get attacher, if possible
 	attachableVolumePlugin, _ :=
 		og.volumePluginMgr.FindAttachablePluginBySpec(volumeToMount.VolumeSpec)
@@ -473,6 +501,12 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 				volumeToMount.Pod.UID,
 				deviceMountPath)
 
+			if utilfeature.DefaultFeatureGate.Enabled(features.ExpandPersistentVolumes) {
+				if resizeErr := og.resizeFileSystem(volumeToMount, devicePath, deviceMountPath); resizeErr != nil {
+					return resizeErr
+				}
+			}
+
 			// Update actual state of world to reflect volume is globally mounted
 			markDeviceMountedErr := actualStateOfWorld.MarkDeviceAsMounted(
 				volumeToMount.VolumeName)
@@ -525,6 +559,7 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 			volumeToMount.Pod.UID,
 			volumeToMount.VolumeName,
 			volumeMounter,
+			nil, /* blockVolumeMapper */
 			volumeToMount.OuterVolumeSpecName,
 			volumeToMount.VolumeGidValue)
 		if markVolMountedErr != nil {
@@ -729,6 +764,399 @@ func (og *operationGenerator) GenerateUnmountDeviceFunc(
 	}, nil
 }
 
+func (og *operationGenerator) GenerateMapVolumeFunc(
+	waitForAttachTimeout time.Duration,
+	volumeToMount VolumeToMount,
+	actualStateOfWorld ActualStateOfWorldMounterUpdater) (func() error, error) {
+	// Get block volume mapper plugin
+	blockVolumePlugin, err :=
+		og.volumePluginMgr.FindMapperPluginBySpec(volumeToMount.VolumeSpec)
+	if err != nil || blockVolumePlugin == nil {
+		return nil, fmt.Errorf(
+			"MapVolume.FindMapperPluginBySpec failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			err)
+	}
+
+	blockVolumeMapper, newMapperErr := blockVolumePlugin.NewBlockVolumeMapper(
+		volumeToMount.VolumeSpec,
+		volumeToMount.Pod,
+		volume.VolumeOptions{})
+	if newMapperErr != nil {
+		return nil, fmt.Errorf(
+			"MapVolume.NewBlockVolumeMapper failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			newMapperErr)
+	}
+
+	if affinityErr := checkNodeAffinity(og, volumeToMount); affinityErr != nil {
+		return nil, affinityErr
+	}
+
+	// Get attacher, if possible
+	attachableVolumePlugin, _ :=
+		og.volumePluginMgr.FindAttachablePluginBySpec(volumeToMount.VolumeSpec)
+	var volumeAttacher volume.Attacher
+	if attachableVolumePlugin != nil {
+		volumeAttacher, _ = attachableVolumePlugin.NewAttacher()
+	}
+
+	return func() error {
+		devicePath := volumeToMount.DevicePath
+		if volumeAttacher != nil {
+			// Wait for attachable volumes to finish attaching
+			glog.Infof(
+				"Entering MapVolume.WaitForAttach for volume %q (spec.Name: %q) pod %q (UID: %q) DevicePath: %q",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				volumeToMount.DevicePath)
+
+			devicePath, err = volumeAttacher.WaitForAttach(
+				volumeToMount.VolumeSpec, volumeToMount.DevicePath, waitForAttachTimeout)
+			if err != nil {
+				// On failure, return error. Caller will log and retry.
+				return fmt.Errorf(
+					"MapVolume.WaitForAttach failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+					volumeToMount.VolumeName,
+					volumeToMount.VolumeSpec.Name(),
+					volumeToMount.PodName,
+					volumeToMount.Pod.UID,
+					err)
+			}
+
+			glog.Infof(
+				"MapVolume.WaitForAttach succeeded for volume %q (spec.Name: %q) pod %q (UID: %q).",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID)
+		}
+
+		// Set up the device. Plugins that attach the device themselves
+		// return its path, attachable plugins return an empty path.
+		pluginDevicePath, mapErr := blockVolumeMapper.SetUpDevice()
+		if mapErr != nil {
+			// On failure, return error. Caller will log and retry.
+			err := fmt.Errorf(
+				"MapVolume.SetUpDevice failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				mapErr)
+			og.recorder.Event(volumeToMount.Pod, v1.EventTypeWarning, kevents.FailedMapVolume, err.Error())
+			return err
+		}
+		if pluginDevicePath != "" {
+			devicePath = pluginDevicePath
+		}
+		if devicePath == "" {
+			return fmt.Errorf(
+				"MapVolume failed for volume %q (spec.Name: %q) pod %q (UID: %q): the device path of the volume is empty",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID)
+		}
+
+		// Map the device to the global map path, keyed by pod UID, and to
+		// the pod device map path, keyed by volume name
+		globalMapPath, err := blockVolumeMapper.GetGlobalMapPath(volumeToMount.VolumeSpec)
+		if err != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"MapVolume.GetGlobalMapPath failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				err)
+		}
+		if mapErr := og.blkUtil.MapDevice(devicePath, globalMapPath, string(volumeToMount.Pod.UID)); mapErr != nil {
+			// On failure, return error. Caller will log and retry.
+			err := fmt.Errorf(
+				"MapVolume.MapDevice failed for volume %q (spec.Name: %q) pod %q (UID: %q) global map path %q with: %v",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				globalMapPath,
+				mapErr)
+			og.recorder.Event(volumeToMount.Pod, v1.EventTypeWarning, kevents.FailedMapVolume, err.Error())
+			return err
+		}
+
+		// Update actual state of world to reflect the device is set up, so it
+		// gets torn down once no pod maps it anymore
+		markDeviceMappedErr := actualStateOfWorld.MarkDeviceAsMounted(
+			volumeToMount.VolumeName)
+		if markDeviceMappedErr != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"MapVolume.MarkDeviceAsMounted failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				markDeviceMappedErr)
+		}
+
+		podDeviceMapPath, volumeMapName := blockVolumeMapper.GetPodDeviceMapPath()
+		if mapErr := og.blkUtil.MapDevice(devicePath, podDeviceMapPath, volumeMapName); mapErr != nil {
+			// On failure, return error. Caller will log and retry.
+			err := fmt.Errorf(
+				"MapVolume.MapDevice failed for volume %q (spec.Name: %q) pod %q (UID: %q) pod device map path %q with: %v",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				podDeviceMapPath,
+				mapErr)
+			og.recorder.Event(volumeToMount.Pod, v1.EventTypeWarning, kevents.FailedMapVolume, err.Error())
+			return err
+		}
+
+		glog.Infof(
+			"MapVolume succeeded for volume %q (spec.Name: %q) pod %q (UID: %q) device %q.",
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			devicePath)
+
+		// Update actual state of world
+		markVolMountedErr := actualStateOfWorld.MarkVolumeAsMounted(
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			volumeToMount.VolumeName,
+			nil, /* mounter */
+			blockVolumeMapper,
+			volumeToMount.OuterVolumeSpecName,
+			volumeToMount.VolumeGidValue)
+		if markVolMountedErr != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"MapVolume.MarkVolumeAsMounted failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				markVolMountedErr)
+		}
+
+		return nil
+	}, nil
+}
+
+func (og *operationGenerator) GenerateUnmapVolumeFunc(
+	volumeToUnmount MountedVolume,
+	actualStateOfWorld ActualStateOfWorldMounterUpdater) (func() error, error) {
+	// Get block volume unmapper plugin
+	blockVolumePlugin, err :=
+		og.volumePluginMgr.FindMapperPluginByName(volumeToUnmount.PluginName)
+	if err != nil || blockVolumePlugin == nil {
+		return nil, fmt.Errorf(
+			"UnmapVolume.FindMapperPluginByName failed for volume %q (volume.spec.Name: %q) pod %q (UID: %q) err=%v",
+			volumeToUnmount.VolumeName,
+			volumeToUnmount.OuterVolumeSpecName,
+			volumeToUnmount.PodName,
+			volumeToUnmount.PodUID,
+			err)
+	}
+
+	blockVolumeUnmapper, newUnmapperErr := blockVolumePlugin.NewBlockVolumeUnmapper(
+		volumeToUnmount.InnerVolumeSpecName, volumeToUnmount.PodUID)
+	if newUnmapperErr != nil {
+		return nil, fmt.Errorf(
+			"UnmapVolume.NewBlockVolumeUnmapper failed for volume %q (volume.spec.Name: %q) pod %q (UID: %q) err=%v",
+			volumeToUnmount.VolumeName,
+			volumeToUnmount.OuterVolumeSpecName,
+			volumeToUnmount.PodName,
+			volumeToUnmount.PodUID,
+			newUnmapperErr)
+	}
+
+	return func() error {
+		// Remove the link from the pod device map path
+		podDeviceMapPath, volumeMapName := blockVolumeUnmapper.GetPodDeviceMapPath()
+		if unmapErr := og.blkUtil.UnmapDevice(podDeviceMapPath, volumeMapName); unmapErr != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"UnmapVolume.UnmapDevice failed for volume %q (volume.spec.Name: %q) pod %q (UID: %q) pod device map path %q with: %v",
+				volumeToUnmount.VolumeName,
+				volumeToUnmount.OuterVolumeSpecName,
+				volumeToUnmount.PodName,
+				volumeToUnmount.PodUID,
+				podDeviceMapPath,
+				unmapErr)
+		}
+
+		// Remove the pod's link from the global map path
+		globalMapPath, err := blockVolumeUnmapper.GetGlobalMapPath(volumeToUnmount.VolumeSpec)
+		if err != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"UnmapVolume.GetGlobalMapPath failed for volume %q (volume.spec.Name: %q) pod %q (UID: %q) with: %v",
+				volumeToUnmount.VolumeName,
+				volumeToUnmount.OuterVolumeSpecName,
+				volumeToUnmount.PodName,
+				volumeToUnmount.PodUID,
+				err)
+		}
+		if unmapErr := og.blkUtil.UnmapDevice(globalMapPath, string(volumeToUnmount.PodUID)); unmapErr != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"UnmapVolume.UnmapDevice failed for volume %q (volume.spec.Name: %q) pod %q (UID: %q) global map path %q with: %v",
+				volumeToUnmount.VolumeName,
+				volumeToUnmount.OuterVolumeSpecName,
+				volumeToUnmount.PodName,
+				volumeToUnmount.PodUID,
+				globalMapPath,
+				unmapErr)
+		}
+
+		glog.Infof(
+			"UnmapVolume succeeded for volume %q (OuterVolumeSpecName: %q) pod %q (UID: %q). InnerVolumeSpecName %q. PluginName %q, VolumeGidValue %q",
+			volumeToUnmount.VolumeName,
+			volumeToUnmount.OuterVolumeSpecName,
+			volumeToUnmount.PodName,
+			volumeToUnmount.PodUID,
+			volumeToUnmount.InnerVolumeSpecName,
+			volumeToUnmount.PluginName,
+			volumeToUnmount.VolumeGidValue)
+
+		// Update actual state of world
+		markVolUnmountedErr := actualStateOfWorld.MarkVolumeAsUnmounted(
+			volumeToUnmount.PodName, volumeToUnmount.VolumeName)
+		if markVolUnmountedErr != nil {
+			// On failure, just log and exit
+			glog.Errorf(
+				"UnmapVolume.MarkVolumeAsUnmounted failed for volume %q (volume.spec.Name: %q) pod %q (UID: %q) with: %v",
+				volumeToUnmount.VolumeName,
+				volumeToUnmount.OuterVolumeSpecName,
+				volumeToUnmount.PodName,
+				volumeToUnmount.PodUID,
+				markVolUnmountedErr)
+		}
+
+		return nil
+	}, nil
+}
+
+func (og *operationGenerator) GenerateUnmapDeviceFunc(
+	deviceToDetach AttachedVolume,
+	actualStateOfWorld ActualStateOfWorldMounterUpdater,
+	mounter mount.Interface) (func() error, error) {
+	// Get block volume unmapper plugin
+	blockVolumePlugin, err :=
+		og.volumePluginMgr.FindMapperPluginBySpec(deviceToDetach.VolumeSpec)
+	if err != nil || blockVolumePlugin == nil {
+		return nil, fmt.Errorf(
+			"UnmapDevice.FindMapperPluginBySpec failed for volume %q (spec.Name: %q) with: %v",
+			deviceToDetach.VolumeName,
+			deviceToDetach.VolumeSpec.Name(),
+			err)
+	}
+
+	blockVolumeUnmapper, newUnmapperErr := blockVolumePlugin.NewBlockVolumeUnmapper(
+		deviceToDetach.VolumeSpec.Name(), "" /* podUID */)
+	if newUnmapperErr != nil {
+		return nil, fmt.Errorf(
+			"UnmapDevice.NewBlockVolumeUnmapper failed for volume %q (spec.Name: %q) with: %v",
+			deviceToDetach.VolumeName,
+			deviceToDetach.VolumeSpec.Name(),
+			newUnmapperErr)
+	}
+
+	return func() error {
+		globalMapPath, err := blockVolumeUnmapper.GetGlobalMapPath(deviceToDetach.VolumeSpec)
+		if err != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"UnmapDevice.GetGlobalMapPath failed for volume %q (spec.Name: %q) with: %v",
+				deviceToDetach.VolumeName,
+				deviceToDetach.VolumeSpec.Name(),
+				err)
+		}
+		refs, err := og.blkUtil.GetDeviceSymlinkRefs("" /* devicePath */, globalMapPath)
+		if err != nil || len(refs) > 0 {
+			if err == nil {
+				err = fmt.Errorf("The device %q is still mapped by other pods %v", globalMapPath, refs)
+			}
+			return fmt.Errorf(
+				"UnmapDevice.GetDeviceSymlinkRefs check failed for volume %q (spec.Name: %q) with: %v",
+				deviceToDetach.VolumeName,
+				deviceToDetach.VolumeSpec.Name(),
+				err)
+		}
+
+		// Execute tear down
+		tearDownErr := blockVolumeUnmapper.TearDownDevice(globalMapPath, deviceToDetach.DevicePath)
+		if tearDownErr != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"UnmapDevice.TearDownDevice failed for volume %q (spec.Name: %q) with: %v",
+				deviceToDetach.VolumeName,
+				deviceToDetach.VolumeSpec.Name(),
+				tearDownErr)
+		}
+		if removeErr := og.blkUtil.RemoveMapPath(globalMapPath); removeErr != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"UnmapDevice.RemoveMapPath failed for volume %q (spec.Name: %q) with: %v",
+				deviceToDetach.VolumeName,
+				deviceToDetach.VolumeSpec.Name(),
+				removeErr)
+		}
+
+		// The device must not be in use elsewhere before it is detached.
+		// Caller will log and retry.
+		if deviceToDetach.DevicePath != "" {
+			deviceOpened, deviceOpenedErr := mounter.DeviceOpened(deviceToDetach.DevicePath)
+			if deviceOpenedErr != nil {
+				return fmt.Errorf(
+					"UnmapDevice.DeviceOpened failed for volume %q (spec.Name: %q) with: %v",
+					deviceToDetach.VolumeName,
+					deviceToDetach.VolumeSpec.Name(),
+					deviceOpenedErr)
+			}
+			if deviceOpened {
+				return fmt.Errorf(
+					"UnmapDevice failed for volume %q (spec.Name: %q) because the device is in use when it was no longer expected to be in use",
+					deviceToDetach.VolumeName,
+					deviceToDetach.VolumeSpec.Name())
+			}
+		}
+
+		glog.Infof(
+			"UnmapDevice succeeded for volume %q (spec.Name: %q).",
+			deviceToDetach.VolumeName,
+			deviceToDetach.VolumeSpec.Name())
+
+		// Update actual state of world
+		markDeviceUnmappedErr := actualStateOfWorld.MarkDeviceAsUnmounted(
+			deviceToDetach.VolumeName)
+		if markDeviceUnmappedErr != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"MarkDeviceAsUnmounted failed for device %q (spec.Name: %q) with: %v",
+				deviceToDetach.VolumeName,
+				deviceToDetach.VolumeSpec.Name(),
+				markDeviceUnmappedErr)
+		}
+
+		return nil
+	}, nil
+}
+
 func (og *operationGenerator) GenerateVerifyControllerAttachedVolumeFunc(
 	volumeToMount VolumeToMount,
 	nodeName types.NodeName,
@@ -890,3 +1318,164 @@ func checkMountOptionSupport(og *operationGenerator, volumeToMount VolumeToMount
 	}
 	return nil
 }
+
+// checkNodeAffinity looks at the PV node affinity, and checks if the node has the same corresponding labels
+// This ensures that we don't mount a volume that doesn't belong to this node
+func checkNodeAffinity(og *operationGenerator, volumeToMount VolumeToMount) error {
+	pv := volumeToMount.VolumeSpec.PersistentVolume
+	if pv == nil || pv.Spec.NodeAffinity == nil {
+		return nil
+	}
+
+	nodeLabels, err := og.volumePluginMgr.Host.GetNodeLabels()
+	if err == nil {
+		err = util.CheckNodeAffinity(pv, nodeLabels)
+	}
+	if err != nil {
+		return fmt.Errorf(
+			"MountVolume.checkNodeAffinity failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			err)
+	}
+	return nil
+}
+
+func (og *operationGenerator) GenerateExpandVolumeFSWithoutUnmountingFunc(
+	volumeToMount VolumeToMount,
+	actualStateOfWorld ActualStateOfWorldMounterUpdater) (func() error, error) {
+	// Only the file systems on the devices of attachable volumes are resized
+	attachableVolumePlugin, err :=
+		og.volumePluginMgr.FindAttachablePluginBySpec(volumeToMount.VolumeSpec)
+	if err != nil || attachableVolumePlugin == nil {
+		return nil, fmt.Errorf(
+			"VolumeFSResize.FindAttachablePluginBySpec failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			err)
+	}
+
+	volumeAttacher, err := attachableVolumePlugin.NewAttacher()
+	if err != nil || volumeAttacher == nil {
+		return nil, fmt.Errorf(
+			"VolumeFSResize.NewAttacher failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			err)
+	}
+
+	return func() error {
+		deviceMountPath, err :=
+			volumeAttacher.GetDeviceMountPath(volumeToMount.VolumeSpec)
+		if err != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"VolumeFSResize.GetDeviceMountPath failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				err)
+		}
+
+		if resizeErr := og.resizeFileSystem(volumeToMount, volumeToMount.DevicePath, deviceMountPath); resizeErr != nil {
+			return resizeErr
+		}
+
+		markResizedErr := actualStateOfWorld.MarkVolumeAsResized(volumeToMount.PodName, volumeToMount.VolumeName)
+		if markResizedErr != nil {
+			// On failure, return error. Caller will log and retry.
+			return fmt.Errorf(
+				"VolumeFSResize.MarkVolumeAsResized failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+				volumeToMount.VolumeName,
+				volumeToMount.VolumeSpec.Name(),
+				volumeToMount.PodName,
+				volumeToMount.Pod.UID,
+				markResizedErr)
+		}
+		return nil
+	}, nil
+}
+
+// resizeFileSystem grows the file system on the device of a volume whose
+// claim is waiting for a file system resize, and records the new capacity
+// on the claim afterwards.
+func (og *operationGenerator) resizeFileSystem(volumeToMount VolumeToMount, devicePath, deviceMountPath string) error {
+	pv := volumeToMount.VolumeSpec.PersistentVolume
+	if pv == nil || pv.Spec.ClaimRef == nil {
+		return nil
+	}
+
+	pvc, err := og.kubeClient.Core().PersistentVolumeClaims(pv.Spec.ClaimRef.Namespace).Get(pv.Spec.ClaimRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf(
+			"MountVolume.resizeFileSystem failed to get claim %s/%s for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			pv.Spec.ClaimRef.Namespace,
+			pv.Spec.ClaimRef.Name,
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			err)
+	}
+	if !util.ClaimHasCondition(pvc, v1.PersistentVolumeClaimFileSystemResizePending) {
+		return nil
+	}
+
+	// The volume spec may predate the expansion of a volume which is already
+	// mounted, read the new capacity from the volume itself.
+	pv, err = og.kubeClient.Core().PersistentVolumes().Get(pv.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf(
+			"MountVolume.resizeFileSystem failed to get volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			err)
+	}
+
+	diskFormatter := &mount.SafeFormatAndMount{
+		Interface: og.volumePluginMgr.Host.GetMounter(),
+		Runner:    exec.New(),
+	}
+	resizer := resizefs.NewResizeFs(diskFormatter)
+	if _, err := resizer.Resize(devicePath, deviceMountPath); err != nil {
+		err := fmt.Errorf(
+			"MountVolume.resizeFileSystem failed for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			err)
+		og.recorder.Event(volumeToMount.Pod, v1.EventTypeWarning, kevents.FileSystemResizeFailed, err.Error())
+		return err
+	}
+
+	if _, err := util.MarkResizeFinished(pvc, pv.Spec.Capacity[v1.ResourceStorage], og.kubeClient); err != nil {
+		return fmt.Errorf(
+			"MountVolume.resizeFileSystem failed to update claim %s/%s for volume %q (spec.Name: %q) pod %q (UID: %q) with: %v",
+			pvc.Namespace,
+			pvc.Name,
+			volumeToMount.VolumeName,
+			volumeToMount.VolumeSpec.Name(),
+			volumeToMount.PodName,
+			volumeToMount.Pod.UID,
+			err)
+	}
+
+	glog.Infof(
+		"MountVolume.resizeFileSystem succeeded for volume %q (spec.Name: %q) pod %q (UID: %q).",
+		volumeToMount.VolumeName,
+		volumeToMount.VolumeSpec.Name(),
+		volumeToMount.PodName,
+		volumeToMount.Pod.UID)
+	og.recorder.Eventf(volumeToMount.Pod, v1.EventTypeNormal, kevents.FileSystemResizeSuccess, "MountVolume.resizeFileSystem succeeded for volume %q", volumeToMount.VolumeSpec.Name())
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+)
+
+// ClaimNeedsResize returns true if the storage requested by a bound claim is
+// larger than the capacity reported in its status.
+func ClaimNeedsResize(pvc *v1.PersistentVolumeClaim) bool {
+	if pvc.Status.Phase != v1.ClaimBound || pvc.Spec.VolumeName == "" {
+		return false
+	}
+	requestSize, ok := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	if !ok {
+		return false
+	}
+	statusSize := pvc.Status.Capacity[v1.ResourceStorage]
+	return requestSize.Cmp(statusSize) > 0
+}
+
+// ClaimHasCondition returns true if the claim has a condition of the given
+// type with status true.
+func ClaimHasCondition(pvc *v1.PersistentVolumeClaim, conditionType v1.PersistentVolumeClaimConditionType) bool {
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkResizeInProgress sets the Resizing condition on the claim.
+func MarkResizeInProgress(pvc *v1.PersistentVolumeClaim, kubeClient clientset.Interface) (*v1.PersistentVolumeClaim, error) {
+	return updateClaimResizeStatus(pvc, nil, []v1.PersistentVolumeClaimConditionType{v1.PersistentVolumeClaimResizing}, kubeClient)
+}
+
+// MarkForFSResize replaces the conditions of the claim with
+// FileSystemResizePending, which tells the kubelet to grow the file system
+// on the volume the next time it is mounted.
+func MarkForFSResize(pvc *v1.PersistentVolumeClaim, kubeClient clientset.Interface) (*v1.PersistentVolumeClaim, error) {
+	return updateClaimResizeStatus(pvc, nil, []v1.PersistentVolumeClaimConditionType{v1.PersistentVolumeClaimFileSystemResizePending}, kubeClient)
+}
+
+// MarkResizeFinished records the new capacity of the claim in its status and
+// removes all resize conditions.
+func MarkResizeFinished(pvc *v1.PersistentVolumeClaim, newSize resource.Quantity, kubeClient clientset.Interface) (*v1.PersistentVolumeClaim, error) {
+	return updateClaimResizeStatus(pvc, &newSize, nil, kubeClient)
+}
+
+func updateClaimResizeStatus(
+	pvc *v1.PersistentVolumeClaim,
+	newSize *resource.Quantity,
+	conditionTypes []v1.PersistentVolumeClaimConditionType,
+	kubeClient clientset.Interface) (*v1.PersistentVolumeClaim, error) {
+	clone, err := api.Scheme.DeepCopy(pvc)
+	if err != nil {
+		return nil, fmt.Errorf("error cloning claim %s/%s: %v", pvc.Namespace, pvc.Name, err)
+	}
+	claimClone, ok := clone.(*v1.PersistentVolumeClaim)
+	if !ok {
+		return nil, fmt.Errorf("unexpected claim cast error: %v", clone)
+	}
+
+	if newSize != nil {
+		if claimClone.Status.Capacity == nil {
+			claimClone.Status.Capacity = v1.ResourceList{}
+		}
+		claimClone.Status.Capacity[v1.ResourceStorage] = *newSize
+	}
+
+	now := metav1.Now()
+	var conditions []v1.PersistentVolumeClaimCondition
+	for _, conditionType := range conditionTypes {
+		condition := v1.PersistentVolumeClaimCondition{
+			Type:               conditionType,
+			Status:             v1.ConditionTrue,
+			LastProbeTime:      now,
+			LastTransitionTime: now,
+		}
+		// keep the transition time of conditions the claim already has
+		for _, oldCondition := range pvc.Status.Conditions {
+			if oldCondition.Type == conditionType && oldCondition.Status == v1.ConditionTrue {
+				condition.LastTransitionTime = oldCondition.LastTransitionTime
+			}
+		}
+		conditions = append(conditions, condition)
+	}
+	claimClone.Status.Conditions = conditions
+
+	return kubeClient.Core().PersistentVolumeClaims(claimClone.Namespace).UpdateStatus(claimClone)
+}
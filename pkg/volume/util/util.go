@@ -23,6 +23,7 @@ import (
 
 	"github.com/golang/glog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/kubernetes/pkg/api/v1"
 	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
 	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
@@ -31,6 +32,10 @@ import (
 
 const readyFileName = "ready"
 
+// VolumeDevicesInGlobalPath is the directory under a plugin directory that
+// holds the global map paths of raw block volumes.
+const VolumeDevicesInGlobalPath = "volumeDevices"
+
 // IsReady checks for the existence of a regular file
 // called 'ready' in the given directory and returns
 // true if that file exists.
@@ -164,3 +169,43 @@ func GetClassForVolume(kubeClient clientset.Interface, pv *v1.PersistentVolume)
 	}
 	return class, nil
 }
+
+// CheckNodeAffinity looks at the PV node affinity, and checks if the node has the same corresponding labels
+// This ensures that we don't mount a volume that doesn't belong to this node
+func CheckNodeAffinity(pv *v1.PersistentVolume, nodeLabels map[string]string) error {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return nil
+	}
+
+	terms := pv.Spec.NodeAffinity.Required.NodeSelectorTerms
+	glog.V(10).Infof("Match for Required node selector terms %+v", terms)
+	for _, term := range terms {
+		selector, err := v1.NodeSelectorRequirementsAsSelector(term.MatchExpressions)
+		if err != nil {
+			return fmt.Errorf("Failed to parse MatchExpressions: %v", err)
+		}
+		if selector.Matches(labels.Set(nodeLabels)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("No matching NodeSelectorTerms")
+}
+
+// GetPersistentVolumeMode returns the volume mode of a persistent volume,
+// which is Filesystem unless the volume asks for raw block access.
+func GetPersistentVolumeMode(pvSpec *v1.PersistentVolumeSpec) v1.PersistentVolumeMode {
+	if pvSpec.VolumeMode == nil {
+		return v1.PersistentVolumeFilesystem
+	}
+	return *pvSpec.VolumeMode
+}
+
+// GetPersistentVolumeClaimVolumeMode returns the volume mode requested by a
+// persistent volume claim, which is Filesystem unless the claim asks for raw
+// block access.
+func GetPersistentVolumeClaimVolumeMode(pvcSpec *v1.PersistentVolumeClaimSpec) v1.PersistentVolumeMode {
+	if pvcSpec.VolumeMode == nil {
+		return v1.PersistentVolumeFilesystem
+	}
+	return *pvcSpec.VolumeMode
+}
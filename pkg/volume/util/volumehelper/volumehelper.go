@@ -21,7 +21,9 @@ package volumehelper
 import (
 	"fmt"
 
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/util/types"
 )
@@ -87,3 +89,16 @@ func GetUniqueVolumeNameFromSpec(
 			volumeName),
 		nil
 }
+
+// IsBlockVolume returns true if the given volume spec is a persistent volume
+// in Block mode and the BlockVolume feature is enabled.
+func IsBlockVolume(volumeSpec *volume.Spec) bool {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.BlockVolume) {
+		return false
+	}
+	if volumeSpec == nil || volumeSpec.PersistentVolume == nil {
+		return false
+	}
+	volumeMode := volumeSpec.PersistentVolume.Spec.VolumeMode
+	return volumeMode != nil && *volumeMode == v1.PersistentVolumeBlock
+}
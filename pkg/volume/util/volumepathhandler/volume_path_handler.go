@@ -0,0 +1,157 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package volumepathhandler contains helpers that link raw block devices
+// into the global and pod specific map paths of block volumes.
+package volumepathhandler
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/golang/glog"
+)
+
+// BlockVolumePathHandler defines a set of operations for handling the
+// symbolic links of raw block volumes.
+type BlockVolumePathHandler interface {
+	// MapDevice creates a symbolic link named linkName under mapPath that
+	// points to devicePath.
+	MapDevice(devicePath string, mapPath string, linkName string) error
+	// UnmapDevice removes the symbolic link named linkName under mapPath.
+	UnmapDevice(mapPath string, linkName string) error
+	// RemoveMapPath removes the map path directory, which must be empty.
+	RemoveMapPath(mapPath string) error
+	// IsSymlinkExist returns true if the given path is an existing symbolic link.
+	IsSymlinkExist(mapPath string) (bool, error)
+	// GetDeviceSymlinkRefs returns the symbolic links under mapPath that
+	// point to devicePath, or all of them if devicePath is empty.
+	GetDeviceSymlinkRefs(devicePath string, mapPath string) ([]string, error)
+}
+
+// NewBlockVolumePathHandler returns a new instance of BlockVolumePathHandler.
+func NewBlockVolumePathHandler() BlockVolumePathHandler {
+	return &VolumePathHandler{}
+}
+
+// VolumePathHandler is the default BlockVolumePathHandler.
+type VolumePathHandler struct {
+}
+
+// MapDevice creates a symbolic link to the block device under the map path.
+func (v VolumePathHandler) MapDevice(devicePath string, mapPath string, linkName string) error {
+	if len(devicePath) == 0 {
+		return fmt.Errorf("failed to map device to map path. devicePath is empty")
+	}
+	if len(mapPath) == 0 {
+		return fmt.Errorf("failed to map device to map path. mapPath is empty")
+	}
+	if !path.IsAbs(mapPath) {
+		return fmt.Errorf("the map path should be absolute: map path: %s", mapPath)
+	}
+	glog.V(5).Infof("MapDevice: devicePath %s", devicePath)
+	glog.V(5).Infof("MapDevice: mapPath %s", mapPath)
+	glog.V(5).Infof("MapDevice: linkName %s", linkName)
+
+	if err := os.MkdirAll(mapPath, 0750); err != nil {
+		return fmt.Errorf("failed to mkdir %s, error %v", mapPath, err)
+	}
+	linkPath := path.Join(mapPath, linkName)
+	if target, err := os.Readlink(linkPath); err == nil {
+		if target == devicePath {
+			// The device is already mapped
+			return nil
+		}
+		if err := os.Remove(linkPath); err != nil {
+			return fmt.Errorf("failed to remove stale symbolic link %s, error %v", linkPath, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	return os.Symlink(devicePath, linkPath)
+}
+
+// UnmapDevice removes the symbolic link to the block device from the map path.
+func (v VolumePathHandler) UnmapDevice(mapPath string, linkName string) error {
+	if len(mapPath) == 0 {
+		return fmt.Errorf("failed to unmap device from map path. mapPath is empty")
+	}
+	glog.V(5).Infof("UnmapDevice: mapPath %s", mapPath)
+	glog.V(5).Infof("UnmapDevice: linkName %s", linkName)
+
+	linkPath := path.Join(mapPath, linkName)
+	if isSymlink, err := v.IsSymlinkExist(linkPath); err != nil {
+		return err
+	} else if !isSymlink {
+		glog.Warningf("UnmapDevice: symbolic link %s does not exist, skipping", linkPath)
+		return nil
+	}
+	return os.Remove(linkPath)
+}
+
+// RemoveMapPath removes the map path directory if it exists.
+func (v VolumePathHandler) RemoveMapPath(mapPath string) error {
+	if len(mapPath) == 0 {
+		return fmt.Errorf("failed to remove map path. mapPath is empty")
+	}
+	glog.V(5).Infof("RemoveMapPath: mapPath %s", mapPath)
+	if err := os.Remove(mapPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// IsSymlinkExist returns true if the given path is an existing symbolic link.
+func (v VolumePathHandler) IsSymlinkExist(mapPath string) (bool, error) {
+	fi, err := os.Lstat(mapPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return fi.Mode()&os.ModeSymlink == os.ModeSymlink, nil
+}
+
+// GetDeviceSymlinkRefs returns the symbolic links under the map path that
+// point to the given block device.
+func (v VolumePathHandler) GetDeviceSymlinkRefs(devicePath string, mapPath string) ([]string, error) {
+	var refs []string
+	files, err := ioutil.ReadDir(mapPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return refs, nil
+		}
+		return nil, fmt.Errorf("directory cannot be read %v", err)
+	}
+	for _, file := range files {
+		if file.Mode()&os.ModeSymlink != os.ModeSymlink {
+			continue
+		}
+		linkPath := path.Join(mapPath, file.Name())
+		target, err := os.Readlink(linkPath)
+		if err != nil {
+			return nil, fmt.Errorf("symbolic link cannot be retrieved %v", err)
+		}
+		if len(devicePath) == 0 || target == devicePath {
+			refs = append(refs, linkPath)
+		}
+	}
+	glog.V(5).Infof("GetDeviceSymlinkRefs: refs %v", refs)
+	return refs, nil
+}
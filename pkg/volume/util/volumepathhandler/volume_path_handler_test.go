@@ -0,0 +1,89 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumepathhandler
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestMapAndUnmapDevice(t *testing.T) {
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "volumepathhandler")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	devicePath := path.Join(tmpDir, "dev")
+	if err := ioutil.WriteFile(devicePath, []byte{}, 0600); err != nil {
+		t.Fatalf("can't create fake device: %v", err)
+	}
+	globalMapPath := path.Join(tmpDir, "plugins", "volumeDevices", "vol1")
+	handler := NewBlockVolumePathHandler()
+
+	for _, link := range []string{"pod1", "pod2"} {
+		if err := handler.MapDevice(devicePath, globalMapPath, link); err != nil {
+			t.Fatalf("MapDevice(%s) failed: %v", link, err)
+		}
+	}
+	// Mapping the same device again is a no-op.
+	if err := handler.MapDevice(devicePath, globalMapPath, "pod1"); err != nil {
+		t.Fatalf("second MapDevice failed: %v", err)
+	}
+	if exists, err := handler.IsSymlinkExist(path.Join(globalMapPath, "pod1")); err != nil || !exists {
+		t.Errorf("expected symbolic link to exist, got %v, %v", exists, err)
+	}
+
+	refs, err := handler.GetDeviceSymlinkRefs(devicePath, globalMapPath)
+	if err != nil {
+		t.Fatalf("GetDeviceSymlinkRefs failed: %v", err)
+	}
+	if len(refs) != 2 {
+		t.Errorf("expected 2 references, got %v", refs)
+	}
+
+	for _, link := range []string{"pod1", "pod2"} {
+		if err := handler.UnmapDevice(globalMapPath, link); err != nil {
+			t.Fatalf("UnmapDevice(%s) failed: %v", link, err)
+		}
+	}
+	// Unmapping a missing link is not an error.
+	if err := handler.UnmapDevice(globalMapPath, "pod1"); err != nil {
+		t.Errorf("second UnmapDevice failed: %v", err)
+	}
+	refs, err = handler.GetDeviceSymlinkRefs("", globalMapPath)
+	if err != nil || len(refs) != 0 {
+		t.Errorf("expected no references, got %v, %v", refs, err)
+	}
+	if err := handler.RemoveMapPath(globalMapPath); err != nil {
+		t.Fatalf("RemoveMapPath failed: %v", err)
+	}
+	if _, err := os.Stat(globalMapPath); !os.IsNotExist(err) {
+		t.Errorf("expected map path to be removed, got %v", err)
+	}
+	if _, err := os.Stat(devicePath); err != nil {
+		t.Errorf("expected device to be left alone, got %v", err)
+	}
+}
+
+func TestMapDeviceRelativePath(t *testing.T) {
+	if err := NewBlockVolumePathHandler().MapDevice("/dev/sdb", "relative/path", "pod1"); err == nil {
+		t.Errorf("expected an error for a relative map path")
+	}
+}
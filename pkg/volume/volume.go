@@ -81,6 +81,13 @@ type Metrics struct {
 	// a filesystem with the host (e.g. emptydir, hostpath), this is the free inodes
 	// on the underlying storage, and is shared with host processes and other volumes
 	InodesFree *resource.Quantity
+
+	// Abnormal is true if the volume is not usable as expected, e.g. because
+	// the device backing it has gone away. Nil if the condition is unknown.
+	Abnormal *bool
+
+	// Message describes the condition of the volume, e.g. why it is abnormal.
+	Message *string
 }
 
 // Attributes represents the attributes of this mounter.
@@ -134,6 +141,40 @@ type Unmounter interface {
 	TearDownAt(dir string) error
 }
 
+// BlockVolume interface provides methods to generate the global map path
+// and the pod device map path of a raw block volume.
+type BlockVolume interface {
+	// GetGlobalMapPath returns a global map path which holds a symbolic
+	// link to the device for every pod using the volume, named after the
+	// pod UID, e.g.
+	// plugins/kubernetes.io/{PluginName}/volumeDevices/{volumePluginDependentPath}
+	GetGlobalMapPath(spec *Spec) (string, error)
+	// GetPodDeviceMapPath returns the pod device map path and the name of
+	// the symbolic link to the device inside of it, e.g.
+	// pods/{podUid}/volumeDevices/{escapeQualifiedPluginName}, {volumeName}
+	GetPodDeviceMapPath() (string, string)
+}
+
+// BlockVolumeMapper interface provides methods to set up/map the volume.
+type BlockVolumeMapper interface {
+	BlockVolume
+	// SetUpDevice prepares the volume on the node in a plugin specific
+	// way, e.g. by logging into an iSCSI target, and returns the path of
+	// the block device on the node.  Plugins whose devices are attached by
+	// an Attacher return an empty path, the device path reported by
+	// WaitForAttach is used instead.
+	SetUpDevice() (string, error)
+}
+
+// BlockVolumeUnmapper interface provides methods to cleanup/unmap the volumes.
+type BlockVolumeUnmapper interface {
+	BlockVolume
+	// TearDownDevice removes traces of the SetUpDevice procedure once no
+	// pod uses the device anymore.  mapPath is the global map path of the
+	// volume and devicePath the block device on the node.
+	TearDownDevice(mapPath string, devicePath string) error
+}
+
 // Provisioner is an interface that creates templates for PersistentVolumes
 // and can create the volume as a new resource in the infrastructure provider.
 type Provisioner interface {
@@ -24,6 +24,7 @@ import (
 	appsinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/apps/v1beta1"
 	coreinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/core/v1"
 	extensionsinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/extensions/v1beta1"
+	storageinformers "k8s.io/kubernetes/pkg/client/informers/informers_generated/externalversions/storage/v1beta1"
 	"k8s.io/kubernetes/plugin/cmd/kube-scheduler/app/options"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -82,6 +83,7 @@ func createScheduler(
 	replicaSetInformer extensionsinformers.ReplicaSetInformer,
 	statefulSetInformer appsinformers.StatefulSetInformer,
 	serviceInformer coreinformers.ServiceInformer,
+	storageClassInformer storageinformers.StorageClassInformer,
 	recorder record.EventRecorder,
 ) (*scheduler.Scheduler, error) {
 	configurator := factory.NewConfigFactory(
@@ -94,6 +96,7 @@ func createScheduler(
 		replicaSetInformer,
 		statefulSetInformer,
 		serviceInformer,
+		storageClassInformer,
 		s.HardPodAffinitySymmetricWeight,
 	)
 
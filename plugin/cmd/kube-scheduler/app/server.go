@@ -83,6 +83,7 @@ func Run(s *options.SchedulerServer) error {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		recorder,
 	)
 	if err != nil {
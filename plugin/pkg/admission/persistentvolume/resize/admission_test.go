@@ -0,0 +1,142 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resize
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/storage"
+	informers "k8s.io/kubernetes/pkg/client/informers/informers_generated/internalversion"
+	"k8s.io/kubernetes/pkg/controller"
+)
+
+func newClaim(className, size string, phase api.PersistentVolumeClaimPhase) *api.PersistentVolumeClaim {
+	return &api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "ns"},
+		Spec: api.PersistentVolumeClaimSpec{
+			StorageClassName: &className,
+			Resources: api.ResourceRequirements{
+				Requests: api.ResourceList{
+					api.ResourceStorage: resource.MustParse(size),
+				},
+			},
+			VolumeName: "volume",
+		},
+		Status: api.PersistentVolumeClaimStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func TestPVCResizeAdmission(t *testing.T) {
+	allowExpand := true
+	disallowExpand := false
+	classes := []*storage.StorageClass{
+		{
+			ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+			Provisioner:          "kubernetes.io/glusterfs",
+			AllowVolumeExpansion: &allowExpand,
+		},
+		{
+			ObjectMeta:           metav1.ObjectMeta{Name: "fixed"},
+			Provisioner:          "kubernetes.io/glusterfs",
+			AllowVolumeExpansion: &disallowExpand,
+		},
+		{
+			ObjectMeta:  metav1.ObjectMeta{Name: "unset"},
+			Provisioner: "kubernetes.io/glusterfs",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		oldClaim    *api.PersistentVolumeClaim
+		newClaim    *api.PersistentVolumeClaim
+		expectError bool
+	}{
+		{
+			name:        "expand with expandable class",
+			oldClaim:    newClaim("expandable", "1Gi", api.ClaimBound),
+			newClaim:    newClaim("expandable", "2Gi", api.ClaimBound),
+			expectError: false,
+		},
+		{
+			name:        "expand with class that disallows expansion",
+			oldClaim:    newClaim("fixed", "1Gi", api.ClaimBound),
+			newClaim:    newClaim("fixed", "2Gi", api.ClaimBound),
+			expectError: true,
+		},
+		{
+			name:        "expand with class that does not set expansion",
+			oldClaim:    newClaim("unset", "1Gi", api.ClaimBound),
+			newClaim:    newClaim("unset", "2Gi", api.ClaimBound),
+			expectError: true,
+		},
+		{
+			name:        "expand with missing class",
+			oldClaim:    newClaim("missing", "1Gi", api.ClaimBound),
+			newClaim:    newClaim("missing", "2Gi", api.ClaimBound),
+			expectError: true,
+		},
+		{
+			name:        "expand pending claim",
+			oldClaim:    newClaim("expandable", "1Gi", api.ClaimPending),
+			newClaim:    newClaim("expandable", "2Gi", api.ClaimPending),
+			expectError: true,
+		},
+		{
+			name:        "no size change with class that disallows expansion",
+			oldClaim:    newClaim("fixed", "1Gi", api.ClaimBound),
+			newClaim:    newClaim("fixed", "1Gi", api.ClaimBound),
+			expectError: false,
+		},
+	}
+
+	for _, test := range tests {
+		ctrl := newPlugin()
+		informerFactory := informers.NewSharedInformerFactory(nil, controller.NoResyncPeriodFunc())
+		ctrl.SetInformerFactory(informerFactory)
+		if err := ctrl.Validate(); err != nil {
+			t.Fatalf("Test %q: plugin validation failed: %v", test.name, err)
+		}
+		for _, c := range classes {
+			informerFactory.Storage().InternalVersion().StorageClasses().Informer().GetStore().Add(c)
+		}
+		attrs := admission.NewAttributesRecord(
+			test.newClaim, // new object
+			test.oldClaim, // old object
+			api.Kind("PersistentVolumeClaim").WithVersion("version"),
+			test.newClaim.Namespace,
+			test.newClaim.Name,
+			api.Resource("persistentvolumeclaims").WithVersion("version"),
+			"", // subresource
+			admission.Update,
+			nil, // userInfo
+		)
+		err := ctrl.Admit(attrs)
+		if err != nil && !test.expectError {
+			t.Errorf("Test %q: unexpected error received: %v", test.name, err)
+		}
+		if err == nil && test.expectError {
+			t.Errorf("Test %q: expected error and no error received", test.name)
+		}
+	}
+}
@@ -25,12 +25,13 @@ import (
 )
 
 // rolesWithAllowStar are the controller roles which are allowed to contain a *.  These are
-// namespace lifecycle and GC which have to delete anything.  If you're adding to this list
-// tag sig-auth
+// namespace lifecycle and GC which have to delete anything, and the disruption controller
+// which reads the scale of any controller.  If you're adding to this list tag sig-auth
 var rolesWithAllowStar = sets.NewString(
 	saRolePrefix+"namespace-controller",
 	saRolePrefix+"generic-garbage-collector",
 	saRolePrefix+"resourcequota-controller",
+	saRolePrefix+"disruption-controller",
 )
 
 // TestNoStarsForControllers confirms that no controller role has star verbs, groups,
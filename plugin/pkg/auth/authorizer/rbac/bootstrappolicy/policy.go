@@ -237,10 +237,15 @@ func ClusterRoles() []rbac.ClusterRole {
 				// TODO: restrict to claims/volumes used by pods scheduled on bound node once supported
 				// Needed for persistent volumes
 				rbac.NewRule("get").Groups(legacyGroup).Resources("persistentvolumeclaims", "persistentvolumes").RuleOrDie(),
+				// TODO: restrict to claims used by pods scheduled on bound node once supported
+				// Needed to record the new capacity of claims once their file system was resized
+				rbac.NewRule("update", "patch").Groups(legacyGroup).Resources("persistentvolumeclaims/status").RuleOrDie(),
 				// TODO: restrict to namespaces of pods scheduled on bound node once supported
 				// TODO: change glusterfs to use DNS lookup so this isn't needed?
 				// Needed for glusterfs volumes
 				rbac.NewRule("get").Groups(legacyGroup).Resources("endpoints").RuleOrDie(),
+				// Needed for CSI volumes, to read the attachment metadata
+				rbac.NewRule("get").Groups(storageGroup).Resources("volumeattachments").RuleOrDie(),
 				// Used to create a certificatesigningrequest for a node-specific client certificate, and watch
 				// for it to be signed. This allows the kubelet to rotate it's own certificate.
 				rbac.NewRule("create", "get", "list", "watch").Groups(certificatesGroup).Resources("certificatesigningrequests").RuleOrDie(),
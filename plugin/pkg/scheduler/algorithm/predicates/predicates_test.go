@@ -25,9 +25,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/controller/volume/persistentvolume"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/schedulercache"
 	schedulertesting "k8s.io/kubernetes/plugin/pkg/scheduler/testing"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/volumebinder"
 )
 
 type FakeNodeInfo v1.Node
@@ -71,8 +73,9 @@ func (pvs FakePersistentVolumeInfo) GetPersistentVolumeInfo(pvID string) (*v1.Pe
 }
 
 var (
-	opaqueResourceA = v1.OpaqueIntResourceName("AAA")
-	opaqueResourceB = v1.OpaqueIntResourceName("BBB")
+	opaqueResourceA   = v1.OpaqueIntResourceName("AAA")
+	opaqueResourceB   = v1.OpaqueIntResourceName("BBB")
+	hugePageResourceA = v1.HugePageResourceName(resource.MustParse("2Mi"))
 )
 
 func makeResources(milliCPU, memory, nvidiaGPUs, pods, opaqueA int64) v1.NodeResources {
@@ -398,6 +401,56 @@ func TestPodFitsResources(t *testing.T) {
 	}
 }
 
+func TestPodFitsResourcesHugePages(t *testing.T) {
+	allocatable := makeAllocatableResources(10, 20, 0, 32, 5)
+	allocatable[hugePageResourceA] = *resource.NewQuantity(5, resource.BinarySI)
+	node := v1.Node{Status: v1.NodeStatus{Allocatable: allocatable}}
+
+	tests := []struct {
+		pod      *v1.Pod
+		nodeInfo *schedulercache.NodeInfo
+		fits     bool
+		test     string
+		reasons  []algorithm.PredicateFailureReason
+	}{
+		{
+			pod:      newResourcePod(schedulercache.Resource{MilliCPU: 1, Memory: 1, OpaqueIntResources: map[v1.ResourceName]int64{hugePageResourceA: 3}}),
+			nodeInfo: schedulercache.NewNodeInfo(newResourcePod(schedulercache.Resource{OpaqueIntResources: map[v1.ResourceName]int64{hugePageResourceA: 2}})),
+			fits:     true,
+			test:     "huge pages fit",
+		},
+		{
+			pod:      newResourcePod(schedulercache.Resource{MilliCPU: 1, Memory: 1, OpaqueIntResources: map[v1.ResourceName]int64{hugePageResourceA: 4}}),
+			nodeInfo: schedulercache.NewNodeInfo(newResourcePod(schedulercache.Resource{OpaqueIntResources: map[v1.ResourceName]int64{hugePageResourceA: 2}})),
+			fits:     false,
+			test:     "huge pages allocatable enforced",
+			reasons:  []algorithm.PredicateFailureReason{NewInsufficientResourceError(hugePageResourceA, 4, 2, 5)},
+		},
+		{
+			pod: newResourceInitPod(newResourcePod(schedulercache.Resource{}),
+				schedulercache.Resource{MilliCPU: 1, Memory: 1, OpaqueIntResources: map[v1.ResourceName]int64{hugePageResourceA: 6}}),
+			nodeInfo: schedulercache.NewNodeInfo(newResourcePod(schedulercache.Resource{})),
+			fits:     false,
+			test:     "huge pages allocatable enforced for init container",
+			reasons:  []algorithm.PredicateFailureReason{NewInsufficientResourceError(hugePageResourceA, 6, 0, 5)},
+		},
+	}
+
+	for _, test := range tests {
+		test.nodeInfo.SetNode(&node)
+		fits, reasons, err := PodFitsResources(test.pod, PredicateMetadata(test.pod, nil), test.nodeInfo)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if !fits && !reflect.DeepEqual(reasons, test.reasons) {
+			t.Errorf("%s: unexpected failure reasons: %v, want: %v", test.test, reasons, test.reasons)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
+
 func TestPodFitsHost(t *testing.T) {
 	tests := []struct {
 		pod  *v1.Pod
@@ -4710,3 +4763,94 @@ func TestInterPodAffinityAnnotationsWithMultipleNodes(t *testing.T) {
 		}
 	}
 }
+
+func TestVolumeBindingPredicate(t *testing.T) {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "default"}}
+
+	tests := []struct {
+		name            string
+		gateEnabled     bool
+		config          persistentvolume.FakeVolumeBinderConfig
+		fits            bool
+		expectedReasons []algorithm.PredicateFailureReason
+		shouldFail      bool
+	}{
+		{
+			name:        "feature gate disabled",
+			gateEnabled: false,
+			config:      persistentvolume.FakeVolumeBinderConfig{},
+			fits:        true,
+		},
+		{
+			name:        "all volumes satisfied",
+			gateEnabled: true,
+			config: persistentvolume.FakeVolumeBinderConfig{
+				FindUnboundSatisfied: true,
+				FindBoundSatisfied:   true,
+			},
+			fits: true,
+		},
+		{
+			name:        "bound volumes not satisfied",
+			gateEnabled: true,
+			config: persistentvolume.FakeVolumeBinderConfig{
+				FindUnboundSatisfied: true,
+				FindBoundSatisfied:   false,
+			},
+			expectedReasons: []algorithm.PredicateFailureReason{ErrVolumeNodeConflict},
+		},
+		{
+			name:        "unbound volumes not satisfied",
+			gateEnabled: true,
+			config: persistentvolume.FakeVolumeBinderConfig{
+				FindUnboundSatisfied: false,
+				FindBoundSatisfied:   true,
+			},
+			expectedReasons: []algorithm.PredicateFailureReason{ErrVolumeBindConflict},
+		},
+		{
+			name:        "bound and unbound volumes not satisfied",
+			gateEnabled: true,
+			config: persistentvolume.FakeVolumeBinderConfig{
+				FindUnboundSatisfied: false,
+				FindBoundSatisfied:   false,
+			},
+			expectedReasons: []algorithm.PredicateFailureReason{ErrVolumeNodeConflict, ErrVolumeBindConflict},
+		},
+		{
+			name:        "binder error",
+			gateEnabled: true,
+			config: persistentvolume.FakeVolumeBinderConfig{
+				FindErr: fmt.Errorf("binder error"),
+			},
+			shouldFail: true,
+		},
+	}
+
+	defer utilfeature.DefaultFeatureGate.Set("VolumeScheduling=false")
+	for _, test := range tests {
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("VolumeScheduling=%v", test.gateEnabled)); err != nil {
+			t.Fatalf("Failed to set feature gate: %v", err)
+		}
+
+		nodeInfo := schedulercache.NewNodeInfo()
+		nodeInfo.SetNode(node)
+
+		config := test.config
+		predicate := NewVolumeBindingPredicate(volumebinder.NewFakeVolumeBinder(&config))
+		fits, reasons, err := predicate(pod, nil, nodeInfo)
+		if test.shouldFail && err == nil {
+			t.Errorf("%s: expected error, got success", test.name)
+		}
+		if !test.shouldFail && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected fits %v, got %v", test.name, test.fits, fits)
+		}
+		if len(reasons) != len(test.expectedReasons) || (len(reasons) > 0 && !reflect.DeepEqual(reasons, test.expectedReasons)) {
+			t.Errorf("%s: unexpected failure reasons: %v, want: %v", test.name, reasons, test.expectedReasons)
+		}
+	}
+}
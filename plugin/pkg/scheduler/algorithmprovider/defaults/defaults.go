@@ -171,6 +171,16 @@ func defaultPredicates() sets.String {
 
 		// Fit is determined by node disk pressure condition.
 		factory.RegisterFitPredicate("CheckNodeDiskPressure", predicates.CheckNodeDiskPressurePredicate),
+
+		// Fit is determined by volume node affinity of bound PVs and by the
+		// availability of matching PVs for unbound PVCs.  The predicate is a
+		// no-op unless the VolumeScheduling feature is enabled.
+		factory.RegisterFitPredicateFactory(
+			"CheckVolumeBinding",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return predicates.NewVolumeBindingPredicate(args.VolumeBinder)
+			},
+		),
 	)
 }
 
@@ -61,6 +61,7 @@ func TestCreate(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	factory.Create()
@@ -91,6 +92,7 @@ func TestCreateFromConfig(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 
@@ -144,6 +146,7 @@ func TestCreateFromEmptyConfig(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 
@@ -199,6 +202,7 @@ func TestDefaultErrorFunc(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
@@ -308,6 +312,7 @@ func TestResponsibleForPod(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	// factory of "foo-scheduler"
@@ -321,6 +326,7 @@ func TestResponsibleForPod(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	// scheduler annotations to be tested
@@ -389,6 +395,7 @@ func TestInvalidHardPodAffinitySymmetricWeight(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		-1,
 	)
 	_, err := factory.Create()
@@ -433,6 +440,7 @@ func TestInvalidFactoryArgs(t *testing.T) {
 			informerFactory.Extensions().V1beta1().ReplicaSets(),
 			informerFactory.Apps().V1beta1().StatefulSets(),
 			informerFactory.Core().V1().Services(),
+			informerFactory.Storage().V1beta1().StorageClasses(),
 			test.hardPodAffinitySymmetricWeight,
 		)
 		_, err := factory.Create()
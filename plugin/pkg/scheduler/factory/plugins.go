@@ -28,6 +28,7 @@ import (
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm/priorities"
 	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/volumebinder"
 
 	"github.com/golang/glog"
 )
@@ -44,6 +45,7 @@ type PluginFactoryArgs struct {
 	PVInfo                         predicates.PersistentVolumeInfo
 	PVCInfo                        predicates.PersistentVolumeClaimInfo
 	HardPodAffinitySymmetricWeight int
+	VolumeBinder                   *volumebinder.VolumeBinder
 }
 
 // MetadataProducerFactory produces MetadataProducer from the given args.
@@ -21,15 +21,18 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/kubernetes/pkg/api/v1"
 	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 	corelisters "k8s.io/kubernetes/pkg/client/listers/core/v1"
+	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
 	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/metrics"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/schedulercache"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/util"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/volumebinder"
 
 	"github.com/golang/glog"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -109,6 +112,9 @@ type Config struct {
 
 	// Close this to shut down the scheduler.
 	StopEverything chan struct{}
+
+	// VolumeBinder handles PVC/PV binding for the pod.
+	VolumeBinder *volumebinder.VolumeBinder
 }
 
 // New returns a new scheduler.
@@ -198,6 +204,25 @@ func (s *Scheduler) scheduleOne() {
 			},
 		}
 
+		// Pre-bind the pod's unbound volumes to PVs on the chosen node, so
+		// that the PV controller completes their binding.
+		if utilfeature.DefaultFeatureGate.Enabled(features.VolumeScheduling) && s.config.VolumeBinder != nil {
+			if err := s.config.VolumeBinder.Binder.BindPodVolumes(&assumed); err != nil {
+				glog.V(1).Infof("Failed to bind volumes for pod: %v/%v: %v", pod.Namespace, pod.Name, err)
+				if err := s.config.SchedulerCache.ForgetPod(&assumed); err != nil {
+					glog.Errorf("scheduler cache ForgetPod failed: %v", err)
+				}
+				s.config.Error(pod, err)
+				s.config.Recorder.Eventf(pod, v1.EventTypeWarning, "FailedScheduling", "Volume binding failed: %v", err)
+				s.config.PodConditionUpdater.Update(pod, &v1.PodCondition{
+					Type:   v1.PodScheduled,
+					Status: v1.ConditionFalse,
+					Reason: "VolumeBindingFailed",
+				})
+				return
+			}
+		}
+
 		bindingStart := time.Now()
 		// If binding succeeded then PodScheduled condition will be updated in apiserver so that
 		// it's atomic with setting host.
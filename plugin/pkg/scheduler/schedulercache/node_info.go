@@ -63,6 +63,8 @@ type NodeInfo struct {
 }
 
 // Resource is a collection of compute resource.
+// OpaqueIntResources tracks all the resources that are counted by name:
+// opaque integer resources and huge pages.
 type Resource struct {
 	MilliCPU           int64
 	Memory             int64
@@ -77,7 +79,11 @@ func (r *Resource) ResourceList() v1.ResourceList {
 		v1.ResourceNvidiaGPU: *resource.NewQuantity(r.NvidiaGPU, resource.DecimalSI),
 	}
 	for rName, rQuant := range r.OpaqueIntResources {
-		result[rName] = *resource.NewQuantity(rQuant, resource.DecimalSI)
+		if v1.IsHugePageResourceName(rName) {
+			result[rName] = *resource.NewQuantity(rQuant, resource.BinarySI)
+		} else {
+			result[rName] = *resource.NewQuantity(rQuant, resource.DecimalSI)
+		}
 	}
 	return result
 }
@@ -304,7 +310,7 @@ func calculateResource(pod *v1.Pod) (res Resource, non0_cpu int64, non0_mem int6
 			case v1.ResourceNvidiaGPU:
 				res.NvidiaGPU += rQuant.Value()
 			default:
-				if v1.IsOpaqueIntResourceName(rName) {
+				if v1.IsOpaqueIntResourceName(rName) || v1.IsHugePageResourceName(rName) {
 					res.AddOpaque(rName, rQuant.Value())
 				}
 			}
@@ -332,7 +338,7 @@ func (n *NodeInfo) SetNode(node *v1.Node) error {
 		case v1.ResourcePods:
 			n.allowedPodNumber = int(rQuant.Value())
 		default:
-			if v1.IsOpaqueIntResourceName(rName) {
+			if v1.IsOpaqueIntResourceName(rName) || v1.IsHugePageResourceName(rName) {
 				n.allocatableResource.AddOpaque(rName, rQuant.Value())
 			}
 		}
@@ -223,6 +223,14 @@ type ObjectMeta struct {
 	// This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.
 	// +optional
 	ClusterName string `json:"clusterName,omitempty" protobuf:"bytes,15,opt,name=clusterName"`
+
+	// ManagedFields records which fields of this object are owned by which
+	// manager. An entry is added or updated every time a manager applies a
+	// configuration to, or otherwise changes, the object. This field is
+	// maintained by the server and should not be set by clients.
+	// This is an alpha feature and may change in the future.
+	// +optional
+	ManagedFields []ManagedFieldsEntry `json:"managedFields,omitempty" protobuf:"bytes,16,rep,name=managedFields"`
 }
 
 const (
@@ -266,6 +274,42 @@ type OwnerReference struct {
 	BlockOwnerDeletion *bool `json:"blockOwnerDeletion,omitempty" protobuf:"varint,7,opt,name=blockOwnerDeletion"`
 }
 
+// ManagedFieldsOperationType is the type of operation which led to a
+// ManagedFieldsEntry being created.
+type ManagedFieldsOperationType string
+
+const (
+	// ManagedFieldsOperationApply means the fields were set by an apply
+	// request. Fields owned through an apply are removed from the object
+	// when the manager stops specifying them.
+	ManagedFieldsOperationApply ManagedFieldsOperationType = "Apply"
+	// ManagedFieldsOperationUpdate means the fields were set by a create,
+	// update or non-apply patch request.
+	ManagedFieldsOperationUpdate ManagedFieldsOperationType = "Update"
+)
+
+// ManagedFieldsEntry is a manager, the operation it performed and the set
+// of fields it owns as a result.
+type ManagedFieldsEntry struct {
+	// Manager is an identifier of the workflow managing these fields.
+	Manager string `json:"manager,omitempty" protobuf:"bytes,1,opt,name=manager"`
+	// Operation is the type of operation which led to this entry being
+	// created. The only valid values are "Apply" and "Update".
+	Operation ManagedFieldsOperationType `json:"operation,omitempty" protobuf:"bytes,2,opt,name=operation,casttype=ManagedFieldsOperationType"`
+	// APIVersion is the version of the resource the fields were set
+	// through. Field paths are only meaningful in that version.
+	APIVersion string `json:"apiVersion,omitempty" protobuf:"bytes,3,opt,name=apiVersion"`
+	// Time is the timestamp of the last operation of this manager.
+	// +optional
+	Time *Time `json:"time,omitempty" protobuf:"bytes,4,opt,name=time"`
+	// Fields is the sorted list of paths of the fields owned by the manager,
+	// for example ".spec.replicas" or ".spec.containers[name="nginx"].image".
+	// Elements of lists merged by key are identified by their key field,
+	// and elements of lists of scalars by their value.
+	// +optional
+	Fields []string `json:"fields,omitempty" protobuf:"bytes,5,rep,name=fields"`
+}
+
 // ListOptions is the query options to a standard REST list call.
 type ListOptions struct {
 	TypeMeta `json:",inline"`
@@ -25,4 +25,7 @@ const (
 	JSONPatchType           PatchType = "application/json-patch+json"
 	MergePatchType          PatchType = "application/merge-patch+json"
 	StrategicMergePatchType PatchType = "application/strategic-merge-patch+json"
+	// ApplyPatchType is the patch type of a server-side apply, whose body is
+	// the full configuration the caller wants to own, in YAML or JSON.
+	ApplyPatchType PatchType = "application/apply-patch+yaml"
 )
@@ -58,7 +58,9 @@ import (
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	genericapitesting "k8s.io/apiserver/pkg/endpoints/testing"
+	"k8s.io/apiserver/pkg/features"
 	"k8s.io/apiserver/pkg/registry/rest"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 )
 
 // alwaysAdmit is an implementation of admission.Interface which always says yes to an admit request.
@@ -2261,6 +2263,72 @@ func TestPatchRequiresMatchingName(t *testing.T) {
 	}
 }
 
+func TestPatchApply(t *testing.T) {
+	// Enable ServerSideApply for test.
+	utilfeature.DefaultFeatureGate.Set(string(features.ServerSideApply) + "=true")
+	defer utilfeature.DefaultFeatureGate.Set(string(features.ServerSideApply) + "=false")
+
+	storage := map[string]rest.Storage{}
+	ID := "id"
+	item := &genericapitesting.Simple{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ID,
+			Namespace: "",
+			UID:       "uid",
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "bob", Operation: metav1.ManagedFieldsOperationUpdate, Fields: []string{".other"}},
+			},
+		},
+		Other: "bar",
+	}
+	simpleStorage := SimpleRESTStorage{item: *item}
+	storage["simple"] = &simpleStorage
+	handler := handle(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	path := server.URL + "/" + prefix + "/" + testGroupVersion.Group + "/" + testGroupVersion.Version + "/namespaces/default/simple/" + ID
+	body := `{"apiVersion":"` + testGroupVersion.String() + `","kind":"Simple","metadata":{"name":"id"},"other":"foo","labels":{"app":"web"}}`
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected int
+	}{
+		{name: "conflict", query: "?fieldManager=alice", expected: http.StatusConflict},
+		{name: "force", query: "?fieldManager=alice&force=true", expected: http.StatusOK},
+	}
+	for _, tc := range testCases {
+		simpleStorage.updated = nil
+		request, err := http.NewRequest("PATCH", path+tc.query, bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		request.Header.Set("Content-Type", "application/apply-patch+yaml")
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		response.Body.Close()
+		if response.StatusCode != tc.expected {
+			t.Errorf("%s: expected status %d, got %d", tc.name, tc.expected, response.StatusCode)
+		}
+	}
+
+	updated := simpleStorage.updated
+	if updated == nil || updated.Other != "foo" || updated.Labels["app"] != "web" {
+		t.Fatalf("Unexpected update value %#v", updated)
+	}
+	if len(updated.ManagedFields) != 1 {
+		t.Fatalf("Unexpected managed fields %#v", updated.ManagedFields)
+	}
+	entry := updated.ManagedFields[0]
+	expectedFields := []string{".labels.app", ".other"}
+	if entry.Manager != "alice" || entry.Operation != metav1.ManagedFieldsOperationApply || !reflect.DeepEqual(entry.Fields, expectedFields) {
+		t.Errorf("Unexpected managed fields entry %#v", entry)
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	simpleStorage := SimpleRESTStorage{}
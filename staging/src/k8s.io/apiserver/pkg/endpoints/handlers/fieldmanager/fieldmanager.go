@@ -0,0 +1,152 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fieldmanager records which manager owns each field of an object,
+// and merges the configurations sent by server-side apply into objects.
+package fieldmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ghodss/yaml"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// FieldManager maintains the managed fields of the objects of one kind, and
+// merges applied configurations into them.
+type FieldManager struct {
+	convertor runtime.ObjectConvertor
+	decoder   runtime.Decoder
+	schema    reflect.Type
+	kind      schema.GroupVersionKind
+}
+
+// NewFieldManager creates a FieldManager for objects of the given kind.
+// Field paths are computed on the versioned form of objects, and lists are
+// merged according to the patch strategies and merge keys of versionedObj.
+// decoder must decode versioned JSON into defaulted internal objects.
+func NewFieldManager(convertor runtime.ObjectConvertor, decoder runtime.Decoder, versionedObj runtime.Object, kind schema.GroupVersionKind) *FieldManager {
+	return &FieldManager{
+		convertor: convertor,
+		decoder:   decoder,
+		schema:    reflect.TypeOf(versionedObj),
+		kind:      kind,
+	}
+}
+
+// Update records the fields that changed between liveObj and newObj as owned
+// by manager in the managed fields of newObj, which is returned. liveObj is
+// nil when newObj is being created. Managed fields set by the caller in
+// newObj are ignored.
+func (f *FieldManager) Update(liveObj, newObj runtime.Object, manager string) (runtime.Object, error) {
+	newMeta := objectMeta(newObj)
+	if newMeta == nil {
+		return newObj, nil
+	}
+	live := map[string]interface{}{}
+	var managed []metav1.ManagedFieldsEntry
+	if liveObj != nil {
+		if liveMeta := objectMeta(liveObj); liveMeta != nil {
+			managed = liveMeta.ManagedFields
+		}
+		var err error
+		if live, err = f.toUnstructured(liveObj); err != nil {
+			return nil, err
+		}
+	}
+	obj, err := f.toUnstructured(newObj)
+	if err != nil {
+		return nil, err
+	}
+	newMeta.ManagedFields = updateFields(live, obj, f.schema, managed, manager, f.kind.GroupVersion().String(), metav1.Now())
+	return newObj, nil
+}
+
+// Apply merges the configuration in patch, a YAML or JSON object of the kind
+// of the FieldManager, into liveObj on behalf of manager and returns the
+// result. A Conflict error is returned if the configuration changes fields
+// owned by other managers, unless force is set.
+func (f *FieldManager) Apply(liveObj runtime.Object, patch []byte, manager string, force bool) (runtime.Object, error) {
+	liveMeta := objectMeta(liveObj)
+	if liveMeta == nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("apply is not supported for %s", f.kind.Kind))
+	}
+
+	config := map[string]interface{}{}
+	if err := yaml.Unmarshal(patch, &config); err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("error decoding the applied configuration: %v", err))
+	}
+	apiVersion := f.kind.GroupVersion().String()
+	if config["apiVersion"] != apiVersion || config["kind"] != f.kind.Kind {
+		return nil, errors.NewBadRequest(fmt.Sprintf("the applied configuration must have apiVersion %q and kind %q", apiVersion, f.kind.Kind))
+	}
+
+	live, err := f.toUnstructured(liveObj)
+	if err != nil {
+		return nil, err
+	}
+	merged, managed, err := applyFields(live, config, f.schema, liveMeta.ManagedFields, manager, apiVersion, metav1.Now(), force)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := runtime.Decode(f.decoder, data)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	objMeta := objectMeta(obj)
+	if objMeta == nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unexpected object without metadata: %T", obj))
+	}
+	objMeta.ManagedFields = managed
+	return obj, nil
+}
+
+// toUnstructured converts obj to the version of the FieldManager and returns
+// its unstructured representation.
+func (f *FieldManager) toUnstructured(obj runtime.Object) (map[string]interface{}, error) {
+	versioned, err := f.convertor.ConvertToVersion(obj, f.kind.GroupVersion())
+	if err != nil {
+		return nil, err
+	}
+	u := map[string]interface{}{}
+	if err := unstructured.DefaultConverter.ToUnstructured(versioned, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// objectMeta returns the metadata of obj, or nil if obj does not embed the
+// standard object metadata.
+func objectMeta(obj runtime.Object) *metav1.ObjectMeta {
+	accessor, ok := obj.(metav1.ObjectMetaAccessor)
+	if !ok {
+		return nil
+	}
+	objectMeta, _ := accessor.GetObjectMeta().(*metav1.ObjectMeta)
+	return objectMeta
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldmanager
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ghodss/yaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              testSpec `json:"spec,omitempty"`
+}
+
+type testSpec struct {
+	Replicas   *int32          `json:"replicas,omitempty"`
+	Finalizers []string        `json:"finalizers,omitempty" patchStrategy:"merge"`
+	Containers []testContainer `json:"containers,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+}
+
+type testContainer struct {
+	Name  string   `json:"name"`
+	Image string   `json:"image,omitempty"`
+	Args  []string `json:"args,omitempty"`
+}
+
+var testType = reflect.TypeOf(&testObject{})
+
+func parse(t *testing.T, data string) map[string]interface{} {
+	obj := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(data), &obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return obj
+}
+
+func managerFields(managed []metav1.ManagedFieldsEntry) map[string][]string {
+	fields := map[string][]string{}
+	for _, entry := range managed {
+		fields[entry.Manager+"/"+string(entry.Operation)] = entry.Fields
+	}
+	return fields
+}
+
+func TestFieldValues(t *testing.T) {
+	obj := parse(t, `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+  labels:
+    app: web
+    "example.com/tier": frontend
+spec:
+  replicas: 3
+  finalizers: [a, b]
+  containers:
+  - name: nginx
+    image: nginx:1.13
+    args: [--debug]
+`)
+	expected := []string{
+		`.metadata.labels."example.com/tier"`,
+		`.metadata.labels.app`,
+		`.spec.containers[name="nginx"]`,
+		`.spec.containers[name="nginx"].args`,
+		`.spec.containers[name="nginx"].image`,
+		`.spec.containers[name="nginx"].name`,
+		`.spec.finalizers["a"]`,
+		`.spec.finalizers["b"]`,
+		`.spec.replicas`,
+	}
+	values := fieldValues(obj, testType)
+	var paths []string
+	for path := range values {
+		paths = append(paths, path)
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected paths %v, got %v", expected, paths)
+	}
+	for _, path := range expected {
+		if _, ok := values[path]; !ok {
+			t.Errorf("expected path %s in %v", path, paths)
+		}
+	}
+}
+
+func TestApplyFields(t *testing.T) {
+	now := metav1.Now()
+	live := `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 3
+  containers:
+  - name: nginx
+    image: nginx:1.13
+  - name: sidecar
+    image: sidecar:1.0
+`
+	managed := []metav1.ManagedFieldsEntry{
+		{
+			Manager:   "kubectl",
+			Operation: metav1.ManagedFieldsOperationApply,
+			Fields: []string{
+				`.spec.containers[name="nginx"]`,
+				`.spec.containers[name="nginx"].image`,
+				`.spec.containers[name="nginx"].name`,
+				`.spec.replicas`,
+			},
+		},
+		{
+			Manager:   "injector",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			Fields: []string{
+				`.spec.containers[name="sidecar"]`,
+				`.spec.containers[name="sidecar"].image`,
+				`.spec.containers[name="sidecar"].name`,
+			},
+		},
+		{
+			Manager:   "autoscaler",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			Fields:    []string{`.spec.replicas`},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		manager  string
+		config   string
+		force    bool
+		conflict bool
+		expected string
+		fields   map[string][]string
+	}{
+		{
+			name:    "no change",
+			manager: "kubectl",
+			config: `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 3
+  containers:
+  - name: nginx
+    image: nginx:1.13
+`,
+			expected: live,
+			fields:   managerFields(managed),
+		},
+		{
+			name:    "conflict",
+			manager: "kubectl",
+			config: `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 5
+  containers:
+  - name: nginx
+    image: nginx:1.13
+`,
+			conflict: true,
+		},
+		{
+			name:    "force",
+			manager: "kubectl",
+			config: `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 5
+  containers:
+  - name: nginx
+    image: nginx:1.13
+`,
+			force: true,
+			expected: `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 5
+  containers:
+  - name: nginx
+    image: nginx:1.13
+  - name: sidecar
+    image: sidecar:1.0
+`,
+			fields: map[string][]string{
+				"kubectl/Apply":     managed[0].Fields,
+				"injector/Update":   managed[1].Fields,
+				"autoscaler/Update": nil,
+			},
+		},
+		{
+			name:    "removed fields",
+			manager: "kubectl",
+			config: `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 3
+`,
+			expected: `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 3
+  containers:
+  - name: sidecar
+    image: sidecar:1.0
+`,
+			fields: map[string][]string{
+				"kubectl/Apply":     {`.spec.replicas`},
+				"injector/Update":   managed[1].Fields,
+				"autoscaler/Update": managed[2].Fields,
+			},
+		},
+		{
+			name:    "new manager sharing a field",
+			manager: "other",
+			config: `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  containers:
+  - name: nginx
+    args: [--debug]
+`,
+			expected: `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 3
+  containers:
+  - name: nginx
+    image: nginx:1.13
+    args: [--debug]
+  - name: sidecar
+    image: sidecar:1.0
+`,
+			fields: map[string][]string{
+				"kubectl/Apply":     managed[0].Fields,
+				"injector/Update":   managed[1].Fields,
+				"autoscaler/Update": managed[2].Fields,
+				"other/Apply": {
+					`.spec.containers[name="nginx"]`,
+					`.spec.containers[name="nginx"].args`,
+					`.spec.containers[name="nginx"].name`,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		merged, result, err := applyFields(parse(t, live), parse(t, tc.config), testType, managed, tc.manager, "v1", now, tc.force)
+		if tc.conflict {
+			if !IsConflict(err) {
+				t.Errorf("%s: expected a conflict, got %v", tc.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if expected := parse(t, tc.expected); !valuesEqual(expected, merged) {
+			t.Errorf("%s: expected object %v, got %v", tc.name, expected, merged)
+		}
+		fields := managerFields(result)
+		for manager, expected := range tc.fields {
+			if len(expected) == 0 {
+				if _, ok := fields[manager]; ok {
+					t.Errorf("%s: expected no fields for %s, got %v", tc.name, manager, fields[manager])
+				}
+				continue
+			}
+			if !reflect.DeepEqual(expected, fields[manager]) {
+				t.Errorf("%s: expected fields %v for %s, got %v", tc.name, expected, manager, fields[manager])
+			}
+		}
+	}
+}
+
+func TestUpdateFields(t *testing.T) {
+	now := metav1.Now()
+	live := parse(t, `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 3
+  finalizers: [a]
+`)
+	managed := []metav1.ManagedFieldsEntry{
+		{
+			Manager:   "kubectl",
+			Operation: metav1.ManagedFieldsOperationApply,
+			Fields:    []string{`.spec.finalizers["a"]`, `.spec.replicas`},
+		},
+	}
+
+	obj := parse(t, `
+apiVersion: v1
+kind: Test
+metadata:
+  name: foo
+spec:
+  replicas: 5
+`)
+	result := updateFields(live, obj, testType, managed, "autoscaler", "v1", now)
+	expected := map[string][]string{
+		"autoscaler/Update": {`.spec.replicas`},
+	}
+	if fields := managerFields(result); !reflect.DeepEqual(expected, fields) {
+		t.Errorf("expected fields %v, got %v", expected, fields)
+	}
+
+	if result := updateFields(live, live, testType, managed, "autoscaler", "v1", now); !reflect.DeepEqual(managed, result) {
+		t.Errorf("expected unchanged fields %v, got %v", managed, result)
+	}
+}
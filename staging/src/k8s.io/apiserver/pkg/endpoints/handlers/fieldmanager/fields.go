@@ -0,0 +1,329 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	forkedjson "k8s.io/apimachinery/third_party/forked/golang/json"
+)
+
+// ignoredFields are the fields that are set by the system, or identify the
+// object, and are therefore never owned by a manager.
+var ignoredFields = sets.NewString(
+	".apiVersion",
+	".kind",
+	".metadata.name",
+	".metadata.namespace",
+	".metadata.generateName",
+	".metadata.selfLink",
+	".metadata.uid",
+	".metadata.resourceVersion",
+	".metadata.generation",
+	".metadata.creationTimestamp",
+	".metadata.deletionTimestamp",
+	".metadata.deletionGracePeriodSeconds",
+	".metadata.managedFields",
+)
+
+// listType describes how the items of a list are merged.
+type listType int
+
+const (
+	// atomicList lists are replaced as a whole and owned as a single field.
+	atomicList listType = iota
+	// associativeList lists are merged item by item, items being identified
+	// by the value of their merge key.
+	associativeList
+	// setList lists of scalars are merged as sets of values.
+	setList
+)
+
+// lookupField returns the type of the field called name in t, along with its
+// patch strategy and merge key. A nil type is returned if t is unknown or has
+// no such field, in which case maps are merged and lists are atomic.
+func lookupField(t reflect.Type, name string) (reflect.Type, string, string) {
+	if t == nil {
+		return nil, "", ""
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fieldType, strategy, key, err := forkedjson.LookupPatchMetadata(t, name)
+	if err != nil {
+		return nil, "", ""
+	}
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	return fieldType, strategy, key
+}
+
+// elemType returns the type of the items of the list type t, if known.
+func elemType(t reflect.Type) reflect.Type {
+	if t == nil || t.Kind() != reflect.Slice {
+		return nil
+	}
+	t = t.Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// listTypeOf returns how items should be merged into a list with the given
+// patch strategy and merge key. Lists whose items don't fit the strategy are
+// treated as atomic.
+func listTypeOf(strategy, key string, items []interface{}) listType {
+	merge := false
+	for _, s := range strings.Split(strategy, ",") {
+		if s == "merge" {
+			merge = true
+		}
+	}
+	if !merge {
+		return atomicList
+	}
+	if len(key) > 0 {
+		for _, item := range items {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				return atomicList
+			}
+			if _, ok := m[key]; !ok {
+				return atomicList
+			}
+		}
+		return associativeList
+	}
+	for _, item := range items {
+		switch item.(type) {
+		case map[string]interface{}, []interface{}, nil:
+			return atomicList
+		}
+	}
+	return setList
+}
+
+var plainFieldName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// fieldPath returns the path of the field or map key called name under parent.
+func fieldPath(parent, name string) string {
+	if plainFieldName.MatchString(name) {
+		return parent + "." + name
+	}
+	return parent + "." + strconv.Quote(name)
+}
+
+// keyPath returns the path of the item of the associative list at parent
+// whose merge key has the given value.
+func keyPath(parent, key string, value interface{}) string {
+	return parent + "[" + key + "=" + encodeValue(value) + "]"
+}
+
+// valuePath returns the path of the item of the set list at parent with the
+// given value.
+func valuePath(parent string, value interface{}) string {
+	return parent + "[" + encodeValue(value) + "]"
+}
+
+// encodeValue returns the JSON representation of value, which does not
+// depend on whether numbers were decoded as integers or floats.
+func encodeValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// valuesEqual returns true if a and b have the same JSON representation.
+func valuesEqual(a, b interface{}) bool {
+	return encodeValue(a) == encodeValue(b)
+}
+
+// fieldValues returns the paths of all the fields set in obj, mapped to their
+// values. The items of associative and set lists are included as well; they
+// are mapped to their merge key and their value respectively.
+func fieldValues(obj map[string]interface{}, t reflect.Type) map[string]interface{} {
+	values := map[string]interface{}{}
+	collectMap("", obj, t, values)
+	return values
+}
+
+func collectMap(prefix string, obj map[string]interface{}, t reflect.Type, values map[string]interface{}) {
+	for name, value := range obj {
+		path := fieldPath(prefix, name)
+		if ignoredFields.Has(path) {
+			continue
+		}
+		fieldType, strategy, key := lookupField(t, name)
+		switch v := value.(type) {
+		case nil:
+		case map[string]interface{}:
+			collectMap(path, v, fieldType, values)
+		case []interface{}:
+			switch listTypeOf(strategy, key, v) {
+			case associativeList:
+				for _, item := range v {
+					m := item.(map[string]interface{})
+					itemPath := keyPath(path, key, m[key])
+					values[itemPath] = m[key]
+					collectMap(itemPath, m, elemType(fieldType), values)
+				}
+			case setList:
+				for _, item := range v {
+					values[valuePath(path, item)] = item
+				}
+			default:
+				values[path] = v
+			}
+		default:
+			values[path] = v
+		}
+	}
+}
+
+// mergeMap returns the result of merging config into live. Maps are merged
+// recursively, associative and set lists item by item, and all other values
+// are replaced. Neither live nor config are modified, but the result shares
+// the values of live that are not merged.
+func mergeMap(live, config map[string]interface{}, t reflect.Type) map[string]interface{} {
+	merged := make(map[string]interface{}, len(live))
+	for name, value := range live {
+		merged[name] = value
+	}
+	for name, value := range config {
+		fieldType, strategy, key := lookupField(t, name)
+		merged[name] = mergeValue(merged[name], value, fieldType, strategy, key)
+	}
+	return merged
+}
+
+func mergeValue(live, config interface{}, t reflect.Type, strategy, key string) interface{} {
+	switch c := config.(type) {
+	case map[string]interface{}:
+		if l, ok := live.(map[string]interface{}); ok {
+			return mergeMap(l, c, t)
+		}
+	case []interface{}:
+		if l, ok := live.([]interface{}); ok {
+			return mergeList(l, c, t, strategy, key)
+		}
+	}
+	return config
+}
+
+func mergeList(live, config []interface{}, t reflect.Type, strategy, key string) []interface{} {
+	lt := listTypeOf(strategy, key, live)
+	if lt == atomicList || lt != listTypeOf(strategy, key, config) {
+		return config
+	}
+	merged := make([]interface{}, 0, len(live)+len(config))
+	if lt == setList {
+		seen := sets.NewString()
+		for _, item := range append(append([]interface{}{}, live...), config...) {
+			if id := encodeValue(item); !seen.Has(id) {
+				seen.Insert(id)
+				merged = append(merged, item)
+			}
+		}
+		return merged
+	}
+
+	configItems := map[string]map[string]interface{}{}
+	for _, item := range config {
+		m := item.(map[string]interface{})
+		configItems[encodeValue(m[key])] = m
+	}
+	seen := sets.NewString()
+	for _, item := range live {
+		m := item.(map[string]interface{})
+		id := encodeValue(m[key])
+		seen.Insert(id)
+		if c, ok := configItems[id]; ok {
+			merged = append(merged, mergeMap(m, c, elemType(t)))
+		} else {
+			merged = append(merged, m)
+		}
+	}
+	for _, item := range config {
+		m := item.(map[string]interface{})
+		if id := encodeValue(m[key]); !seen.Has(id) {
+			seen.Insert(id)
+			merged = append(merged, m)
+		}
+	}
+	return merged
+}
+
+// removeFields removes from obj, in place, the fields and list items whose
+// path is accepted by remove.
+func removeFields(obj map[string]interface{}, t reflect.Type, remove func(path string) bool) {
+	removeFromMap("", obj, t, remove)
+}
+
+func removeFromMap(prefix string, obj map[string]interface{}, t reflect.Type, remove func(string) bool) {
+	for name, value := range obj {
+		path := fieldPath(prefix, name)
+		if remove(path) {
+			delete(obj, name)
+			continue
+		}
+		fieldType, strategy, key := lookupField(t, name)
+		switch v := value.(type) {
+		case map[string]interface{}:
+			removeFromMap(path, v, fieldType, remove)
+		case []interface{}:
+			obj[name] = removeFromList(path, v, fieldType, strategy, key, remove)
+		}
+	}
+}
+
+func removeFromList(prefix string, items []interface{}, t reflect.Type, strategy, key string, remove func(string) bool) []interface{} {
+	lt := listTypeOf(strategy, key, items)
+	if lt == atomicList {
+		return items
+	}
+	kept := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		if lt == setList {
+			if !remove(valuePath(prefix, item)) {
+				kept = append(kept, item)
+			}
+			continue
+		}
+		m := item.(map[string]interface{})
+		itemPath := keyPath(prefix, key, m[key])
+		if remove(itemPath) {
+			continue
+		}
+		// the merge key of an item that is kept must never be removed
+		keyField := fieldPath(itemPath, key)
+		removeFromMap(itemPath, m, elemType(t), func(path string) bool {
+			return path != keyField && remove(path)
+		})
+		kept = append(kept, m)
+	}
+	return kept
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldmanager
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// CauseTypeFieldManagerConflict is the type of the causes of the error
+// returned when an apply changes fields that are owned by other managers.
+const CauseTypeFieldManagerConflict metav1.CauseType = "FieldManagerConflict"
+
+// conflict is a field whose value an applier tried to change while it is
+// owned by another manager.
+type conflict struct {
+	manager string
+	path    string
+}
+
+// newConflictError returns a Conflict error listing the given conflicts.
+func newConflictError(conflicts []conflict) error {
+	causes := make([]metav1.StatusCause, 0, len(conflicts))
+	messages := make([]string, 0, len(conflicts))
+	for _, c := range conflicts {
+		causes = append(causes, metav1.StatusCause{
+			Type:    CauseTypeFieldManagerConflict,
+			Message: fmt.Sprintf("conflict with %q", c.manager),
+			Field:   c.path,
+		})
+		messages = append(messages, fmt.Sprintf("conflict with %q: %s", c.manager, c.path))
+	}
+	return &errors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusConflict,
+		Reason:  metav1.StatusReasonConflict,
+		Details: &metav1.StatusDetails{Causes: causes},
+		Message: fmt.Sprintf("Apply failed with %d conflict(s): %s", len(conflicts), strings.Join(messages, ", ")),
+	}}
+}
+
+// IsConflict returns true if err was returned because an apply tried to
+// change fields owned by other managers.
+func IsConflict(err error) bool {
+	status, ok := err.(errors.APIStatus)
+	if !ok || status.Status().Reason != metav1.StatusReasonConflict || status.Status().Details == nil {
+		return false
+	}
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type == CauseTypeFieldManagerConflict {
+			return true
+		}
+	}
+	return false
+}
+
+// ownedUnder returns true if path, or any field below it, is in owned.
+func ownedUnder(owned sets.String, path string) bool {
+	for field := range owned {
+		if field == path || strings.HasPrefix(field, path+".") || strings.HasPrefix(field, path+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// applyFields merges config into live on behalf of manager and returns the
+// merged object along with the updated managed fields.
+//
+// Applying a value to a field owned by another manager is a conflict, unless
+// the field already has that value, in which case the ownership is shared.
+// With force, conflicting fields are taken over from their owners instead.
+// Fields that manager applied before but are missing from config are removed
+// from the object, unless another manager also owns them.
+func applyFields(live, config map[string]interface{}, t reflect.Type, managed []metav1.ManagedFieldsEntry, manager, apiVersion string, now metav1.Time, force bool) (map[string]interface{}, []metav1.ManagedFieldsEntry, error) {
+	liveValues := fieldValues(live, t)
+	configValues := fieldValues(config, t)
+	applied := sets.StringKeySet(configValues)
+
+	conflicting := sets.NewString()
+	var conflicts []conflict
+	for _, entry := range managed {
+		if entry.Manager == manager {
+			continue
+		}
+		for _, path := range entry.Fields {
+			configValue, ok := configValues[path]
+			if !ok {
+				continue
+			}
+			if liveValue, ok := liveValues[path]; !ok || !valuesEqual(liveValue, configValue) {
+				conflicting.Insert(path)
+				conflicts = append(conflicts, conflict{manager: entry.Manager, path: path})
+			}
+		}
+	}
+	if len(conflicts) > 0 && !force {
+		return nil, nil, newConflictError(conflicts)
+	}
+
+	previous := sets.NewString()
+	owned := sets.NewString()
+	index := -1
+	result := make([]metav1.ManagedFieldsEntry, 0, len(managed)+1)
+	for _, entry := range managed {
+		fields := sets.NewString(entry.Fields...)
+		switch {
+		case entry.Manager == manager && entry.Operation == metav1.ManagedFieldsOperationApply:
+			previous = fields
+			index = len(result)
+			continue
+		case entry.Manager == manager:
+			// the fields this manager updated before are now applied
+			fields = fields.Difference(applied)
+		default:
+			fields = fields.Difference(conflicting)
+		}
+		if fields.Len() == 0 {
+			continue
+		}
+		owned = owned.Union(fields)
+		entry.Fields = fields.List()
+		result = append(result, entry)
+	}
+
+	merged := mergeMap(live, config, t)
+	removed := previous.Difference(applied)
+	if removed.Len() > 0 {
+		removeFields(merged, t, func(path string) bool {
+			return removed.Has(path) && !ownedUnder(owned, path)
+		})
+	}
+
+	if applied.Len() > 0 {
+		entry := metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  metav1.ManagedFieldsOperationApply,
+			APIVersion: apiVersion,
+			Time:       &now,
+			Fields:     applied.List(),
+		}
+		if index < 0 {
+			index = len(result)
+		}
+		result = append(result[:index], append([]metav1.ManagedFieldsEntry{entry}, result[index:]...)...)
+	}
+	return merged, result, nil
+}
+
+// updateFields returns the managed fields of obj, a new version of live
+// written by manager. The fields manager changed become owned by it alone,
+// and the fields it removed are not owned by anyone anymore.
+func updateFields(live, obj map[string]interface{}, t reflect.Type, managed []metav1.ManagedFieldsEntry, manager, apiVersion string, now metav1.Time) []metav1.ManagedFieldsEntry {
+	liveValues := fieldValues(live, t)
+	newValues := fieldValues(obj, t)
+	changed := sets.NewString()
+	for path, value := range newValues {
+		if liveValue, ok := liveValues[path]; !ok || !valuesEqual(liveValue, value) {
+			changed.Insert(path)
+		}
+	}
+	removed := sets.NewString()
+	for path := range liveValues {
+		if _, ok := newValues[path]; !ok {
+			removed.Insert(path)
+		}
+	}
+	if changed.Len() == 0 && removed.Len() == 0 {
+		return managed
+	}
+
+	found := false
+	result := make([]metav1.ManagedFieldsEntry, 0, len(managed)+1)
+	for _, entry := range managed {
+		fields := sets.NewString(entry.Fields...).Difference(removed)
+		if entry.Manager == manager && entry.Operation == metav1.ManagedFieldsOperationUpdate {
+			found = true
+			if changed.Len() > 0 {
+				fields = fields.Union(changed)
+				entry.APIVersion = apiVersion
+				entry.Time = &now
+			}
+		} else {
+			fields = fields.Difference(changed)
+		}
+		if fields.Len() == 0 {
+			continue
+		}
+		entry.Fields = fields.List()
+		result = append(result, entry)
+	}
+	if !found && changed.Len() > 0 {
+		result = append(result, metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: apiVersion,
+			Time:       &now,
+			Fields:     changed.List(),
+		})
+	}
+	return result
+}
@@ -24,6 +24,7 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +44,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/endpoints/handlers/fieldmanager"
 	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 	"k8s.io/apiserver/pkg/endpoints/request"
@@ -93,6 +95,11 @@ type RequestScope struct {
 	Subresource string
 
 	MetaGroupVersion schema.GroupVersion
+
+	// FieldManager records the managers of the fields of the objects written
+	// through this scope, and handles apply patches. It is nil if the
+	// resource does not support server-side apply.
+	FieldManager *fieldmanager.FieldManager
 }
 
 func (scope *RequestScope) err(err error, w http.ResponseWriter, req *http.Request) {
@@ -422,6 +429,19 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 			}
 		}
 
+		// The fields set by admission are owned by the manager too.
+		if scope.FieldManager != nil {
+			manager, err := fieldManagerName(req.Request)
+			if err != nil {
+				scope.err(err, res.ResponseWriter, req.Request)
+				return
+			}
+			if obj, err = scope.FieldManager.Update(nil, obj, manager); err != nil {
+				scope.err(err, res.ResponseWriter, req.Request)
+				return
+			}
+		}
+
 		trace.Step("About to store object in database")
 		result, err := finishRequest(timeout, func() (runtime.Object, error) {
 			out, err := r.Create(ctx, name, obj)
@@ -497,6 +517,25 @@ func PatchResource(r rest.Patcher, scope RequestScope, admit admission.Interface
 			contentType = contentType[:idx]
 		}
 		patchType := types.PatchType(contentType)
+		if patchType == types.ApplyPatchType && scope.FieldManager == nil {
+			scope.err(errors.NewBadRequest(fmt.Sprintf("apply is not supported for %s", scope.Resource.Resource)), res.ResponseWriter, req.Request)
+			return
+		}
+
+		var (
+			manager string
+			force   bool
+		)
+		if scope.FieldManager != nil {
+			if manager, err = fieldManagerName(req.Request); err != nil {
+				scope.err(err, res.ResponseWriter, req.Request)
+				return
+			}
+			if force, err = parseForce(req.Request); err != nil {
+				scope.err(err, res.ResponseWriter, req.Request)
+				return
+			}
+		}
 
 		patchJS, err := readBody(req.Request)
 		if err != nil {
@@ -525,7 +564,16 @@ func PatchResource(r rest.Patcher, scope RequestScope, admit admission.Interface
 		}
 
 		result, err := patchResource(ctx, updateAdmit, timeout, versionedObj, r, name, patchType, patchJS,
-			scope.Namer, scope.Copier, scope.Creater, scope.UnsafeConvertor, scope.Kind, scope.Resource, codec)
+			scope.Namer, scope.Copier, scope.Creater, scope.UnsafeConvertor, scope.Kind, scope.Resource, codec,
+			scope.FieldManager, manager, force)
+		status := http.StatusOK
+		if errors.IsNotFound(err) && patchType == types.ApplyPatchType {
+			// applying a configuration to an object that does not exist creates it
+			if creater, ok := r.(rest.Creater); ok {
+				result, err = applyCreate(ctx, creater, scope, admit, timeout, namespace, name, patchJS, manager)
+				status = http.StatusCreated
+			}
+		}
 		if err != nil {
 			scope.err(err, res.ResponseWriter, req.Request)
 			return
@@ -536,13 +584,42 @@ func PatchResource(r rest.Patcher, scope RequestScope, admit admission.Interface
 			return
 		}
 
-		responsewriters.WriteObject(http.StatusOK, scope.Kind.GroupVersion(), scope.Serializer, result, w, req.Request)
+		responsewriters.WriteObject(status, scope.Kind.GroupVersion(), scope.Serializer, result, w, req.Request)
 	}
 
 }
 
 type updateAdmissionFunc func(updatedObject runtime.Object, currentObject runtime.Object) error
 
+// applyCreate creates the object described by the applied configuration in
+// patchJS, on behalf of manager.
+func applyCreate(ctx request.Context, r rest.Creater, scope RequestScope, admit admission.Interface, timeout time.Duration, namespace, name string, patchJS []byte, manager string) (runtime.Object, error) {
+	obj, err := scope.FieldManager.Apply(r.New(), patchJS, manager, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkName(obj, name, namespace, scope.Namer); err != nil {
+		return nil, err
+	}
+	if admit != nil && admit.Handles(admission.Create) {
+		applied, err := scope.Copier.Copy(obj)
+		if err != nil {
+			return nil, err
+		}
+		userInfo, _ := request.UserFrom(ctx)
+		if err := admit.Admit(admission.NewAttributesRecord(obj, nil, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Create, userInfo)); err != nil {
+			return nil, err
+		}
+		// The fields set by admission are owned by the manager too.
+		if obj, err = scope.FieldManager.Update(applied, obj, manager); err != nil {
+			return nil, err
+		}
+	}
+	return finishRequest(timeout, func() (runtime.Object, error) {
+		return r.Create(ctx, obj)
+	})
+}
+
 // patchResource divides PatchResource for easier unit testing
 func patchResource(
 	ctx request.Context,
@@ -560,6 +637,9 @@ func patchResource(
 	kind schema.GroupVersionKind,
 	resource schema.GroupVersionResource,
 	codec runtime.Codec,
+	fieldManager *fieldmanager.FieldManager,
+	manager string,
+	force bool,
 ) (runtime.Object, error) {
 
 	namespace := request.NamespaceValue(ctx)
@@ -571,6 +651,7 @@ func patchResource(
 		originalPatchMap        map[string]interface{}
 		lastConflictErr         error
 		originalResourceVersion string
+		appliedObject           runtime.Object
 	)
 
 	// applyPatch is called every time GuaranteedUpdate asks for the updated object,
@@ -583,6 +664,23 @@ func patchResource(
 			return nil, errors.NewNotFound(resource.GroupResource(), name)
 		}
 
+		if patchType == types.ApplyPatchType {
+			// an applied configuration is merged into the current object
+			// every time, so conflicting changes need no special handling
+			objToUpdate, err := fieldManager.Apply(currentObject, patchJS, manager, force)
+			if err != nil {
+				return nil, err
+			}
+			if err := checkName(objToUpdate, name, namespace, namer); err != nil {
+				return nil, err
+			}
+			// keep the applied object to track the changes made by admission
+			if appliedObject, err = copier.Copy(objToUpdate); err != nil {
+				return nil, err
+			}
+			return objToUpdate, nil
+		}
+
 		currentResourceVersion := ""
 		if currentMetadata, err := meta.Accessor(currentObject); err == nil {
 			currentResourceVersion = currentMetadata.GetResourceVersion()
@@ -736,11 +834,23 @@ func patchResource(
 		return patchedObject, admit(patchedObject, currentObject)
 	}
 
-	updatedObjectInfo := rest.DefaultUpdatedObjectInfo(nil, copier, applyPatch, applyAdmission)
+	transformers := []rest.TransformFunc{applyPatch, applyAdmission}
+	// The fields set by admission are owned by the manager too.
+	if fieldManager != nil {
+		transformers = append(transformers, func(_ request.Context, admittedObject runtime.Object, currentObject runtime.Object) (runtime.Object, error) {
+			if patchType == types.ApplyPatchType {
+				// the applied fields are already owned by the manager
+				currentObject = appliedObject
+			}
+			return fieldManager.Update(currentObject, admittedObject, manager)
+		})
+	}
+	updatedObjectInfo := rest.DefaultUpdatedObjectInfo(nil, copier, transformers...)
 
 	return finishRequest(timeout, func() (runtime.Object, error) {
 		updateObject, _, updateErr := patcher.Update(ctx, name, updatedObjectInfo)
-		for i := 0; i < maxRetryWhenPatchConflicts && (errors.IsConflict(updateErr)); i++ {
+		// conflicts with the managers of fields are not resolved by retrying
+		for i := 0; i < maxRetryWhenPatchConflicts && errors.IsConflict(updateErr) && !fieldmanager.IsConflict(updateErr); i++ {
 			lastConflictErr = updateErr
 			updateObject, _, updateErr = patcher.Update(ctx, name, updatedObjectInfo)
 		}
@@ -807,6 +917,17 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 				return newObj, admit.Admit(admission.NewAttributesRecord(newObj, oldObj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo))
 			})
 		}
+		// The fields set by admission are owned by the manager too.
+		if scope.FieldManager != nil {
+			manager, err := fieldManagerName(req.Request)
+			if err != nil {
+				scope.err(err, res.ResponseWriter, req.Request)
+				return
+			}
+			transformers = append(transformers, func(_ request.Context, newObj, oldObj runtime.Object) (runtime.Object, error) {
+				return scope.FieldManager.Update(oldObj, newObj, manager)
+			})
+		}
 
 		trace.Step("About to store object in database")
 		wasCreated := false
@@ -1191,6 +1312,39 @@ func summarizeData(data []byte, maxLength int) string {
 	}
 }
 
+// maxFieldManagerLength is the maximum length of the name of a field manager.
+const maxFieldManagerLength = 128
+
+// fieldManagerName returns the name of the manager of the fields written by
+// req: the fieldManager query parameter, or else the product name of the
+// user agent.
+func fieldManagerName(req *http.Request) (string, error) {
+	manager := req.URL.Query().Get("fieldManager")
+	if len(manager) == 0 {
+		manager = strings.TrimSpace(strings.SplitN(req.UserAgent(), "/", 2)[0])
+	}
+	if len(manager) == 0 {
+		manager = "unknown"
+	}
+	if len(manager) > maxFieldManagerLength {
+		return "", errors.NewBadRequest(fmt.Sprintf("fieldManager must not be longer than %d characters", maxFieldManagerLength))
+	}
+	return manager, nil
+}
+
+// parseForce returns the value of the force query parameter of req.
+func parseForce(req *http.Request) (bool, error) {
+	value := req.URL.Query().Get("force")
+	if len(value) == 0 {
+		return false, nil
+	}
+	force, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.NewBadRequest(fmt.Sprintf("invalid value for force: %q", value))
+	}
+	return force, nil
+}
+
 func readBody(req *http.Request) ([]byte, error) {
 	defer req.Body.Close()
 	return ioutil.ReadAll(req.Body)
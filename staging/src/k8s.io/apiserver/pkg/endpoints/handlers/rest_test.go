@@ -34,6 +34,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/diff"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/endpoints/handlers/fieldmanager"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/client-go/pkg/api"
@@ -247,7 +249,7 @@ func (tc *patchTestCase) Run(t *testing.T) {
 
 		}
 
-		resultObj, err := patchResource(ctx, admit, 1*time.Second, versionedObj, testPatcher, name, patchType, patch, namer, copier, creater, convertor, kind, resource, codec)
+		resultObj, err := patchResource(ctx, admit, 1*time.Second, versionedObj, testPatcher, name, patchType, patch, namer, copier, creater, convertor, kind, resource, codec, nil, "", false)
 		if len(tc.expectedError) != 0 {
 			if err == nil || err.Error() != tc.expectedError {
 				t.Errorf("%s: expected error %v, but got %v", tc.name, tc.expectedError, err)
@@ -462,6 +464,145 @@ func TestPatchWithVersionConflictThenAdmissionFailure(t *testing.T) {
 	tc.Run(t)
 }
 
+// nodeNameAdmission is a mutating admission plugin that sets the node name of
+// pods.
+type nodeNameAdmission struct{}
+
+func (nodeNameAdmission) Admit(a admission.Attributes) error {
+	a.GetObject().(*api.Pod).Spec.NodeName = "admitted"
+	return nil
+}
+
+func (nodeNameAdmission) Handles(operation admission.Operation) bool {
+	return true
+}
+
+type testCreater struct{}
+
+func (testCreater) New() runtime.Object {
+	return &api.Pod{}
+}
+
+func (testCreater) Create(ctx request.Context, obj runtime.Object) (runtime.Object, error) {
+	return obj, nil
+}
+
+func newTestFieldManager() *fieldmanager.FieldManager {
+	kind := schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+	return fieldmanager.NewFieldManager(api.Scheme, api.Codecs.UniversalDecoder(), &v1.Pod{}, kind)
+}
+
+// managedFields returns the fields of obj owned by manager through operation.
+func managedFields(obj runtime.Object, manager string, operation metav1.ManagedFieldsOperationType) []string {
+	for _, entry := range obj.(*api.Pod).ManagedFields {
+		if entry.Manager == manager && entry.Operation == operation {
+			return entry.Fields
+		}
+	}
+	return nil
+}
+
+func hasField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPatchTracksAdmissionChanges(t *testing.T) {
+	namespace := "bar"
+	name := "foo"
+	fifteen := int64(15)
+
+	testCases := []struct {
+		patchType types.PatchType
+		patch     string
+		// operation is the operation through which the manager owns the
+		// patched field
+		operation metav1.ManagedFieldsOperationType
+	}{
+		{
+			patchType: types.MergePatchType,
+			patch:     `{"spec":{"activeDeadlineSeconds":30}}`,
+			operation: metav1.ManagedFieldsOperationUpdate,
+		},
+		{
+			patchType: types.StrategicMergePatchType,
+			patch:     `{"spec":{"activeDeadlineSeconds":30}}`,
+			operation: metav1.ManagedFieldsOperationUpdate,
+		},
+		{
+			patchType: types.ApplyPatchType,
+			patch:     `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"foo"},"spec":{"activeDeadlineSeconds":30}}`,
+			operation: metav1.ManagedFieldsOperationApply,
+		},
+	}
+	for _, tc := range testCases {
+		pod := &api.Pod{}
+		pod.Name = name
+		pod.Namespace = namespace
+		pod.UID = types.UID("uid")
+		pod.ResourceVersion = "1"
+		pod.Spec.ActiveDeadlineSeconds = &fifteen
+
+		ctx := request.WithNamespace(request.NewDefaultContext(), namespace)
+		admit := func(updatedObject runtime.Object, currentObject runtime.Object) error {
+			return nodeNameAdmission{}.Admit(admission.NewAttributesRecord(updatedObject, currentObject, schema.GroupVersionKind{}, namespace, name, schema.GroupVersionResource{}, "", admission.Update, nil))
+		}
+		patcher := &testPatcher{t: t, startingPod: pod, updatePod: pod}
+		codec := api.Codecs.LegacyCodec(schema.GroupVersion{Version: "v1"})
+		kind := schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+		resource := schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+
+		result, err := patchResource(ctx, admit, 1*time.Second, &v1.Pod{}, patcher, name, tc.patchType, []byte(tc.patch),
+			&testNamer{namespace, name}, api.Scheme, api.Scheme, api.Scheme, kind, resource, codec,
+			newTestFieldManager(), "manager", false)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.patchType, err)
+			continue
+		}
+		if nodeName := result.(*api.Pod).Spec.NodeName; nodeName != "admitted" {
+			t.Errorf("%s: expected the node name set by admission, got %q", tc.patchType, nodeName)
+		}
+		if fields := managedFields(result, "manager", tc.operation); !hasField(fields, ".spec.activeDeadlineSeconds") {
+			t.Errorf("%s: expected the patched field to be owned by the manager, got %v", tc.patchType, fields)
+		}
+		if fields := managedFields(result, "manager", metav1.ManagedFieldsOperationUpdate); !hasField(fields, ".spec.nodeName") {
+			t.Errorf("%s: expected the field set by admission to be owned by the manager, got %v", tc.patchType, fields)
+		}
+	}
+}
+
+func TestApplyCreateTracksAdmissionChanges(t *testing.T) {
+	namespace := "bar"
+	name := "foo"
+	scope := RequestScope{
+		Namer:        &testNamer{namespace, name},
+		Copier:       api.Scheme,
+		Kind:         schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"},
+		Resource:     schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"},
+		FieldManager: newTestFieldManager(),
+	}
+	ctx := request.WithNamespace(request.NewDefaultContext(), namespace)
+	patch := []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"foo"},"spec":{"activeDeadlineSeconds":30}}`)
+
+	result, err := applyCreate(ctx, testCreater{}, scope, nodeNameAdmission{}, 1*time.Second, namespace, name, patch, "manager")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nodeName := result.(*api.Pod).Spec.NodeName; nodeName != "admitted" {
+		t.Errorf("expected the node name set by admission, got %q", nodeName)
+	}
+	if fields := managedFields(result, "manager", metav1.ManagedFieldsOperationApply); !hasField(fields, ".spec.activeDeadlineSeconds") {
+		t.Errorf("expected the applied field to be owned by the manager, got %v", fields)
+	}
+	if fields := managedFields(result, "manager", metav1.ManagedFieldsOperationUpdate); !hasField(fields, ".spec.nodeName") {
+		t.Errorf("expected the field set by admission to be owned by the manager, got %v", fields)
+	}
+}
+
 func TestHasUID(t *testing.T) {
 	testcases := []struct {
 		obj    runtime.Object
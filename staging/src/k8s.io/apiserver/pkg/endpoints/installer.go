@@ -36,10 +36,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apiserver/pkg/endpoints/handlers"
+	"k8s.io/apiserver/pkg/endpoints/handlers/fieldmanager"
 	"k8s.io/apiserver/pkg/endpoints/handlers/negotiation"
 	"k8s.io/apiserver/pkg/endpoints/metrics"
 	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/features"
 	"k8s.io/apiserver/pkg/registry/rest"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	"github.com/emicklei/go-restful"
 )
@@ -82,6 +85,10 @@ var toDiscoveryKubeVerb = map[string]string{
 // errEmptyName is returned when API requests do not fill the name section of the path.
 var errEmptyName = errors.NewBadRequest("name must be provided")
 
+// fieldManagerParamDoc documents the query parameter naming the manager of
+// the fields written by a request.
+const fieldManagerParamDoc = "The name of the manager of the fields set by this request. Defaults to the product name of the user agent."
+
 // Installs handlers for API resources.
 func (a *APIInstaller) Install(ws *restful.WebService) (apiResources []metav1.APIResource, errors []error) {
 	errors = make([]error, 0)
@@ -535,6 +542,15 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 	if a.group.MetaGroupVersion != nil {
 		reqScope.MetaGroupVersion = *a.group.MetaGroupVersion
 	}
+	// the fields of objects are tracked on the resource itself and its status
+	if utilfeature.DefaultFeatureGate.Enabled(features.ServerSideApply) && (!hasSubresource || subresource == "status") {
+		s, ok := runtime.SerializerInfoForMediaType(a.group.Serializer.SupportedMediaTypes(), runtime.ContentTypeJSON)
+		if !ok {
+			return nil, fmt.Errorf("no serializer defined for JSON")
+		}
+		decoder := a.group.Serializer.DecoderToVersion(s.Serializer, schema.GroupVersion{Group: fqKindToRegister.Group, Version: runtime.APIVersionInternal})
+		reqScope.FieldManager = fieldmanager.NewFieldManager(a.group.Convertor, decoder, versionedPtr, fqKindToRegister)
+	}
 	for _, action := range actions {
 		versionedObject := storageMeta.ProducesObject(action.Verb)
 		if versionedObject == nil {
@@ -640,6 +656,9 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 				Returns(http.StatusOK, "OK", versionedObject).
 				Reads(versionedObject).
 				Writes(versionedObject)
+			if reqScope.FieldManager != nil {
+				route.Param(ws.QueryParameter("fieldManager", fieldManagerParamDoc))
+			}
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "PATCH": // Partially update a resource
@@ -648,15 +667,23 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 				doc = "partially update " + subresource + " of the specified " + kind
 			}
 			handler := metrics.InstrumentRouteFunc(action.Verb, resource, handlers.PatchResource(patcher, reqScope, admit, mapping.ObjectConvertor))
+			supportedTypes := []string{string(types.JSONPatchType), string(types.MergePatchType), string(types.StrategicMergePatchType)}
+			if reqScope.FieldManager != nil {
+				supportedTypes = append(supportedTypes, string(types.ApplyPatchType))
+			}
 			route := ws.PATCH(action.Path).To(handler).
 				Doc(doc).
 				Param(ws.QueryParameter("pretty", "If 'true', then the output is pretty printed.")).
-				Consumes(string(types.JSONPatchType), string(types.MergePatchType), string(types.StrategicMergePatchType)).
+				Consumes(supportedTypes...).
 				Operation("patch"+namespaced+kind+strings.Title(subresource)+operationSuffix).
 				Produces(append(storageMeta.ProducesMIMETypes(action.Verb), mediaTypes...)...).
 				Returns(http.StatusOK, "OK", versionedObject).
 				Reads(metav1.Patch{}).
 				Writes(versionedObject)
+			if reqScope.FieldManager != nil {
+				route.Param(ws.QueryParameter("fieldManager", fieldManagerParamDoc))
+				route.Param(ws.QueryParameter("force", "If 'true', an apply takes over the fields owned by other managers instead of failing with a conflict.").DataType("boolean"))
+			}
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "POST": // Create a resource.
@@ -680,6 +707,9 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 				Returns(http.StatusOK, "OK", versionedObject).
 				Reads(versionedObject).
 				Writes(versionedObject)
+			if reqScope.FieldManager != nil {
+				route.Param(ws.QueryParameter("fieldManager", fieldManagerParamDoc))
+			}
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "DELETE": // Delete a resource.
@@ -0,0 +1,158 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cached provides a discovery client that caches the discovery
+// information in memory, for long running processes such as controllers.
+package cached
+
+import (
+	"sync"
+
+	"github.com/emicklei/go-restful/swagger"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	restclient "k8s.io/client-go/rest"
+)
+
+// memCacheClient keeps the server groups and the resources of each group
+// version in memory once they were retrieved, until it is invalidated.
+type memCacheClient struct {
+	delegate discovery.DiscoveryInterface
+
+	// lock protects the variables below
+	lock sync.Mutex
+
+	groupList              *metav1.APIGroupList
+	groupToServerResources map[string]*metav1.APIResourceList
+	// fresh is true if no cached data was used since the last invalidation
+	fresh bool
+}
+
+var _ discovery.CachedDiscoveryInterface = &memCacheClient{}
+
+// NewMemCacheClient returns a CachedDiscoveryInterface which caches the
+// discovery information retrieved through delegate in memory.
+func NewMemCacheClient(delegate discovery.DiscoveryInterface) discovery.CachedDiscoveryInterface {
+	return &memCacheClient{
+		delegate:               delegate,
+		groupToServerResources: map[string]*metav1.APIResourceList{},
+		fresh:                  true,
+	}
+}
+
+// ServerResourcesForGroupVersion returns the supported resources for a group and version.
+func (d *memCacheClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	d.lock.Lock()
+	cachedResources, ok := d.groupToServerResources[groupVersion]
+	if ok {
+		d.fresh = false
+	}
+	d.lock.Unlock()
+	if ok {
+		return cachedResources, nil
+	}
+
+	liveResources, err := d.delegate.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return liveResources, err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.groupToServerResources[groupVersion] = liveResources
+	return liveResources, nil
+}
+
+// ServerResources returns the supported resources for all groups and versions.
+func (d *memCacheClient) ServerResources() ([]*metav1.APIResourceList, error) {
+	apiGroups, err := d.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	groupVersions := metav1.ExtractGroupVersions(apiGroups)
+	result := []*metav1.APIResourceList{}
+	for _, groupVersion := range groupVersions {
+		resources, err := d.ServerResourcesForGroupVersion(groupVersion)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resources)
+	}
+	return result, nil
+}
+
+// ServerGroups returns the supported groups, with information like supported versions and the
+// preferred version.
+func (d *memCacheClient) ServerGroups() (*metav1.APIGroupList, error) {
+	d.lock.Lock()
+	cachedGroups := d.groupList
+	if cachedGroups != nil {
+		d.fresh = false
+	}
+	d.lock.Unlock()
+	if cachedGroups != nil {
+		return cachedGroups, nil
+	}
+
+	liveGroups, err := d.delegate.ServerGroups()
+	if err != nil {
+		return liveGroups, err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.groupList = liveGroups
+	return liveGroups, nil
+}
+
+func (d *memCacheClient) RESTClient() restclient.Interface {
+	return d.delegate.RESTClient()
+}
+
+func (d *memCacheClient) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return d.delegate.ServerPreferredResources()
+}
+
+func (d *memCacheClient) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	return d.delegate.ServerPreferredNamespacedResources()
+}
+
+func (d *memCacheClient) ServerVersion() (*version.Info, error) {
+	return d.delegate.ServerVersion()
+}
+
+func (d *memCacheClient) SwaggerSchema(version schema.GroupVersion) (*swagger.ApiDeclaration, error) {
+	return d.delegate.SwaggerSchema(version)
+}
+
+func (d *memCacheClient) Fresh() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.fresh
+}
+
+// Invalidate drops the cached discovery information, it is retrieved again
+// the next time it is requested.
+func (d *memCacheClient) Invalidate() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.groupList = nil
+	d.groupToServerResources = map[string]*metav1.APIResourceList{}
+	d.fresh = true
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cached
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+type fakeDiscovery struct {
+	*fake.FakeDiscovery
+
+	groupCalls    int
+	resourceCalls int
+}
+
+func (d *fakeDiscovery) ServerGroups() (*metav1.APIGroupList, error) {
+	d.groupCalls++
+	return &metav1.APIGroupList{
+		Groups: []metav1.APIGroup{
+			{
+				Name:     "a",
+				Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "a/v1", Version: "v1"}},
+			},
+		},
+	}, nil
+}
+
+func (d *fakeDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	d.resourceCalls++
+	return &metav1.APIResourceList{
+		GroupVersion: groupVersion,
+		APIResources: []metav1.APIResource{{Name: "widgets", Kind: "Widget", Namespaced: true}},
+	}, nil
+}
+
+func TestMemCacheClient(t *testing.T) {
+	delegate := &fakeDiscovery{FakeDiscovery: &fake.FakeDiscovery{Fake: &clienttesting.Fake{}}}
+	c := NewMemCacheClient(delegate)
+
+	for i := 0; i < 2; i++ {
+		resources, err := c.ServerResources()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resources) != 1 || resources[0].GroupVersion != "a/v1" {
+			t.Errorf("unexpected resources: %v", resources)
+		}
+	}
+	if delegate.groupCalls != 1 || delegate.resourceCalls != 1 {
+		t.Errorf("expected the discovery information to be retrieved once, got %d group and %d resource calls", delegate.groupCalls, delegate.resourceCalls)
+	}
+	if c.Fresh() {
+		t.Errorf("expected cached data to have been used")
+	}
+
+	c.Invalidate()
+	if !c.Fresh() {
+		t.Errorf("expected the client to be fresh after invalidation")
+	}
+	if _, err := c.ServerResources(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if delegate.groupCalls != 2 || delegate.resourceCalls != 2 {
+		t.Errorf("expected the discovery information to be retrieved again, got %d group and %d resource calls", delegate.groupCalls, delegate.resourceCalls)
+	}
+}
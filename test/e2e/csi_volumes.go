@@ -0,0 +1,175 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/kubernetes/pkg/api/v1"
+	rbacv1beta1 "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+	storage "k8s.io/kubernetes/pkg/apis/storage/v1beta1"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubernetes/test/e2e/framework"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	csiMockDriverName  = "mock.csi.k8s.io"
+	csiMockDriverImage = "gcr.io/google_containers/mock-csi-driver:0.1"
+)
+
+// The tests need the CSIPersistentVolume feature enabled in the cluster and
+// the kubelet directory shared with the containers of the node, so that the
+// mounts made by the driver pod are visible to the kubelet.
+var _ = framework.KubeDescribe("CSI Volumes [Feature:CSI]", func() {
+	f := framework.NewDefaultFramework("csi-mock-driver")
+
+	var (
+		cs   clientset.Interface
+		ns   string
+		node string
+	)
+
+	BeforeEach(func() {
+		cs = f.ClientSet
+		ns = f.Namespace.Name
+		nodes := framework.GetReadySchedulableNodesOrDie(cs)
+		Expect(len(nodes.Items)).NotTo(BeZero())
+		node = nodes.Items[0].Name
+
+		// The external attacher updates VolumeAttachments and reads
+		// PersistentVolumes with the default service account.
+		framework.BindClusterRole(cs.Rbac(), "cluster-admin", ns,
+			rbacv1beta1.Subject{Kind: rbacv1beta1.ServiceAccountKind, Namespace: ns, Name: "default"})
+		err := framework.WaitForAuthorizationUpdate(cs.AuthorizationV1beta1(),
+			serviceaccount.MakeUsername(ns, "default"),
+			"", "update", schema.GroupResource{Group: storage.GroupName, Resource: "volumeattachments"}, true)
+		framework.ExpectNoError(err)
+	})
+
+	It("should attach, mount and detach a pre-provisioned volume", func() {
+		volumeHandle := "e2e-" + ns
+
+		By("Starting the mock driver and its external attacher")
+		driverPod, err := cs.Core().Pods(ns).Create(makeCSIMockDriverPod(ns, node, volumeHandle))
+		framework.ExpectNoError(err)
+		defer framework.DeletePodWithWait(f, cs, driverPod)
+		framework.ExpectNoError(framework.WaitForPodRunningInNamespace(cs, driverPod))
+
+		By("Creating a PersistentVolume of the driver and its claim")
+		pvConfig := framework.PersistentVolumeConfig{
+			NamePrefix: "csi-",
+			PVSource: v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{
+					Driver:       csiMockDriverName,
+					VolumeHandle: volumeHandle,
+				},
+			},
+		}
+		pv, pvc := framework.CreatePVPVC(cs, pvConfig, ns, false)
+		defer framework.PVPVCCleanup(cs, ns, pv, pvc)
+		framework.WaitOnPVandPVC(cs, ns, pv, pvc)
+
+		By("Writing to the volume from a pod")
+		pod := framework.MakePod(ns, pvc.Name, false, "touch /mnt/SUCCESS")
+		pod.Spec.NodeName = node
+		pod, err = cs.Core().Pods(ns).Create(pod)
+		framework.ExpectNoError(err)
+		framework.ExpectNoError(framework.WaitForPodSuccessInNamespace(cs, pod.Name, ns))
+
+		By("Checking the volume is attached to the node")
+		attachment := findVolumeAttachment(cs, pv.Name, node)
+		Expect(attachment).NotTo(BeNil())
+		Expect(attachment.Spec.Attacher).To(Equal(csiMockDriverName))
+		Expect(attachment.Status.Attached).To(BeTrue())
+
+		By("Deleting the pod and waiting for the volume to be detached")
+		framework.DeletePodWithWait(f, cs, pod)
+		err = wait.Poll(5*time.Second, 5*time.Minute, func() (bool, error) {
+			return findVolumeAttachment(cs, pv.Name, node) == nil, nil
+		})
+		framework.ExpectNoError(err, fmt.Sprintf("waiting for PersistentVolume %s to be detached from %s", pv.Name, node))
+	})
+})
+
+// makeCSIMockDriverPod returns a pod running the mock driver with the given
+// volume on the node, together with its external attacher.
+func makeCSIMockDriverPod(ns, node, volumeHandle string) *v1.Pod {
+	privileged := true
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "csi-mock-driver-",
+			Namespace:    ns,
+		},
+		Spec: v1.PodSpec{
+			NodeName: node,
+			Containers: []v1.Container{
+				{
+					Name:  "driver",
+					Image: csiMockDriverImage,
+					Args: []string{
+						"--driver-name=" + csiMockDriverName,
+						"--data-dir=/var/lib/kubelet/mock-csi-driver/" + ns,
+						"--volumes=" + volumeHandle,
+						"--attacher",
+					},
+					SecurityContext: &v1.SecurityContext{
+						Privileged: &privileged,
+					},
+					VolumeMounts: []v1.VolumeMount{
+						{
+							Name:      "kubelet-dir",
+							MountPath: "/var/lib/kubelet",
+						},
+					},
+				},
+			},
+			Volumes: []v1.Volume{
+				{
+					Name: "kubelet-dir",
+					VolumeSource: v1.VolumeSource{
+						HostPath: &v1.HostPathVolumeSource{
+							Path: "/var/lib/kubelet",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// findVolumeAttachment returns the VolumeAttachment of the persistent volume
+// to the node, or nil if there is none.
+func findVolumeAttachment(cs clientset.Interface, pvName, node string) *storage.VolumeAttachment {
+	attachments, err := cs.StorageV1beta1().VolumeAttachments().List(metav1.ListOptions{})
+	framework.ExpectNoError(err)
+	for i := range attachments.Items {
+		attachment := &attachments.Items[i]
+		source := attachment.Spec.Source.PersistentVolumeName
+		if attachment.Spec.NodeName == node && source != nil && *source == pvName {
+			return attachment
+		}
+	}
+	return nil
+}
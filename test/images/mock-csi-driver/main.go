@@ -0,0 +1,112 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// A CSI driver keeping volumes as directories of a data directory, served on
+// a unix socket, and optionally its external attacher. It is used by the
+// end-to-end tests of the CSI volume plugin.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubernetes/pkg/util/mount"
+	csiapi "k8s.io/kubernetes/pkg/volume/csi/api/v1alpha1/driver"
+	"k8s.io/kubernetes/pkg/volume/csi/mock"
+)
+
+var (
+	driverName  = flag.String("driver-name", "mock.csi.k8s.io", "Name of the driver.")
+	endpoint    = flag.String("endpoint", "", "Path of the unix socket to serve on, defaults to /var/lib/kubelet/plugins/<driver-name>/csi.sock.")
+	dataDir     = flag.String("data-dir", "/var/lib/mock-csi-driver", "Directory holding the volumes.")
+	volumes     = flag.String("volumes", "", "Comma separated names of volumes to create on startup.")
+	runAttacher = flag.Bool("attacher", false, "Run the external attacher of the driver, using the in-cluster config.")
+)
+
+func main() {
+	flag.Parse()
+
+	addr := *endpoint
+	if addr == "" {
+		addr = fmt.Sprintf("/var/lib/kubelet/plugins/%s/csi.sock", *driverName)
+	}
+	if err := os.MkdirAll(filepath.Dir(addr), 0750); err != nil {
+		log.Fatalf("Failed to create the socket directory: %v", err)
+	}
+	if err := os.Remove(addr); err != nil && !os.IsNotExist(err) {
+		log.Fatalf("Failed to remove the stale socket: %v", err)
+	}
+	if err := os.MkdirAll(*dataDir, 0750); err != nil {
+		log.Fatalf("Failed to create the data directory: %v", err)
+	}
+
+	driver := mock.NewDriver(*driverName, *dataDir, mount.New(""))
+	for _, name := range strings.Split(*volumes, ",") {
+		if name == "" {
+			continue
+		}
+		if _, err := driver.CreateVolume(context.Background(), &csiapi.CreateVolumeRequest{Name: name}); err != nil {
+			log.Fatalf("Failed to create volume %s: %v", name, err)
+		}
+		log.Printf("Created volume %s", name)
+	}
+
+	listener, err := net.Listen("unix", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", addr, err)
+	}
+	go func() {
+		log.Fatal(driver.NewServer().Serve(listener))
+	}()
+	log.Printf("Serving driver %s on %s", *driverName, addr)
+
+	if *runAttacher {
+		config, err := restclient.InClusterConfig()
+		if err != nil {
+			log.Fatalf("Failed to create client: %v", err)
+		}
+		client, err := clientset.NewForConfig(config)
+		if err != nil {
+			log.Fatalf("Failed to create client: %v", err)
+		}
+		conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", addr, timeout)
+		}))
+		if err != nil {
+			log.Fatalf("Failed to connect to the driver: %v", err)
+		}
+		attacher := mock.NewAttacher(client, *driverName, csiapi.NewControllerClient(conn))
+		go attacher.Run(time.Second, make(chan struct{}))
+		log.Printf("Running the external attacher of %s", *driverName)
+	}
+
+	http.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "Ok")
+	})
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
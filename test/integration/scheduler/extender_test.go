@@ -328,6 +328,7 @@ func TestSchedulerExtender(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	schedulerConfig, err := schedulerConfigFactory.CreateFromConfig(policy)
@@ -73,6 +73,7 @@ func TestUnschedulableNodes(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	schedulerConfig, err := schedulerConfigFactory.Create()
@@ -355,6 +356,7 @@ func TestMultiScheduler(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	schedulerConfig, err := schedulerConfigFactory.Create()
@@ -439,6 +441,7 @@ func TestMultiScheduler(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	schedulerConfig2, err := schedulerConfigFactory2.Create()
@@ -547,6 +550,7 @@ func TestAllocatable(t *testing.T) {
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 	schedulerConfig, err := schedulerConfigFactory.Create()
@@ -71,6 +71,7 @@ func mustSetupScheduler() (schedulerConfigurator scheduler.Configurator, destroy
 		informerFactory.Extensions().V1beta1().ReplicaSets(),
 		informerFactory.Apps().V1beta1().StatefulSets(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Storage().V1beta1().StorageClasses(),
 		v1.DefaultHardPodAffinitySymmetricWeight,
 	)
 
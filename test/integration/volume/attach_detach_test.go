@@ -202,6 +202,7 @@ func createAdClients(ns *v1.Namespace, t *testing.T, server *httptest.Server, sy
 		informers.Core().V1().PersistentVolumes(),
 		cloud,
 		plugins,
+		nil, /* prober */
 		false,
 		time.Second*5,
 	)